@@ -19,6 +19,7 @@ import (
 	_ "storj.io/storj/satellite/admin/ui"        // embed ui
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/satellitedb"
+	"storj.io/storj/shared/flightrecorder"
 )
 
 func cmdAdminRun(cmd *cobra.Command, args []string) (err error) {
@@ -31,9 +32,16 @@ func cmdAdminRun(cmd *cobra.Command, args []string) (err error) {
 		return errs.New("Failed to load identity: %+v", err)
 	}
 
+	var recorder *flightrecorder.Box
+	if runCfg.FlightRecorder.Enabled {
+		recorder = flightrecorder.NewBox(log.Named("flightrecorder"), runCfg.FlightRecorder)
+		log = log.WithOptions(zap.WrapCore(recorder.WrapLogCore))
+	}
+
 	db, err := satellitedb.Open(ctx, log.Named("db"), runCfg.Database, satellitedb.Options{
 		ApplicationName:   "satellite-admin",
 		APIKeysLRUOptions: runCfg.APIKeysLRUOptions(),
+		FlightRecorder:    recorder,
 	})
 	if err != nil {
 		return errs.New("Error starting master database on satellite api: %+v", err)
@@ -42,8 +50,10 @@ func cmdAdminRun(cmd *cobra.Command, args []string) (err error) {
 		err = errs.Combine(err, db.Close())
 	}()
 
-	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), runCfg.Config.Metainfo.DatabaseURL,
-		runCfg.Config.Metainfo.Metabase("satellite-admin"))
+	metabaseCfg := runCfg.Config.Metainfo.Metabase("satellite-admin")
+	metabaseCfg.FlightRecorder = recorder
+
+	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), runCfg.Config.Metainfo.DatabaseURL, metabaseCfg)
 	if err != nil {
 		return errs.New("Error creating metabase connection on satellite api: %+v", err)
 	}
@@ -65,7 +75,7 @@ func cmdAdminRun(cmd *cobra.Command, args []string) (err error) {
 		err = errs.Combine(err, accountingCache.Close())
 	}()
 
-	peer, err := satellite.NewAdmin(log, identity, db, metabaseDB, accountingCache, version.Build, &runCfg.Config, process.AtomicLevel(cmd))
+	peer, err := satellite.NewAdmin(log, identity, db, metabaseDB, accountingCache, version.Build, &runCfg.Config, process.AtomicLevel(cmd), recorder)
 	if err != nil {
 		return err
 	}
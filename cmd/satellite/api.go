@@ -38,6 +38,7 @@ func cmdAPIRun(cmd *cobra.Command, args []string) (err error) {
 	var recorder *flightrecorder.Box
 	if runCfg.FlightRecorder.Enabled {
 		recorder = flightrecorder.NewBox(log.Named("flightrecorder"), runCfg.FlightRecorder)
+		log = log.WithOptions(zap.WrapCore(recorder.WrapLogCore))
 	}
 
 	var maxCommitDelay *time.Duration
@@ -107,7 +108,7 @@ func cmdAPIRun(cmd *cobra.Command, args []string) (err error) {
 		err = errs.Combine(err, rollupsWriteCache.CloseAndFlush(context2.WithoutCancellation(ctx)))
 	}()
 
-	peer, err := satellite.NewAPI(log, identity, db, metabaseDB, revocationDB, accountingCache, rollupsWriteCache, &runCfg.Config, version.Build, process.AtomicLevel(cmd))
+	peer, err := satellite.NewAPI(log, identity, db, metabaseDB, revocationDB, accountingCache, rollupsWriteCache, &runCfg.Config, version.Build, process.AtomicLevel(cmd), recorder)
 	if err != nil {
 		return err
 	}
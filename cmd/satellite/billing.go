@@ -90,6 +90,7 @@ func setupPayments(log *zap.Logger, db satellite.DB) (*stripe.Service, error) {
 			BillingDB:            db.Billing(),
 			ProjectsDB:           db.Console().Projects(),
 			UsersDB:              db.Console().Users(),
+			OrganizationsDB:      db.Console().Organizations(),
 			UsageDB:              db.ProjectAccounting(),
 			RetentionRemainderDB: db.RetentionRemainderCharges(),
 			Analytics:            analytics.NewService(log.Named("analytics:service"), runCfg.Analytics, runCfg.Console.SatelliteName, runCfg.Console.ExternalAddress),
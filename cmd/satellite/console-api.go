@@ -40,6 +40,7 @@ func cmdConsoleAPIRun(cmd *cobra.Command, args []string) (err error) {
 	var recorder *flightrecorder.Box
 	if runCfg.FlightRecorder.Enabled {
 		recorder = flightrecorder.NewBox(log.Named("flightrecorder"), runCfg.FlightRecorder)
+		log = log.WithOptions(zap.WrapCore(recorder.WrapLogCore))
 	}
 
 	db, err := satellitedb.Open(ctx, log.Named("db"), runCfg.Database, satellitedb.Options{
@@ -93,7 +94,7 @@ func cmdConsoleAPIRun(cmd *cobra.Command, args []string) (err error) {
 		err = errs.Combine(err, rollupsWriteCache.CloseAndFlush(context2.WithoutCancellation(ctx)))
 	}()
 
-	peer, err := satellite.NewConsoleAPI(log, identity, db, metabaseDB, revocationDB, accountingCache, rollupsWriteCache, &runCfg.Config, version.Build, process.AtomicLevel(cmd))
+	peer, err := satellite.NewConsoleAPI(log, identity, db, metabaseDB, revocationDB, accountingCache, rollupsWriteCache, &runCfg.Config, version.Build, process.AtomicLevel(cmd), recorder)
 	if err != nil {
 		return err
 	}
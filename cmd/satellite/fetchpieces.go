@@ -85,6 +85,7 @@ func cmdFetchPieces(cmd *cobra.Command, args []string) (err error) {
 		db.NodeEvents(),
 		db.Reputation(),
 		db.Containment(),
+		db.PeerIdentities(),
 		version.Build,
 		&runCfg.Config,
 		process.AtomicLevel(cmd),
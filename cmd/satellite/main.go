@@ -378,6 +378,37 @@ var (
 		RunE:  cmdSetPlacementProductMap,
 	}
 
+	projectMigrationCmd = &cobra.Command{
+		Use:   "project-migration",
+		Short: "Migrate projects between metabase adapters",
+		Long:  "Operations to move the objects and segments of a project between metabase adapters, while the project is in use",
+	}
+	projectMigrationStartCmd = &cobra.Command{
+		Use:   "start <project-id> <adapter>",
+		Short: "Start the migration of a project and run it",
+		Long:  "Start the migration of a project to the adapter (postgres, cockroach or spanner) and run it until it's finished",
+		Args:  cobra.ExactArgs(2),
+		RunE:  cmdProjectMigrationStart,
+	}
+	projectMigrationRunCmd = &cobra.Command{
+		Use:   "run <project-id>",
+		Short: "Continue an interrupted migration of a project",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdProjectMigrationRun,
+	}
+	projectMigrationAbortCmd = &cobra.Command{
+		Use:   "abort <project-id>",
+		Short: "Abort the migration of a project and delete the partial copy",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdProjectMigrationAbort,
+	}
+	projectMigrationStatusCmd = &cobra.Command{
+		Use:   "status [project-id]",
+		Short: "Show the routes and migrations of projects",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  cmdProjectMigrationStatus,
+	}
+
 	usersCmd = &cobra.Command{
 		Use:   "user-accounts",
 		Short: "User accounts administration",
@@ -539,6 +570,7 @@ func init() {
 	rootCmd.AddCommand(fixLastNetsCmd)
 	rootCmd.AddCommand(usersCmd)
 	rootCmd.AddCommand(entitlementsCmd)
+	rootCmd.AddCommand(projectMigrationCmd)
 	reportsCmd.AddCommand(nodeUsageCmd)
 	reportsCmd.AddCommand(partnerAttributionCmd)
 	reportsCmd.AddCommand(reportsGracefulExitCmd)
@@ -573,6 +605,16 @@ func init() {
 	setPlacementProductMapCmd.Flags().StringVar(&entitlementJSON, "placements", "", "1:1 JSON mapping of placement to product ID to set (e.g., \"{0:3,12:2}\"). If not provided, uses satellite config defaults")
 	setPlacementProductMapCmd.Flags().BoolVar(&entitlementSkipConfirm, "skip-confirmation", false, "Skip confirmation prompt for bulk operations")
 	setPlacementProductMapCmd.Flags().BoolVar(&entitlementVerbose, "verbose", false, "Whether to log info about each processed project")
+	projectMigrationCmd.AddCommand(projectMigrationStartCmd)
+	projectMigrationCmd.AddCommand(projectMigrationRunCmd)
+	projectMigrationCmd.AddCommand(projectMigrationAbortCmd)
+	projectMigrationCmd.AddCommand(projectMigrationStatusCmd)
+	for _, cmd := range []*cobra.Command{projectMigrationStartCmd, projectMigrationRunCmd, projectMigrationAbortCmd} {
+		cmd.Flags().IntVar(&projectMigrationCfg.BatchSize, "batch-size", 1000, "Number of objects copied in a single transaction")
+		cmd.Flags().IntVar(&projectMigrationCfg.MaxPasses, "max-passes", 10, "Number of copy passes, after which the project is frozen, even when the copy doesn't converge")
+		cmd.Flags().IntVar(&projectMigrationCfg.FreezeThreshold, "freeze-threshold", 100, "Number of changed objects in a copy pass, below which the project is frozen for the final pass")
+		cmd.Flags().DurationVar(&projectMigrationCfg.GracePeriod, "grace-period", 0, "How long to wait for all processes to load a changed route, 0 means three times the refresh interval")
+	}
 	consistencyCmd.AddCommand(consistencyGECleanupCmd)
 	usersCmd.AddCommand(deleteObjectsCmd)
 	deleteObjectsCmd.Flags().IntVar(&batchSizeDeleteObjects, "batch-size", 100, "Number of objects/segments to delete in a single batch")
@@ -631,6 +673,10 @@ func init() {
 	process.Bind(setAccountsStatusPendingDeletionCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(setNewBucketPlacementsCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(setPlacementProductMapCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(projectMigrationStartCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(projectMigrationRunCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(projectMigrationAbortCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(projectMigrationStatusCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 
 	if err := consistencyGECleanupCmd.MarkFlagRequired("before"); err != nil {
 		panic(err)
@@ -0,0 +1,116 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/process"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+var projectMigrationCfg metabase.ProjectMigrationConfig
+
+func cmdProjectMigrationStart(cmd *cobra.Command, args []string) error {
+	projectID, err := uuid.FromString(args[0])
+	if err != nil {
+		return errs.New("invalid project ID: %+v", err)
+	}
+
+	return withProjectMigrator(cmd, func(ctx context.Context, migrator *metabase.ProjectMigrator) error {
+		if err := migrator.Start(ctx, projectID, args[1]); err != nil {
+			return err
+		}
+		return migrator.Run(ctx, projectID)
+	})
+}
+
+func cmdProjectMigrationRun(cmd *cobra.Command, args []string) error {
+	projectID, err := uuid.FromString(args[0])
+	if err != nil {
+		return errs.New("invalid project ID: %+v", err)
+	}
+
+	return withProjectMigrator(cmd, func(ctx context.Context, migrator *metabase.ProjectMigrator) error {
+		return migrator.Run(ctx, projectID)
+	})
+}
+
+func cmdProjectMigrationAbort(cmd *cobra.Command, args []string) error {
+	projectID, err := uuid.FromString(args[0])
+	if err != nil {
+		return errs.New("invalid project ID: %+v", err)
+	}
+
+	return withProjectMigrator(cmd, func(ctx context.Context, migrator *metabase.ProjectMigrator) error {
+		if err := migrator.Abort(ctx, projectID); err != nil {
+			return err
+		}
+		return migrator.Run(ctx, projectID)
+	})
+}
+
+func cmdProjectMigrationStatus(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	metabaseDB, err := metabase.Open(ctx, zap.L().Named("metabase"), runCfg.Metainfo.DatabaseURL,
+		runCfg.Config.Metainfo.Metabase("satellite-project-migration"))
+	if err != nil {
+		return errs.New("Error creating metabase connection: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, metabaseDB.Close())
+	}()
+
+	var routes []metabase.ProjectRoute
+	if len(args) > 0 {
+		projectID, err := uuid.FromString(args[0])
+		if err != nil {
+			return errs.New("invalid project ID: %+v", err)
+		}
+		route, err := metabaseDB.GetProjectRoute(ctx, projectID)
+		if err != nil {
+			return err
+		}
+		routes = append(routes, route)
+	} else {
+		routes, err = metabaseDB.ListProjectRoutes(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "PROJECT\tADAPTER\tMIGRATION\tSTATE\tPASS\tCHANGED\tOBJECTS\tSEGMENTS\tUPDATED\tERROR")
+	for _, route := range routes {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\t%d\t%d\t%s\t%s\n",
+			route.ProjectID, route.Adapter, route.MigrationAdapter, route.MigrationState, route.Pass,
+			route.ChangedObjects, route.TotalObjects, route.TotalSegments, route.UpdatedAt.Format("2006-01-02 15:04:05"), route.Error)
+	}
+	return w.Flush()
+}
+
+func withProjectMigrator(cmd *cobra.Command, fn func(ctx context.Context, migrator *metabase.ProjectMigrator) error) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), runCfg.Metainfo.DatabaseURL,
+		runCfg.Config.Metainfo.Metabase("satellite-project-migration"))
+	if err != nil {
+		return errs.New("Error creating metabase connection: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, metabaseDB.Close())
+	}()
+
+	return fn(ctx, metabase.NewProjectMigrator(log.Named("project-migration"), metabaseDB, projectMigrationCfg))
+}
@@ -77,6 +77,7 @@ func cmdRepairerRun(cmd *cobra.Command, args []string) (err error) {
 		db.NodeEvents(),
 		db.Reputation(),
 		db.Containment(),
+		db.PeerIdentities(),
 		version.Build,
 		&runCfg.Config,
 		process.AtomicLevel(cmd),
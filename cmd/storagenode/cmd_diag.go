@@ -4,6 +4,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -26,6 +27,8 @@ import (
 type diagCfg struct {
 	storagenode.Config
 
+	Repair bool `help:"check the databases for corruption and rebuild the corrupted ones, the node must be stopped" default:"false"`
+
 	DiagDir string `internal:"true"`
 }
 
@@ -68,6 +71,10 @@ func cmdDiag(cmd *cobra.Command, cfg *diagCfg) (err error) {
 		err = errs.Combine(err, db.Close())
 	}()
 
+	if cfg.Repair {
+		return diagRepair(ctx, db)
+	}
+
 	summaries, err := db.Bandwidth().SummaryBySatellite(ctx, time.Time{}, time.Now())
 	if err != nil {
 		fmt.Printf("unable to get bandwidth summary: %v\n", err)
@@ -101,3 +108,25 @@ func cmdDiag(cmd *cobra.Command, cfg *diagCfg) (err error) {
 
 	return nil
 }
+
+func diagRepair(ctx context.Context, db *storagenodedb.DB) error {
+	corrupted, err := db.CheckIntegrity(ctx, true)
+	if err != nil {
+		return err
+	}
+	if len(corrupted) == 0 {
+		fmt.Println("all databases are ok")
+		return nil
+	}
+
+	for _, dbName := range corrupted {
+		fmt.Println("database is corrupted:", dbName)
+	}
+	if err := db.Rebuild(ctx, corrupted...); err != nil {
+		return err
+	}
+
+	fmt.Printf("corrupted databases were rebuilt, the originals were moved to %q\n",
+		filepath.Join(db.DBDirectory(), storagenodedb.QuarantineDir))
+	return nil
+}
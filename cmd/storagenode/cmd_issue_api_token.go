@@ -19,6 +19,8 @@ import (
 
 type issueCfg struct {
 	storagenode.Config
+
+	Scopes string `default:"" help:"comma separated management actions allowed with the api key: allocated-disk-space, graceful-exit, forget-satellite, filewalker, rotate-api-key or all"`
 }
 
 func newIssueAPIKeyCmd(f *Factory) *cobra.Command {
@@ -55,9 +57,14 @@ func cmdIssue(cmd *cobra.Command, cfg *issueCfg) (err error) {
 		err = errs.Combine(err, db.Close())
 	}()
 
+	scopes, err := apikeys.ParseScope(cfg.Scopes)
+	if err != nil {
+		return err
+	}
+
 	service := apikeys.NewService(db.APIKeys())
 
-	apiKey, err := service.Issue(ctx)
+	apiKey, err := service.Issue(ctx, scopes)
 	if err != nil {
 		return errs.New("Error while trying to issue new api key: %v", err)
 	}
@@ -22,7 +22,8 @@ import (
 type nodeInfoCfg struct {
 	storagenode.Config
 
-	JSON bool `default:"false" help:"print node info in JSON format"`
+	JSON   bool   `default:"false" help:"print node info in JSON format"`
+	Scopes string `default:"" help:"comma separated management actions allowed with the issued api key: allocated-disk-space, graceful-exit, forget-satellite, filewalker, rotate-api-key or all"`
 }
 
 func newNodeInfoCmd(f *Factory) *cobra.Command {
@@ -79,9 +80,14 @@ func cmdInfo(cmd *cobra.Command, cfg *nodeInfoCfg) (err error) {
 		err = errs.Combine(err, db.Close())
 	}()
 
+	scopes, err := apikeys.ParseScope(cfg.Scopes)
+	if err != nil {
+		return err
+	}
+
 	service := apikeys.NewService(db.APIKeys())
 
-	apiKey, err := service.Issue(ctx)
+	apiKey, err := service.Issue(ctx, scopes)
 	if err != nil {
 		return errs.New("error while trying to issue new api key: %v", err)
 	}
@@ -0,0 +1,201 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/cfgstruct"
+	"storj.io/common/memory"
+	"storj.io/common/process"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/relocate"
+)
+
+// relocateCfg defines configuration for relocate command.
+type relocateCfg struct {
+	storagenode.Config
+
+	RelocateOptions
+}
+
+// RelocateOptions defines options for relocate command.
+type RelocateOptions struct {
+	To          string `help:"new storage directory" default:""`
+	LogsTo      string `help:"new hashstore logs directory, if it should be moved separately from the storage directory" default:""`
+	TablesTo    string `help:"new hashstore tables directory, if it should be moved separately from the storage directory" default:""`
+	DatabasesTo string `help:"new database directory, if it should be moved separately from the storage directory" default:""`
+
+	MaxPasses    int         `help:"maximum number of copy passes before giving up to converge" default:"10"`
+	ConvergeSize memory.Size `help:"amount of data copied in a pass below which the copy is considered converged" default:"1GiB"`
+
+	Status bool `help:"print the status of the relocation" default:"false"`
+	Cancel bool `help:"cancel the relocation, the data copied so far is not removed" default:"false"`
+
+	Stdout io.Writer `internal:"true"`
+}
+
+func newRelocateCmd(f *Factory) *cobra.Command {
+	var cfg relocateCfg
+	cmd := &cobra.Command{
+		Use:   "relocate",
+		Short: "Move the storage node data to a new location",
+		Long: "The command copies the data of the storage node to a new location while the node keeps running.\n" +
+			"Once the copy is synced, restarting the node copies the remaining changes, verifies the copy and\n" +
+			"switches the configuration to the new location. Running the command again resumes the copy.\n",
+		Example: `
+# Copy the data while the node is running, then restart the node to switch to the new location
+$ storagenode relocate --to /mnt/new-disk/storagenode --config-dir /path/to/configDir
+
+# Move the databases to a separate disk as well
+$ storagenode relocate --to /mnt/new-disk/storagenode --databases-to /mnt/ssd/databases --config-dir /path/to/configDir
+
+# Show the progress of the relocation
+$ storagenode relocate --status --config-dir /path/to/configDir
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, _ := process.Ctx(cmd)
+			log := zap.L()
+
+			if cfg.Status && cfg.Cancel {
+				return errs.New("cannot specify both --status and --cancel")
+			}
+			if cfg.Stdout == nil {
+				cfg.Stdout = os.Stdout
+			}
+
+			switch {
+			case cfg.Status:
+				return cmdRelocateStatus(&cfg)
+			case cfg.Cancel:
+				if err := relocate.Cancel(cfg.Storage.Path); err != nil {
+					return err
+				}
+				_, err := fmt.Fprintln(cfg.Stdout, "Relocation canceled.")
+				return err
+			}
+
+			state, err := relocate.LoadState(cfg.Storage.Path)
+			if err != nil {
+				return err
+			}
+
+			if state == nil {
+				if cfg.To == "" {
+					return errs.New("--to is required")
+				}
+				state, err = relocate.NewState(relocate.Paths{
+					Storage:     cfg.Storage.Path,
+					LogsPath:    cfg.Hashstore.LogsPath,
+					TablePath:   cfg.Hashstore.TablePath,
+					DatabaseDir: cfg.Storage2.DatabaseDir,
+				}, relocate.Targets{
+					Storage:   cfg.To,
+					Logs:      cfg.LogsTo,
+					Tables:    cfg.TablesTo,
+					Databases: cfg.DatabasesTo,
+				}, time.Now())
+				if err != nil {
+					return err
+				}
+				if err := state.CheckDestinations(); err != nil {
+					return err
+				}
+			} else if state.Phase == relocate.PhaseDone {
+				return errs.New("relocation to %q is already done", state.Locations[0].Destination)
+			} else {
+				destination := state.Locations[0].Destination
+				if cfg.To != "" {
+					to, err := filepath.Abs(cfg.To)
+					if err != nil {
+						return err
+					}
+					if to != destination {
+						return errs.New("relocation to %q is in progress, use --cancel to start a different one", destination)
+					}
+				}
+				log.Info("resuming relocation", zap.String("destination", destination))
+			}
+
+			err = relocate.Copy(ctx, log, state, relocate.Options{
+				MaxPasses:    cfg.MaxPasses,
+				ConvergeSize: cfg.ConvergeSize,
+				Progress: func(state relocate.State) {
+					log.Info("relocation pass done",
+						zap.Int("pass", state.Passes),
+						zap.Int64("files copied", state.LastPass.FilesCopied),
+						zap.Stringer("copied", memory.Size(state.LastPass.BytesCopied)),
+						zap.Duration("duration", state.LastPass.Duration))
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			return printRelocationStatus(cfg.Stdout, state)
+		},
+		Annotations: map[string]string{"type": "helper"},
+	}
+
+	process.Bind(cmd, &cfg, f.Defaults, cfgstruct.ConfDir(f.ConfDir), cfgstruct.IdentityDir(f.IdentityDir))
+
+	return cmd
+}
+
+func cmdRelocateStatus(cfg *relocateCfg) error {
+	state, err := relocate.LoadState(cfg.Storage.Path)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		_, err := fmt.Fprintln(cfg.Stdout, "No relocation in progress.")
+		return err
+	}
+	return printRelocationStatus(cfg.Stdout, state)
+}
+
+func printRelocationStatus(w io.Writer, state *relocate.State) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(tw, "Phase:\t%s\n", state.Phase)
+	for _, location := range state.Locations {
+		_, _ = fmt.Fprintf(tw, "Location:\t%s -> %s\n", location.Source, location.Destination)
+	}
+	_, _ = fmt.Fprintf(tw, "Passes:\t%d\n", state.Passes)
+	_, _ = fmt.Fprintf(tw, "Copied:\t%d files, %s\n", state.FilesCopied, memory.Size(state.BytesCopied))
+	_, _ = fmt.Fprintf(tw, "Last pass:\t%d files, %s in %s\n", state.LastPass.FilesCopied, memory.Size(state.LastPass.BytesCopied), state.LastPass.Duration)
+	_, _ = fmt.Fprintf(tw, "Updated:\t%s\n", state.UpdatedAt.Format(time.RFC3339))
+
+	switch state.Phase {
+	case relocate.PhaseCopying:
+		_, _ = fmt.Fprintln(tw, "\nThe copy hasn't converged yet, run the command again to continue.")
+	case relocate.PhaseSynced:
+		_, _ = fmt.Fprintln(tw, "\nRestart the node to finish the relocation.")
+	}
+	return tw.Flush()
+}
+
+// applyRelocation applies the config values of a finished relocation.
+func applyRelocation(cfg *storagenode.Config, values map[string]string) {
+	for key, value := range values {
+		switch key {
+		case relocate.ConfigKeyStoragePath:
+			cfg.Storage.Path = value
+		case relocate.ConfigKeyLogsPath:
+			cfg.Hashstore.LogsPath = value
+		case relocate.ConfigKeyTablePath:
+			cfg.Hashstore.TablePath = value
+		case relocate.ConfigKeyDatabaseDir:
+			cfg.Storage2.DatabaseDir = value
+		}
+	}
+}
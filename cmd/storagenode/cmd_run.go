@@ -4,6 +4,8 @@
 package main
 
 import (
+	"path/filepath"
+
 	"github.com/spf13/cobra"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -14,12 +16,15 @@ import (
 	"storj.io/common/version"
 	"storj.io/storj/private/revocation"
 	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/relocate"
 	"storj.io/storj/storagenode/storagenodedb"
 )
 
 // runCfg defines configuration for run command.
 type runCfg struct {
 	StorageNodeFlags
+
+	ConfigFile string `internal:"true"`
 }
 
 // newRunCmd creates a new run command.
@@ -30,6 +35,7 @@ func newRunCmd(f *Factory) *cobra.Command {
 		Use:   "run",
 		Short: "Run the storagenode",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			runCfg.ConfigFile = filepath.Join(f.ConfDir, "config.yaml")
 			return cmdRun(cmd, &runCfg)
 		},
 	}
@@ -64,6 +70,12 @@ func cmdRun(cmd *cobra.Command, cfg *runCfg) (err error) {
 	if cfg.StorageNodeFlags.Config.Pieces.FileStatCache != "" && cfg.StorageNodeFlags.Config.Pieces.EnableLazyFilewalker {
 		return errs.New("filestat cache is incompatible with lazy file walker. Please use --pieces.enable-lazy-filewalker=false")
 	}
+	relocated, err := relocate.Finish(ctx, log.Named("relocate"), cfg.Storage.Path, cfg.ConfigFile)
+	if err != nil {
+		return errs.New("Error finishing storage relocation: %+v", err)
+	}
+	applyRelocation(&cfg.Config, relocated)
+
 	db, err := storagenodedb.OpenExisting(ctx, log.Named("db"), cfg.DatabaseConfig())
 	if err != nil {
 		return errs.New("Error opening database on storagenode: %+v", err)
@@ -73,6 +85,14 @@ func cmdRun(cmd *cobra.Command, cfg *runCfg) (err error) {
 		err = errs.Combine(err, db.Close())
 	}()
 
+	var rebuilt []string
+	if cfg.Config.Preflight.DatabaseRepair {
+		rebuilt, err = db.RepairCorrupted(ctx, cfg.Config.Preflight.DatabaseIntegrityCheck)
+		if err != nil {
+			return errs.New("Error repairing corrupted databases on storagenode: %+v", err)
+		}
+	}
+
 	revocationDB, err := revocation.OpenDBFromCfg(ctx, cfg.Server.Config)
 	if err != nil {
 		return errs.New("Error opening revocation database: %+v", err)
@@ -85,6 +105,7 @@ func cmdRun(cmd *cobra.Command, cfg *runCfg) (err error) {
 	if err != nil {
 		return errs.New("Failed to create storage node peer: %+v", err)
 	}
+	peer.RepopulateRebuiltDatabases(rebuilt)
 
 	// okay, start doing stuff ====
 
@@ -99,11 +120,13 @@ func cmdRun(cmd *cobra.Command, cfg *runCfg) (err error) {
 
 	err = db.MigrateToLatest(ctx)
 	if err != nil {
+		db.ReportCorruption(err)
 		return errs.New("Error migrating tables for database on storagenode: %+v", err)
 	}
 
 	err = db.CheckVersion(ctx)
 	if err != nil {
+		db.ReportCorruption(err)
 		return errs.New("Error checking version for storagenode database: %+v", err)
 	}
 
@@ -114,6 +137,7 @@ func cmdRun(cmd *cobra.Command, cfg *runCfg) (err error) {
 	if preflightEnabled {
 		err = db.Preflight(ctx)
 		if err != nil {
+			db.ReportCorruption(err)
 			return errs.New("Error during preflight check for storagenode databases: %+v", err)
 		}
 	}
@@ -125,6 +149,7 @@ func cmdRun(cmd *cobra.Command, cfg *runCfg) (err error) {
 	}
 
 	runError := peer.Run(ctx)
+	db.ReportCorruption(runError)
 	closeError := peer.Close()
 
 	return errs.Combine(runError, closeError)
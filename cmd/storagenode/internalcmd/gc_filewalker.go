@@ -94,7 +94,7 @@ func gcCmdRun(g *RunOptions) (err error) {
 	}()
 
 	// Decode the bloom filter
-	filter, err := bloomfilter.NewFromBytes(req.BloomFilter)
+	filter, err := bloomfilter.Decode(req.BloomFilter)
 	if err != nil {
 		return err
 	}
@@ -33,6 +33,7 @@ require (
 	github.com/dgraph-io/badger/v4 v4.5.0
 	github.com/dsnet/try v0.0.3
 	github.com/fatih/color v1.15.0
+	github.com/fxamacker/cbor/v2 v2.7.0
 	github.com/go-oauth2/oauth2/v4 v4.5.4
 	github.com/gogo/protobuf v1.3.2
 	github.com/golang-jwt/jwt/v5 v5.3.0
@@ -50,6 +51,7 @@ require (
 	github.com/linkedin/goavro/v2 v2.13.1
 	github.com/loov/hrtime v1.0.3
 	github.com/mattn/go-sqlite3 v1.14.28
+	github.com/miekg/pkcs11 v1.1.2
 	github.com/nsf/jsondiff v0.0.0-20200515183724-f29ed568f4ce
 	github.com/nsf/termbox-go v0.0.0-20200418040025-38ba6e5628f1
 	github.com/oschwald/maxminddb-golang v1.12.0
@@ -144,7 +146,6 @@ require (
 	github.com/felixge/httpsnoop v1.0.4 // indirect
 	github.com/flynn/noise v1.0.0 // indirect
 	github.com/fsnotify/fsnotify v1.7.0 // indirect
-	github.com/fxamacker/cbor/v2 v2.7.0 // indirect
 	github.com/go-jose/go-jose/v4 v4.1.3 // indirect
 	github.com/go-logr/logr v1.4.3 // indirect
 	github.com/go-logr/stdr v1.2.2 // indirect
@@ -0,0 +1,83 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/multinode/management"
+)
+
+var (
+	// ErrManagement is an error type for management web api controller.
+	ErrManagement = errs.Class("management web api controller")
+)
+
+// Management is a management web api controller.
+type Management struct {
+	log     *zap.Logger
+	service *management.Service
+}
+
+// NewManagement is a constructor of management controller.
+func NewManagement(log *zap.Logger, service *management.Service) *Management {
+	return &Management{
+		log:     log,
+		service: service,
+	}
+}
+
+// Run handles performing a management action on the selected nodes.
+// The response contains the result for every node, even if the action failed on some of them.
+func (controller *Management) Run(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	action := management.Action(mux.Vars(r)["action"])
+
+	var request management.Request
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.Wrap(err))
+		return
+	}
+
+	results, err := controller.service.Run(ctx, action, request)
+	if err != nil {
+		if management.Error.Has(err) {
+			controller.serveError(w, http.StatusBadRequest, ErrManagement.Wrap(err))
+			return
+		}
+		controller.log.Error("management action internal error", zap.Error(ErrManagement.Wrap(err)))
+		controller.serveError(w, http.StatusInternalServerError, ErrManagement.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(results); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(ErrManagement.Wrap(err)))
+		return
+	}
+}
+
+// serveError set http statuses and send json error.
+func (controller *Management) serveError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(err))
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/multinode/metrics"
+)
+
+var (
+	// ErrMetrics is an error type for metrics web api controller.
+	ErrMetrics = errs.Class("metrics web api controller")
+)
+
+// defaultMetricsRange is the range of the returned samples, when from is not specified.
+const defaultMetricsRange = 7 * 24 * time.Hour
+
+// Metrics is a metrics web api controller.
+type Metrics struct {
+	log     *zap.Logger
+	service *metrics.Service
+}
+
+// NewMetrics is a constructor of metrics controller.
+func NewMetrics(log *zap.Logger, service *metrics.Service) *Metrics {
+	return &Metrics{
+		log:     log,
+		service: service,
+	}
+}
+
+// metricsQuery contains the parsed query parameters of metrics requests.
+type metricsQuery struct {
+	nodeID      storj.NodeID
+	satelliteID storj.NodeID
+	from, to    time.Time
+}
+
+// NodeSamples handles retrieval of node samples in a time range.
+func (controller *Metrics) NodeSamples(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	query, err := parseMetricsQuery(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrMetrics.Wrap(err))
+		return
+	}
+
+	samples, err := controller.service.NodeSamples(ctx, query.nodeID, query.from, query.to)
+	if err != nil {
+		controller.log.Error("node samples internal error", zap.Error(ErrMetrics.Wrap(err)))
+		controller.serveError(w, http.StatusInternalServerError, ErrMetrics.Wrap(err))
+		return
+	}
+
+	if len(samples) == 0 {
+		samples = make([]metrics.NodeSample, 0)
+	}
+	if err = json.NewEncoder(w).Encode(samples); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(ErrMetrics.Wrap(err)))
+		return
+	}
+}
+
+// SatelliteSamples handles retrieval of satellite samples in a time range.
+func (controller *Metrics) SatelliteSamples(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	query, err := parseMetricsQuery(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrMetrics.Wrap(err))
+		return
+	}
+
+	samples, err := controller.service.SatelliteSamples(ctx, query.nodeID, query.satelliteID, query.from, query.to)
+	if err != nil {
+		controller.log.Error("satellite samples internal error", zap.Error(ErrMetrics.Wrap(err)))
+		controller.serveError(w, http.StatusInternalServerError, ErrMetrics.Wrap(err))
+		return
+	}
+
+	if len(samples) == 0 {
+		samples = make([]metrics.SatelliteSample, 0)
+	}
+	if err = json.NewEncoder(w).Encode(samples); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(ErrMetrics.Wrap(err)))
+		return
+	}
+}
+
+// ExportNodeSamples handles export of node samples in a time range as csv.
+func (controller *Metrics) ExportNodeSamples(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	query, err := parseMetricsQuery(r)
+	if err != nil {
+		w.Header().Add("Content-Type", "application/json")
+		controller.serveError(w, http.StatusBadRequest, ErrMetrics.Wrap(err))
+		return
+	}
+
+	samples, err := controller.service.NodeSamples(ctx, query.nodeID, query.from, query.to)
+	if err != nil {
+		controller.log.Error("node samples internal error", zap.Error(ErrMetrics.Wrap(err)))
+		w.Header().Add("Content-Type", "application/json")
+		controller.serveError(w, http.StatusInternalServerError, ErrMetrics.Wrap(err))
+		return
+	}
+
+	controller.setCSVHeaders(w, "node-metrics", query)
+	if err = metrics.WriteNodeSamplesCSV(w, samples); err != nil {
+		controller.log.Error("failed to write csv response", zap.Error(ErrMetrics.Wrap(err)))
+		return
+	}
+}
+
+// ExportSatelliteSamples handles export of satellite samples in a time range as csv.
+func (controller *Metrics) ExportSatelliteSamples(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	query, err := parseMetricsQuery(r)
+	if err != nil {
+		w.Header().Add("Content-Type", "application/json")
+		controller.serveError(w, http.StatusBadRequest, ErrMetrics.Wrap(err))
+		return
+	}
+
+	samples, err := controller.service.SatelliteSamples(ctx, query.nodeID, query.satelliteID, query.from, query.to)
+	if err != nil {
+		controller.log.Error("satellite samples internal error", zap.Error(ErrMetrics.Wrap(err)))
+		w.Header().Add("Content-Type", "application/json")
+		controller.serveError(w, http.StatusInternalServerError, ErrMetrics.Wrap(err))
+		return
+	}
+
+	controller.setCSVHeaders(w, "satellite-metrics", query)
+	if err = metrics.WriteSatelliteSamplesCSV(w, samples); err != nil {
+		controller.log.Error("failed to write csv response", zap.Error(ErrMetrics.Wrap(err)))
+		return
+	}
+}
+
+// setCSVHeaders sets the headers of a csv file download.
+func (controller *Metrics) setCSVHeaders(w http.ResponseWriter, name string, query metricsQuery) {
+	filename := fmt.Sprintf("%s-%s-%s.csv", name, query.from.UTC().Format("20060102T150405Z"), query.to.UTC().Format("20060102T150405Z"))
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+}
+
+// parseMetricsQuery parses the optional nodeID, satelliteID, from and to query parameters.
+// The times are in RFC3339 format, by default the last week is returned.
+func parseMetricsQuery(r *http.Request) (query metricsQuery, err error) {
+	values := r.URL.Query()
+
+	if nodeIDParam := values.Get("nodeID"); nodeIDParam != "" {
+		query.nodeID, err = storj.NodeIDFromString(nodeIDParam)
+		if err != nil {
+			return metricsQuery{}, err
+		}
+	}
+	if satelliteIDParam := values.Get("satelliteID"); satelliteIDParam != "" {
+		query.satelliteID, err = storj.NodeIDFromString(satelliteIDParam)
+		if err != nil {
+			return metricsQuery{}, err
+		}
+	}
+
+	query.to = time.Now()
+	if toParam := values.Get("to"); toParam != "" {
+		query.to, err = time.Parse(time.RFC3339, toParam)
+		if err != nil {
+			return metricsQuery{}, err
+		}
+	}
+
+	query.from = query.to.Add(-defaultMetricsRange)
+	if fromParam := values.Get("from"); fromParam != "" {
+		query.from, err = time.Parse(time.RFC3339, fromParam)
+		if err != nil {
+			return metricsQuery{}, err
+		}
+	}
+
+	if !query.from.Before(query.to) {
+		return metricsQuery{}, errs.New("from must be before to")
+	}
+
+	return query, nil
+}
+
+// serveError set http statuses and send json error.
+func (controller *Metrics) serveError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(err))
+	}
+}
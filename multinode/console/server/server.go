@@ -19,6 +19,8 @@ import (
 	"storj.io/common/errs2"
 	"storj.io/storj/multinode/bandwidth"
 	"storj.io/storj/multinode/console/controllers"
+	"storj.io/storj/multinode/management"
+	"storj.io/storj/multinode/metrics"
 	"storj.io/storj/multinode/nodes"
 	"storj.io/storj/multinode/operators"
 	"storj.io/storj/multinode/payouts"
@@ -46,6 +48,8 @@ type Services struct {
 	Storage    *storage.Service
 	Bandwidth  *bandwidth.Service
 	Reputation *reputation.Service
+	Metrics    *metrics.Service
+	Management *management.Service
 }
 
 // Server represents Multinode Dashboard http server.
@@ -63,6 +67,8 @@ type Server struct {
 	bandwidth  *bandwidth.Service
 	storage    *storage.Service
 	reputation *reputation.Service
+	metrics    *metrics.Service
+	management *management.Service
 }
 
 // NewServer returns new instance of Multinode Dashboard http server.
@@ -77,6 +83,8 @@ func NewServer(log *zap.Logger, listener net.Listener, assets fs.FS, services Se
 		storage:    services.Storage,
 		bandwidth:  services.Bandwidth,
 		reputation: services.Reputation,
+		metrics:    services.Metrics,
+		management: services.Management,
 	}
 
 	router := mux.NewRouter()
@@ -133,6 +141,17 @@ func NewServer(log *zap.Logger, listener net.Listener, assets fs.FS, services Se
 	reputationRouter := apiRouter.PathPrefix("/reputation").Subrouter()
 	reputationRouter.HandleFunc("/satellites/{satelliteID}", reputationController.Stats)
 
+	metricsController := controllers.NewMetrics(server.log, server.metrics)
+	metricsRouter := apiRouter.PathPrefix("/metrics").Subrouter()
+	metricsRouter.HandleFunc("/nodes", metricsController.NodeSamples).Methods(http.MethodGet)
+	metricsRouter.HandleFunc("/nodes/export", metricsController.ExportNodeSamples).Methods(http.MethodGet)
+	metricsRouter.HandleFunc("/satellites", metricsController.SatelliteSamples).Methods(http.MethodGet)
+	metricsRouter.HandleFunc("/satellites/export", metricsController.ExportSatelliteSamples).Methods(http.MethodGet)
+
+	managementController := controllers.NewManagement(server.log, server.management)
+	managementRouter := apiRouter.PathPrefix("/management").Subrouter()
+	managementRouter.HandleFunc("/{action}", managementController.Run).Methods(http.MethodPost)
+
 	staticServer := http.FileServer(http.FS(server.assets))
 	router.PathPrefix("/static").Handler(http.StripPrefix("/static/", web.CacheHandler(staticServer)))
 	router.PathPrefix("/").HandlerFunc(server.appHandler)
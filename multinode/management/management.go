@@ -0,0 +1,53 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package management
+
+import (
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is an error class for management service error.
+	Error = errs.Class("management")
+)
+
+// Action is a management action, which changes the state of storage nodes.
+type Action string
+
+const (
+	// ActionSetAllocatedDiskSpace changes the allocated disk space.
+	ActionSetAllocatedDiskSpace Action = "set-allocated-disk-space"
+	// ActionInitiateGracefulExit starts graceful exit from a satellite.
+	ActionInitiateGracefulExit Action = "initiate-graceful-exit"
+	// ActionCancelGracefulExit cancels pending graceful exit from a satellite.
+	ActionCancelGracefulExit Action = "cancel-graceful-exit"
+	// ActionForgetSatellite removes all data of a satellite.
+	ActionForgetSatellite Action = "forget-satellite"
+	// ActionTriggerUsedSpaceFilewalker recalculates the used space.
+	ActionTriggerUsedSpaceFilewalker Action = "trigger-used-space-filewalker"
+	// ActionRotateAPIKey replaces the api key of the node with a new one.
+	ActionRotateAPIKey Action = "rotate-api-key"
+)
+
+// Request contains the nodes and the parameters of a bulk action.
+type Request struct {
+	NodeIDs []storj.NodeID `json:"nodeIds"`
+	// Allocated is the new allocated disk space in bytes for ActionSetAllocatedDiskSpace.
+	Allocated int64 `json:"allocated"`
+	// SatelliteID is the satellite for graceful exit and forget satellite actions.
+	SatelliteID storj.NodeID `json:"satelliteId"`
+	// ForceCleanup removes the satellite data for ActionForgetSatellite even if the satellite is trusted.
+	ForceCleanup bool `json:"forceCleanup"`
+}
+
+// Result is the outcome of an action on a single node.
+type Result struct {
+	NodeID storj.NodeID `json:"nodeId"`
+	Error  string       `json:"error,omitempty"`
+}
@@ -0,0 +1,190 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package management
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/private/multinodeauth"
+	"storj.io/storj/private/multinodepb"
+)
+
+// ErrPermissionDenied is an error class that indicates that the node api key doesn't allow the action.
+var ErrPermissionDenied = errs.Class("node api key doesn't allow the action")
+
+// maxConcurrentNodes is the number of nodes a bulk action is performed on at the same time.
+const maxConcurrentNodes = 8
+
+// Service performs management actions on storage nodes.
+//
+// architecture: Service
+type Service struct {
+	log    *zap.Logger
+	dialer rpc.Dialer
+	nodes  *nodes.Service
+}
+
+// NewService creates new instance of Service.
+func NewService(log *zap.Logger, dialer rpc.Dialer, nodes *nodes.Service) *Service {
+	return &Service{
+		log:    log,
+		dialer: dialer,
+		nodes:  nodes,
+	}
+}
+
+// Run performs the action on all requested nodes and returns the result for every node.
+// Failure on a single node doesn't stop the action on the other nodes.
+func (service *Service) Run(ctx context.Context, action Action, req Request) (_ []Result, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := validate(action, req); err != nil {
+		return nil, err
+	}
+
+	results := make([]Result, len(req.NodeIDs))
+	limiter := sync2.NewLimiter(maxConcurrentNodes)
+	for i, nodeID := range req.NodeIDs {
+		results[i].NodeID = nodeID
+
+		limiter.Go(ctx, func() {
+			log := service.log.With(zap.String("action", string(action)), zap.Stringer("node_id", nodeID))
+			if err := service.run(ctx, action, nodeID, req); err != nil {
+				log.Warn("management action failed", zap.Error(err))
+				results[i].Error = err.Error()
+				return
+			}
+			log.Info("management action succeeded")
+		})
+	}
+	limiter.Wait()
+
+	return results, ctx.Err()
+}
+
+// validate checks that the request contains the parameters required by the action.
+func validate(action Action, req Request) error {
+	if len(req.NodeIDs) == 0 {
+		return Error.New("no nodes selected")
+	}
+
+	switch action {
+	case ActionSetAllocatedDiskSpace:
+		if req.Allocated <= 0 {
+			return Error.New("allocated disk space must be positive")
+		}
+	case ActionInitiateGracefulExit, ActionCancelGracefulExit, ActionForgetSatellite:
+		if req.SatelliteID.IsZero() {
+			return Error.New("satellite is required")
+		}
+	case ActionTriggerUsedSpaceFilewalker, ActionRotateAPIKey:
+	default:
+		return Error.New("unknown action %q", action)
+	}
+
+	return nil
+}
+
+// run performs the action on a single node.
+func (service *Service) run(ctx context.Context, action Action, nodeID storj.NodeID, req Request) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	node, err := service.nodes.Get(ctx, nodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	conn, err := service.dialer.DialNodeURL(ctx, storj.NodeURL{
+		ID:      node.ID,
+		Address: node.PublicAddress,
+	})
+	if err != nil {
+		return nodes.ErrNodeNotReachable.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, conn.Close())
+	}()
+
+	client := multinodepb.NewDRPCManagementClient(conn)
+	header := &multinodepb.RequestHeader{
+		ApiKey: node.APISecret[:],
+	}
+
+	switch action {
+	case ActionSetAllocatedDiskSpace:
+		_, err = client.SetAllocatedDiskSpace(ctx, &multinodepb.SetAllocatedDiskSpaceRequest{
+			Header:    header,
+			Allocated: req.Allocated,
+		})
+	case ActionInitiateGracefulExit:
+		_, err = client.InitiateGracefulExit(ctx, &multinodepb.InitiateGracefulExitRequest{
+			Header:      header,
+			SatelliteId: req.SatelliteID,
+		})
+	case ActionCancelGracefulExit:
+		_, err = client.CancelGracefulExit(ctx, &multinodepb.CancelGracefulExitRequest{
+			Header:      header,
+			SatelliteId: req.SatelliteID,
+		})
+	case ActionForgetSatellite:
+		_, err = client.ForgetSatellite(ctx, &multinodepb.ForgetSatelliteRequest{
+			Header:       header,
+			SatelliteId:  req.SatelliteID,
+			ForceCleanup: req.ForceCleanup,
+		})
+	case ActionTriggerUsedSpaceFilewalker:
+		_, err = client.TriggerUsedSpaceFilewalker(ctx, &multinodepb.TriggerUsedSpaceFilewalkerRequest{
+			Header: header,
+		})
+	case ActionRotateAPIKey:
+		return service.rotateAPIKey(ctx, client, header, node)
+	default:
+		return Error.New("unknown action %q", action)
+	}
+
+	return wrapNodeError(err)
+}
+
+// rotateAPIKey replaces the api key of the node and stores the new one.
+func (service *Service) rotateAPIKey(ctx context.Context, client multinodepb.DRPCManagementClient, header *multinodepb.RequestHeader, node nodes.Node) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	resp, err := client.RotateAPIKey(ctx, &multinodepb.RotateAPIKeyRequest{Header: header})
+	if err != nil {
+		return wrapNodeError(err)
+	}
+
+	secret, err := multinodeauth.SecretFromBytes(resp.ApiKey)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if err = service.nodes.UpdateAPISecret(ctx, node.ID, secret); err != nil {
+		return Error.New("api key was rotated, but storing it failed, issue a new api key on the node and add the node again: %v", err)
+	}
+
+	return nil
+}
+
+// wrapNodeError converts the rpc error returned by the node.
+func wrapNodeError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case rpcstatus.Code(err) == rpcstatus.Unauthenticated:
+		return nodes.ErrNodeAPIKeyInvalid.Wrap(err)
+	case rpcstatus.Code(err) == rpcstatus.PermissionDenied:
+		return ErrPermissionDenied.Wrap(err)
+	default:
+		return Error.Wrap(err)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package management_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/multinode"
+	"storj.io/storj/multinode/management"
+	"storj.io/storj/multinode/multinodedb/multinodedbtest"
+	"storj.io/storj/multinode/nodes"
+)
+
+func TestServiceRun(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		log := zaptest.NewLogger(t)
+		service := management.NewService(log, rpc.Dialer{}, nodes.NewService(log, rpc.Dialer{}, db.Nodes()))
+
+		nodeID := testrand.NodeID()
+
+		t.Run("invalid request", func(t *testing.T) {
+			for _, tt := range []struct {
+				action management.Action
+				req    management.Request
+			}{
+				{management.ActionTriggerUsedSpaceFilewalker, management.Request{}},
+				{management.ActionSetAllocatedDiskSpace, management.Request{NodeIDs: []storj.NodeID{nodeID}}},
+				{management.ActionInitiateGracefulExit, management.Request{NodeIDs: []storj.NodeID{nodeID}}},
+				{management.ActionCancelGracefulExit, management.Request{NodeIDs: []storj.NodeID{nodeID}}},
+				{management.ActionForgetSatellite, management.Request{NodeIDs: []storj.NodeID{nodeID}}},
+				{management.Action("unknown"), management.Request{NodeIDs: []storj.NodeID{nodeID}}},
+			} {
+				_, err := service.Run(ctx, tt.action, tt.req)
+				require.True(t, management.Error.Has(err), tt.action)
+			}
+		})
+
+		t.Run("unknown node", func(t *testing.T) {
+			results, err := service.Run(ctx, management.ActionTriggerUsedSpaceFilewalker, management.Request{
+				NodeIDs: []storj.NodeID{nodeID},
+			})
+			require.NoError(t, err)
+			require.Len(t, results, 1)
+			require.Equal(t, nodeID, results[0].NodeID)
+			require.NotEmpty(t, results[0].Error)
+		})
+	})
+}
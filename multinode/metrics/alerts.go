@@ -0,0 +1,246 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/storj/multinode/nodes"
+)
+
+// AlertsConfig contains the alert thresholds and destinations.
+type AlertsConfig struct {
+	UnavailableFor     time.Duration `help:"how long a node has to be offline or unreachable before an alert is sent, zero disables the alert" default:"1h"`
+	MinFreeSpace       memory.Size   `help:"free disk space below which an alert is sent, zero disables the alert" default:"0B"`
+	MinAuditScore      float64       `help:"audit score below which an alert is sent, zero disables the alert" default:"0.98"`
+	MinSuspensionScore float64       `help:"suspension score below which an alert is sent, zero disables the alert" default:"0.9"`
+	MinOnlineScore     float64       `help:"online score below which an alert is sent, zero disables the alert" default:"0.9"`
+
+	Webhook WebhookConfig
+	SMTP    SMTPConfig
+}
+
+// AlertKind defines the condition which raised an alert.
+type AlertKind string
+
+const (
+	// AlertNodeUnavailable is raised when a node is offline or unreachable.
+	AlertNodeUnavailable AlertKind = "node unavailable"
+	// AlertLowFreeSpace is raised when the free disk space of a node is low.
+	AlertLowFreeSpace AlertKind = "low free space"
+	// AlertLowAuditScore is raised when the audit score of a node on a satellite is low.
+	AlertLowAuditScore AlertKind = "low audit score"
+	// AlertLowSuspensionScore is raised when the suspension score of a node on a satellite is low.
+	AlertLowSuspensionScore AlertKind = "low suspension score"
+	// AlertLowOnlineScore is raised when the online score of a node on a satellite is low.
+	AlertLowOnlineScore AlertKind = "low online score"
+)
+
+// Alert is a raised or resolved threshold alert.
+type Alert struct {
+	Kind        AlertKind     `json:"kind"`
+	NodeID      storj.NodeID  `json:"nodeId"`
+	NodeName    string        `json:"nodeName"`
+	SatelliteID *storj.NodeID `json:"satelliteId,omitempty"`
+	Status      nodes.Status  `json:"status,omitempty"`
+	Value       float64       `json:"value"`
+	Threshold   float64       `json:"threshold"`
+	Resolved    bool          `json:"resolved"`
+	Time        time.Time     `json:"time"`
+}
+
+// String returns a human readable description of the alert.
+func (alert Alert) String() string {
+	var b bytes.Buffer
+
+	if alert.Resolved {
+		b.WriteString("RESOLVED: ")
+	}
+
+	name := alert.NodeName
+	if name == "" {
+		name = alert.NodeID.String()
+	}
+	fmt.Fprintf(&b, "%s: node %s", alert.Kind, name)
+	if alert.SatelliteID != nil {
+		fmt.Fprintf(&b, " on satellite %s", alert.SatelliteID)
+	}
+
+	switch alert.Kind {
+	case AlertNodeUnavailable:
+		fmt.Fprintf(&b, " is %s for %s", alert.Status, time.Duration(alert.Value)*time.Second)
+	case AlertLowFreeSpace:
+		fmt.Fprintf(&b, " has %s free, threshold %s", memory.Size(alert.Value), memory.Size(alert.Threshold))
+	default:
+		fmt.Fprintf(&b, " is %.4f, threshold %.4f", alert.Value, alert.Threshold)
+	}
+
+	return b.String()
+}
+
+// alertKey identifies an alert between checks.
+type alertKey struct {
+	kind        AlertKind
+	nodeID      storj.NodeID
+	satelliteID storj.NodeID
+}
+
+// Alerter compares the collected samples against the configured thresholds
+// and notifies about the alerts when they are raised and when they are resolved.
+//
+// The raised alerts are kept in memory, so they are raised again after a restart.
+type Alerter struct {
+	log      *zap.Logger
+	config   AlertsConfig
+	notifier Notifier
+
+	unavailableSince map[storj.NodeID]time.Time
+	active           map[alertKey]Alert
+}
+
+// NewAlerter creates new instance of Alerter.
+func NewAlerter(log *zap.Logger, notifier Notifier, config AlertsConfig) *Alerter {
+	return &Alerter{
+		log:      log,
+		config:   config,
+		notifier: notifier,
+
+		unavailableSince: map[storj.NodeID]time.Time{},
+		active:           map[alertKey]Alert{},
+	}
+}
+
+// Check compares the samples against the thresholds and sends the alerts
+// which were raised or resolved since the previous check. When sending fails,
+// the alerts are sent again on the next check.
+func (alerter *Alerter) Check(ctx context.Context, now time.Time, collected []Collected) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	firing := map[alertKey]Alert{}
+	seen := map[storj.NodeID]struct{}{}
+	for _, c := range collected {
+		seen[c.Node.ID] = struct{}{}
+		alerter.evaluate(now, c, firing)
+	}
+	for nodeID := range alerter.unavailableSince {
+		if _, ok := seen[nodeID]; !ok {
+			delete(alerter.unavailableSince, nodeID)
+		}
+	}
+
+	var changed []Alert
+	for key, alert := range firing {
+		if _, ok := alerter.active[key]; !ok {
+			changed = append(changed, alert)
+		}
+	}
+	for key, alert := range alerter.active {
+		if _, ok := firing[key]; !ok {
+			alert.Resolved = true
+			alert.Time = now
+			changed = append(changed, alert)
+		}
+	}
+
+	if len(changed) == 0 {
+		return nil
+	}
+
+	sort.Slice(changed, func(i, k int) bool {
+		if changed[i].NodeID != changed[k].NodeID {
+			return changed[i].NodeID.Less(changed[k].NodeID)
+		}
+		if changed[i].Kind != changed[k].Kind {
+			return changed[i].Kind < changed[k].Kind
+		}
+		return changed[i].SatelliteID != nil && changed[k].SatelliteID != nil &&
+			changed[i].SatelliteID.Less(*changed[k].SatelliteID)
+	})
+
+	for _, alert := range changed {
+		alerter.log.Warn("alert", zap.Stringer("Node ID", alert.NodeID), zap.Stringer("alert", alert))
+	}
+
+	if err := alerter.notifier.Notify(ctx, changed); err != nil {
+		return Error.Wrap(err)
+	}
+
+	alerter.active = firing
+	return nil
+}
+
+// evaluate adds the alerts for the collected samples to firing.
+func (alerter *Alerter) evaluate(now time.Time, collected Collected, firing map[alertKey]Alert) {
+	config := alerter.config
+	sample := collected.Sample
+
+	raise := func(kind AlertKind, satelliteID *storj.NodeID, value, threshold float64) {
+		key := alertKey{kind: kind, nodeID: sample.NodeID}
+		if satelliteID != nil {
+			key.satelliteID = *satelliteID
+		}
+
+		alert, ok := alerter.active[key]
+		if !ok {
+			alert = Alert{
+				Kind:        kind,
+				NodeID:      sample.NodeID,
+				NodeName:    collected.Node.Name,
+				SatelliteID: satelliteID,
+				Time:        now,
+			}
+		}
+		alert.Status = sample.Status
+		alert.Value = value
+		alert.Threshold = threshold
+		firing[key] = alert
+	}
+
+	if sample.Status == nodes.StatusOnline {
+		delete(alerter.unavailableSince, sample.NodeID)
+	} else if _, ok := alerter.unavailableSince[sample.NodeID]; !ok {
+		alerter.unavailableSince[sample.NodeID] = now
+	}
+
+	if since, ok := alerter.unavailableSince[sample.NodeID]; ok && config.UnavailableFor > 0 {
+		if unavailable := now.Sub(since); unavailable >= config.UnavailableFor {
+			raise(AlertNodeUnavailable, nil, unavailable.Seconds(), config.UnavailableFor.Seconds())
+		}
+	}
+
+	// space and reputation are not known when the node could not be queried.
+	if sample.Status != nodes.StatusOnline && sample.Status != nodes.StatusOffline {
+		for key, alert := range alerter.active {
+			if key.nodeID == sample.NodeID && key.kind != AlertNodeUnavailable {
+				firing[key] = alert
+			}
+		}
+		return
+	}
+
+	if config.MinFreeSpace > 0 && sample.DiskSpaceFree < config.MinFreeSpace.Int64() {
+		raise(AlertLowFreeSpace, nil, float64(sample.DiskSpaceFree), float64(config.MinFreeSpace))
+	}
+
+	for _, satellite := range collected.Satellites {
+		satelliteID := satellite.SatelliteID
+		if config.MinAuditScore > 0 && satellite.AuditScore < config.MinAuditScore {
+			raise(AlertLowAuditScore, &satelliteID, satellite.AuditScore, config.MinAuditScore)
+		}
+		if config.MinSuspensionScore > 0 && satellite.SuspensionScore < config.MinSuspensionScore {
+			raise(AlertLowSuspensionScore, &satelliteID, satellite.SuspensionScore, config.MinSuspensionScore)
+		}
+		if config.MinOnlineScore > 0 && satellite.OnlineScore < config.MinOnlineScore {
+			raise(AlertLowOnlineScore, &satelliteID, satellite.OnlineScore, config.MinOnlineScore)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metrics_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/multinode/metrics"
+	"storj.io/storj/multinode/nodes"
+)
+
+type mockNotifier struct {
+	err    error
+	alerts [][]metrics.Alert
+}
+
+func (notifier *mockNotifier) Notify(ctx context.Context, alerts []metrics.Alert) error {
+	if notifier.err != nil {
+		return notifier.err
+	}
+	notifier.alerts = append(notifier.alerts, alerts)
+	return nil
+}
+
+func TestAlerter(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	notifier := &mockNotifier{}
+	alerter := metrics.NewAlerter(zaptest.NewLogger(t), notifier, metrics.AlertsConfig{
+		UnavailableFor: time.Hour,
+		MinFreeSpace:   memory.GiB,
+		MinAuditScore:  0.98,
+	})
+
+	node := nodes.Node{ID: testrand.NodeID(), Name: "alice"}
+	satelliteID := testrand.NodeID()
+	start := time.Date(2026, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+	collect := func(status nodes.Status, free int64, auditScore float64) []metrics.Collected {
+		return []metrics.Collected{{
+			Node: node,
+			Sample: metrics.NodeSample{
+				NodeID:        node.ID,
+				Status:        status,
+				DiskSpaceFree: free,
+			},
+			Satellites: []metrics.SatelliteSample{{
+				NodeID:      node.ID,
+				SatelliteID: satelliteID,
+				AuditScore:  auditScore,
+			}},
+		}}
+	}
+
+	// healthy node doesn't raise alerts.
+	require.NoError(t, alerter.Check(ctx, start, collect(nodes.StatusOnline, memory.TiB.Int64(), 1)))
+	require.Empty(t, notifier.alerts)
+
+	// low space and audit score raise alerts.
+	require.NoError(t, alerter.Check(ctx, start.Add(time.Minute), collect(nodes.StatusOnline, memory.MiB.Int64(), 0.9)))
+	require.Len(t, notifier.alerts, 1)
+	kinds := map[metrics.AlertKind]metrics.Alert{}
+	for _, alert := range notifier.alerts[0] {
+		require.False(t, alert.Resolved)
+		require.Equal(t, node.ID, alert.NodeID)
+		kinds[alert.Kind] = alert
+	}
+	require.Len(t, kinds, 2)
+	require.Equal(t, satelliteID, *kinds[metrics.AlertLowAuditScore].SatelliteID)
+	require.Equal(t, 0.9, kinds[metrics.AlertLowAuditScore].Value)
+	require.Nil(t, kinds[metrics.AlertLowFreeSpace].SatelliteID)
+
+	// the same alerts are not sent again.
+	require.NoError(t, alerter.Check(ctx, start.Add(2*time.Minute), collect(nodes.StatusOnline, memory.MiB.Int64(), 0.9)))
+	require.Len(t, notifier.alerts, 1)
+
+	// unavailable node keeps the alerts, but raises the unavailable alert only after a while.
+	require.NoError(t, alerter.Check(ctx, start.Add(3*time.Minute), collect(nodes.StatusNotReachable, 0, 0)))
+	require.Len(t, notifier.alerts, 1)
+
+	// failed notifications are sent on the next check.
+	notifier.err = errors.New("failure")
+	require.Error(t, alerter.Check(ctx, start.Add(time.Hour+3*time.Minute), collect(nodes.StatusNotReachable, 0, 0)))
+	notifier.err = nil
+	require.NoError(t, alerter.Check(ctx, start.Add(time.Hour+4*time.Minute), collect(nodes.StatusNotReachable, 0, 0)))
+	require.Len(t, notifier.alerts, 2)
+	require.Len(t, notifier.alerts[1], 1)
+	require.Equal(t, metrics.AlertNodeUnavailable, notifier.alerts[1][0].Kind)
+	require.Equal(t, nodes.StatusNotReachable, notifier.alerts[1][0].Status)
+
+	// all alerts are resolved once the node is healthy again.
+	require.NoError(t, alerter.Check(ctx, start.Add(2*time.Hour), collect(nodes.StatusOnline, memory.TiB.Int64(), 1)))
+	require.Len(t, notifier.alerts, 3)
+	require.Len(t, notifier.alerts[2], 3)
+	for _, alert := range notifier.alerts[2] {
+		require.True(t, alert.Resolved)
+		require.Equal(t, start.Add(2*time.Hour), alert.Time)
+	}
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	received := make(chan []metrics.Alert, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Alerts []metrics.Alert `json:"alerts"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- body.Alerts
+	}))
+	defer server.Close()
+
+	alert := metrics.Alert{
+		Kind:      metrics.AlertLowOnlineScore,
+		NodeID:    testrand.NodeID(),
+		Value:     0.5,
+		Threshold: 0.9,
+		Time:      time.Date(2026, time.May, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	notifier, err := metrics.NewNotifier(metrics.AlertsConfig{
+		Webhook: metrics.WebhookConfig{URL: server.URL},
+	})
+	require.NoError(t, err)
+	require.NoError(t, notifier.Notify(ctx, []metrics.Alert{alert}))
+	require.Equal(t, []metrics.Alert{alert}, <-received)
+
+	missing := httptest.NewServer(http.NotFoundHandler())
+	defer missing.Close()
+
+	require.Error(t, metrics.NewWebhookNotifier(missing.URL).Notify(ctx, []metrics.Alert{alert}))
+}
@@ -0,0 +1,237 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/private/multinodepb"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is an error class for metrics error.
+	Error = errs.Class("metrics")
+)
+
+// Config contains configurable values for the metrics collector.
+type Config struct {
+	Interval  time.Duration `help:"how often to collect metrics from the nodes" default:"15m"`
+	Retention time.Duration `help:"how long to keep the collected metrics, zero keeps them forever" default:"2160h"`
+
+	Alerts AlertsConfig
+}
+
+// Collected contains the samples collected from a node in a single run.
+type Collected struct {
+	Node       nodes.Node
+	Sample     NodeSample
+	Satellites []SatelliteSample
+}
+
+// Collector periodically polls all nodes and stores their state as time series.
+//
+// architecture: Chore
+type Collector struct {
+	log     *zap.Logger
+	dialer  rpc.Dialer
+	nodes   *nodes.Service
+	db      DB
+	alerter *Alerter
+	config  Config
+	nowFn   func() time.Time
+
+	Loop *sync2.Cycle
+}
+
+// NewCollector creates new instance of Collector.
+func NewCollector(log *zap.Logger, dialer rpc.Dialer, nodes *nodes.Service, db DB, alerter *Alerter, config Config) *Collector {
+	return &Collector{
+		log:     log,
+		dialer:  dialer,
+		nodes:   nodes,
+		db:      db,
+		alerter: alerter,
+		config:  config,
+		nowFn:   time.Now,
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the collector.
+func (collector *Collector) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return collector.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := collector.RunOnce(ctx); err != nil {
+			collector.log.Error("failed to collect metrics", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// RunOnce collects the metrics of all nodes, checks the alert thresholds
+// and deletes the samples older than the retention.
+func (collector *Collector) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	list, err := collector.nodes.List(ctx)
+	if err != nil && !nodes.ErrNoNode.Has(err) {
+		return Error.Wrap(err)
+	}
+
+	now := collector.nowFn().UTC()
+
+	collected := make([]Collected, 0, len(list))
+	for _, node := range list {
+		sample, satellites := collector.collect(ctx, node, now)
+
+		if err := collector.db.Add(ctx, sample, satellites); err != nil {
+			collector.log.Error("failed to store node metrics", zap.Stringer("Node ID", node.ID), zap.Error(err))
+			continue
+		}
+
+		collected = append(collected, Collected{
+			Node:       node,
+			Sample:     sample,
+			Satellites: satellites,
+		})
+	}
+
+	if collector.alerter != nil {
+		if err := collector.alerter.Check(ctx, now, collected); err != nil {
+			collector.log.Error("failed to send alerts", zap.Error(err))
+		}
+	}
+
+	if collector.config.Retention > 0 {
+		deleted, err := collector.db.DeleteBefore(ctx, now.Add(-collector.config.Retention))
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if deleted > 0 {
+			collector.log.Debug("deleted expired samples", zap.Int64("count", deleted))
+		}
+	}
+
+	return nil
+}
+
+// collect queries the state of a single node. Failures are recorded as the status of the sample.
+func (collector *Collector) collect(ctx context.Context, node nodes.Node, now time.Time) (NodeSample, []SatelliteSample) {
+	sample := NodeSample{
+		NodeID:    node.ID,
+		SampledAt: now,
+	}
+
+	sample.Status, _, _ = collector.nodes.FetchNodeMeta(ctx, node)
+	if sample.Status != nodes.StatusOnline && sample.Status != nodes.StatusOffline {
+		return sample, nil
+	}
+
+	satellites, err := collector.fetch(ctx, node, &sample)
+	if err != nil {
+		collector.log.Warn("failed to collect node metrics", zap.Stringer("Node ID", node.ID), zap.Error(err))
+		return NodeSample{
+			NodeID:    node.ID,
+			SampledAt: now,
+			Status:    nodes.StatusStorageNodeInternalError,
+		}, nil
+	}
+
+	return sample, satellites
+}
+
+// fetch retrieves the space, bandwidth, payout and reputation data from the node.
+func (collector *Collector) fetch(ctx context.Context, node nodes.Node, sample *NodeSample) (_ []SatelliteSample, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := collector.dialer.DialNodeURL(ctx, storj.NodeURL{
+		ID:      node.ID,
+		Address: node.PublicAddress,
+	})
+	if err != nil {
+		return nil, nodes.ErrNodeNotReachable.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, conn.Close())
+	}()
+
+	nodeClient := multinodepb.NewDRPCNodeClient(conn)
+	storageClient := multinodepb.NewDRPCStorageClient(conn)
+	bandwidthClient := multinodepb.NewDRPCBandwidthClient(conn)
+	payoutClient := multinodepb.NewDRPCPayoutClient(conn)
+
+	header := &multinodepb.RequestHeader{
+		ApiKey: node.APISecret[:],
+	}
+
+	diskSpace, err := storageClient.DiskSpace(ctx, &multinodepb.DiskSpaceRequest{Header: header})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	bandwidth, err := bandwidthClient.MonthSummary(ctx, &multinodepb.BandwidthMonthSummaryRequest{Header: header})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	estimated, err := payoutClient.EstimatedPayoutTotal(ctx, &multinodepb.EstimatedPayoutTotalRequest{Header: header})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	trusted, err := nodeClient.TrustedSatellites(ctx, &multinodepb.TrustedSatellitesRequest{Header: header})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var satellites []SatelliteSample
+	for _, satellite := range trusted.TrustedSatellites {
+		rep, err := nodeClient.Reputation(ctx, &multinodepb.ReputationRequest{
+			Header:      header,
+			SatelliteId: satellite.NodeId,
+		})
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		satellites = append(satellites, SatelliteSample{
+			NodeID:          node.ID,
+			SatelliteID:     satellite.NodeId,
+			SampledAt:       sample.SampledAt,
+			AuditScore:      rep.GetAudit().GetScore(),
+			SuspensionScore: rep.GetAudit().GetSuspensionScore(),
+			OnlineScore:     rep.GetOnline().GetScore(),
+		})
+	}
+
+	sample.DiskSpaceUsed = diskSpace.GetUsedPieces() + diskSpace.GetUsedTrash()
+	sample.DiskSpaceFree = diskSpace.GetAvailable()
+	sample.BandwidthUsed = bandwidth.GetUsed()
+	sample.EstimatedPayout = estimated.GetEstimatedEarnings()
+
+	return satellites, nil
+}
+
+// SetNow sets nowFn on collector for testing.
+func (collector *Collector) SetNow(f func() time.Time) {
+	collector.nowFn = f
+}
+
+// Close closes the collector.
+func (collector *Collector) Close() error {
+	collector.Loop.Close()
+	return nil
+}
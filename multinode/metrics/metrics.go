@@ -0,0 +1,52 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/storj/multinode/nodes"
+)
+
+// DB exposes needed by MND metrics functionality.
+//
+// architecture: Database
+type DB interface {
+	// Add stores the node sample together with the samples for the satellites of the node.
+	Add(ctx context.Context, sample NodeSample, satellites []SatelliteSample) error
+	// NodeSamples returns node samples taken in [from, to) ordered by node and time.
+	// When nodeID is zero, samples of all nodes are returned.
+	NodeSamples(ctx context.Context, nodeID storj.NodeID, from, to time.Time) ([]NodeSample, error)
+	// SatelliteSamples returns satellite samples taken in [from, to) ordered by node, satellite and time.
+	// When nodeID is zero, samples of all nodes are returned.
+	SatelliteSamples(ctx context.Context, nodeID storj.NodeID, from, to time.Time) ([]SatelliteSample, error)
+	// DeleteBefore deletes all samples taken before the provided time.
+	DeleteBefore(ctx context.Context, before time.Time) (deleted int64, err error)
+}
+
+// NodeSample is the state of a node at a point in time.
+type NodeSample struct {
+	NodeID    storj.NodeID `json:"nodeId"`
+	SampledAt time.Time    `json:"sampledAt"`
+	Status    nodes.Status `json:"status"`
+	// DiskSpaceUsed is the space used by pieces and trash.
+	DiskSpaceUsed int64 `json:"diskSpaceUsed"`
+	DiskSpaceFree int64 `json:"diskSpaceFree"`
+	// BandwidthUsed is the bandwidth used in the current month.
+	BandwidthUsed int64 `json:"bandwidthUsed"`
+	// EstimatedPayout is the payout estimation for the current month in cents.
+	EstimatedPayout int64 `json:"estimatedPayout"`
+}
+
+// SatelliteSample is the reputation of a node on a satellite at a point in time.
+type SatelliteSample struct {
+	NodeID          storj.NodeID `json:"nodeId"`
+	SatelliteID     storj.NodeID `json:"satelliteId"`
+	SampledAt       time.Time    `json:"sampledAt"`
+	AuditScore      float64      `json:"auditScore"`
+	SuspensionScore float64      `json:"suspensionScore"`
+	OnlineScore     float64      `json:"onlineScore"`
+}
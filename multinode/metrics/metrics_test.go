@@ -0,0 +1,126 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metrics_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/multinode"
+	"storj.io/storj/multinode/metrics"
+	"storj.io/storj/multinode/multinodedb/multinodedbtest"
+	"storj.io/storj/multinode/nodes"
+)
+
+func TestMetricsDB(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		metricsDB := db.Metrics()
+
+		node1, node2 := testrand.NodeID(), testrand.NodeID()
+		satelliteID := testrand.NodeID()
+		start := time.Date(2026, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+		var expected1 []metrics.NodeSample
+		var expectedSatellites []metrics.SatelliteSample
+		for i := 0; i < 3; i++ {
+			sampledAt := start.Add(time.Duration(i) * time.Hour)
+
+			sample := metrics.NodeSample{
+				NodeID:          node1,
+				SampledAt:       sampledAt,
+				Status:          nodes.StatusOnline,
+				DiskSpaceUsed:   int64(i + 1),
+				DiskSpaceFree:   100,
+				BandwidthUsed:   10,
+				EstimatedPayout: 5,
+			}
+			satellites := []metrics.SatelliteSample{{
+				NodeID:          node1,
+				SatelliteID:     satelliteID,
+				SampledAt:       sampledAt,
+				AuditScore:      1,
+				SuspensionScore: 0.99,
+				OnlineScore:     0.95,
+			}}
+			require.NoError(t, metricsDB.Add(ctx, sample, satellites))
+			expected1 = append(expected1, sample)
+			expectedSatellites = append(expectedSatellites, satellites...)
+
+			require.NoError(t, metricsDB.Add(ctx, metrics.NodeSample{
+				NodeID:    node2,
+				SampledAt: sampledAt,
+				Status:    nodes.StatusNotReachable,
+			}, nil))
+		}
+
+		samples, err := metricsDB.NodeSamples(ctx, node1, start, start.Add(3*time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, expected1, samples)
+
+		samples, err = metricsDB.NodeSamples(ctx, node1, start.Add(time.Hour), start.Add(2*time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, expected1[1:2], samples)
+
+		samples, err = metricsDB.NodeSamples(ctx, storj.NodeID{}, start, start.Add(3*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, samples, 6)
+
+		satellites, err := metricsDB.SatelliteSamples(ctx, node1, start, start.Add(3*time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, expectedSatellites, satellites)
+
+		satellites, err = metricsDB.SatelliteSamples(ctx, node2, start, start.Add(3*time.Hour))
+		require.NoError(t, err)
+		require.Empty(t, satellites)
+
+		deleted, err := metricsDB.DeleteBefore(ctx, start.Add(time.Hour))
+		require.NoError(t, err)
+		require.EqualValues(t, 3, deleted)
+
+		samples, err = metricsDB.NodeSamples(ctx, node1, start, start.Add(3*time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, expected1[1:], samples)
+	})
+}
+
+func TestWriteCSV(t *testing.T) {
+	nodeID := storj.NodeID{1}
+	satelliteID := storj.NodeID{2}
+	sampledAt := time.Date(2026, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	require.NoError(t, metrics.WriteNodeSamplesCSV(&buf, []metrics.NodeSample{{
+		NodeID:          nodeID,
+		SampledAt:       sampledAt,
+		Status:          nodes.StatusOnline,
+		DiskSpaceUsed:   1,
+		DiskSpaceFree:   2,
+		BandwidthUsed:   3,
+		EstimatedPayout: 4,
+	}}))
+	require.Equal(t,
+		"node_id,sampled_at,status,disk_space_used,disk_space_free,bandwidth_used,estimated_payout\n"+
+			nodeID.String()+",2026-05-01T12:00:00Z,online,1,2,3,4\n",
+		buf.String())
+
+	buf.Reset()
+	require.NoError(t, metrics.WriteSatelliteSamplesCSV(&buf, []metrics.SatelliteSample{{
+		NodeID:          nodeID,
+		SatelliteID:     satelliteID,
+		SampledAt:       sampledAt,
+		AuditScore:      1,
+		SuspensionScore: 0.5,
+		OnlineScore:     0.25,
+	}}))
+	require.Equal(t,
+		"node_id,satellite_id,sampled_at,audit_score,suspension_score,online_score\n"+
+			nodeID.String()+","+satelliteID.String()+",2026-05-01T12:00:00Z,1,0.5,0.25\n",
+		buf.String())
+}
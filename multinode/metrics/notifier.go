@@ -0,0 +1,189 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/private/post"
+)
+
+// ErrNotifier is an error class for alert notifier errors.
+var ErrNotifier = errs.Class("alert notifier")
+
+// Notifier sends alerts to the node operator.
+type Notifier interface {
+	// Notify sends the raised and resolved alerts.
+	Notify(ctx context.Context, alerts []Alert) error
+}
+
+// WebhookConfig contains configurable values for the webhook notifier.
+type WebhookConfig struct {
+	URL string `help:"url to which alerts are posted as json, empty disables the webhook" default:""`
+}
+
+// SMTPConfig contains configurable values for the email notifier.
+type SMTPConfig struct {
+	ServerAddress string `help:"smtp server address used for sending alert emails, empty disables the emails" default:""`
+	From          string `help:"sender email address of alert emails" default:""`
+	To            string `help:"comma separated list of recipients of alert emails" default:""`
+	Login         string `help:"smtp login, empty disables authentication" default:""`
+	Password      string `help:"smtp password" default:""`
+}
+
+// NewNotifier creates a notifier, which sends alerts to all configured destinations.
+func NewNotifier(config AlertsConfig) (Notifier, error) {
+	var notifiers Notifiers
+
+	if config.Webhook.URL != "" {
+		notifiers = append(notifiers, NewWebhookNotifier(config.Webhook.URL))
+	}
+
+	if config.SMTP.ServerAddress != "" {
+		notifier, err := NewSMTPNotifier(config.SMTP)
+		if err != nil {
+			return nil, err
+		}
+		notifiers = append(notifiers, notifier)
+	}
+
+	return notifiers, nil
+}
+
+// Notifiers sends alerts using multiple notifiers.
+type Notifiers []Notifier
+
+// Notify sends the alerts using all notifiers.
+func (notifiers Notifiers) Notify(ctx context.Context, alerts []Alert) error {
+	var group errs.Group
+	for _, notifier := range notifiers {
+		group.Add(notifier.Notify(ctx, alerts))
+	}
+	return group.Err()
+}
+
+// WebhookNotifier posts alerts as json to an url.
+type WebhookNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookNotifier creates new instance of WebhookNotifier.
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:    url,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Notify posts the alerts to the webhook.
+func (notifier *WebhookNotifier) Notify(ctx context.Context, alerts []Alert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	body, err := json.Marshal(struct {
+		Alerts []Alert `json:"alerts"`
+	}{
+		Alerts: alerts,
+	})
+	if err != nil {
+		return ErrNotifier.Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, notifier.url, bytes.NewReader(body))
+	if err != nil {
+		return ErrNotifier.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := notifier.client.Do(req)
+	if err != nil {
+		return ErrNotifier.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return ErrNotifier.New("webhook responded with %s", resp.Status)
+	}
+	return nil
+}
+
+// SMTPNotifier sends alerts by email.
+type SMTPNotifier struct {
+	sender *post.SMTPSender
+	to     []post.Address
+}
+
+// NewSMTPNotifier creates new instance of SMTPNotifier.
+func NewSMTPNotifier(config SMTPConfig) (*SMTPNotifier, error) {
+	host, _, err := net.SplitHostPort(config.ServerAddress)
+	if err != nil {
+		return nil, ErrNotifier.New("invalid smtp server address %q: %w", config.ServerAddress, err)
+	}
+
+	from, err := mail.ParseAddress(config.From)
+	if err != nil {
+		return nil, ErrNotifier.New("invalid sender address %q: %w", config.From, err)
+	}
+
+	var to []post.Address
+	for _, recipient := range strings.Split(config.To, ",") {
+		if strings.TrimSpace(recipient) == "" {
+			continue
+		}
+		address, err := mail.ParseAddress(recipient)
+		if err != nil {
+			return nil, ErrNotifier.New("invalid recipient address %q: %w", recipient, err)
+		}
+		to = append(to, *address)
+	}
+	if len(to) == 0 {
+		return nil, ErrNotifier.New("no recipients for alert emails")
+	}
+
+	sender := &post.SMTPSender{
+		ServerAddress: config.ServerAddress,
+		From:          *from,
+	}
+	if config.Login != "" {
+		sender.Auth = smtp.PlainAuth("", config.Login, config.Password, host)
+	}
+
+	return &SMTPNotifier{
+		sender: sender,
+		to:     to,
+	}, nil
+}
+
+// Notify sends the alerts in a single email.
+func (notifier *SMTPNotifier) Notify(ctx context.Context, alerts []Alert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var body strings.Builder
+	for _, alert := range alerts {
+		_, _ = fmt.Fprintf(&body, "%s %s\n", alert.Time.Format(time.RFC3339), alert)
+	}
+
+	subject := fmt.Sprintf("Multinode Dashboard: %d node alerts", len(alerts))
+	if len(alerts) == 1 {
+		subject = "Multinode Dashboard: " + alerts[0].String()
+	}
+
+	return ErrNotifier.Wrap(notifier.sender.SendEmail(ctx, &post.Message{
+		From:      notifier.sender.FromAddress(),
+		To:        notifier.to,
+		Subject:   subject,
+		PlainText: body.String(),
+	}))
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metrics
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+)
+
+// Service exposes the collected metrics.
+//
+// architecture: Service
+type Service struct {
+	log *zap.Logger
+	db  DB
+}
+
+// NewService creates new instance of Service.
+func NewService(log *zap.Logger, db DB) *Service {
+	return &Service{
+		log: log,
+		db:  db,
+	}
+}
+
+// NodeSamples returns node samples taken in [from, to).
+// When nodeID is zero, samples of all nodes are returned.
+func (service *Service) NodeSamples(ctx context.Context, nodeID storj.NodeID, from, to time.Time) (_ []NodeSample, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !from.Before(to) {
+		return nil, Error.New("invalid range from %s to %s", from, to)
+	}
+
+	samples, err := service.db.NodeSamples(ctx, nodeID, from, to)
+	return samples, Error.Wrap(err)
+}
+
+// SatelliteSamples returns satellite samples taken in [from, to).
+// When nodeID or satelliteID is zero, samples of all nodes or satellites are returned.
+func (service *Service) SatelliteSamples(ctx context.Context, nodeID, satelliteID storj.NodeID, from, to time.Time) (_ []SatelliteSample, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !from.Before(to) {
+		return nil, Error.New("invalid range from %s to %s", from, to)
+	}
+
+	samples, err := service.db.SatelliteSamples(ctx, nodeID, from, to)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if satelliteID.IsZero() {
+		return samples, nil
+	}
+
+	filtered := samples[:0]
+	for _, sample := range samples {
+		if sample.SatelliteID == satelliteID {
+			filtered = append(filtered, sample)
+		}
+	}
+	return filtered, nil
+}
+
+// WriteNodeSamplesCSV writes the node samples as csv.
+func WriteNodeSamplesCSV(w io.Writer, samples []NodeSample) error {
+	writer := csv.NewWriter(w)
+	_ = writer.Write([]string{
+		"node_id", "sampled_at", "status",
+		"disk_space_used", "disk_space_free", "bandwidth_used", "estimated_payout",
+	})
+	for _, sample := range samples {
+		_ = writer.Write([]string{
+			sample.NodeID.String(),
+			sample.SampledAt.UTC().Format(time.RFC3339),
+			string(sample.Status),
+			strconv.FormatInt(sample.DiskSpaceUsed, 10),
+			strconv.FormatInt(sample.DiskSpaceFree, 10),
+			strconv.FormatInt(sample.BandwidthUsed, 10),
+			strconv.FormatInt(sample.EstimatedPayout, 10),
+		})
+	}
+	writer.Flush()
+	return Error.Wrap(writer.Error())
+}
+
+// WriteSatelliteSamplesCSV writes the satellite samples as csv.
+func WriteSatelliteSamplesCSV(w io.Writer, samples []SatelliteSample) error {
+	writer := csv.NewWriter(w)
+	_ = writer.Write([]string{
+		"node_id", "satellite_id", "sampled_at",
+		"audit_score", "suspension_score", "online_score",
+	})
+	for _, sample := range samples {
+		_ = writer.Write([]string{
+			sample.NodeID.String(),
+			sample.SatelliteID.String(),
+			sample.SampledAt.UTC().Format(time.RFC3339),
+			strconv.FormatFloat(sample.AuditScore, 'f', -1, 64),
+			strconv.FormatFloat(sample.SuspensionScore, 'f', -1, 64),
+			strconv.FormatFloat(sample.OnlineScore, 'f', -1, 64),
+		})
+	}
+	writer.Flush()
+	return Error.Wrap(writer.Error())
+}
@@ -12,6 +12,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/storj/multinode"
+	"storj.io/storj/multinode/metrics"
 	"storj.io/storj/multinode/multinodedb/dbx"
 	"storj.io/storj/multinode/nodes"
 	"storj.io/storj/private/migrate"
@@ -94,6 +95,13 @@ func (db *DB) Nodes() nodes.DB {
 	}
 }
 
+// Metrics returns metrics database.
+func (db *DB) Metrics() metrics.DB {
+	return &metricsdb{
+		db: db.DB,
+	}
+}
+
 // MigrateToLatest migrates db to the latest version.
 func (db DB) MigrateToLatest(ctx context.Context) error {
 	var migration *migrate.Migration
@@ -278,6 +278,27 @@ func newpgx(db *DB) *pgxDB {
 func (obj *pgxDB) Schema() []string {
 	return []string{
 
+		`CREATE TABLE node_samples (
+	node_id bytea NOT NULL,
+	sampled_at timestamp with time zone NOT NULL,
+	status text NOT NULL,
+	disk_space_used bigint NOT NULL,
+	disk_space_free bigint NOT NULL,
+	bandwidth_used bigint NOT NULL,
+	estimated_payout bigint NOT NULL,
+	PRIMARY KEY ( node_id, sampled_at )
+)`,
+
+		`CREATE TABLE node_satellite_samples (
+	node_id bytea NOT NULL,
+	satellite_id bytea NOT NULL,
+	sampled_at timestamp with time zone NOT NULL,
+	audit_score double precision NOT NULL,
+	suspension_score double precision NOT NULL,
+	online_score double precision NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id, sampled_at )
+)`,
+
 		`CREATE TABLE nodes (
 	id bytea NOT NULL,
 	name text NOT NULL,
@@ -285,6 +306,10 @@ func (obj *pgxDB) Schema() []string {
 	api_secret bytea NOT NULL,
 	PRIMARY KEY ( id )
 )`,
+
+		`CREATE INDEX node_samples_sampled_at_index ON node_samples ( sampled_at )`,
+
+		`CREATE INDEX node_satellite_samples_sampled_at_index ON node_satellite_samples ( sampled_at )`,
 	}
 }
 
@@ -292,6 +317,10 @@ func (obj *pgxDB) DropSchema() []string {
 	return []string{
 
 		`DROP TABLE IF EXISTS nodes`,
+
+		`DROP TABLE IF EXISTS node_satellite_samples`,
+
+		`DROP TABLE IF EXISTS node_samples`,
 	}
 }
 
@@ -360,6 +389,27 @@ func newsqlite3(db *DB) *sqlite3DB {
 func (obj *sqlite3DB) Schema() []string {
 	return []string{
 
+		`CREATE TABLE node_samples (
+	node_id BLOB NOT NULL,
+	sampled_at TIMESTAMP NOT NULL,
+	status TEXT NOT NULL,
+	disk_space_used INTEGER NOT NULL,
+	disk_space_free INTEGER NOT NULL,
+	bandwidth_used INTEGER NOT NULL,
+	estimated_payout INTEGER NOT NULL,
+	PRIMARY KEY ( node_id, sampled_at )
+)`,
+
+		`CREATE TABLE node_satellite_samples (
+	node_id BLOB NOT NULL,
+	satellite_id BLOB NOT NULL,
+	sampled_at TIMESTAMP NOT NULL,
+	audit_score REAL NOT NULL,
+	suspension_score REAL NOT NULL,
+	online_score REAL NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id, sampled_at )
+)`,
+
 		`CREATE TABLE nodes (
 	id BLOB NOT NULL,
 	name TEXT NOT NULL,
@@ -367,6 +417,10 @@ func (obj *sqlite3DB) Schema() []string {
 	api_secret BLOB NOT NULL,
 	PRIMARY KEY ( id )
 )`,
+
+		`CREATE INDEX node_samples_sampled_at_index ON node_samples ( sampled_at )`,
+
+		`CREATE INDEX node_satellite_samples_sampled_at_index ON node_satellite_samples ( sampled_at )`,
 	}
 }
 
@@ -374,6 +428,10 @@ func (obj *sqlite3DB) DropSchema() []string {
 	return []string{
 
 		`DROP TABLE IF EXISTS nodes`,
+
+		`DROP TABLE IF EXISTS node_satellite_samples`,
+
+		`DROP TABLE IF EXISTS node_samples`,
 	}
 }
 
@@ -448,7 +506,8 @@ type Node struct {
 func (Node) _Table() string { return "nodes" }
 
 type Node_Update_Fields struct {
-	Name Node_Name_Field
+	Name      Node_Name_Field
+	ApiSecret Node_ApiSecret_Field
 }
 
 type Node_Id_Field struct {
@@ -519,6 +578,256 @@ func (f Node_ApiSecret_Field) value() any {
 	return f._value
 }
 
+type NodeSample struct {
+	NodeId          []byte
+	SampledAt       time.Time
+	Status          string
+	DiskSpaceUsed   int64
+	DiskSpaceFree   int64
+	BandwidthUsed   int64
+	EstimatedPayout int64
+}
+
+func (NodeSample) _Table() string { return "node_samples" }
+
+type NodeSample_Update_Fields struct {
+}
+
+type NodeSample_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeSample_NodeId(v []byte) NodeSample_NodeId_Field {
+	return NodeSample_NodeId_Field{_set: true, _value: v}
+}
+
+func (f NodeSample_NodeId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeSample_SampledAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeSample_SampledAt(v time.Time) NodeSample_SampledAt_Field {
+	return NodeSample_SampledAt_Field{_set: true, _value: v}
+}
+
+func (f NodeSample_SampledAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeSample_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeSample_Status(v string) NodeSample_Status_Field {
+	return NodeSample_Status_Field{_set: true, _value: v}
+}
+
+func (f NodeSample_Status_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeSample_DiskSpaceUsed_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func NodeSample_DiskSpaceUsed(v int64) NodeSample_DiskSpaceUsed_Field {
+	return NodeSample_DiskSpaceUsed_Field{_set: true, _value: v}
+}
+
+func (f NodeSample_DiskSpaceUsed_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeSample_DiskSpaceFree_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func NodeSample_DiskSpaceFree(v int64) NodeSample_DiskSpaceFree_Field {
+	return NodeSample_DiskSpaceFree_Field{_set: true, _value: v}
+}
+
+func (f NodeSample_DiskSpaceFree_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeSample_BandwidthUsed_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func NodeSample_BandwidthUsed(v int64) NodeSample_BandwidthUsed_Field {
+	return NodeSample_BandwidthUsed_Field{_set: true, _value: v}
+}
+
+func (f NodeSample_BandwidthUsed_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeSample_EstimatedPayout_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func NodeSample_EstimatedPayout(v int64) NodeSample_EstimatedPayout_Field {
+	return NodeSample_EstimatedPayout_Field{_set: true, _value: v}
+}
+
+func (f NodeSample_EstimatedPayout_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeSatelliteSample struct {
+	NodeId          []byte
+	SatelliteId     []byte
+	SampledAt       time.Time
+	AuditScore      float64
+	SuspensionScore float64
+	OnlineScore     float64
+}
+
+func (NodeSatelliteSample) _Table() string { return "node_satellite_samples" }
+
+type NodeSatelliteSample_Update_Fields struct {
+}
+
+type NodeSatelliteSample_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeSatelliteSample_NodeId(v []byte) NodeSatelliteSample_NodeId_Field {
+	return NodeSatelliteSample_NodeId_Field{_set: true, _value: v}
+}
+
+func (f NodeSatelliteSample_NodeId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeSatelliteSample_SatelliteId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeSatelliteSample_SatelliteId(v []byte) NodeSatelliteSample_SatelliteId_Field {
+	return NodeSatelliteSample_SatelliteId_Field{_set: true, _value: v}
+}
+
+func (f NodeSatelliteSample_SatelliteId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeSatelliteSample_SampledAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeSatelliteSample_SampledAt(v time.Time) NodeSatelliteSample_SampledAt_Field {
+	return NodeSatelliteSample_SampledAt_Field{_set: true, _value: v}
+}
+
+func (f NodeSatelliteSample_SampledAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeSatelliteSample_AuditScore_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func NodeSatelliteSample_AuditScore(v float64) NodeSatelliteSample_AuditScore_Field {
+	return NodeSatelliteSample_AuditScore_Field{_set: true, _value: v}
+}
+
+func (f NodeSatelliteSample_AuditScore_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeSatelliteSample_SuspensionScore_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func NodeSatelliteSample_SuspensionScore(v float64) NodeSatelliteSample_SuspensionScore_Field {
+	return NodeSatelliteSample_SuspensionScore_Field{_set: true, _value: v}
+}
+
+func (f NodeSatelliteSample_SuspensionScore_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeSatelliteSample_OnlineScore_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func NodeSatelliteSample_OnlineScore(v float64) NodeSatelliteSample_OnlineScore_Field {
+	return NodeSatelliteSample_OnlineScore_Field{_set: true, _value: v}
+}
+
+func (f NodeSatelliteSample_OnlineScore_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 func toUTC(t time.Time) time.Time {
 	return t.UTC()
 }
@@ -969,6 +1278,11 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
 	}
 
+	if update.ApiSecret._set {
+		__values = append(__values, update.ApiSecret.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("api_secret = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -1011,6 +1325,11 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
 	}
 
+	if update.ApiSecret._set {
+		__values = append(__values, update.ApiSecret.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("api_secret = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return emptyUpdate()
 	}
@@ -1242,6 +1561,11 @@ func (obj *sqlite3Impl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
 	}
 
+	if update.ApiSecret._set {
+		__values = append(__values, update.ApiSecret.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("api_secret = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -1284,6 +1608,11 @@ func (obj *sqlite3Impl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
 	}
 
+	if update.ApiSecret._set {
+		__values = append(__values, update.ApiSecret.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("api_secret = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return emptyUpdate()
 	}
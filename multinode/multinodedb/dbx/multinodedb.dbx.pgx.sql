@@ -1,9 +1,30 @@
 -- AUTOGENERATED BY storj.io/dbx
 -- DO NOT EDIT
+CREATE TABLE node_samples (
+	node_id bytea NOT NULL,
+	sampled_at timestamp with time zone NOT NULL,
+	status text NOT NULL,
+	disk_space_used bigint NOT NULL,
+	disk_space_free bigint NOT NULL,
+	bandwidth_used bigint NOT NULL,
+	estimated_payout bigint NOT NULL,
+	PRIMARY KEY ( node_id, sampled_at )
+) ;
+CREATE TABLE node_satellite_samples (
+	node_id bytea NOT NULL,
+	satellite_id bytea NOT NULL,
+	sampled_at timestamp with time zone NOT NULL,
+	audit_score double precision NOT NULL,
+	suspension_score double precision NOT NULL,
+	online_score double precision NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id, sampled_at )
+) ;
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	name text NOT NULL,
 	public_address text NOT NULL,
 	api_secret bytea NOT NULL,
 	PRIMARY KEY ( id )
-)
+) ;
+CREATE INDEX node_samples_sampled_at_index ON node_samples ( sampled_at ) ;
+CREATE INDEX node_satellite_samples_sampled_at_index ON node_satellite_samples ( sampled_at )
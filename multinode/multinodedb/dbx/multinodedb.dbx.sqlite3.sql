@@ -1,9 +1,30 @@
 -- AUTOGENERATED BY storj.io/dbx
 -- DO NOT EDIT
+CREATE TABLE node_samples (
+	node_id BLOB NOT NULL,
+	sampled_at TIMESTAMP NOT NULL,
+	status TEXT NOT NULL,
+	disk_space_used INTEGER NOT NULL,
+	disk_space_free INTEGER NOT NULL,
+	bandwidth_used INTEGER NOT NULL,
+	estimated_payout INTEGER NOT NULL,
+	PRIMARY KEY ( node_id, sampled_at )
+) ;
+CREATE TABLE node_satellite_samples (
+	node_id BLOB NOT NULL,
+	satellite_id BLOB NOT NULL,
+	sampled_at TIMESTAMP NOT NULL,
+	audit_score REAL NOT NULL,
+	suspension_score REAL NOT NULL,
+	online_score REAL NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id, sampled_at )
+) ;
 CREATE TABLE nodes (
 	id BLOB NOT NULL,
 	name TEXT NOT NULL,
 	public_address TEXT NOT NULL,
 	api_secret BLOB NOT NULL,
 	PRIMARY KEY ( id )
-)
+) ;
+CREATE INDEX node_samples_sampled_at_index ON node_samples ( sampled_at ) ;
+CREATE INDEX node_satellite_samples_sampled_at_index ON node_satellite_samples ( sampled_at )
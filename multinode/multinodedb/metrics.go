@@ -0,0 +1,161 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multinodedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/multinode/metrics"
+	"storj.io/storj/multinode/multinodedb/dbx"
+	"storj.io/storj/multinode/nodes"
+	"storj.io/storj/shared/dbutil/txutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+// ErrMetricsDB indicates about internal MetricsDB error.
+var ErrMetricsDB = errs.Class("MetricsDB")
+
+// ensures that metricsdb implements metrics.DB.
+var _ metrics.DB = (*metricsdb)(nil)
+
+// metricsdb stores the node metrics collected by the multinode collector.
+//
+// architecture: Database
+type metricsdb struct {
+	db *dbx.DB
+}
+
+// Add stores the node sample together with the samples for the satellites of the node.
+func (m *metricsdb) Add(ctx context.Context, sample metrics.NodeSample, satellites []metrics.SatelliteSample) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return ErrMetricsDB.Wrap(txutil.WithTx(ctx, m.db.DB, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		_, err := tx.ExecContext(ctx, m.db.Rebind(`
+			INSERT INTO node_samples (
+				node_id, sampled_at, status,
+				disk_space_used, disk_space_free, bandwidth_used, estimated_payout
+			) VALUES (?, ?, ?, ?, ?, ?, ?)
+		`), sample.NodeID, sample.SampledAt.UTC(), string(sample.Status),
+			sample.DiskSpaceUsed, sample.DiskSpaceFree, sample.BandwidthUsed, sample.EstimatedPayout)
+		if err != nil {
+			return err
+		}
+
+		for _, satellite := range satellites {
+			_, err := tx.ExecContext(ctx, m.db.Rebind(`
+				INSERT INTO node_satellite_samples (
+					node_id, satellite_id, sampled_at,
+					audit_score, suspension_score, online_score
+				) VALUES (?, ?, ?, ?, ?, ?)
+			`), satellite.NodeID, satellite.SatelliteID, satellite.SampledAt.UTC(),
+				satellite.AuditScore, satellite.SuspensionScore, satellite.OnlineScore)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}))
+}
+
+// NodeSamples returns node samples taken in [from, to) ordered by node and time.
+// When nodeID is zero, samples of all nodes are returned.
+func (m *metricsdb) NodeSamples(ctx context.Context, nodeID storj.NodeID, from, to time.Time) (_ []metrics.NodeSample, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `
+		SELECT node_id, sampled_at, status,
+			disk_space_used, disk_space_free, bandwidth_used, estimated_payout
+		FROM node_samples
+		WHERE sampled_at >= ? AND sampled_at < ?`
+	args := []any{from.UTC(), to.UTC()}
+	if !nodeID.IsZero() {
+		query += ` AND node_id = ?`
+		args = append(args, nodeID)
+	}
+	query += ` ORDER BY node_id, sampled_at`
+
+	rows, err := m.db.QueryContext(ctx, m.db.Rebind(query), args...)
+	if err != nil {
+		return nil, ErrMetricsDB.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrMetricsDB.Wrap(rows.Close())) }()
+
+	var samples []metrics.NodeSample
+	for rows.Next() {
+		var sample metrics.NodeSample
+		var status string
+		err := rows.Scan(&sample.NodeID, &sample.SampledAt, &status,
+			&sample.DiskSpaceUsed, &sample.DiskSpaceFree, &sample.BandwidthUsed, &sample.EstimatedPayout)
+		if err != nil {
+			return nil, ErrMetricsDB.Wrap(err)
+		}
+		sample.SampledAt = sample.SampledAt.UTC()
+		sample.Status = nodes.Status(status)
+		samples = append(samples, sample)
+	}
+
+	return samples, ErrMetricsDB.Wrap(rows.Err())
+}
+
+// SatelliteSamples returns satellite samples taken in [from, to) ordered by node, satellite and time.
+// When nodeID is zero, samples of all nodes are returned.
+func (m *metricsdb) SatelliteSamples(ctx context.Context, nodeID storj.NodeID, from, to time.Time) (_ []metrics.SatelliteSample, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `
+		SELECT node_id, satellite_id, sampled_at,
+			audit_score, suspension_score, online_score
+		FROM node_satellite_samples
+		WHERE sampled_at >= ? AND sampled_at < ?`
+	args := []any{from.UTC(), to.UTC()}
+	if !nodeID.IsZero() {
+		query += ` AND node_id = ?`
+		args = append(args, nodeID)
+	}
+	query += ` ORDER BY node_id, satellite_id, sampled_at`
+
+	rows, err := m.db.QueryContext(ctx, m.db.Rebind(query), args...)
+	if err != nil {
+		return nil, ErrMetricsDB.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrMetricsDB.Wrap(rows.Close())) }()
+
+	var samples []metrics.SatelliteSample
+	for rows.Next() {
+		var sample metrics.SatelliteSample
+		err := rows.Scan(&sample.NodeID, &sample.SatelliteID, &sample.SampledAt,
+			&sample.AuditScore, &sample.SuspensionScore, &sample.OnlineScore)
+		if err != nil {
+			return nil, ErrMetricsDB.Wrap(err)
+		}
+		sample.SampledAt = sample.SampledAt.UTC()
+		samples = append(samples, sample)
+	}
+
+	return samples, ErrMetricsDB.Wrap(rows.Err())
+}
+
+// DeleteBefore deletes all samples taken before the provided time.
+func (m *metricsdb) DeleteBefore(ctx context.Context, before time.Time) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, table := range []string{"node_samples", "node_satellite_samples"} {
+		result, err := m.db.ExecContext(ctx, m.db.Rebind(`DELETE FROM `+table+` WHERE sampled_at < ?`), before.UTC())
+		if err != nil {
+			return deleted, ErrMetricsDB.Wrap(err)
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return deleted, ErrMetricsDB.Wrap(err)
+		}
+		deleted += affected
+	}
+
+	return deleted, nil
+}
@@ -26,6 +26,34 @@ func (db *DB) SQLite3Migration() *migrate.Migration {
 					); `,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add node metrics samples",
+				Version:     1,
+				Action: migrate.SQL{
+					`CREATE TABLE node_samples (
+						node_id BLOB NOT NULL,
+						sampled_at TIMESTAMP NOT NULL,
+						status TEXT NOT NULL,
+						disk_space_used INTEGER NOT NULL,
+						disk_space_free INTEGER NOT NULL,
+						bandwidth_used INTEGER NOT NULL,
+						estimated_payout INTEGER NOT NULL,
+						PRIMARY KEY ( node_id, sampled_at )
+					);`,
+					`CREATE TABLE node_satellite_samples (
+						node_id BLOB NOT NULL,
+						satellite_id BLOB NOT NULL,
+						sampled_at TIMESTAMP NOT NULL,
+						audit_score REAL NOT NULL,
+						suspension_score REAL NOT NULL,
+						online_score REAL NOT NULL,
+						PRIMARY KEY ( node_id, satellite_id, sampled_at )
+					);`,
+					`CREATE INDEX node_samples_sampled_at_index ON node_samples ( sampled_at );`,
+					`CREATE INDEX node_satellite_samples_sampled_at_index ON node_satellite_samples ( sampled_at );`,
+				},
+			},
 		},
 	}
 }
@@ -49,6 +77,34 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					);`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add node metrics samples",
+				Version:     1,
+				Action: migrate.SQL{
+					`CREATE TABLE node_samples (
+						node_id bytea NOT NULL,
+						sampled_at timestamp with time zone NOT NULL,
+						status text NOT NULL,
+						disk_space_used bigint NOT NULL,
+						disk_space_free bigint NOT NULL,
+						bandwidth_used bigint NOT NULL,
+						estimated_payout bigint NOT NULL,
+						PRIMARY KEY ( node_id, sampled_at )
+					);`,
+					`CREATE TABLE node_satellite_samples (
+						node_id bytea NOT NULL,
+						satellite_id bytea NOT NULL,
+						sampled_at timestamp with time zone NOT NULL,
+						audit_score double precision NOT NULL,
+						suspension_score double precision NOT NULL,
+						online_score double precision NOT NULL,
+						PRIMARY KEY ( node_id, satellite_id, sampled_at )
+					);`,
+					`CREATE INDEX node_samples_sampled_at_index ON node_samples ( sampled_at );`,
+					`CREATE INDEX node_satellite_samples_sampled_at_index ON node_satellite_samples ( sampled_at );`,
+				},
+			},
 		},
 	}
 }
@@ -0,0 +1,39 @@
+-- AUTOGENERATED BY storj.io/dbx
+-- DO NOT EDIT
+CREATE TABLE node_samples (
+	node_id bytea NOT NULL,
+	sampled_at timestamp with time zone NOT NULL,
+	status text NOT NULL,
+	disk_space_used bigint NOT NULL,
+	disk_space_free bigint NOT NULL,
+	bandwidth_used bigint NOT NULL,
+	estimated_payout bigint NOT NULL,
+	PRIMARY KEY ( node_id, sampled_at )
+) ;
+CREATE TABLE node_satellite_samples (
+	node_id bytea NOT NULL,
+	satellite_id bytea NOT NULL,
+	sampled_at timestamp with time zone NOT NULL,
+	audit_score double precision NOT NULL,
+	suspension_score double precision NOT NULL,
+	online_score double precision NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id, sampled_at )
+) ;
+CREATE TABLE nodes (
+	id bytea NOT NULL,
+	name text NOT NULL,
+	public_address text NOT NULL,
+	api_secret bytea NOT NULL,
+	PRIMARY KEY ( id )
+) ;
+CREATE INDEX node_samples_sampled_at_index ON node_samples ( sampled_at ) ;
+CREATE INDEX node_satellite_samples_sampled_at_index ON node_satellite_samples ( sampled_at );
+
+-- MAIN DATA --
+
+INSERT INTO nodes (id, name, public_address, api_secret) VALUES (E'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', 'node_name', '127.0.0.1:13000', E'\\153\\313\\233\\074\\327\\177\\136\\070\\346\\001');
+
+-- NEW DATA --
+
+INSERT INTO node_samples (node_id, sampled_at, status, disk_space_used, disk_space_free, bandwidth_used, estimated_payout) VALUES (E'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', '2021-04-12 10:00:00+00:00', 'online', 1000000, 2000000, 300000, 125);
+INSERT INTO node_satellite_samples (node_id, satellite_id, sampled_at, audit_score, suspension_score, online_score) VALUES (E'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', E'\\x84a74c2cd43c5ba76535e1f42f5df7c287ed68d33522782f4afabfdb40000000', '2021-04-12 10:00:00+00:00', 1, 0.98, 0.95);
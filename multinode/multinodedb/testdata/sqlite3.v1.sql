@@ -0,0 +1,39 @@
+-- AUTOGENERATED BY storj.io/dbx
+-- DO NOT EDIT
+CREATE TABLE node_samples (
+	node_id BLOB NOT NULL,
+	sampled_at TIMESTAMP NOT NULL,
+	status TEXT NOT NULL,
+	disk_space_used INTEGER NOT NULL,
+	disk_space_free INTEGER NOT NULL,
+	bandwidth_used INTEGER NOT NULL,
+	estimated_payout INTEGER NOT NULL,
+	PRIMARY KEY ( node_id, sampled_at )
+) ;
+CREATE TABLE node_satellite_samples (
+	node_id BLOB NOT NULL,
+	satellite_id BLOB NOT NULL,
+	sampled_at TIMESTAMP NOT NULL,
+	audit_score REAL NOT NULL,
+	suspension_score REAL NOT NULL,
+	online_score REAL NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id, sampled_at )
+) ;
+CREATE TABLE nodes (
+	id BLOB NOT NULL,
+	name TEXT NOT NULL,
+	public_address TEXT NOT NULL,
+	api_secret BLOB NOT NULL,
+	PRIMARY KEY ( id )
+) ;
+CREATE INDEX node_samples_sampled_at_index ON node_samples ( sampled_at ) ;
+CREATE INDEX node_satellite_samples_sampled_at_index ON node_satellite_samples ( sampled_at );
+
+-- MAIN DATA --
+
+INSERT INTO nodes (id, name, public_address, api_secret) VALUES (X'2b3a5863a41f25408a8f5348839d7a1361dbd886d75786bb139a8ca0bdf41000', 'node_name', '127.0.0.1:13000', X'62180593328b8ff3c9f97565fdfd305d');
+
+-- NEW DATA --
+
+INSERT INTO node_samples (node_id, sampled_at, status, disk_space_used, disk_space_free, bandwidth_used, estimated_payout) VALUES (X'2b3a5863a41f25408a8f5348839d7a1361dbd886d75786bb139a8ca0bdf41000', '2021-04-12 10:00:00+00:00', 'online', 1000000, 2000000, 300000, 125);
+INSERT INTO node_satellite_samples (node_id, satellite_id, sampled_at, audit_score, suspension_score, online_score) VALUES (X'2b3a5863a41f25408a8f5348839d7a1361dbd886d75786bb139a8ca0bdf41000', X'84a74c2cd43c5ba76535e1f42f5df7c287ed68d33522782f4afabfdb40000000', '2021-04-12 10:00:00+00:00', 1, 0.98, 0.95);
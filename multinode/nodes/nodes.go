@@ -31,6 +31,8 @@ type DB interface {
 	Remove(ctx context.Context, id storj.NodeID) error
 	// UpdateName will update name of the specified node in database.
 	UpdateName(ctx context.Context, id storj.NodeID, name string) error
+	// UpdateAPISecret will update api secret of the specified node in database.
+	UpdateAPISecret(ctx context.Context, id storj.NodeID, secret multinodeauth.Secret) error
 }
 
 var (
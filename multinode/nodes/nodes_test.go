@@ -51,6 +51,15 @@ func TestNodesDB(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, node.Name, newName)
 
+		newSecret := multinodeauth.Secret{uint8(1)}
+		err = nodesRepository.UpdateAPISecret(ctx, nodeID, newSecret)
+		assert.NoError(t, err)
+
+		node, err = nodesRepository.Get(ctx, nodeID)
+		assert.NoError(t, err)
+		assert.Equal(t, node.APISecret, newSecret)
+		assert.Equal(t, node.Name, newName)
+
 		err = nodesRepository.Remove(ctx, nodeID)
 		assert.NoError(t, err)
 
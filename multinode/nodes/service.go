@@ -15,6 +15,7 @@ import (
 	"storj.io/common/rpc"
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
+	"storj.io/storj/private/multinodeauth"
 	"storj.io/storj/private/multinodepb"
 )
 
@@ -98,6 +99,12 @@ func (service *Service) UpdateName(ctx context.Context, id storj.NodeID, name st
 	return Error.Wrap(service.nodes.UpdateName(ctx, id, name))
 }
 
+// UpdateAPISecret will update api secret of the specified node.
+func (service *Service) UpdateAPISecret(ctx context.Context, id storj.NodeID, secret multinodeauth.Secret) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return Error.Wrap(service.nodes.UpdateAPISecret(ctx, id, secret))
+}
+
 // Get retrieves node by id.
 func (service *Service) Get(ctx context.Context, id storj.NodeID) (_ Node, err error) {
 	defer mon.Task()(&ctx)(&err)
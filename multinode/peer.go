@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 
 	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
@@ -20,6 +21,8 @@ import (
 	"storj.io/common/rpc"
 	"storj.io/storj/multinode/bandwidth"
 	"storj.io/storj/multinode/console/server"
+	"storj.io/storj/multinode/management"
+	"storj.io/storj/multinode/metrics"
 	"storj.io/storj/multinode/nodes"
 	"storj.io/storj/multinode/operators"
 	"storj.io/storj/multinode/payouts"
@@ -42,6 +45,8 @@ var (
 type DB interface {
 	// Nodes returns nodes database.
 	Nodes() nodes.DB
+	// Metrics returns metrics database.
+	Metrics() metrics.DB
 
 	// MigrateToLatest initializes the database.
 	MigrateToLatest(ctx context.Context) error
@@ -56,6 +61,7 @@ type Config struct {
 	Debug    debug.Config
 
 	Console server.Config
+	Metrics metrics.Config
 }
 
 // Peer is the a Multinode Dashboard application itself.
@@ -97,13 +103,26 @@ type Peer struct {
 		Service *reputation.Service
 	}
 
+	// collects node metrics history and sends alerts.
+	Metrics struct {
+		Service   *metrics.Service
+		Alerter   *metrics.Alerter
+		Collector *metrics.Collector
+	}
+
+	// performs management actions on nodes.
+	Management struct {
+		Service *management.Service
+	}
+
 	// Web server with web UI.
 	Console struct {
 		Listener net.Listener
 		Endpoint *server.Server
 	}
 
-	Servers *lifecycle.Group
+	Servers  *lifecycle.Group
+	Services *lifecycle.Group
 }
 
 // New creates a new instance of Multinode Dashboard application.
@@ -113,6 +132,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 		Identity: full,
 		DB:       db,
 		Servers:  lifecycle.NewGroup(log.Named("servers")),
+		Services: lifecycle.NewGroup(log.Named("services")),
 	}
 
 	tlsConfig := tlsopts.Config{
@@ -175,6 +195,45 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 		)
 	}
 
+	{ // metrics setup
+		notifier, err := metrics.NewNotifier(config.Metrics.Alerts)
+		if err != nil {
+			return nil, err
+		}
+
+		peer.Metrics.Service = metrics.NewService(
+			peer.Log.Named("metrics:service"),
+			peer.DB.Metrics(),
+		)
+		peer.Metrics.Alerter = metrics.NewAlerter(
+			peer.Log.Named("metrics:alerter"),
+			notifier,
+			config.Metrics.Alerts,
+		)
+		peer.Metrics.Collector = metrics.NewCollector(
+			peer.Log.Named("metrics:collector"),
+			peer.Dialer,
+			peer.Nodes.Service,
+			peer.DB.Metrics(),
+			peer.Metrics.Alerter,
+			config.Metrics,
+		)
+
+		peer.Services.Add(lifecycle.Item{
+			Name:  "metrics:collector",
+			Run:   peer.Metrics.Collector.Run,
+			Close: peer.Metrics.Collector.Close,
+		})
+	}
+
+	{ // management setup
+		peer.Management.Service = management.NewService(
+			peer.Log.Named("management:service"),
+			peer.Dialer,
+			peer.Nodes.Service,
+		)
+	}
+
 	{ // console setup
 		peer.Console.Listener, err = net.Listen("tcp", config.Console.Address)
 		if err != nil {
@@ -201,6 +260,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 				Storage:    peer.Storage.Service,
 				Bandwidth:  peer.Bandwidth.Service,
 				Reputation: peer.Reputation.Service,
+				Metrics:    peer.Metrics.Service,
+				Management: peer.Management.Service,
 			},
 		)
 		if err != nil {
@@ -224,11 +285,15 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 	group, ctx := errgroup.WithContext(ctx)
 
 	peer.Servers.Run(ctx, group)
+	peer.Services.Run(ctx, group)
 
 	return group.Wait()
 }
 
 // Close closes all the resources.
 func (peer *Peer) Close() error {
-	return peer.Servers.Close()
+	return errs.Combine(
+		peer.Servers.Close(),
+		peer.Services.Close(),
+	)
 }
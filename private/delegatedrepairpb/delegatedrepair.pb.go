@@ -0,0 +1,212 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: delegatedrepair.proto
+
+package delegatedrepairpb
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	pb "storj.io/common/pb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type RepairRequest struct {
+	// Signed GET_REPAIR orders for the retrievable pieces, indexed by piece number
+	GetOrders []*pb.AddressedOrderLimit `protobuf:"bytes,1,rep,name=get_orders,json=getOrders,proto3" json:"get_orders,omitempty"`
+	// Private piece key to use for fetching
+	PrivateKeyForGet []byte `protobuf:"bytes,2,opt,name=private_key_for_get,json=privateKeyForGet,proto3" json:"private_key_for_get,omitempty"`
+	// Signed PUT_REPAIR orders for the new pieces, indexed by piece number
+	PutOrders []*pb.AddressedOrderLimit `protobuf:"bytes,3,rep,name=put_orders,json=putOrders,proto3" json:"put_orders,omitempty"`
+	// Private piece key to use for storing
+	PrivateKeyForPut []byte `protobuf:"bytes,4,opt,name=private_key_for_put,json=privateKeyForPut,proto3" json:"private_key_for_put,omitempty"`
+	// Redundancy scheme of the existing pieces
+	Redundancy *pb.RedundancyScheme `protobuf:"bytes,5,opt,name=redundancy,proto3" json:"redundancy,omitempty"`
+	// Redundancy scheme of the new pieces
+	NewRedundancy *pb.RedundancyScheme `protobuf:"bytes,6,opt,name=new_redundancy,json=newRedundancy,proto3" json:"new_redundancy,omitempty"`
+	// Size of the segment to be repaired
+	SegmentSize int64 `protobuf:"varint,7,opt,name=segment_size,json=segmentSize,proto3" json:"segment_size,omitempty"`
+	// Number of successful uploads, after which the remaining uploads are canceled
+	SuccessfulNeeded     int32    `protobuf:"varint,8,opt,name=successful_needed,json=successfulNeeded,proto3" json:"successful_needed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepairRequest) Reset()         { *m = RepairRequest{} }
+func (m *RepairRequest) String() string { return proto.CompactTextString(m) }
+func (*RepairRequest) ProtoMessage()    {}
+func (*RepairRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db48626ef36a7ccc, []int{0}
+}
+func (m *RepairRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RepairRequest.Unmarshal(m, b)
+}
+func (m *RepairRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RepairRequest.Marshal(b, m, deterministic)
+}
+func (m *RepairRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepairRequest.Merge(m, src)
+}
+func (m *RepairRequest) XXX_Size() int {
+	return xxx_messageInfo_RepairRequest.Size(m)
+}
+func (m *RepairRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepairRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepairRequest proto.InternalMessageInfo
+
+func (m *RepairRequest) GetGetOrders() []*pb.AddressedOrderLimit {
+	if m != nil {
+		return m.GetOrders
+	}
+	return nil
+}
+
+func (m *RepairRequest) GetPrivateKeyForGet() []byte {
+	if m != nil {
+		return m.PrivateKeyForGet
+	}
+	return nil
+}
+
+func (m *RepairRequest) GetPutOrders() []*pb.AddressedOrderLimit {
+	if m != nil {
+		return m.PutOrders
+	}
+	return nil
+}
+
+func (m *RepairRequest) GetPrivateKeyForPut() []byte {
+	if m != nil {
+		return m.PrivateKeyForPut
+	}
+	return nil
+}
+
+func (m *RepairRequest) GetRedundancy() *pb.RedundancyScheme {
+	if m != nil {
+		return m.Redundancy
+	}
+	return nil
+}
+
+func (m *RepairRequest) GetNewRedundancy() *pb.RedundancyScheme {
+	if m != nil {
+		return m.NewRedundancy
+	}
+	return nil
+}
+
+func (m *RepairRequest) GetSegmentSize() int64 {
+	if m != nil {
+		return m.SegmentSize
+	}
+	return 0
+}
+
+func (m *RepairRequest) GetSuccessfulNeeded() int32 {
+	if m != nil {
+		return m.SuccessfulNeeded
+	}
+	return 0
+}
+
+type RepairResponse struct {
+	// Uploaded pieces with the piece hashes signed by the receiving nodes
+	UploadedPieces []*pb.SegmentPieceUploadResult `protobuf:"bytes,1,rep,name=uploaded_pieces,json=uploadedPieces,proto3" json:"uploaded_pieces,omitempty"`
+	// Piece numbers of the downloaded pieces which failed hash verification
+	FailedPieceNums      []int32  `protobuf:"varint,2,rep,packed,name=failed_piece_nums,json=failedPieceNums,proto3" json:"failed_piece_nums,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepairResponse) Reset()         { *m = RepairResponse{} }
+func (m *RepairResponse) String() string { return proto.CompactTextString(m) }
+func (*RepairResponse) ProtoMessage()    {}
+func (*RepairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db48626ef36a7ccc, []int{1}
+}
+func (m *RepairResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RepairResponse.Unmarshal(m, b)
+}
+func (m *RepairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RepairResponse.Marshal(b, m, deterministic)
+}
+func (m *RepairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepairResponse.Merge(m, src)
+}
+func (m *RepairResponse) XXX_Size() int {
+	return xxx_messageInfo_RepairResponse.Size(m)
+}
+func (m *RepairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepairResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepairResponse proto.InternalMessageInfo
+
+func (m *RepairResponse) GetUploadedPieces() []*pb.SegmentPieceUploadResult {
+	if m != nil {
+		return m.UploadedPieces
+	}
+	return nil
+}
+
+func (m *RepairResponse) GetFailedPieceNums() []int32 {
+	if m != nil {
+		return m.FailedPieceNums
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RepairRequest)(nil), "delegatedrepair.RepairRequest")
+	proto.RegisterType((*RepairResponse)(nil), "delegatedrepair.RepairResponse")
+}
+
+func init() { proto.RegisterFile("delegatedrepair.proto", fileDescriptor_db48626ef36a7ccc) }
+
+var fileDescriptor_db48626ef36a7ccc = []byte{
+	// 432 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
+	0x18, 0xc4, 0x0d, 0x0d, 0xb0, 0x69, 0xe3, 0x76, 0x11, 0x92, 0x15, 0x04, 0x98, 0x9c, 0xac, 0x22,
+	0x1c, 0x29, 0x1c, 0xe1, 0x42, 0x85, 0xe0, 0x10, 0x54, 0xaa, 0x8d, 0xb8, 0x70, 0xc0, 0x72, 0xbc,
+	0x13, 0xb3, 0x60, 0xef, 0x2e, 0xfb, 0x43, 0x95, 0xbe, 0x01, 0xaf, 0xc4, 0xd3, 0xa1, 0xda, 0x71,
+	0x52, 0x22, 0xfe, 0x6e, 0xeb, 0x99, 0xd9, 0x19, 0xef, 0xf7, 0x0d, 0xb9, 0xc7, 0x51, 0xa1, 0xcc,
+	0x1d, 0xb8, 0x81, 0xce, 0x85, 0x49, 0xb5, 0x51, 0x4e, 0xd1, 0x70, 0x07, 0x1e, 0x0d, 0x6b, 0xb8,
+	0x5c, 0xc8, 0xa5, 0x6a, 0x05, 0xa3, 0x50, 0x2b, 0x21, 0x1d, 0x0c, 0x5f, 0xb4, 0xc0, 0xf8, 0x47,
+	0x8f, 0x1c, 0xb2, 0x46, 0xcb, 0xf0, 0xd5, 0xc3, 0x3a, 0xfa, 0x82, 0x90, 0x12, 0x2e, 0x53, 0x86,
+	0xc3, 0xd8, 0x28, 0x88, 0x7b, 0xc9, 0x60, 0xfa, 0x20, 0xdd, 0xf8, 0xbc, 0xe4, 0xdc, 0xc0, 0x5a,
+	0xf0, 0x77, 0x57, 0x82, 0xb7, 0xa2, 0x16, 0x8e, 0xdd, 0x29, 0xe1, 0x9a, 0x4f, 0x4b, 0x9f, 0x92,
+	0xbb, 0xda, 0x88, 0x6f, 0xb9, 0x43, 0xf6, 0x05, 0xab, 0x6c, 0xa9, 0x4c, 0x56, 0xc2, 0x45, 0x7b,
+	0x71, 0x90, 0x1c, 0xb0, 0xa3, 0x35, 0x35, 0xc3, 0xea, 0xb5, 0x32, 0x6f, 0xd0, 0x84, 0x69, 0xbf,
+	0x09, 0xeb, 0xfd, 0x57, 0x98, 0xf6, 0x7f, 0x09, 0xd3, 0xde, 0x45, 0x37, 0x7f, 0x13, 0x76, 0xee,
+	0x1d, 0x7d, 0x4e, 0x88, 0x01, 0xf7, 0x92, 0xe7, 0xb2, 0x58, 0x45, 0xfb, 0x71, 0x90, 0x0c, 0xa6,
+	0xf7, 0xd3, 0xed, 0x44, 0xd8, 0x86, 0x9c, 0x17, 0x9f, 0x50, 0x83, 0x5d, 0x93, 0xd3, 0x53, 0x32,
+	0x94, 0xb8, 0xc8, 0xae, 0x19, 0xf4, 0xff, 0x6d, 0x70, 0x28, 0x71, 0xb1, 0x05, 0xe9, 0x63, 0x72,
+	0x60, 0x51, 0xd6, 0x90, 0x2e, 0xb3, 0xe2, 0x12, 0xd1, 0xad, 0x38, 0x48, 0x7a, 0x6c, 0xb0, 0xc6,
+	0xe6, 0xe2, 0x12, 0xf4, 0x09, 0x39, 0xb6, 0xbe, 0x28, 0x60, 0xed, 0xd2, 0x57, 0x99, 0x04, 0x38,
+	0x78, 0x74, 0x3b, 0x0e, 0x92, 0x7d, 0x76, 0xb4, 0x25, 0xce, 0x1a, 0x7c, 0xfc, 0x3d, 0x20, 0xc3,
+	0x6e, 0x79, 0x56, 0x2b, 0x69, 0x41, 0x67, 0x24, 0xf4, 0xba, 0x52, 0x39, 0x07, 0xcf, 0xb4, 0x40,
+	0x81, 0x6e, 0x85, 0xe3, 0xed, 0x54, 0xe7, 0x6d, 0xde, 0xf9, 0x15, 0xfd, 0xbe, 0x11, 0x33, 0x58,
+	0x5f, 0x39, 0x36, 0xec, 0xae, 0x36, 0x94, 0xa5, 0x27, 0xe4, 0x78, 0x99, 0x8b, 0xaa, 0xb3, 0xca,
+	0xa4, 0xaf, 0x6d, 0xb4, 0x17, 0xf7, 0x92, 0x7d, 0x16, 0xb6, 0x44, 0x23, 0x3c, 0xf3, 0xb5, 0x9d,
+	0x7e, 0x24, 0xe1, 0xab, 0xae, 0x7c, 0xed, 0x3f, 0xd1, 0x19, 0xe9, 0xaf, 0x4f, 0x0f, 0xd3, 0xdd,
+	0xbe, 0xfe, 0xd2, 0xb9, 0xd1, 0xa3, 0x3f, 0xf2, 0xed, 0xb3, 0xc6, 0x37, 0x4e, 0x4f, 0x3e, 0x24,
+	0xd6, 0x29, 0xf3, 0x39, 0x15, 0x6a, 0xd2, 0x1c, 0x26, 0xeb, 0xfd, 0x4e, 0x76, 0xae, 0xea, 0xc5,
+	0xa2, 0xdf, 0x74, 0xfb, 0xd9, 0xcf, 0x01, 0x00, 0x8a, 0xba, 0x01, 0x01, 0x26, 0x03, 0x00, 0x00,
+}
@@ -0,0 +1,119 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.35-0.20250513201419-f7819ea69b55
+// source: delegatedrepair.proto
+
+package delegatedrepairpb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_delegatedrepair_proto struct{}
+
+func (drpcEncoding_File_delegatedrepair_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_delegatedrepair_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_delegatedrepair_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_delegatedrepair_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCDelegatedRepairClient interface {
+	DRPCConn() drpc.Conn
+
+	Repair(ctx context.Context, in *RepairRequest) (*RepairResponse, error)
+}
+
+type drpcDelegatedRepairClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCDelegatedRepairClient(cc drpc.Conn) DRPCDelegatedRepairClient {
+	return &drpcDelegatedRepairClient{cc}
+}
+
+func (c *drpcDelegatedRepairClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcDelegatedRepairClient) Repair(ctx context.Context, in *RepairRequest) (*RepairResponse, error) {
+	out := new(RepairResponse)
+	err := c.cc.Invoke(ctx, "/delegatedrepair.DelegatedRepair/Repair", drpcEncoding_File_delegatedrepair_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCDelegatedRepairServer interface {
+	Repair(context.Context, *RepairRequest) (*RepairResponse, error)
+}
+
+type DRPCDelegatedRepairUnimplementedServer struct{}
+
+func (s *DRPCDelegatedRepairUnimplementedServer) Repair(context.Context, *RepairRequest) (*RepairResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCDelegatedRepairDescription struct{}
+
+func (DRPCDelegatedRepairDescription) NumMethods() int { return 1 }
+
+func (DRPCDelegatedRepairDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/delegatedrepair.DelegatedRepair/Repair", drpcEncoding_File_delegatedrepair_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCDelegatedRepairServer).
+					Repair(
+						ctx,
+						in1.(*RepairRequest),
+					)
+			}, DRPCDelegatedRepairServer.Repair, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterDelegatedRepair(mux drpc.Mux, impl DRPCDelegatedRepairServer) error {
+	return mux.Register(impl, DRPCDelegatedRepairDescription{})
+}
+
+type DRPCDelegatedRepair_RepairStream interface {
+	drpc.Stream
+	SendAndClose(*RepairResponse) error
+}
+
+type drpcDelegatedRepair_RepairStream struct {
+	drpc.Stream
+}
+
+func (x *drpcDelegatedRepair_RepairStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcDelegatedRepair_RepairStream) SendAndClose(m *RepairResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_delegatedrepair_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
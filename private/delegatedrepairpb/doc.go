@@ -0,0 +1,8 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package delegatedrepairpb contains protobuf definitions for delegated repair,
+// where a storage node repairs a segment on behalf of the satellite.
+package delegatedrepairpb
+
+//go:generate go run gen.go
@@ -9,6 +9,7 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/storj/shared/modular"
 	"storj.io/storj/shared/modular/config"
 	"storj.io/storj/shared/mud"
 )
@@ -17,13 +18,16 @@ import (
 func Module(ball *mud.Ball) {
 	config.RegisterConfig[Config](ball, "healthcheck")
 	mud.RegisterImplementation[[]HealthCheck](ball)
-	mud.Provide[*Server](ball, func(log *zap.Logger, cfg Config, checks []HealthCheck) (*Server, error) {
+	mud.Provide[*modular.Health](ball, modular.NewHealth)
+	mud.Provide[*Server](ball, func(log *zap.Logger, cfg Config, checks []HealthCheck, health *modular.Health) (*Server, error) {
 
 		listener, err := net.Listen("tcp", cfg.Address)
 		if err != nil {
 			return nil, errs.Wrap(err)
 		}
 
-		return NewServer(log, listener, checks...), nil
+		srv := NewServer(log, listener, checks...)
+		srv.AddLifecycle(health)
+		return srv, nil
 	})
 }
@@ -17,6 +17,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/common/errs2"
+	"storj.io/storj/shared/modular"
 )
 
 var mon = monkit.Package()
@@ -49,6 +50,7 @@ type Server struct {
 	checks map[string]HealthCheck
 
 	listener net.Listener
+	router   *mux.Router
 	server   http.Server
 }
 
@@ -62,14 +64,14 @@ func NewServer(log *zap.Logger, listener net.Listener, checks ...HealthCheck) *S
 		log:      log,
 		listener: listener,
 		checks:   checkMap,
+		router:   mux.NewRouter(),
 	}
 
-	router := mux.NewRouter()
-	router.HandleFunc("/health", srv.handleAllHTTP)
-	router.HandleFunc("/health/{name}", srv.handleSingleHTTP)
+	srv.router.HandleFunc("/health", srv.handleAllHTTP)
+	srv.router.HandleFunc("/health/{name}", srv.handleSingleHTTP)
 
 	srv.server = http.Server{
-		Handler: router,
+		Handler: srv.router,
 	}
 
 	return srv
@@ -85,6 +87,13 @@ func (s *Server) AddCheck(check HealthCheck) error {
 	return nil
 }
 
+// AddLifecycle serves the readiness and liveness of the modular components
+// on the /ready and /live endpoints.
+func (s *Server) AddLifecycle(health *modular.Health) {
+	s.router.HandleFunc("/ready", health.ServeReady)
+	s.router.HandleFunc("/live", health.ServeLive)
+}
+
 func (s *Server) handleAllHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var err error
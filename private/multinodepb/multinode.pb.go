@@ -4133,6 +4133,451 @@ func (m *SatellitePeriodPaystubResponse) GetPaystub() *Paystub {
 	return nil
 }
 
+type SetAllocatedDiskSpaceRequest struct {
+	Header *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// Allocated is the new allocated disk space, in bytes.
+	Allocated            int64    `protobuf:"varint,2,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetAllocatedDiskSpaceRequest) Reset()         { *m = SetAllocatedDiskSpaceRequest{} }
+func (m *SetAllocatedDiskSpaceRequest) String() string { return proto.CompactTextString(m) }
+func (*SetAllocatedDiskSpaceRequest) ProtoMessage()    {}
+func (*SetAllocatedDiskSpaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{89}
+}
+func (m *SetAllocatedDiskSpaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAllocatedDiskSpaceRequest.Unmarshal(m, b)
+}
+func (m *SetAllocatedDiskSpaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAllocatedDiskSpaceRequest.Marshal(b, m, deterministic)
+}
+func (m *SetAllocatedDiskSpaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAllocatedDiskSpaceRequest.Merge(m, src)
+}
+func (m *SetAllocatedDiskSpaceRequest) XXX_Size() int {
+	return xxx_messageInfo_SetAllocatedDiskSpaceRequest.Size(m)
+}
+func (m *SetAllocatedDiskSpaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAllocatedDiskSpaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAllocatedDiskSpaceRequest proto.InternalMessageInfo
+
+func (m *SetAllocatedDiskSpaceRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SetAllocatedDiskSpaceRequest) GetAllocated() int64 {
+	if m != nil {
+		return m.Allocated
+	}
+	return 0
+}
+
+type SetAllocatedDiskSpaceResponse struct {
+	Allocated            int64    `protobuf:"varint,1,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetAllocatedDiskSpaceResponse) Reset()         { *m = SetAllocatedDiskSpaceResponse{} }
+func (m *SetAllocatedDiskSpaceResponse) String() string { return proto.CompactTextString(m) }
+func (*SetAllocatedDiskSpaceResponse) ProtoMessage()    {}
+func (*SetAllocatedDiskSpaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{90}
+}
+func (m *SetAllocatedDiskSpaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAllocatedDiskSpaceResponse.Unmarshal(m, b)
+}
+func (m *SetAllocatedDiskSpaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAllocatedDiskSpaceResponse.Marshal(b, m, deterministic)
+}
+func (m *SetAllocatedDiskSpaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAllocatedDiskSpaceResponse.Merge(m, src)
+}
+func (m *SetAllocatedDiskSpaceResponse) XXX_Size() int {
+	return xxx_messageInfo_SetAllocatedDiskSpaceResponse.Size(m)
+}
+func (m *SetAllocatedDiskSpaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAllocatedDiskSpaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAllocatedDiskSpaceResponse proto.InternalMessageInfo
+
+func (m *SetAllocatedDiskSpaceResponse) GetAllocated() int64 {
+	if m != nil {
+		return m.Allocated
+	}
+	return 0
+}
+
+type InitiateGracefulExitRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	SatelliteId          NodeID         `protobuf:"bytes,2,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *InitiateGracefulExitRequest) Reset()         { *m = InitiateGracefulExitRequest{} }
+func (m *InitiateGracefulExitRequest) String() string { return proto.CompactTextString(m) }
+func (*InitiateGracefulExitRequest) ProtoMessage()    {}
+func (*InitiateGracefulExitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{91}
+}
+func (m *InitiateGracefulExitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitiateGracefulExitRequest.Unmarshal(m, b)
+}
+func (m *InitiateGracefulExitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitiateGracefulExitRequest.Marshal(b, m, deterministic)
+}
+func (m *InitiateGracefulExitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitiateGracefulExitRequest.Merge(m, src)
+}
+func (m *InitiateGracefulExitRequest) XXX_Size() int {
+	return xxx_messageInfo_InitiateGracefulExitRequest.Size(m)
+}
+func (m *InitiateGracefulExitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitiateGracefulExitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitiateGracefulExitRequest proto.InternalMessageInfo
+
+func (m *InitiateGracefulExitRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type InitiateGracefulExitResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitiateGracefulExitResponse) Reset()         { *m = InitiateGracefulExitResponse{} }
+func (m *InitiateGracefulExitResponse) String() string { return proto.CompactTextString(m) }
+func (*InitiateGracefulExitResponse) ProtoMessage()    {}
+func (*InitiateGracefulExitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{92}
+}
+func (m *InitiateGracefulExitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitiateGracefulExitResponse.Unmarshal(m, b)
+}
+func (m *InitiateGracefulExitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitiateGracefulExitResponse.Marshal(b, m, deterministic)
+}
+func (m *InitiateGracefulExitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitiateGracefulExitResponse.Merge(m, src)
+}
+func (m *InitiateGracefulExitResponse) XXX_Size() int {
+	return xxx_messageInfo_InitiateGracefulExitResponse.Size(m)
+}
+func (m *InitiateGracefulExitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitiateGracefulExitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitiateGracefulExitResponse proto.InternalMessageInfo
+
+type CancelGracefulExitRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	SatelliteId          NodeID         `protobuf:"bytes,2,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CancelGracefulExitRequest) Reset()         { *m = CancelGracefulExitRequest{} }
+func (m *CancelGracefulExitRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelGracefulExitRequest) ProtoMessage()    {}
+func (*CancelGracefulExitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{93}
+}
+func (m *CancelGracefulExitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelGracefulExitRequest.Unmarshal(m, b)
+}
+func (m *CancelGracefulExitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelGracefulExitRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelGracefulExitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelGracefulExitRequest.Merge(m, src)
+}
+func (m *CancelGracefulExitRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelGracefulExitRequest.Size(m)
+}
+func (m *CancelGracefulExitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelGracefulExitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelGracefulExitRequest proto.InternalMessageInfo
+
+func (m *CancelGracefulExitRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type CancelGracefulExitResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelGracefulExitResponse) Reset()         { *m = CancelGracefulExitResponse{} }
+func (m *CancelGracefulExitResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelGracefulExitResponse) ProtoMessage()    {}
+func (*CancelGracefulExitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{94}
+}
+func (m *CancelGracefulExitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelGracefulExitResponse.Unmarshal(m, b)
+}
+func (m *CancelGracefulExitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelGracefulExitResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelGracefulExitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelGracefulExitResponse.Merge(m, src)
+}
+func (m *CancelGracefulExitResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelGracefulExitResponse.Size(m)
+}
+func (m *CancelGracefulExitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelGracefulExitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelGracefulExitResponse proto.InternalMessageInfo
+
+type ForgetSatelliteRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	SatelliteId          NodeID         `protobuf:"bytes,2,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	ForceCleanup         bool           `protobuf:"varint,3,opt,name=force_cleanup,json=forceCleanup,proto3" json:"force_cleanup,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ForgetSatelliteRequest) Reset()         { *m = ForgetSatelliteRequest{} }
+func (m *ForgetSatelliteRequest) String() string { return proto.CompactTextString(m) }
+func (*ForgetSatelliteRequest) ProtoMessage()    {}
+func (*ForgetSatelliteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{95}
+}
+func (m *ForgetSatelliteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForgetSatelliteRequest.Unmarshal(m, b)
+}
+func (m *ForgetSatelliteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForgetSatelliteRequest.Marshal(b, m, deterministic)
+}
+func (m *ForgetSatelliteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForgetSatelliteRequest.Merge(m, src)
+}
+func (m *ForgetSatelliteRequest) XXX_Size() int {
+	return xxx_messageInfo_ForgetSatelliteRequest.Size(m)
+}
+func (m *ForgetSatelliteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForgetSatelliteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForgetSatelliteRequest proto.InternalMessageInfo
+
+func (m *ForgetSatelliteRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ForgetSatelliteRequest) GetForceCleanup() bool {
+	if m != nil {
+		return m.ForceCleanup
+	}
+	return false
+}
+
+type ForgetSatelliteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForgetSatelliteResponse) Reset()         { *m = ForgetSatelliteResponse{} }
+func (m *ForgetSatelliteResponse) String() string { return proto.CompactTextString(m) }
+func (*ForgetSatelliteResponse) ProtoMessage()    {}
+func (*ForgetSatelliteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{96}
+}
+func (m *ForgetSatelliteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForgetSatelliteResponse.Unmarshal(m, b)
+}
+func (m *ForgetSatelliteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForgetSatelliteResponse.Marshal(b, m, deterministic)
+}
+func (m *ForgetSatelliteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForgetSatelliteResponse.Merge(m, src)
+}
+func (m *ForgetSatelliteResponse) XXX_Size() int {
+	return xxx_messageInfo_ForgetSatelliteResponse.Size(m)
+}
+func (m *ForgetSatelliteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForgetSatelliteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForgetSatelliteResponse proto.InternalMessageInfo
+
+type TriggerUsedSpaceFilewalkerRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TriggerUsedSpaceFilewalkerRequest) Reset()         { *m = TriggerUsedSpaceFilewalkerRequest{} }
+func (m *TriggerUsedSpaceFilewalkerRequest) String() string { return proto.CompactTextString(m) }
+func (*TriggerUsedSpaceFilewalkerRequest) ProtoMessage()    {}
+func (*TriggerUsedSpaceFilewalkerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{97}
+}
+func (m *TriggerUsedSpaceFilewalkerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerUsedSpaceFilewalkerRequest.Unmarshal(m, b)
+}
+func (m *TriggerUsedSpaceFilewalkerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerUsedSpaceFilewalkerRequest.Marshal(b, m, deterministic)
+}
+func (m *TriggerUsedSpaceFilewalkerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerUsedSpaceFilewalkerRequest.Merge(m, src)
+}
+func (m *TriggerUsedSpaceFilewalkerRequest) XXX_Size() int {
+	return xxx_messageInfo_TriggerUsedSpaceFilewalkerRequest.Size(m)
+}
+func (m *TriggerUsedSpaceFilewalkerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerUsedSpaceFilewalkerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerUsedSpaceFilewalkerRequest proto.InternalMessageInfo
+
+func (m *TriggerUsedSpaceFilewalkerRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type TriggerUsedSpaceFilewalkerResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerUsedSpaceFilewalkerResponse) Reset()         { *m = TriggerUsedSpaceFilewalkerResponse{} }
+func (m *TriggerUsedSpaceFilewalkerResponse) String() string { return proto.CompactTextString(m) }
+func (*TriggerUsedSpaceFilewalkerResponse) ProtoMessage()    {}
+func (*TriggerUsedSpaceFilewalkerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{98}
+}
+func (m *TriggerUsedSpaceFilewalkerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TriggerUsedSpaceFilewalkerResponse.Unmarshal(m, b)
+}
+func (m *TriggerUsedSpaceFilewalkerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TriggerUsedSpaceFilewalkerResponse.Marshal(b, m, deterministic)
+}
+func (m *TriggerUsedSpaceFilewalkerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerUsedSpaceFilewalkerResponse.Merge(m, src)
+}
+func (m *TriggerUsedSpaceFilewalkerResponse) XXX_Size() int {
+	return xxx_messageInfo_TriggerUsedSpaceFilewalkerResponse.Size(m)
+}
+func (m *TriggerUsedSpaceFilewalkerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerUsedSpaceFilewalkerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerUsedSpaceFilewalkerResponse proto.InternalMessageInfo
+
+type RotateAPIKeyRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RotateAPIKeyRequest) Reset()         { *m = RotateAPIKeyRequest{} }
+func (m *RotateAPIKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RotateAPIKeyRequest) ProtoMessage()    {}
+func (*RotateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{99}
+}
+func (m *RotateAPIKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RotateAPIKeyRequest.Unmarshal(m, b)
+}
+func (m *RotateAPIKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RotateAPIKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *RotateAPIKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateAPIKeyRequest.Merge(m, src)
+}
+func (m *RotateAPIKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RotateAPIKeyRequest.Size(m)
+}
+func (m *RotateAPIKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateAPIKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateAPIKeyRequest proto.InternalMessageInfo
+
+func (m *RotateAPIKeyRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type RotateAPIKeyResponse struct {
+	// ApiKey is the new api key, the key used for the request is revoked.
+	ApiKey               []byte   `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RotateAPIKeyResponse) Reset()         { *m = RotateAPIKeyResponse{} }
+func (m *RotateAPIKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*RotateAPIKeyResponse) ProtoMessage()    {}
+func (*RotateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{100}
+}
+func (m *RotateAPIKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RotateAPIKeyResponse.Unmarshal(m, b)
+}
+func (m *RotateAPIKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RotateAPIKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *RotateAPIKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateAPIKeyResponse.Merge(m, src)
+}
+func (m *RotateAPIKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_RotateAPIKeyResponse.Size(m)
+}
+func (m *RotateAPIKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateAPIKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateAPIKeyResponse proto.InternalMessageInfo
+
+func (m *RotateAPIKeyResponse) GetApiKey() []byte {
+	if m != nil {
+		return m.ApiKey
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RequestHeader)(nil), "multinode.RequestHeader")
 	proto.RegisterType((*DiskSpaceRequest)(nil), "multinode.DiskSpaceRequest")
@@ -4233,187 +4678,204 @@ func init() {
 func init() { proto.RegisterFile("multinode.proto", fileDescriptor_9a45fd79b06f3a1b) }
 
 var fileDescriptor_9a45fd79b06f3a1b = []byte{
-	// 2911 bytes of a gzipped FileDescriptorProto
+	// 3179 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4b, 0x6f, 0x1c, 0xc7,
-	0xf1, 0xff, 0x0f, 0x29, 0xee, 0x72, 0x6b, 0x77, 0xb9, 0x64, 0x8b, 0x8f, 0xe5, 0x88, 0x2f, 0x0d,
-	0xf5, 0x97, 0xa8, 0x58, 0xa2, 0x6c, 0xda, 0x70, 0x62, 0xc7, 0x46, 0xbc, 0x94, 0x64, 0x93, 0xb6,
-	0x64, 0x31, 0x43, 0xc9, 0x31, 0xac, 0xc0, 0xeb, 0xe1, 0x4e, 0x93, 0x1c, 0x7b, 0x76, 0x67, 0x3d,
-	0xd3, 0x4b, 0x85, 0x40, 0x60, 0xe4, 0x90, 0x38, 0xa7, 0x00, 0x39, 0x1b, 0x41, 0xae, 0xb9, 0xe5,
-	0x90, 0x4b, 0xbe, 0x41, 0x60, 0x20, 0xdf, 0x20, 0x07, 0x07, 0xc8, 0x2d, 0x97, 0x5c, 0x72, 0xcb,
-	0x21, 0x08, 0xfa, 0x31, 0x8f, 0x9e, 0xd7, 0x92, 0xb3, 0x32, 0xe8, 0xdb, 0x74, 0x3d, 0x7e, 0x5d,
-	0x5d, 0xdd, 0x5d, 0xd3, 0x55, 0xdd, 0xd0, 0xe8, 0x0e, 0x6c, 0x62, 0xf5, 0x1c, 0x13, 0x6f, 0xf6,
-	0x5d, 0x87, 0x38, 0xa8, 0x12, 0x10, 0x54, 0x38, 0x72, 0x8e, 0x1c, 0x4e, 0x56, 0x57, 0x8f, 0x1c,
-	0xe7, 0xc8, 0xc6, 0x77, 0x58, 0xeb, 0x60, 0x70, 0x78, 0x87, 0x58, 0x5d, 0xec, 0x11, 0xa3, 0xdb,
-	0xe7, 0x02, 0xda, 0x06, 0xd4, 0x75, 0xfc, 0xf9, 0x00, 0x7b, 0x64, 0x07, 0x1b, 0x26, 0x76, 0xd1,
-	0x02, 0x94, 0x8d, 0xbe, 0xd5, 0xfe, 0x0c, 0x9f, 0x36, 0x95, 0x35, 0x65, 0xa3, 0xa6, 0x97, 0x8c,
-	0xbe, 0xf5, 0x1e, 0x3e, 0xd5, 0xee, 0xc1, 0xf4, 0x3d, 0xcb, 0xfb, 0x6c, 0xbf, 0x6f, 0x74, 0xb0,
-	0x50, 0x41, 0x2f, 0x42, 0xe9, 0x98, 0xa9, 0x31, 0xd9, 0xea, 0x56, 0x73, 0x33, 0xb4, 0x4b, 0x82,
-	0xd5, 0x85, 0x9c, 0xf6, 0x5f, 0x05, 0x66, 0x22, 0x30, 0x5e, 0xdf, 0xe9, 0x79, 0x18, 0x2d, 0x41,
-	0xc5, 0xb0, 0x6d, 0xa7, 0x63, 0x10, 0x6c, 0x32, 0xa8, 0x71, 0x3d, 0x24, 0xa0, 0x55, 0xa8, 0x0e,
-	0x3c, 0x6c, 0xb6, 0xfb, 0x16, 0xee, 0x60, 0xaf, 0x39, 0xc6, 0xf8, 0x40, 0x49, 0x7b, 0x8c, 0x82,
-	0x96, 0x81, 0xb5, 0xda, 0xc4, 0x35, 0xbc, 0xe3, 0xe6, 0x38, 0xd7, 0xa7, 0x94, 0xc7, 0x94, 0x80,
-	0x10, 0x5c, 0x3a, 0x74, 0x31, 0x6e, 0x5e, 0x62, 0x0c, 0xf6, 0xcd, 0x7a, 0x3c, 0x31, 0x2c, 0xdb,
-	0x38, 0xb0, 0x71, 0x73, 0x42, 0xf4, 0xe8, 0x13, 0x90, 0x0a, 0x93, 0xce, 0x09, 0x76, 0x29, 0x44,
-	0xb3, 0xc4, 0x98, 0x41, 0x9b, 0xa2, 0x31, 0x7a, 0x99, 0xa3, 0x31, 0xda, 0x4d, 0x98, 0x66, 0x06,
-	0xb8, 0xb8, 0x63, 0x1b, 0x56, 0x97, 0x81, 0x4e, 0x32, 0x7e, 0x83, 0xd2, 0xf5, 0x90, 0xac, 0xfd,
-	0x51, 0x81, 0xda, 0x3e, 0x71, 0x5c, 0xe3, 0x08, 0x3f, 0xf1, 0x8c, 0x23, 0x8c, 0x34, 0xa8, 0x1b,
-	0xa4, 0xed, 0x62, 0x8f, 0xb4, 0x89, 0x43, 0x0c, 0x9b, 0x8d, 0x5f, 0xd1, 0xab, 0x06, 0xd1, 0xb1,
-	0x47, 0x1e, 0x53, 0x12, 0x7a, 0x0f, 0xa6, 0xac, 0x1e, 0xc1, 0xee, 0x89, 0x61, 0xb7, 0x3d, 0x62,
-	0xb8, 0x84, 0x39, 0xa1, 0xba, 0xa5, 0x6e, 0xf2, 0xf9, 0xdd, 0xf4, 0xe7, 0x77, 0xf3, 0xb1, 0x3f,
-	0xbf, 0xdb, 0x93, 0x5f, 0x7f, 0xb3, 0xfa, 0x7f, 0xbf, 0xfd, 0xfb, 0xaa, 0xa2, 0xd7, 0x7d, 0xdd,
-	0x7d, 0xaa, 0x8a, 0x6e, 0xc3, 0x65, 0xa9, 0xc3, 0xf6, 0xc1, 0x29, 0xc1, 0x1e, 0x73, 0x9b, 0xa2,
-	0x4f, 0x47, 0xba, 0xdd, 0xa6, 0x74, 0xed, 0xcf, 0x0a, 0x5c, 0x8e, 0x1a, 0x5c, 0x78, 0xee, 0xd1,
-	0x0f, 0xe8, 0x3c, 0x38, 0xdd, 0x73, 0xd9, 0xce, 0x34, 0xd0, 0x2b, 0x30, 0x46, 0x1c, 0x66, 0xe1,
-	0x59, 0xf5, 0xc6, 0x88, 0xa3, 0xfd, 0x5e, 0x81, 0x59, 0xd9, 0x72, 0xb1, 0xdc, 0xde, 0x80, 0xba,
-	0xc7, 0xe9, 0xed, 0x01, 0x65, 0x34, 0x95, 0xb5, 0xf1, 0x8d, 0xea, 0xd6, 0x42, 0x64, 0x04, 0x92,
-	0x5e, 0xcd, 0x8b, 0x4e, 0x58, 0x13, 0xca, 0xde, 0xa0, 0xdb, 0x35, 0xdc, 0x53, 0x36, 0x12, 0x45,
-	0xf7, 0x9b, 0x68, 0x13, 0x2e, 0x1b, 0x27, 0x38, 0xc4, 0x95, 0x3c, 0x3b, 0x23, 0x58, 0x0c, 0x84,
-	0xbb, 0xf6, 0xdf, 0x0a, 0x2c, 0x45, 0x3b, 0xda, 0x37, 0x08, 0xb6, 0x6d, 0x8b, 0x8c, 0xe0, 0xe3,
-	0x97, 0xa0, 0xe6, 0xf9, 0x28, 0x6d, 0xcb, 0x64, 0x16, 0xd6, 0xb6, 0xa7, 0xa8, 0x5f, 0xfe, 0xf6,
-	0xcd, 0x6a, 0xe9, 0x7d, 0xc7, 0xc4, 0xbb, 0xf7, 0xf4, 0x6a, 0x20, 0xb3, 0x6b, 0x06, 0xd3, 0x32,
-	0x5e, 0x70, 0x5a, 0x2e, 0x9d, 0x73, 0x5a, 0xfe, 0xa0, 0xc0, 0x72, 0xc6, 0xa8, 0xbf, 0x63, 0xf3,
-	0xb3, 0x07, 0x4b, 0xdb, 0x46, 0xcf, 0x7c, 0x66, 0x99, 0xe4, 0xf8, 0xa1, 0xd3, 0x23, 0xc7, 0xfb,
-	0x1c, 0xa8, 0x78, 0xf8, 0x7b, 0x19, 0x96, 0x33, 0x10, 0xc5, 0xd0, 0xfd, 0xe8, 0xa2, 0x84, 0xd1,
-	0x45, 0xfb, 0xb5, 0x02, 0x6b, 0x81, 0x96, 0x50, 0xb8, 0x90, 0xa5, 0xa2, 0xbd, 0x09, 0x57, 0x73,
-	0x0c, 0x11, 0x43, 0x88, 0xf8, 0x9f, 0x8f, 0xc2, 0x6f, 0x6a, 0xef, 0xc1, 0x42, 0x5c, 0xbd, 0xb8,
-	0x2b, 0x5f, 0x81, 0x66, 0x12, 0x6c, 0xa8, 0x09, 0xbf, 0x54, 0x60, 0xf9, 0xfe, 0x91, 0x8b, 0x3d,
-	0xef, 0x42, 0x1d, 0xf9, 0x3a, 0xac, 0x64, 0x59, 0x31, 0x74, 0x08, 0x3b, 0x30, 0x2b, 0xe9, 0x16,
-	0x77, 0xe1, 0x4b, 0x30, 0x17, 0x43, 0x1a, 0xda, 0xf9, 0xaf, 0x14, 0x58, 0xd9, 0xed, 0x5d, 0xbc,
-	0x03, 0x7f, 0x08, 0xab, 0x99, 0x66, 0x0c, 0x1d, 0xc4, 0x2e, 0xcc, 0xc9, 0xca, 0xc5, 0x5d, 0xb8,
-	0x05, 0xf3, 0x71, 0xa8, 0xa1, 0xdd, 0xff, 0x1c, 0xe6, 0xee, 0x19, 0x96, 0x7d, 0x41, 0x9e, 0xdb,
-	0x87, 0xf9, 0x78, 0xef, 0xc2, 0xe2, 0xd7, 0xa0, 0xc6, 0xc3, 0xa2, 0xeb, 0xd8, 0xf6, 0xa0, 0x2f,
-	0xa2, 0xee, 0x7c, 0xc4, 0x08, 0x1e, 0x6e, 0x19, 0x57, 0xaf, 0x0e, 0xc2, 0x86, 0xf6, 0x16, 0xd4,
-	0x18, 0x68, 0x71, 0x47, 0xbe, 0x0b, 0x75, 0x81, 0x30, 0xba, 0x35, 0x7f, 0x55, 0xa0, 0x1a, 0x61,
-	0xa2, 0x9b, 0x50, 0xc2, 0x6c, 0x8e, 0x84, 0x35, 0x33, 0x11, 0x10, 0xbe, 0x01, 0x74, 0x21, 0x80,
-	0x6e, 0x41, 0xd9, 0xe2, 0xf3, 0x29, 0x8e, 0x29, 0x28, 0x22, 0x2b, 0x66, 0x5a, 0xf7, 0x45, 0xd0,
-	0x3c, 0x94, 0x4c, 0x6c, 0x63, 0x82, 0xc5, 0xa1, 0x53, 0xb4, 0x52, 0xce, 0x6b, 0x97, 0x0a, 0x9f,
-	0xd7, 0xb4, 0x07, 0x50, 0xba, 0x1f, 0x74, 0xe7, 0xe2, 0xbe, 0x61, 0xb9, 0x62, 0x45, 0x89, 0x16,
-	0x9a, 0x85, 0x09, 0x63, 0x60, 0x5a, 0x44, 0x1c, 0x8d, 0x79, 0x83, 0x52, 0xf9, 0xdf, 0x93, 0xdb,
-	0xc6, 0x1b, 0xda, 0xf7, 0xa1, 0xbc, 0xdb, 0x93, 0xe1, 0x4c, 0x09, 0xce, 0x0c, 0x15, 0xc7, 0xa2,
-	0x8a, 0xdb, 0x30, 0xf5, 0x01, 0x76, 0x3d, 0xcb, 0xe9, 0x15, 0x9f, 0xe4, 0x17, 0xa0, 0x11, 0x60,
-	0x84, 0xdb, 0xe4, 0x84, 0x93, 0x18, 0x4a, 0x45, 0xf7, 0x9b, 0xda, 0xdb, 0x80, 0x1e, 0x18, 0x1e,
-	0xb9, 0xeb, 0xf4, 0x88, 0xd1, 0x21, 0xc5, 0x3b, 0xfd, 0x18, 0x2e, 0x4b, 0x38, 0xa2, 0xe3, 0x77,
-	0xa0, 0x66, 0x1b, 0x1e, 0x69, 0x77, 0x38, 0x5d, 0xc0, 0x9d, 0x6d, 0x86, 0xaa, 0x76, 0x08, 0xa8,
-	0xfd, 0x0c, 0x66, 0x74, 0xdc, 0x1f, 0x10, 0x83, 0x8c, 0xe2, 0x9b, 0x22, 0x5b, 0xf9, 0x2b, 0x05,
-	0xaa, 0x2d, 0x3a, 0xd7, 0x3f, 0xb1, 0x7a, 0xa6, 0xf3, 0x8c, 0x0e, 0xe9, 0x19, 0xfb, 0x12, 0x8b,
-	0xee, 0x5c, 0x43, 0xe2, 0x9a, 0x3c, 0x45, 0xb8, 0x0a, 0x35, 0xa7, 0x67, 0x5b, 0x3d, 0xdc, 0xee,
-	0x38, 0x83, 0x1e, 0x5f, 0x57, 0x13, 0x7a, 0x95, 0xd3, 0xee, 0x52, 0x12, 0x4d, 0xca, 0x78, 0xf6,
-	0xc0, 0x25, 0xc6, 0x99, 0x04, 0x30, 0x12, 0x13, 0xd0, 0xfe, 0x53, 0x06, 0x14, 0xf5, 0x4b, 0x70,
-	0xb6, 0x2b, 0x71, 0x18, 0x61, 0xdd, 0x35, 0xc9, 0x31, 0x71, 0xf1, 0xcd, 0x47, 0x4c, 0x56, 0x17,
-	0x3a, 0xe8, 0xb5, 0xe8, 0x4a, 0xaf, 0x6e, 0xad, 0xe7, 0x2b, 0x33, 0xdf, 0xf8, 0xdb, 0xe1, 0x21,
-	0x34, 0x4c, 0xcb, 0xfb, 0x7c, 0x60, 0xd8, 0xd6, 0xa1, 0x85, 0xcd, 0xb6, 0x41, 0xce, 0x78, 0xe2,
-	0x55, 0x98, 0x7f, 0xa6, 0xa2, 0xca, 0x2d, 0x42, 0x7d, 0xed, 0x0d, 0xbc, 0x3e, 0xee, 0x99, 0x1c,
-	0xeb, 0xd2, 0x39, 0xb0, 0xaa, 0x81, 0x66, 0x8b, 0xa0, 0x0f, 0x60, 0xd6, 0x39, 0x3c, 0x64, 0xce,
-	0x96, 0x00, 0x27, 0xce, 0x01, 0x88, 0x04, 0xc2, 0x7e, 0x04, 0xf7, 0x29, 0x2c, 0xf8, 0xb8, 0x83,
-	0x9e, 0x89, 0xdd, 0xb6, 0x8b, 0x4f, 0x2c, 0xfc, 0x8c, 0x42, 0x97, 0xce, 0x01, 0xed, 0x1b, 0xf7,
-	0x84, 0x62, 0xe8, 0x0c, 0xa2, 0x45, 0x50, 0x0b, 0x2a, 0x27, 0x98, 0x10, 0x6e, 0x69, 0xe5, 0x1c,
-	0x70, 0x93, 0x5c, 0xad, 0x45, 0xd0, 0x5d, 0x80, 0x41, 0xdf, 0x34, 0x04, 0x46, 0xf9, 0x1c, 0x4b,
-	0xb5, 0x22, 0xf4, 0xb8, 0x1d, 0x9f, 0x3a, 0x56, 0x8f, 0x63, 0x4c, 0x9e, 0x03, 0x63, 0x92, 0xab,
-	0xb5, 0x88, 0xba, 0x02, 0x25, 0xbe, 0xc8, 0x68, 0xdc, 0xf3, 0x3a, 0x8e, 0x8b, 0x45, 0x06, 0xce,
-	0x1b, 0xea, 0x9f, 0xc6, 0x60, 0xa2, 0xe5, 0x07, 0xd4, 0x24, 0x9f, 0xe6, 0xfe, 0x7c, 0xde, 0x68,
-	0xd0, 0x6a, 0x73, 0x01, 0x9e, 0x77, 0x34, 0x42, 0xfa, 0x3e, 0x13, 0x4d, 0xd9, 0x33, 0xe3, 0xd1,
-	0x3d, 0x83, 0xd6, 0xa1, 0xee, 0x0d, 0x3a, 0x1d, 0xec, 0x79, 0x42, 0x84, 0x97, 0x2c, 0x6a, 0x82,
-	0xc8, 0x85, 0x68, 0xb4, 0xb7, 0xfb, 0xc7, 0x06, 0x5b, 0x21, 0x8a, 0xce, 0x1b, 0x34, 0x71, 0x38,
-	0xc0, 0xc4, 0x60, 0x73, 0xab, 0xe8, 0xec, 0x9b, 0xc2, 0x0d, 0x7a, 0x9f, 0xf5, 0x9c, 0x67, 0xbd,
-	0x36, 0xd7, 0x28, 0x33, 0x66, 0x4d, 0x10, 0x5b, 0x4c, 0xf1, 0x2a, 0xf8, 0xed, 0x36, 0x03, 0x98,
-	0xe4, 0xe5, 0x07, 0x41, 0xdb, 0xa6, 0x38, 0x2f, 0x42, 0xf9, 0xd8, 0xa2, 0x39, 0xd6, 0x69, 0xb3,
-	0x92, 0xf8, 0x0b, 0x47, 0x02, 0x90, 0xee, 0x8b, 0x69, 0x0f, 0xa0, 0xf9, 0xd8, 0x1d, 0x78, 0x04,
-	0x9b, 0xc1, 0x31, 0xc3, 0x2b, 0x1e, 0xc1, 0xff, 0xa2, 0xc0, 0x62, 0x0a, 0x9c, 0x88, 0x28, 0x4f,
-	0x01, 0x11, 0xce, 0x6c, 0x07, 0xc1, 0xd1, 0x13, 0xc7, 0x85, 0x5b, 0x11, 0xec, 0x4c, 0x84, 0x4d,
-	0x1a, 0x5b, 0x9f, 0xe8, 0x0f, 0xf4, 0x19, 0x12, 0x17, 0x51, 0x1f, 0x40, 0x59, 0x70, 0xd1, 0x0d,
-	0x28, 0x53, 0x9c, 0xb6, 0xf8, 0x5f, 0x26, 0x63, 0x73, 0x89, 0xb2, 0x77, 0x4d, 0xfa, 0x4b, 0x33,
-	0x4c, 0x33, 0x38, 0x43, 0x54, 0x74, 0xbf, 0xa9, 0xdd, 0x85, 0xc6, 0xa3, 0x3e, 0x76, 0x0d, 0xe2,
-	0xb8, 0xc5, 0xbd, 0x61, 0xc1, 0x74, 0x08, 0x22, 0x7c, 0x30, 0x0b, 0x13, 0xb8, 0x6b, 0x58, 0xb6,
-	0xf8, 0x87, 0xf2, 0x06, 0xfd, 0xc1, 0x3f, 0x33, 0x6c, 0x1b, 0x13, 0x61, 0x87, 0x68, 0xa1, 0x1b,
-	0xd0, 0xe0, 0x5f, 0xed, 0x43, 0x6c, 0x90, 0x81, 0xcb, 0x72, 0xe0, 0xf1, 0x8d, 0x8a, 0x3e, 0xc5,
-	0xc9, 0x6f, 0x0b, 0xaa, 0xf6, 0xa5, 0x02, 0xab, 0xf7, 0x3d, 0x62, 0x75, 0xe9, 0x76, 0xdb, 0x33,
-	0x4e, 0x9d, 0x01, 0xb9, 0x98, 0x43, 0xeb, 0x8f, 0x61, 0x2d, 0xdb, 0x0e, 0xe1, 0x83, 0xdb, 0x80,
-	0xb0, 0x2f, 0xd3, 0xc6, 0x86, 0xdb, 0xb3, 0x7a, 0x47, 0x9e, 0x38, 0xda, 0xcc, 0x04, 0x9c, 0xfb,
-	0x82, 0xa1, 0xbd, 0x0b, 0xf3, 0x31, 0xc8, 0xe2, 0x53, 0xb2, 0x03, 0x0b, 0x09, 0xac, 0x62, 0x56,
-	0x6d, 0xc3, 0xd4, 0xc8, 0x39, 0xc9, 0x2e, 0x34, 0xe2, 0xc9, 0xc8, 0xab, 0x50, 0xed, 0x33, 0xbb,
-	0xda, 0x56, 0xef, 0xd0, 0x11, 0x48, 0x73, 0x11, 0x24, 0x6e, 0xf5, 0x6e, 0xef, 0xd0, 0xd1, 0xa1,
-	0x1f, 0x7c, 0x6b, 0x9f, 0xc0, 0xac, 0x80, 0xda, 0xc3, 0xae, 0xe5, 0x98, 0xc5, 0x27, 0x7d, 0x1e,
-	0x4a, 0x7d, 0x06, 0xe1, 0xaf, 0x45, 0xde, 0xd2, 0x1e, 0xc1, 0x5c, 0xac, 0x87, 0x11, 0x4d, 0xfe,
-	0x02, 0x16, 0x2e, 0x34, 0x33, 0xd5, 0xa1, 0x99, 0x99, 0x92, 0x16, 0x1d, 0xd3, 0xef, 0x14, 0x58,
-	0x8e, 0x83, 0x8e, 0x3a, 0x21, 0x05, 0x2a, 0x85, 0xe1, 0x1c, 0x8e, 0x4b, 0x73, 0xf8, 0x21, 0xac,
-	0x64, 0x59, 0x37, 0xe2, 0xc0, 0x5b, 0x50, 0xa7, 0x5b, 0x03, 0x17, 0x1f, 0xa7, 0x76, 0x1d, 0xa6,
-	0x7c, 0x88, 0x30, 0x58, 0x86, 0x95, 0xf6, 0x71, 0x9d, 0x37, 0x58, 0x3c, 0x60, 0x72, 0xa3, 0x2f,
-	0x1b, 0xed, 0x13, 0x58, 0x48, 0x60, 0x89, 0xce, 0xef, 0xc3, 0x34, 0x66, 0xac, 0xf0, 0x67, 0x25,
-	0xfe, 0x55, 0x6a, 0x34, 0x2b, 0x8d, 0x69, 0x37, 0xb0, 0x4c, 0xd0, 0x3e, 0x82, 0x46, 0x4c, 0x26,
-	0x7d, 0x58, 0x45, 0x56, 0xf0, 0x0e, 0xcc, 0x3e, 0xe9, 0x99, 0x96, 0x47, 0x5c, 0xeb, 0x60, 0x40,
-	0x46, 0xf1, 0xfd, 0x6d, 0x98, 0x8b, 0x21, 0xe5, 0x4e, 0xc1, 0x17, 0xb0, 0xb0, 0x67, 0x9c, 0x7a,
-	0x64, 0x70, 0x70, 0x31, 0x5b, 0x77, 0x07, 0x9a, 0xc9, 0xfe, 0x85, 0xc5, 0xb7, 0xa0, 0xdc, 0xe7,
-	0x3c, 0x61, 0x01, 0x92, 0x57, 0x2f, 0xe5, 0xe8, 0xbe, 0x08, 0x0d, 0xe3, 0x3e, 0xad, 0xb0, 0xf3,
-	0x7e, 0x04, 0x8d, 0x00, 0xa3, 0x90, 0x11, 0x9f, 0xc0, 0xac, 0xa0, 0x7d, 0x5b, 0xc1, 0xfb, 0x3e,
-	0xcc, 0xc5, 0x7a, 0x28, 0x64, 0x28, 0x0d, 0x6f, 0x71, 0xc7, 0x7f, 0x87, 0xc2, 0xdb, 0xfb, 0xb0,
-	0x92, 0x65, 0x5d, 0xa1, 0xe1, 0xbe, 0x02, 0x10, 0x86, 0x3b, 0x7a, 0x70, 0x3f, 0xc6, 0x76, 0x50,
-	0xf1, 0xa7, 0xdf, 0x94, 0xc6, 0xea, 0x32, 0xbc, 0x00, 0xc3, 0xbe, 0xb5, 0xdf, 0x8c, 0x43, 0x59,
-	0x40, 0x21, 0x0d, 0xea, 0xbc, 0x36, 0x26, 0x2e, 0xf2, 0xfc, 0x3b, 0x43, 0x46, 0x6c, 0xb1, 0x1b,
-	0x3c, 0x74, 0x05, 0x2a, 0x5c, 0xe6, 0x08, 0xfb, 0x85, 0xa1, 0x49, 0x46, 0x78, 0x07, 0x13, 0xb4,
-	0x01, 0xd3, 0x01, 0xb3, 0x2d, 0x6a, 0x4a, 0x3c, 0x1d, 0x99, 0xf2, 0x65, 0x74, 0x5e, 0x5b, 0xba,
-	0x0e, 0x8d, 0x50, 0x92, 0xe7, 0xde, 0x3c, 0x29, 0xa9, 0xfb, 0x82, 0x3c, 0x39, 0x5a, 0x83, 0x5a,
-	0xc7, 0xe9, 0xf6, 0x03, 0x8b, 0xf8, 0x9d, 0x2a, 0x50, 0x9a, 0x30, 0x68, 0x11, 0x26, 0x99, 0x04,
-	0xb5, 0x87, 0x5f, 0xaa, 0x96, 0x69, 0x9b, 0x9a, 0x73, 0x1d, 0x1a, 0x3e, 0xcb, 0xb7, 0x86, 0x5f,
-	0xaf, 0xd6, 0x85, 0x84, 0x30, 0xe6, 0x1a, 0x4c, 0x05, 0x72, 0xdc, 0x16, 0x7e, 0xcb, 0x5a, 0x13,
-	0x62, 0xdc, 0x14, 0xdf, 0xa3, 0x95, 0x14, 0x8f, 0x42, 0xe8, 0x51, 0xb4, 0x06, 0xd5, 0x48, 0x6c,
-	0x6a, 0x56, 0x19, 0x2b, 0x4a, 0x42, 0x2a, 0x4c, 0x9a, 0x96, 0xd7, 0x77, 0x3c, 0x6c, 0x36, 0x6b,
-	0xdc, 0x85, 0x7e, 0x9b, 0xa6, 0x38, 0x3b, 0xd8, 0x36, 0x5b, 0x5d, 0x9a, 0x94, 0xed, 0xf0, 0xbc,
-	0xa7, 0xf8, 0x66, 0xff, 0x7a, 0x0c, 0x16, 0x53, 0xe0, 0xc4, 0xfa, 0xda, 0x0b, 0x13, 0x30, 0xfe,
-	0xaf, 0x78, 0x35, 0x02, 0x98, 0xa9, 0x96, 0xc2, 0xf1, 0x61, 0xd4, 0x37, 0x00, 0x42, 0x6e, 0x64,
-	0xe5, 0x2b, 0xd1, 0x95, 0x4f, 0xe9, 0x46, 0x37, 0xa8, 0x00, 0x8d, 0xeb, 0xa2, 0xa5, 0x7e, 0xa5,
-	0xc0, 0x4c, 0x02, 0x3c, 0xb1, 0xe5, 0x94, 0xe1, 0x5b, 0x4e, 0x87, 0x1a, 0x9d, 0x9e, 0x36, 0xc7,
-	0xa5, 0xf9, 0x12, 0x1d, 0xdd, 0x9d, 0x73, 0x8e, 0x4e, 0xaf, 0x1e, 0x07, 0xdf, 0x9e, 0xf6, 0x08,
-	0xae, 0xc4, 0x0e, 0xe3, 0xec, 0x36, 0xbb, 0xf8, 0xdc, 0x3c, 0x84, 0xa5, 0x74, 0xc0, 0x62, 0x47,
-	0xfc, 0x47, 0x70, 0xa5, 0x65, 0xdb, 0x61, 0x8e, 0x39, 0xf2, 0x79, 0xff, 0x03, 0x58, 0x4a, 0x07,
-	0x1c, 0xf1, 0xf0, 0xd5, 0x85, 0xab, 0x12, 0x2e, 0x0f, 0x7a, 0xa3, 0x9a, 0x9b, 0xf9, 0x33, 0xf9,
-	0x29, 0x68, 0x79, 0xdd, 0x3d, 0x87, 0xb4, 0xc0, 0x87, 0x1e, 0x79, 0x08, 0x05, 0xd3, 0x82, 0x44,
-	0xff, 0xcf, 0x23, 0x2d, 0x90, 0x7f, 0x49, 0x17, 0x30, 0xb4, 0xdc, 0xb4, 0x20, 0xc3, 0xba, 0x11,
-	0x07, 0xfe, 0x10, 0x16, 0xf9, 0xe9, 0x77, 0x0f, 0xbb, 0xcf, 0xe1, 0xb8, 0xde, 0x01, 0x35, 0x0d,
-	0xee, 0xf9, 0x9e, 0xd8, 0xa3, 0x0b, 0x70, 0xd4, 0xb3, 0x61, 0xc1, 0xc3, 0x6d, 0xb2, 0xff, 0xc2,
-	0xe7, 0x4a, 0x36, 0x9d, 0x23, 0x0f, 0x23, 0xef, 0x5c, 0x29, 0xf7, 0x50, 0xf8, 0x5c, 0x19, 0x5b,
-	0x81, 0x17, 0xe0, 0xf9, 0xbc, 0x73, 0x65, 0x96, 0x75, 0x45, 0x86, 0xbb, 0xf5, 0x8b, 0x31, 0x28,
-	0x8b, 0x77, 0x31, 0xe8, 0x6d, 0xa8, 0x04, 0xcf, 0xec, 0xd0, 0x95, 0x88, 0x56, 0xfc, 0x0d, 0x9f,
-	0xba, 0x94, 0xce, 0x14, 0x16, 0xec, 0xc0, 0x04, 0x7f, 0x55, 0xb3, 0x92, 0xf5, 0xf8, 0x46, 0xc0,
-	0xac, 0x66, 0xf2, 0x05, 0x52, 0x07, 0xa6, 0xe4, 0xe7, 0x3e, 0xe8, 0x46, 0x86, 0x4a, 0x7c, 0x47,
-	0xab, 0x1b, 0xc3, 0x05, 0x79, 0x27, 0x5b, 0xff, 0x28, 0x41, 0x25, 0x78, 0x15, 0x82, 0x0c, 0xa8,
-	0x45, 0x1f, 0xd9, 0x48, 0x1d, 0xe6, 0x3d, 0xec, 0x91, 0x3a, 0xcc, 0x7f, 0xaf, 0x73, 0x02, 0x8b,
-	0x99, 0x2f, 0x62, 0xd0, 0x0b, 0x69, 0x30, 0x19, 0xc5, 0x29, 0xf5, 0xd6, 0xd9, 0x84, 0x83, 0xa2,
-	0xf7, 0x74, 0x5c, 0x08, 0x69, 0x39, 0x08, 0x7e, 0x2f, 0xeb, 0xb9, 0x32, 0x02, 0xbc, 0x0b, 0xf3,
-	0xe9, 0xaf, 0x53, 0xd0, 0x46, 0xe2, 0xe6, 0x3c, 0x6b, 0x38, 0x37, 0xcf, 0x20, 0x29, 0xba, 0xd3,
-	0xa1, 0x2e, 0x49, 0xa0, 0xd5, 0x2c, 0x5d, 0x1f, 0x7c, 0x2d, 0x5b, 0x40, 0x60, 0xf6, 0x61, 0x21,
-	0xe3, 0x7d, 0x08, 0xba, 0x99, 0xbc, 0xd1, 0xcf, 0x1a, 0xc4, 0xf7, 0xce, 0x22, 0x2a, 0x7a, 0x7c,
-	0x02, 0x53, 0xb2, 0x08, 0x5a, 0xcb, 0xd4, 0xf6, 0xf1, 0xaf, 0xe6, 0x48, 0x84, 0xb0, 0xf2, 0x73,
-	0x0d, 0x09, 0x36, 0xf5, 0x1d, 0x89, 0x04, 0x9b, 0xf1, 0xd6, 0xe3, 0x75, 0x98, 0x60, 0x1c, 0xb4,
-	0x10, 0x97, 0xf5, 0x41, 0x9a, 0x49, 0x86, 0xd8, 0x64, 0x5f, 0x8e, 0xc3, 0x25, 0x1a, 0xe7, 0xd0,
-	0x5b, 0x50, 0x16, 0xd7, 0xf9, 0x68, 0x31, 0x22, 0x2d, 0x3f, 0x13, 0x50, 0xd5, 0x34, 0x96, 0x30,
-	0xe3, 0x01, 0x54, 0x23, 0x77, 0xf3, 0x68, 0x39, 0x22, 0x9a, 0xbc, 0xfb, 0x57, 0x57, 0xb2, 0xd8,
-	0x02, 0x6d, 0x17, 0x20, 0xbc, 0x05, 0x46, 0x4b, 0x19, 0x97, 0xc3, 0x1c, 0x6b, 0x39, 0xf7, 0xea,
-	0x18, 0x7d, 0x0c, 0x33, 0x89, 0xfb, 0x22, 0xb4, 0x9e, 0x7f, 0x9b, 0xc4, 0x81, 0xaf, 0x9d, 0xe5,
-	0xca, 0x09, 0xdd, 0x85, 0x49, 0xff, 0x12, 0x07, 0x45, 0x1d, 0x14, 0xbb, 0x1e, 0x52, 0xaf, 0xa4,
-	0xf2, 0xc4, 0x44, 0xfc, 0xb3, 0xc2, 0x4a, 0x02, 0xce, 0x80, 0x78, 0x74, 0x2e, 0xfc, 0x75, 0x17,
-	0x9d, 0x8b, 0xd8, 0x82, 0x53, 0xd3, 0x58, 0xe1, 0x36, 0x94, 0x2a, 0xf1, 0xd2, 0x36, 0x4c, 0xbb,
-	0x05, 0x90, 0xb6, 0x61, 0x7a, 0x11, 0xff, 0x29, 0x4c, 0x27, 0xf6, 0x9f, 0x96, 0xd4, 0x4a, 0xac,
-	0xe0, 0xf5, 0x5c, 0x99, 0x30, 0x4c, 0xa5, 0x97, 0x9d, 0xa5, 0x30, 0x95, 0x5b, 0x37, 0x97, 0xc2,
-	0xd4, 0x90, 0x1a, 0xf6, 0x9b, 0x50, 0xe2, 0x87, 0x3c, 0xd4, 0x4c, 0x9c, 0xfb, 0x7c, 0xb8, 0xc5,
-	0x14, 0x8e, 0x50, 0xff, 0x30, 0x59, 0xb1, 0xbd, 0x9a, 0x73, 0x7e, 0x14, 0x80, 0x5a, 0x9e, 0x88,
-	0x40, 0xf6, 0xa0, 0x99, 0x75, 0x39, 0x86, 0xa2, 0x11, 0x6c, 0xc8, 0x4d, 0x9e, 0xfa, 0xc2, 0x99,
-	0x64, 0x23, 0xc3, 0x91, 0x65, 0xe4, 0xe1, 0xa4, 0x5e, 0xad, 0xc9, 0xc3, 0xc9, 0xb8, 0x31, 0xd3,
-	0xa1, 0x2e, 0x15, 0x8d, 0xa5, 0x75, 0x98, 0x56, 0x98, 0x96, 0xd6, 0x61, 0x7a, 0xbd, 0xf9, 0x29,
-	0x4c, 0xc7, 0x4b, 0x78, 0xd2, 0x3a, 0xcc, 0x28, 0x3b, 0x4b, 0xeb, 0x30, 0xb3, 0x34, 0xfc, 0x56,
-	0x58, 0x98, 0x5b, 0x4c, 0x39, 0x9f, 0xa5, 0x6c, 0xbd, 0xf8, 0x39, 0x4f, 0x87, 0xba, 0x54, 0x47,
-	0x95, 0x86, 0x9c, 0x56, 0xc3, 0x95, 0x86, 0x9c, 0x5e, 0x82, 0xed, 0xc2, 0x7c, 0x7a, 0xd5, 0x52,
-	0xda, 0x1d, 0xb9, 0x65, 0x57, 0x69, 0x77, 0x0c, 0x29, 0x81, 0x7e, 0x9c, 0x56, 0x11, 0x5a, 0xcf,
-	0x2f, 0xe4, 0x24, 0x03, 0x66, 0x66, 0xb5, 0x67, 0xeb, 0x5f, 0x15, 0x28, 0x89, 0x75, 0x76, 0x04,
-	0xb3, 0x69, 0xf5, 0x0e, 0x74, 0x3d, 0xfa, 0x2a, 0x21, 0xbb, 0xc2, 0xa2, 0xde, 0x18, 0x2a, 0x27,
-	0xc6, 0x74, 0x0a, 0x6a, 0x76, 0x45, 0x02, 0xdd, 0xca, 0x82, 0x49, 0xcb, 0xc4, 0xd5, 0xdb, 0x67,
-	0x94, 0x8e, 0x04, 0xce, 0x58, 0xb9, 0x40, 0x0e, 0x9c, 0xe9, 0xb5, 0x0c, 0x39, 0x70, 0x66, 0xd5,
-	0x1b, 0x68, 0xe0, 0x4c, 0x4d, 0xcc, 0xe5, 0xc0, 0x99, 0x57, 0x59, 0x90, 0x03, 0x67, 0x7e, 0x96,
-	0x3f, 0x62, 0xe0, 0x34, 0x00, 0x25, 0xb3, 0x73, 0x74, 0x2d, 0xa1, 0x90, 0x52, 0x0b, 0x50, 0xff,
-	0x7f, 0x88, 0xd4, 0x45, 0x46, 0xd0, 0x23, 0x98, 0x4d, 0x2b, 0x2b, 0x4a, 0xcb, 0x38, 0xa7, 0x90,
-	0x29, 0x2d, 0xe3, 0xdc, 0xfa, 0xe4, 0xb7, 0x14, 0x50, 0xe3, 0xd5, 0x84, 0xf4, 0xf5, 0x19, 0x8b,
-	0x82, 0xeb, 0xb9, 0x32, 0xcf, 0x35, 0xa0, 0x46, 0x33, 0x6a, 0x39, 0xa0, 0xa6, 0x54, 0x02, 0xe4,
-	0x80, 0x9a, 0x9a, 0x8c, 0x27, 0x77, 0x8d, 0x0f, 0x9e, 0xb3, 0x6b, 0x62, 0xbd, 0xdc, 0x3c, 0x83,
-	0x24, 0xef, 0x6e, 0xfb, 0xda, 0x47, 0x1a, 0x8d, 0x80, 0x9f, 0x6e, 0x5a, 0xce, 0x1d, 0xf6, 0x71,
-	0xa7, 0xef, 0x5a, 0x27, 0x06, 0xc1, 0x77, 0x02, 0x88, 0xfe, 0xc1, 0x41, 0x89, 0xbd, 0x72, 0x7b,
-	0xf9, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x92, 0xe4, 0x74, 0x43, 0x11, 0x38, 0x00, 0x00,
+	0xb5, 0xbe, 0xcd, 0x11, 0x67, 0x38, 0x67, 0x86, 0xa4, 0x58, 0xe2, 0x63, 0xd8, 0xa2, 0xf8, 0x68,
+	0xca, 0x12, 0x79, 0x2d, 0x91, 0x36, 0x6d, 0xf8, 0x5e, 0x3b, 0x36, 0xe2, 0x21, 0x45, 0x89, 0xb4,
+	0x24, 0x8b, 0x69, 0x8a, 0x8e, 0x61, 0x07, 0x1e, 0x37, 0xa7, 0x8b, 0xc3, 0xb6, 0x7a, 0xba, 0xc7,
+	0xdd, 0x35, 0xa4, 0x09, 0x04, 0x86, 0x11, 0x24, 0xce, 0x2a, 0x40, 0xd6, 0x46, 0x90, 0x45, 0x36,
+	0xd9, 0x65, 0x91, 0x4d, 0xfe, 0x41, 0x60, 0x20, 0xff, 0x20, 0x0b, 0x07, 0xc8, 0x2e, 0x59, 0x64,
+	0x93, 0x5d, 0x16, 0x41, 0x50, 0x8f, 0x9e, 0x7e, 0xf7, 0x90, 0x3d, 0x72, 0xe8, 0x5d, 0xd7, 0xa9,
+	0xef, 0x7c, 0x75, 0xea, 0x75, 0xba, 0xea, 0x9c, 0x82, 0xf1, 0x76, 0xd7, 0x24, 0x86, 0x65, 0xeb,
+	0x78, 0xad, 0xe3, 0xd8, 0xc4, 0x46, 0xe5, 0x9e, 0x40, 0x86, 0x96, 0xdd, 0xb2, 0xb9, 0x58, 0x5e,
+	0x68, 0xd9, 0x76, 0xcb, 0xc4, 0xeb, 0xac, 0x74, 0xd8, 0x3d, 0x5a, 0x27, 0x46, 0x1b, 0xbb, 0x44,
+	0x6b, 0x77, 0x38, 0x40, 0x59, 0x81, 0x51, 0x15, 0x7f, 0xda, 0xc5, 0x2e, 0xd9, 0xc1, 0x9a, 0x8e,
+	0x1d, 0x34, 0x03, 0x25, 0xad, 0x63, 0x34, 0x9e, 0xe1, 0xb3, 0x9a, 0xb4, 0x28, 0xad, 0x54, 0xd5,
+	0xa2, 0xd6, 0x31, 0x1e, 0xe2, 0x33, 0xe5, 0x1e, 0x5c, 0xbd, 0x67, 0xb8, 0xcf, 0xf6, 0x3b, 0x5a,
+	0x13, 0x0b, 0x15, 0xf4, 0x12, 0x14, 0x8f, 0x99, 0x1a, 0xc3, 0x56, 0x36, 0x6a, 0x6b, 0xbe, 0x5d,
+	0x21, 0x5a, 0x55, 0xe0, 0x94, 0x7f, 0x4b, 0x30, 0x11, 0xa0, 0x71, 0x3b, 0xb6, 0xe5, 0x62, 0x34,
+	0x07, 0x65, 0xcd, 0x34, 0xed, 0xa6, 0x46, 0xb0, 0xce, 0xa8, 0x0a, 0xaa, 0x2f, 0x40, 0x0b, 0x50,
+	0xe9, 0xba, 0x58, 0x6f, 0x74, 0x0c, 0xdc, 0xc4, 0x6e, 0x6d, 0x88, 0xd5, 0x03, 0x15, 0xed, 0x31,
+	0x09, 0xba, 0x01, 0xac, 0xd4, 0x20, 0x8e, 0xe6, 0x1e, 0xd7, 0x0a, 0x5c, 0x9f, 0x4a, 0x9e, 0x52,
+	0x01, 0x42, 0x70, 0xe5, 0xc8, 0xc1, 0xb8, 0x76, 0x85, 0x55, 0xb0, 0x6f, 0xd6, 0xe2, 0x89, 0x66,
+	0x98, 0xda, 0xa1, 0x89, 0x6b, 0xc3, 0xa2, 0x45, 0x4f, 0x80, 0x64, 0x18, 0xb1, 0x4f, 0xb0, 0x43,
+	0x29, 0x6a, 0x45, 0x56, 0xd9, 0x2b, 0x53, 0x36, 0x26, 0x2f, 0x71, 0x36, 0x26, 0x5b, 0x85, 0xab,
+	0xcc, 0x00, 0x07, 0x37, 0x4d, 0xcd, 0x68, 0x33, 0xd2, 0x11, 0x56, 0x3f, 0x4e, 0xe5, 0xaa, 0x2f,
+	0x56, 0x7e, 0x27, 0x41, 0x75, 0x9f, 0xd8, 0x8e, 0xd6, 0xc2, 0x07, 0xae, 0xd6, 0xc2, 0x48, 0x81,
+	0x51, 0x8d, 0x34, 0x1c, 0xec, 0x92, 0x06, 0xb1, 0x89, 0x66, 0xb2, 0xfe, 0x4b, 0x6a, 0x45, 0x23,
+	0x2a, 0x76, 0xc9, 0x53, 0x2a, 0x42, 0x0f, 0x61, 0xcc, 0xb0, 0x08, 0x76, 0x4e, 0x34, 0xb3, 0xe1,
+	0x12, 0xcd, 0x21, 0x6c, 0x10, 0x2a, 0x1b, 0xf2, 0x1a, 0x9f, 0xdf, 0x35, 0x6f, 0x7e, 0xd7, 0x9e,
+	0x7a, 0xf3, 0xbb, 0x39, 0xf2, 0xf5, 0x37, 0x0b, 0xff, 0xf3, 0xcb, 0xbf, 0x2c, 0x48, 0xea, 0xa8,
+	0xa7, 0xbb, 0x4f, 0x55, 0xd1, 0x5d, 0xb8, 0x16, 0x6a, 0xb0, 0x71, 0x78, 0x46, 0xb0, 0xcb, 0x86,
+	0x4d, 0x52, 0xaf, 0x06, 0x9a, 0xdd, 0xa4, 0x72, 0xe5, 0x0f, 0x12, 0x5c, 0x0b, 0x1a, 0x9c, 0x7b,
+	0xee, 0xd1, 0xff, 0xd3, 0x79, 0xb0, 0xdb, 0x17, 0xb2, 0x9d, 0x69, 0xa0, 0x57, 0x61, 0x88, 0xd8,
+	0xb5, 0xc2, 0x05, 0xf4, 0x86, 0x88, 0xad, 0xfc, 0x5a, 0x82, 0xc9, 0xb0, 0xe5, 0x62, 0xb9, 0xbd,
+	0x09, 0xa3, 0x2e, 0x97, 0x37, 0xba, 0xb4, 0xa2, 0x26, 0x2d, 0x16, 0x56, 0x2a, 0x1b, 0x33, 0x81,
+	0x1e, 0x84, 0xf4, 0xaa, 0x6e, 0x70, 0xc2, 0x6a, 0x50, 0x72, 0xbb, 0xed, 0xb6, 0xe6, 0x9c, 0xb1,
+	0x9e, 0x48, 0xaa, 0x57, 0x44, 0x6b, 0x70, 0x4d, 0x3b, 0xc1, 0x3e, 0x6f, 0x68, 0x64, 0x27, 0x44,
+	0x15, 0x23, 0xe1, 0x43, 0xfb, 0x4f, 0x09, 0xe6, 0x82, 0x0d, 0xed, 0x6b, 0x04, 0x9b, 0xa6, 0x41,
+	0x06, 0x18, 0xe3, 0x97, 0xa1, 0xea, 0x7a, 0x2c, 0x0d, 0x43, 0x67, 0x16, 0x56, 0x37, 0xc7, 0xe8,
+	0xb8, 0xfc, 0xf9, 0x9b, 0x85, 0xe2, 0xbb, 0xb6, 0x8e, 0x77, 0xef, 0xa9, 0x95, 0x1e, 0x66, 0x57,
+	0xef, 0x4d, 0x4b, 0x21, 0xe7, 0xb4, 0x5c, 0xb9, 0xe0, 0xb4, 0xfc, 0x56, 0x82, 0x1b, 0x29, 0xbd,
+	0xfe, 0x8e, 0xcd, 0xcf, 0x1e, 0xcc, 0x6d, 0x6a, 0x96, 0x7e, 0x6a, 0xe8, 0xe4, 0xf8, 0xb1, 0x6d,
+	0x91, 0xe3, 0x7d, 0x4e, 0x94, 0xdf, 0xfd, 0xbd, 0x02, 0x37, 0x52, 0x18, 0x45, 0xd7, 0x3d, 0xef,
+	0x22, 0xf9, 0xde, 0x45, 0xf9, 0xb9, 0x04, 0x8b, 0x3d, 0x2d, 0xa1, 0x70, 0x29, 0x4b, 0x45, 0x79,
+	0x0b, 0x96, 0x32, 0x0c, 0x11, 0x5d, 0x08, 0x8c, 0x3f, 0xef, 0x85, 0x57, 0x54, 0x1e, 0xc2, 0x4c,
+	0x54, 0x3d, 0xff, 0x50, 0xbe, 0x0a, 0xb5, 0x38, 0x59, 0x5f, 0x13, 0x7e, 0x2a, 0xc1, 0x8d, 0xed,
+	0x96, 0x83, 0x5d, 0xf7, 0x52, 0x07, 0xf2, 0x0d, 0x98, 0x4f, 0xb3, 0xa2, 0x6f, 0x17, 0x76, 0x60,
+	0x32, 0xa4, 0x9b, 0x7f, 0x08, 0x5f, 0x86, 0xa9, 0x08, 0x53, 0xdf, 0xc6, 0x7f, 0x26, 0xc1, 0xfc,
+	0xae, 0x75, 0xf9, 0x03, 0xf8, 0x3d, 0x58, 0x48, 0x35, 0xa3, 0x6f, 0x27, 0x76, 0x61, 0x2a, 0xac,
+	0x9c, 0x7f, 0x08, 0x37, 0x60, 0x3a, 0x4a, 0xd5, 0xb7, 0xf9, 0x1f, 0xc3, 0xd4, 0x3d, 0xcd, 0x30,
+	0x2f, 0x69, 0xe4, 0xf6, 0x61, 0x3a, 0xda, 0xba, 0xb0, 0xf8, 0x75, 0xa8, 0x72, 0xb7, 0xe8, 0xd8,
+	0xa6, 0xd9, 0xed, 0x08, 0xaf, 0x3b, 0x1d, 0x30, 0x82, 0xbb, 0x5b, 0x56, 0xab, 0x56, 0xba, 0x7e,
+	0x41, 0x79, 0x1b, 0xaa, 0x8c, 0x34, 0xff, 0x40, 0xbe, 0x03, 0xa3, 0x82, 0x61, 0x70, 0x6b, 0xfe,
+	0x24, 0x41, 0x25, 0x50, 0x89, 0x56, 0xa1, 0x88, 0xd9, 0x1c, 0x09, 0x6b, 0x26, 0x02, 0x24, 0x7c,
+	0x03, 0xa8, 0x02, 0x80, 0xee, 0x40, 0xc9, 0xe0, 0xf3, 0x29, 0x8e, 0x29, 0x28, 0x80, 0x15, 0x33,
+	0xad, 0x7a, 0x10, 0x34, 0x0d, 0x45, 0x1d, 0x9b, 0x98, 0x60, 0x71, 0xe8, 0x14, 0xa5, 0x84, 0xf3,
+	0xda, 0x95, 0xdc, 0xe7, 0x35, 0xe5, 0x11, 0x14, 0xb7, 0x7b, 0xcd, 0x39, 0xb8, 0xa3, 0x19, 0x8e,
+	0x58, 0x51, 0xa2, 0x84, 0x26, 0x61, 0x58, 0xeb, 0xea, 0x06, 0x11, 0x47, 0x63, 0x5e, 0xa0, 0x52,
+	0xfe, 0xf7, 0xe4, 0xb6, 0xf1, 0x82, 0xf2, 0x7f, 0x50, 0xda, 0xb5, 0xc2, 0x74, 0x7a, 0x88, 0x4e,
+	0xf7, 0x15, 0x87, 0x82, 0x8a, 0x9b, 0x30, 0xf6, 0x1e, 0x76, 0x5c, 0xc3, 0xb6, 0xf2, 0x4f, 0xf2,
+	0x8b, 0x30, 0xde, 0xe3, 0xf0, 0xb7, 0xc9, 0x09, 0x17, 0x31, 0x96, 0xb2, 0xea, 0x15, 0x95, 0xfb,
+	0x80, 0x1e, 0x69, 0x2e, 0xd9, 0xb2, 0x2d, 0xa2, 0x35, 0x49, 0xfe, 0x46, 0x3f, 0x82, 0x6b, 0x21,
+	0x1e, 0xd1, 0xf0, 0x03, 0xa8, 0x9a, 0x9a, 0x4b, 0x1a, 0x4d, 0x2e, 0xaf, 0x49, 0x17, 0x98, 0xa1,
+	0x8a, 0xe9, 0x13, 0x2a, 0x9f, 0xc1, 0x84, 0x8a, 0x3b, 0x5d, 0xa2, 0x91, 0x41, 0xc6, 0x26, 0xcf,
+	0x56, 0xfe, 0x4a, 0x82, 0x4a, 0x9d, 0xce, 0xf5, 0x0f, 0x0d, 0x4b, 0xb7, 0x4f, 0x69, 0x97, 0x4e,
+	0xd9, 0x97, 0x58, 0x74, 0x17, 0xea, 0x12, 0xd7, 0xe4, 0x57, 0x84, 0x25, 0xa8, 0xda, 0x96, 0x69,
+	0x58, 0xb8, 0xd1, 0xb4, 0xbb, 0x16, 0x5f, 0x57, 0xc3, 0x6a, 0x85, 0xcb, 0xb6, 0xa8, 0x88, 0x5e,
+	0xca, 0xf8, 0xed, 0x81, 0x23, 0x0a, 0x0c, 0x01, 0x4c, 0xc4, 0x00, 0xca, 0xbf, 0x4a, 0x80, 0x82,
+	0xe3, 0xd2, 0x3b, 0xdb, 0x15, 0x39, 0x8d, 0xb0, 0xee, 0x66, 0x68, 0x60, 0xa2, 0xf0, 0xb5, 0x27,
+	0x0c, 0xab, 0x0a, 0x1d, 0xf4, 0x7a, 0x70, 0xa5, 0x57, 0x36, 0x96, 0xb3, 0x95, 0xd9, 0xd8, 0x78,
+	0xdb, 0xe1, 0x31, 0x8c, 0xeb, 0x86, 0xfb, 0x69, 0x57, 0x33, 0x8d, 0x23, 0x03, 0xeb, 0x0d, 0x8d,
+	0x9c, 0xf3, 0xc4, 0x2b, 0xb1, 0xf1, 0x19, 0x0b, 0x2a, 0xd7, 0x09, 0x1d, 0x6b, 0xb7, 0xeb, 0x76,
+	0xb0, 0xa5, 0x73, 0xae, 0x2b, 0x17, 0xe0, 0xaa, 0xf4, 0x34, 0xeb, 0x04, 0xbd, 0x07, 0x93, 0xf6,
+	0xd1, 0x11, 0x1b, 0xec, 0x10, 0xe1, 0xf0, 0x05, 0x08, 0x91, 0x60, 0xd8, 0x0f, 0xf0, 0x7e, 0x08,
+	0x33, 0x1e, 0x6f, 0xd7, 0xd2, 0xb1, 0xd3, 0x70, 0xf0, 0x89, 0x81, 0x4f, 0x29, 0x75, 0xf1, 0x02,
+	0xd4, 0x9e, 0x71, 0x07, 0x94, 0x43, 0x65, 0x14, 0x75, 0x82, 0xea, 0x50, 0x3e, 0xc1, 0x84, 0x70,
+	0x4b, 0xcb, 0x17, 0xa0, 0x1b, 0xe1, 0x6a, 0x75, 0x82, 0xb6, 0x00, 0xba, 0x1d, 0x5d, 0x13, 0x1c,
+	0xa5, 0x0b, 0x2c, 0xd5, 0xb2, 0xd0, 0xe3, 0x76, 0x7c, 0x62, 0x1b, 0x16, 0xe7, 0x18, 0xb9, 0x00,
+	0xc7, 0x08, 0x57, 0xab, 0x13, 0x79, 0x1e, 0x8a, 0x7c, 0x91, 0x51, 0xbf, 0xe7, 0x36, 0x6d, 0x07,
+	0x8b, 0x1b, 0x38, 0x2f, 0xc8, 0xbf, 0x1f, 0x82, 0xe1, 0xba, 0xe7, 0x50, 0xe3, 0xf5, 0xf4, 0xee,
+	0xcf, 0xe7, 0x8d, 0x3a, 0xad, 0x06, 0x07, 0xf0, 0x7b, 0xc7, 0xb8, 0x2f, 0xdf, 0x67, 0xd0, 0x84,
+	0x3d, 0x53, 0x08, 0xee, 0x19, 0xb4, 0x0c, 0xa3, 0x6e, 0xb7, 0xd9, 0xc4, 0xae, 0x2b, 0x20, 0x3c,
+	0x64, 0x51, 0x15, 0x42, 0x0e, 0xa2, 0xde, 0xde, 0xec, 0x1c, 0x6b, 0x6c, 0x85, 0x48, 0x2a, 0x2f,
+	0xd0, 0x8b, 0xc3, 0x21, 0x26, 0x1a, 0x9b, 0x5b, 0x49, 0x65, 0xdf, 0x94, 0xae, 0x6b, 0x3d, 0xb3,
+	0xec, 0x53, 0xab, 0xc1, 0x35, 0x4a, 0xac, 0xb2, 0x2a, 0x84, 0x75, 0xa6, 0xb8, 0x04, 0x5e, 0xb9,
+	0xc1, 0x08, 0x46, 0x18, 0xa6, 0x22, 0x64, 0x9b, 0x94, 0xe7, 0x25, 0x28, 0x1d, 0x1b, 0xf4, 0x8e,
+	0x75, 0x56, 0x2b, 0xc7, 0xfe, 0xc2, 0x01, 0x07, 0xa4, 0x7a, 0x30, 0xe5, 0x11, 0xd4, 0x9e, 0x3a,
+	0x5d, 0x97, 0x60, 0xbd, 0x77, 0xcc, 0x70, 0xf3, 0x7b, 0xf0, 0x3f, 0x4a, 0x30, 0x9b, 0x40, 0x27,
+	0x3c, 0xca, 0x87, 0x80, 0x08, 0xaf, 0x6c, 0xf4, 0x9c, 0xa3, 0x2b, 0x8e, 0x0b, 0x77, 0x02, 0xdc,
+	0xa9, 0x0c, 0x6b, 0xd4, 0xb7, 0x1e, 0xa8, 0x8f, 0xd4, 0x09, 0x12, 0x85, 0xc8, 0x8f, 0xa0, 0x24,
+	0x6a, 0xd1, 0x6d, 0x28, 0x51, 0x9e, 0x86, 0xf8, 0x5f, 0xc6, 0x7d, 0x73, 0x91, 0x56, 0xef, 0xea,
+	0xf4, 0x97, 0xa6, 0xe9, 0x7a, 0xef, 0x0c, 0x51, 0x56, 0xbd, 0xa2, 0xb2, 0x05, 0xe3, 0x4f, 0x3a,
+	0xd8, 0xd1, 0x88, 0xed, 0xe4, 0x1f, 0x0d, 0x03, 0xae, 0xfa, 0x24, 0x62, 0x0c, 0x26, 0x61, 0x18,
+	0xb7, 0x35, 0xc3, 0x14, 0xff, 0x50, 0x5e, 0xa0, 0x3f, 0xf8, 0x53, 0xcd, 0x34, 0x31, 0x11, 0x76,
+	0x88, 0x12, 0xba, 0x0d, 0xe3, 0xfc, 0xab, 0x71, 0x84, 0x35, 0xd2, 0x75, 0xd8, 0x1d, 0xb8, 0xb0,
+	0x52, 0x56, 0xc7, 0xb8, 0xf8, 0xbe, 0x90, 0x2a, 0x5f, 0x4a, 0xb0, 0xb0, 0xed, 0x12, 0xa3, 0x4d,
+	0xb7, 0xdb, 0x9e, 0x76, 0x66, 0x77, 0xc9, 0xe5, 0x1c, 0x5a, 0x7f, 0x00, 0x8b, 0xe9, 0x76, 0x88,
+	0x31, 0xb8, 0x0b, 0x08, 0x7b, 0x98, 0x06, 0xd6, 0x1c, 0xcb, 0xb0, 0x5a, 0xae, 0x38, 0xda, 0x4c,
+	0xf4, 0x6a, 0xb6, 0x45, 0x85, 0xf2, 0x0e, 0x4c, 0x47, 0x28, 0xf3, 0x4f, 0xc9, 0x0e, 0xcc, 0xc4,
+	0xb8, 0xf2, 0x59, 0xb5, 0x09, 0x63, 0x03, 0xdf, 0x49, 0x76, 0x61, 0x3c, 0x7a, 0x19, 0x79, 0x0d,
+	0x2a, 0x1d, 0x66, 0x57, 0xc3, 0xb0, 0x8e, 0x6c, 0xc1, 0x34, 0x15, 0x60, 0xe2, 0x56, 0xef, 0x5a,
+	0x47, 0xb6, 0x0a, 0x9d, 0xde, 0xb7, 0xf2, 0x31, 0x4c, 0x0a, 0xaa, 0x3d, 0xec, 0x18, 0xb6, 0x9e,
+	0x7f, 0xd2, 0xa7, 0xa1, 0xd8, 0x61, 0x14, 0xde, 0x5a, 0xe4, 0x25, 0xe5, 0x09, 0x4c, 0x45, 0x5a,
+	0x18, 0xd0, 0xe4, 0xcf, 0x61, 0xe6, 0x52, 0x6f, 0xa6, 0x2a, 0xd4, 0x52, 0xaf, 0xa4, 0x79, 0xfb,
+	0xf4, 0x2b, 0x1a, 0x32, 0x8b, 0x90, 0x0e, 0x3a, 0x21, 0x39, 0x22, 0x85, 0xfe, 0x1c, 0x16, 0x42,
+	0x73, 0xf8, 0x3e, 0xcc, 0xa7, 0x59, 0x37, 0x60, 0xc7, 0xeb, 0x30, 0x4a, 0xb7, 0x06, 0xce, 0xdf,
+	0x4f, 0xe5, 0x16, 0x8c, 0x79, 0x14, 0xbe, 0xb3, 0xf4, 0x23, 0xed, 0x05, 0x95, 0x17, 0x98, 0x3f,
+	0x60, 0xb8, 0xc1, 0x97, 0x8d, 0xf2, 0x31, 0xcc, 0xc4, 0xb8, 0x44, 0xe3, 0xdb, 0x70, 0x15, 0xb3,
+	0x2a, 0xff, 0x67, 0x25, 0xfe, 0x55, 0x72, 0xf0, 0x56, 0x1a, 0xd1, 0x1e, 0xc7, 0x61, 0x81, 0xf2,
+	0x01, 0x8c, 0x47, 0x30, 0xc9, 0xdd, 0xca, 0xb3, 0x82, 0x77, 0x60, 0xf2, 0xc0, 0xd2, 0x0d, 0x97,
+	0x38, 0xc6, 0x61, 0x97, 0x0c, 0x32, 0xf6, 0x77, 0x61, 0x2a, 0xc2, 0x94, 0x39, 0x05, 0x9f, 0xc3,
+	0xcc, 0x9e, 0x76, 0xe6, 0x92, 0xee, 0xe1, 0xe5, 0x6c, 0xdd, 0x1d, 0xa8, 0xc5, 0xdb, 0x17, 0x16,
+	0xdf, 0x81, 0x52, 0x87, 0xd7, 0xd5, 0xa4, 0x58, 0x60, 0x40, 0x68, 0xa9, 0x1e, 0x84, 0xba, 0x71,
+	0x4f, 0x96, 0x7b, 0xf0, 0xbe, 0x0f, 0xe3, 0x3d, 0x8e, 0x5c, 0x46, 0x7c, 0x0c, 0x93, 0x42, 0xf6,
+	0x6d, 0x39, 0xef, 0x6d, 0x98, 0x8a, 0xb4, 0x90, 0xcb, 0x50, 0xea, 0xde, 0xa2, 0x03, 0xff, 0x1d,
+	0x72, 0x6f, 0xef, 0xc2, 0x7c, 0x9a, 0x75, 0xb9, 0xba, 0xfb, 0x2a, 0x80, 0xef, 0xee, 0xe8, 0xc1,
+	0xfd, 0x18, 0x9b, 0xbd, 0x88, 0x3f, 0xfd, 0xa6, 0xb2, 0x8e, 0x26, 0x8c, 0x2e, 0xa8, 0xec, 0x5b,
+	0xf9, 0x45, 0x01, 0x4a, 0x82, 0x8a, 0xe6, 0x0c, 0x79, 0x6c, 0x4c, 0x24, 0xf2, 0xbc, 0x9c, 0x21,
+	0x13, 0xd6, 0x59, 0x06, 0x0f, 0x5d, 0x87, 0x32, 0xc7, 0xb4, 0xb0, 0x17, 0x18, 0x1a, 0x61, 0x82,
+	0x07, 0x98, 0xa0, 0x15, 0xb8, 0xda, 0xab, 0x6c, 0x88, 0x98, 0x12, 0xbf, 0x8e, 0x8c, 0x79, 0x18,
+	0x95, 0x49, 0xd1, 0x2d, 0x18, 0xf7, 0x91, 0xfc, 0xee, 0xcd, 0x2f, 0x25, 0xa3, 0x1e, 0x90, 0x5f,
+	0x8e, 0x16, 0xa1, 0xda, 0xb4, 0xdb, 0x9d, 0x9e, 0x45, 0x3c, 0xa7, 0x0a, 0x54, 0x26, 0x0c, 0x9a,
+	0x85, 0x11, 0x86, 0xa0, 0xf6, 0xf0, 0xa4, 0x6a, 0x89, 0x96, 0xa9, 0x39, 0xb7, 0x60, 0xdc, 0xab,
+	0xf2, 0xac, 0xe1, 0xe9, 0xd5, 0x51, 0x81, 0x10, 0xc6, 0xdc, 0x84, 0xb1, 0x1e, 0x8e, 0xdb, 0xc2,
+	0xb3, 0xac, 0x55, 0x01, 0xe3, 0xa6, 0x78, 0x23, 0x5a, 0x4e, 0x18, 0x51, 0xf0, 0x47, 0x14, 0x2d,
+	0x42, 0x25, 0xe0, 0x9b, 0x6a, 0x15, 0x56, 0x15, 0x14, 0xd1, 0x3c, 0xb0, 0x6e, 0xb8, 0x1d, 0xdb,
+	0xc5, 0x7a, 0xad, 0xca, 0x87, 0xd0, 0x2b, 0xd3, 0x2b, 0xce, 0x0e, 0x36, 0xf5, 0x7a, 0x9b, 0x5e,
+	0xca, 0x76, 0xf8, 0xbd, 0x27, 0xff, 0x66, 0xff, 0x7a, 0x08, 0x66, 0x13, 0xe8, 0xc4, 0xfa, 0xda,
+	0xf3, 0x2f, 0x60, 0xfc, 0x5f, 0xf1, 0x5a, 0x80, 0x30, 0x55, 0x2d, 0xa1, 0xc6, 0xa3, 0x91, 0xdf,
+	0x04, 0xf0, 0x6b, 0x03, 0x2b, 0x5f, 0x0a, 0xae, 0x7c, 0x2a, 0xd7, 0xda, 0xbd, 0x08, 0x50, 0x41,
+	0x15, 0x25, 0xf9, 0x2b, 0x09, 0x26, 0x62, 0xe4, 0xb1, 0x2d, 0x27, 0xf5, 0xdf, 0x72, 0x2a, 0x54,
+	0xe9, 0xf4, 0x34, 0x38, 0x2f, 0xbd, 0x2f, 0xd1, 0xde, 0xad, 0x5f, 0xb0, 0x77, 0x6a, 0xe5, 0xb8,
+	0xf7, 0xed, 0x2a, 0x4f, 0xe0, 0x7a, 0xe4, 0x30, 0xce, 0xb2, 0xd9, 0xf9, 0xe7, 0xe6, 0x31, 0xcc,
+	0x25, 0x13, 0xe6, 0x3b, 0xe2, 0x3f, 0x81, 0xeb, 0x75, 0xd3, 0xf4, 0xef, 0x98, 0x03, 0x9f, 0xf7,
+	0xdf, 0x83, 0xb9, 0x64, 0xc2, 0x01, 0x0f, 0x5f, 0x6d, 0x58, 0x0a, 0xf1, 0x72, 0xa7, 0x37, 0xa8,
+	0xb9, 0xa9, 0x3f, 0x93, 0x1f, 0x81, 0x92, 0xd5, 0xdc, 0x73, 0xb8, 0x16, 0x78, 0xd4, 0x03, 0x77,
+	0x21, 0xe7, 0xb5, 0x20, 0xd6, 0xfe, 0xf3, 0xb8, 0x16, 0x84, 0x7f, 0x49, 0x97, 0xd0, 0xb5, 0xcc,
+	0x6b, 0x41, 0x8a, 0x75, 0x03, 0x76, 0xfc, 0x31, 0xcc, 0xf2, 0xd3, 0xef, 0x1e, 0x76, 0x9e, 0xc3,
+	0x71, 0xbd, 0x09, 0x72, 0x12, 0xdd, 0xf3, 0x3d, 0xb1, 0x07, 0x17, 0xe0, 0xa0, 0x67, 0xc3, 0x9c,
+	0x87, 0xdb, 0x78, 0xfb, 0xb9, 0xcf, 0x95, 0x6c, 0x3a, 0x07, 0xee, 0x46, 0xd6, 0xb9, 0x32, 0xdc,
+	0x42, 0xee, 0x73, 0x65, 0x64, 0x05, 0x5e, 0xc2, 0xc8, 0x67, 0x9d, 0x2b, 0xd3, 0xac, 0xcb, 0xd5,
+	0x5d, 0x0b, 0xe6, 0xf6, 0x31, 0xa9, 0x7b, 0xef, 0xe6, 0x06, 0x7f, 0xad, 0x17, 0x7e, 0x97, 0x37,
+	0x14, 0x79, 0x97, 0xa7, 0xbc, 0x05, 0x37, 0x52, 0xda, 0x3b, 0xcf, 0xb3, 0x3e, 0xe5, 0x27, 0x12,
+	0x5c, 0xdf, 0xb5, 0x0c, 0x62, 0x68, 0x04, 0x3f, 0x70, 0xb4, 0x26, 0x3e, 0xea, 0x9a, 0xdb, 0x9f,
+	0x19, 0xe4, 0xbf, 0xba, 0x2b, 0xe6, 0x61, 0x2e, 0xd9, 0x06, 0xde, 0x05, 0xe5, 0x0b, 0x09, 0x66,
+	0xb7, 0x34, 0xab, 0x89, 0xcd, 0x4b, 0x33, 0x71, 0x0e, 0xe4, 0x24, 0x0b, 0x84, 0x81, 0xbf, 0x91,
+	0x60, 0xfa, 0xbe, 0xed, 0xb4, 0xf0, 0xe5, 0x44, 0x66, 0x69, 0x8e, 0xe1, 0xc8, 0x76, 0x9a, 0xb8,
+	0xd1, 0x34, 0xb1, 0x66, 0x75, 0x3b, 0x6c, 0x8d, 0x8f, 0xa8, 0x55, 0x26, 0xdc, 0xe2, 0x32, 0x65,
+	0x16, 0x66, 0x62, 0x36, 0x0a, 0xfb, 0x0f, 0x60, 0xe9, 0xa9, 0x63, 0xb4, 0x5a, 0xd8, 0x39, 0x70,
+	0xb1, 0xce, 0xd6, 0xcf, 0x7d, 0xc3, 0xc4, 0xa7, 0x9a, 0xf9, 0x0c, 0x0f, 0x10, 0x24, 0xbf, 0x09,
+	0x4a, 0x16, 0xad, 0x68, 0xfc, 0x01, 0x5c, 0x53, 0x6d, 0xa2, 0x11, 0x5c, 0xdf, 0xdb, 0x7d, 0x88,
+	0x07, 0x38, 0x82, 0xad, 0xc3, 0x64, 0x98, 0x48, 0xec, 0x80, 0xb4, 0xd7, 0xb4, 0x1b, 0x5f, 0x0c,
+	0x41, 0x49, 0xbc, 0x61, 0x43, 0xf7, 0xa1, 0xdc, 0xdb, 0x3b, 0xe8, 0x7a, 0xa0, 0xad, 0xe8, 0x0e,
+	0x96, 0xe7, 0x92, 0x2b, 0x45, 0x63, 0x3b, 0x30, 0xcc, 0x5f, 0xc0, 0xcd, 0xa7, 0x3d, 0x94, 0x13,
+	0x34, 0x0b, 0xa9, 0xf5, 0x82, 0xa9, 0x09, 0x63, 0xe1, 0xa7, 0x79, 0xe8, 0x76, 0x8a, 0x4a, 0x74,
+	0xd1, 0xc9, 0x2b, 0xfd, 0x81, 0xbc, 0x91, 0x8d, 0xbf, 0x16, 0xa1, 0xdc, 0x7b, 0xc1, 0x85, 0x34,
+	0xa8, 0x06, 0x1f, 0xc4, 0x85, 0x1a, 0xcc, 0x7a, 0x84, 0x27, 0xaf, 0xf4, 0x07, 0x8a, 0x5e, 0x9d,
+	0xc0, 0x6c, 0xea, 0xeb, 0x35, 0xf4, 0x62, 0x12, 0x4d, 0x4a, 0x20, 0x59, 0xbe, 0x73, 0x3e, 0x70,
+	0x2f, 0x41, 0x75, 0x35, 0x0a, 0x42, 0x4a, 0x06, 0x83, 0xd7, 0xca, 0x72, 0x26, 0x46, 0x90, 0xb7,
+	0x61, 0x3a, 0xf9, 0x25, 0x19, 0x5a, 0x89, 0xbd, 0x72, 0x49, 0xeb, 0xce, 0xea, 0x39, 0x90, 0xa2,
+	0x39, 0x15, 0x46, 0x43, 0x08, 0xb4, 0x90, 0xa6, 0xeb, 0x91, 0x2f, 0xa6, 0x03, 0x04, 0x67, 0x07,
+	0x66, 0x52, 0xde, 0x72, 0xa1, 0xd5, 0xf8, 0xeb, 0x9b, 0xb4, 0x4e, 0xfc, 0xef, 0x79, 0xa0, 0xa2,
+	0xc5, 0x03, 0x18, 0x0b, 0x43, 0xd0, 0x62, 0xaa, 0xb6, 0xc7, 0xbf, 0x94, 0x81, 0xf0, 0x69, 0xc3,
+	0x4f, 0xab, 0x42, 0xb4, 0x89, 0x6f, 0xbe, 0xe4, 0xa5, 0x0c, 0x84, 0xa0, 0x7d, 0x03, 0x86, 0x59,
+	0x0d, 0x9a, 0x89, 0x62, 0x3d, 0x92, 0x5a, 0xbc, 0x42, 0x6c, 0xb2, 0x2f, 0x0b, 0x70, 0x85, 0xba,
+	0x6d, 0xf4, 0x36, 0x94, 0xc4, 0xd3, 0x1b, 0x34, 0x1b, 0x40, 0x87, 0x9f, 0xf4, 0xc8, 0x72, 0x52,
+	0x95, 0x30, 0xe3, 0x11, 0x54, 0x02, 0xef, 0x68, 0xd0, 0x8d, 0x00, 0x34, 0xfe, 0x4e, 0x47, 0x9e,
+	0x4f, 0xab, 0x16, 0x6c, 0xbb, 0x00, 0xfe, 0x8b, 0x0d, 0x34, 0x97, 0xf2, 0x90, 0x83, 0x73, 0xdd,
+	0xc8, 0x7c, 0xe6, 0x81, 0x3e, 0x82, 0x89, 0x58, 0x6e, 0x17, 0x2d, 0x67, 0x67, 0x7e, 0x39, 0xf1,
+	0xcd, 0xf3, 0xa4, 0x87, 0xd1, 0x16, 0x8c, 0x78, 0x09, 0x57, 0x14, 0x1c, 0xa0, 0x48, 0x2a, 0x57,
+	0xbe, 0x9e, 0x58, 0x27, 0x26, 0xe2, 0x6f, 0x65, 0x16, 0xbe, 0xb3, 0xbb, 0xc4, 0xa5, 0x73, 0xe1,
+	0xad, 0xbb, 0xe0, 0x5c, 0x44, 0x16, 0x9c, 0x9c, 0x54, 0xe5, 0x6f, 0xc3, 0x50, 0xd6, 0x2c, 0xb4,
+	0x0d, 0x93, 0x32, 0x76, 0xf2, 0x62, 0x3a, 0xc0, 0x77, 0x53, 0xb1, 0xfd, 0xa7, 0xc4, 0xb5, 0x62,
+	0x2b, 0x78, 0x39, 0x13, 0xe3, 0xbb, 0xa9, 0xe4, 0x14, 0x51, 0xc8, 0x4d, 0x65, 0xe6, 0xb8, 0xe4,
+	0xd5, 0x73, 0x20, 0x45, 0x73, 0x6f, 0x41, 0x91, 0x5f, 0xc8, 0x50, 0x2d, 0x76, 0x47, 0xf3, 0xe8,
+	0x66, 0x13, 0x6a, 0x84, 0xfa, 0xfb, 0xf1, 0xec, 0xca, 0x52, 0xc6, 0x5d, 0x4f, 0x10, 0x2a, 0x59,
+	0x10, 0xc1, 0xec, 0x42, 0x2d, 0x2d, 0x91, 0x8d, 0x82, 0x1e, 0xac, 0x4f, 0xd6, 0x5d, 0x7e, 0xf1,
+	0x5c, 0xd8, 0x40, 0x77, 0xc2, 0x98, 0x70, 0x77, 0x12, 0xd3, 0xe0, 0xb2, 0x92, 0x05, 0xf1, 0xd7,
+	0x61, 0x28, 0xc1, 0x13, 0x5a, 0x87, 0x49, 0x49, 0x24, 0x79, 0x31, 0x1d, 0xe0, 0xaf, 0xc3, 0x68,
+	0xb8, 0x3d, 0xb4, 0x0e, 0x53, 0x52, 0x44, 0xf2, 0x72, 0x26, 0x46, 0x90, 0xbf, 0xed, 0x07, 0xd1,
+	0x67, 0xe3, 0xf8, 0xa4, 0xad, 0x17, 0xbd, 0x93, 0xa9, 0x30, 0x1a, 0xca, 0x79, 0x84, 0xba, 0x9c,
+	0x94, 0x6f, 0x91, 0x17, 0xd3, 0x01, 0xfe, 0xee, 0x48, 0xce, 0x30, 0x84, 0x76, 0x47, 0x66, 0x8a,
+	0x44, 0x5e, 0x3d, 0x07, 0xd2, 0x77, 0x98, 0xf1, 0xe8, 0xed, 0x72, 0x76, 0xd0, 0x35, 0xee, 0x30,
+	0x53, 0x23, 0xb3, 0x1b, 0xff, 0x28, 0x43, 0x51, 0xac, 0xb3, 0x16, 0x4c, 0x26, 0xc5, 0x26, 0xd1,
+	0xad, 0xe0, 0x0b, 0xa2, 0xf4, 0x68, 0xa8, 0x7c, 0xbb, 0x2f, 0x4e, 0xf4, 0xe9, 0x0c, 0xe4, 0xf4,
+	0xe8, 0x21, 0xba, 0x93, 0x46, 0x93, 0x14, 0x35, 0x93, 0xef, 0x9e, 0x13, 0x1d, 0x70, 0x9c, 0x91,
+	0xd0, 0x5e, 0xd8, 0x71, 0x26, 0xc7, 0x1d, 0xe5, 0xe5, 0x4c, 0x4c, 0xc0, 0x71, 0x26, 0x06, 0xd1,
+	0xc2, 0x8e, 0x33, 0x2b, 0x0a, 0x28, 0xaf, 0x9e, 0x03, 0xf9, 0x7c, 0x1c, 0xa7, 0x06, 0x28, 0x1e,
+	0x49, 0x43, 0x37, 0x63, 0x0a, 0x09, 0x71, 0x3b, 0xf9, 0x85, 0x3e, 0xa8, 0xcb, 0xf4, 0xa0, 0x2d,
+	0x98, 0x4c, 0x4a, 0x01, 0x84, 0x96, 0x71, 0x46, 0xd2, 0x41, 0xbe, 0xdd, 0x17, 0xf7, 0xed, 0x3a,
+	0xd4, 0x68, 0xe4, 0x2f, 0x79, 0x7d, 0x46, 0xbc, 0xe0, 0x72, 0x26, 0xe6, 0xb9, 0x3a, 0xd4, 0x60,
+	0xf4, 0x2b, 0xec, 0x50, 0x13, 0xa2, 0x76, 0xf2, 0x62, 0x3a, 0x20, 0x75, 0xd7, 0x78, 0xe4, 0x19,
+	0xbb, 0x26, 0xd2, 0xca, 0xea, 0x39, 0x90, 0xc2, 0xe1, 0xfd, 0xfd, 0x0a, 0xc0, 0x63, 0xcd, 0xd2,
+	0x5a, 0xb8, 0x8d, 0x2d, 0x82, 0x3e, 0x81, 0xa9, 0xc4, 0xc0, 0x58, 0xf8, 0x16, 0x9d, 0x11, 0xaa,
+	0x93, 0x57, 0xfa, 0x03, 0xfd, 0x95, 0x99, 0x14, 0xc0, 0x0a, 0xad, 0xcc, 0x8c, 0x28, 0x9b, 0x7c,
+	0xbb, 0x2f, 0xce, 0xdf, 0xda, 0xf1, 0x30, 0x54, 0x68, 0x6b, 0xa7, 0xc6, 0xc9, 0xe4, 0x17, 0xfa,
+	0xa0, 0xfc, 0x73, 0x4a, 0x24, 0x4c, 0x14, 0x3a, 0xa7, 0x24, 0x87, 0xb9, 0x64, 0x25, 0x0b, 0xe2,
+	0xff, 0x1d, 0xd2, 0xc3, 0x41, 0x28, 0xfc, 0x4a, 0xb4, 0x4f, 0x30, 0x4a, 0xbe, 0x7b, 0x4e, 0xb4,
+	0x68, 0xfa, 0x09, 0x54, 0x83, 0xa1, 0xa1, 0x50, 0x70, 0x26, 0x21, 0xf8, 0x24, 0x2f, 0xa4, 0xd6,
+	0x73, 0xc2, 0xcd, 0x9b, 0x1f, 0x28, 0xf4, 0x77, 0xfb, 0xc9, 0x9a, 0x61, 0xaf, 0xb3, 0x8f, 0xf5,
+	0x8e, 0x63, 0x9c, 0x68, 0x04, 0xaf, 0xf7, 0x14, 0x3b, 0x87, 0x87, 0x45, 0xf6, 0xfc, 0xf9, 0x95,
+	0xff, 0x0c, 0x00, 0xf0, 0x60, 0x98, 0x9f, 0x2a, 0x3e, 0x00, 0x00,
 }
@@ -2149,3 +2149,302 @@ func (x *drpcPayout_SatellitePeriodPaystubStream) SendAndClose(m *SatellitePerio
 	}
 	return x.CloseSend()
 }
+
+type DRPCManagementClient interface {
+	DRPCConn() drpc.Conn
+
+	SetAllocatedDiskSpace(ctx context.Context, in *SetAllocatedDiskSpaceRequest) (*SetAllocatedDiskSpaceResponse, error)
+	InitiateGracefulExit(ctx context.Context, in *InitiateGracefulExitRequest) (*InitiateGracefulExitResponse, error)
+	CancelGracefulExit(ctx context.Context, in *CancelGracefulExitRequest) (*CancelGracefulExitResponse, error)
+	ForgetSatellite(ctx context.Context, in *ForgetSatelliteRequest) (*ForgetSatelliteResponse, error)
+	TriggerUsedSpaceFilewalker(ctx context.Context, in *TriggerUsedSpaceFilewalkerRequest) (*TriggerUsedSpaceFilewalkerResponse, error)
+	RotateAPIKey(ctx context.Context, in *RotateAPIKeyRequest) (*RotateAPIKeyResponse, error)
+}
+
+type drpcManagementClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCManagementClient(cc drpc.Conn) DRPCManagementClient {
+	return &drpcManagementClient{cc}
+}
+
+func (c *drpcManagementClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcManagementClient) SetAllocatedDiskSpace(ctx context.Context, in *SetAllocatedDiskSpaceRequest) (*SetAllocatedDiskSpaceResponse, error) {
+	out := new(SetAllocatedDiskSpaceResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Management/SetAllocatedDiskSpace", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcManagementClient) InitiateGracefulExit(ctx context.Context, in *InitiateGracefulExitRequest) (*InitiateGracefulExitResponse, error) {
+	out := new(InitiateGracefulExitResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Management/InitiateGracefulExit", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcManagementClient) CancelGracefulExit(ctx context.Context, in *CancelGracefulExitRequest) (*CancelGracefulExitResponse, error) {
+	out := new(CancelGracefulExitResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Management/CancelGracefulExit", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcManagementClient) ForgetSatellite(ctx context.Context, in *ForgetSatelliteRequest) (*ForgetSatelliteResponse, error) {
+	out := new(ForgetSatelliteResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Management/ForgetSatellite", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcManagementClient) TriggerUsedSpaceFilewalker(ctx context.Context, in *TriggerUsedSpaceFilewalkerRequest) (*TriggerUsedSpaceFilewalkerResponse, error) {
+	out := new(TriggerUsedSpaceFilewalkerResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Management/TriggerUsedSpaceFilewalker", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcManagementClient) RotateAPIKey(ctx context.Context, in *RotateAPIKeyRequest) (*RotateAPIKeyResponse, error) {
+	out := new(RotateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Management/RotateAPIKey", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCManagementServer interface {
+	SetAllocatedDiskSpace(context.Context, *SetAllocatedDiskSpaceRequest) (*SetAllocatedDiskSpaceResponse, error)
+	InitiateGracefulExit(context.Context, *InitiateGracefulExitRequest) (*InitiateGracefulExitResponse, error)
+	CancelGracefulExit(context.Context, *CancelGracefulExitRequest) (*CancelGracefulExitResponse, error)
+	ForgetSatellite(context.Context, *ForgetSatelliteRequest) (*ForgetSatelliteResponse, error)
+	TriggerUsedSpaceFilewalker(context.Context, *TriggerUsedSpaceFilewalkerRequest) (*TriggerUsedSpaceFilewalkerResponse, error)
+	RotateAPIKey(context.Context, *RotateAPIKeyRequest) (*RotateAPIKeyResponse, error)
+}
+
+type DRPCManagementUnimplementedServer struct{}
+
+func (s *DRPCManagementUnimplementedServer) SetAllocatedDiskSpace(context.Context, *SetAllocatedDiskSpaceRequest) (*SetAllocatedDiskSpaceResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCManagementUnimplementedServer) InitiateGracefulExit(context.Context, *InitiateGracefulExitRequest) (*InitiateGracefulExitResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCManagementUnimplementedServer) CancelGracefulExit(context.Context, *CancelGracefulExitRequest) (*CancelGracefulExitResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCManagementUnimplementedServer) ForgetSatellite(context.Context, *ForgetSatelliteRequest) (*ForgetSatelliteResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCManagementUnimplementedServer) TriggerUsedSpaceFilewalker(context.Context, *TriggerUsedSpaceFilewalkerRequest) (*TriggerUsedSpaceFilewalkerResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCManagementUnimplementedServer) RotateAPIKey(context.Context, *RotateAPIKeyRequest) (*RotateAPIKeyResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCManagementDescription struct{}
+
+func (DRPCManagementDescription) NumMethods() int { return 6 }
+
+func (DRPCManagementDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/multinode.Management/SetAllocatedDiskSpace", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCManagementServer).
+					SetAllocatedDiskSpace(
+						ctx,
+						in1.(*SetAllocatedDiskSpaceRequest),
+					)
+			}, DRPCManagementServer.SetAllocatedDiskSpace, true
+	case 1:
+		return "/multinode.Management/InitiateGracefulExit", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCManagementServer).
+					InitiateGracefulExit(
+						ctx,
+						in1.(*InitiateGracefulExitRequest),
+					)
+			}, DRPCManagementServer.InitiateGracefulExit, true
+	case 2:
+		return "/multinode.Management/CancelGracefulExit", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCManagementServer).
+					CancelGracefulExit(
+						ctx,
+						in1.(*CancelGracefulExitRequest),
+					)
+			}, DRPCManagementServer.CancelGracefulExit, true
+	case 3:
+		return "/multinode.Management/ForgetSatellite", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCManagementServer).
+					ForgetSatellite(
+						ctx,
+						in1.(*ForgetSatelliteRequest),
+					)
+			}, DRPCManagementServer.ForgetSatellite, true
+	case 4:
+		return "/multinode.Management/TriggerUsedSpaceFilewalker", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCManagementServer).
+					TriggerUsedSpaceFilewalker(
+						ctx,
+						in1.(*TriggerUsedSpaceFilewalkerRequest),
+					)
+			}, DRPCManagementServer.TriggerUsedSpaceFilewalker, true
+	case 5:
+		return "/multinode.Management/RotateAPIKey", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCManagementServer).
+					RotateAPIKey(
+						ctx,
+						in1.(*RotateAPIKeyRequest),
+					)
+			}, DRPCManagementServer.RotateAPIKey, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterManagement(mux drpc.Mux, impl DRPCManagementServer) error {
+	return mux.Register(impl, DRPCManagementDescription{})
+}
+
+type DRPCManagement_SetAllocatedDiskSpaceStream interface {
+	drpc.Stream
+	SendAndClose(*SetAllocatedDiskSpaceResponse) error
+}
+
+type drpcManagement_SetAllocatedDiskSpaceStream struct {
+	drpc.Stream
+}
+
+func (x *drpcManagement_SetAllocatedDiskSpaceStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcManagement_SetAllocatedDiskSpaceStream) SendAndClose(m *SetAllocatedDiskSpaceResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCManagement_InitiateGracefulExitStream interface {
+	drpc.Stream
+	SendAndClose(*InitiateGracefulExitResponse) error
+}
+
+type drpcManagement_InitiateGracefulExitStream struct {
+	drpc.Stream
+}
+
+func (x *drpcManagement_InitiateGracefulExitStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcManagement_InitiateGracefulExitStream) SendAndClose(m *InitiateGracefulExitResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCManagement_CancelGracefulExitStream interface {
+	drpc.Stream
+	SendAndClose(*CancelGracefulExitResponse) error
+}
+
+type drpcManagement_CancelGracefulExitStream struct {
+	drpc.Stream
+}
+
+func (x *drpcManagement_CancelGracefulExitStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcManagement_CancelGracefulExitStream) SendAndClose(m *CancelGracefulExitResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCManagement_ForgetSatelliteStream interface {
+	drpc.Stream
+	SendAndClose(*ForgetSatelliteResponse) error
+}
+
+type drpcManagement_ForgetSatelliteStream struct {
+	drpc.Stream
+}
+
+func (x *drpcManagement_ForgetSatelliteStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcManagement_ForgetSatelliteStream) SendAndClose(m *ForgetSatelliteResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCManagement_TriggerUsedSpaceFilewalkerStream interface {
+	drpc.Stream
+	SendAndClose(*TriggerUsedSpaceFilewalkerResponse) error
+}
+
+type drpcManagement_TriggerUsedSpaceFilewalkerStream struct {
+	drpc.Stream
+}
+
+func (x *drpcManagement_TriggerUsedSpaceFilewalkerStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcManagement_TriggerUsedSpaceFilewalkerStream) SendAndClose(m *TriggerUsedSpaceFilewalkerResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCManagement_RotateAPIKeyStream interface {
+	drpc.Stream
+	SendAndClose(*RotateAPIKeyResponse) error
+}
+
+type drpcManagement_RotateAPIKeyStream struct {
+	drpc.Stream
+}
+
+func (x *drpcManagement_RotateAPIKeyStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcManagement_RotateAPIKeyStream) SendAndClose(m *RotateAPIKeyResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
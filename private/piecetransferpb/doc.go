@@ -0,0 +1,9 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package piecetransferpb contains protobuf definitions for node-to-node piece
+// transfers, where a storage node downloads a piece directly from another
+// storage node.
+package piecetransferpb
+
+//go:generate go run gen.go
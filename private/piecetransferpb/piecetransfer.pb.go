@@ -0,0 +1,159 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: piecetransfer.proto
+
+package piecetransferpb
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	pb "storj.io/common/pb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type TransferRequest struct {
+	// Signed GET_REPAIR order for the source node
+	SourceLimit *pb.AddressedOrderLimit `protobuf:"bytes,1,opt,name=source_limit,json=sourceLimit,proto3" json:"source_limit,omitempty"`
+	// Private piece key to use for fetching
+	SourcePrivateKey []byte `protobuf:"bytes,2,opt,name=source_private_key,json=sourcePrivateKey,proto3" json:"source_private_key,omitempty"`
+	// Signed PUT_REPAIR order for the destination node
+	DestinationLimit *pb.OrderLimit `protobuf:"bytes,3,opt,name=destination_limit,json=destinationLimit,proto3" json:"destination_limit,omitempty"`
+	// Private piece key to use for storing
+	DestinationPrivateKey []byte   `protobuf:"bytes,4,opt,name=destination_private_key,json=destinationPrivateKey,proto3" json:"destination_private_key,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *TransferRequest) Reset()         { *m = TransferRequest{} }
+func (m *TransferRequest) String() string { return proto.CompactTextString(m) }
+func (*TransferRequest) ProtoMessage()    {}
+func (*TransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73ee76d9df526979, []int{0}
+}
+func (m *TransferRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferRequest.Unmarshal(m, b)
+}
+func (m *TransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferRequest.Marshal(b, m, deterministic)
+}
+func (m *TransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRequest.Merge(m, src)
+}
+func (m *TransferRequest) XXX_Size() int {
+	return xxx_messageInfo_TransferRequest.Size(m)
+}
+func (m *TransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferRequest proto.InternalMessageInfo
+
+func (m *TransferRequest) GetSourceLimit() *pb.AddressedOrderLimit {
+	if m != nil {
+		return m.SourceLimit
+	}
+	return nil
+}
+
+func (m *TransferRequest) GetSourcePrivateKey() []byte {
+	if m != nil {
+		return m.SourcePrivateKey
+	}
+	return nil
+}
+
+func (m *TransferRequest) GetDestinationLimit() *pb.OrderLimit {
+	if m != nil {
+		return m.DestinationLimit
+	}
+	return nil
+}
+
+func (m *TransferRequest) GetDestinationPrivateKey() []byte {
+	if m != nil {
+		return m.DestinationPrivateKey
+	}
+	return nil
+}
+
+type TransferResponse struct {
+	// Hash of the stored piece, signed by the destination node
+	Hash                 *pb.PieceHash `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *TransferResponse) Reset()         { *m = TransferResponse{} }
+func (m *TransferResponse) String() string { return proto.CompactTextString(m) }
+func (*TransferResponse) ProtoMessage()    {}
+func (*TransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73ee76d9df526979, []int{1}
+}
+func (m *TransferResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferResponse.Unmarshal(m, b)
+}
+func (m *TransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferResponse.Marshal(b, m, deterministic)
+}
+func (m *TransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferResponse.Merge(m, src)
+}
+func (m *TransferResponse) XXX_Size() int {
+	return xxx_messageInfo_TransferResponse.Size(m)
+}
+func (m *TransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferResponse proto.InternalMessageInfo
+
+func (m *TransferResponse) GetHash() *pb.PieceHash {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TransferRequest)(nil), "piecetransfer.TransferRequest")
+	proto.RegisterType((*TransferResponse)(nil), "piecetransfer.TransferResponse")
+}
+
+func init() { proto.RegisterFile("piecetransfer.proto", fileDescriptor_73ee76d9df526979) }
+
+var fileDescriptor_73ee76d9df526979 = []byte{
+	// 297 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcf, 0x4b, 0xc3, 0x30,
+	0x14, 0xc7, 0xad, 0x0e, 0x91, 0xac, 0xd3, 0x2e, 0x22, 0x8e, 0x82, 0x3a, 0x0a, 0x4a, 0x0f, 0xd2,
+	0xc2, 0x04, 0xc1, 0x93, 0x3f, 0x4e, 0x82, 0x8a, 0xa3, 0x78, 0xf2, 0xe0, 0xc8, 0xd6, 0x37, 0x1a,
+	0x75, 0x4d, 0xcd, 0xcb, 0x84, 0xfd, 0xeb, 0x9e, 0xa4, 0x49, 0xb6, 0xa5, 0x82, 0xb7, 0xe4, 0x7d,
+	0xdf, 0xfb, 0x7e, 0xde, 0x0f, 0xb2, 0x5f, 0x71, 0x98, 0x80, 0x92, 0xac, 0xc4, 0x29, 0xc8, 0xa4,
+	0x92, 0x42, 0x09, 0xda, 0x69, 0x04, 0xc3, 0xdd, 0x19, 0x28, 0xc6, 0xcb, 0xa9, 0x30, 0x72, 0xe8,
+	0x0b, 0x99, 0x83, 0x44, 0xf3, 0x8b, 0x7e, 0x3c, 0xb2, 0xf7, 0x62, 0x53, 0x33, 0xf8, 0x9a, 0x03,
+	0x2a, 0x7a, 0x43, 0x7c, 0x14, 0x73, 0x39, 0x81, 0xd1, 0x27, 0x9f, 0x71, 0xd5, 0xf3, 0xfa, 0x5e,
+	0xdc, 0x1e, 0x1c, 0x25, 0x2b, 0xa3, 0xdb, 0x3c, 0x97, 0x80, 0x08, 0xf9, 0x73, 0x6d, 0xf5, 0x58,
+	0x27, 0x65, 0x6d, 0x53, 0xa2, 0x3f, 0xf4, 0x9c, 0x50, 0xeb, 0x50, 0x49, 0xfe, 0xcd, 0x14, 0x8c,
+	0x3e, 0x60, 0xd1, 0xdb, 0xec, 0x7b, 0xb1, 0x9f, 0x05, 0x46, 0x19, 0x1a, 0xe1, 0x01, 0x16, 0xf4,
+	0x9a, 0x74, 0x73, 0x40, 0xc5, 0x4b, 0xa6, 0xb8, 0x28, 0x2d, 0x74, 0x4b, 0x43, 0x69, 0x62, 0xbb,
+	0x75, 0x48, 0x81, 0x93, 0x6c, 0x70, 0x97, 0xe4, 0xd0, 0x35, 0x70, 0x99, 0x2d, 0xcd, 0x3c, 0x70,
+	0xe4, 0x35, 0x38, 0xba, 0x22, 0xc1, 0x7a, 0x76, 0xac, 0x44, 0x89, 0x40, 0x4f, 0x49, 0xab, 0x60,
+	0x58, 0xd8, 0xa1, 0xbb, 0x4b, 0xfe, 0xb0, 0xde, 0xe9, 0x3d, 0xc3, 0x22, 0xd3, 0xf2, 0xe0, 0x8d,
+	0x74, 0x74, 0x68, 0x59, 0x4f, 0x9f, 0xc8, 0xce, 0xea, 0x7d, 0x9c, 0x34, 0xef, 0xf2, 0x67, 0xc1,
+	0xe1, 0xc9, 0xbf, 0xba, 0x69, 0x22, 0xda, 0xb8, 0x8b, 0x5f, 0xcf, 0x50, 0x09, 0xf9, 0x9e, 0x70,
+	0x91, 0xea, 0x47, 0x6a, 0xa7, 0x4a, 0x1b, 0xa5, 0xd5, 0x78, 0xbc, 0xad, 0x0f, 0x79, 0xf1, 0x3b,
+	0x00, 0x8e, 0x53, 0x07, 0xbe, 0x0c, 0x02, 0x00, 0x00,
+}
@@ -0,0 +1,119 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.35-0.20250513201419-f7819ea69b55
+// source: piecetransfer.proto
+
+package piecetransferpb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_piecetransfer_proto struct{}
+
+func (drpcEncoding_File_piecetransfer_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_piecetransfer_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_piecetransfer_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_piecetransfer_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCPieceTransferClient interface {
+	DRPCConn() drpc.Conn
+
+	Transfer(ctx context.Context, in *TransferRequest) (*TransferResponse, error)
+}
+
+type drpcPieceTransferClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCPieceTransferClient(cc drpc.Conn) DRPCPieceTransferClient {
+	return &drpcPieceTransferClient{cc}
+}
+
+func (c *drpcPieceTransferClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcPieceTransferClient) Transfer(ctx context.Context, in *TransferRequest) (*TransferResponse, error) {
+	out := new(TransferResponse)
+	err := c.cc.Invoke(ctx, "/piecetransfer.PieceTransfer/Transfer", drpcEncoding_File_piecetransfer_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCPieceTransferServer interface {
+	Transfer(context.Context, *TransferRequest) (*TransferResponse, error)
+}
+
+type DRPCPieceTransferUnimplementedServer struct{}
+
+func (s *DRPCPieceTransferUnimplementedServer) Transfer(context.Context, *TransferRequest) (*TransferResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCPieceTransferDescription struct{}
+
+func (DRPCPieceTransferDescription) NumMethods() int { return 1 }
+
+func (DRPCPieceTransferDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/piecetransfer.PieceTransfer/Transfer", drpcEncoding_File_piecetransfer_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCPieceTransferServer).
+					Transfer(
+						ctx,
+						in1.(*TransferRequest),
+					)
+			}, DRPCPieceTransferServer.Transfer, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterPieceTransfer(mux drpc.Mux, impl DRPCPieceTransferServer) error {
+	return mux.Register(impl, DRPCPieceTransferDescription{})
+}
+
+type DRPCPieceTransfer_TransferStream interface {
+	drpc.Stream
+	SendAndClose(*TransferResponse) error
+}
+
+type drpcPieceTransfer_TransferStream struct {
+	drpc.Stream
+}
+
+func (x *drpcPieceTransfer_TransferStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcPieceTransfer_TransferStream) SendAndClose(m *TransferResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_piecetransfer_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
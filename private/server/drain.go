@@ -0,0 +1,112 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package server
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/drpc"
+	"storj.io/storj/shared/flightrecorder"
+)
+
+// drainHandler wraps a drpc.Handler to track in-flight RPCs, and to reject
+// new RPCs after draining is started.
+type drainHandler struct {
+	handler  drpc.Handler
+	recorder atomic.Pointer[flightrecorder.Box]
+
+	mu       sync.Mutex
+	draining bool
+	active   int
+	idle     chan struct{}
+}
+
+func newDrainHandler(handler drpc.Handler) *drainHandler {
+	return &drainHandler{
+		handler: handler,
+		idle:    make(chan struct{}),
+	}
+}
+
+// HandleRPC implements drpc.Handler.
+func (h *drainHandler) HandleRPC(stream drpc.Stream, rpc string) error {
+	if !h.start() {
+		return rpcstatus.Error(rpcstatus.Unavailable, "server is shutting down")
+	}
+	defer h.finish()
+
+	recorder := h.recorder.Load()
+	if recorder == nil {
+		return h.handler.HandleRPC(stream, rpc)
+	}
+
+	defer recorder.DumpOnPanic()
+	start := time.Now()
+	err := h.handler.HandleRPC(stream, rpc)
+	recorder.RecordDRPC(rpc, time.Since(start), err)
+	return err
+}
+
+func (h *drainHandler) start() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.draining {
+		return false
+	}
+	h.active++
+	return true
+}
+
+func (h *drainHandler) finish() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.active--
+	if h.draining && h.active == 0 {
+		close(h.idle)
+	}
+}
+
+// drain rejects the new RPCs and waits until the in-flight RPCs are finished.
+func (h *drainHandler) drain(ctx context.Context) error {
+	h.mu.Lock()
+	if !h.draining {
+		h.draining = true
+		if h.active == 0 {
+			close(h.idle)
+		}
+	}
+	h.mu.Unlock()
+
+	select {
+	case <-h.idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Drain stops accepting new RPCs and waits until the in-flight RPCs are finished
+// (or ctx is done). Listeners are kept open until Close, to reply to the new
+// requests with an Unavailable error.
+func (p *Server) Drain(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, endpoints := range []*endpointCollection{p.publicEndpointsAll, p.publicEndpointsReplaySafe, p.privateEndpoints} {
+		if err := endpoints.drain.drain(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetFlightRecorder records the served RPCs to the flight recorder.
+func (p *Server) SetFlightRecorder(recorder *flightrecorder.Box) {
+	for _, endpoints := range []*endpointCollection{p.publicEndpointsAll, p.publicEndpointsReplaySafe, p.privateEndpoints} {
+		endpoints.drain.recorder.Store(recorder)
+	}
+}
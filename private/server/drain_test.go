@@ -0,0 +1,54 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/testcontext"
+	"storj.io/drpc"
+)
+
+type blockingHandler struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (h *blockingHandler) HandleRPC(stream drpc.Stream, rpc string) error {
+	h.started <- struct{}{}
+	<-h.release
+	return nil
+}
+
+func TestDrainHandler(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	blocking := &blockingHandler{
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+	handler := newDrainHandler(blocking)
+
+	ctx.Go(func() error {
+		return handler.HandleRPC(nil, "inflight")
+	})
+	<-blocking.started
+
+	// in-flight request is not finished before the deadline
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, handler.drain(timeoutCtx), context.DeadlineExceeded)
+
+	// new requests are rejected during drain
+	err := handler.HandleRPC(nil, "new")
+	require.Equal(t, rpcstatus.Unavailable, rpcstatus.Code(err))
+
+	close(blocking.release)
+	require.NoError(t, handler.drain(ctx))
+}
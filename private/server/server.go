@@ -489,18 +489,21 @@ func (p *Server) Run(ctx context.Context) (err error) {
 }
 
 type endpointCollection struct {
-	mux  *drpcmux.Mux
-	drpc *drpcserver.Server
+	mux   *drpcmux.Mux
+	drain *drainHandler
+	drpc  *drpcserver.Server
 }
 
 func newEndpointCollection() *endpointCollection {
 	mux := drpcmux.New()
+	drain := newDrainHandler(mux)
 	return &endpointCollection{
-		mux: mux,
+		mux:   mux,
+		drain: drain,
 		drpc: drpcserver.NewWithOptions(
 			experiment.NewHandler(
 				rpctracing.NewHandler(
-					mux,
+					drain,
 					jaeger.RemoteTraceHandler),
 			),
 			drpcserver.Options{
@@ -19,6 +19,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/storj/multinode"
 	"storj.io/storj/multinode/console/server"
+	"storj.io/storj/multinode/metrics"
 	"storj.io/storj/multinode/multinodedb"
 )
 
@@ -98,6 +99,9 @@ func (planet *Planet) newMultinode(ctx context.Context, prefix string, index int
 			Address:   net.JoinHostPort(planet.config.Host, "0"),
 			StaticDir: filepath.Join(developmentRoot, "web/multinode/"),
 		},
+		Metrics: metrics.Config{
+			Interval: defaultInterval,
+		},
 	}
 	if planet.config.Reconfigure.Multinode != nil {
 		planet.config.Reconfigure.Multinode(index, &config)
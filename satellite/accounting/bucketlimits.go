@@ -0,0 +1,161 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"storj.io/common/memory"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+// ExceedsBucketUploadLimits returns whether the storage or segment limits of a
+// bucket have been exceeded. Supply nonzero headroom parameters to check if there
+// is room for a new object.
+//
+// Limits which aren't set are never exceeded.
+func (usage *Service) ExceedsBucketUploadLimits(
+	ctx context.Context, bucket metabase.BucketLocation, storageSizeHeadroom, segmentCountHeadroom int64, limits buckets.Limits,
+) (limit UploadLimit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if limits.Storage == nil && limits.Segments == nil {
+		return UploadLimit{}, nil
+	}
+
+	storageUsage, segmentUsage, err := usage.getBucketStorageAndSegmentUsage(ctx, bucket)
+	if err != nil {
+		return UploadLimit{}, err
+	}
+
+	if limits.Segments != nil {
+		limit.SegmentsLimit = *limits.Segments
+		limit.ExceedsSegments = (segmentUsage + segmentCountHeadroom) > limit.SegmentsLimit
+	}
+
+	if limits.Storage != nil {
+		limit.StorageLimit = memory.Size(*limits.Storage)
+		limit.ExceedsStorage = (storageUsage + storageSizeHeadroom) > limit.StorageLimit.Int64()
+	}
+
+	return limit, nil
+}
+
+// AddBucketUsageUpToLimit increases the segment and storage usage of a bucket
+// up to its limits. If any limit is exceeded, neither usage is increased and
+// ErrBucketLimitExceeded is returned.
+func (usage *Service) AddBucketUsageUpToLimit(ctx context.Context, bucket metabase.BucketLocation, storage, segments int64, limits buckets.Limits) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if limits.Storage == nil && limits.Segments == nil {
+		return nil
+	}
+
+	storageLimit, segmentLimit := int64(math.MaxInt64), int64(math.MaxInt64)
+	if limits.Storage != nil {
+		storageLimit = *limits.Storage
+	}
+	if limits.Segments != nil {
+		segmentLimit = *limits.Segments
+	}
+
+	err = usage.liveAccounting.AddBucketUsageUpToLimit(ctx, bucket, storage, segments, storageLimit, segmentLimit)
+	if ErrKeyNotFound.Has(err) {
+		// load the bucket usage into the cache and try again.
+		if _, _, err := usage.getBucketStorageAndSegmentUsage(ctx, bucket); err != nil {
+			return err
+		}
+		err = usage.liveAccounting.AddBucketUsageUpToLimit(ctx, bucket, storage, segments, storageLimit, segmentLimit)
+	}
+
+	return err
+}
+
+// UpdateBucketStorageAndSegmentUsage increments the storage and segment cache
+// keys of a bucket. It doesn't do anything if the bucket has no storage nor
+// segment limits.
+func (usage *Service) UpdateBucketStorageAndSegmentUsage(ctx context.Context, bucket metabase.BucketLocation, limits buckets.Limits, storageIncr, segmentIncr int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if limits.Storage == nil && limits.Segments == nil {
+		return nil
+	}
+
+	return usage.liveAccounting.UpdateBucketStorageAndSegmentUsage(ctx, bucket, storageIncr, segmentIncr)
+}
+
+// ExceedsBucketBandwidthUsage returns whether the bandwidth limit of a bucket has
+// been exceeded in the current month.
+//
+// A bucket without bandwidth limit never exceeds it.
+func (usage *Service) ExceedsBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, limits buckets.Limits) (bwLimit BandwidthLimit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if limits.Bandwidth == nil {
+		return BandwidthLimit{}, nil
+	}
+
+	bwLimit.Limit = memory.Size(*limits.Bandwidth)
+
+	now := usage.nowFn()
+	bandwidthUsage, err := usage.liveAccounting.GetBucketBandwidthUsage(ctx, bucket, now)
+	if err != nil {
+		if !ErrKeyNotFound.Has(err) {
+			return BandwidthLimit{}, ErrProjectUsage.Wrap(err)
+		}
+
+		year, month, _ := now.UTC().Date()
+		bandwidthUsage, err = usage.projectAccountingDB.GetBucketBandwidth(ctx, bucket, time.Date(year, month, 1, 0, 0, 0, 0, time.UTC), now)
+		if err != nil {
+			return BandwidthLimit{}, ErrProjectUsage.Wrap(err)
+		}
+
+		_, err = usage.liveAccounting.InsertBucketBandwidthUsage(ctx, bucket, bandwidthUsage, usage.bandwidthCacheTTL, now)
+		if err != nil {
+			return BandwidthLimit{}, ErrProjectUsage.Wrap(err)
+		}
+	}
+
+	bwLimit.Exceeds = bandwidthUsage >= bwLimit.Limit.Int64()
+	return bwLimit, nil
+}
+
+// UpdateBucketBandwidthUsage increments the bandwidth cache key of a bucket.
+// It doesn't do anything if the bucket has no bandwidth limit.
+func (usage *Service) UpdateBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, limits buckets.Limits, increment int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if limits.Bandwidth == nil {
+		return nil
+	}
+
+	return usage.liveAccounting.UpdateBucketBandwidthUsage(ctx, bucket, increment, usage.bandwidthCacheTTL, usage.nowFn())
+}
+
+// getBucketStorageAndSegmentUsage returns the storage and segment usage of a
+// bucket from the cache. The usage recorded by the latest bucket tally is
+// loaded into the cache when it isn't there.
+func (usage *Service) getBucketStorageAndSegmentUsage(ctx context.Context, bucket metabase.BucketLocation) (storage, segments int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	storage, segments, err = usage.liveAccounting.GetBucketStorageAndSegmentUsage(ctx, bucket)
+	if !ErrKeyNotFound.Has(err) {
+		return storage, segments, ErrProjectUsage.Wrap(err)
+	}
+
+	storage, segments, err = usage.projectAccountingDB.GetLatestBucketTally(ctx, bucket)
+	if err != nil {
+		return 0, 0, ErrProjectUsage.Wrap(err)
+	}
+
+	_, err = usage.liveAccounting.InsertBucketStorageAndSegmentUsage(ctx, bucket, storage, segments, usage.bucketUsageCacheTTL)
+	if err != nil {
+		return 0, 0, ErrProjectUsage.Wrap(err)
+	}
+
+	return storage, segments, nil
+}
@@ -349,6 +349,10 @@ type ProjectAccounting interface {
 	GetBucketsWithEntitlementsInRange(ctx context.Context, from, to metabase.BucketLocation, projectScopePrefix string) ([]BucketLocationWithEntitlements, error)
 	// GetProjectSettledBandwidthTotal returns the sum of GET bandwidth usage settled for a projectID in the past time frame.
 	TestingGetProjectSettledBandwidthTotal(ctx context.Context, projectID uuid.UUID, from time.Time) (_ int64, err error)
+	// GetBucketBandwidth returns the GET bandwidth allocated for a bucket in the specified time frame.
+	GetBucketBandwidth(ctx context.Context, bucket metabase.BucketLocation, from, to time.Time) (int64, error)
+	// GetLatestBucketTally returns the storage and segment usage of a bucket recorded by its latest tally.
+	GetLatestBucketTally(ctx context.Context, bucket metabase.BucketLocation) (storage, segments int64, err error)
 	// GetProjectBandwidth returns project allocated bandwidth for the specified year, month and day.
 	GetProjectBandwidth(ctx context.Context, projectID uuid.UUID, year int, month time.Month, day int, asOfSystemInterval time.Duration) (int64, error)
 	// GetProjectDailyBandwidth returns bandwidth (allocated and settled) for the specified day.
@@ -448,6 +452,27 @@ type Cache interface {
 	GetProjectNotificationFlags(ctx context.Context, projectID uuid.UUID) (int, error)
 	// UpdateProjectNotificationFlags sets the notification_flags for the project in the cache.
 	UpdateProjectNotificationFlags(ctx context.Context, projectID uuid.UUID, flags int) error
+	// GetBucketStorageAndSegmentUsage returns the bucket's storage and segment usage.
+	// It returns ErrKeyNotFound if the bucket's usage isn't in the cache.
+	GetBucketStorageAndSegmentUsage(ctx context.Context, bucket metabase.BucketLocation) (storage, segments int64, err error)
+	// InsertBucketStorageAndSegmentUsage inserts the bucket's storage and segment usage
+	// with the ttl if it doesn't exist. It returns true if it's inserted, otherwise false.
+	InsertBucketStorageAndSegmentUsage(ctx context.Context, bucket metabase.BucketLocation, storage, segments int64, ttl time.Duration) (inserted bool, err error)
+	// AddBucketUsageUpToLimit increases the bucket's storage and segment usage up to the limits.
+	// If any limit is exceeded, neither usage is increased and ErrBucketLimitExceeded is returned.
+	// It returns ErrKeyNotFound if the bucket's usage isn't in the cache.
+	AddBucketUsageUpToLimit(ctx context.Context, bucket metabase.BucketLocation, storage, segments, storageLimit, segmentLimit int64) error
+	// UpdateBucketStorageAndSegmentUsage updates the bucket's storage and segment usage by
+	// increasing it. It doesn't do anything if the bucket's usage isn't in the cache.
+	UpdateBucketStorageAndSegmentUsage(ctx context.Context, bucket metabase.BucketLocation, storageIncrement, segmentIncrement int64) error
+	// GetBucketBandwidthUsage returns the bucket's bandwidth usage.
+	GetBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, now time.Time) (currentUsed int64, err error)
+	// InsertBucketBandwidthUsage inserts a bucket bandwidth usage if it
+	// doesn't exist. It returns true if it's inserted, otherwise false.
+	InsertBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, value int64, ttl time.Duration, now time.Time) (inserted bool, _ error)
+	// UpdateBucketBandwidthUsage updates the bucket's bandwidth usage increasing
+	// it. The key is inserted with the increment when it doesn't exists.
+	UpdateBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, increment int64, ttl time.Duration, now time.Time) error
 	// Close the client, releasing any open resources. Once it's called any other
 	// method must be called.
 	Close() error
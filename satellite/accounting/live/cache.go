@@ -23,10 +23,11 @@ var (
 
 // Config contains configurable values for the live accounting service.
 type Config struct {
-	StorageBackend     string        `help:"what to use for storing real-time accounting data"`
-	BandwidthCacheTTL  time.Duration `default:"5m" help:"bandwidth cache key time to live"`
-	AsOfSystemInterval time.Duration `default:"-10s" devDefault:"-1us" testDefault:"-1us" help:"as of system interval"`
-	BatchSize          int           `default:"5000" help:"how much projects usage should be requested from redis cache at once"`
+	StorageBackend      string        `help:"what to use for storing real-time accounting data"`
+	BandwidthCacheTTL   time.Duration `default:"5m" help:"bandwidth cache key time to live"`
+	BucketUsageCacheTTL time.Duration `default:"1h" help:"bucket storage and segment usage cache key time to live; once expired, it's reloaded from the latest bucket tally"`
+	AsOfSystemInterval  time.Duration `default:"-10s" devDefault:"-1us" testDefault:"-1us" help:"as of system interval"`
+	BatchSize           int           `default:"5000" help:"how much projects usage should be requested from redis cache at once"`
 }
 
 // OpenCache creates a new accounting.Cache instance using the type specified backend in
@@ -9,6 +9,7 @@ import (
 
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/metabase"
 )
 
 type noopCache struct {
@@ -81,6 +82,41 @@ func (noopCache) UpdateProjectNotificationFlags(ctx context.Context, projectID u
 	return nil
 }
 
+// GetBucketStorageAndSegmentUsage noop method.
+func (noopCache) GetBucketStorageAndSegmentUsage(ctx context.Context, bucket metabase.BucketLocation) (storage, segments int64, err error) {
+	return 0, 0, nil
+}
+
+// InsertBucketStorageAndSegmentUsage noop method.
+func (noopCache) InsertBucketStorageAndSegmentUsage(ctx context.Context, bucket metabase.BucketLocation, storage, segments int64, ttl time.Duration) (inserted bool, err error) {
+	return true, nil
+}
+
+// AddBucketUsageUpToLimit noop method.
+func (noopCache) AddBucketUsageUpToLimit(ctx context.Context, bucket metabase.BucketLocation, storage, segments, storageLimit, segmentLimit int64) error {
+	return nil
+}
+
+// UpdateBucketStorageAndSegmentUsage noop method.
+func (noopCache) UpdateBucketStorageAndSegmentUsage(ctx context.Context, bucket metabase.BucketLocation, storageIncrement, segmentIncrement int64) error {
+	return nil
+}
+
+// GetBucketBandwidthUsage noop method.
+func (noopCache) GetBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, now time.Time) (currentUsed int64, err error) {
+	return 0, nil
+}
+
+// InsertBucketBandwidthUsage noop method.
+func (noopCache) InsertBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, value int64, ttl time.Duration, now time.Time) (inserted bool, _ error) {
+	return true, nil
+}
+
+// UpdateBucketBandwidthUsage noop method.
+func (noopCache) UpdateBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, increment int64, ttl time.Duration, now time.Time) error {
+	return nil
+}
+
 // Close noop method.
 func (noopCache) Close() error {
 	return nil
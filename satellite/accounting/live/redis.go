@@ -15,6 +15,7 @@ import (
 
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/metabase"
 )
 
 // ErrGetProjectLimitCache error for getting project limits from cache.
@@ -409,6 +410,179 @@ func createBandwidthProjectIDKey(projectID uuid.UUID, now time.Time) string {
 	return string(projectID[:]) + string(byte(month)) + string(byte(day)) + ":bandwidth"
 }
 
+// GetBucketStorageAndSegmentUsage gets the storage and segment usage of a bucket.
+func (cache *redisLiveAccounting) GetBucketStorageAndSegmentUsage(ctx context.Context, bucket metabase.BucketLocation) (storage, segments int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	key := createBucketUsageKey(bucket)
+	results, err := cache.client.HMGet(ctx, key, "storage", "segments").Result()
+	if err != nil {
+		return 0, 0, accounting.ErrSystemOrNetError.New("Redis hmget failed: %w", err)
+	}
+
+	if len(results) != 2 {
+		return 0, 0, accounting.ErrUnexpectedValue.New("wrong number of results: got %d wants %d", len(results), 2)
+	}
+
+	if results[0] == nil || results[1] == nil {
+		return 0, 0, accounting.ErrKeyNotFound.New("%q", key)
+	}
+
+	storage, err = parseAnyAsInt64(results[0])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	segments, err = parseAnyAsInt64(results[1])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return storage, segments, nil
+}
+
+// InsertBucketStorageAndSegmentUsage inserts the storage and segment usage of a
+// bucket if it doesn't exist. It returns true if it's inserted, otherwise false.
+func (cache *redisLiveAccounting) InsertBucketStorageAndSegmentUsage(ctx context.Context, bucket metabase.BucketLocation, storage, segments int64, ttl time.Duration) (inserted bool, err error) {
+	defer mon.Task()(&ctx, storage, segments, ttl)(&err)
+
+	// The following script will set the cache key to the specific values with
+	// an expiration time to live when it doesn't exist, otherwise it ignores it.
+	script := redis.NewScript(`if redis.call("exists", KEYS[1]) == 1 then
+		return 0
+	end
+
+	redis.call("hset", KEYS[1], "storage", ARGV[1], "segments", ARGV[2])
+	redis.call("expire", KEYS[1], ARGV[3])
+	return 1
+	`)
+
+	insert, err := script.Run(ctx, cache.client, []string{createBucketUsageKey(bucket)}, storage, segments, int(ttl.Seconds())).Int()
+	if err != nil {
+		return false, accounting.ErrSystemOrNetError.New("Redis eval failed: %w", err)
+	}
+
+	return insert == 1, nil
+}
+
+// AddBucketUsageUpToLimit increases the storage and segment usage of a bucket
+// up to the limits. If any limit is exceeded, neither usage is increased and
+// accounting.ErrBucketLimitExceeded is returned.
+func (cache *redisLiveAccounting) AddBucketUsageUpToLimit(ctx context.Context, bucket metabase.BucketLocation, storage, segments, storageLimit, segmentLimit int64) (err error) {
+	defer mon.Task()(&ctx, storage, segments)(&err)
+
+	// The following script checks both limits before increasing any usage, so
+	// there is nothing to roll back when one of them is exceeded.
+	// It returns -1 when the key doesn't exist, 1 when the storage limit is
+	// exceeded, 2 when the segment limit is exceeded and 0 otherwise.
+	script := redis.NewScript(`local usage = redis.call("hmget", KEYS[1], "storage", "segments")
+	if not usage[1] or not usage[2] then
+		return -1
+	end
+	if tonumber(usage[1]) + tonumber(ARGV[1]) > tonumber(ARGV[3]) then
+		return 1
+	end
+	if tonumber(usage[2]) + tonumber(ARGV[2]) > tonumber(ARGV[4]) then
+		return 2
+	end
+
+	redis.call("hincrby", KEYS[1], "storage", ARGV[1])
+	redis.call("hincrby", KEYS[1], "segments", ARGV[2])
+	return 0
+	`)
+
+	key := createBucketUsageKey(bucket)
+	result, err := script.Run(ctx, cache.client, []string{key}, storage, segments, storageLimit, segmentLimit).Int()
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("Redis eval failed: %w", err)
+	}
+
+	switch result {
+	case -1:
+		return accounting.ErrKeyNotFound.New("%q", key)
+	case 1:
+		return accounting.ErrBucketLimitExceeded.New("Additional storage of %d bytes exceeds bucket limit of %d", storage, storageLimit)
+	case 2:
+		return accounting.ErrBucketLimitExceeded.New("Additional %d segments exceed bucket limit of %d", segments, segmentLimit)
+	}
+
+	return nil
+}
+
+// UpdateBucketStorageAndSegmentUsage increments the storage and segment usage
+// of a bucket if it exists in the cache.
+func (cache *redisLiveAccounting) UpdateBucketStorageAndSegmentUsage(ctx context.Context, bucket metabase.BucketLocation, storageIncrement, segmentIncrement int64) (err error) {
+	defer mon.Task()(&ctx, storageIncrement, segmentIncrement)(&err)
+
+	// The key isn't created when it doesn't exist because it would be created
+	// without the usage stored before and without expiration.
+	script := redis.NewScript(`if redis.call("exists", KEYS[1]) == 0 then
+		return 0
+	end
+
+	redis.call("hincrby", KEYS[1], "storage", ARGV[1])
+	redis.call("hincrby", KEYS[1], "segments", ARGV[2])
+	return 1
+	`)
+
+	err = script.Run(ctx, cache.client, []string{createBucketUsageKey(bucket)}, storageIncrement, segmentIncrement).Err()
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("Redis eval failed: %w", err)
+	}
+
+	return nil
+}
+
+// GetBucketBandwidthUsage returns the current bandwidth usage of a bucket.
+func (cache *redisLiveAccounting) GetBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, now time.Time) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, now)(&err)
+
+	return cache.getInt64(ctx, createBandwidthBucketKey(bucket, now))
+}
+
+// InsertBucketBandwidthUsage inserts a bucket bandwidth usage if it doesn't
+// exist. It returns true if it's inserted, otherwise false.
+func (cache *redisLiveAccounting) InsertBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, value int64, ttl time.Duration, now time.Time) (inserted bool, err error) {
+	defer mon.Task()(&ctx, value, ttl, now)(&err)
+
+	script := redis.NewScript(`local inserted
+	inserted = redis.call("setnx", KEYS[1], ARGV[1])
+	if tonumber(inserted) == 1 then
+		redis.call("expire",KEYS[1], ARGV[2])
+	end
+
+	return inserted
+	`)
+
+	insert, err := script.Run(ctx, cache.client, []string{createBandwidthBucketKey(bucket, now)}, value, int(ttl.Seconds())).Int()
+	if err != nil {
+		return false, accounting.ErrSystemOrNetError.New("Redis eval failed: %w", err)
+	}
+
+	return insert == 1, nil
+}
+
+// UpdateBucketBandwidthUsage increments the bandwidth cache key value of a bucket.
+func (cache *redisLiveAccounting) UpdateBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, increment int64, ttl time.Duration, now time.Time) (err error) {
+	defer mon.Task()(&ctx, increment, ttl, now)(&err)
+
+	// See UpdateProjectBandwidthUsage for the details of the script.
+	script := redis.NewScript(`local current
+	current = redis.call("incrby", KEYS[1], ARGV[1])
+	if tonumber(current) == tonumber(ARGV[1]) then
+		redis.call("expire", KEYS[1], ARGV[2])
+	end
+	return current
+	`)
+
+	err = script.Run(ctx, cache.client, []string{createBandwidthBucketKey(bucket, now)}, increment, int(ttl.Seconds())).Err()
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("Redis eval failed: %w", err)
+	}
+
+	return nil
+}
+
 // GetProjectNotificationFlags returns the cached notification_flags for the project.
 // Returns error if the key does not exist in the cache.
 func (cache *redisLiveAccounting) GetProjectNotificationFlags(ctx context.Context, projectID uuid.UUID) (_ int, err error) {
@@ -454,6 +628,18 @@ func createStorageProjectIDKey(projectID uuid.UUID) string {
 	return string(projectID[:])
 }
 
+// createBucketUsageKey creates the key of the hash which holds the storage and segment usage of a bucket.
+func createBucketUsageKey(bucket metabase.BucketLocation) string {
+	return string(bucket.ProjectID[:]) + "/" + string(bucket.BucketName) + ":bucketusage"
+}
+
+// createBandwidthBucketKey creates the bandwidth bucket key.
+// The current month is combined with the bucket location to create a prefix.
+func createBandwidthBucketKey(bucket metabase.BucketLocation, now time.Time) string {
+	_, month, day := now.Date()
+	return string(bucket.ProjectID[:]) + "/" + string(bucket.BucketName) + string(byte(month)) + string(byte(day)) + ":bucketbandwidth"
+}
+
 // createNotificationFlagsProjectIDKey creates the notification flags project key.
 func createNotificationFlagsProjectIDKey(projectID uuid.UUID) string {
 	return string(projectID[:]) + ":notificationflags"
@@ -30,6 +30,9 @@ var ErrProjectUsage = errs.Class("project usage")
 // ErrProjectLimitExceeded is used when the configured limits of a project are reached.
 var ErrProjectLimitExceeded = errs.Class("project limit")
 
+// ErrBucketLimitExceeded is used when the configured limits of a bucket are reached.
+var ErrBucketLimitExceeded = errs.Class("bucket limit")
+
 // Service is handling project usage related logic.
 //
 // architecture: Service
@@ -39,6 +42,7 @@ type Service struct {
 	liveAccounting      Cache
 	metabaseDB          metabase.DB
 	bandwidthCacheTTL   time.Duration
+	bucketUsageCacheTTL time.Duration
 	nowFn               func() time.Time
 
 	defaultMaxStorage   memory.Size
@@ -48,7 +52,7 @@ type Service struct {
 }
 
 // NewService created new instance of project usage service.
-func NewService(log *zap.Logger, projectAccountingDB ProjectAccounting, liveAccounting Cache, metabaseDB metabase.DB, bandwidthCacheTTL time.Duration,
+func NewService(log *zap.Logger, projectAccountingDB ProjectAccounting, liveAccounting Cache, metabaseDB metabase.DB, bandwidthCacheTTL, bucketUsageCacheTTL time.Duration,
 	defaultMaxStorage, defaultMaxBandwidth memory.Size, defaultMaxSegments int64, asOfSystemInterval time.Duration) *Service {
 	return &Service{
 		log:                 log,
@@ -56,6 +60,7 @@ func NewService(log *zap.Logger, projectAccountingDB ProjectAccounting, liveAcco
 		liveAccounting:      liveAccounting,
 		metabaseDB:          metabaseDB,
 		bandwidthCacheTTL:   bandwidthCacheTTL,
+		bucketUsageCacheTTL: bucketUsageCacheTTL,
 
 		defaultMaxStorage:   defaultMaxStorage,
 		defaultMaxBandwidth: defaultMaxBandwidth,
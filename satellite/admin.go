@@ -35,6 +35,7 @@ import (
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/stripe"
+	"storj.io/storj/shared/flightrecorder"
 )
 
 // Admin is the satellite core process that runs chores.
@@ -107,7 +108,7 @@ type Admin struct {
 
 // NewAdmin creates a new satellite admin peer.
 func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *metabase.DB,
-	liveAccounting accounting.Cache, versionInfo version.Info, config *Config, atomicLogLevel *zap.AtomicLevel) (*Admin, error) {
+	liveAccounting accounting.Cache, versionInfo version.Info, config *Config, atomicLogLevel *zap.AtomicLevel, recorder *flightrecorder.Box) (*Admin, error) {
 	peer := &Admin{
 		Log:        log,
 		Identity:   full,
@@ -143,7 +144,13 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 		}
 		debugConfig := config.Debug
 		debugConfig.ControlTitle = "Admin"
-		peer.Debug.Server = debug.NewServerWithAtomicLevel(log.Named("debug"), peer.Debug.Listener, monkit.Default, debugConfig, atomicLogLevel)
+
+		var extensions []debug.Extension
+		if recorder != nil {
+			extensions = append(extensions, recorder)
+		}
+
+		peer.Debug.Server = debug.NewServerWithAtomicLevel(log.Named("debug"), peer.Debug.Listener, monkit.Default, debugConfig, atomicLogLevel, extensions...)
 		peer.Servers.Add(lifecycle.Item{
 			Name:  "debug",
 			Run:   peer.Debug.Server.Run,
@@ -246,6 +253,7 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 				BillingDB:            peer.DB.Billing(),
 				ProjectsDB:           peer.DB.Console().Projects(),
 				UsersDB:              peer.DB.Console().Users(),
+				OrganizationsDB:      peer.DB.Console().Organizations(),
 				UsageDB:              peer.DB.ProjectAccounting(),
 				RetentionRemainderDB: peer.DB.RetentionRemainderCharges(),
 				Analytics:            peer.Analytics.Service,
@@ -289,6 +297,7 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 			peer.LiveAccounting.Cache,
 			*metabaseDB,
 			config.LiveAccounting.BandwidthCacheTTL,
+			config.LiveAccounting.BucketUsageCacheTTL,
 			config.Console.Config.UsageLimits.Storage.Free,
 			config.Console.Config.UsageLimits.Bandwidth.Free,
 			config.Console.Config.UsageLimits.Segment.Free,
@@ -352,6 +361,7 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 			log.Named("admin:service"),
 			peer.DB.Console(),
 			peer.DB.AdminChangeHistory(),
+			peer.DB.AdminApprovals(),
 			db.Attribution(),
 			peer.DB.ProjectAccounting(),
 			peer.Accounting.Service,
@@ -398,6 +408,14 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 			Run:   peer.Admin.Server.Run,
 			Close: peer.Admin.Server.Close,
 		})
+
+		if recorder != nil {
+			peer.Admin.Server.SetFlightRecorder(recorder)
+			peer.Services.Add(lifecycle.Item{
+				Name: "flightrecorder",
+				Run:  recorder.Run,
+			})
+		}
 	}
 
 	return peer, nil
@@ -0,0 +1,437 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/private/api"
+	"storj.io/storj/satellite/admin/approvals"
+	"storj.io/storj/satellite/admin/changehistory"
+)
+
+// These constants are the operations that can be configured to require the approval of a second
+// administrator before they take effect.
+const (
+	OperationDisableProject             = "disable_project"
+	OperationMarkProjectPendingDeletion = "mark_project_pending_deletion"
+	OperationDisableUser                = "disable_user"
+	OperationMarkUserPendingDeletion    = "mark_user_pending_deletion"
+	OperationRevokeUserLicense          = "revoke_user_license"
+	OperationDeleteUserLicense          = "delete_user_license"
+)
+
+// These constants are the change history operations that record the approval trail of an
+// operation which requires approval.
+const (
+	approvalRequestedOperation = "approval_requested"
+	approvalGrantedOperation   = "approval_granted"
+	approvalRejectedOperation  = "approval_rejected"
+	approvalExpiredOperation   = "approval_expired"
+)
+
+// ApprovalConfig configures the two-person approval workflow of destructive operations.
+type ApprovalConfig struct {
+	Enabled    bool          `help:"whether the configured operations require the approval of a second administrator" default:"false"`
+	Operations []string      `help:"the operations that require approval when the approval workflow is enabled" default:"disable_project,mark_project_pending_deletion,disable_user,mark_user_pending_deletion,revoke_user_license,delete_user_license"`
+	Expiration time.Duration `help:"how long an approval request stays pending before it expires" default:"72h"`
+}
+
+// ApprovalRequest is an operation that is pending the approval of a second administrator.
+type ApprovalRequest struct {
+	ID          uuid.UUID              `json:"id"`
+	Operation   string                 `json:"operation"`
+	ItemType    changehistory.ItemType `json:"itemType"`
+	UserID      uuid.UUID              `json:"userID"`
+	ProjectID   *uuid.UUID             `json:"projectID,omitempty"`
+	RequestedBy string                 `json:"requestedBy"`
+	Reason      string                 `json:"reason"`
+	Details     map[string]any         `json:"details"`
+	CreatedAt   time.Time              `json:"createdAt"`
+	ExpiresAt   time.Time              `json:"expiresAt"`
+}
+
+// ApprovalDecisionRequest represents a request to approve or reject an approval request.
+type ApprovalDecisionRequest struct {
+	Reason string `json:"reason"`
+}
+
+// approvalPermissions are the permissions that the approver of an operation must have in
+// addition to PermApproveOperations.
+var approvalPermissions = map[string][]Permission{
+	OperationDisableProject:             {PermProjectDeleteNoData},
+	OperationMarkProjectPendingDeletion: {PermProjectMarkPendingDeletion},
+	OperationDisableUser:                {PermAccountDeleteNoData},
+	OperationMarkUserPendingDeletion:    {PermAccountDeleteWithData, PermAccountMarkPendingDeletion},
+	OperationRevokeUserLicense:          {PermAccountChangeLicenses},
+	OperationDeleteUserLicense:          {PermAccountChangeLicenses},
+}
+
+type approvedOperationKey struct{}
+
+// withApprovedOperation marks ctx as the context of an operation that has been approved.
+func withApprovedOperation(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, approvedOperationKey{}, id)
+}
+
+// isApprovedOperation returns whether ctx is the context of an operation that has been approved.
+func isApprovedOperation(ctx context.Context) bool {
+	_, ok := ctx.Value(approvedOperationKey{}).(uuid.UUID)
+	return ok
+}
+
+// requiresApproval returns whether operation requires the approval of a second administrator.
+func (s *Service) requiresApproval(ctx context.Context, operation string) bool {
+	if !s.adminConfig.Approval.Enabled || isApprovedOperation(ctx) {
+		return false
+	}
+
+	return slices.Contains(s.adminConfig.Approval.Operations, operation)
+}
+
+// requestApproval creates a pending approval request for an operation that cannot be performed
+// until another administrator approves it. payload is the request of the operation, which is
+// performed with it when the approval request is approved.
+func (s *Service) requestApproval(
+	ctx context.Context, authInfo *AuthInfo, request ApprovalRequest, payload any,
+) (*ApprovalRequest, api.HTTPError) {
+	apiError := func(status int, err error) (*ApprovalRequest, api.HTTPError) {
+		return nil, api.HTTPError{
+			Status: status, Err: Error.Wrap(err),
+		}
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return apiError(http.StatusInternalServerError, err)
+	}
+
+	rawPayload, err := json.Marshal(payload)
+	if err != nil {
+		return apiError(http.StatusInternalServerError, err)
+	}
+
+	now := s.nowFn()
+	request.ID = id
+	request.RequestedBy = authInfo.Email
+	request.ExpiresAt = now.Add(s.adminConfig.Approval.Expiration)
+
+	created, err := s.approvalsDB.Create(ctx, approvals.Request{
+		ID:          request.ID,
+		Operation:   request.Operation,
+		ItemType:    request.ItemType,
+		UserID:      request.UserID,
+		ProjectID:   request.ProjectID,
+		RequestedBy: request.RequestedBy,
+		Reason:      request.Reason,
+		Details:     request.Details,
+		Payload:     rawPayload,
+		ExpiresAt:   request.ExpiresAt,
+	})
+	if err != nil {
+		return apiError(http.StatusInternalServerError, err)
+	}
+	request = approvalRequestFromDB(*created)
+
+	err = s.logApprovalChange(ctx, request, authInfo.Email, approvalRequestedOperation, request.Reason)
+	if err != nil {
+		s.log.Error("failed to log approval request",
+			zap.Stringer("request_id", request.ID),
+			zap.Error(err),
+		)
+	}
+
+	return &request, api.HTTPError{}
+}
+
+// GetApprovalRequests returns the approval requests that are pending.
+func (s *Service) GetApprovalRequests(ctx context.Context) ([]ApprovalRequest, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	pending, err := s.approvalsDB.List(ctx)
+	if err != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusInternalServerError,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	now := s.nowFn()
+	requests := make([]ApprovalRequest, 0, len(pending))
+	for _, p := range pending {
+		if !p.ExpiresAt.After(now) {
+			s.expireApprovalRequest(ctx, p)
+			continue
+		}
+		requests = append(requests, approvalRequestFromDB(p))
+	}
+
+	return requests, api.HTTPError{}
+}
+
+// ApproveRequest approves a pending approval request and performs its operation.
+//
+// The approver must be a different administrator than the one who requested the operation and
+// they must have the permissions required to perform the operation. The approval request stays
+// pending if the operation fails.
+func (s *Service) ApproveRequest(ctx context.Context, authInfo *AuthInfo, requestID uuid.UUID, request ApprovalDecisionRequest) api.HTTPError {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	apiError := func(status int, err error) api.HTTPError {
+		return api.HTTPError{
+			Status: status, Err: Error.Wrap(err),
+		}
+	}
+
+	if authInfo == nil || len(authInfo.Groups) == 0 {
+		return apiError(http.StatusUnauthorized, errs.New("not authorized"))
+	}
+
+	if request.Reason == "" {
+		return apiError(http.StatusBadRequest, errs.New("reason is required"))
+	}
+
+	pending, apiErr := s.getApprovalRequest(ctx, requestID)
+	if apiErr.Err != nil {
+		return apiErr
+	}
+
+	if strings.EqualFold(pending.RequestedBy, authInfo.Email) {
+		return apiError(http.StatusForbidden, errs.New("an approval request cannot be approved by its requester"))
+	}
+	perms, ok := approvalPermissions[pending.Operation]
+	if !ok {
+		return apiError(http.StatusInternalServerError, errs.New("unknown operation %q", pending.Operation))
+	}
+	if !s.authorizer.GroupsHavePerms(authInfo.Groups, append([]Permission{PermApproveOperations}, perms...)...) {
+		return apiError(http.StatusForbidden, errs.New("not authorized to approve %s", pending.Operation))
+	}
+
+	// The request is claimed by deleting it, so that concurrent approvals can't perform
+	// the operation more than once.
+	claimed, err := s.approvalsDB.Delete(ctx, requestID)
+	if err != nil {
+		return apiError(http.StatusInternalServerError, err)
+	}
+	if !claimed {
+		return apiError(http.StatusNotFound, errs.New("approval request not found"))
+	}
+
+	apiErr = s.performApprovedOperation(withApprovedOperation(ctx, requestID), authInfo, *pending)
+	if apiErr.Err != nil {
+		if _, err := s.approvalsDB.Create(ctx, *pending); err != nil {
+			s.log.Error("failed to restore approval request after the operation failed",
+				zap.Stringer("request_id", requestID),
+				zap.Error(err),
+			)
+		}
+		return apiErr
+	}
+
+	err = s.logApprovalChange(ctx, approvalRequestFromDB(*pending), authInfo.Email, approvalGrantedOperation, request.Reason)
+	if err != nil {
+		s.log.Error("failed to log approval of request",
+			zap.Stringer("request_id", requestID),
+			zap.Error(err),
+		)
+	}
+
+	return api.HTTPError{}
+}
+
+// RejectRequest rejects a pending approval request, so its operation is never performed.
+//
+// The requester can reject their own request to withdraw it.
+func (s *Service) RejectRequest(ctx context.Context, authInfo *AuthInfo, requestID uuid.UUID, request ApprovalDecisionRequest) api.HTTPError {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	apiError := func(status int, err error) api.HTTPError {
+		return api.HTTPError{
+			Status: status, Err: Error.Wrap(err),
+		}
+	}
+
+	if authInfo == nil || len(authInfo.Groups) == 0 {
+		return apiError(http.StatusUnauthorized, errs.New("not authorized"))
+	}
+
+	if request.Reason == "" {
+		return apiError(http.StatusBadRequest, errs.New("reason is required"))
+	}
+
+	pending, apiErr := s.getApprovalRequest(ctx, requestID)
+	if apiErr.Err != nil {
+		return apiErr
+	}
+
+	deleted, err := s.approvalsDB.Delete(ctx, requestID)
+	if err != nil {
+		return apiError(http.StatusInternalServerError, err)
+	}
+	if !deleted {
+		return apiError(http.StatusNotFound, errs.New("approval request not found"))
+	}
+
+	err = s.logApprovalChange(ctx, approvalRequestFromDB(*pending), authInfo.Email, approvalRejectedOperation, request.Reason)
+	if err != nil {
+		return apiError(http.StatusInternalServerError, err)
+	}
+
+	return api.HTTPError{}
+}
+
+// getApprovalRequest returns the pending approval request with id. It returns an error with
+// http.StatusGone status if the request expired.
+func (s *Service) getApprovalRequest(ctx context.Context, id uuid.UUID) (*approvals.Request, api.HTTPError) {
+	pending, err := s.approvalsDB.Get(ctx, id)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, sql.ErrNoRows) {
+			status = http.StatusNotFound
+			err = errs.New("approval request not found")
+		}
+		return nil, api.HTTPError{
+			Status: status,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	if !pending.ExpiresAt.After(s.nowFn()) {
+		s.expireApprovalRequest(ctx, *pending)
+		return nil, api.HTTPError{
+			Status: http.StatusGone,
+			Err:    Error.New("approval request expired"),
+		}
+	}
+
+	return pending, api.HTTPError{}
+}
+
+// performApprovedOperation performs the operation of an approval request with the request
+// stored in its payload.
+func (s *Service) performApprovedOperation(ctx context.Context, authInfo *AuthInfo, pending approvals.Request) api.HTTPError {
+	apiError := func(status int, err error) api.HTTPError {
+		return api.HTTPError{
+			Status: status, Err: Error.Wrap(err),
+		}
+	}
+
+	decode := func(v any) api.HTTPError {
+		if err := json.Unmarshal(pending.Payload, v); err != nil {
+			return apiError(http.StatusInternalServerError, err)
+		}
+		return api.HTTPError{}
+	}
+
+	switch pending.Operation {
+	case OperationDisableProject, OperationMarkProjectPendingDeletion:
+		if pending.ProjectID == nil {
+			return apiError(http.StatusInternalServerError, errs.New("approval request has no project"))
+		}
+		var request DisableProjectRequest
+		if apiErr := decode(&request); apiErr.Err != nil {
+			return apiErr
+		}
+		_, apiErr := s.DisableProject(ctx, authInfo, *pending.ProjectID, request)
+		return apiErr
+	case OperationDisableUser, OperationMarkUserPendingDeletion:
+		var request DisableUserRequest
+		if apiErr := decode(&request); apiErr.Err != nil {
+			return apiErr
+		}
+		_, apiErr := s.DisableUser(ctx, authInfo, pending.UserID, request)
+		return apiErr
+	case OperationRevokeUserLicense:
+		var request RevokeLicenseRequest
+		if apiErr := decode(&request); apiErr.Err != nil {
+			return apiErr
+		}
+		_, apiErr := s.RevokeUserLicense(ctx, authInfo, pending.UserID, request)
+		return apiErr
+	case OperationDeleteUserLicense:
+		var request DeleteLicenseRequest
+		if apiErr := decode(&request); apiErr.Err != nil {
+			return apiErr
+		}
+		_, apiErr := s.DeleteUserLicense(ctx, authInfo, pending.UserID, request)
+		return apiErr
+	default:
+		return apiError(http.StatusInternalServerError, errs.New("unknown operation %q", pending.Operation))
+	}
+}
+
+// expireApprovalRequest removes a pending approval request that expired. The expiration is
+// only logged by the server that removes it.
+func (s *Service) expireApprovalRequest(ctx context.Context, pending approvals.Request) {
+	deleted, err := s.approvalsDB.Delete(ctx, pending.ID)
+	if err != nil {
+		s.log.Error("failed to delete expired approval request",
+			zap.Stringer("request_id", pending.ID),
+			zap.Error(err),
+		)
+		return
+	}
+	if !deleted {
+		return
+	}
+
+	err = s.logApprovalChange(ctx, approvalRequestFromDB(pending), pending.RequestedBy, approvalExpiredOperation, "approval request expired")
+	if err != nil {
+		s.log.Error("failed to log expiration of approval request",
+			zap.Stringer("request_id", pending.ID),
+			zap.Error(err),
+		)
+	}
+}
+
+func approvalRequestFromDB(request approvals.Request) ApprovalRequest {
+	return ApprovalRequest{
+		ID:          request.ID,
+		Operation:   request.Operation,
+		ItemType:    request.ItemType,
+		UserID:      request.UserID,
+		ProjectID:   request.ProjectID,
+		RequestedBy: request.RequestedBy,
+		Reason:      request.Reason,
+		Details:     request.Details,
+		CreatedAt:   request.CreatedAt,
+		ExpiresAt:   request.ExpiresAt,
+	}
+}
+
+// logApprovalChange records a step of the approval trail of request in the change history.
+func (s *Service) logApprovalChange(ctx context.Context, request ApprovalRequest, adminEmail, operation, reason string) error {
+	_, err := s.history.LogChange(ctx, changehistory.ChangeLog{
+		UserID:     request.UserID,
+		ProjectID:  request.ProjectID,
+		AdminEmail: adminEmail,
+		ItemType:   request.ItemType,
+		Reason:     reason,
+		Operation:  operation,
+		Changes: map[string]any{
+			"approvalRequestID": request.ID.String(),
+			"operation":         request.Operation,
+			"requestedBy":       request.RequestedBy,
+			"requestReason":     request.Reason,
+			"details":           request.Details,
+			"expiresAt":         request.ExpiresAt,
+		},
+		Timestamp: s.nowFn(),
+	})
+	return err
+}
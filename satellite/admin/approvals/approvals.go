@@ -0,0 +1,45 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package approvals
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/admin/changehistory"
+)
+
+// Request is an operation that is pending the approval of a second administrator.
+type Request struct {
+	ID          uuid.UUID
+	Operation   string
+	ItemType    changehistory.ItemType
+	UserID      uuid.UUID
+	ProjectID   *uuid.UUID
+	RequestedBy string
+	Reason      string
+	Details     map[string]any
+	// Payload is the request that performs the operation once it's approved.
+	Payload   json.RawMessage
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// DB defines the interface for storing the approval requests of the operations that are
+// pending the approval of a second administrator.
+type DB interface {
+	// Create stores a new approval request. The creation time is set by the database.
+	Create(ctx context.Context, request Request) (*Request, error)
+	// Get retrieves the approval request with the given ID.
+	// It returns an error that wraps sql.ErrNoRows if it doesn't exist.
+	Get(ctx context.Context, id uuid.UUID) (*Request, error)
+	// List retrieves all the approval requests ordered by creation time.
+	List(ctx context.Context) ([]Request, error)
+	// Delete deletes the approval request with the given ID.
+	// It returns false if the approval request didn't exist, for example, because another
+	// administrator has already handled it.
+	Delete(ctx context.Context, id uuid.UUID) (deleted bool, err error)
+}
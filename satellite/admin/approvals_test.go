@@ -0,0 +1,209 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	admin "storj.io/storj/satellite/admin"
+	"storj.io/storj/satellite/admin/changehistory"
+	"storj.io/storj/satellite/console"
+)
+
+func TestApprovals(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.UserGroupsRoleAdmin = []string{"admin"}
+				config.Admin.UserGroupsRoleCustomerSupport = []string{"support"}
+				config.Admin.Approval.Enabled = true
+				config.Admin.Approval.Operations = []string{admin.OperationDisableProject}
+				config.Admin.Approval.Expiration = time.Hour
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.Admin.Admin.Service
+		consoleDB := sat.DB.Console()
+
+		requester := &admin.AuthInfo{Groups: []string{"admin"}, Email: "requester@storj.test"}
+		approver := &admin.AuthInfo{Groups: []string{"admin"}, Email: "approver@storj.test"}
+		support := &admin.AuthInfo{Groups: []string{"support"}, Email: "support@storj.test"}
+		decision := admin.ApprovalDecisionRequest{Reason: "reviewed"}
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			Email:    "user@storj.test",
+			FullName: "Test User",
+		}, 3)
+		require.NoError(t, err)
+
+		requestDisable := func(t *testing.T) (*console.Project, admin.ApprovalRequest) {
+			project, err := sat.AddProject(ctx, user.ID, testrand.BucketName())
+			require.NoError(t, err)
+
+			request, apiErr := service.DisableProject(ctx, requester, project.PublicID, admin.DisableProjectRequest{Reason: "reason"})
+			require.NoError(t, apiErr.Err)
+			require.NotNil(t, request)
+			require.Equal(t, admin.OperationDisableProject, request.Operation)
+			require.Equal(t, requester.Email, request.RequestedBy)
+			require.NotNil(t, request.ProjectID)
+			require.Equal(t, project.PublicID, *request.ProjectID)
+
+			p, err := consoleDB.Projects().Get(ctx, project.ID)
+			require.NoError(t, err)
+			require.NotNil(t, p.Status)
+			require.Equal(t, console.ProjectActive, *p.Status)
+
+			requests, apiErr := service.GetApprovalRequests(ctx)
+			require.NoError(t, apiErr.Err)
+			for _, r := range requests {
+				if r.ID == request.ID {
+					return project, r
+				}
+			}
+			require.FailNow(t, "approval request not found")
+			return nil, admin.ApprovalRequest{}
+		}
+
+		requireHistory := func(t *testing.T, project *console.Project, operations ...string) {
+			changes, apiErr := service.GetChangeHistory(ctx, "true", string(changehistory.ItemTypeProject), project.PublicID.String())
+			require.NoError(t, apiErr.Err)
+
+			var got []string
+			for _, c := range changes {
+				got = append(got, c.Operation)
+			}
+			for _, op := range operations {
+				require.Contains(t, got, op)
+			}
+		}
+
+		t.Run("approve", func(t *testing.T) {
+			project, request := requestDisable(t)
+
+			apiErr := service.ApproveRequest(ctx, requester, request.ID, decision)
+			require.Equal(t, http.StatusForbidden, apiErr.Status)
+
+			apiErr = service.ApproveRequest(ctx, support, request.ID, decision)
+			require.Equal(t, http.StatusForbidden, apiErr.Status)
+
+			apiErr = service.ApproveRequest(ctx, approver, request.ID, admin.ApprovalDecisionRequest{})
+			require.Equal(t, http.StatusBadRequest, apiErr.Status)
+
+			apiErr = service.ApproveRequest(ctx, approver, request.ID, decision)
+			require.NoError(t, apiErr.Err)
+
+			p, err := consoleDB.Projects().Get(ctx, project.ID)
+			require.NoError(t, err)
+			require.NotNil(t, p.Status)
+			require.Equal(t, console.ProjectDisabled, *p.Status)
+
+			apiErr = service.ApproveRequest(ctx, approver, request.ID, decision)
+			require.Equal(t, http.StatusNotFound, apiErr.Status)
+
+			requireHistory(t, project, "approval_requested", "approval_granted")
+		})
+
+		t.Run("approve concurrently", func(t *testing.T) {
+			_, request := requestDisable(t)
+
+			var group errgroup.Group
+			statuses := make([]int, 2)
+			for i := range statuses {
+				group.Go(func() error {
+					apiErr := service.ApproveRequest(ctx, approver, request.ID, decision)
+					statuses[i] = apiErr.Status
+					if apiErr.Err == nil {
+						statuses[i] = http.StatusOK
+					}
+					return nil
+				})
+			}
+			require.NoError(t, group.Wait())
+			require.ElementsMatch(t, []int{http.StatusOK, http.StatusNotFound}, statuses)
+		})
+
+		t.Run("failed operation", func(t *testing.T) {
+			project, request := requestDisable(t)
+			require.NoError(t, consoleDB.Projects().Delete(ctx, project.ID))
+
+			apiErr := service.ApproveRequest(ctx, approver, request.ID, decision)
+			require.Error(t, apiErr.Err)
+
+			// the approval request stays pending.
+			requests, apiErr := service.GetApprovalRequests(ctx)
+			require.NoError(t, apiErr.Err)
+			var ids []uuid.UUID
+			for _, r := range requests {
+				ids = append(ids, r.ID)
+			}
+			require.Contains(t, ids, request.ID)
+
+			require.NoError(t, service.RejectRequest(ctx, approver, request.ID, decision).Err)
+		})
+
+		t.Run("reject", func(t *testing.T) {
+			project, request := requestDisable(t)
+
+			apiErr := service.RejectRequest(ctx, approver, request.ID, decision)
+			require.NoError(t, apiErr.Err)
+
+			apiErr = service.ApproveRequest(ctx, approver, request.ID, decision)
+			require.Equal(t, http.StatusNotFound, apiErr.Status)
+
+			p, err := consoleDB.Projects().Get(ctx, project.ID)
+			require.NoError(t, err)
+			require.NotNil(t, p.Status)
+			require.Equal(t, console.ProjectActive, *p.Status)
+
+			requireHistory(t, project, "approval_requested", "approval_rejected")
+		})
+
+		t.Run("expire", func(t *testing.T) {
+			project, request := requestDisable(t)
+
+			service.TestSetNowFn(func() time.Time { return time.Now().Add(2 * time.Hour) })
+			defer service.TestSetNowFn(time.Now)
+
+			apiErr := service.ApproveRequest(ctx, approver, request.ID, decision)
+			require.Equal(t, http.StatusGone, apiErr.Status)
+
+			p, err := consoleDB.Projects().Get(ctx, project.ID)
+			require.NoError(t, err)
+			require.NotNil(t, p.Status)
+			require.Equal(t, console.ProjectActive, *p.Status)
+
+			requireHistory(t, project, "approval_requested", "approval_expired")
+		})
+
+		t.Run("operation not requiring approval", func(t *testing.T) {
+			project, err := sat.AddProject(ctx, user.ID, testrand.BucketName())
+			require.NoError(t, err)
+
+			request, apiErr := service.DisableProject(ctx, requester, project.PublicID, admin.DisableProjectRequest{
+				Reason:             "reason",
+				SetPendingDeletion: true,
+			})
+			require.NoError(t, apiErr.Err)
+			require.Nil(t, request)
+
+			p, err := consoleDB.Projects().Get(ctx, project.ID)
+			require.NoError(t, err)
+			require.NotNil(t, p.Status)
+			require.Equal(t, console.ProjectPendingDeletion, *p.Status)
+		})
+	})
+}
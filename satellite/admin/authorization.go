@@ -54,6 +54,8 @@ const (
 	PermAccountChangeLicenses
 	PermViewPrivateProjectID
 	PermAccountUpdateTenantID
+	PermApproveOperations
+	PermBucketSetLimits
 )
 
 // These constants are the list of roles that users can have and the service uses to match
@@ -70,7 +72,7 @@ const (
 			PermProjectDeleteNoData | PermProjectMarkPendingDeletion |
 			PermBucketView | PermBucketSetDataPlacement | PermBucketRemoveDataPlacement |
 			PermBucketSetUserAgent | PermViewChangeHistory | PermAccountChangeUpgradeTime | PermNodesView | PermProjectMembersView |
-			PermAccountChangeLicenses | PermViewPrivateProjectID | PermAccountUpdateTenantID,
+			PermAccountChangeLicenses | PermViewPrivateProjectID | PermAccountUpdateTenantID | PermApproveOperations | PermBucketSetLimits,
 	)
 	RoleViewer          = Authorization(PermAccountView | PermProjectView | PermBucketView | PermViewChangeHistory | PermProjectMembersView)
 	RoleCustomerSupport = Authorization(
@@ -81,7 +83,7 @@ const (
 			PermProjectRemoveDataPlacement | PermProjectSetUserAgent | PermProjectSendInvitation |
 			PermBucketView | PermBucketSetDataPlacement | PermBucketRemoveDataPlacement |
 			PermBucketSetUserAgent | PermViewChangeHistory | PermProjectMembersView | PermAccountChangeLicenses |
-			PermAccountCreateRegToken,
+			PermAccountCreateRegToken | PermBucketSetLimits,
 	)
 	RoleFinanceManager = Authorization(PermAccountView | PermProjectView | PermBucketView | PermProjectMembersView)
 )
@@ -21,6 +21,7 @@ import (
 	"storj.io/storj/satellite/admin/auditlogger"
 	"storj.io/storj/satellite/admin/changehistory"
 	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/metabase"
 )
 
@@ -62,6 +63,22 @@ type UpdateBucketRequest struct {
 	Reason string `json:"reason"` // Reason for the change, for audit logging
 }
 
+// BucketLimits contains the user-specified limits of a bucket. A nil value means the bucket has
+// no limit of that kind and only the project limits apply.
+type BucketLimits struct {
+	Storage   *int64 `json:"storage"`
+	Bandwidth *int64 `json:"bandwidth"`
+	Segments  *int64 `json:"segments"`
+}
+
+// UpdateBucketLimitsRequest contains the limits to set on a bucket. Every limit is replaced, so a
+// nil value removes the corresponding limit.
+type UpdateBucketLimitsRequest struct {
+	BucketLimits
+
+	Reason string `json:"reason"` // Reason for the change, for audit logging
+}
+
 // GetProjectBuckets retrieves all buckets for a given project public ID.
 func (s *Service) GetProjectBuckets(ctx context.Context, publicID uuid.UUID, search, pageStr, limitStr string, since, before time.Time) (*BucketInfoPage, api.HTTPError) {
 	var err error
@@ -344,3 +361,117 @@ func (s *Service) GetBucketState(ctx context.Context, projectPublicID uuid.UUID,
 		Empty: isEmpty,
 	}, api.HTTPError{}
 }
+
+// GetBucketLimits retrieves the user-specified limits of a bucket.
+func (s *Service) GetBucketLimits(ctx context.Context, projectPublicID uuid.UUID, bucketName string) (*BucketLimits, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	project, bucket, apiErr := s.getProjectAndBucket(ctx, projectPublicID, bucketName)
+	if apiErr.Err != nil {
+		return nil, apiErr
+	}
+
+	limits, err := s.buckets.GetBucketLimits(ctx, []byte(bucket.Name), project.ID)
+	if err != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusInternalServerError,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	return &BucketLimits{
+		Storage:   limits.Storage,
+		Bandwidth: limits.Bandwidth,
+		Segments:  limits.Segments,
+	}, api.HTTPError{}
+}
+
+// UpdateBucketLimits replaces the user-specified limits of a bucket.
+func (s *Service) UpdateBucketLimits(ctx context.Context, authInfo *AuthInfo, projectPublicID uuid.UUID, bucketName string, req UpdateBucketLimitsRequest) api.HTTPError {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	apiError := func(status int, err error) api.HTTPError {
+		return api.HTTPError{
+			Status: status, Err: Error.Wrap(err),
+		}
+	}
+
+	if authInfo == nil || len(authInfo.Groups) == 0 {
+		return apiError(http.StatusUnauthorized, errs.New("not authorized"))
+	}
+	if req.Reason == "" {
+		return apiError(http.StatusBadRequest, errs.New("reason is required"))
+	}
+	for _, limit := range []*int64{req.Storage, req.Bandwidth, req.Segments} {
+		if limit != nil && *limit < 0 {
+			return apiError(http.StatusBadRequest, errs.New("limits must not be negative"))
+		}
+	}
+
+	project, bucket, apiErr := s.getProjectAndBucket(ctx, projectPublicID, bucketName)
+	if apiErr.Err != nil {
+		return apiErr
+	}
+
+	before, err := s.buckets.GetBucketLimits(ctx, []byte(bucket.Name), project.ID)
+	if err != nil {
+		return apiError(http.StatusInternalServerError, err)
+	}
+
+	after := buckets.Limits{
+		Storage:   req.Storage,
+		Bandwidth: req.Bandwidth,
+		Segments:  req.Segments,
+	}
+	err = s.buckets.UpdateBucketLimits(ctx, []byte(bucket.Name), project.ID, after)
+	if err != nil {
+		return apiError(http.StatusInternalServerError, err)
+	}
+
+	s.auditLogger.EnqueueChangeEvent(auditlogger.Event{
+		UserID:     project.OwnerID,
+		ProjectID:  &project.PublicID,
+		BucketName: &bucket.Name,
+		Action:     "update_bucket_limits",
+		AdminEmail: authInfo.Email,
+		ItemType:   changehistory.ItemTypeBucket,
+		Reason:     req.Reason,
+		Before:     before,
+		After:      after,
+		Timestamp:  s.nowFn(),
+	})
+
+	return api.HTTPError{}
+}
+
+func (s *Service) getProjectAndBucket(ctx context.Context, projectPublicID uuid.UUID, bucketName string) (*console.Project, buckets.Bucket, api.HTTPError) {
+	project, err := s.consoleDB.Projects().GetByPublicID(ctx, projectPublicID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, sql.ErrNoRows) {
+			status = http.StatusNotFound
+			err = errs.New("project not found")
+		}
+		return nil, buckets.Bucket{}, api.HTTPError{
+			Status: status,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	bucket, err := s.buckets.GetBucket(ctx, []byte(bucketName), project.ID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if buckets.ErrBucketNotFound.Has(err) {
+			status = http.StatusNotFound
+			err = errs.New("bucket not found")
+		}
+		return nil, buckets.Bucket{}, api.HTTPError{
+			Status: status,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	return project, bucket, api.HTTPError{}
+}
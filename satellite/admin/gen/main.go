@@ -242,6 +242,21 @@ func main() {
 		},
 	})
 
+	group.Delete("/{userID}/webauthn", &apigen.Endpoint{
+		Name:           "Reset WebAuthn credentials",
+		Description:    "Removes all security keys and passkeys of a user",
+		GoName:         "ResetWebAuthn",
+		TypeScriptName: "resetWebAuthn",
+		PathParams: []apigen.Param{
+			apigen.NewParam("userID", uuid.UUID{}),
+		},
+		Request: backoffice.ResetWebAuthnRequest{},
+		Settings: map[any]any{
+			authPermsKey:     []backoffice.Permission{backoffice.PermAccountDisableMFA},
+			passAuthParamKey: true,
+		},
+	})
+
 	group.Post("/rest-keys/{userID}", &apigen.Endpoint{
 		Name:           "Create Rest Key",
 		Description:    "Creates a rest API key a user",
@@ -301,14 +316,16 @@ func main() {
 	})
 
 	group.Delete("/{userID}/licenses", &apigen.Endpoint{
-		Name:           "Revoke user license",
-		Description:    "Revokes a license for a user",
+		Name: "Revoke user license",
+		Description: "Revokes a license for a user." +
+			" It returns the approval request if the operation requires the approval of another administrator.",
 		GoName:         "RevokeUserLicense",
 		TypeScriptName: "revokeUserLicense",
 		PathParams: []apigen.Param{
 			apigen.NewParam("userID", uuid.UUID{}),
 		},
-		Request: backoffice.RevokeLicenseRequest{},
+		Request:  backoffice.RevokeLicenseRequest{},
+		Response: backoffice.ApprovalRequest{},
 		Settings: map[any]any{
 			authPermsKey:     []backoffice.Permission{backoffice.PermAccountChangeLicenses},
 			passAuthParamKey: true,
@@ -316,14 +333,16 @@ func main() {
 	})
 
 	group.Post("/{userID}/licenses/delete", &apigen.Endpoint{
-		Name:           "Delete user license",
-		Description:    "Permanently deletes a license for a user",
+		Name: "Delete user license",
+		Description: "Permanently deletes a license for a user." +
+			" It returns the approval request if the operation requires the approval of another administrator.",
 		GoName:         "DeleteUserLicense",
 		TypeScriptName: "deleteUserLicense",
 		PathParams: []apigen.Param{
 			apigen.NewParam("userID", uuid.UUID{}),
 		},
-		Request: backoffice.DeleteLicenseRequest{},
+		Request:  backoffice.DeleteLicenseRequest{},
+		Response: backoffice.ApprovalRequest{},
 		Settings: map[any]any{
 			authPermsKey:     []backoffice.Permission{backoffice.PermAccountChangeLicenses},
 			passAuthParamKey: true,
@@ -426,6 +445,37 @@ func main() {
 		},
 	})
 
+	group.Get("/{publicID}/buckets/{bucketName}/limits", &apigen.Endpoint{
+		Name:           "Get bucket limits",
+		Description:    "Gets a bucket's storage, egress and segment limits",
+		GoName:         "GetBucketLimits",
+		TypeScriptName: "getBucketLimits",
+		PathParams: []apigen.Param{
+			apigen.NewParam("publicID", uuid.UUID{}),
+			apigen.NewParam("bucketName", ""),
+		},
+		Response: backoffice.BucketLimits{},
+		Settings: map[any]any{
+			authPermsKey: []backoffice.Permission{backoffice.PermProjectView, backoffice.PermBucketView},
+		},
+	})
+
+	group.Put("/{publicID}/buckets/{bucketName}/limits", &apigen.Endpoint{
+		Name:           "Update bucket limits",
+		Description:    "Replaces a bucket's storage, egress and segment limits. A null limit removes it",
+		GoName:         "UpdateBucketLimits",
+		TypeScriptName: "updateBucketLimits",
+		PathParams: []apigen.Param{
+			apigen.NewParam("publicID", uuid.UUID{}),
+			apigen.NewParam("bucketName", ""),
+		},
+		Request: backoffice.UpdateBucketLimitsRequest{},
+		Settings: map[any]any{
+			authPermsKey:     []backoffice.Permission{backoffice.PermProjectView, backoffice.PermBucketSetLimits},
+			passAuthParamKey: true,
+		},
+	})
+
 	group.Patch("/{publicID}", &apigen.Endpoint{
 		Name:           "Update project",
 		Description:    "Updates project name, user agent and default placement by ID",
@@ -443,14 +493,16 @@ func main() {
 	})
 
 	group.Put("/{publicID}", &apigen.Endpoint{
-		Name:           "Disable project",
-		Description:    "Disables a project by ID. It can also set status to pending deletion.",
+		Name: "Disable project",
+		Description: "Disables a project by ID. It can also set status to pending deletion." +
+			" It returns the approval request if the operation requires the approval of another administrator.",
 		GoName:         "DisableProject",
 		TypeScriptName: "disableProject",
 		PathParams: []apigen.Param{
 			apigen.NewParam("publicID", uuid.UUID{}),
 		},
-		Request: backoffice.DisableProjectRequest{},
+		Request:  backoffice.DisableProjectRequest{},
+		Response: backoffice.ApprovalRequest{},
 		Settings: map[any]any{
 			authPermsKey:     []backoffice.Permission{},
 			passAuthParamKey: true,
@@ -510,6 +562,54 @@ func main() {
 		},
 	})
 
+	group = api.Group("OrganizationManagement", "organizations")
+	group.Middleware = append(group.Middleware, authMiddleware{})
+
+	group.Get("/{organizationID}", &apigen.Endpoint{
+		Name:           "Get organization",
+		Description:    "Gets an organization with its members and projects by ID",
+		GoName:         "GetOrganization",
+		TypeScriptName: "getOrganization",
+		PathParams: []apigen.Param{
+			apigen.NewParam("organizationID", uuid.UUID{}),
+		},
+		Response: backoffice.OrganizationAccount{},
+		Settings: map[any]any{
+			authPermsKey: []backoffice.Permission{backoffice.PermAccountView},
+		},
+	})
+
+	group.Put("/{organizationID}/limits", &apigen.Endpoint{
+		Name:           "Update organization limits",
+		Description:    "Updates the project count, storage, egress and segment limits of an organization. A 0 limit removes it",
+		GoName:         "UpdateOrganizationLimits",
+		TypeScriptName: "updateOrganizationLimits",
+		PathParams: []apigen.Param{
+			apigen.NewParam("organizationID", uuid.UUID{}),
+		},
+		Request: backoffice.UpdateOrganizationLimitsRequest{},
+		Settings: map[any]any{
+			authPermsKey:     []backoffice.Permission{backoffice.PermAccountView, backoffice.PermAccountChangeLimits},
+			passAuthParamKey: true,
+		},
+	})
+
+	group.Post("/{organizationID}/projects/{publicID}", &apigen.Endpoint{
+		Name:           "Transfer project to organization",
+		Description:    "Moves a project into an organization, making the organization's owner the owner of the project",
+		GoName:         "TransferProjectToOrganization",
+		TypeScriptName: "transferProjectToOrganization",
+		PathParams: []apigen.Param{
+			apigen.NewParam("organizationID", uuid.UUID{}),
+			apigen.NewParam("publicID", uuid.UUID{}),
+		},
+		Request: backoffice.TransferProjectToOrganizationRequest{},
+		Settings: map[any]any{
+			authPermsKey:     []backoffice.Permission{backoffice.PermProjectView, backoffice.PermProjectUpdate},
+			passAuthParamKey: true,
+		},
+	})
+
 	// generic api group that handles searching for users and projects together
 	group = api.Group("Search", "search")
 	group.Middleware = append(group.Middleware, authMiddleware{})
@@ -552,6 +652,51 @@ func main() {
 		},
 	})
 
+	// api group that handles the approval requests of operations that require a second administrator
+	group = api.Group("ApprovalManagement", "approvals")
+	group.Middleware = append(group.Middleware, authMiddleware{})
+
+	group.Get("/", &apigen.Endpoint{
+		Name:           "Get approval requests",
+		Description:    "Gets the operations that are pending the approval of a second administrator",
+		GoName:         "GetApprovalRequests",
+		TypeScriptName: "getApprovalRequests",
+		Response:       []backoffice.ApprovalRequest{},
+		Settings: map[any]any{
+			authPermsKey: []backoffice.Permission{backoffice.PermViewChangeHistory},
+		},
+	})
+
+	group.Post("/{requestID}/approve", &apigen.Endpoint{
+		Name:           "Approve request",
+		Description:    "Approves a pending approval request and performs its operation",
+		GoName:         "ApproveRequest",
+		TypeScriptName: "approveRequest",
+		PathParams: []apigen.Param{
+			apigen.NewParam("requestID", uuid.UUID{}),
+		},
+		Request: backoffice.ApprovalDecisionRequest{},
+		Settings: map[any]any{
+			authPermsKey:     []backoffice.Permission{backoffice.PermApproveOperations},
+			passAuthParamKey: true,
+		},
+	})
+
+	group.Post("/{requestID}/reject", &apigen.Endpoint{
+		Name:           "Reject request",
+		Description:    "Rejects a pending approval request, so its operation is never performed",
+		GoName:         "RejectRequest",
+		TypeScriptName: "rejectRequest",
+		PathParams: []apigen.Param{
+			apigen.NewParam("requestID", uuid.UUID{}),
+		},
+		Request: backoffice.ApprovalDecisionRequest{},
+		Settings: map[any]any{
+			authPermsKey:     []backoffice.Permission{backoffice.PermApproveOperations},
+			passAuthParamKey: true,
+		},
+	})
+
 	// api group that handles retrieving node information
 	group = api.Group("NodeManagement", "nodes")
 	group.Middleware = append(group.Middleware, authMiddleware{})
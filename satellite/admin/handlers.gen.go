@@ -27,8 +27,10 @@ var ErrPlacementsAPI = errs.Class("admin placements api")
 var ErrProductsAPI = errs.Class("admin products api")
 var ErrUsersAPI = errs.Class("admin users api")
 var ErrProjectsAPI = errs.Class("admin projects api")
+var ErrOrganizationsAPI = errs.Class("admin organizations api")
 var ErrSearchAPI = errs.Class("admin search api")
 var ErrChangehistoryAPI = errs.Class("admin changehistory api")
+var ErrApprovalsAPI = errs.Class("admin approvals api")
 var ErrNodesAPI = errs.Class("admin nodes api")
 
 type SettingsService interface {
@@ -56,12 +58,13 @@ type UserManagementService interface {
 	DisableUser(ctx context.Context, authInfo *AuthInfo, userID uuid.UUID, request DisableUserRequest) (*UserAccount, api.HTTPError)
 	ToggleFreezeUser(ctx context.Context, authInfo *AuthInfo, userID uuid.UUID, request ToggleFreezeUserRequest) api.HTTPError
 	ToggleMFA(ctx context.Context, authInfo *AuthInfo, userID uuid.UUID, request ToggleMfaRequest) api.HTTPError
+	ResetWebAuthn(ctx context.Context, authInfo *AuthInfo, userID uuid.UUID, request ResetWebAuthnRequest) api.HTTPError
 	CreateRestKey(ctx context.Context, authInfo *AuthInfo, userID uuid.UUID, request CreateRestKeyRequest) (*string, api.HTTPError)
 	CreateRegistrationToken(ctx context.Context, authInfo *AuthInfo, request CreateRegistrationTokenRequest) (*CreateRegistrationTokenResponse, api.HTTPError)
 	GetUserLicenses(ctx context.Context, userID uuid.UUID) (*UserLicensesResponse, api.HTTPError)
 	GrantUserLicense(ctx context.Context, authInfo *AuthInfo, userID uuid.UUID, request GrantLicenseRequest) api.HTTPError
-	RevokeUserLicense(ctx context.Context, authInfo *AuthInfo, userID uuid.UUID, request RevokeLicenseRequest) api.HTTPError
-	DeleteUserLicense(ctx context.Context, authInfo *AuthInfo, userID uuid.UUID, request DeleteLicenseRequest) api.HTTPError
+	RevokeUserLicense(ctx context.Context, authInfo *AuthInfo, userID uuid.UUID, request RevokeLicenseRequest) (*ApprovalRequest, api.HTTPError)
+	DeleteUserLicense(ctx context.Context, authInfo *AuthInfo, userID uuid.UUID, request DeleteLicenseRequest) (*ApprovalRequest, api.HTTPError)
 	UpdateUserLicense(ctx context.Context, authInfo *AuthInfo, userID uuid.UUID, request UpdateLicenseRequest) api.HTTPError
 }
 
@@ -71,13 +74,21 @@ type ProjectManagementService interface {
 	GetProjectBuckets(ctx context.Context, publicID uuid.UUID, search, page, limit string, since, before time.Time) (*BucketInfoPage, api.HTTPError)
 	UpdateBucket(ctx context.Context, authInfo *AuthInfo, publicID uuid.UUID, bucketName string, request UpdateBucketRequest) api.HTTPError
 	GetBucketState(ctx context.Context, publicID uuid.UUID, bucketName string) (*BucketState, api.HTTPError)
+	GetBucketLimits(ctx context.Context, publicID uuid.UUID, bucketName string) (*BucketLimits, api.HTTPError)
+	UpdateBucketLimits(ctx context.Context, authInfo *AuthInfo, publicID uuid.UUID, bucketName string, request UpdateBucketLimitsRequest) api.HTTPError
 	UpdateProject(ctx context.Context, authInfo *AuthInfo, publicID uuid.UUID, request UpdateProjectRequest) (*Project, api.HTTPError)
-	DisableProject(ctx context.Context, authInfo *AuthInfo, publicID uuid.UUID, request DisableProjectRequest) api.HTTPError
+	DisableProject(ctx context.Context, authInfo *AuthInfo, publicID uuid.UUID, request DisableProjectRequest) (*ApprovalRequest, api.HTTPError)
 	UpdateProjectLimits(ctx context.Context, authInfo *AuthInfo, publicID uuid.UUID, request ProjectLimitsUpdateRequest) (*Project, api.HTTPError)
 	UpdateProjectEntitlements(ctx context.Context, authInfo *AuthInfo, publicID uuid.UUID, request UpdateProjectEntitlementsRequest) (*ProjectEntitlements, api.HTTPError)
 	GetProjectMembers(ctx context.Context, publicID uuid.UUID, search, page, limit, order, direction string) (*ProjectMembersPage, api.HTTPError)
 }
 
+type OrganizationManagementService interface {
+	GetOrganization(ctx context.Context, organizationID uuid.UUID) (*OrganizationAccount, api.HTTPError)
+	UpdateOrganizationLimits(ctx context.Context, authInfo *AuthInfo, organizationID uuid.UUID, request UpdateOrganizationLimitsRequest) api.HTTPError
+	TransferProjectToOrganization(ctx context.Context, authInfo *AuthInfo, organizationID, publicID uuid.UUID, request TransferProjectToOrganizationRequest) api.HTTPError
+}
+
 type SearchService interface {
 	SearchUsersProjectsOrNodes(ctx context.Context, authInfo *AuthInfo, term string) (*SearchResult, api.HTTPError)
 }
@@ -86,6 +97,12 @@ type ChangeHistoryService interface {
 	GetChangeHistory(ctx context.Context, exact, itemType, id string) ([]changehistory.ChangeLog, api.HTTPError)
 }
 
+type ApprovalManagementService interface {
+	GetApprovalRequests(ctx context.Context) ([]ApprovalRequest, api.HTTPError)
+	ApproveRequest(ctx context.Context, authInfo *AuthInfo, requestID uuid.UUID, request ApprovalDecisionRequest) api.HTTPError
+	RejectRequest(ctx context.Context, authInfo *AuthInfo, requestID uuid.UUID, request ApprovalDecisionRequest) api.HTTPError
+}
+
 type NodeManagementService interface {
 	GetNodeInfo(ctx context.Context, nodeID string) (*NodeFullInfo, api.HTTPError)
 }
@@ -128,6 +145,14 @@ type ProjectManagementHandler struct {
 	auth    *Authorizer
 }
 
+// OrganizationManagementHandler is an api handler that implements all OrganizationManagement API endpoints functionality.
+type OrganizationManagementHandler struct {
+	log     *zap.Logger
+	mon     *monkit.Scope
+	service OrganizationManagementService
+	auth    *Authorizer
+}
+
 // SearchHandler is an api handler that implements all Search API endpoints functionality.
 type SearchHandler struct {
 	log     *zap.Logger
@@ -144,6 +169,14 @@ type ChangeHistoryHandler struct {
 	auth    *Authorizer
 }
 
+// ApprovalManagementHandler is an api handler that implements all ApprovalManagement API endpoints functionality.
+type ApprovalManagementHandler struct {
+	log     *zap.Logger
+	mon     *monkit.Scope
+	service ApprovalManagementService
+	auth    *Authorizer
+}
+
 // NodeManagementHandler is an api handler that implements all NodeManagement API endpoints functionality.
 type NodeManagementHandler struct {
 	log     *zap.Logger
@@ -213,6 +246,7 @@ func NewUserManagement(log *zap.Logger, mon *monkit.Scope, service UserManagemen
 	usersRouter.HandleFunc("/{userID}", handler.handleDisableUser).Methods("PUT")
 	usersRouter.HandleFunc("/{userID}/freeze-events", handler.handleToggleFreezeUser).Methods("PUT")
 	usersRouter.HandleFunc("/{userID}/mfa", handler.handleToggleMFA).Methods("PUT")
+	usersRouter.HandleFunc("/{userID}/webauthn", handler.handleResetWebAuthn).Methods("DELETE")
 	usersRouter.HandleFunc("/rest-keys/{userID}", handler.handleCreateRestKey).Methods("POST")
 	usersRouter.HandleFunc("/registration-tokens", handler.handleCreateRegistrationToken).Methods("POST")
 	usersRouter.HandleFunc("/{userID}/licenses", handler.handleGetUserLicenses).Methods("GET")
@@ -238,6 +272,8 @@ func NewProjectManagement(log *zap.Logger, mon *monkit.Scope, service ProjectMan
 	projectsRouter.HandleFunc("/{publicID}/buckets", handler.handleGetProjectBuckets).Methods("GET")
 	projectsRouter.HandleFunc("/{publicID}/buckets/{bucketName}", handler.handleUpdateBucket).Methods("PATCH")
 	projectsRouter.HandleFunc("/{publicID}/buckets/{bucketName}/state", handler.handleGetBucketState).Methods("GET")
+	projectsRouter.HandleFunc("/{publicID}/buckets/{bucketName}/limits", handler.handleGetBucketLimits).Methods("GET")
+	projectsRouter.HandleFunc("/{publicID}/buckets/{bucketName}/limits", handler.handleUpdateBucketLimits).Methods("PUT")
 	projectsRouter.HandleFunc("/{publicID}", handler.handleUpdateProject).Methods("PATCH")
 	projectsRouter.HandleFunc("/{publicID}", handler.handleDisableProject).Methods("PUT")
 	projectsRouter.HandleFunc("/{publicID}/limits", handler.handleUpdateProjectLimits).Methods("PATCH")
@@ -247,6 +283,22 @@ func NewProjectManagement(log *zap.Logger, mon *monkit.Scope, service ProjectMan
 	return handler
 }
 
+func NewOrganizationManagement(log *zap.Logger, mon *monkit.Scope, service OrganizationManagementService, router *mux.Router, auth *Authorizer) *OrganizationManagementHandler {
+	handler := &OrganizationManagementHandler{
+		log:     log,
+		mon:     mon,
+		service: service,
+		auth:    auth,
+	}
+
+	organizationsRouter := router.PathPrefix("/api/v1/organizations").Subrouter()
+	organizationsRouter.HandleFunc("/{organizationID}", handler.handleGetOrganization).Methods("GET")
+	organizationsRouter.HandleFunc("/{organizationID}/limits", handler.handleUpdateOrganizationLimits).Methods("PUT")
+	organizationsRouter.HandleFunc("/{organizationID}/projects/{publicID}", handler.handleTransferProjectToOrganization).Methods("POST")
+
+	return handler
+}
+
 func NewSearch(log *zap.Logger, mon *monkit.Scope, service SearchService, router *mux.Router, auth *Authorizer) *SearchHandler {
 	handler := &SearchHandler{
 		log:     log,
@@ -275,6 +327,22 @@ func NewChangeHistory(log *zap.Logger, mon *monkit.Scope, service ChangeHistoryS
 	return handler
 }
 
+func NewApprovalManagement(log *zap.Logger, mon *monkit.Scope, service ApprovalManagementService, router *mux.Router, auth *Authorizer) *ApprovalManagementHandler {
+	handler := &ApprovalManagementHandler{
+		log:     log,
+		mon:     mon,
+		service: service,
+		auth:    auth,
+	}
+
+	approvalsRouter := router.PathPrefix("/api/v1/approvals").Subrouter()
+	approvalsRouter.HandleFunc("/", handler.handleGetApprovalRequests).Methods("GET")
+	approvalsRouter.HandleFunc("/{requestID}/approve", handler.handleApproveRequest).Methods("POST")
+	approvalsRouter.HandleFunc("/{requestID}/reject", handler.handleRejectRequest).Methods("POST")
+
+	return handler
+}
+
 func NewNodeManagement(log *zap.Logger, mon *monkit.Scope, service NodeManagementService, router *mux.Router, auth *Authorizer) *NodeManagementHandler {
 	handler := &NodeManagementHandler{
 		log:     log,
@@ -821,6 +889,52 @@ func (h *UserManagementHandler) handleToggleMFA(w http.ResponseWriter, r *http.R
 	}
 }
 
+func (h *UserManagementHandler) handleResetWebAuthn(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	userIDParam, ok := mux.Vars(r)["userID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing userID route param"))
+		return
+	}
+
+	userID, err := uuid.FromString(userIDParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	payload := ResetWebAuthnRequest{}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
+		return
+	}
+
+	authInfo := h.auth.GetAuthInfo(r)
+	if authInfo == nil || len(authInfo.Groups) == 0 || authInfo.Email == "" {
+		api.ServeError(h.log, w, http.StatusUnauthorized, errs.New("Unauthorized"))
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 64) {
+		return
+	}
+
+	httpErr := h.service.ResetWebAuthn(ctx, authInfo, userID, payload)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+	}
+}
+
 func (h *UserManagementHandler) handleCreateRestKey(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var err error
@@ -1039,9 +1153,15 @@ func (h *UserManagementHandler) handleRevokeUserLicense(w http.ResponseWriter, r
 		return
 	}
 
-	httpErr := h.service.RevokeUserLicense(ctx, authInfo, userID, payload)
+	retVal, httpErr := h.service.RevokeUserLicense(ctx, authInfo, userID, payload)
 	if httpErr.Err != nil {
 		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json RevokeUserLicense response", zap.Error(ErrUsersAPI.Wrap(err)))
 	}
 }
 
@@ -1085,9 +1205,15 @@ func (h *UserManagementHandler) handleDeleteUserLicense(w http.ResponseWriter, r
 		return
 	}
 
-	httpErr := h.service.DeleteUserLicense(ctx, authInfo, userID, payload)
+	retVal, httpErr := h.service.DeleteUserLicense(ctx, authInfo, userID, payload)
 	if httpErr.Err != nil {
 		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json DeleteUserLicense response", zap.Error(ErrUsersAPI.Wrap(err)))
 	}
 }
 
@@ -1387,6 +1513,104 @@ func (h *ProjectManagementHandler) handleGetBucketState(w http.ResponseWriter, r
 	}
 }
 
+func (h *ProjectManagementHandler) handleGetBucketLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	publicIDParam, ok := mux.Vars(r)["publicID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing publicID route param"))
+		return
+	}
+
+	publicID, err := uuid.FromString(publicIDParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	bucketName, ok := mux.Vars(r)["bucketName"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing bucketName route param"))
+		return
+	}
+
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 262144, 268435456) {
+		return
+	}
+
+	retVal, httpErr := h.service.GetBucketLimits(ctx, publicID, bucketName)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json GetBucketLimits response", zap.Error(ErrProjectsAPI.Wrap(err)))
+	}
+}
+
+func (h *ProjectManagementHandler) handleUpdateBucketLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	publicIDParam, ok := mux.Vars(r)["publicID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing publicID route param"))
+		return
+	}
+
+	publicID, err := uuid.FromString(publicIDParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	bucketName, ok := mux.Vars(r)["bucketName"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing bucketName route param"))
+		return
+	}
+
+	payload := UpdateBucketLimitsRequest{}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
+		return
+	}
+
+	authInfo := h.auth.GetAuthInfo(r)
+	if authInfo == nil || len(authInfo.Groups) == 0 || authInfo.Email == "" {
+		api.ServeError(h.log, w, http.StatusUnauthorized, errs.New("Unauthorized"))
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 262144, 549755813888) {
+		return
+	}
+
+	httpErr := h.service.UpdateBucketLimits(ctx, authInfo, publicID, bucketName, payload)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+	}
+}
+
 func (h *ProjectManagementHandler) handleUpdateProject(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var err error
@@ -1471,9 +1695,15 @@ func (h *ProjectManagementHandler) handleDisableProject(w http.ResponseWriter, r
 		return
 	}
 
-	httpErr := h.service.DisableProject(ctx, authInfo, publicID, payload)
+	retVal, httpErr := h.service.DisableProject(ctx, authInfo, publicID, payload)
 	if httpErr.Err != nil {
 		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json DisableProject response", zap.Error(ErrProjectsAPI.Wrap(err)))
 	}
 }
 
@@ -1651,6 +1881,150 @@ func (h *ProjectManagementHandler) handleGetProjectMembers(w http.ResponseWriter
 	}
 }
 
+func (h *OrganizationManagementHandler) handleGetOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	organizationIDParam, ok := mux.Vars(r)["organizationID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing organizationID route param"))
+		return
+	}
+
+	organizationID, err := uuid.FromString(organizationIDParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 1) {
+		return
+	}
+
+	retVal, httpErr := h.service.GetOrganization(ctx, organizationID)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json GetOrganization response", zap.Error(ErrOrganizationsAPI.Wrap(err)))
+	}
+}
+
+func (h *OrganizationManagementHandler) handleUpdateOrganizationLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	organizationIDParam, ok := mux.Vars(r)["organizationID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing organizationID route param"))
+		return
+	}
+
+	organizationID, err := uuid.FromString(organizationIDParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	payload := UpdateOrganizationLimitsRequest{}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
+		return
+	}
+
+	authInfo := h.auth.GetAuthInfo(r)
+	if authInfo == nil || len(authInfo.Groups) == 0 || authInfo.Email == "" {
+		api.ServeError(h.log, w, http.StatusUnauthorized, errs.New("Unauthorized"))
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 1, 128) {
+		return
+	}
+
+	httpErr := h.service.UpdateOrganizationLimits(ctx, authInfo, organizationID, payload)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+	}
+}
+
+func (h *OrganizationManagementHandler) handleTransferProjectToOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	organizationIDParam, ok := mux.Vars(r)["organizationID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing organizationID route param"))
+		return
+	}
+
+	organizationID, err := uuid.FromString(organizationIDParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	publicIDParam, ok := mux.Vars(r)["publicID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing publicID route param"))
+		return
+	}
+
+	publicID, err := uuid.FromString(publicIDParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	payload := TransferProjectToOrganizationRequest{}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
+		return
+	}
+
+	authInfo := h.auth.GetAuthInfo(r)
+	if authInfo == nil || len(authInfo.Groups) == 0 || authInfo.Email == "" {
+		api.ServeError(h.log, w, http.StatusUnauthorized, errs.New("Unauthorized"))
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 262144, 1048576) {
+		return
+	}
+
+	httpErr := h.service.TransferProjectToOrganization(ctx, authInfo, organizationID, publicID, payload)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+	}
+}
+
 func (h *SearchHandler) handleSearchUsersProjectsOrNodes(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var err error
@@ -1733,6 +2107,126 @@ func (h *ChangeHistoryHandler) handleGetChangeHistory(w http.ResponseWriter, r *
 	}
 }
 
+func (h *ApprovalManagementHandler) handleGetApprovalRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 8589934592) {
+		return
+	}
+
+	retVal, httpErr := h.service.GetApprovalRequests(ctx)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json GetApprovalRequests response", zap.Error(ErrApprovalsAPI.Wrap(err)))
+	}
+}
+
+func (h *ApprovalManagementHandler) handleApproveRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	requestIDParam, ok := mux.Vars(r)["requestID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing requestID route param"))
+		return
+	}
+
+	requestID, err := uuid.FromString(requestIDParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	payload := ApprovalDecisionRequest{}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
+		return
+	}
+
+	authInfo := h.auth.GetAuthInfo(r)
+	if authInfo == nil || len(authInfo.Groups) == 0 || authInfo.Email == "" {
+		api.ServeError(h.log, w, http.StatusUnauthorized, errs.New("Unauthorized"))
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 274877906944) {
+		return
+	}
+
+	httpErr := h.service.ApproveRequest(ctx, authInfo, requestID, payload)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+	}
+}
+
+func (h *ApprovalManagementHandler) handleRejectRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	requestIDParam, ok := mux.Vars(r)["requestID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing requestID route param"))
+		return
+	}
+
+	requestID, err := uuid.FromString(requestIDParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	payload := ApprovalDecisionRequest{}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = h.auth.VerifyHost(r); err != nil {
+		api.ServeError(h.log, w, http.StatusForbidden, err)
+		return
+	}
+
+	authInfo := h.auth.GetAuthInfo(r)
+	if authInfo == nil || len(authInfo.Groups) == 0 || authInfo.Email == "" {
+		api.ServeError(h.log, w, http.StatusUnauthorized, errs.New("Unauthorized"))
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 274877906944) {
+		return
+	}
+
+	httpErr := h.service.RejectRequest(ctx, authInfo, requestID, payload)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+	}
+}
+
 func (h *NodeManagementHandler) handleGetNodeInfo(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var err error
@@ -465,6 +465,17 @@ func (server *Server) putProjectLimit(w http.ResponseWriter, r *http.Request) {
 		})
 	}
 
+	err = console.CheckProjectLimitUpdates(ctx, server.db.Console(), server.console.UsageLimits, project.ID, toUpdate)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if console.ErrConflict.Has(err) {
+			status = http.StatusConflict
+		}
+		sendJSONError(w, "failed to check organization limits",
+			err.Error(), status)
+		return
+	}
+
 	err = server.db.Console().Projects().UpdateLimitsGeneric(ctx, project.ID, toUpdate)
 	if err != nil {
 		sendJSONError(w, "failed to update usage",
@@ -639,12 +639,18 @@ func (server *Server) updateUserKind(w http.ResponseWriter, r *http.Request) {
 
 	// update projects limits
 	var updateErrs errs.Group
+	newLimits := console.UsageLimits{
+		Storage:   storageLimit.Int64(),
+		Bandwidth: bandwidthLimit.Int64(),
+		Segment:   segmentLimit,
+	}
 	for _, p := range projects {
-		err = server.db.Console().Projects().UpdateUsageLimits(ctx, p.ID, console.UsageLimits{
-			Storage:   storageLimit.Int64(),
-			Bandwidth: bandwidthLimit.Int64(),
-			Segment:   segmentLimit,
-		})
+		err = console.CheckProjectLimitUpdates(ctx, server.db.Console(), server.console.UsageLimits, p.ID, usageLimitUpdates(newLimits))
+		if err != nil {
+			updateErrs.Add(errs.New("project %s: %w", p.ID, err))
+			continue
+		}
+		err = server.db.Console().Projects().UpdateUsageLimits(ctx, p.ID, newLimits)
 		if err != nil {
 			updateErrs.Add(errs.New("project %s: %w", p.ID, err))
 		}
@@ -833,6 +839,17 @@ func (server *Server) updateLimits(w http.ResponseWriter, r *http.Request) {
 	}
 
 	for _, p := range userProjects {
+		err = console.CheckProjectLimitUpdates(ctx, server.db.Console(), server.console.UsageLimits, p.ID, usageLimitUpdates(newLimits))
+		if err != nil {
+			status := http.StatusInternalServerError
+			if console.ErrConflict.Has(err) {
+				status = http.StatusConflict
+			}
+			sendJSONError(w, "failed to check organization limits",
+				err.Error(), status)
+			return
+		}
+
 		err = server.db.Console().Projects().UpdateUsageLimits(ctx, p.ID, newLimits)
 		if err != nil {
 			sendJSONError(w, "failed to update project limits",
@@ -1459,3 +1476,12 @@ func (server *Server) updateUserData(
 
 	return 0, "", ""
 }
+
+// usageLimitUpdates converts the usage limits into the updates of the project limits.
+func usageLimitUpdates(limits console.UsageLimits) []console.Limit {
+	return []console.Limit{
+		{Kind: console.StorageLimit, Value: &limits.Storage},
+		{Kind: console.BandwidthLimit, Value: &limits.Bandwidth},
+		{Kind: console.SegmentLimit, Value: &limits.Segment},
+	}
+}
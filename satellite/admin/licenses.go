@@ -231,12 +231,12 @@ func (s *Service) GrantUserLicense(ctx context.Context, authInfo *AuthInfo, user
 }
 
 // RevokeUserLicense revokes a license for a user by setting the RevokedAt timestamp.
-func (s *Service) RevokeUserLicense(ctx context.Context, authInfo *AuthInfo, userID uuid.UUID, request RevokeLicenseRequest) api.HTTPError {
+func (s *Service) RevokeUserLicense(ctx context.Context, authInfo *AuthInfo, userID uuid.UUID, request RevokeLicenseRequest) (*ApprovalRequest, api.HTTPError) {
 	var err error
 	defer mon.Task()(&ctx)(&err)
 
-	apiError := func(status int, err error) api.HTTPError {
-		return api.HTTPError{
+	apiError := func(status int, err error) (*ApprovalRequest, api.HTTPError) {
+		return nil, api.HTTPError{
 			Status: status, Err: Error.Wrap(err),
 		}
 	}
@@ -289,6 +289,21 @@ func (s *Service) RevokeUserLicense(ctx context.Context, authInfo *AuthInfo, use
 		return apiError(http.StatusNotFound, errs.New("license not found"))
 	}
 
+	if s.requiresApproval(ctx, OperationRevokeUserLicense) {
+		return s.requestApproval(ctx, authInfo, ApprovalRequest{
+			Operation: OperationRevokeUserLicense,
+			ItemType:  changehistory.ItemTypeUser,
+			UserID:    user.ID,
+			Reason:    request.Reason,
+			Details: map[string]any{
+				"type":       request.Type,
+				"publicId":   request.PublicId,
+				"bucketName": request.BucketName,
+				"expiresAt":  request.ExpiresAt,
+			},
+		}, request)
+	}
+
 	err = s.entitlements.Licenses().Set(ctx, user.ID, currentLicenses)
 	if err != nil {
 		return apiError(http.StatusInternalServerError, err)
@@ -310,16 +325,16 @@ func (s *Service) RevokeUserLicense(ctx context.Context, authInfo *AuthInfo, use
 		Timestamp:  s.nowFn(),
 	})
 
-	return api.HTTPError{}
+	return nil, api.HTTPError{}
 }
 
 // DeleteUserLicense permanently removes a license from a user.
-func (s *Service) DeleteUserLicense(ctx context.Context, authInfo *AuthInfo, userID uuid.UUID, request DeleteLicenseRequest) api.HTTPError {
+func (s *Service) DeleteUserLicense(ctx context.Context, authInfo *AuthInfo, userID uuid.UUID, request DeleteLicenseRequest) (*ApprovalRequest, api.HTTPError) {
 	var err error
 	defer mon.Task()(&ctx)(&err)
 
-	apiError := func(status int, err error) api.HTTPError {
-		return api.HTTPError{
+	apiError := func(status int, err error) (*ApprovalRequest, api.HTTPError) {
+		return nil, api.HTTPError{
 			Status: status, Err: Error.Wrap(err),
 		}
 	}
@@ -371,6 +386,21 @@ func (s *Service) DeleteUserLicense(ctx context.Context, authInfo *AuthInfo, use
 		return apiError(http.StatusNotFound, errs.New("license not found"))
 	}
 
+	if s.requiresApproval(ctx, OperationDeleteUserLicense) {
+		return s.requestApproval(ctx, authInfo, ApprovalRequest{
+			Operation: OperationDeleteUserLicense,
+			ItemType:  changehistory.ItemTypeUser,
+			UserID:    user.ID,
+			Reason:    request.Reason,
+			Details: map[string]any{
+				"type":       request.Type,
+				"publicId":   request.PublicId,
+				"bucketName": request.BucketName,
+				"expiresAt":  request.ExpiresAt,
+			},
+		}, request)
+	}
+
 	err = s.entitlements.Licenses().Set(ctx, user.ID, currentLicenses)
 	if err != nil {
 		return apiError(http.StatusInternalServerError, err)
@@ -392,7 +422,7 @@ func (s *Service) DeleteUserLicense(ctx context.Context, authInfo *AuthInfo, use
 		Timestamp:  s.nowFn(),
 	})
 
-	return api.HTTPError{}
+	return nil, api.HTTPError{}
 }
 
 // UpdateUserLicense updates a license's expiration time for a user.
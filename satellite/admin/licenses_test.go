@@ -193,7 +193,7 @@ func TestAdmin_LicenseManagement(t *testing.T) {
 				Reason:    "Test revocation",
 			}
 
-			apiErr = service.RevokeUserLicense(ctx, authInfo, consoleUser.ID, request)
+			_, apiErr = service.RevokeUserLicense(ctx, authInfo, consoleUser.ID, request)
 			require.NoError(t, apiErr.Err)
 
 			// Verify license was revoked
@@ -251,7 +251,7 @@ func TestAdmin_LicenseManagement(t *testing.T) {
 				Reason:    "Revoke nonexistent",
 			}
 
-			apiErr := service.RevokeUserLicense(ctx, authInfo, consoleUser.ID, request)
+			_, apiErr := service.RevokeUserLicense(ctx, authInfo, consoleUser.ID, request)
 			require.Equal(t, http.StatusNotFound, apiErr.Status)
 		})
 
@@ -262,7 +262,7 @@ func TestAdmin_LicenseManagement(t *testing.T) {
 				ExpiresAt: time.Now().Add(30 * 24 * time.Hour),
 			}
 
-			apiErr := service.RevokeUserLicense(ctx, authInfo, consoleUser.ID, request)
+			_, apiErr := service.RevokeUserLicense(ctx, authInfo, consoleUser.ID, request)
 			require.Equal(t, http.StatusBadRequest, apiErr.Status)
 		})
 
@@ -290,7 +290,7 @@ func TestAdmin_LicenseManagement(t *testing.T) {
 				Reason:     "Test deletion",
 			}
 
-			apiErr = service.DeleteUserLicense(ctx, authInfo, consoleUser.ID, request)
+			_, apiErr = service.DeleteUserLicense(ctx, authInfo, consoleUser.ID, request)
 			require.NoError(t, apiErr.Err)
 
 			// Verify license was removed
@@ -311,7 +311,7 @@ func TestAdmin_LicenseManagement(t *testing.T) {
 				Reason:    "Delete nonexistent",
 			}
 
-			apiErr := service.DeleteUserLicense(ctx, authInfo, consoleUser.ID, request)
+			_, apiErr := service.DeleteUserLicense(ctx, authInfo, consoleUser.ID, request)
 			require.Equal(t, http.StatusNotFound, apiErr.Status)
 		})
 
@@ -321,7 +321,7 @@ func TestAdmin_LicenseManagement(t *testing.T) {
 				ExpiresAt: time.Now().Add(30 * 24 * time.Hour),
 			}
 
-			apiErr := service.DeleteUserLicense(ctx, authInfo, consoleUser.ID, request)
+			_, apiErr := service.DeleteUserLicense(ctx, authInfo, consoleUser.ID, request)
 			require.Equal(t, http.StatusBadRequest, apiErr.Status)
 		})
 
@@ -424,7 +424,7 @@ func TestAdmin_LicenseManagement(t *testing.T) {
 				ExpiresAt:  newExpiresAt,
 				Reason:     "Cleanup after test",
 			}
-			apiErr = service.DeleteUserLicense(ctx, authInfo, consoleUser.ID, deleteReq)
+			_, apiErr = service.DeleteUserLicense(ctx, authInfo, consoleUser.ID, deleteReq)
 			require.NoError(t, apiErr.Err)
 		})
 
@@ -475,7 +475,7 @@ func TestAdmin_LicenseManagement(t *testing.T) {
 				ExpiresAt: newExpiresAt,
 				Reason:    "Cleanup",
 			}
-			apiErr = service.DeleteUserLicense(ctx, authInfo, consoleUser.ID, deleteReq)
+			_, apiErr = service.DeleteUserLicense(ctx, authInfo, consoleUser.ID, deleteReq)
 			require.NoError(t, apiErr.Err)
 		})
 
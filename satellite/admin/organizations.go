@@ -0,0 +1,271 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"storj.io/common/memory"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/api"
+	"storj.io/storj/satellite/admin/auditlogger"
+	"storj.io/storj/satellite/admin/changehistory"
+	"storj.io/storj/satellite/console"
+)
+
+// OrganizationAccount holds information about an organization, its members and its projects.
+type OrganizationAccount struct {
+	console.Organization
+	Members  []console.OrganizationMember `json:"members"`
+	Projects []OrganizationProject        `json:"projects"`
+}
+
+// OrganizationProject holds basic information about a project of an organization.
+type OrganizationProject struct {
+	ID   uuid.UUID `json:"id"` // This is the public ID
+	Name string    `json:"name"`
+}
+
+// UpdateOrganizationLimitsRequest represents a request to update the limits of an organization.
+// Nil limits are left unchanged, while setting a limit to 0 removes it.
+type UpdateOrganizationLimitsRequest struct {
+	ProjectLimit   *int   `json:"projectLimit"`
+	StorageLimit   *int64 `json:"storageLimit"`
+	BandwidthLimit *int64 `json:"bandwidthLimit"`
+	SegmentLimit   *int64 `json:"segmentLimit"`
+
+	Reason string `json:"reason"` // reason for audit log
+}
+
+// TransferProjectToOrganizationRequest represents a request to move a project into an organization.
+type TransferProjectToOrganizationRequest struct {
+	Reason string `json:"reason"` // reason for audit log
+}
+
+// GetOrganization gets the organization with its members and projects.
+func (s *Service) GetOrganization(ctx context.Context, organizationID uuid.UUID) (*OrganizationAccount, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	organization, apiErr := s.getOrganization(ctx, organizationID)
+	if apiErr.Err != nil {
+		return nil, apiErr
+	}
+
+	members, err := s.consoleDB.Organizations().GetMembers(ctx, organization.ID)
+	if err != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusInternalServerError,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	projectIDs, err := s.consoleDB.Organizations().GetProjectIDs(ctx, organization.ID)
+	if err != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusInternalServerError,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	projects := make([]OrganizationProject, 0, len(projectIDs))
+	for _, id := range projectIDs {
+		p, err := s.consoleDB.Projects().Get(ctx, id)
+		if err != nil {
+			return nil, api.HTTPError{
+				Status: http.StatusInternalServerError,
+				Err:    Error.Wrap(err),
+			}
+		}
+		projects = append(projects, OrganizationProject{ID: p.PublicID, Name: p.Name})
+	}
+
+	return &OrganizationAccount{
+		Organization: *organization,
+		Members:      members,
+		Projects:     projects,
+	}, api.HTTPError{}
+}
+
+// UpdateOrganizationLimits updates the limits of the organization.
+func (s *Service) UpdateOrganizationLimits(ctx context.Context, authInfo *AuthInfo, organizationID uuid.UUID, request UpdateOrganizationLimitsRequest) api.HTTPError {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if authInfo == nil {
+		return api.HTTPError{
+			Status: http.StatusUnauthorized,
+			Err:    Error.New("not authorized"),
+		}
+	}
+
+	if request.Reason == "" {
+		return api.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    Error.New("reason is required"),
+		}
+	}
+
+	for _, limit := range []*int64{request.StorageLimit, request.BandwidthLimit, request.SegmentLimit} {
+		if limit != nil && *limit < 0 {
+			return api.HTTPError{
+				Status: http.StatusBadRequest,
+				Err:    Error.New("limits can't be negative"),
+			}
+		}
+	}
+	if request.ProjectLimit != nil && *request.ProjectLimit < 0 {
+		return api.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    Error.New("limits can't be negative"),
+		}
+	}
+
+	organization, apiErr := s.getOrganization(ctx, organizationID)
+	if apiErr.Err != nil {
+		return apiErr
+	}
+	beforeState := *organization
+
+	if request.ProjectLimit != nil {
+		organization.ProjectLimit = nil
+		if *request.ProjectLimit != 0 {
+			organization.ProjectLimit = request.ProjectLimit
+		}
+	}
+	if request.StorageLimit != nil {
+		organization.StorageLimit = optionalSize(*request.StorageLimit)
+	}
+	if request.BandwidthLimit != nil {
+		organization.BandwidthLimit = optionalSize(*request.BandwidthLimit)
+	}
+	if request.SegmentLimit != nil {
+		organization.SegmentLimit = nil
+		if *request.SegmentLimit != 0 {
+			organization.SegmentLimit = request.SegmentLimit
+		}
+	}
+
+	err = s.consoleDB.Organizations().Update(ctx, *organization)
+	if err != nil {
+		return api.HTTPError{
+			Status: http.StatusInternalServerError,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	s.auditLogger.EnqueueChangeEvent(auditlogger.Event{
+		UserID:     organization.OwnerID,
+		Action:     "update_organization_limits",
+		AdminEmail: authInfo.Email,
+		ItemType:   changehistory.ItemTypeUser,
+		Reason:     request.Reason,
+		Before:     beforeState,
+		After:      *organization,
+		Timestamp:  s.nowFn(),
+	})
+
+	return api.HTTPError{}
+}
+
+// TransferProjectToOrganization moves the project into the organization. The
+// organization's owner becomes the owner of the project, which moves its
+// billing to the organization. Unlike the console, the limits of the
+// organization aren't enforced, but the project limit of the owner is.
+func (s *Service) TransferProjectToOrganization(ctx context.Context, authInfo *AuthInfo, organizationID, publicID uuid.UUID, request TransferProjectToOrganizationRequest) api.HTTPError {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if authInfo == nil {
+		return api.HTTPError{
+			Status: http.StatusUnauthorized,
+			Err:    Error.New("not authorized"),
+		}
+	}
+
+	if request.Reason == "" {
+		return api.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    Error.New("reason is required"),
+		}
+	}
+
+	organization, apiErr := s.getOrganization(ctx, organizationID)
+	if apiErr.Err != nil {
+		return apiErr
+	}
+
+	p, err := s.consoleDB.Projects().GetByPublicID(ctx, publicID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, sql.ErrNoRows) {
+			status = http.StatusNotFound
+			err = errors.New("project not found")
+		}
+		return api.HTTPError{
+			Status: status,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	err = s.consoleDB.WithTx(ctx, func(ctx context.Context, tx console.DBTx) error {
+		return console.AddProjectToOrganization(ctx, tx, organization, p.ID)
+	})
+	if err != nil {
+		status := http.StatusInternalServerError
+		if console.ErrOrganizationProjectAlreadyExists.Has(err) {
+			status = http.StatusConflict
+			err = errors.New("project already belongs to an organization")
+		} else if console.ErrProjLimit.Has(err) {
+			status = http.StatusConflict
+			err = errors.New("organization owner reached their project limit")
+		}
+		return api.HTTPError{
+			Status: status,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	s.auditLogger.EnqueueChangeEvent(auditlogger.Event{
+		UserID:     p.OwnerID,
+		ProjectID:  &p.PublicID,
+		Action:     "transfer_project_to_organization",
+		AdminEmail: authInfo.Email,
+		ItemType:   changehistory.ItemTypeProject,
+		Reason:     request.Reason,
+		Before:     map[string]any{"ownerID": p.OwnerID, "organizationID": nil},
+		After:      map[string]any{"ownerID": organization.OwnerID, "organizationID": organization.ID},
+		Timestamp:  s.nowFn(),
+	})
+
+	return api.HTTPError{}
+}
+
+func (s *Service) getOrganization(ctx context.Context, organizationID uuid.UUID) (*console.Organization, api.HTTPError) {
+	organization, err := s.consoleDB.Organizations().Get(ctx, organizationID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if console.ErrNoOrganization.Has(err) {
+			status = http.StatusNotFound
+			err = errors.New("organization not found")
+		}
+		return nil, api.HTTPError{
+			Status: status,
+			Err:    Error.Wrap(err),
+		}
+	}
+	return organization, api.HTTPError{}
+}
+
+// optionalSize converts the size into a limit, where 0 means no limit.
+func optionalSize(size int64) *memory.Size {
+	if size == 0 {
+		return nil
+	}
+	limit := memory.Size(size)
+	return &limit
+}
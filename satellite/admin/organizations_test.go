@@ -0,0 +1,117 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	backoffice "storj.io/storj/satellite/admin"
+	"storj.io/storj/satellite/console"
+)
+
+func TestOrganizationManagement(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.Admin.Admin.Service
+		organizations := sat.DB.Console().Organizations()
+
+		owner, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Owner", Email: "owner@test.io",
+		}, 1)
+		require.NoError(t, err)
+		member, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Member", Email: "member@test.io",
+		}, 1)
+		require.NoError(t, err)
+
+		organization, err := organizations.Insert(ctx, console.Organization{
+			ID:      testrand.UUID(),
+			Name:    "Example Org",
+			OwnerID: owner.ID,
+		})
+		require.NoError(t, err)
+		_, err = organizations.InsertMember(ctx, organization.ID, owner.ID, console.OrganizationRoleOwner)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, member.ID, "project")
+		require.NoError(t, err)
+
+		authInfo := &backoffice.AuthInfo{Email: "test@example.com"}
+
+		t.Run("get", func(t *testing.T) {
+			_, apiErr := service.GetOrganization(ctx, testrand.UUID())
+			require.Equal(t, http.StatusNotFound, apiErr.Status)
+
+			account, apiErr := service.GetOrganization(ctx, organization.ID)
+			require.NoError(t, apiErr.Err)
+			require.Equal(t, organization.Name, account.Name)
+			require.Len(t, account.Members, 1)
+			require.Empty(t, account.Projects)
+		})
+
+		t.Run("update limits", func(t *testing.T) {
+			storageLimit := memory.TB.Int64()
+			projectLimit := 3
+
+			request := backoffice.UpdateOrganizationLimitsRequest{StorageLimit: &storageLimit, ProjectLimit: &projectLimit}
+			apiErr := service.UpdateOrganizationLimits(ctx, authInfo, organization.ID, request)
+			require.Error(t, apiErr.Err)
+			require.Contains(t, apiErr.Err.Error(), "reason is required")
+
+			request.Reason = "reason"
+			require.NoError(t, service.UpdateOrganizationLimits(ctx, authInfo, organization.ID, request).Err)
+
+			got, err := organizations.Get(ctx, organization.ID)
+			require.NoError(t, err)
+			require.Equal(t, memory.TB, *got.StorageLimit)
+			require.Equal(t, projectLimit, *got.ProjectLimit)
+
+			// nil limits are left unchanged, while 0 removes the limit.
+			zero := int64(0)
+			request = backoffice.UpdateOrganizationLimitsRequest{StorageLimit: &zero, Reason: "reason"}
+			require.NoError(t, service.UpdateOrganizationLimits(ctx, authInfo, organization.ID, request).Err)
+
+			got, err = organizations.Get(ctx, organization.ID)
+			require.NoError(t, err)
+			require.Nil(t, got.StorageLimit)
+			require.Equal(t, projectLimit, *got.ProjectLimit)
+		})
+
+		t.Run("transfer project", func(t *testing.T) {
+			request := backoffice.TransferProjectToOrganizationRequest{Reason: "reason"}
+
+			apiErr := service.TransferProjectToOrganization(ctx, authInfo, organization.ID, testrand.UUID(), request)
+			require.Equal(t, http.StatusNotFound, apiErr.Status)
+
+			require.NoError(t, service.TransferProjectToOrganization(ctx, authInfo, organization.ID, project.PublicID, request).Err)
+
+			apiErr = service.TransferProjectToOrganization(ctx, authInfo, organization.ID, project.PublicID, request)
+			require.Equal(t, http.StatusConflict, apiErr.Status)
+
+			got, err := sat.DB.Console().Projects().Get(ctx, project.ID)
+			require.NoError(t, err)
+			require.Equal(t, owner.ID, got.OwnerID)
+
+			account, apiErr := service.GetOrganization(ctx, organization.ID)
+			require.NoError(t, apiErr.Err)
+			require.Len(t, account.Projects, 1)
+			require.Equal(t, project.PublicID, account.Projects[0].ID)
+
+			// the project doesn't fit into the project limit of the organization's owner.
+			otherProject, err := sat.AddProject(ctx, member.ID, "other project")
+			require.NoError(t, err)
+			apiErr = service.TransferProjectToOrganization(ctx, authInfo, organization.ID, otherProject.PublicID, request)
+			require.Equal(t, http.StatusConflict, apiErr.Status)
+		})
+	})
+}
@@ -441,6 +441,18 @@ func (s *Service) UpdateProjectLimits(ctx context.Context, authInfo *AuthInfo, i
 		}
 	}
 
+	err = console.CheckProjectLimitUpdates(ctx, s.consoleDB, s.consoleConfig.UsageLimits, p.ID, toUpdate)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if console.ErrConflict.Has(err) {
+			status = http.StatusConflict
+		}
+		return nil, api.HTTPError{
+			Status: status,
+			Err:    Error.Wrap(err),
+		}
+	}
+
 	err = s.consoleDB.Projects().UpdateLimitsGeneric(ctx, p.ID, toUpdate)
 	if err != nil {
 		return nil, api.HTTPError{
@@ -769,12 +781,12 @@ func (s *Service) validateUpdateProjectRequest(ctx context.Context, authInfo *Au
 }
 
 // DisableProject deletes a project by ID.
-func (s *Service) DisableProject(ctx context.Context, authInfo *AuthInfo, id uuid.UUID, request DisableProjectRequest) api.HTTPError {
+func (s *Service) DisableProject(ctx context.Context, authInfo *AuthInfo, id uuid.UUID, request DisableProjectRequest) (*ApprovalRequest, api.HTTPError) {
 	var err error
 	defer mon.Task()(&ctx)(&err)
 
-	apiError := func(status int, err error) api.HTTPError {
-		return api.HTTPError{
+	apiError := func(status int, err error) (*ApprovalRequest, api.HTTPError) {
+		return nil, api.HTTPError{
 			Status: status, Err: Error.Wrap(err),
 		}
 	}
@@ -816,6 +828,21 @@ func (s *Service) DisableProject(ctx context.Context, authInfo *AuthInfo, id uui
 		return apiError(http.StatusInternalServerError, err)
 	}
 
+	operation := OperationDisableProject
+	if request.SetPendingDeletion {
+		operation = OperationMarkProjectPendingDeletion
+	}
+	if s.requiresApproval(ctx, operation) {
+		return s.requestApproval(ctx, authInfo, ApprovalRequest{
+			Operation: operation,
+			ItemType:  changehistory.ItemTypeProject,
+			UserID:    p.OwnerID,
+			ProjectID: &p.PublicID,
+			Reason:    request.Reason,
+			Details:   map[string]any{"setPendingDeletion": request.SetPendingDeletion},
+		}, request)
+	}
+
 	afterState := *p
 	disabledStatus := console.ProjectDisabled
 	if request.SetPendingDeletion {
@@ -848,12 +875,12 @@ func (s *Service) DisableProject(ctx context.Context, authInfo *AuthInfo, id uui
 
 		auditLog("force_disable_project")
 
-		return api.HTTPError{}
+		return nil, api.HTTPError{}
 	}
 
 	apiErr := s.checkProjectUsageForDisabling(ctx, user, p)
 	if apiErr.Err != nil {
-		return apiErr
+		return nil, apiErr
 	}
 
 	if request.SetPendingDeletion {
@@ -864,14 +891,14 @@ func (s *Service) DisableProject(ctx context.Context, authInfo *AuthInfo, id uui
 
 		auditLog("mark_project_pending_deletion")
 
-		return api.HTTPError{}
+		return nil, api.HTTPError{}
 	}
 
 	// Check for existing buckets
 	options := buckets.ListOptions{Limit: 1, Direction: buckets.DirectionForward}
 	bucketsList, err := s.buckets.ListBuckets(ctx, p.ID, options, macaroon.AllowedBuckets{All: true})
 	if err != nil {
-		return api.HTTPError{
+		return nil, api.HTTPError{
 			Status: http.StatusInternalServerError,
 			Err:    Error.Wrap(err),
 		}
@@ -887,7 +914,7 @@ func (s *Service) DisableProject(ctx context.Context, authInfo *AuthInfo, id uui
 
 	auditLog("disable_project")
 
-	return api.HTTPError{}
+	return nil, api.HTTPError{}
 }
 
 func (s *Service) checkProjectUsageForDisabling(ctx context.Context, u *console.User, p *console.Project) api.HTTPError {
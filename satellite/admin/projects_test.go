@@ -1102,7 +1102,7 @@ func TestDisableProject(t *testing.T) {
 		t.Run("authorization", func(t *testing.T) {
 			req := admin.DisableProjectRequest{Reason: "reason"}
 			testFailAuth := func(groups []string) {
-				apiErr := service.DisableProject(ctx, &admin.AuthInfo{Groups: groups}, testrand.UUID(), req)
+				_, apiErr := service.DisableProject(ctx, &admin.AuthInfo{Groups: groups}, testrand.UUID(), req)
 				require.True(t, apiErr.Status == http.StatusUnauthorized || apiErr.Status == http.StatusForbidden)
 				require.Error(t, apiErr.Err)
 				require.Contains(t, apiErr.Err.Error(), "not authorized")
@@ -1115,13 +1115,13 @@ func TestDisableProject(t *testing.T) {
 			testFailAuth([]string{"viewer"}) // insufficient permissions
 			req.SetPendingDeletion = false
 
-			apiErr := service.DisableProject(ctx, authInfo, testrand.UUID(), req)
+			_, apiErr := service.DisableProject(ctx, authInfo, testrand.UUID(), req)
 			require.Equal(t, http.StatusNotFound, apiErr.Status)
 			require.Error(t, apiErr.Err)
 		})
 
 		t.Run("non-existent project", func(t *testing.T) {
-			apiErr := service.DisableProject(ctx, authInfo, testrand.UUID(), request)
+			_, apiErr := service.DisableProject(ctx, authInfo, testrand.UUID(), request)
 			require.Error(t, apiErr.Err)
 			assert.Equal(t, http.StatusNotFound, apiErr.Status)
 		})
@@ -1138,7 +1138,7 @@ func TestDisableProject(t *testing.T) {
 			require.NoError(t, err)
 
 			// disable the project
-			apiErr := service.DisableProject(ctx, authInfo, project.PublicID, request)
+			_, apiErr := service.DisableProject(ctx, authInfo, project.PublicID, request)
 			require.NoError(t, apiErr.Err)
 
 			// Verify project status is set to disabled
@@ -1168,7 +1168,7 @@ func TestDisableProject(t *testing.T) {
 			require.NoError(t, err)
 
 			// Attempt to delete the project should fail
-			apiErr := service.DisableProject(ctx, authInfo, project.PublicID, request)
+			_, apiErr := service.DisableProject(ctx, authInfo, project.PublicID, request)
 			require.Error(t, apiErr.Err)
 			assert.Equal(t, http.StatusConflict, apiErr.Status)
 			assert.Contains(t, apiErr.Err.Error(), "buckets still exist")
@@ -1194,7 +1194,7 @@ func TestDisableProject(t *testing.T) {
 
 			require.NoError(t, sat.DB.Orders().UpdateBucketBandwidthSettle(ctx, project.ID, []byte("bucket"), pb.PieceAction_GET, 1000000, 0, time.Now().Add(-2*time.Hour)))
 
-			apiErr := service.DisableProject(ctx, authInfo, project.PublicID, request)
+			_, apiErr := service.DisableProject(ctx, authInfo, project.PublicID, request)
 			require.Error(t, apiErr.Err)
 			require.Equal(t, http.StatusConflict, apiErr.Status)
 			require.Contains(t, apiErr.Err.Error(), "usage for current month exists")
@@ -1202,7 +1202,7 @@ func TestDisableProject(t *testing.T) {
 			_, err = sat.DB.ProjectAccounting().ArchiveRollupsBefore(ctx, time.Now(), 1)
 			require.NoError(t, err)
 
-			apiErr = service.DisableProject(ctx, authInfo, project.PublicID, request)
+			_, apiErr = service.DisableProject(ctx, authInfo, project.PublicID, request)
 			require.NoError(t, apiErr.Err)
 
 			updated, err := consoleDB.Projects().Get(ctx, project.ID)
@@ -1247,7 +1247,7 @@ func TestDisableProject(t *testing.T) {
 			}, 4)
 
 			// Force disable should succeed even with buckets and objects
-			apiErr := service.DisableProject(ctx, authInfo, project.PublicID, request)
+			_, apiErr := service.DisableProject(ctx, authInfo, project.PublicID, request)
 			require.NoError(t, apiErr.Err)
 
 			// Verify project status is disabled
@@ -1279,7 +1279,7 @@ func TestDisableProject(t *testing.T) {
 			require.NoError(t, err)
 
 			// disable the project
-			apiErr := service.DisableProject(ctx, authInfo, project.PublicID, request)
+			_, apiErr := service.DisableProject(ctx, authInfo, project.PublicID, request)
 			require.NoError(t, apiErr.Err)
 
 			// Verify project is disabled
@@ -1316,7 +1316,7 @@ func TestDisableProject(t *testing.T) {
 
 			// Test that disabling fails due to existing usage
 			// even for abbreviated deletion
-			apiErr := service.DisableProject(ctx, authInfo, project.PublicID, request)
+			_, apiErr := service.DisableProject(ctx, authInfo, project.PublicID, request)
 			require.Error(t, apiErr.Err)
 			require.Equal(t, http.StatusConflict, apiErr.Status)
 			require.Contains(t, apiErr.Err.Error(), "usage for current month exists")
@@ -1340,7 +1340,7 @@ func TestDisableProject(t *testing.T) {
 			}, 4)
 
 			// abbreviated disabling should succeed even with buckets and objects
-			apiErr = service.DisableProject(ctx, authInfo, project.PublicID, request)
+			_, apiErr = service.DisableProject(ctx, authInfo, project.PublicID, request)
 			require.NoError(t, apiErr.Err)
 
 			updated, err := consoleDB.Projects().Get(ctx, project.ID)
@@ -32,6 +32,7 @@ import (
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/satellite/payments"
+	"storj.io/storj/shared/flightrecorder"
 )
 
 // Assets contains either the built admin/ui or it is nil.
@@ -63,6 +64,7 @@ type Config struct {
 	UserGroupsRoleFinanceManager  []string `help:"the list of groups whose users has the finance manager role"  releaseDefault:"" devDefault:""`
 
 	AuditLogger auditlogger.Config
+	Approval    ApprovalConfig
 
 	Legacy legacyAdmin.Config
 }
@@ -114,9 +116,11 @@ func NewServer(
 	NewProductManagement(log, mon, service, root)
 	NewUserManagement(log, mon, service, root, service.authorizer)
 	NewProjectManagement(log, mon, service, root, service.authorizer)
+	NewOrganizationManagement(log, mon, service, root, service.authorizer)
 	NewSettings(log, mon, service, root, service.authorizer)
 	NewSearch(log, mon, service, root, service.authorizer)
 	NewChangeHistory(log, mon, service, root, service.authorizer)
+	NewApprovalManagement(log, mon, service, root, service.authorizer)
 	NewNodeManagement(log, mon, service, root, service.authorizer)
 
 	server.legacyServer = legacyAdmin.NewServer(
@@ -236,6 +240,12 @@ func (server *Server) Run(ctx context.Context) error {
 	return group.Wait()
 }
 
+// SetFlightRecorder records the served HTTP requests to the flight recorder. It must be
+// called before Run.
+func (server *Server) SetFlightRecorder(recorder *flightrecorder.Box) {
+	server.server.Handler = recorder.HTTPMiddleware(server.server.Handler)
+}
+
 // Close closes server and underlying listener.
 func (server *Server) Close() error {
 	return Error.Wrap(server.server.Close())
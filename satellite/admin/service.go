@@ -17,6 +17,7 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/private/api"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/admin/approvals"
 	"storj.io/storj/satellite/admin/auditlogger"
 	"storj.io/storj/satellite/admin/changehistory"
 	"storj.io/storj/satellite/analytics"
@@ -49,6 +50,7 @@ type Service struct {
 	accountingDB  accounting.ProjectAccounting
 	consoleDB     console.DB
 	history       changehistory.DB
+	approvalsDB   approvals.DB
 	metabase      *metabase.DB
 	overlayDB     overlay.DB
 
@@ -76,6 +78,7 @@ func NewService(
 	log *zap.Logger,
 	consoleDB console.DB,
 	history changehistory.DB,
+	approvalsDB approvals.DB,
 	attributionDB attribution.DB,
 	accountingDB accounting.ProjectAccounting,
 	accounting *accounting.Service,
@@ -102,6 +105,7 @@ func NewService(
 		log:           log,
 		consoleDB:     consoleDB,
 		history:       history,
+		approvalsDB:   approvalsDB,
 		restKeys:      restKeys,
 		analytics:     analytics,
 		attributionDB: attributionDB,
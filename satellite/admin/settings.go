@@ -31,18 +31,21 @@ type SettingsConsole struct {
 // SettingsAdmin are the settings of this service and the server that exposes it.
 type SettingsAdmin struct {
 	Features FeatureFlags `json:"features"`
+	// ApprovalOperations are the operations that require the approval of a second administrator.
+	ApprovalOperations []string `json:"approvalOperations"`
 }
 
 // FeatureFlags indicates what Admin service features are enabled or disabled. The features are
 // usually disabled when they are not fully implemented.
 type FeatureFlags struct {
-	Account         AccountFlags `json:"account"`
-	Project         ProjectFlags `json:"project"`
-	Bucket          BucketFlags  `json:"bucket"`
-	Dashboard       bool         `json:"dashboard"`
-	Operator        bool         `json:"operator"` // This is the information about the logged operator
-	SignOut         bool         `json:"signOut"`
-	SwitchSatellite bool         `json:"switchSatellite"`
+	Account         AccountFlags  `json:"account"`
+	Project         ProjectFlags  `json:"project"`
+	Bucket          BucketFlags   `json:"bucket"`
+	Approval        ApprovalFlags `json:"approval"`
+	Dashboard       bool          `json:"dashboard"`
+	Operator        bool          `json:"operator"` // This is the information about the logged operator
+	SignOut         bool          `json:"signOut"`
+	SwitchSatellite bool          `json:"switchSatellite"`
 }
 
 // AccountFlags are the feature flags related to user's accounts.
@@ -97,11 +100,19 @@ type BucketFlags struct {
 	History                bool `json:"history"`
 	List                   bool `json:"list"`
 	UpdateInfo             bool `json:"updateInfo"`
+	UpdateLimits           bool `json:"updateLimits"`
 	UpdatePlacement        bool `json:"updatePlacement"`
 	UpdateValueAttribution bool `json:"updateValueAttribution"`
 	View                   bool `json:"view"`
 }
 
+// ApprovalFlags are the feature flags related to the approval of operations that require a second
+// administrator.
+type ApprovalFlags struct {
+	List    bool `json:"list"`
+	Approve bool `json:"approve"`
+}
+
 // GetSettings returns the service settings based on the caller's permissions.
 func (s *Service) GetSettings(_ context.Context, authInfo *AuthInfo) (*Settings, api.HTTPError) {
 	settings := Settings{
@@ -111,6 +122,10 @@ func (s *Service) GetSettings(_ context.Context, authInfo *AuthInfo) (*Settings,
 		},
 	}
 
+	if s.adminConfig.Approval.Enabled {
+		settings.Admin.ApprovalOperations = s.adminConfig.Approval.Operations
+	}
+
 	for _, g := range authInfo.Groups {
 		// account permission features
 		if s.authorizer.HasPermissions(g, PermAccountView) {
@@ -218,9 +233,20 @@ func (s *Service) GetSettings(_ context.Context, authInfo *AuthInfo) (*Settings,
 		if s.authorizer.HasPermissions(g, PermProjectView, PermBucketSetUserAgent) {
 			settings.Admin.Features.Bucket.UpdateValueAttribution = true
 		}
+		if s.authorizer.HasPermissions(g, PermProjectView, PermBucketSetLimits) {
+			settings.Admin.Features.Bucket.UpdateLimits = true
+		}
 		if s.authorizer.HasPermissions(g, PermProjectView, PermBucketView, PermViewChangeHistory) {
 			settings.Admin.Features.Bucket.History = true
 		}
+
+		// approval permission features
+		if s.adminConfig.Approval.Enabled && s.authorizer.HasPermissions(g, PermViewChangeHistory) {
+			settings.Admin.Features.Approval.List = true
+		}
+		if s.adminConfig.Approval.Enabled && s.authorizer.HasPermissions(g, PermApproveOperations) {
+			settings.Admin.Features.Approval.Approve = true
+		}
 	}
 
 	return &settings, api.HTTPError{}
@@ -77,6 +77,7 @@ func TestGetSettings(t *testing.T) {
 					Bucket: backoffice.BucketFlags{
 						List:                   true,
 						View:                   true,
+						UpdateLimits:           true,
 						UpdatePlacement:        true,
 						UpdateValueAttribution: true,
 						History:                true,
@@ -40,12 +40,35 @@ export class AccountMin {
     tenantID: string | null;
 }
 
+export class ApprovalDecisionRequest {
+    reason: string;
+}
+
+export class ApprovalFlags {
+    list: boolean;
+    approve: boolean;
+}
+
+export class ApprovalRequest {
+    id: UUID;
+    operation: string;
+    itemType: string;
+    userID: UUID;
+    projectID?: UUID | null;
+    requestedBy: string;
+    reason: string;
+    details: Record<string, unknown> | null;
+    createdAt: Time;
+    expiresAt: Time;
+}
+
 export class BucketFlags {
     create: boolean;
     delete: boolean;
     history: boolean;
     list: boolean;
     updateInfo: boolean;
+    updateLimits: boolean;
     updatePlacement: boolean;
     updateValueAttribution: boolean;
     view: boolean;
@@ -72,6 +95,12 @@ export class BucketInfoPage {
     totalCount: number;
 }
 
+export class BucketLimits {
+    storage: number | null;
+    bandwidth: number | null;
+    segments: number | null;
+}
+
 export class BucketState {
     empty: boolean;
 }
@@ -132,6 +161,7 @@ export class FeatureFlags {
     account: AccountFlags;
     project: ProjectFlags;
     bucket: BucketFlags;
+    approval: ApprovalFlags;
     dashboard: boolean;
     operator: boolean;
     signOut: boolean;
@@ -194,6 +224,32 @@ export class NodeMinInfo {
     createdAt: Time;
 }
 
+export class OrganizationAccount {
+    id: UUID;
+    name: string;
+    ownerId: UUID;
+    projectLimit: number | null;
+    storageLimit: MemorySize | null;
+    bandwidthLimit: MemorySize | null;
+    segmentLimit: number | null;
+    createdAt: Time;
+    members: OrganizationMember[] | null;
+    projects: OrganizationProject[] | null;
+}
+
+export class OrganizationMember {
+    id: UUID;
+    role: number;
+    createdAt: Time;
+    email: string;
+    fullName: string;
+}
+
+export class OrganizationProject {
+    id: UUID;
+    name: string;
+}
+
 export class PlacementInfo {
     id: number;
     location: string;
@@ -312,6 +368,10 @@ export class ProjectStatusInfo {
     value: number;
 }
 
+export class ResetWebAuthnRequest {
+    reason: string;
+}
+
 export class RevokeLicenseRequest {
     type: string;
     publicId?: string;
@@ -333,6 +393,7 @@ export class Settings {
 
 export class SettingsAdmin {
     features: FeatureFlags;
+    approvalOperations: string[] | null;
 }
 
 export class SettingsConsole {
@@ -350,6 +411,17 @@ export class ToggleMfaRequest {
     reason: string;
 }
 
+export class TransferProjectToOrganizationRequest {
+    reason: string;
+}
+
+export class UpdateBucketLimitsRequest {
+    storage: number | null;
+    bandwidth: number | null;
+    segments: number | null;
+    reason: string;
+}
+
 export class UpdateBucketRequest {
     userAgent: string | null;
     placement: number | null;
@@ -365,6 +437,14 @@ export class UpdateLicenseRequest {
     reason: string;
 }
 
+export class UpdateOrganizationLimitsRequest {
+    projectLimit: number | null;
+    storageLimit: number | null;
+    bandwidthLimit: number | null;
+    segmentLimit: number | null;
+    reason: string;
+}
+
 export class UpdateProjectEntitlementsRequest {
     newBucketPlacements: number[] | null;
     computeAccessToken: string | null;
@@ -431,6 +511,7 @@ export class UserAccount {
     trialExpiration: Time | null;
     mfaEnabled: boolean;
     tenantID: string | null;
+    pendingApproval?: ApprovalRequest | null;
 }
 
 export class UserLicense {
@@ -647,6 +728,16 @@ export class UserManagementHttpApiV1 {
         throw new APIError(err.error, response.status);
     }
 
+    public async resetWebAuthn(request: ResetWebAuthnRequest, userID: UUID): Promise<void> {
+        const fullPath = `${this.ROOT_PATH}/${userID}/webauthn`;
+        const response = await this.http.delete(fullPath, JSON.stringify(request));
+        if (response.ok) {
+            return;
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
     public async createRestKey(request: CreateRestKeyRequest, userID: UUID): Promise<string> {
         const fullPath = `${this.ROOT_PATH}/rest-keys/${userID}`;
         const response = await this.http.post(fullPath, JSON.stringify(request));
@@ -687,21 +778,21 @@ export class UserManagementHttpApiV1 {
         throw new APIError(err.error, response.status);
     }
 
-    public async revokeUserLicense(request: RevokeLicenseRequest, userID: UUID): Promise<void> {
+    public async revokeUserLicense(request: RevokeLicenseRequest, userID: UUID): Promise<ApprovalRequest> {
         const fullPath = `${this.ROOT_PATH}/${userID}/licenses`;
         const response = await this.http.delete(fullPath, JSON.stringify(request));
         if (response.ok) {
-            return;
+            return response.json().then((body) => body as ApprovalRequest);
         }
         const err = await response.json();
         throw new APIError(err.error, response.status);
     }
 
-    public async deleteUserLicense(request: DeleteLicenseRequest, userID: UUID): Promise<void> {
+    public async deleteUserLicense(request: DeleteLicenseRequest, userID: UUID): Promise<ApprovalRequest> {
         const fullPath = `${this.ROOT_PATH}/${userID}/licenses/delete`;
         const response = await this.http.post(fullPath, JSON.stringify(request));
         if (response.ok) {
-            return;
+            return response.json().then((body) => body as ApprovalRequest);
         }
         const err = await response.json();
         throw new APIError(err.error, response.status);
@@ -778,6 +869,26 @@ export class ProjectManagementHttpApiV1 {
         throw new APIError(err.error, response.status);
     }
 
+    public async getBucketLimits(publicID: UUID, bucketName: string): Promise<BucketLimits> {
+        const fullPath = `${this.ROOT_PATH}/${publicID}/buckets/${bucketName}/limits`;
+        const response = await this.http.get(fullPath);
+        if (response.ok) {
+            return response.json().then((body) => body as BucketLimits);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async updateBucketLimits(request: UpdateBucketLimitsRequest, publicID: UUID, bucketName: string): Promise<void> {
+        const fullPath = `${this.ROOT_PATH}/${publicID}/buckets/${bucketName}/limits`;
+        const response = await this.http.put(fullPath, JSON.stringify(request));
+        if (response.ok) {
+            return;
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
     public async updateProject(request: UpdateProjectRequest, publicID: UUID): Promise<Project> {
         const fullPath = `${this.ROOT_PATH}/${publicID}`;
         const response = await this.http.patch(fullPath, JSON.stringify(request));
@@ -788,11 +899,11 @@ export class ProjectManagementHttpApiV1 {
         throw new APIError(err.error, response.status);
     }
 
-    public async disableProject(request: DisableProjectRequest, publicID: UUID): Promise<void> {
+    public async disableProject(request: DisableProjectRequest, publicID: UUID): Promise<ApprovalRequest> {
         const fullPath = `${this.ROOT_PATH}/${publicID}`;
         const response = await this.http.put(fullPath, JSON.stringify(request));
         if (response.ok) {
-            return;
+            return response.json().then((body) => body as ApprovalRequest);
         }
         const err = await response.json();
         throw new APIError(err.error, response.status);
@@ -835,6 +946,41 @@ export class ProjectManagementHttpApiV1 {
     }
 }
 
+export class OrganizationManagementHttpApiV1 {
+    private readonly http: HttpClient = new HttpClient();
+    private readonly ROOT_PATH: string = '/api/v1/organizations';
+
+    public async getOrganization(organizationID: UUID): Promise<OrganizationAccount> {
+        const fullPath = `${this.ROOT_PATH}/${organizationID}`;
+        const response = await this.http.get(fullPath);
+        if (response.ok) {
+            return response.json().then((body) => body as OrganizationAccount);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async updateOrganizationLimits(request: UpdateOrganizationLimitsRequest, organizationID: UUID): Promise<void> {
+        const fullPath = `${this.ROOT_PATH}/${organizationID}/limits`;
+        const response = await this.http.put(fullPath, JSON.stringify(request));
+        if (response.ok) {
+            return;
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async transferProjectToOrganization(request: TransferProjectToOrganizationRequest, organizationID: UUID, publicID: UUID): Promise<void> {
+        const fullPath = `${this.ROOT_PATH}/${organizationID}/projects/${publicID}`;
+        const response = await this.http.post(fullPath, JSON.stringify(request));
+        if (response.ok) {
+            return;
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+}
+
 export class SearchHttpApiV1 {
     private readonly http: HttpClient = new HttpClient();
     private readonly ROOT_PATH: string = '/api/v1/search';
@@ -871,6 +1017,41 @@ export class ChangeHistoryHttpApiV1 {
     }
 }
 
+export class ApprovalManagementHttpApiV1 {
+    private readonly http: HttpClient = new HttpClient();
+    private readonly ROOT_PATH: string = '/api/v1/approvals';
+
+    public async getApprovalRequests(): Promise<ApprovalRequest[]> {
+        const fullPath = `${this.ROOT_PATH}/`;
+        const response = await this.http.get(fullPath);
+        if (response.ok) {
+            return response.json().then((body) => body as ApprovalRequest[]);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async approveRequest(request: ApprovalDecisionRequest, requestID: UUID): Promise<void> {
+        const fullPath = `${this.ROOT_PATH}/${requestID}/approve`;
+        const response = await this.http.post(fullPath, JSON.stringify(request));
+        if (response.ok) {
+            return;
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async rejectRequest(request: ApprovalDecisionRequest, requestID: UUID): Promise<void> {
+        const fullPath = `${this.ROOT_PATH}/${requestID}/reject`;
+        const response = await this.http.post(fullPath, JSON.stringify(request));
+        if (response.ok) {
+            return;
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+}
+
 export class NodeManagementHttpApiV1 {
     private readonly http: HttpClient = new HttpClient();
     private readonly ROOT_PATH: string = '/api/v1/nodes';
@@ -14,6 +14,8 @@ export abstract class ROUTES {
     public static ProjectDetail = new NavigationLink('/projects-details', 'Project Details');
 
     public static NodeDetail = new NavigationLink('/nodes/:nodeID', 'Node Detail');
+
+    public static Approvals = new NavigationLink('/approvals', 'Approvals');
 }
 
 const routes = [
@@ -60,6 +62,11 @@ const routes = [
                 name: ROUTES.NodeDetail.name,
                 component: () => import(/* webpackChunkName: "NodeDetail" */ '@/views/NodeDetail.vue'),
             },
+            {
+                path: ROUTES.Approvals.path,
+                name: ROUTES.Approvals.name,
+                component: () => import(/* webpackChunkName: "Approvals" */ '@/views/Approvals.vue'),
+            },
         ],
     },
 ];
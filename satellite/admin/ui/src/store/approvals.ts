@@ -0,0 +1,42 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+import { defineStore } from 'pinia';
+import { reactive } from 'vue';
+
+import { ApprovalDecisionRequest, ApprovalManagementHttpApiV1, ApprovalRequest } from '@/api/client.gen';
+
+class ApprovalsState {
+    public requests: ApprovalRequest[] = [];
+}
+
+export const useApprovalsStore = defineStore('approvals', () => {
+    const state = reactive<ApprovalsState>(new ApprovalsState());
+
+    const approvalsApi = new ApprovalManagementHttpApiV1();
+
+    async function getRequests(): Promise<void> {
+        state.requests = await approvalsApi.getApprovalRequests();
+    }
+
+    async function approveRequest(requestID: string, reason: string): Promise<void> {
+        const request = new ApprovalDecisionRequest();
+        request.reason = reason;
+        await approvalsApi.approveRequest(request, requestID);
+        state.requests = state.requests.filter(r => r.id !== requestID);
+    }
+
+    async function rejectRequest(requestID: string, reason: string): Promise<void> {
+        const request = new ApprovalDecisionRequest();
+        request.reason = reason;
+        await approvalsApi.rejectRequest(request, requestID);
+        state.requests = state.requests.filter(r => r.id !== requestID);
+    }
+
+    return {
+        state,
+        getRequests,
+        approveRequest,
+        rejectRequest,
+    };
+});
@@ -5,6 +5,7 @@ import { reactive } from 'vue';
 import { defineStore } from 'pinia';
 
 import {
+    ApprovalRequest,
     ChangeHistoryHttpApiV1,
     ChangeLog,
     DisableProjectRequest,
@@ -55,7 +56,7 @@ export const useProjectsStore = defineStore('projects', () => {
         return await projectApi.updateProject(request, projectID);
     }
 
-    async function disableProject(projectID: string, setPendingDeletion: boolean, reason: string): Promise<void> {
+    async function disableProject(projectID: string, setPendingDeletion: boolean, reason: string): Promise<ApprovalRequest | null> {
         const request = new DisableProjectRequest();
         request.reason = reason;
         request.setPendingDeletion = setPendingDeletion;
@@ -6,6 +6,7 @@ import { reactive } from 'vue';
 
 import {
     AccountMin,
+    ApprovalRequest,
     ChangeHistoryHttpApiV1,
     ChangeLog,
     CreateRegistrationTokenRequest,
@@ -161,11 +162,11 @@ export const useUsersStore = defineStore('users', () => {
         return userApi.grantUserLicense(request, userID);
     }
 
-    async function revokeUserLicense(userID: string, request: RevokeLicenseRequest): Promise<void> {
+    async function revokeUserLicense(userID: string, request: RevokeLicenseRequest): Promise<ApprovalRequest | null> {
         return userApi.revokeUserLicense(request, userID);
     }
 
-    async function deleteUserLicense(userID: string, request: DeleteLicenseRequest): Promise<void> {
+    async function deleteUserLicense(userID: string, request: DeleteLicenseRequest): Promise<ApprovalRequest | null> {
         return userApi.deleteUserLicense(request, userID);
     }
 
@@ -74,4 +74,4 @@ export enum ItemType {
     User = 'User',
     Project = 'Project',
     Bucket = 'Bucket',
-}
\ No newline at end of file
+}
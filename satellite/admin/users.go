@@ -65,6 +65,9 @@ type UserAccount struct {
 	TrialExpiration  *time.Time                `json:"trialExpiration"`
 	MFAEnabled       bool                      `json:"mfaEnabled"`
 	TenantID         *string                   `json:"tenantID"`
+	// PendingApproval is the approval request created when the operation that returns the
+	// account requires the approval of another administrator, so it hasn't been performed yet.
+	PendingApproval *ApprovalRequest `json:"pendingApproval,omitempty"`
 }
 
 // UpdateUserRequest represents a request to update a user.
@@ -153,6 +156,11 @@ type ToggleMfaRequest struct {
 	Reason string `json:"reason"` // reason for audit log
 }
 
+// ResetWebAuthnRequest represents a request to remove all security keys and passkeys of a user.
+type ResetWebAuthnRequest struct {
+	Reason string `json:"reason"` // reason for audit log
+}
+
 // UserProject is project owned by a user with  basic information, usage, and limits.
 type UserProject struct {
 	ID                   uuid.UUID `json:"-"`
@@ -571,6 +579,9 @@ func (s *Service) UpdateUser(ctx context.Context, authInfo *AuthInfo, userID uui
 					Kind: console.SegmentLimit, Value: request.SegmentLimit,
 				})
 			}
+			if err = console.CheckProjectLimitUpdates(ctx, tx, s.consoleConfig.UsageLimits, p.ID, toUpdate); err != nil {
+				return err
+			}
 			if err = projectsDB.UpdateLimitsGeneric(ctx, p.ID, toUpdate); err != nil {
 				return err
 			}
@@ -593,8 +604,12 @@ func (s *Service) UpdateUser(ctx context.Context, authInfo *AuthInfo, userID uui
 		return nil
 	})
 	if err != nil {
+		status := http.StatusInternalServerError
+		if console.ErrConflict.Has(err) {
+			status = http.StatusConflict
+		}
 		return nil, api.HTTPError{
-			Status: http.StatusInternalServerError,
+			Status: status,
 			Err:    Error.Wrap(err),
 		}
 	}
@@ -1008,6 +1023,31 @@ func (s *Service) DisableUser(ctx context.Context, authInfo *AuthInfo, userID uu
 		return apiError(status, err)
 	}
 
+	operation := OperationDisableUser
+	if request.SetPendingDeletion {
+		operation = OperationMarkUserPendingDeletion
+	}
+	if s.requiresApproval(ctx, operation) {
+		approvalRequest, apiErr := s.requestApproval(ctx, authInfo, ApprovalRequest{
+			Operation: operation,
+			ItemType:  changehistory.ItemTypeUser,
+			UserID:    user.ID,
+			Reason:    request.Reason,
+			Details:   map[string]any{"setPendingDeletion": request.SetPendingDeletion},
+		}, request)
+		if apiErr.Err != nil {
+			return nil, apiErr
+		}
+
+		account, apiErr := s.getUserAccount(ctx, user)
+		if apiErr.Err != nil {
+			return nil, apiErr
+		}
+		account.PendingApproval = approvalRequest
+
+		return account, api.HTTPError{}
+	}
+
 	if !request.SetPendingDeletion {
 		projects, err := s.consoleDB.Projects().GetOwnActive(ctx, user.ID)
 		if err != nil {
@@ -1182,6 +1222,67 @@ func (s *Service) ToggleMFA(ctx context.Context, authInfo *AuthInfo, userID uuid
 	return api.HTTPError{}
 }
 
+// ResetWebAuthn removes all security keys and passkeys of a user, e.g. when they lost them.
+func (s *Service) ResetWebAuthn(ctx context.Context, authInfo *AuthInfo, userID uuid.UUID, request ResetWebAuthnRequest) api.HTTPError {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if authInfo == nil {
+		return api.HTTPError{
+			Status: http.StatusUnauthorized,
+			Err:    Error.New("not authorized"),
+		}
+	}
+
+	if request.Reason == "" {
+		return api.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    Error.New("reason is required"),
+		}
+	}
+
+	user, err := s.consoleDB.Users().Get(ctx, userID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, sql.ErrNoRows) {
+			status = http.StatusNotFound
+			err = errors.New("user not found")
+		}
+		return api.HTTPError{
+			Status: status, Err: Error.Wrap(err),
+		}
+	}
+
+	credentials, err := s.consoleDB.WebAuthnCredentials().GetByUserID(ctx, user.ID)
+	if err != nil {
+		return api.HTTPError{
+			Status: http.StatusInternalServerError,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	_, err = s.consoleDB.WebAuthnCredentials().DeleteByUserID(ctx, user.ID)
+	if err != nil {
+		return api.HTTPError{
+			Status: http.StatusInternalServerError,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	s.auditLogger.EnqueueChangeEvent(auditlogger.Event{
+		UserID:     userID,
+		Action:     "reset_webauthn",
+		AdminEmail: authInfo.Email,
+		ItemType:   changehistory.ItemTypeUser,
+		Reason:     request.Reason,
+		Before:     credentials,
+		After:      []console.WebAuthnCredential{},
+		Timestamp:  s.nowFn(),
+	})
+
+	return api.HTTPError{}
+}
+
 // CreateRestKey creates a new REST API key for a user.
 func (s *Service) CreateRestKey(ctx context.Context, authInfo *AuthInfo, userID uuid.UUID, request CreateRestKeyRequest) (*string, api.HTTPError) {
 	var err error
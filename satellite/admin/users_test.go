@@ -881,6 +881,48 @@ func TestDisableMFA(t *testing.T) {
 	})
 }
 
+func TestResetWebAuthn(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.Admin.Admin.Service
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Test User", Email: "test@test.io",
+		}, 1)
+		require.NoError(t, err)
+
+		credentials := sat.DB.Console().WebAuthnCredentials()
+		for i := range 2 {
+			_, err = credentials.Insert(ctx, console.WebAuthnCredential{
+				ID:           testrand.UUID(),
+				UserID:       user.ID,
+				CredentialID: testrand.BytesInt(16),
+				PublicKey:    testrand.BytesInt(64),
+				Name:         fmt.Sprintf("key %d", i),
+			})
+			require.NoError(t, err)
+		}
+
+		authInfo := &backoffice.AuthInfo{Email: "test@example.com"}
+
+		apiErr := service.ResetWebAuthn(ctx, authInfo, user.ID, backoffice.ResetWebAuthnRequest{})
+		require.Error(t, apiErr.Err)
+		require.Contains(t, apiErr.Err.Error(), "reason is required")
+
+		apiErr = service.ResetWebAuthn(ctx, authInfo, testrand.UUID(), backoffice.ResetWebAuthnRequest{Reason: "reason"})
+		require.Equal(t, http.StatusNotFound, apiErr.Status)
+
+		apiErr = service.ResetWebAuthn(ctx, authInfo, user.ID, backoffice.ResetWebAuthnRequest{Reason: "reason"})
+		require.NoError(t, apiErr.Err)
+
+		list, err := credentials.GetByUserID(ctx, user.ID)
+		require.NoError(t, err)
+		require.Empty(t, list)
+	})
+}
+
 func TestCreateRestKey(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1,
@@ -52,6 +52,7 @@ import (
 	"storj.io/storj/satellite/entitlements"
 	"storj.io/storj/satellite/eventing"
 	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/kms"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/mailservice/hubspotmails"
@@ -70,6 +71,7 @@ import (
 	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/snopayouts"
 	"storj.io/storj/satellite/trust"
+	"storj.io/storj/shared/flightrecorder"
 	"storj.io/storj/shared/nodetag"
 )
 
@@ -226,7 +228,7 @@ type API struct {
 func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 	metabaseDB *metabase.DB, revocationDB extensions.RevocationDB,
 	liveAccounting accounting.Cache, rollupsWriteCache *orders.RollupsWriteCache,
-	config *Config, versionInfo version.Info, atomicLogLevel *zap.AtomicLevel) (*API, error) {
+	config *Config, versionInfo version.Info, atomicLogLevel *zap.AtomicLevel, recorder *flightrecorder.Box) (*API, error) {
 	peer := &API{
 		Log:             log,
 		Identity:        full,
@@ -358,8 +360,13 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		debugConfig := config.Debug
 		debugConfig.ControlTitle = "API"
 
+		extensions := []debug.Extension{migrationModeFlag, trackerInfo, nodeSelectionStats}
+		if recorder != nil {
+			extensions = append(extensions, recorder)
+		}
+
 		peer.Debug.Server = debug.NewServerWithAtomicLevel(log.Named("debug"), peer.Debug.Listener, monkit.Default,
-			debugConfig, atomicLogLevel, migrationModeFlag, trackerInfo, nodeSelectionStats)
+			debugConfig, atomicLogLevel, extensions...)
 		peer.Servers.Add(lifecycle.Item{
 			Name:  "debug",
 			Run:   peer.Debug.Server.Run,
@@ -415,6 +422,14 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			},
 			Close: peer.Server.Close,
 		})
+
+		if recorder != nil {
+			peer.Server.SetFlightRecorder(recorder)
+			peer.Services.Add(lifecycle.Item{
+				Name: "flightrecorder",
+				Run:  recorder.Run,
+			})
+		}
 	}
 
 	{ // setup reputation
@@ -463,6 +478,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.LiveAccounting.Cache,
 			*metabaseDB,
 			config.LiveAccounting.BandwidthCacheTTL,
+			config.LiveAccounting.BucketUsageCacheTTL,
 			config.Console.Config.UsageLimits.Storage.Free,
 			config.Console.Config.UsageLimits.Bandwidth.Free,
 			config.Console.Config.UsageLimits.Segment.Free,
@@ -574,6 +590,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.Accounting.ProjectUsage,
 			peer.DB.Console().Projects(),
 			peer.DB.Console().ProjectMembers(),
+			peer.DB.Console().ProjectRoles(),
 			peer.DB.Console().Users(),
 			signing.SignerFromFullIdentity(peer.Identity),
 			peer.DB.Revocation(),
@@ -599,6 +616,10 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			return nil, errs.Combine(err, peer.Close())
 		}
 
+		if err := internalpb.DRPCRegisterBucketLifecycle(peer.Server.DRPC(), peer.Metainfo.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
 		peer.Services.Add(lifecycle.Item{
 			Name:  "metainfo:endpoint",
 			Run:   peer.Metainfo.Endpoint.Run,
@@ -790,6 +811,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 					BillingDB:            peer.DB.Billing(),
 					ProjectsDB:           peer.DB.Console().Projects(),
 					UsersDB:              peer.DB.Console().Users(),
+					OrganizationsDB:      peer.DB.Console().Organizations(),
 					UsageDB:              peer.DB.ProjectAccounting(),
 					RetentionRemainderDB: peer.DB.RetentionRemainderCharges(),
 					Analytics:            peer.Analytics.Service,
@@ -1003,6 +1025,9 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 				config.Entitlements.Enabled,
 				config.SSO.Enabled,
 			)
+			if recorder != nil {
+				peer.Console.Endpoint.SetFlightRecorder(recorder)
+			}
 
 			peer.Servers.Add(lifecycle.Item{
 				Name:  "console:endpoint",
@@ -34,8 +34,13 @@ type Config struct {
 	NodeFilter string `help:"filter expression for participating nodes"`
 	// Grouping is the node attribute used to group nodes (e.g., last_net, country, tag:signer/key).
 	Grouping string `help:"node attribute for grouping nodes" default:"last_net"`
+	// PlanOutput enables the dry-run mode: the jobs are written to this file instead of the queue.
+	PlanOutput string `help:"write the rebalancing plan with the predicted disk utilization to this file (.json or .csv) instead of pushing the jobs to the queue" default:""`
 }
 
+// jobBatchSize is the number of jobs pushed to the queue at once.
+const jobBatchSize = 10
+
 // Job represents a rebalancing task in the queue.
 type Job struct {
 	StreamID   uuid.UUID    `redis:"stream_id"`
@@ -64,6 +69,10 @@ type Balancer struct {
 	// state populated during Start, read-only during Fork/Process
 	nodeCache           map[storj.NodeID]*nodeInfo
 	groupDestCandidates map[string][]*nodeInfo
+
+	// state of the dry-run mode, populated during Join
+	startTime time.Time
+	moves     []PlannedMove
 }
 
 // NewBalancer creates a new Balancer observer.
@@ -91,6 +100,8 @@ func (b *Balancer) Start(ctx context.Context, startTime time.Time) (err error) {
 
 	b.nodeCache = make(map[storj.NodeID]*nodeInfo)
 	b.groupDestCandidates = make(map[string][]*nodeInfo)
+	b.startTime = startTime
+	b.moves = nil
 
 	// Parse node filter.
 	var parsedFilter nodeselection.NodeFilter
@@ -198,22 +209,50 @@ func (b *Balancer) Fork(ctx context.Context) (rangedloop.Partial, error) {
 	}, nil
 }
 
-// Join merges partial results. No-op since jobs are pushed during Process.
+// Join merges partial results. Only the planned moves are collected, since
+// jobs are pushed during Process.
 func (b *Balancer) Join(ctx context.Context, partial rangedloop.Partial) error {
+	fork, ok := partial.(*balancerFork)
+	if !ok {
+		return Error.New("expected %T but got %T", fork, partial)
+	}
+	b.moves = append(b.moves, fork.moves...)
 	return nil
 }
 
 // Finish is called after all segments are processed.
 func (b *Balancer) Finish(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
+
+	if b.dryRun() {
+		plan := newPlan(b.startTime, b.config.Grouping, b.nodeCache, b.moves)
+		if err := plan.Write(b.config.PlanOutput); err != nil {
+			return err
+		}
+		b.log.Info("Balancer plan written",
+			zap.String("path", b.config.PlanOutput),
+			zap.Int("moves", len(plan.Moves)),
+			zap.Int64("bytes", plan.Bytes()),
+			zap.Int("nodes", len(plan.Nodes)),
+			zap.Int("groups", len(plan.Groups)),
+		)
+		return nil
+	}
+
 	b.log.Info("Balancer finished")
 	return nil
 }
 
+// dryRun returns true when the jobs are recorded in a plan instead of being pushed to the queue.
+func (b *Balancer) dryRun() bool {
+	return b.config.PlanOutput != ""
+}
+
 // balancerFork implements rangedloop.Partial.
 type balancerFork struct {
 	observer *Balancer
 	jobs     []any
+	moves    []PlannedMove
 }
 
 var _ rangedloop.Partial = (*balancerFork)(nil)
@@ -348,8 +387,19 @@ func (f *balancerFork) processSegment(ctx context.Context, segment *rangedloop.S
 			atomic.AddInt64(&source.info.currentFree, pieceSize)
 			atomic.AddInt64(&dest.currentFree, -pieceSize)
 
+			if f.observer.dryRun() {
+				f.moves = append(f.moves, PlannedMove{
+					StreamID:   job.StreamID,
+					Position:   job.Position,
+					SourceNode: job.SourceNode,
+					DestNode:   job.DestNode,
+					PieceSize:  pieceSize,
+				})
+				return nil
+			}
+
 			f.jobs = append(f.jobs, job)
-			if len(f.jobs) >= 10 {
+			if len(f.jobs) >= jobBatchSize {
 				err := f.observer.client.PushBatch(ctx, f.observer.config.StreamID, f.jobs)
 				if err != nil {
 					return Error.Wrap(err)
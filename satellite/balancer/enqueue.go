@@ -0,0 +1,66 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package balancer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/satellite/taskqueue"
+	"storj.io/storj/shared/modular"
+)
+
+// EnqueuePlanConfig is the configuration of EnqueuePlan.
+type EnqueuePlanConfig struct {
+	Path string `help:"path of the reviewed rebalancing plan (.json or .csv) to push to the queue" default:""`
+}
+
+// EnqueuePlan pushes the jobs of a previously reviewed plan to the queue, and
+// stops the application.
+type EnqueuePlan struct {
+	log      *zap.Logger
+	client   *taskqueue.Client
+	stop     *modular.StopTrigger
+	streamID string
+	path     string
+}
+
+// NewEnqueuePlan creates a new EnqueuePlan.
+func NewEnqueuePlan(log *zap.Logger, client *taskqueue.Client, stop *modular.StopTrigger, config Config, enqueueConfig EnqueuePlanConfig) *EnqueuePlan {
+	return &EnqueuePlan{
+		log:      log,
+		client:   client,
+		stop:     stop,
+		streamID: config.StreamID,
+		path:     enqueueConfig.Path,
+	}
+}
+
+// Run reads the plan and pushes its moves to the queue.
+func (e *EnqueuePlan) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	defer e.stop.Cancel()
+
+	if e.path == "" {
+		return Error.New("path of the plan is not set")
+	}
+
+	plan, err := ReadPlan(e.path)
+	if err != nil {
+		return err
+	}
+
+	if err := plan.Enqueue(ctx, e.client, e.streamID); err != nil {
+		return err
+	}
+
+	e.log.Info("Balancer plan enqueued",
+		zap.String("path", e.path),
+		zap.String("stream_id", e.streamID),
+		zap.Int("moves", len(plan.Moves)),
+		zap.Int64("bytes", plan.Bytes()),
+	)
+	return nil
+}
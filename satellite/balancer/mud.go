@@ -16,6 +16,10 @@ func Module(ball *mud.Ball) {
 	mud.Tag[*Balancer, mud.Optional](ball, mud.Optional{})
 	mud.Implementation[[]rangedloop.Observer, *Balancer](ball)
 
+	config.RegisterConfig[EnqueuePlanConfig](ball, "balancer.enqueue-plan")
+	mud.Provide[*EnqueuePlan](ball, NewEnqueuePlan)
+	mud.Tag[*EnqueuePlan, mud.Optional](ball, mud.Optional{})
+
 	config.RegisterConfig[WorkerConfig](ball, "balancer.worker")
 	mud.Provide[*Worker](ball, NewWorker)
 	mud.Tag[*Worker, mud.Optional](ball, mud.Optional{})
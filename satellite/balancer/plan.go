@@ -0,0 +1,364 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package balancer
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/taskqueue"
+)
+
+// PlannedMove is a piece move which is recorded in a plan instead of being
+// pushed to the queue.
+type PlannedMove struct {
+	StreamID   uuid.UUID    `json:"stream_id"`
+	Position   uint64       `json:"position"`
+	SourceNode storj.NodeID `json:"source_node"`
+	DestNode   storj.NodeID `json:"dest_node"`
+	PieceSize  int64        `json:"piece_size"`
+}
+
+// Job returns the queue job of the move.
+func (m PlannedMove) Job() Job {
+	return Job{
+		StreamID:   m.StreamID,
+		Position:   m.Position,
+		SourceNode: m.SourceNode,
+		DestNode:   m.DestNode,
+	}
+}
+
+// NodeProjection is the predicted disk utilization of a node after executing a plan.
+type NodeProjection struct {
+	NodeID       storj.NodeID `json:"node_id"`
+	Group        string       `json:"group"`
+	ExpectedFree int64        `json:"expected_free"`
+	FreeBefore   int64        `json:"free_before"`
+	FreeAfter    int64        `json:"free_after"`
+	MovesIn      int64        `json:"moves_in"`
+	MovesOut     int64        `json:"moves_out"`
+	BytesIn      int64        `json:"bytes_in"`
+	BytesOut     int64        `json:"bytes_out"`
+}
+
+// GroupProjection is the predicted disk utilization of a node group after executing a plan.
+type GroupProjection struct {
+	Group        string `json:"group"`
+	Nodes        int64  `json:"nodes"`
+	ExpectedFree int64  `json:"expected_free"`
+	// MinFree and MaxFree are the smallest and the largest free space of the nodes in the group.
+	MinFreeBefore int64 `json:"min_free_before"`
+	MinFreeAfter  int64 `json:"min_free_after"`
+	MaxFreeBefore int64 `json:"max_free_before"`
+	MaxFreeAfter  int64 `json:"max_free_after"`
+	// Deviation is the sum of the absolute differences between the free space and the expected free space.
+	DeviationBefore int64 `json:"deviation_before"`
+	DeviationAfter  int64 `json:"deviation_after"`
+	Moves           int64 `json:"moves"`
+	Bytes           int64 `json:"bytes"`
+}
+
+// Plan is the result of a dry-run of the balancer: the moves it would do and
+// the predicted disk utilization of the participating nodes.
+type Plan struct {
+	CreatedAt time.Time         `json:"created_at"`
+	Grouping  string            `json:"grouping"`
+	Moves     []PlannedMove     `json:"moves"`
+	Nodes     []NodeProjection  `json:"nodes"`
+	Groups    []GroupProjection `json:"groups"`
+}
+
+// newPlan creates a plan with the projections of the nodes and groups, based
+// on the free space of the nodes at the start of the loop.
+func newPlan(createdAt time.Time, grouping string, nodes map[storj.NodeID]*nodeInfo, moves []PlannedMove) *Plan {
+	projections := make(map[storj.NodeID]*NodeProjection, len(nodes))
+	for id, info := range nodes {
+		projections[id] = &NodeProjection{
+			NodeID:       id,
+			Group:        info.group,
+			ExpectedFree: info.expectedFree,
+			FreeBefore:   info.node.FreeDisk,
+			FreeAfter:    info.node.FreeDisk,
+		}
+	}
+
+	groups := make(map[string]*GroupProjection)
+	for _, move := range moves {
+		if source, ok := projections[move.SourceNode]; ok {
+			source.MovesOut++
+			source.BytesOut += move.PieceSize
+			source.FreeAfter += move.PieceSize
+
+			group, ok := groups[source.Group]
+			if !ok {
+				group = &GroupProjection{Group: source.Group}
+				groups[source.Group] = group
+			}
+			group.Moves++
+			group.Bytes += move.PieceSize
+		}
+		if dest, ok := projections[move.DestNode]; ok {
+			dest.MovesIn++
+			dest.BytesIn += move.PieceSize
+			dest.FreeAfter -= move.PieceSize
+		}
+	}
+
+	plan := &Plan{
+		CreatedAt: createdAt,
+		Grouping:  grouping,
+		Moves:     moves,
+		Nodes:     make([]NodeProjection, 0, len(projections)),
+	}
+	for _, node := range projections {
+		plan.Nodes = append(plan.Nodes, *node)
+
+		group, ok := groups[node.Group]
+		if !ok {
+			group = &GroupProjection{Group: node.Group}
+			groups[node.Group] = group
+		}
+		if group.Nodes == 0 {
+			group.ExpectedFree = node.ExpectedFree
+			group.MinFreeBefore, group.MaxFreeBefore = node.FreeBefore, node.FreeBefore
+			group.MinFreeAfter, group.MaxFreeAfter = node.FreeAfter, node.FreeAfter
+		}
+		group.Nodes++
+		group.MinFreeBefore = min(group.MinFreeBefore, node.FreeBefore)
+		group.MaxFreeBefore = max(group.MaxFreeBefore, node.FreeBefore)
+		group.MinFreeAfter = min(group.MinFreeAfter, node.FreeAfter)
+		group.MaxFreeAfter = max(group.MaxFreeAfter, node.FreeAfter)
+		group.DeviationBefore += abs(node.FreeBefore - node.ExpectedFree)
+		group.DeviationAfter += abs(node.FreeAfter - node.ExpectedFree)
+	}
+	sort.Slice(plan.Nodes, func(i, j int) bool {
+		if plan.Nodes[i].Group != plan.Nodes[j].Group {
+			return plan.Nodes[i].Group < plan.Nodes[j].Group
+		}
+		return plan.Nodes[i].NodeID.Less(plan.Nodes[j].NodeID)
+	})
+
+	plan.Groups = make([]GroupProjection, 0, len(groups))
+	for _, group := range groups {
+		plan.Groups = append(plan.Groups, *group)
+	}
+	sort.Slice(plan.Groups, func(i, j int) bool {
+		return plan.Groups[i].Group < plan.Groups[j].Group
+	})
+
+	return plan
+}
+
+// Bytes returns the total size of the pieces moved by the plan.
+func (p *Plan) Bytes() (total int64) {
+	for _, move := range p.Moves {
+		total += move.PieceSize
+	}
+	return total
+}
+
+// Write writes the plan to the file. The format is selected by the extension
+// of the path: with ".csv" the moves are written to the file, and the node and
+// group projections are written next to it with ".nodes.csv" and ".groups.csv"
+// suffixes. Any other extension writes the whole plan as JSON.
+func (p *Plan) Write(path string) error {
+	if !isCSV(path) {
+		return writeFile(path, p.WriteJSON)
+	}
+	base := strings.TrimSuffix(path, filepath.Ext(path))
+	return errs.Combine(
+		writeFile(path, p.WriteMovesCSV),
+		writeFile(base+".nodes.csv", p.WriteNodesCSV),
+		writeFile(base+".groups.csv", p.WriteGroupsCSV),
+	)
+}
+
+// WriteJSON writes the whole plan as JSON.
+func (p *Plan) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return Error.Wrap(encoder.Encode(p))
+}
+
+var movesHeader = []string{"stream_id", "position", "source_node", "dest_node", "piece_size"}
+
+// WriteMovesCSV writes the moves of the plan as CSV.
+func (p *Plan) WriteMovesCSV(w io.Writer) error {
+	records := make([][]string, 0, len(p.Moves)+1)
+	records = append(records, movesHeader)
+	for _, move := range p.Moves {
+		records = append(records, []string{
+			move.StreamID.String(),
+			strconv.FormatUint(move.Position, 10),
+			move.SourceNode.String(),
+			move.DestNode.String(),
+			strconv.FormatInt(move.PieceSize, 10),
+		})
+	}
+	return Error.Wrap(csv.NewWriter(w).WriteAll(records))
+}
+
+// WriteNodesCSV writes the node projections of the plan as CSV.
+func (p *Plan) WriteNodesCSV(w io.Writer) error {
+	records := make([][]string, 0, len(p.Nodes)+1)
+	records = append(records, []string{"node_id", "group", "expected_free", "free_before", "free_after", "moves_in", "moves_out", "bytes_in", "bytes_out"})
+	for _, node := range p.Nodes {
+		records = append(records, []string{
+			node.NodeID.String(),
+			node.Group,
+			strconv.FormatInt(node.ExpectedFree, 10),
+			strconv.FormatInt(node.FreeBefore, 10),
+			strconv.FormatInt(node.FreeAfter, 10),
+			strconv.FormatInt(node.MovesIn, 10),
+			strconv.FormatInt(node.MovesOut, 10),
+			strconv.FormatInt(node.BytesIn, 10),
+			strconv.FormatInt(node.BytesOut, 10),
+		})
+	}
+	return Error.Wrap(csv.NewWriter(w).WriteAll(records))
+}
+
+// WriteGroupsCSV writes the group projections of the plan as CSV.
+func (p *Plan) WriteGroupsCSV(w io.Writer) error {
+	records := make([][]string, 0, len(p.Groups)+1)
+	records = append(records, []string{"group", "nodes", "expected_free", "min_free_before", "min_free_after", "max_free_before", "max_free_after", "deviation_before", "deviation_after", "moves", "bytes"})
+	for _, group := range p.Groups {
+		records = append(records, []string{
+			group.Group,
+			strconv.FormatInt(group.Nodes, 10),
+			strconv.FormatInt(group.ExpectedFree, 10),
+			strconv.FormatInt(group.MinFreeBefore, 10),
+			strconv.FormatInt(group.MinFreeAfter, 10),
+			strconv.FormatInt(group.MaxFreeBefore, 10),
+			strconv.FormatInt(group.MaxFreeAfter, 10),
+			strconv.FormatInt(group.DeviationBefore, 10),
+			strconv.FormatInt(group.DeviationAfter, 10),
+			strconv.FormatInt(group.Moves, 10),
+			strconv.FormatInt(group.Bytes, 10),
+		})
+	}
+	return Error.Wrap(csv.NewWriter(w).WriteAll(records))
+}
+
+// ReadPlan reads a plan written by Plan.Write. Only the moves are read from
+// CSV plans.
+func ReadPlan(path string) (_ *Plan, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(file.Close())) }()
+
+	if isCSV(path) {
+		moves, err := ReadMovesCSV(file)
+		if err != nil {
+			return nil, err
+		}
+		return &Plan{Moves: moves}, nil
+	}
+
+	var plan Plan
+	if err := json.NewDecoder(file).Decode(&plan); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &plan, nil
+}
+
+// ReadMovesCSV reads the moves written by Plan.WriteMovesCSV.
+func ReadMovesCSV(r io.Reader) ([]PlannedMove, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(movesHeader)
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if len(records) > 0 && records[0][0] == movesHeader[0] {
+		records = records[1:]
+	}
+
+	moves := make([]PlannedMove, 0, len(records))
+	for i, record := range records {
+		move, err := parseMove(record)
+		if err != nil {
+			return nil, Error.New("invalid move on line %d: %w", i+2, err)
+		}
+		moves = append(moves, move)
+	}
+	return moves, nil
+}
+
+func parseMove(record []string) (move PlannedMove, err error) {
+	if move.StreamID, err = uuid.FromString(record[0]); err != nil {
+		return move, err
+	}
+	if move.Position, err = strconv.ParseUint(record[1], 10, 64); err != nil {
+		return move, err
+	}
+	if move.SourceNode, err = storj.NodeIDFromString(record[2]); err != nil {
+		return move, err
+	}
+	if move.DestNode, err = storj.NodeIDFromString(record[3]); err != nil {
+		return move, err
+	}
+	if move.PieceSize, err = strconv.ParseInt(record[4], 10, 64); err != nil {
+		return move, err
+	}
+	return move, nil
+}
+
+// Enqueue pushes the moves of the plan to the queue as balancer jobs.
+func (p *Plan) Enqueue(ctx context.Context, client *taskqueue.Client, streamID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	jobs := make([]any, 0, jobBatchSize)
+	for _, move := range p.Moves {
+		jobs = append(jobs, move.Job())
+		if len(jobs) >= jobBatchSize {
+			if err := client.PushBatch(ctx, streamID, jobs); err != nil {
+				return Error.Wrap(err)
+			}
+			jobs = jobs[:0]
+		}
+	}
+	if len(jobs) > 0 {
+		if err := client.PushBatch(ctx, streamID, jobs); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return nil
+}
+
+func writeFile(path string, write func(io.Writer) error) (err error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(file.Close())) }()
+	return write(file)
+}
+
+func isCSV(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".csv")
+}
+
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,200 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package balancer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testredis"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/taskqueue"
+)
+
+func TestDryRun(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	// Group "A": node1 (FreeDisk=1000, overfull), node2 (FreeDisk=5000, underfull) => avg=3000
+	// Group "B": node3 (FreeDisk=3000) => avg=3000
+	node1 := testrand.NodeID()
+	node2 := testrand.NodeID()
+	node3 := testrand.NodeID()
+
+	nodeCache := map[storj.NodeID]*nodeInfo{
+		node1: {node: nodeselection.SelectedNode{ID: node1, FreeDisk: 1000}, group: "A", expectedFree: 3000, currentFree: 1000},
+		node2: {node: nodeselection.SelectedNode{ID: node2, FreeDisk: 5000}, group: "A", expectedFree: 3000, currentFree: 5000},
+		node3: {node: nodeselection.SelectedNode{ID: node3, FreeDisk: 3000}, group: "B", expectedFree: 3000, currentFree: 3000},
+	}
+
+	output := ctx.File("plan.json")
+	startTime := time.Now().UTC().Truncate(time.Second)
+
+	// The client is not set: the dry-run must not push anything.
+	observer := &Balancer{
+		log:    zaptest.NewLogger(t),
+		config: Config{StreamID: "balancer", Grouping: "last_net", PlanOutput: output},
+		placements: nodeselection.PlacementDefinitions{
+			storj.DefaultPlacement: {ID: storj.DefaultPlacement},
+		},
+		nodeCache: nodeCache,
+		groupDestCandidates: map[string][]*nodeInfo{
+			"A": {nodeCache[node2]},
+		},
+		startTime: startTime,
+	}
+
+	segment := rangedloop.Segment{
+		StreamID:    testrand.UUID(),
+		Position:    metabase.SegmentPosition{Part: 1, Index: 2},
+		RootPieceID: testrand.PieceID(),
+		Pieces: metabase.Pieces{
+			{Number: 0, StorageNode: node1},
+			{Number: 1, StorageNode: node3},
+		},
+		Redundancy:    storj.RedundancyScheme{RequiredShares: 1, RepairShares: 1, OptimalShares: 2, TotalShares: 2, ShareSize: 256},
+		EncryptedSize: 1024,
+	}
+	pieceSize := segment.PieceSize()
+
+	fork, err := observer.Fork(ctx)
+	require.NoError(t, err)
+	require.NoError(t, fork.Process(ctx, []rangedloop.Segment{segment}))
+	require.NoError(t, observer.Join(ctx, fork))
+	require.NoError(t, observer.Finish(ctx))
+
+	plan, err := ReadPlan(output)
+	require.NoError(t, err)
+
+	assert.Equal(t, startTime, plan.CreatedAt.UTC())
+	assert.Equal(t, []PlannedMove{{
+		StreamID:   segment.StreamID,
+		Position:   segment.Position.Encode(),
+		SourceNode: node1,
+		DestNode:   node2,
+		PieceSize:  pieceSize,
+	}}, plan.Moves)
+	assert.Equal(t, pieceSize, plan.Bytes())
+
+	nodes := map[storj.NodeID]NodeProjection{}
+	for _, node := range plan.Nodes {
+		nodes[node.NodeID] = node
+	}
+	require.Len(t, nodes, 3)
+	assert.Equal(t, NodeProjection{
+		NodeID: node1, Group: "A", ExpectedFree: 3000,
+		FreeBefore: 1000, FreeAfter: 1000 + pieceSize,
+		MovesOut: 1, BytesOut: pieceSize,
+	}, nodes[node1])
+	assert.Equal(t, NodeProjection{
+		NodeID: node2, Group: "A", ExpectedFree: 3000,
+		FreeBefore: 5000, FreeAfter: 5000 - pieceSize,
+		MovesIn: 1, BytesIn: pieceSize,
+	}, nodes[node2])
+	assert.Equal(t, NodeProjection{
+		NodeID: node3, Group: "B", ExpectedFree: 3000,
+		FreeBefore: 3000, FreeAfter: 3000,
+	}, nodes[node3])
+
+	assert.Equal(t, []GroupProjection{
+		{
+			Group: "A", Nodes: 2, ExpectedFree: 3000,
+			MinFreeBefore: 1000, MinFreeAfter: 1000 + pieceSize,
+			MaxFreeBefore: 5000, MaxFreeAfter: 5000 - pieceSize,
+			DeviationBefore: 4000, DeviationAfter: 4000 - 2*pieceSize,
+			Moves: 1, Bytes: pieceSize,
+		},
+		{
+			Group: "B", Nodes: 1, ExpectedFree: 3000,
+			MinFreeBefore: 3000, MinFreeAfter: 3000,
+			MaxFreeBefore: 3000, MaxFreeAfter: 3000,
+		},
+	}, plan.Groups)
+}
+
+func TestPlanCSV(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	source, dest := testrand.NodeID(), testrand.NodeID()
+	nodes := map[storj.NodeID]*nodeInfo{
+		source: {node: nodeselection.SelectedNode{ID: source, FreeDisk: 1000}, group: "A", expectedFree: 2000},
+		dest:   {node: nodeselection.SelectedNode{ID: dest, FreeDisk: 3000}, group: "A", expectedFree: 2000},
+	}
+
+	var moves []PlannedMove
+	for i := 0; i < 3; i++ {
+		moves = append(moves, PlannedMove{
+			StreamID:   testrand.UUID(),
+			Position:   uint64(i),
+			SourceNode: source,
+			DestNode:   dest,
+			PieceSize:  int64(100 * (i + 1)),
+		})
+	}
+
+	plan := newPlan(time.Now(), "last_net", nodes, moves)
+
+	dir := ctx.Dir("plan")
+	output := filepath.Join(dir, "plan.csv")
+	require.NoError(t, plan.Write(output))
+
+	for _, name := range []string{"plan.nodes.csv", "plan.groups.csv"} {
+		_, err := os.Stat(filepath.Join(dir, name))
+		require.NoError(t, err, name)
+	}
+
+	read, err := ReadPlan(output)
+	require.NoError(t, err)
+	assert.Equal(t, moves, read.Moves)
+	assert.Equal(t, int64(600), read.Bytes())
+}
+
+func TestPlanEnqueue(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	redisServer, err := testredis.Start(ctx)
+	require.NoError(t, err)
+	defer ctx.Check(redisServer.Close)
+
+	client, err := taskqueue.NewClient(ctx, taskqueue.Config{
+		Address:  "redis://" + redisServer.Addr(),
+		Group:    "test-group",
+		Consumer: "test-consumer",
+	})
+	require.NoError(t, err)
+	defer ctx.Check(client.Close)
+
+	plan := &Plan{}
+	for i := 0; i < jobBatchSize+5; i++ {
+		plan.Moves = append(plan.Moves, PlannedMove{
+			StreamID:   testrand.UUID(),
+			Position:   uint64(i),
+			SourceNode: testrand.NodeID(),
+			DestNode:   testrand.NodeID(),
+			PieceSize:  1024,
+		})
+	}
+	require.NoError(t, plan.Enqueue(ctx, client, "balancer"))
+
+	for _, move := range plan.Moves {
+		var job Job
+		ok, err := client.Pop(ctx, "balancer", &job, time.Second)
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, move.Job(), job)
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package balancer
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/errs2"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/storj/private/piecetransferpb"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/orders"
+)
+
+// transferPiece moves the piece from the source node to the destination node.
+// With node-to-node transfers enabled, the destination node downloads the piece
+// directly from the source node; when that fails, the piece is relayed through
+// the satellite.
+func (w *Worker) transferPiece(ctx context.Context, segment metabase.Segment, source, dest nodeselection.SelectedNode, pieceNum uint16, pieceSize int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if w.config.NodeToNode {
+		err := w.transferNodeToNode(ctx, segment, source, dest, pieceNum, pieceSize)
+		if err == nil {
+			mon.Counter("balancer_transfer_node_to_node").Inc(1)
+			if w.OnTestingTransferHook != nil {
+				w.OnTestingTransferHook(true)
+			}
+			return nil
+		}
+		if errs2.IsCanceled(err) {
+			return err
+		}
+		mon.Counter("balancer_transfer_node_to_node_failed").Inc(1)
+		w.log.Debug("node-to-node transfer failed, relaying through the satellite",
+			zap.Stringer("stream_id", segment.StreamID),
+			zap.Uint64("position", segment.Position.Encode()),
+			zap.Stringer("source_node", source.ID),
+			zap.Stringer("dest_node", dest.ID),
+			zap.Error(err))
+	}
+
+	// Download piece from source node.
+	pieceData, downloadHash, err := w.downloadPiece(ctx, segment, source, pieceNum, pieceSize)
+	if err != nil {
+		return Error.New("download from source %s failed: %w", source.ID, err)
+	}
+
+	// Upload piece to destination node, using the same hash algorithm as the source.
+	var hashAlgo pb.PieceHashAlgorithm
+	if downloadHash != nil {
+		hashAlgo = downloadHash.HashAlgorithm
+	}
+	uploadHash, err := w.uploadPiece(ctx, segment, dest, pieceNum, pieceSize, pieceData, hashAlgo)
+	if err != nil {
+		return Error.New("upload to destination %s failed: %w", dest.ID, err)
+	}
+
+	// Verify that download and upload hashes match.
+	if downloadHash != nil && uploadHash != nil && !bytes.Equal(downloadHash.Hash, uploadHash.Hash) {
+		return Error.New("piece hash mismatch: download %x != upload %x", downloadHash.Hash, uploadHash.Hash)
+	}
+	if w.OnTestingTransferHook != nil {
+		w.OnTestingTransferHook(false)
+	}
+	return nil
+}
+
+// transferNodeToNode asks the destination node to download the piece from the
+// source node, and waits for the confirmation.
+func (w *Worker) transferNodeToNode(ctx context.Context, segment metabase.Segment, source, dest nodeselection.SelectedNode, pieceNum uint16, pieceSize int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	getSigner, err := orders.NewSignerRepairGet(w.orders, segment.RootPieceID, time.Now(), pieceSize, metabase.BucketLocation{})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	sourceLimit, err := getSigner.Sign(ctx, &pb.Node{
+		Id:      source.ID,
+		Address: source.Address,
+	}, int32(pieceNum))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var expiration time.Time
+	if segment.ExpiresAt != nil {
+		expiration = *segment.ExpiresAt
+	}
+	putSigner, err := orders.NewSignerRepairPut(w.orders, segment.RootPieceID, expiration, time.Now(), pieceSize, metabase.BucketLocation{})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	destLimit, err := putSigner.Sign(ctx, &pb.Node{
+		Id:      dest.ID,
+		Address: dest.Address,
+	}, int32(pieceNum))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	dialCtx, dialCancel := context.WithTimeout(ctx, w.config.DialTimeout)
+	defer dialCancel()
+
+	conn, err := w.dialer.DialNodeURL(dialCtx, storj.NodeURL{
+		ID:      dest.ID,
+		Address: dest.Address.Address,
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	transferCtx, transferCancel := context.WithTimeout(ctx, w.config.DownloadTimeout+w.config.UploadTimeout)
+	defer transferCancel()
+
+	resp, err := piecetransferpb.NewDRPCPieceTransferClient(conn).Transfer(transferCtx, &piecetransferpb.TransferRequest{
+		SourceLimit:           sourceLimit,
+		SourcePrivateKey:      getSigner.PrivateKey.Bytes(),
+		DestinationLimit:      destLimit.GetLimit(),
+		DestinationPrivateKey: putSigner.PrivateKey.Bytes(),
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// The connection is authenticated with the identity of the destination
+	// node, so it's enough to check that the confirmed piece is the expected one.
+	hash := resp.GetHash()
+	switch {
+	case hash == nil:
+		return Error.New("missing piece hash in transfer response")
+	case hash.PieceId != destLimit.GetLimit().PieceId:
+		return Error.New("piece id mismatch: %s != %s", hash.PieceId, destLimit.GetLimit().PieceId)
+	case hash.PieceSize != pieceSize:
+		return Error.New("piece size mismatch: %d != %d", hash.PieceSize, pieceSize)
+	}
+
+	mon.IntVal("balancer_bytes_transferred_node_to_node").Observe(pieceSize)
+	return nil
+}
@@ -32,6 +32,7 @@ type WorkerConfig struct {
 	DownloadTimeout     time.Duration `help:"timeout for downloading a piece" default:"5m"`
 	UploadTimeout       time.Duration `help:"timeout for uploading a piece" default:"5m"`
 	DeleteAfterTransfer bool          `help:"delete the source piece from the source node after a successful transfer" default:"false"`
+	NodeToNode          bool          `help:"ask the destination node to download the piece directly from the source node, instead of relaying it through the satellite" default:"false"`
 }
 
 // Worker consumes balancer jobs from the task queue and transfers pieces between nodes.
@@ -47,6 +48,10 @@ type Worker struct {
 
 	runner  *taskqueue.Runner[Job]
 	nodeMap map[storj.NodeID]*nodeselection.SelectedNode
+
+	// OnTestingTransferHook is called after a piece is transferred, with whether
+	// the destination node downloaded it directly from the source node.
+	OnTestingTransferHook func(nodeToNode bool)
 }
 
 // NewWorker creates a new balancer worker.
@@ -94,8 +99,8 @@ func (w *Worker) Close() error {
 	return w.runner.Close()
 }
 
-// Process handles a single balancer job.
-func (w *Worker) Process(ctx context.Context, job Job) {
+// Process handles a single balancer job. Failed jobs are retried by the runner.
+func (w *Worker) Process(ctx context.Context, job Job) error {
 	err := w.processJob(ctx, job)
 	if err != nil {
 		w.log.Error("failed to process balancer job",
@@ -106,9 +111,10 @@ func (w *Worker) Process(ctx context.Context, job Job) {
 			zap.Error(err),
 		)
 		mon.Counter("balancer_worker_failed").Inc(1)
-		return
+		return err
 	}
 	mon.Counter("balancer_worker_success").Inc(1)
+	return nil
 }
 
 // TestingProcessJob exposes processJob for testing.
@@ -225,28 +231,12 @@ func (w *Worker) processJob(ctx context.Context, job Job) (err error) {
 
 	pieceSize := segment.PieceSize()
 
-	// 6. Download piece from source node.
-	pieceData, downloadHash, err := w.downloadPiece(ctx, segment, *sourceNode, uint16(pieceNum), pieceSize)
-	if err != nil {
-		return Error.New("download from source %s failed: %w", job.SourceNode, err)
-	}
-
-	// 7. Upload piece to destination node, using the same hash algorithm as the source.
-	var hashAlgo pb.PieceHashAlgorithm
-	if downloadHash != nil {
-		hashAlgo = downloadHash.HashAlgorithm
-	}
-	uploadHash, err := w.uploadPiece(ctx, segment, *destNode, uint16(pieceNum), pieceSize, pieceData, hashAlgo)
-	if err != nil {
-		return Error.New("upload to destination %s failed: %w", job.DestNode, err)
-	}
-
-	// 8. Verify that download and upload hashes match.
-	if downloadHash != nil && uploadHash != nil && !bytes.Equal(downloadHash.Hash, uploadHash.Hash) {
-		return Error.New("piece hash mismatch: download %x != upload %x", downloadHash.Hash, uploadHash.Hash)
+	// 6. Transfer the piece from the source to the destination node.
+	if err := w.transferPiece(ctx, segment, *sourceNode, *destNode, uint16(pieceNum), pieceSize); err != nil {
+		return err
 	}
 
-	// 9. Update segment pieces atomically (CAS).
+	// 7. Update segment pieces atomically (CAS).
 	newPieces, err := segment.Pieces.Update(
 		metabase.Pieces{{Number: uint16(pieceNum), StorageNode: job.DestNode}},
 		metabase.Pieces{{Number: uint16(pieceNum), StorageNode: job.SourceNode}},
@@ -272,7 +262,7 @@ func (w *Worker) processJob(ctx context.Context, job Job) (err error) {
 		return Error.Wrap(err)
 	}
 
-	// 10. Optionally delete the piece from the source node.
+	// 8. Optionally delete the piece from the source node.
 	if w.config.DeleteAfterTransfer {
 		pieceID := segment.RootPieceID.Derive(job.SourceNode, int32(pieceNum))
 		deleteErr := w.deletePiece(ctx, *sourceNode, pieceID)
@@ -31,12 +31,15 @@ func TestWorkerProcessJob(t *testing.T) {
 	} {
 		hashAlgo := hashAlgo
 		t.Run(hashAlgo.String(), func(t *testing.T) {
-			testWorkerProcessJob(t, hashAlgo)
+			testWorkerProcessJob(t, hashAlgo, false)
+		})
+		t.Run(hashAlgo.String()+"-node-to-node", func(t *testing.T) {
+			testWorkerProcessJob(t, hashAlgo, true)
 		})
 	}
 }
 
-func testWorkerProcessJob(t *testing.T, hashAlgo pb.PieceHashAlgorithm) {
+func testWorkerProcessJob(t *testing.T, hashAlgo pb.PieceHashAlgorithm, nodeToNode bool) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount:   1,
 		StorageNodeCount: 6,
@@ -89,6 +92,7 @@ func testWorkerProcessJob(t *testing.T, hashAlgo pb.PieceHashAlgorithm) {
 				DialTimeout:     5 * time.Second,
 				DownloadTimeout: 5 * time.Minute,
 				UploadTimeout:   5 * time.Minute,
+				NodeToNode:      nodeToNode,
 			},
 			taskqueue.RunnerConfig{},
 			nil, // no redis client needed for direct processJob call
@@ -106,9 +110,26 @@ func testWorkerProcessJob(t *testing.T, hashAlgo pb.PieceHashAlgorithm) {
 			DestNode:   destNodeID,
 		}
 
+		var transfers []bool
+		worker.OnTestingTransferHook = func(nodeToNode bool) {
+			transfers = append(transfers, nodeToNode)
+		}
+
 		err = worker.TestingProcessJob(ctx, job)
 		require.NoError(t, err)
 
+		// Verify: the piece is transferred once, directly between the nodes when it's enabled.
+		require.Equal(t, []bool{nodeToNode}, transfers)
+
+		// Verify: the destination node stores the piece with the hash of the source piece.
+		pieceID := segment.RootPieceID.Derive(destNodeID, int32(sourcePiece.Number))
+		sourcePieceID := segment.RootPieceID.Derive(sourcePiece.StorageNode, int32(sourcePiece.Number))
+		destHeader := readPieceHeader(ctx, t, planet.FindNode(destNodeID), sat.ID(), pieceID)
+		sourceHeader := readPieceHeader(ctx, t, planet.FindNode(sourcePiece.StorageNode), sat.ID(), sourcePieceID)
+		require.Equal(t, sourceHeader.Hash, destHeader.Hash)
+		require.Equal(t, hashAlgo, destHeader.HashAlgorithm)
+		require.Equal(t, pb.PieceAction_PUT_REPAIR, destHeader.OrderLimit.Action)
+
 		// Verify: the segment should now have the destination node instead of the source.
 		updatedSegments, err := sat.Metabase.DB.TestingAllSegments(ctx)
 		require.NoError(t, err)
@@ -129,6 +150,17 @@ func testWorkerProcessJob(t *testing.T, hashAlgo pb.PieceHashAlgorithm) {
 	})
 }
 
+// readPieceHeader reads the header of a piece stored by the node.
+func readPieceHeader(ctx *testcontext.Context, t *testing.T, node *testplanet.StorageNode, satelliteID storj.NodeID, pieceID storj.PieceID) *pb.PieceHeader {
+	reader, err := node.Storage2.PieceBackend.Reader(ctx, satelliteID, pieceID)
+	require.NoError(t, err)
+	defer ctx.Check(reader.Close)
+
+	header, err := reader.GetPieceHeader()
+	require.NoError(t, err)
+	return header
+}
+
 func TestWorkerProcessJob_SegmentNotFound(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount:   1,
@@ -147,6 +147,19 @@ type NotificationConfig struct {
 	UpdatedAt    time.Time
 }
 
+// Limits contains the optional storage, bandwidth and segment limits of a bucket.
+// A nil limit means that the bucket is only restricted by the limits of its project.
+type Limits struct {
+	Storage   *int64
+	Bandwidth *int64
+	Segments  *int64
+}
+
+// IsZero returns true if none of the limits is set.
+func (limits Limits) IsZero() bool {
+	return limits.Storage == nil && limits.Bandwidth == nil && limits.Segments == nil
+}
+
 // ListOptions lists objects.
 type ListOptions struct {
 	Cursor    string
@@ -224,4 +237,23 @@ type DB interface {
 	GetBucketNotificationConfig(ctx context.Context, bucketName []byte, projectID uuid.UUID) (*NotificationConfig, error)
 	// DeleteBucketNotificationConfig removes the notification configuration for a bucket.
 	DeleteBucketNotificationConfig(ctx context.Context, bucketName []byte, projectID uuid.UUID) error
+	// GetBucketLimits returns the storage, bandwidth and segment limits of a bucket.
+	// Returns zero limits if the bucket has no limits.
+	GetBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID) (Limits, error)
+	// UpdateBucketLimits sets the storage, bandwidth and segment limits of a bucket.
+	// Setting zero limits removes all the limits of the bucket.
+	UpdateBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID, limits Limits) error
+	// GetBucketLifecycleConfig retrieves the lifecycle configuration of a bucket.
+	// Returns nil if no configuration exists.
+	GetBucketLifecycleConfig(ctx context.Context, bucketName []byte, projectID uuid.UUID) (*LifecycleConfiguration, error)
+	// UpdateBucketLifecycleConfig replaces the lifecycle configuration of a bucket.
+	UpdateBucketLifecycleConfig(ctx context.Context, bucketName []byte, projectID uuid.UUID, config LifecycleConfiguration) error
+	// DeleteBucketLifecycleConfig removes the lifecycle configuration of a bucket.
+	DeleteBucketLifecycleConfig(ctx context.Context, bucketName []byte, projectID uuid.UUID) error
+	// ListBucketLifecycleConfigs returns up to limit lifecycle configurations of buckets
+	// that are ordered after the cursor.
+	ListBucketLifecycleConfigs(ctx context.Context, cursor metabase.BucketLocation, limit int) ([]BucketLifecycle, error)
+	// UpdateBucketLifecycleDeletionCursor sets the object key from which the lifecycle deletion
+	// chore continues applying the rules of the bucket. An empty cursor starts from the beginning.
+	UpdateBucketLifecycleDeletionCursor(ctx context.Context, bucket metabase.BucketLocation, cursor metabase.ObjectKey) error
 }
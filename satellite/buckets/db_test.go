@@ -386,3 +386,133 @@ func TestEnableSuspendBucketVersioning(t *testing.T) {
 		requireBucketVersioning(lockBucketName, buckets.Unversioned)
 	})
 }
+
+func TestBucketLimits(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		project, err := sat.DB.Console().Projects().Insert(ctx, &console.Project{Name: "testproject"})
+		require.NoError(t, err)
+
+		bucketsDB := sat.API.Buckets.Service
+		_, err = bucketsDB.CreateBucket(ctx, newTestBucket("testbucket", project.ID))
+		require.NoError(t, err)
+
+		limits, err := bucketsDB.GetBucketLimits(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.True(t, limits.IsZero())
+
+		storage, segments := int64(1000), int64(10)
+		expected := buckets.Limits{Storage: &storage, Segments: &segments}
+		require.NoError(t, bucketsDB.UpdateBucketLimits(ctx, []byte("testbucket"), project.ID, expected))
+
+		limits, err = bucketsDB.GetBucketLimits(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, expected, limits)
+
+		// overwrite existing limits
+		bandwidth := int64(500)
+		expected = buckets.Limits{Bandwidth: &bandwidth}
+		require.NoError(t, bucketsDB.UpdateBucketLimits(ctx, []byte("testbucket"), project.ID, expected))
+
+		limits, err = bucketsDB.GetBucketLimits(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, expected, limits)
+
+		// zero limits remove the row
+		require.NoError(t, bucketsDB.UpdateBucketLimits(ctx, []byte("testbucket"), project.ID, buckets.Limits{}))
+
+		limits, err = bucketsDB.GetBucketLimits(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.True(t, limits.IsZero())
+
+		// limits are removed together with the bucket
+		require.NoError(t, bucketsDB.UpdateBucketLimits(ctx, []byte("testbucket"), project.ID, expected))
+		require.NoError(t, bucketsDB.DeleteBucket(ctx, []byte("testbucket"), project.ID))
+
+		limits, err = bucketsDB.GetBucketLimits(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.True(t, limits.IsZero())
+	})
+}
+
+func TestBucketLifecycleConfig(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		project, err := sat.DB.Console().Projects().Insert(ctx, &console.Project{Name: "testproject"})
+		require.NoError(t, err)
+
+		bucketsDB := sat.API.Buckets.Service
+		for _, name := range []string{"bucket-a", "bucket-b", "bucket-c"} {
+			_, err = bucketsDB.CreateBucket(ctx, newTestBucket(name, project.ID))
+			require.NoError(t, err)
+		}
+
+		config, err := bucketsDB.GetBucketLifecycleConfig(ctx, []byte("bucket-a"), project.ID)
+		require.NoError(t, err)
+		require.Nil(t, config)
+
+		// invalid configurations are rejected
+		err = bucketsDB.UpdateBucketLifecycleConfig(ctx, []byte("bucket-a"), project.ID, buckets.LifecycleConfiguration{})
+		require.True(t, buckets.ErrInvalidLifecycle.Has(err))
+
+		expected := []buckets.LifecycleRule{{ID: "logs", Enabled: true, Prefix: []byte("logs/"), ExpirationDays: 30}}
+		for _, name := range []string{"bucket-a", "bucket-c"} {
+			require.NoError(t, bucketsDB.UpdateBucketLifecycleConfig(ctx, []byte(name), project.ID, buckets.LifecycleConfiguration{Rules: expected}))
+		}
+
+		config, err = bucketsDB.GetBucketLifecycleConfig(ctx, []byte("bucket-a"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, expected, config.Rules)
+		require.False(t, config.CreatedAt.IsZero())
+
+		// overwrite existing configuration
+		expected = []buckets.LifecycleRule{{ID: "uploads", Enabled: true, AbortIncompleteUploadDays: 7}}
+		require.NoError(t, bucketsDB.UpdateBucketLifecycleConfig(ctx, []byte("bucket-a"), project.ID, buckets.LifecycleConfiguration{Rules: expected}))
+
+		config, err = bucketsDB.GetBucketLifecycleConfig(ctx, []byte("bucket-a"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, expected, config.Rules)
+
+		// listing pages through the configured buckets only
+		list, err := bucketsDB.ListBucketLifecycleConfigs(ctx, metabase.BucketLocation{}, 1)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, metabase.BucketLocation{ProjectID: project.ID, BucketName: "bucket-a"}, list[0].Location)
+
+		list, err = bucketsDB.ListBucketLifecycleConfigs(ctx, list[0].Location, 10)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, metabase.BucketLocation{ProjectID: project.ID, BucketName: "bucket-c"}, list[0].Location)
+
+		// the deletion cursor is kept until the rules are replaced
+		require.Empty(t, list[0].DeletionCursor)
+		require.NoError(t, bucketsDB.UpdateBucketLifecycleDeletionCursor(ctx, list[0].Location, "logs/b"))
+
+		list, err = bucketsDB.ListBucketLifecycleConfigs(ctx, metabase.BucketLocation{ProjectID: project.ID, BucketName: "bucket-b"}, 10)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, metabase.ObjectKey("logs/b"), list[0].DeletionCursor)
+
+		require.NoError(t, bucketsDB.UpdateBucketLifecycleConfig(ctx, []byte("bucket-c"), project.ID, buckets.LifecycleConfiguration{Rules: expected}))
+
+		list, err = bucketsDB.ListBucketLifecycleConfigs(ctx, metabase.BucketLocation{ProjectID: project.ID, BucketName: "bucket-b"}, 10)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Empty(t, list[0].DeletionCursor)
+
+		require.NoError(t, bucketsDB.DeleteBucketLifecycleConfig(ctx, []byte("bucket-a"), project.ID))
+
+		config, err = bucketsDB.GetBucketLifecycleConfig(ctx, []byte("bucket-a"), project.ID)
+		require.NoError(t, err)
+		require.Nil(t, config)
+
+		// configuration is removed together with the bucket
+		require.NoError(t, bucketsDB.DeleteBucket(ctx, []byte("bucket-c"), project.ID))
+
+		list, err = bucketsDB.ListBucketLifecycleConfigs(ctx, metabase.BucketLocation{}, 10)
+		require.NoError(t, err)
+		require.Empty(t, list)
+	})
+}
@@ -0,0 +1,140 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/metabase"
+)
+
+// ErrInvalidLifecycle is used when a bucket lifecycle configuration is not valid.
+var ErrInvalidLifecycle = errs.Class("invalid lifecycle configuration")
+
+const (
+	// MaxLifecycleRules is the maximum number of rules in a lifecycle configuration.
+	MaxLifecycleRules = 1000
+	// maxLifecycleRuleIDLength is the maximum length of a lifecycle rule ID.
+	maxLifecycleRuleIDLength = 255
+)
+
+// LifecycleConfiguration contains the lifecycle rules of a bucket.
+type LifecycleConfiguration struct {
+	Rules     []LifecycleRule `json:"rules"`
+	CreatedAt time.Time       `json:"-"`
+	UpdatedAt time.Time       `json:"-"`
+}
+
+// LifecycleRule describes which objects of a bucket expire and when.
+//
+// Zero values mean that the corresponding action is not configured. Unlike S3,
+// rules can't filter objects by tags: object metadata is end-to-end encrypted,
+// so the satellite has nothing to evaluate a tag filter against.
+type LifecycleRule struct {
+	ID      string `json:"id,omitempty"`
+	Enabled bool   `json:"enabled"`
+
+	// Prefix limits the rule to objects whose (encrypted) object key starts
+	// with it. Uplink encrypts each path component separately, so a prefix
+	// which ends with a path delimiter matches the same objects as listing
+	// with that prefix.
+	Prefix []byte `json:"prefix,omitempty"`
+
+	// ExpirationDays expires the current version of objects this many days after
+	// they were created. In versioned buckets a delete marker is inserted instead.
+	ExpirationDays int `json:"expirationDays,omitempty"`
+	// NoncurrentDays deletes noncurrent versions this many days after they became
+	// noncurrent.
+	NoncurrentDays int `json:"noncurrentDays,omitempty"`
+	// NewerNoncurrentVersions retains this many of the newest noncurrent versions
+	// from NoncurrentDays expiration, or deletes all older noncurrent versions
+	// when NoncurrentDays isn't set.
+	NewerNoncurrentVersions int `json:"newerNoncurrentVersions,omitempty"`
+	// AbortIncompleteUploadDays deletes pending objects this many days after the
+	// upload was started.
+	AbortIncompleteUploadDays int `json:"abortIncompleteUploadDays,omitempty"`
+}
+
+// BucketLifecycle is a lifecycle configuration together with the bucket it belongs to.
+type BucketLifecycle struct {
+	Location      metabase.BucketLocation
+	Configuration LifecycleConfiguration
+	// DeletionCursor is the object key from which the lifecycle deletion chore
+	// continues applying the rules. It's empty when the chore starts from the
+	// beginning of the bucket.
+	DeletionCursor metabase.ObjectKey
+}
+
+// Validate checks whether the lifecycle configuration can be applied.
+func (config LifecycleConfiguration) Validate() error {
+	if len(config.Rules) == 0 {
+		return ErrInvalidLifecycle.New("at least one rule is required")
+	}
+	if len(config.Rules) > MaxLifecycleRules {
+		return ErrInvalidLifecycle.New("at most %d rules are allowed", MaxLifecycleRules)
+	}
+
+	ids := make(map[string]struct{}, len(config.Rules))
+	for _, rule := range config.Rules {
+		if rule.ID != "" {
+			if _, ok := ids[rule.ID]; ok {
+				return ErrInvalidLifecycle.New("duplicate rule ID %q", rule.ID)
+			}
+			ids[rule.ID] = struct{}{}
+		}
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks whether the lifecycle rule can be applied.
+func (rule LifecycleRule) Validate() error {
+	switch {
+	case len(rule.ID) > maxLifecycleRuleIDLength:
+		return ErrInvalidLifecycle.New("rule ID must be at most %d characters long", maxLifecycleRuleIDLength)
+	case rule.ExpirationDays < 0, rule.NoncurrentDays < 0, rule.NewerNoncurrentVersions < 0, rule.AbortIncompleteUploadDays < 0:
+		return ErrInvalidLifecycle.New("rule %q: days and version counts must not be negative", rule.ID)
+	case rule.ExpirationDays == 0 && rule.NoncurrentDays == 0 && rule.NewerNoncurrentVersions == 0 && rule.AbortIncompleteUploadDays == 0:
+		return ErrInvalidLifecycle.New("rule %q: at least one action is required", rule.ID)
+	}
+	return nil
+}
+
+// Matches returns whether the rule applies to the object key.
+func (rule LifecycleRule) Matches(key metabase.ObjectKey) bool {
+	return rule.Enabled && bytes.HasPrefix([]byte(key), rule.Prefix)
+}
+
+// ExpiresCurrent returns whether the current version of an object created at
+// createdAt has expired.
+func (rule LifecycleRule) ExpiresCurrent(createdAt, now time.Time) bool {
+	return rule.ExpirationDays > 0 && !now.Before(addDays(createdAt, rule.ExpirationDays))
+}
+
+// ExpiresNoncurrent returns whether a noncurrent version has expired. The
+// version became noncurrent at noncurrentSince and newerNoncurrent is the
+// number of noncurrent versions of the same object that are newer than it.
+func (rule LifecycleRule) ExpiresNoncurrent(noncurrentSince time.Time, newerNoncurrent int, now time.Time) bool {
+	if rule.NoncurrentDays == 0 && rule.NewerNoncurrentVersions == 0 {
+		return false
+	}
+	if rule.NoncurrentDays > 0 && now.Before(addDays(noncurrentSince, rule.NoncurrentDays)) {
+		return false
+	}
+	return newerNoncurrent >= rule.NewerNoncurrentVersions
+}
+
+// AbortsUpload returns whether a pending upload started at createdAt should be aborted.
+func (rule LifecycleRule) AbortsUpload(createdAt, now time.Time) bool {
+	return rule.AbortIncompleteUploadDays > 0 && !now.Before(addDays(createdAt, rule.AbortIncompleteUploadDays))
+}
+
+func addDays(t time.Time, days int) time.Time {
+	return t.Add(time.Duration(days) * 24 * time.Hour)
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/buckets"
+)
+
+func TestLifecycleConfiguration_Validate(t *testing.T) {
+	valid := buckets.LifecycleRule{ID: "rule", Enabled: true, ExpirationDays: 1}
+
+	for _, tt := range []struct {
+		name   string
+		config buckets.LifecycleConfiguration
+		err    string
+	}{
+		{name: "valid", config: buckets.LifecycleConfiguration{Rules: []buckets.LifecycleRule{valid}}},
+		{name: "no rules", config: buckets.LifecycleConfiguration{}, err: "at least one rule is required"},
+		{
+			name:   "duplicate ID",
+			config: buckets.LifecycleConfiguration{Rules: []buckets.LifecycleRule{valid, valid}},
+			err:    "duplicate rule ID",
+		},
+		{
+			name:   "long ID",
+			config: buckets.LifecycleConfiguration{Rules: []buckets.LifecycleRule{{ID: strings.Repeat("a", 256), ExpirationDays: 1}}},
+			err:    "rule ID must be at most",
+		},
+		{
+			name:   "no action",
+			config: buckets.LifecycleConfiguration{Rules: []buckets.LifecycleRule{{ID: "rule", Enabled: true}}},
+			err:    "at least one action is required",
+		},
+		{
+			name:   "negative days",
+			config: buckets.LifecycleConfiguration{Rules: []buckets.LifecycleRule{{ExpirationDays: 1, NoncurrentDays: -1}}},
+			err:    "must not be negative",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.True(t, buckets.ErrInvalidLifecycle.Has(err), err)
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestLifecycleRule(t *testing.T) {
+	now := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	t.Run("matches", func(t *testing.T) {
+		rule := buckets.LifecycleRule{Enabled: true, Prefix: []byte("logs/")}
+		require.True(t, rule.Matches("logs/a"))
+		require.False(t, rule.Matches("data/a"))
+
+		rule.Enabled = false
+		require.False(t, rule.Matches("logs/a"))
+
+		require.True(t, buckets.LifecycleRule{Enabled: true}.Matches("anything"))
+	})
+
+	t.Run("current", func(t *testing.T) {
+		rule := buckets.LifecycleRule{ExpirationDays: 30}
+		require.False(t, rule.ExpiresCurrent(now.Add(-29*day), now))
+		require.True(t, rule.ExpiresCurrent(now.Add(-30*day), now))
+		require.False(t, buckets.LifecycleRule{}.ExpiresCurrent(now.Add(-1000*day), now))
+	})
+
+	t.Run("noncurrent days", func(t *testing.T) {
+		rule := buckets.LifecycleRule{NoncurrentDays: 7}
+		require.False(t, rule.ExpiresNoncurrent(now.Add(-6*day), 0, now))
+		require.True(t, rule.ExpiresNoncurrent(now.Add(-7*day), 0, now))
+	})
+
+	t.Run("newer noncurrent versions", func(t *testing.T) {
+		rule := buckets.LifecycleRule{NewerNoncurrentVersions: 2}
+		require.False(t, rule.ExpiresNoncurrent(now, 0, now))
+		require.False(t, rule.ExpiresNoncurrent(now, 1, now))
+		require.True(t, rule.ExpiresNoncurrent(now, 2, now))
+
+		// both conditions must be satisfied.
+		rule.NoncurrentDays = 7
+		require.False(t, rule.ExpiresNoncurrent(now.Add(-1*day), 5, now))
+		require.False(t, rule.ExpiresNoncurrent(now.Add(-8*day), 1, now))
+		require.True(t, rule.ExpiresNoncurrent(now.Add(-8*day), 2, now))
+
+		require.False(t, buckets.LifecycleRule{}.ExpiresNoncurrent(now.Add(-1000*day), 1000, now))
+	})
+
+	t.Run("incomplete uploads", func(t *testing.T) {
+		rule := buckets.LifecycleRule{AbortIncompleteUploadDays: 3}
+		require.False(t, rule.AbortsUpload(now.Add(-2*day), now))
+		require.True(t, rule.AbortsUpload(now.Add(-3*day), now))
+	})
+}
@@ -58,6 +58,7 @@ import (
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripe"
+	"storj.io/storj/shared/flightrecorder"
 )
 
 // ConsoleAPI is the satellite console API process.
@@ -180,7 +181,7 @@ type ConsoleAPI struct {
 func NewConsoleAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 	metabaseDB *metabase.DB, revocationDB extensions.RevocationDB,
 	liveAccounting accounting.Cache, rollupsWriteCache *orders.RollupsWriteCache,
-	config *Config, versionInfo version.Info, atomicLogLevel *zap.AtomicLevel) (*ConsoleAPI, error) {
+	config *Config, versionInfo version.Info, atomicLogLevel *zap.AtomicLevel, recorder *flightrecorder.Box) (*ConsoleAPI, error) {
 	peer := &ConsoleAPI{
 		Log:             log,
 		Identity:        full,
@@ -206,7 +207,13 @@ func NewConsoleAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		}
 		debugConfig := config.Debug
 		debugConfig.ControlTitle = "API"
-		peer.Debug.Server = debug.NewServerWithAtomicLevel(log.Named("debug"), peer.Debug.Listener, monkit.Default, debugConfig, atomicLogLevel)
+
+		var extensions []debug.Extension
+		if recorder != nil {
+			extensions = append(extensions, recorder)
+		}
+
+		peer.Debug.Server = debug.NewServerWithAtomicLevel(log.Named("debug"), peer.Debug.Listener, monkit.Default, debugConfig, atomicLogLevel, extensions...)
 		peer.Servers.Add(lifecycle.Item{
 			Name:  "debug",
 			Run:   peer.Debug.Server.Run,
@@ -277,6 +284,7 @@ func NewConsoleAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.LiveAccounting.Cache,
 			*metabaseDB,
 			config.LiveAccounting.BandwidthCacheTTL,
+			config.LiveAccounting.BucketUsageCacheTTL,
 			config.Console.Config.UsageLimits.Storage.Free,
 			config.Console.Config.UsageLimits.Bandwidth.Free,
 			config.Console.Config.UsageLimits.Segment.Free,
@@ -463,6 +471,7 @@ func NewConsoleAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 				BillingDB:            peer.DB.Billing(),
 				ProjectsDB:           peer.DB.Console().Projects(),
 				UsersDB:              peer.DB.Console().Users(),
+				OrganizationsDB:      peer.DB.Console().Organizations(),
 				UsageDB:              peer.DB.ProjectAccounting(),
 				RetentionRemainderDB: peer.DB.RetentionRemainderCharges(),
 				Analytics:            peer.Analytics.Service,
@@ -681,6 +690,14 @@ func NewConsoleAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			Run:   peer.Console.Endpoint.Run,
 			Close: peer.Console.Endpoint.Close,
 		})
+
+		if recorder != nil {
+			peer.Console.Endpoint.SetFlightRecorder(recorder)
+			peer.Services.Add(lifecycle.Item{
+				Name: "flightrecorder",
+				Run:  recorder.Run,
+			})
+		}
 	}
 
 	{ // setup health check
@@ -63,6 +63,7 @@ type Config struct {
 	ShowNewPricingTiers               bool                      `help:"whether to show new pricing tiers in the UI" default:"false"`
 	NewPricingStartDate               string                    `help:"the date (YYYY-MM-DD) when new pricing tiers will be enabled" default:"2025-11-01"`
 	MemberAccountsEnabled             bool                      `help:"whether member accounts are enabled" default:"false"`
+	OrganizationsEnabled              bool                      `help:"whether organizations owning projects with shared billing and membership are enabled" default:"false"`
 	CollectBillingInfoOnOnboarding    bool                      `help:"whether to collect billing information during onboarding" default:"false"`
 	RequireBillingAddress             bool                      `help:"whether to require billing address during account upgrades and package purchases" default:"false"`
 	HideUplinkBehavior                bool                      `help:"whether to hide uplink behavior in the UI" default:"false"`
@@ -83,6 +84,18 @@ type Config struct {
 	Session           SessionConfig
 	AccountFreeze     AccountFreezeConfig
 	Announcement      AnnouncementConfig
+	WebAuthn          WebAuthnConfig
+}
+
+// WebAuthnConfig contains configurations for WebAuthn security keys and passkeys.
+type WebAuthnConfig struct {
+	Enabled             bool          `help:"whether users can register security keys and passkeys as a second factor" default:"false"`
+	PasswordlessEnabled bool          `help:"whether users can log in with a passkey instead of their password and second factor" default:"false"`
+	RPID                string        `help:"WebAuthn relying party ID. Defaults to the host of the satellite's external address" default:""`
+	RPName              string        `help:"WebAuthn relying party name shown by authenticators" default:"Storj"`
+	Origins             []string      `help:"origins WebAuthn ceremonies may be performed from. Defaults to the origin of the satellite's external address" default:""`
+	Timeout             time.Duration `help:"time users have to complete a WebAuthn ceremony" default:"5m"`
+	MaxCredentials      int           `help:"maximum number of security keys and passkeys per user" default:"10"`
 }
 
 // AnnouncementConfig contains configurations for announcements shown in the UI.
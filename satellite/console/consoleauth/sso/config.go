@@ -25,6 +25,7 @@ type Config struct {
 	MockSso                        bool                  `help:"whether to mock SSO for testing purposes. This should never be true in production." default:"false" hidden:"true"`
 	MockEmail                      string                `help:"mock email for successful SSO auth for testing purposes." default:"" hidden:"true"`
 	Webhook                        WebhookConfig
+	Scim                           ScimConfig
 }
 
 // WebhookConfig holds configuration for the primary auth provider webhook endpoint.
@@ -36,6 +37,59 @@ type WebhookConfig struct {
 	SignatureHeader string `help:"HTTP header name carrying the webhook signature JWT." default:"X-FusionAuth-Signature-JWT"`
 }
 
+// ScimConfig holds configuration for the SCIM 2.0 provisioning endpoint of SSO tenants.
+type ScimConfig struct {
+	Enabled bool       `help:"whether the SCIM 2.0 user and group provisioning endpoint is enabled." default:"false"`
+	Tokens  ScimTokens `help:"semicolon-separated provider:bearer-token pairs authenticating the SCIM requests of each SSO tenant. Providers must exist in email-provider-mappings." default:""`
+}
+
+// Ensure that ScimTokens implements pflag.Value.
+var _ pflag.Value = (*ScimTokens)(nil)
+
+// ScimTokens is a map of SSO providers to the bearer tokens of their SCIM clients.
+type ScimTokens struct {
+	Values map[string]string
+}
+
+// Type returns the type of the pflag.Value.
+func (ScimTokens) Type() string { return "sso.scim-tokens" }
+
+func (st *ScimTokens) String() string {
+	var s strings.Builder
+	i := 0
+	for k, v := range st.Values {
+		if i > 0 {
+			s.WriteString(";")
+		}
+		_, _ = fmt.Fprintf(&s, "%s:%s", k, v)
+		i++
+	}
+	return s.String()
+}
+
+// Set SCIM tokens from a semicolon-separated string.
+func (st *ScimTokens) Set(s string) error {
+	tokens := make(map[string]string)
+	for _, keyStr := range strings.Split(s, ";") {
+		if keyStr == "" {
+			continue
+		}
+
+		provider, token, ok := strings.Cut(keyStr, ":")
+		provider, token = strings.TrimSpace(provider), strings.TrimSpace(token)
+		if !ok || provider == "" || token == "" {
+			return Error.New("invalid string (expected format provider:bearer-token, got %s)", keyStr)
+		}
+		if _, ok := tokens[provider]; ok {
+			return Error.New("provider duplicate found. Provider must be unique: %s", provider)
+		}
+
+		tokens[provider] = token
+	}
+	st.Values = tokens
+	return nil
+}
+
 // Ensure that GeneralProviders implements pflag.Value.
 var _ pflag.Value = (*GeneralProviders)(nil)
 
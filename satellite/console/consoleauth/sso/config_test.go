@@ -105,3 +105,45 @@ func TestGeneralProvidersConfigValidation(t *testing.T) {
 		require.Equal(t, tt.expected, gp.Values)
 	}
 }
+
+func TestScimTokensConfigValidation(t *testing.T) {
+	tests := []struct {
+		description  string
+		configString string
+		expected     map[string]string
+		expectError  bool
+	}{
+		{
+			description:  "valid tokens",
+			configString: "provider1:token1;provider2:to:ken2",
+			expected:     map[string]string{"provider1": "token1", "provider2": "to:ken2"},
+		},
+		{
+			description:  "empty string",
+			configString: "",
+			expected:     map[string]string{},
+		},
+		{
+			description:  "missing token",
+			configString: "provider1:",
+			expectError:  true,
+		},
+		{
+			description:  "duplicate provider",
+			configString: "provider1:token1;provider1:token2",
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Log(tt.description)
+		st := sso.ScimTokens{}
+		err := st.Set(tt.configString)
+		if tt.expectError {
+			require.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, tt.expected, st.Values)
+	}
+}
@@ -123,6 +123,12 @@ func (s *Service) Initialize(ctx context.Context) (err error) {
 		return Error.New("primary auth provider %s is not configured in oidc-provider-infos", s.config.PrimaryAuthProvider)
 	}
 
+	for p := range s.config.Scim.Tokens.Values {
+		if _, ok := s.config.EmailProviderMappings.Values[p]; !ok {
+			return Error.New("SCIM provider %s is not configured in email-provider-mappings", p)
+		}
+	}
+
 	wh := s.config.Webhook
 	if wh.Enabled {
 		if wh.Username == "" || wh.Password == "" || wh.SigningSecret == "" || wh.SignatureHeader == "" {
@@ -454,6 +460,20 @@ func (s *Service) WebhookSignatureHeader() string {
 	return s.config.Webhook.SignatureHeader
 }
 
+// ScimEnabled returns whether the SCIM provisioning endpoint is enabled.
+func (s *Service) ScimEnabled() bool {
+	return s.config.Scim.Enabled
+}
+
+// AuthenticateScim returns whether the bearer token authenticates the SCIM client of the provider.
+func (s *Service) AuthenticateScim(provider, bearerToken string) bool {
+	token, ok := s.config.Scim.Tokens.Values[provider]
+	if !ok || token == "" || bearerToken == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(bearerToken), []byte(token)) == 1
+}
+
 // TestSetGeneralLinkVerificationEnabled sets general link verification enabled for testing.
 func (s *Service) TestSetGeneralLinkVerificationEnabled(enabled bool) {
 	s.config.GeneralLinkVerificationEnabled = enabled
@@ -0,0 +1,112 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package webauthn
+
+import (
+	"encoding/binary"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// Flags of the authenticator data.
+const (
+	flagUserPresent            = 0x01
+	flagUserVerified           = 0x04
+	flagBackupEligible         = 0x08
+	flagAttestedCredentialData = 0x40
+	flagExtensionData          = 0x80
+)
+
+const (
+	rpIDHashSize         = 32
+	aaguidSize           = 16
+	minAuthenticatorData = rpIDHashSize + 1 + 4
+	maxCredentialIDSize  = 1023
+)
+
+// authenticatorData is the parsed authenticator data of a ceremony response.
+type authenticatorData struct {
+	rpIDHash  []byte
+	flags     byte
+	signCount uint32
+
+	// credentialID and publicKey are only set in registration ceremonies.
+	credentialID []byte
+	publicKey    []byte
+}
+
+func (d *authenticatorData) hasFlag(flag byte) bool {
+	return d.flags&flag != 0
+}
+
+// parseAuthenticatorData parses the authenticator data as laid out in
+// https://www.w3.org/TR/webauthn-2/#sctn-authenticator-data.
+func parseAuthenticatorData(data []byte) (*authenticatorData, error) {
+	if len(data) < minAuthenticatorData {
+		return nil, ErrVerification.New("authenticator data is too short")
+	}
+
+	d := &authenticatorData{
+		rpIDHash:  data[:rpIDHashSize],
+		flags:     data[rpIDHashSize],
+		signCount: binary.BigEndian.Uint32(data[rpIDHashSize+1:]),
+	}
+	rest := data[minAuthenticatorData:]
+
+	if d.hasFlag(flagAttestedCredentialData) {
+		if len(rest) < aaguidSize+2 {
+			return nil, ErrVerification.New("attested credential data is too short")
+		}
+		rest = rest[aaguidSize:]
+
+		idLength := int(binary.BigEndian.Uint16(rest))
+		rest = rest[2:]
+		if idLength == 0 || idLength > maxCredentialIDSize || len(rest) < idLength {
+			return nil, ErrVerification.New("invalid credential ID length %d", idLength)
+		}
+		d.credentialID = rest[:idLength]
+		rest = rest[idLength:]
+
+		var publicKey cbor.RawMessage
+		var err error
+		rest, err = cbor.UnmarshalFirst(rest, &publicKey)
+		if err != nil {
+			return nil, ErrVerification.New("invalid credential public key: %v", err)
+		}
+		d.publicKey = publicKey
+	}
+
+	if d.hasFlag(flagExtensionData) {
+		var extensions cbor.RawMessage
+		var err error
+		rest, err = cbor.UnmarshalFirst(rest, &extensions)
+		if err != nil {
+			return nil, ErrVerification.New("invalid extension data: %v", err)
+		}
+	}
+
+	if len(rest) != 0 {
+		return nil, ErrVerification.New("authenticator data has %d trailing bytes", len(rest))
+	}
+
+	return d, nil
+}
+
+// attestationObject is the attestation object of a registration response.
+type attestationObject struct {
+	Format   string          `cbor:"fmt"`
+	AttStmt  cbor.RawMessage `cbor:"attStmt"`
+	AuthData []byte          `cbor:"authData"`
+}
+
+func parseAttestationObject(data []byte) (*attestationObject, error) {
+	var attestation attestationObject
+	if err := cbor.Unmarshal(data, &attestation); err != nil {
+		return nil, ErrVerification.New("invalid attestation object: %v", err)
+	}
+	if attestation.Format == "" || len(attestation.AuthData) == 0 {
+		return nil, ErrVerification.New("incomplete attestation object")
+	}
+	return &attestation, nil
+}
@@ -0,0 +1,157 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"math/big"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// COSE algorithm identifiers, see https://www.iana.org/assignments/cose/cose.xhtml.
+const (
+	AlgES256 int64 = -7
+	AlgEdDSA int64 = -8
+	AlgRS256 int64 = -257
+)
+
+// supportedAlgorithms are the supported signature algorithms in order of preference.
+var supportedAlgorithms = []int64{AlgES256, AlgEdDSA, AlgRS256}
+
+// COSE key parameters.
+const (
+	coseKeyType   = 1
+	coseAlgorithm = 3
+
+	coseEC2Curve = -1
+	coseEC2X     = -2
+	coseEC2Y     = -3
+
+	coseOKPCurve = -1
+	coseOKPX     = -2
+
+	coseRSAModulus  = -1
+	coseRSAExponent = -2
+
+	coseKeyTypeOKP = 1
+	coseKeyTypeEC2 = 2
+	coseKeyTypeRSA = 3
+
+	coseCurveP256    = 1
+	coseCurveEd25519 = 6
+)
+
+const minRSAKeyBits = 2048
+
+// publicKey verifies the signatures of a credential.
+type publicKey struct {
+	key crypto.PublicKey
+}
+
+// parsePublicKey parses a COSE encoded public key of a supported algorithm.
+func parsePublicKey(data []byte) (*publicKey, error) {
+	var params map[int]cbor.RawMessage
+	if err := cbor.Unmarshal(data, &params); err != nil {
+		return nil, ErrVerification.New("invalid public key: %v", err)
+	}
+
+	var kty, alg int64
+	if err := unmarshalParam(params, coseKeyType, &kty); err != nil {
+		return nil, err
+	}
+	if err := unmarshalParam(params, coseAlgorithm, &alg); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case alg == AlgES256 && kty == coseKeyTypeEC2:
+		var crv int64
+		var x, y []byte
+		if err := unmarshalParams(params, map[int]any{coseEC2Curve: &crv, coseEC2X: &x, coseEC2Y: &y}); err != nil {
+			return nil, err
+		}
+		if crv != coseCurveP256 || len(x) != 32 || len(y) != 32 {
+			return nil, ErrVerification.New("invalid ES256 public key")
+		}
+		point := append(append([]byte{0x04}, x...), y...)
+		key, err := ecdsa.ParseUncompressedPublicKey(elliptic.P256(), point)
+		if err != nil {
+			return nil, ErrVerification.New("invalid ES256 public key: %v", err)
+		}
+		return &publicKey{key: key}, nil
+
+	case alg == AlgEdDSA && kty == coseKeyTypeOKP:
+		var crv int64
+		var x []byte
+		if err := unmarshalParams(params, map[int]any{coseOKPCurve: &crv, coseOKPX: &x}); err != nil {
+			return nil, err
+		}
+		if crv != coseCurveEd25519 || len(x) != ed25519.PublicKeySize {
+			return nil, ErrVerification.New("invalid EdDSA public key")
+		}
+		return &publicKey{key: ed25519.PublicKey(x)}, nil
+
+	case alg == AlgRS256 && kty == coseKeyTypeRSA:
+		var n, e []byte
+		if err := unmarshalParams(params, map[int]any{coseRSAModulus: &n, coseRSAExponent: &e}); err != nil {
+			return nil, err
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+			return nil, ErrVerification.New("invalid RS256 public key exponent")
+		}
+		key := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}
+		if key.N.BitLen() < minRSAKeyBits {
+			return nil, ErrVerification.New("RS256 public key is shorter than %d bits", minRSAKeyBits)
+		}
+		return &publicKey{key: key}, nil
+	}
+
+	return nil, ErrVerification.New("unsupported public key algorithm %d", alg)
+}
+
+// verify verifies the signature of the data.
+func (k *publicKey) verify(data, signature []byte) error {
+	valid := false
+	switch key := k.key.(type) {
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(data)
+		valid = ecdsa.VerifyASN1(key, digest[:], signature)
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(key, data, signature)
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(data)
+		valid = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil
+	}
+	if !valid {
+		return ErrVerification.New("invalid signature")
+	}
+	return nil
+}
+
+func unmarshalParams(params map[int]cbor.RawMessage, targets map[int]any) error {
+	for label, target := range targets {
+		if err := unmarshalParam(params, label, target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func unmarshalParam(params map[int]cbor.RawMessage, label int, target any) error {
+	raw, ok := params[label]
+	if !ok {
+		return ErrVerification.New("public key parameter %d is missing", label)
+	}
+	if err := cbor.Unmarshal(raw, target); err != nil {
+		return ErrVerification.New("invalid public key parameter %d: %v", label, err)
+	}
+	return nil
+}
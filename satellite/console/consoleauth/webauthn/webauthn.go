@@ -0,0 +1,354 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package webauthn implements the relying party side of the WebAuthn
+// registration and authentication ceremonies used by security keys and passkeys.
+//
+// Attestation statements aren't verified. The relying party requests "none"
+// attestation conveyance, so the authenticator model isn't part of the trust decision.
+package webauthn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+var (
+	// Error is the default error class for the package.
+	Error = errs.Class("webauthn")
+	// ErrVerification is returned when a ceremony response doesn't verify.
+	ErrVerification = errs.Class("webauthn verification")
+)
+
+// ChallengeSize is the size of the random challenges of the ceremonies.
+const ChallengeSize = 32
+
+// Values of the user verification requirement.
+const (
+	UserVerificationRequired  = "required"
+	UserVerificationPreferred = "preferred"
+)
+
+const (
+	clientDataTypeCreate = "webauthn.create"
+	clientDataTypeGet    = "webauthn.get"
+	publicKeyType        = "public-key"
+)
+
+// Base64URL is a byte slice, which is encoded in JSON as unpadded base64url.
+type Base64URL []byte
+
+// MarshalJSON implements json.Marshaler.
+func (b Base64URL) MarshalJSON() ([]byte, error) {
+	return json.Marshal(base64.RawURLEncoding.EncodeToString(b))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Padded values are accepted as well.
+func (b *Base64URL) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return err
+	}
+	*b = decoded
+	return nil
+}
+
+// RelyingParty identifies the satellite to authenticators.
+type RelyingParty struct {
+	// ID is the domain the credentials are scoped to.
+	ID string
+	// Name is the human-readable name shown by authenticators.
+	Name string
+	// Origins are the origins the ceremonies may be performed from.
+	Origins []string
+}
+
+// NewChallenge returns a random ceremony challenge.
+func NewChallenge() ([]byte, error) {
+	challenge := make([]byte, ChallengeSize)
+	if _, err := rand.Read(challenge); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return challenge, nil
+}
+
+// RelyingPartyEntity describes the relying party in creation options.
+type RelyingPartyEntity struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// UserEntity describes the user account a credential is created for.
+type UserEntity struct {
+	// ID is the user handle, which authenticators return in passwordless logins.
+	ID          Base64URL `json:"id"`
+	Name        string    `json:"name"`
+	DisplayName string    `json:"displayName"`
+}
+
+// CredentialParameter describes a supported credential type and signature algorithm.
+type CredentialParameter struct {
+	Type string `json:"type"`
+	Alg  int64  `json:"alg"`
+}
+
+// CredentialDescriptor identifies an existing credential.
+type CredentialDescriptor struct {
+	Type       string    `json:"type"`
+	ID         Base64URL `json:"id"`
+	Transports []string  `json:"transports,omitempty"`
+}
+
+// NewCredentialDescriptor returns the descriptor of the credential.
+func NewCredentialDescriptor(id []byte, transports []string) CredentialDescriptor {
+	return CredentialDescriptor{Type: publicKeyType, ID: id, Transports: transports}
+}
+
+// AuthenticatorSelection describes the required authenticator capabilities.
+type AuthenticatorSelection struct {
+	ResidentKey      string `json:"residentKey"`
+	UserVerification string `json:"userVerification"`
+}
+
+// CreationOptions are the options of navigator.credentials.create().
+type CreationOptions struct {
+	RP                     RelyingPartyEntity     `json:"rp"`
+	User                   UserEntity             `json:"user"`
+	Challenge              Base64URL              `json:"challenge"`
+	PubKeyCredParams       []CredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                  `json:"timeout,omitempty"`
+	ExcludeCredentials     []CredentialDescriptor `json:"excludeCredentials,omitempty"`
+	AuthenticatorSelection AuthenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                 `json:"attestation"`
+}
+
+// RequestOptions are the options of navigator.credentials.get().
+type RequestOptions struct {
+	Challenge        Base64URL              `json:"challenge"`
+	Timeout          int64                  `json:"timeout,omitempty"`
+	RPID             string                 `json:"rpId"`
+	AllowCredentials []CredentialDescriptor `json:"allowCredentials,omitempty"`
+	UserVerification string                 `json:"userVerification"`
+}
+
+// CreationOptions returns the options of a registration ceremony. Discoverable
+// credentials are preferred, so the credential can be used for passwordless logins.
+func (rp RelyingParty) CreationOptions(challenge []byte, user UserEntity, exclude []CredentialDescriptor, timeout time.Duration) CreationOptions {
+	params := make([]CredentialParameter, 0, len(supportedAlgorithms))
+	for _, alg := range supportedAlgorithms {
+		params = append(params, CredentialParameter{Type: publicKeyType, Alg: alg})
+	}
+
+	return CreationOptions{
+		RP:                 RelyingPartyEntity{ID: rp.ID, Name: rp.Name},
+		User:               user,
+		Challenge:          challenge,
+		PubKeyCredParams:   params,
+		Timeout:            timeout.Milliseconds(),
+		ExcludeCredentials: exclude,
+		AuthenticatorSelection: AuthenticatorSelection{
+			ResidentKey:      "preferred",
+			UserVerification: UserVerificationPreferred,
+		},
+		Attestation: "none",
+	}
+}
+
+// RequestOptions returns the options of an authentication ceremony. An empty
+// allow list lets the user pick any discoverable credential of the relying party.
+func (rp RelyingParty) RequestOptions(challenge []byte, allow []CredentialDescriptor, userVerification string, timeout time.Duration) RequestOptions {
+	return RequestOptions{
+		Challenge:        challenge,
+		Timeout:          timeout.Milliseconds(),
+		RPID:             rp.ID,
+		AllowCredentials: allow,
+		UserVerification: userVerification,
+	}
+}
+
+// AuthenticatorAttestationResponse is the authenticator response of a registration ceremony.
+type AuthenticatorAttestationResponse struct {
+	ClientDataJSON    Base64URL `json:"clientDataJSON"`
+	AttestationObject Base64URL `json:"attestationObject"`
+	Transports        []string  `json:"transports,omitempty"`
+}
+
+// AttestationResponse is the credential created in a registration ceremony.
+type AttestationResponse struct {
+	ID       string                           `json:"id"`
+	RawID    Base64URL                        `json:"rawId"`
+	Type     string                           `json:"type"`
+	Response AuthenticatorAttestationResponse `json:"response"`
+}
+
+// AuthenticatorAssertionResponse is the authenticator response of an authentication ceremony.
+type AuthenticatorAssertionResponse struct {
+	ClientDataJSON    Base64URL `json:"clientDataJSON"`
+	AuthenticatorData Base64URL `json:"authenticatorData"`
+	Signature         Base64URL `json:"signature"`
+	UserHandle        Base64URL `json:"userHandle,omitempty"`
+}
+
+// AssertionResponse is the credential asserted in an authentication ceremony.
+type AssertionResponse struct {
+	ID       string                         `json:"id"`
+	RawID    Base64URL                      `json:"rawId"`
+	Type     string                         `json:"type"`
+	Response AuthenticatorAssertionResponse `json:"response"`
+}
+
+// Credential is a credential verified in a registration ceremony.
+type Credential struct {
+	ID []byte
+	// PublicKey is the COSE encoded public key of the credential.
+	PublicKey  []byte
+	SignCount  uint32
+	Transports []string
+	// UserVerified is whether the authenticator verified the user, e.g. by PIN or biometrics.
+	UserVerified bool
+	// BackupEligible is whether the credential can be synced between devices, like passkeys.
+	BackupEligible bool
+}
+
+// VerifyRegistration verifies the response of a registration ceremony
+// started with the challenge and returns the created credential.
+func (rp RelyingParty) VerifyRegistration(challenge []byte, response AttestationResponse) (_ *Credential, err error) {
+	if response.Type != publicKeyType {
+		return nil, ErrVerification.New("unexpected credential type %q", response.Type)
+	}
+
+	if err = rp.verifyClientData(response.Response.ClientDataJSON, clientDataTypeCreate, challenge); err != nil {
+		return nil, err
+	}
+
+	attestation, err := parseAttestationObject(response.Response.AttestationObject)
+	if err != nil {
+		return nil, err
+	}
+
+	authData, err := parseAuthenticatorData(attestation.AuthData)
+	if err != nil {
+		return nil, err
+	}
+	if err = rp.verifyAuthenticatorData(authData, false); err != nil {
+		return nil, err
+	}
+	if !authData.hasFlag(flagAttestedCredentialData) {
+		return nil, ErrVerification.New("attested credential data is missing")
+	}
+	if len(response.RawID) > 0 && !bytes.Equal(response.RawID, authData.credentialID) {
+		return nil, ErrVerification.New("credential ID doesn't match the authenticator data")
+	}
+
+	if _, err = parsePublicKey(authData.publicKey); err != nil {
+		return nil, err
+	}
+
+	return &Credential{
+		ID:             authData.credentialID,
+		PublicKey:      authData.publicKey,
+		SignCount:      authData.signCount,
+		Transports:     response.Response.Transports,
+		UserVerified:   authData.hasFlag(flagUserVerified),
+		BackupEligible: authData.hasFlag(flagBackupEligible),
+	}, nil
+}
+
+// VerifyAssertion verifies the response of an authentication ceremony started
+// with the challenge against the stored public key and signature counter of
+// the credential. It returns the new signature counter of the credential.
+func (rp RelyingParty) VerifyAssertion(challenge, publicKey []byte, signCount uint32, response AssertionResponse, requireUserVerification bool) (_ uint32, err error) {
+	if response.Type != publicKeyType {
+		return 0, ErrVerification.New("unexpected credential type %q", response.Type)
+	}
+
+	if err = rp.verifyClientData(response.Response.ClientDataJSON, clientDataTypeGet, challenge); err != nil {
+		return 0, err
+	}
+
+	authData, err := parseAuthenticatorData(response.Response.AuthenticatorData)
+	if err != nil {
+		return 0, err
+	}
+	if err = rp.verifyAuthenticatorData(authData, requireUserVerification); err != nil {
+		return 0, err
+	}
+
+	key, err := parsePublicKey(publicKey)
+	if err != nil {
+		return 0, err
+	}
+
+	clientDataHash := sha256.Sum256(response.Response.ClientDataJSON)
+	signed := append(slices.Clip([]byte(response.Response.AuthenticatorData)), clientDataHash[:]...)
+	if err = key.verify(signed, response.Response.Signature); err != nil {
+		return 0, err
+	}
+
+	// authenticators which don't implement the counter always report zero. Otherwise, the
+	// counter must increase, or the credential may have been cloned.
+	if (authData.signCount != 0 || signCount != 0) && authData.signCount <= signCount {
+		return 0, ErrVerification.New("signature counter didn't increase")
+	}
+
+	return authData.signCount, nil
+}
+
+type clientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+func (rp RelyingParty) verifyClientData(data []byte, ceremonyType string, challenge []byte) error {
+	var cd clientData
+	if err := json.Unmarshal(data, &cd); err != nil {
+		return ErrVerification.New("invalid client data: %v", err)
+	}
+
+	if cd.Type != ceremonyType {
+		return ErrVerification.New("unexpected ceremony type %q", cd.Type)
+	}
+
+	received, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(cd.Challenge, "="))
+	if err != nil || len(challenge) == 0 || subtle.ConstantTimeCompare(received, challenge) != 1 {
+		return ErrVerification.New("challenge mismatch")
+	}
+
+	if !slices.Contains(rp.Origins, cd.Origin) {
+		return ErrVerification.New("unexpected origin %q", cd.Origin)
+	}
+	if cd.CrossOrigin {
+		return ErrVerification.New("cross-origin ceremonies aren't allowed")
+	}
+
+	return nil
+}
+
+func (rp RelyingParty) verifyAuthenticatorData(authData *authenticatorData, requireUserVerification bool) error {
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if subtle.ConstantTimeCompare(authData.rpIDHash, rpIDHash[:]) != 1 {
+		return ErrVerification.New("relying party ID mismatch")
+	}
+	if !authData.hasFlag(flagUserPresent) {
+		return ErrVerification.New("user presence wasn't verified")
+	}
+	if requireUserVerification && !authData.hasFlag(flagUserVerified) {
+		return ErrVerification.New("user verification is required")
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package webauthn_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/console/consoleauth/webauthn"
+	"storj.io/storj/satellite/console/consoleauth/webauthn/webauthntest"
+)
+
+func TestRegistrationAndAssertion(t *testing.T) {
+	rp := webauthn.RelyingParty{ID: "example.test", Name: "Example", Origins: []string{"https://example.test"}}
+	authenticator := webauthntest.New(t, rp.ID, "https://example.test")
+
+	challenge, err := webauthn.NewChallenge()
+	require.NoError(t, err)
+
+	response := authenticator.Register(t, challenge)
+
+	// the response survives the JSON round trip of the browser API.
+	encoded, err := json.Marshal(response)
+	require.NoError(t, err)
+	var decoded webauthn.AttestationResponse
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+
+	otherChallenge, err := webauthn.NewChallenge()
+	require.NoError(t, err)
+	_, err = rp.VerifyRegistration(otherChallenge, decoded)
+	require.True(t, webauthn.ErrVerification.Has(err))
+
+	credential, err := rp.VerifyRegistration(challenge, decoded)
+	require.NoError(t, err)
+	require.Equal(t, authenticator.CredentialID, credential.ID)
+	require.Equal(t, []string{"usb"}, credential.Transports)
+	require.True(t, credential.UserVerified)
+
+	t.Run("assertion", func(t *testing.T) {
+		challenge, err := webauthn.NewChallenge()
+		require.NoError(t, err)
+
+		signCount, err := rp.VerifyAssertion(challenge, credential.PublicKey, credential.SignCount, authenticator.Assert(t, challenge, nil), true)
+		require.NoError(t, err)
+		require.Equal(t, authenticator.SignCount, signCount)
+
+		// replaying an assertion doesn't increase the counter.
+		assertion := authenticator.Assert(t, challenge, nil)
+		signCount, err = rp.VerifyAssertion(challenge, credential.PublicKey, signCount, assertion, true)
+		require.NoError(t, err)
+		_, err = rp.VerifyAssertion(challenge, credential.PublicKey, signCount, assertion, true)
+		require.True(t, webauthn.ErrVerification.Has(err))
+
+		assertion = authenticator.Assert(t, challenge, nil)
+		assertion.Response.Signature[len(assertion.Response.Signature)-1] ^= 0xFF
+		_, err = rp.VerifyAssertion(challenge, credential.PublicKey, signCount, assertion, true)
+		require.True(t, webauthn.ErrVerification.Has(err))
+	})
+
+	t.Run("user verification", func(t *testing.T) {
+		authenticator.UserVerified = false
+		defer func() { authenticator.UserVerified = true }()
+
+		challenge, err := webauthn.NewChallenge()
+		require.NoError(t, err)
+
+		signCount := authenticator.SignCount
+		_, err = rp.VerifyAssertion(challenge, credential.PublicKey, signCount, authenticator.Assert(t, challenge, nil), true)
+		require.True(t, webauthn.ErrVerification.Has(err))
+
+		_, err = rp.VerifyAssertion(challenge, credential.PublicKey, signCount, authenticator.Assert(t, challenge, nil), false)
+		require.NoError(t, err)
+	})
+
+	t.Run("relying party mismatch", func(t *testing.T) {
+		challenge, err := webauthn.NewChallenge()
+		require.NoError(t, err)
+
+		other := webauthn.RelyingParty{ID: "other.test", Origins: rp.Origins}
+		_, err = other.VerifyAssertion(challenge, credential.PublicKey, 0, authenticator.Assert(t, challenge, nil), false)
+		require.True(t, webauthn.ErrVerification.Has(err))
+
+		other = webauthn.RelyingParty{ID: rp.ID, Origins: []string{"https://other.test"}}
+		_, err = other.VerifyAssertion(challenge, credential.PublicKey, 0, authenticator.Assert(t, challenge, nil), false)
+		require.True(t, webauthn.ErrVerification.Has(err))
+	})
+}
+
+func TestZeroSignCount(t *testing.T) {
+	rp := webauthn.RelyingParty{ID: "example.test", Origins: []string{"https://example.test"}}
+	authenticator := webauthntest.New(t, rp.ID, "https://example.test")
+	authenticator.SignCount = 0
+
+	challenge, err := webauthn.NewChallenge()
+	require.NoError(t, err)
+	credential, err := rp.VerifyRegistration(challenge, authenticator.Register(t, challenge))
+	require.NoError(t, err)
+
+	// authenticators without a counter always report zero.
+	for range 2 {
+		signCount, err := rp.VerifyAssertion(challenge, credential.PublicKey, 0, authenticator.Assert(t, challenge, nil), false)
+		require.NoError(t, err)
+		require.Zero(t, signCount)
+	}
+}
+
+func TestOptions(t *testing.T) {
+	rp := webauthn.RelyingParty{ID: "example.test", Name: "Example", Origins: []string{"https://example.test"}}
+
+	creation := rp.CreationOptions([]byte{1, 2, 3}, webauthn.UserEntity{ID: []byte{4}, Name: "alice@example.test"},
+		[]webauthn.CredentialDescriptor{webauthn.NewCredentialDescriptor([]byte{5}, nil)}, time.Minute)
+
+	encoded, err := json.Marshal(creation)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"rp": {"id": "example.test", "name": "Example"},
+		"user": {"id": "BA", "name": "alice@example.test", "displayName": ""},
+		"challenge": "AQID",
+		"pubKeyCredParams": [{"type": "public-key", "alg": -7}, {"type": "public-key", "alg": -8}, {"type": "public-key", "alg": -257}],
+		"timeout": 60000,
+		"excludeCredentials": [{"type": "public-key", "id": "BQ"}],
+		"authenticatorSelection": {"residentKey": "preferred", "userVerification": "preferred"},
+		"attestation": "none"
+	}`, string(encoded))
+
+	var padded webauthn.Base64URL
+	require.NoError(t, json.Unmarshal([]byte(`"AQ=="`), &padded))
+	require.Equal(t, webauthn.Base64URL{1}, padded)
+}
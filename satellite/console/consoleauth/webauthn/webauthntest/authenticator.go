@@ -0,0 +1,140 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package webauthntest implements a software authenticator for testing WebAuthn ceremonies.
+package webauthntest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/console/consoleauth/webauthn"
+)
+
+// Authenticator is a software authenticator holding a single ES256 credential.
+type Authenticator struct {
+	RPID   string
+	Origin string
+
+	// CredentialID is the ID of the credential.
+	CredentialID []byte
+	// SignCount is the signature counter, incremented by every assertion.
+	// Zero keeps the counter unimplemented.
+	SignCount uint32
+	// UserVerified is whether the authenticator reports that it verified the user.
+	UserVerified bool
+
+	key *ecdsa.PrivateKey
+}
+
+// New creates an authenticator for the relying party with a new credential.
+func New(t testing.TB, rpID, origin string) *Authenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	credentialID := make([]byte, 16)
+	_, err = rand.Read(credentialID)
+	require.NoError(t, err)
+
+	return &Authenticator{
+		RPID:         rpID,
+		Origin:       origin,
+		CredentialID: credentialID,
+		SignCount:    1,
+		UserVerified: true,
+		key:          key,
+	}
+}
+
+// Register returns the response to the registration ceremony with the challenge.
+func (a *Authenticator) Register(t testing.TB, challenge []byte) webauthn.AttestationResponse {
+	x, y := a.publicKeyCoordinates(t)
+	publicKey, err := cbor.Marshal(map[int]any{1: 2, 3: webauthn.AlgES256, -1: 1, -2: x, -3: y})
+	require.NoError(t, err)
+
+	authData := a.authenticatorData(0x40)
+	authData = append(authData, make([]byte, 16)...) // AAGUID
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(a.CredentialID)))
+	authData = append(authData, a.CredentialID...)
+	authData = append(authData, publicKey...)
+
+	attestationObject, err := cbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": authData,
+	})
+	require.NoError(t, err)
+
+	return webauthn.AttestationResponse{
+		ID:    base64.RawURLEncoding.EncodeToString(a.CredentialID),
+		RawID: a.CredentialID,
+		Type:  "public-key",
+		Response: webauthn.AuthenticatorAttestationResponse{
+			ClientDataJSON:    a.clientData(t, "webauthn.create", challenge),
+			AttestationObject: attestationObject,
+			Transports:        []string{"usb"},
+		},
+	}
+}
+
+// Assert returns the response to the authentication ceremony with the challenge.
+func (a *Authenticator) Assert(t testing.TB, challenge, userHandle []byte) webauthn.AssertionResponse {
+	if a.SignCount != 0 {
+		a.SignCount++
+	}
+
+	authData := a.authenticatorData(0)
+	clientData := a.clientData(t, "webauthn.get", challenge)
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	require.NoError(t, err)
+
+	return webauthn.AssertionResponse{
+		ID:    base64.RawURLEncoding.EncodeToString(a.CredentialID),
+		RawID: a.CredentialID,
+		Type:  "public-key",
+		Response: webauthn.AuthenticatorAssertionResponse{
+			ClientDataJSON:    clientData,
+			AuthenticatorData: authData,
+			Signature:         signature,
+			UserHandle:        userHandle,
+		},
+	}
+}
+
+func (a *Authenticator) authenticatorData(flags byte) []byte {
+	flags |= 0x01
+	if a.UserVerified {
+		flags |= 0x04
+	}
+	rpIDHash := sha256.Sum256([]byte(a.RPID))
+	data := append(rpIDHash[:], flags)
+	return binary.BigEndian.AppendUint32(data, a.SignCount)
+}
+
+func (a *Authenticator) clientData(t testing.TB, ceremonyType string, challenge []byte) []byte {
+	data, err := json.Marshal(map[string]any{
+		"type":      ceremonyType,
+		"challenge": base64.RawURLEncoding.EncodeToString(challenge),
+		"origin":    a.Origin,
+	})
+	require.NoError(t, err)
+	return data
+}
+
+func (a *Authenticator) publicKeyCoordinates(t testing.TB) (x, y []byte) {
+	point, err := a.key.PublicKey.Bytes()
+	require.NoError(t, err)
+	return point[1:33], point[33:]
+}
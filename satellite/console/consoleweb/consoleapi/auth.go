@@ -148,7 +148,11 @@ func (a *Auth) Token(w http.ResponseWriter, r *http.Request) {
 	tokenInfo, err := a.service.Token(ctx, tokenRequest)
 	if err != nil {
 		if console.ErrMFAMissing.Has(err) {
-			web.ServeCustomJSONError(ctx, a.log, w, http.StatusOK, err, a.getUserErrorMessage(err))
+			if challenge, ok := console.AsWebAuthnMFARequired(err); ok {
+				a.serveWebAuthnMFARequired(w, challenge, err)
+			} else {
+				web.ServeCustomJSONError(ctx, a.log, w, http.StatusOK, err, a.getUserErrorMessage(err))
+			}
 		} else {
 			a.log.Info("Error authenticating token request", zap.String("email", tokenRequest.Email), zap.Error(ErrAuthAPI.Wrap(err)))
 			a.serveJSONError(ctx, w, err)
@@ -2093,7 +2097,7 @@ func (a *Auth) getStatusCode(err error) int {
 	case console.ErrUnauthorized.Has(err), console.ErrTokenExpiration.Has(err),
 		console.ErrRecoveryToken.Has(err), console.ErrLoginCredentials.Has(err),
 		console.ErrActivationCode.Has(err), sso.ErrTokenVerification.Has(err),
-		sso.ErrInvalidState.Has(err):
+		sso.ErrInvalidState.Has(err), console.ErrWebAuthn.Has(err):
 		return http.StatusUnauthorized
 	case console.ErrEmailUsed.Has(err), console.ErrMFAConflict.Has(err), console.ErrMFAEnabled.Has(err), console.ErrConflict.Has(err):
 		return http.StatusConflict
@@ -2105,7 +2109,7 @@ func (a *Auth) getStatusCode(err error) int {
 		return http.StatusPaymentRequired
 	case errors.As(err, &maxBytesError):
 		return http.StatusRequestEntityTooLarge
-	case console.ErrEmailNotFound.Has(err):
+	case console.ErrEmailNotFound.Has(err), console.ErrNotFound.Has(err):
 		return http.StatusNotFound
 	default:
 		return http.StatusInternalServerError
@@ -2142,7 +2146,8 @@ func (a *Auth) getUserErrorMessage(err error) string {
 		return "You can't be authenticated. Please contact support"
 	case console.ErrValidation.Has(err), console.ErrChangePassword.Has(err), console.ErrInvalidProjectLimit.Has(err),
 		console.ErrNotPaidTier.Has(err), console.ErrTooManyAttempts.Has(err), console.ErrMFAEnabled.Has(err),
-		console.ErrForbidden.Has(err), console.ErrConflict.Has(err), console.ErrProjectInviteInvalid.Has(err):
+		console.ErrForbidden.Has(err), console.ErrConflict.Has(err), console.ErrProjectInviteInvalid.Has(err),
+		console.ErrWebAuthn.Has(err), console.ErrNotFound.Has(err):
 		return err.Error()
 	case errors.Is(err, errNotImplemented):
 		return "The server is incapable of fulfilling the request"
@@ -16,6 +16,7 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/private/web"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 )
 
@@ -253,6 +254,10 @@ func (b *Buckets) GetBucketTotals(w http.ResponseWriter, r *http.Request) {
 			b.serveJSONError(ctx, w, http.StatusUnauthorized, err)
 			return
 		}
+		if console.ErrForbidden.Has(err) {
+			b.serveJSONError(ctx, w, http.StatusForbidden, err)
+			return
+		}
 
 		b.serveJSONError(ctx, w, http.StatusInternalServerError, err)
 		return
@@ -306,6 +311,10 @@ func (b *Buckets) GetSingleBucketTotals(w http.ResponseWriter, r *http.Request)
 			b.serveJSONError(ctx, w, http.StatusUnauthorized, err)
 			return
 		}
+		if console.ErrForbidden.Has(err) {
+			b.serveJSONError(ctx, w, http.StatusForbidden, err)
+			return
+		}
 
 		b.serveJSONError(ctx, w, http.StatusInternalServerError, err)
 		return
@@ -317,6 +326,109 @@ func (b *Buckets) GetSingleBucketTotals(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// bucketLimits is the JSON representation of user-specified bucket limits.
+// A null value means the bucket has no limit of that kind.
+type bucketLimits struct {
+	Storage   *int64 `json:"storage"`
+	Bandwidth *int64 `json:"bandwidth"`
+	Segments  *int64 `json:"segments"`
+}
+
+// GetBucketLimits returns the user-specified limits of a single bucket.
+func (b *Buckets) GetBucketLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, ok := b.parseBucketLimitsParams(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	limits, err := b.service.GetBucketLimits(ctx, projectID, bucketName)
+	if err != nil {
+		b.serveBucketLimitsError(ctx, w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(bucketLimits{
+		Storage:   limits.Storage,
+		Bandwidth: limits.Bandwidth,
+		Segments:  limits.Segments,
+	})
+	if err != nil {
+		b.log.Error("failed to write json bucket limits response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// UpdateBucketLimits sets the user-specified limits of a single bucket.
+func (b *Buckets) UpdateBucketLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, bucketName, ok := b.parseBucketLimitsParams(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	var request bucketLimits
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		b.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = b.service.UpdateBucketLimits(ctx, projectID, bucketName, buckets.Limits{
+		Storage:   request.Storage,
+		Bandwidth: request.Bandwidth,
+		Segments:  request.Segments,
+	})
+	if err != nil {
+		b.serveBucketLimitsError(ctx, w, err)
+		return
+	}
+}
+
+// parseBucketLimitsParams parses the project ID and bucket name query parameters.
+func (b *Buckets) parseBucketLimitsParams(ctx context.Context, w http.ResponseWriter, r *http.Request) (projectID uuid.UUID, bucketName string, ok bool) {
+	projectIDString := r.URL.Query().Get("projectID")
+	if projectIDString == "" {
+		b.serveJSONError(ctx, w, http.StatusBadRequest, errs.New(missingParamErrMsg, "projectID"))
+		return uuid.UUID{}, "", false
+	}
+	projectID, err := uuid.FromString(projectIDString)
+	if err != nil {
+		b.serveJSONError(ctx, w, http.StatusBadRequest, errs.New(invalidParamErrMsg, projectIDString, "projectID", err))
+		return uuid.UUID{}, "", false
+	}
+
+	bucketName = r.URL.Query().Get("bucket")
+	if len(bucketName) < 3 || len(bucketName) > 63 {
+		b.serveJSONError(ctx, w, http.StatusBadRequest, errs.New(invalidParamErrMsg, bucketName, "bucket", errs.New("bucket name must be at least 3 and no more than 63 characters long")))
+		return uuid.UUID{}, "", false
+	}
+
+	return projectID, bucketName, true
+}
+
+// serveBucketLimitsError maps a bucket limits service error to the response status.
+func (b *Buckets) serveBucketLimitsError(ctx context.Context, w http.ResponseWriter, err error) {
+	switch {
+	case console.ErrUnauthorized.Has(err):
+		b.serveJSONError(ctx, w, http.StatusUnauthorized, err)
+	case console.ErrForbidden.Has(err):
+		b.serveJSONError(ctx, w, http.StatusForbidden, err)
+	case console.ErrNotFound.Has(err):
+		b.serveJSONError(ctx, w, http.StatusNotFound, err)
+	case console.ErrValidation.Has(err):
+		b.serveJSONError(ctx, w, http.StatusBadRequest, err)
+	default:
+		b.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (b *Buckets) serveJSONError(ctx context.Context, w http.ResponseWriter, status int, err error) {
 	web.ServeJSONError(ctx, b.log, w, status, err)
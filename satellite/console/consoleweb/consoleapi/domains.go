@@ -104,7 +104,7 @@ func (d *Domains) CreateDomain(w http.ResponseWriter, r *http.Request) {
 			d.serveJSONError(ctx, w, http.StatusUnauthorized, err)
 			return
 		}
-		if console.ErrNotPaidTier.Has(err) {
+		if console.ErrNotPaidTier.Has(err) || console.ErrForbidden.Has(err) {
 			d.serveJSONError(ctx, w, http.StatusForbidden, err)
 			return
 		}
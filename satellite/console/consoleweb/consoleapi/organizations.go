@@ -0,0 +1,465 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/private/web"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/payments"
+)
+
+var (
+	// ErrOrganizationsAPI - console organizations api error type.
+	ErrOrganizationsAPI = errs.Class("console organizations")
+)
+
+// Organizations is an api controller that exposes all organizations functionality.
+type Organizations struct {
+	log     *zap.Logger
+	service *console.Service
+}
+
+// NewOrganizations is a constructor for Organizations controller.
+func NewOrganizations(log *zap.Logger, service *console.Service) *Organizations {
+	return &Organizations{
+		log:     log,
+		service: service,
+	}
+}
+
+// GetOrganizations returns the organizations the user is a member of.
+func (o *Organizations) GetOrganizations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	organizations, err := o.service.GetOrganizations(ctx)
+	if err != nil {
+		o.serveServiceError(ctx, w, err)
+		return
+	}
+
+	o.encode(ctx, w, organizations)
+}
+
+// CreateOrganization creates an organization owned by the user.
+func (o *Organizations) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	var info console.UpsertOrganizationInfo
+	if err = json.NewDecoder(r.Body).Decode(&info); err != nil {
+		o.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	organization, err := o.service.CreateOrganization(ctx, info)
+	if err != nil {
+		o.serveServiceError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	o.encode(ctx, w, organization)
+}
+
+// GetOrganization returns the organization together with the user's role.
+func (o *Organizations) GetOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, ok := o.uuidRouteParam(ctx, w, r, "id")
+	if !ok {
+		return
+	}
+
+	organization, err := o.service.GetOrganization(ctx, id)
+	if err != nil {
+		o.serveServiceError(ctx, w, err)
+		return
+	}
+
+	o.encode(ctx, w, organization)
+}
+
+// UpdateOrganization renames the organization.
+func (o *Organizations) UpdateOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, ok := o.uuidRouteParam(ctx, w, r, "id")
+	if !ok {
+		return
+	}
+
+	var info console.UpsertOrganizationInfo
+	if err = json.NewDecoder(r.Body).Decode(&info); err != nil {
+		o.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	organization, err := o.service.UpdateOrganization(ctx, id, info)
+	if err != nil {
+		o.serveServiceError(ctx, w, err)
+		return
+	}
+
+	o.encode(ctx, w, organization)
+}
+
+// DeleteOrganization deletes the organization.
+func (o *Organizations) DeleteOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, ok := o.uuidRouteParam(ctx, w, r, "id")
+	if !ok {
+		return
+	}
+
+	err = o.service.DeleteOrganization(ctx, id)
+	if err != nil {
+		o.serveServiceError(ctx, w, err)
+	}
+}
+
+// GetMembers returns the members of the organization.
+func (o *Organizations) GetMembers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, ok := o.uuidRouteParam(ctx, w, r, "id")
+	if !ok {
+		return
+	}
+
+	members, err := o.service.GetOrganizationMembers(ctx, id)
+	if err != nil {
+		o.serveServiceError(ctx, w, err)
+		return
+	}
+
+	o.encode(ctx, w, members)
+}
+
+// AddMember adds a user to the organization.
+func (o *Organizations) AddMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, ok := o.uuidRouteParam(ctx, w, r, "id")
+	if !ok {
+		return
+	}
+
+	var data struct {
+		Email string                   `json:"email"`
+		Role  console.OrganizationRole `json:"role"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&data); err != nil {
+		o.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	member, err := o.service.AddOrganizationMember(ctx, id, data.Email, data.Role)
+	if err != nil {
+		o.serveServiceError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	o.encode(ctx, w, member)
+}
+
+// UpdateMemberRole changes the role of a member of the organization.
+func (o *Organizations) UpdateMemberRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, ok := o.uuidRouteParam(ctx, w, r, "id")
+	if !ok {
+		return
+	}
+	memberID, ok := o.uuidRouteParam(ctx, w, r, "memberID")
+	if !ok {
+		return
+	}
+
+	var data struct {
+		Role console.OrganizationRole `json:"role"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&data); err != nil {
+		o.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = o.service.UpdateOrganizationMemberRole(ctx, id, memberID, data.Role)
+	if err != nil {
+		o.serveServiceError(ctx, w, err)
+	}
+}
+
+// RemoveMember removes a member from the organization.
+func (o *Organizations) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, ok := o.uuidRouteParam(ctx, w, r, "id")
+	if !ok {
+		return
+	}
+	memberID, ok := o.uuidRouteParam(ctx, w, r, "memberID")
+	if !ok {
+		return
+	}
+
+	err = o.service.RemoveOrganizationMember(ctx, id, memberID)
+	if err != nil {
+		o.serveServiceError(ctx, w, err)
+	}
+}
+
+// GetProjects returns the projects of the organization.
+func (o *Organizations) GetProjects(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, ok := o.uuidRouteParam(ctx, w, r, "id")
+	if !ok {
+		return
+	}
+
+	projects, err := o.service.GetOrganizationProjects(ctx, id)
+	if err != nil {
+		o.serveServiceError(ctx, w, err)
+		return
+	}
+
+	response := make([]console.ProjectInfo, 0, len(projects))
+	for _, project := range projects {
+		response = append(response, o.service.GetMinimalProject(&project))
+	}
+
+	o.encode(ctx, w, response)
+}
+
+// TransferProject moves a project owned by the user into the organization.
+func (o *Organizations) TransferProject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, ok := o.uuidRouteParam(ctx, w, r, "id")
+	if !ok {
+		return
+	}
+	projectID, ok := o.uuidRouteParam(ctx, w, r, "projectID")
+	if !ok {
+		return
+	}
+
+	err = o.service.TransferProjectToOrganization(ctx, projectID, id)
+	if err != nil {
+		o.serveServiceError(ctx, w, err)
+	}
+}
+
+// GetUsageLimits returns the usage and the limits of the organization.
+func (o *Organizations) GetUsageLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, ok := o.uuidRouteParam(ctx, w, r, "id")
+	if !ok {
+		return
+	}
+
+	usageLimits, err := o.service.GetOrganizationUsageLimits(ctx, id)
+	if err != nil {
+		o.serveServiceError(ctx, w, err)
+		return
+	}
+
+	o.encode(ctx, w, usageLimits)
+}
+
+// GetCreditCards returns the credit cards of the organization.
+func (o *Organizations) GetCreditCards(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, ok := o.uuidRouteParam(ctx, w, r, "id")
+	if !ok {
+		return
+	}
+
+	cards, err := o.service.GetOrganizationCreditCards(ctx, id)
+	if err != nil {
+		o.serveServiceError(ctx, w, err)
+		return
+	}
+
+	o.encode(ctx, w, cards)
+}
+
+// AddCreditCard adds a credit card to the organization and makes it the default one.
+func (o *Organizations) AddCreditCard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, ok := o.uuidRouteParam(ctx, w, r, "id")
+	if !ok {
+		return
+	}
+
+	var data struct {
+		Token string `json:"token"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&data); err != nil {
+		o.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+	if data.Token == "" {
+		o.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("credit card ID is required"))
+		return
+	}
+
+	card, err := o.service.AddOrganizationCreditCard(ctx, id, data.Token)
+	if err != nil {
+		o.serveServiceError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	o.encode(ctx, w, card)
+}
+
+// RemoveCreditCard removes a credit card from the organization.
+func (o *Organizations) RemoveCreditCard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, ok := o.uuidRouteParam(ctx, w, r, "id")
+	if !ok {
+		return
+	}
+
+	err = o.service.RemoveOrganizationCreditCard(ctx, id, mux.Vars(r)["cardID"])
+	if err != nil {
+		o.serveServiceError(ctx, w, err)
+	}
+}
+
+// GetInvoices returns the invoices of the organization.
+func (o *Organizations) GetInvoices(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, ok := o.uuidRouteParam(ctx, w, r, "id")
+	if !ok {
+		return
+	}
+
+	invoices, err := o.service.GetOrganizationInvoices(ctx, id)
+	if err != nil {
+		o.serveServiceError(ctx, w, err)
+		return
+	}
+
+	o.encode(ctx, w, invoices)
+}
+
+func (o *Organizations) encode(ctx context.Context, w http.ResponseWriter, value any) {
+	err := json.NewEncoder(w).Encode(value)
+	if err != nil {
+		o.log.Error("failed to write json response", zap.Error(ErrOrganizationsAPI.Wrap(err)))
+	}
+}
+
+func (o *Organizations) uuidRouteParam(ctx context.Context, w http.ResponseWriter, r *http.Request, name string) (uuid.UUID, bool) {
+	param, ok := mux.Vars(r)[name]
+	if !ok {
+		o.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("missing %s route param", name))
+		return uuid.UUID{}, false
+	}
+
+	id, err := uuid.FromString(param)
+	if err != nil {
+		o.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return uuid.UUID{}, false
+	}
+
+	return id, true
+}
+
+// serveServiceError writes the error returned by the console service with the matching status code.
+func (o *Organizations) serveServiceError(ctx context.Context, w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case console.ErrUnauthorized.Has(err), console.ErrNoMembership.Has(err):
+		status = http.StatusUnauthorized
+	case console.ErrForbidden.Has(err), console.ErrNotPaidTier.Has(err):
+		status = http.StatusForbidden
+	case console.ErrNotFound.Has(err), payments.ErrCardNotFound.Has(err):
+		status = http.StatusNotFound
+	case console.ErrValidation.Has(err), payments.ErrDuplicateCard.Has(err):
+		status = http.StatusBadRequest
+	case payments.ErrMaxCreditCards.Has(err):
+		status = http.StatusForbidden
+	case console.ErrConflict.Has(err), payments.ErrDefaultCard.Has(err):
+		status = http.StatusConflict
+	}
+
+	o.serveJSONError(ctx, w, status, err)
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (o *Organizations) serveJSONError(ctx context.Context, w http.ResponseWriter, status int, err error) {
+	web.ServeJSONError(ctx, o.log, w, status, err)
+}
@@ -0,0 +1,226 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+// GetPermissions returns the permissions of the current user in the project.
+func (p *Projects) GetPermissions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	publicID, ok := p.uuidRouteParam(ctx, w, r, "id")
+	if !ok {
+		return
+	}
+
+	permissions, err := p.service.GetProjectPermissions(ctx, publicID)
+	if err != nil {
+		p.serveProjectRoleError(ctx, w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(permissions)
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+	}
+}
+
+// GetRoles returns the custom roles of the project.
+func (p *Projects) GetRoles(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	publicID, ok := p.uuidRouteParam(ctx, w, r, "id")
+	if !ok {
+		return
+	}
+
+	roles, err := p.service.GetProjectRoles(ctx, publicID)
+	if err != nil {
+		p.serveProjectRoleError(ctx, w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(roles)
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+	}
+}
+
+// CreateRole creates a custom role in the project.
+func (p *Projects) CreateRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	publicID, ok := p.uuidRouteParam(ctx, w, r, "id")
+	if !ok {
+		return
+	}
+
+	var info console.UpsertProjectRoleInfo
+	if err = json.NewDecoder(r.Body).Decode(&info); err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	role, err := p.service.CreateProjectRole(ctx, publicID, info)
+	if err != nil {
+		p.serveProjectRoleError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(w).Encode(role)
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+	}
+}
+
+// UpdateRole updates a custom role in the project.
+func (p *Projects) UpdateRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	publicID, ok := p.uuidRouteParam(ctx, w, r, "id")
+	if !ok {
+		return
+	}
+	roleID, ok := p.uuidRouteParam(ctx, w, r, "roleID")
+	if !ok {
+		return
+	}
+
+	var info console.UpsertProjectRoleInfo
+	if err = json.NewDecoder(r.Body).Decode(&info); err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	role, err := p.service.UpdateProjectRole(ctx, publicID, roleID, info)
+	if err != nil {
+		p.serveProjectRoleError(ctx, w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(role)
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+	}
+}
+
+// DeleteRole deletes a custom role from the project.
+func (p *Projects) DeleteRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	publicID, ok := p.uuidRouteParam(ctx, w, r, "id")
+	if !ok {
+		return
+	}
+	roleID, ok := p.uuidRouteParam(ctx, w, r, "roleID")
+	if !ok {
+		return
+	}
+
+	err = p.service.DeleteProjectRole(ctx, publicID, roleID)
+	if err != nil {
+		p.serveProjectRoleError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AssignRole assigns a custom role to a project member. A null role ID
+// makes the member fall back to their built-in role.
+func (p *Projects) AssignRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	publicID, ok := p.uuidRouteParam(ctx, w, r, "id")
+	if !ok {
+		return
+	}
+	memberID, ok := p.uuidRouteParam(ctx, w, r, "memberID")
+	if !ok {
+		return
+	}
+
+	var request struct {
+		RoleID *uuid.UUID `json:"roleID"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = p.service.AssignProjectRole(ctx, publicID, memberID, request.RoleID)
+	if err != nil {
+		p.serveProjectRoleError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// uuidRouteParam parses the UUID route param. It serves a bad request error when
+// the param is missing or invalid.
+func (p *Projects) uuidRouteParam(ctx context.Context, w http.ResponseWriter, r *http.Request, name string) (uuid.UUID, bool) {
+	param, ok := mux.Vars(r)[name]
+	if !ok {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, errs.New("missing %s route param", name))
+		return uuid.UUID{}, false
+	}
+
+	id, err := uuid.FromString(param)
+	if err != nil {
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+		return uuid.UUID{}, false
+	}
+
+	return id, true
+}
+
+// serveProjectRoleError serves the error returned by the project role service methods.
+func (p *Projects) serveProjectRoleError(ctx context.Context, w http.ResponseWriter, err error) {
+	switch {
+	case console.ErrUnauthorized.Has(err) || console.ErrNoMembership.Has(err):
+		p.serveJSONError(ctx, w, http.StatusUnauthorized, err)
+	case console.ErrForbidden.Has(err):
+		p.serveJSONError(ctx, w, http.StatusForbidden, err)
+	case console.ErrValidation.Has(err):
+		p.serveJSONError(ctx, w, http.StatusBadRequest, err)
+	case console.ErrNotFound.Has(err):
+		p.serveJSONError(ctx, w, http.StatusNotFound, err)
+	case console.ErrConflict.Has(err):
+		p.serveJSONError(ctx, w, http.StatusConflict, err)
+	default:
+		p.serveJSONError(ctx, w, http.StatusInternalServerError, err)
+	}
+}
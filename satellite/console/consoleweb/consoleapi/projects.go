@@ -237,6 +237,11 @@ func (p *Projects) UpdateUserSpecifiedLimits(w http.ResponseWriter, r *http.Requ
 			return
 		}
 
+		if console.ErrConflict.Has(err) {
+			p.serveJSONError(ctx, w, http.StatusConflict, err)
+			return
+		}
+
 		p.serveJSONError(ctx, w, http.StatusInternalServerError, err)
 	}
 }
@@ -706,6 +711,10 @@ func (p *Projects) GetEmissionImpact(w http.ResponseWriter, r *http.Request) {
 			p.serveJSONError(ctx, w, http.StatusUnauthorized, err)
 			return
 		}
+		if console.ErrForbidden.Has(err) {
+			p.serveJSONError(ctx, w, http.StatusForbidden, err)
+			return
+		}
 		p.serveJSONError(ctx, w, http.StatusInternalServerError, err)
 		return
 	}
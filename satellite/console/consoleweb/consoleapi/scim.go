@@ -0,0 +1,476 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth/sso"
+	"storj.io/storj/satellite/console/scim"
+)
+
+// maxScimRequestSize is the maximum size of SCIM request bodies.
+var maxScimRequestSize = (256 * memory.KB).Int64()
+
+// Scim is an api controller that exposes the SCIM 2.0 provisioning endpoint of SSO tenants.
+type Scim struct {
+	log        *zap.Logger
+	service    *console.Service
+	ssoService *sso.Service
+}
+
+// NewScim is a constructor for the SCIM controller.
+func NewScim(log *zap.Logger, service *console.Service, ssoService *sso.Service) *Scim {
+	return &Scim{
+		log:        log,
+		service:    service,
+		ssoService: ssoService,
+	}
+}
+
+// Authenticate is a middleware that authenticates the SCIM client of the provider by its bearer token.
+func (s *Scim) Authenticate(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		provider := mux.Vars(r)["provider"]
+
+		if !s.ssoService.ScimEnabled() || s.ssoService.IsGeneralProvider(provider) {
+			s.serveError(ctx, w, http.StatusNotFound, "", errs.New("not found"))
+			return
+		}
+
+		token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !found || !s.ssoService.AuthenticateScim(provider, strings.TrimSpace(token)) {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+			s.serveError(ctx, w, http.StatusUnauthorized, "", errs.New("unauthorized"))
+			return
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// GetServiceProviderConfig returns the SCIM features supported by the satellite.
+func (s *Scim) GetServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	s.serveJSON(ctx, w, http.StatusOK, scim.DefaultServiceProviderConfig())
+}
+
+// ListUsers returns the users of the SSO tenant.
+func (s *Scim) ListUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	filter, startIndex, count, err := s.listParams(r)
+	if err != nil {
+		s.serveServiceError(ctx, w, err)
+		return
+	}
+
+	users, total, err := s.service.ScimListUsers(ctx, mux.Vars(r)["provider"], filter, startIndex, count)
+	if err != nil {
+		s.serveServiceError(ctx, w, err)
+		return
+	}
+	for i := range users {
+		s.setUserLocation(r, &users[i])
+	}
+
+	s.serveJSON(ctx, w, http.StatusOK, scim.NewListResponse(users, total, startIndex))
+}
+
+// GetUser returns the user of the SSO tenant.
+func (s *Scim) GetUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, ok := s.idParam(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	user, err := s.service.ScimGetUser(ctx, mux.Vars(r)["provider"], id)
+	if err != nil {
+		s.serveServiceError(ctx, w, err)
+		return
+	}
+	s.setUserLocation(r, user)
+
+	s.serveJSON(ctx, w, http.StatusOK, user)
+}
+
+// CreateUser creates a user of the SSO tenant.
+func (s *Scim) CreateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var resource scim.User
+	if !s.decode(ctx, w, r, &resource) {
+		return
+	}
+
+	user, err := s.service.ScimCreateUser(ctx, mux.Vars(r)["provider"], resource)
+	if err != nil {
+		s.serveServiceError(ctx, w, err)
+		return
+	}
+	s.setUserLocation(r, user)
+
+	w.Header().Set("Location", user.Meta.Location)
+	s.serveJSON(ctx, w, http.StatusCreated, user)
+}
+
+// ReplaceUser replaces the user of the SSO tenant.
+func (s *Scim) ReplaceUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, ok := s.idParam(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	var resource scim.User
+	if !s.decode(ctx, w, r, &resource) {
+		return
+	}
+
+	s.replaceUser(ctx, w, r, id, resource)
+}
+
+// PatchUser partially updates the user of the SSO tenant.
+func (s *Scim) PatchUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, ok := s.idParam(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	var patch scim.PatchRequest
+	if !s.decode(ctx, w, r, &patch) {
+		return
+	}
+
+	user, err := s.service.ScimGetUser(ctx, mux.Vars(r)["provider"], id)
+	if err != nil {
+		s.serveServiceError(ctx, w, err)
+		return
+	}
+
+	if err = scim.ApplyUserPatch(user, patch.Operations); err != nil {
+		s.serveServiceError(ctx, w, err)
+		return
+	}
+
+	s.replaceUser(ctx, w, r, id, *user)
+}
+
+// DeleteUser deletes the user of the SSO tenant.
+func (s *Scim) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, ok := s.idParam(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	if err = s.service.ScimDeleteUser(ctx, mux.Vars(r)["provider"], id); err != nil {
+		s.serveServiceError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListGroups returns the groups of the SSO tenant.
+func (s *Scim) ListGroups(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	filter, startIndex, count, err := s.listParams(r)
+	if err != nil {
+		s.serveServiceError(ctx, w, err)
+		return
+	}
+
+	withMembers := !strings.Contains(strings.ToLower(r.URL.Query().Get("excludedAttributes")), "members")
+
+	groups, total, err := s.service.ScimListGroups(ctx, mux.Vars(r)["provider"], filter, startIndex, count, withMembers)
+	if err != nil {
+		s.serveServiceError(ctx, w, err)
+		return
+	}
+	for i := range groups {
+		s.setGroupLocation(r, &groups[i])
+	}
+
+	s.serveJSON(ctx, w, http.StatusOK, scim.NewListResponse(groups, total, startIndex))
+}
+
+// GetGroup returns the group of the SSO tenant.
+func (s *Scim) GetGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, ok := s.idParam(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	group, err := s.service.ScimGetGroup(ctx, mux.Vars(r)["provider"], id)
+	if err != nil {
+		s.serveServiceError(ctx, w, err)
+		return
+	}
+	s.setGroupLocation(r, group)
+
+	s.serveJSON(ctx, w, http.StatusOK, group)
+}
+
+// CreateGroup creates a group of the SSO tenant.
+func (s *Scim) CreateGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var resource scim.Group
+	if !s.decode(ctx, w, r, &resource) {
+		return
+	}
+
+	group, err := s.service.ScimCreateGroup(ctx, mux.Vars(r)["provider"], resource)
+	if err != nil {
+		s.serveServiceError(ctx, w, err)
+		return
+	}
+	s.setGroupLocation(r, group)
+
+	w.Header().Set("Location", group.Meta.Location)
+	s.serveJSON(ctx, w, http.StatusCreated, group)
+}
+
+// ReplaceGroup replaces the group of the SSO tenant.
+func (s *Scim) ReplaceGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, ok := s.idParam(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	var resource scim.Group
+	if !s.decode(ctx, w, r, &resource) {
+		return
+	}
+
+	s.replaceGroup(ctx, w, r, id, resource)
+}
+
+// PatchGroup partially updates the group of the SSO tenant.
+func (s *Scim) PatchGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, ok := s.idParam(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	var patch scim.PatchRequest
+	if !s.decode(ctx, w, r, &patch) {
+		return
+	}
+
+	group, err := s.service.ScimGetGroup(ctx, mux.Vars(r)["provider"], id)
+	if err != nil {
+		s.serveServiceError(ctx, w, err)
+		return
+	}
+
+	if err = scim.ApplyGroupPatch(group, patch.Operations); err != nil {
+		s.serveServiceError(ctx, w, err)
+		return
+	}
+
+	s.replaceGroup(ctx, w, r, id, *group)
+}
+
+// DeleteGroup deletes the group of the SSO tenant.
+func (s *Scim) DeleteGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, ok := s.idParam(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	if err = s.service.ScimDeleteGroup(ctx, mux.Vars(r)["provider"], id); err != nil {
+		s.serveServiceError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Scim) replaceUser(ctx context.Context, w http.ResponseWriter, r *http.Request, id uuid.UUID, resource scim.User) {
+	user, err := s.service.ScimReplaceUser(ctx, mux.Vars(r)["provider"], id, resource)
+	if err != nil {
+		s.serveServiceError(ctx, w, err)
+		return
+	}
+	s.setUserLocation(r, user)
+
+	s.serveJSON(ctx, w, http.StatusOK, user)
+}
+
+func (s *Scim) replaceGroup(ctx context.Context, w http.ResponseWriter, r *http.Request, id uuid.UUID, resource scim.Group) {
+	group, err := s.service.ScimReplaceGroup(ctx, mux.Vars(r)["provider"], id, resource)
+	if err != nil {
+		s.serveServiceError(ctx, w, err)
+		return
+	}
+	s.setGroupLocation(r, group)
+
+	s.serveJSON(ctx, w, http.StatusOK, group)
+}
+
+// listParams parses the filter and the 1-based pagination of list requests.
+func (s *Scim) listParams(r *http.Request) (filter *scim.Filter, startIndex, count int, err error) {
+	query := r.URL.Query()
+
+	filter, err = scim.ParseFilter(query.Get("filter"))
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	startIndex, count = 1, scim.MaxPageSize
+	if v := query.Get("startIndex"); v != "" {
+		if startIndex, err = strconv.Atoi(v); err != nil {
+			return nil, 0, 0, scim.ErrInvalidValue.New("invalid startIndex %q", v)
+		}
+		startIndex = max(startIndex, 1)
+	}
+	if v := query.Get("count"); v != "" {
+		if count, err = strconv.Atoi(v); err != nil {
+			return nil, 0, 0, scim.ErrInvalidValue.New("invalid count %q", v)
+		}
+		count = min(max(count, 0), scim.MaxPageSize)
+	}
+
+	return filter, startIndex, count, nil
+}
+
+func (s *Scim) idParam(ctx context.Context, w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		// ids that aren't UUIDs can't belong to any resource.
+		s.serveError(ctx, w, http.StatusNotFound, "", errs.New("resource %q doesn't exist", mux.Vars(r)["id"]))
+		return uuid.UUID{}, false
+	}
+	return id, true
+}
+
+func (s *Scim) decode(ctx context.Context, w http.ResponseWriter, r *http.Request, v any) bool {
+	r.Body = http.MaxBytesReader(w, r.Body, maxScimRequestSize)
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		s.serveError(ctx, w, http.StatusBadRequest, scim.ErrorTypeInvalidValue, errs.New("invalid request body: %v", err))
+		return false
+	}
+	return true
+}
+
+func (s *Scim) setUserLocation(r *http.Request, user *scim.User) {
+	if user.Meta != nil {
+		user.Meta.Location = s.location(r, "Users", user.ID)
+	}
+}
+
+func (s *Scim) setGroupLocation(r *http.Request, group *scim.Group) {
+	if group.Meta != nil {
+		group.Meta.Location = s.location(r, "Groups", group.ID)
+	}
+}
+
+// location returns the URL of the resource, relative to the SCIM base URL of the provider.
+func (s *Scim) location(r *http.Request, resourceType, id string) string {
+	base, _, _ := strings.Cut(r.URL.Path, "/scim/v2/")
+	return base + "/scim/v2/" + resourceType + "/" + id
+}
+
+func (s *Scim) serveJSON(ctx context.Context, w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", scim.ContentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		s.log.Error("failed to write SCIM response", zap.Error(err))
+	}
+}
+
+// serveServiceError maps the error to the SCIM error response.
+func (s *Scim) serveServiceError(ctx context.Context, w http.ResponseWriter, err error) {
+	var maxBytesErr *http.MaxBytesError
+	switch {
+	case console.ErrNotFound.Has(err):
+		s.serveError(ctx, w, http.StatusNotFound, "", err)
+	case console.ErrConflict.Has(err):
+		s.serveError(ctx, w, http.StatusConflict, scim.ErrorTypeUniqueness, err)
+	case console.ErrValidation.Has(err), scim.ErrInvalidValue.Has(err):
+		s.serveError(ctx, w, http.StatusBadRequest, scim.ErrorTypeInvalidValue, err)
+	case scim.ErrInvalidFilter.Has(err):
+		s.serveError(ctx, w, http.StatusBadRequest, scim.ErrorTypeInvalidFilter, err)
+	case scim.ErrInvalidPath.Has(err):
+		s.serveError(ctx, w, http.StatusBadRequest, scim.ErrorTypeInvalidPath, err)
+	case errors.As(err, &maxBytesErr):
+		s.serveError(ctx, w, http.StatusRequestEntityTooLarge, "", err)
+	default:
+		s.serveError(ctx, w, http.StatusInternalServerError, "", err)
+	}
+}
+
+func (s *Scim) serveError(ctx context.Context, w http.ResponseWriter, status int, scimType string, err error) {
+	detail := err.Error()
+	if status == http.StatusInternalServerError {
+		s.log.Error("SCIM request failed", zap.Error(err))
+		detail = "internal server error"
+	} else {
+		s.log.Debug("SCIM request rejected", zap.Int("status", status), zap.Error(err))
+	}
+
+	s.serveJSON(ctx, w, status, scim.ErrorResponse{
+		Schemas:  []string{scim.SchemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
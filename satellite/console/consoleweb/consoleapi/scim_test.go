@@ -0,0 +1,239 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth/sso"
+	"storj.io/storj/satellite/console/scim"
+)
+
+func TestScimProvisioning(t *testing.T) {
+	const token = "scim-secret"
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.SSO.Enabled = true
+				config.SSO.MockSso = true
+				config.Console.RateLimit.Burst = 100
+				config.SSO.OidcProviderInfos = sso.OidcProviderInfos{
+					Values: map[string]sso.OidcProviderInfo{
+						"okta": {},
+					},
+				}
+				config.SSO.EmailProviderMappings = sso.EmailProviderMappings{
+					Values: map[string]regexp.Regexp{
+						"okta": *regexp.MustCompile(`@acme.test$`),
+					},
+				}
+				config.SSO.Scim.Enabled = true
+				config.SSO.Scim.Tokens = sso.ScimTokens{Values: map[string]string{"okta": token}}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		db := sat.API.DB.Console()
+		baseURL := sat.ConsoleURL() + "/sso/okta/scim/v2"
+
+		do := func(method, path, bearer string, body any, expectedStatus int, result any) {
+			var reader io.Reader
+			if body != nil {
+				data, err := json.Marshal(body)
+				require.NoError(t, err)
+				reader = bytes.NewReader(data)
+			}
+
+			req, err := http.NewRequestWithContext(ctx, method, baseURL+path, reader)
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", scim.ContentType)
+			req.Header.Set("Authorization", "Bearer "+bearer)
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, resp.Body.Close()) }()
+
+			data, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, expectedStatus, resp.StatusCode, string(data))
+
+			if result != nil {
+				require.Equal(t, scim.ContentType, resp.Header.Get("Content-Type"))
+				require.NoError(t, json.Unmarshal(data, result))
+			}
+		}
+
+		do(http.MethodGet, "/ServiceProviderConfig", "wrong", nil, http.StatusUnauthorized, nil)
+
+		var config scim.ServiceProviderConfig
+		do(http.MethodGet, "/ServiceProviderConfig", token, nil, http.StatusOK, &config)
+		require.True(t, config.Patch.Supported)
+
+		t.Run("users", func(t *testing.T) {
+			var created scim.User
+			do(http.MethodPost, "/Users", token, scim.User{
+				Schemas:    []string{scim.SchemaUser},
+				ExternalID: "00u1",
+				UserName:   "alice@acme.test",
+				Name:       &scim.Name{GivenName: "Alice", FamilyName: "Smith"},
+				Emails:     []scim.Email{{Value: "alice@acme.test", Primary: true}},
+			}, http.StatusCreated, &created)
+			require.Equal(t, "00u1", created.ExternalID)
+			require.Equal(t, "Alice Smith", created.DisplayName)
+			require.True(t, created.IsActive())
+			require.Contains(t, created.Meta.Location, "/sso/okta/scim/v2/Users/"+created.ID)
+
+			id, err := uuid.FromString(created.ID)
+			require.NoError(t, err)
+			user, err := db.Users().Get(ctx, id)
+			require.NoError(t, err)
+			require.Equal(t, "okta:00u1", *user.ExternalID)
+			require.Equal(t, console.Active, user.Status)
+
+			// emails must belong to the tenant and be unique.
+			do(http.MethodPost, "/Users", token, scim.User{UserName: "bob@other.test"}, http.StatusBadRequest, nil)
+			do(http.MethodPost, "/Users", token, scim.User{UserName: "alice@acme.test", ExternalID: "00u2"}, http.StatusConflict, nil)
+
+			var list scim.ListResponse[scim.User]
+			do(http.MethodGet, `/Users?filter=userName+eq+%22alice%40acme.test%22`, token, nil, http.StatusOK, &list)
+			require.Equal(t, 1, list.TotalResults)
+			require.Equal(t, created.ID, list.Resources[0].ID)
+
+			do(http.MethodGet, `/Users?filter=externalId+eq+%22missing%22`, token, nil, http.StatusOK, &list)
+			require.Equal(t, 0, list.TotalResults)
+			require.Empty(t, list.Resources)
+
+			do(http.MethodGet, "/Users?startIndex=1&count=10", token, nil, http.StatusOK, &list)
+			require.Equal(t, 1, list.TotalResults)
+
+			do(http.MethodGet, `/Users?filter=title+co+%22x%22`, token, nil, http.StatusBadRequest, nil)
+
+			// deactivated users can't log in.
+			var patched scim.User
+			do(http.MethodPatch, "/Users/"+created.ID, token, map[string]any{
+				"schemas":    []string{scim.SchemaPatchOp},
+				"Operations": []map[string]any{{"op": "replace", "path": "active", "value": false}},
+			}, http.StatusOK, &patched)
+			require.False(t, patched.IsActive())
+
+			user, err = db.Users().Get(ctx, id)
+			require.NoError(t, err)
+			require.Equal(t, console.Deactivated, user.Status)
+
+			_, err = sat.API.Console.Service.GetUserForSsoAuth(ctx, sso.OidcSsoClaims{Sub: "00u1", Email: "alice@acme.test", Name: "Alice Smith"}, "okta", "", "")
+			require.True(t, console.ErrLoginRestricted.Has(err))
+
+			active := true
+			created.Active = &active
+			created.Name = &scim.Name{Formatted: "Alice Jones"}
+			var replaced scim.User
+			do(http.MethodPut, "/Users/"+created.ID, token, created, http.StatusOK, &replaced)
+			require.True(t, replaced.IsActive())
+			require.Equal(t, "Alice Jones", replaced.DisplayName)
+
+			do(http.MethodDelete, "/Users/"+created.ID, token, nil, http.StatusNoContent, nil)
+			do(http.MethodGet, "/Users/"+created.ID, token, nil, http.StatusNotFound, nil)
+
+			user, err = db.Users().Get(ctx, id)
+			require.NoError(t, err)
+			require.Equal(t, console.PendingDeletion, user.Status)
+			require.Nil(t, user.ExternalID)
+		})
+
+		t.Run("groups", func(t *testing.T) {
+			var owner, member scim.User
+			do(http.MethodPost, "/Users", token, scim.User{UserName: "owner@acme.test", ExternalID: "00u3"}, http.StatusCreated, &owner)
+			do(http.MethodPost, "/Users", token, scim.User{UserName: "member@acme.test", ExternalID: "00u4"}, http.StatusCreated, &member)
+
+			ownerID, err := uuid.FromString(owner.ID)
+			require.NoError(t, err)
+			memberID, err := uuid.FromString(member.ID)
+			require.NoError(t, err)
+
+			project, err := sat.AddProject(ctx, ownerID, "SCIM Project")
+			require.NoError(t, err)
+
+			outsider, err := sat.AddUser(ctx, console.CreateUser{FullName: "Outsider", Email: "outsider@other.test"}, 1)
+			require.NoError(t, err)
+			outsiderProject, err := sat.AddProject(ctx, outsider.ID, "Other Project")
+			require.NoError(t, err)
+
+			// projects of other tenants can't be mapped.
+			do(http.MethodPost, "/Groups", token, scim.Group{
+				DisplayName: "Outsiders",
+				Project:     &scim.Project{ProjectID: outsiderProject.PublicID.String()},
+			}, http.StatusBadRequest, nil)
+
+			var group scim.Group
+			do(http.MethodPost, "/Groups", token, scim.Group{
+				Schemas:     []string{scim.SchemaGroup, scim.SchemaProject},
+				DisplayName: "Engineering",
+				ExternalID:  "00g1",
+				Members:     []scim.Member{{Value: member.ID}},
+				Project:     &scim.Project{ProjectID: project.PublicID.String(), Role: "member"},
+			}, http.StatusCreated, &group)
+			require.Equal(t, []scim.Member{{Value: member.ID}}, group.Members)
+
+			membership, err := db.ProjectMembers().GetByMemberIDAndProjectID(ctx, memberID, project.ID)
+			require.NoError(t, err)
+			require.Equal(t, console.RoleMember, membership.Role)
+
+			do(http.MethodPost, "/Groups", token, scim.Group{DisplayName: "Engineering"}, http.StatusConflict, nil)
+
+			var list scim.ListResponse[scim.Group]
+			do(http.MethodGet, `/Groups?filter=displayName+eq+%22Engineering%22`, token, nil, http.StatusOK, &list)
+			require.Equal(t, 1, list.TotalResults)
+			require.Equal(t, group.ID, list.Resources[0].ID)
+
+			do(http.MethodPatch, "/Groups/"+group.ID, token, map[string]any{
+				"schemas": []string{scim.SchemaPatchOp},
+				"Operations": []map[string]any{
+					{"op": "replace", "path": scim.SchemaProject + ":role", "value": "admin"},
+				},
+			}, http.StatusOK, &group)
+			require.Equal(t, "admin", group.Project.Role)
+
+			membership, err = db.ProjectMembers().GetByMemberIDAndProjectID(ctx, memberID, project.ID)
+			require.NoError(t, err)
+			require.Equal(t, console.RoleAdmin, membership.Role)
+
+			// the project owner's membership is never changed.
+			do(http.MethodPatch, "/Groups/"+group.ID, token, map[string]any{
+				"schemas": []string{scim.SchemaPatchOp},
+				"Operations": []map[string]any{
+					{"op": "add", "path": "members", "value": []scim.Member{{Value: owner.ID}}},
+					{"op": "remove", "path": `members[value eq "` + member.ID + `"]`},
+				},
+			}, http.StatusOK, &group)
+			require.Equal(t, []scim.Member{{Value: owner.ID}}, group.Members)
+
+			_, err = db.ProjectMembers().GetByMemberIDAndProjectID(ctx, memberID, project.ID)
+			require.Error(t, err)
+
+			_, err = db.ProjectMembers().GetByMemberIDAndProjectID(ctx, ownerID, project.ID)
+			require.NoError(t, err)
+
+			do(http.MethodDelete, "/Groups/"+group.ID, token, nil, http.StatusNoContent, nil)
+			do(http.MethodGet, "/Groups/"+group.ID, token, nil, http.StatusNotFound, nil)
+
+			_, err = db.ProjectMembers().GetByMemberIDAndProjectID(ctx, ownerID, project.ID)
+			require.NoError(t, err)
+		})
+	})
+}
@@ -70,6 +70,9 @@ func (ul *UsageLimits) ProjectUsageLimits(w http.ResponseWriter, r *http.Request
 		case console.ErrUnauthorized.Has(err) || console.ErrNoMembership.Has(err):
 			ul.serveJSONError(ctx, w, http.StatusUnauthorized, err)
 			return
+		case console.ErrForbidden.Has(err):
+			ul.serveJSONError(ctx, w, http.StatusForbidden, err)
+			return
 		case accounting.ErrInvalidArgument.Has(err):
 			ul.serveJSONError(ctx, w, http.StatusBadRequest, err)
 			return
@@ -244,6 +247,10 @@ func (ul *UsageLimits) DailyUsage(w http.ResponseWriter, r *http.Request) {
 			ul.serveJSONError(ctx, w, http.StatusUnauthorized, err)
 			return
 		}
+		if console.ErrForbidden.Has(err) {
+			ul.serveJSONError(ctx, w, http.StatusForbidden, err)
+			return
+		}
 
 		ul.serveJSONError(ctx, w, http.StatusInternalServerError, err)
 		return
@@ -0,0 +1,218 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/private/web"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth/webauthn"
+)
+
+// BeginWebAuthnRegistration starts the registration of a security key or passkey for the user.
+func (a *Auth) BeginWebAuthnRegistration(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	options, token, err := a.service.BeginWebAuthnRegistration(ctx)
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(struct {
+		Options *webauthn.CreationOptions `json:"options"`
+		Token   string                    `json:"token"`
+	}{options, token})
+	if err != nil {
+		a.log.Error("could not encode webauthn registration options", zap.Error(ErrAuthAPI.Wrap(err)))
+	}
+}
+
+// FinishWebAuthnRegistration verifies and stores the security key or passkey of the user.
+func (a *Auth) FinishWebAuthnRegistration(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var data struct {
+		Token      string                       `json:"token"`
+		Name       string                       `json:"name"`
+		Credential webauthn.AttestationResponse `json:"credential"`
+	}
+	err = json.NewDecoder(r.Body).Decode(&data)
+	if err != nil {
+		a.serveJSONError(ctx, w, console.ErrValidation.Wrap(err))
+		return
+	}
+
+	credential, err := a.service.FinishWebAuthnRegistration(ctx, data.Token, data.Name, data.Credential)
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(credential)
+	if err != nil {
+		a.log.Error("could not encode webauthn credential", zap.Error(ErrAuthAPI.Wrap(err)))
+	}
+}
+
+// GetWebAuthnCredentials returns the security keys and passkeys of the user.
+func (a *Auth) GetWebAuthnCredentials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	credentials, err := a.service.GetWebAuthnCredentials(ctx)
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(credentials)
+	if err != nil {
+		a.log.Error("could not encode webauthn credentials", zap.Error(ErrAuthAPI.Wrap(err)))
+	}
+}
+
+// RenameWebAuthnCredential renames the security key or passkey of the user.
+func (a *Auth) RenameWebAuthnCredential(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := a.getWebAuthnCredentialID(r)
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+
+	var data struct {
+		Name string `json:"name"`
+	}
+	err = json.NewDecoder(r.Body).Decode(&data)
+	if err != nil {
+		a.serveJSONError(ctx, w, console.ErrValidation.Wrap(err))
+		return
+	}
+
+	err = a.service.RenameWebAuthnCredential(ctx, id, data.Name)
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+	}
+}
+
+// DeleteWebAuthnCredential removes the security key or passkey of the user.
+func (a *Auth) DeleteWebAuthnCredential(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := a.getWebAuthnCredentialID(r)
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+
+	err = a.service.DeleteWebAuthnCredential(ctx, id)
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+	}
+}
+
+// BeginWebAuthnLogin starts a passwordless login with a passkey.
+func (a *Auth) BeginWebAuthnLogin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	challenge, err := a.service.BeginWebAuthnLogin(ctx)
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(challenge)
+	if err != nil {
+		a.log.Error("could not encode webauthn login options", zap.Error(ErrAuthAPI.Wrap(err)))
+	}
+}
+
+// TokenByWebAuthn authenticates user by a passkey and returns auth token.
+func (a *Auth) TokenByWebAuthn(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var request console.WebAuthnLogin
+	err = json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		a.serveJSONError(ctx, w, console.ErrValidation.Wrap(err))
+		return
+	}
+
+	request.UserAgent = r.UserAgent()
+	request.IP, err = web.GetRequestIP(r)
+	if err != nil {
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+	request.AnonymousID = LoadAjsAnonymousID(r)
+
+	tokenInfo, err := a.service.TokenByWebAuthn(ctx, request)
+	if err != nil {
+		a.log.Info("Error authenticating webauthn token request", zap.Error(ErrAuthAPI.Wrap(err)))
+		a.serveJSONError(ctx, w, err)
+		return
+	}
+
+	a.cookieAuth.SetTokenCookie(w, *tokenInfo)
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(struct {
+		console.TokenInfo
+		Token string `json:"token"`
+	}{*tokenInfo, tokenInfo.Token.String()})
+	if err != nil {
+		a.log.Error("webauthn token handler could not encode token response", zap.Error(ErrAuthAPI.Wrap(err)))
+	}
+}
+
+// serveWebAuthnMFARequired responds to a login, which requires a second factor,
+// with the WebAuthn ceremony the user may complete it with.
+func (a *Auth) serveWebAuthnMFARequired(w http.ResponseWriter, challenge *console.WebAuthnChallenge, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(struct {
+		Error    string                     `json:"error"`
+		WebAuthn *console.WebAuthnChallenge `json:"webauthn"`
+	}{a.getUserErrorMessage(err), challenge})
+	if err != nil {
+		a.log.Error("could not encode webauthn mfa challenge", zap.Error(ErrAuthAPI.Wrap(err)))
+	}
+}
+
+func (a *Auth) getWebAuthnCredentialID(r *http.Request) (uuid.UUID, error) {
+	idStr, ok := mux.Vars(r)["id"]
+	if !ok {
+		return uuid.UUID{}, console.ErrValidation.New("id parameter is missing")
+	}
+
+	id, err := uuid.FromString(idStr)
+	if err != nil {
+		return uuid.UUID{}, console.ErrValidation.Wrap(err)
+	}
+	return id, nil
+}
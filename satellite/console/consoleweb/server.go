@@ -53,6 +53,7 @@ import (
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/paymentsconfig"
 	"storj.io/storj/satellite/tenancy"
+	"storj.io/storj/shared/flightrecorder"
 )
 
 const (
@@ -347,6 +348,12 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, cons
 	projectsRouter.Handle("/{id}/members", http.HandlerFunc(projectsController.GetMembersAndInvitations)).Methods(http.MethodGet, http.MethodOptions)
 	projectsRouter.Handle("/{id}/members/{memberID}", server.withCSRFProtection(http.HandlerFunc(projectsController.UpdateMemberRole))).Methods(http.MethodPatch, http.MethodOptions)
 	projectsRouter.Handle("/{id}/members/{memberID}", http.HandlerFunc(projectsController.GetMember)).Methods(http.MethodGet, http.MethodOptions)
+	projectsRouter.Handle("/{id}/members/{memberID}/role", server.withCSRFProtection(http.HandlerFunc(projectsController.AssignRole))).Methods(http.MethodPut, http.MethodOptions)
+	projectsRouter.Handle("/{id}/permissions", http.HandlerFunc(projectsController.GetPermissions)).Methods(http.MethodGet, http.MethodOptions)
+	projectsRouter.Handle("/{id}/roles", http.HandlerFunc(projectsController.GetRoles)).Methods(http.MethodGet, http.MethodOptions)
+	projectsRouter.Handle("/{id}/roles", server.withCSRFProtection(http.HandlerFunc(projectsController.CreateRole))).Methods(http.MethodPost, http.MethodOptions)
+	projectsRouter.Handle("/{id}/roles/{roleID}", server.withCSRFProtection(http.HandlerFunc(projectsController.UpdateRole))).Methods(http.MethodPatch, http.MethodOptions)
+	projectsRouter.Handle("/{id}/roles/{roleID}", server.withCSRFProtection(http.HandlerFunc(projectsController.DeleteRole))).Methods(http.MethodDelete, http.MethodOptions)
 	projectsRouter.Handle("/{id}/invite/{email}", server.withCSRFProtection(server.userIDRateLimiter.Limit(http.HandlerFunc(projectsController.InviteUser)))).Methods(http.MethodPost, http.MethodOptions)
 	projectsRouter.Handle("/{id}/reinvite", server.withCSRFProtection(server.userIDRateLimiter.Limit(http.HandlerFunc(projectsController.ReinviteUsers)))).Methods(http.MethodPost, http.MethodOptions)
 	projectsRouter.Handle("/{id}/invite-link", http.HandlerFunc(projectsController.GetInviteLink)).Methods(http.MethodGet, http.MethodOptions)
@@ -395,6 +402,13 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, cons
 	authRouter.Handle("/mfa/disable", server.withCSRFProtection(server.withAuth(server.userIDRateLimiter.Limit(http.HandlerFunc(authController.DisableUserMFA))))).Methods(http.MethodPost, http.MethodOptions)
 	authRouter.Handle("/mfa/generate-secret-key", server.withCSRFProtection(server.withAuth(http.HandlerFunc(authController.GenerateMFASecretKey)))).Methods(http.MethodPost, http.MethodOptions)
 	authRouter.Handle("/mfa/regenerate-recovery-codes", server.withCSRFProtection(server.withAuth(server.userIDRateLimiter.Limit(http.HandlerFunc(authController.RegenerateMFARecoveryCodes))))).Methods(http.MethodPost, http.MethodOptions)
+	authRouter.Handle("/webauthn/register/begin", server.withCSRFProtection(server.withAuth(server.userIDRateLimiter.Limit(http.HandlerFunc(authController.BeginWebAuthnRegistration))))).Methods(http.MethodPost, http.MethodOptions)
+	authRouter.Handle("/webauthn/register/finish", server.withCSRFProtection(server.withAuth(server.userIDRateLimiter.Limit(http.HandlerFunc(authController.FinishWebAuthnRegistration))))).Methods(http.MethodPost, http.MethodOptions)
+	authRouter.Handle("/webauthn/credentials", server.withAuth(http.HandlerFunc(authController.GetWebAuthnCredentials))).Methods(http.MethodGet, http.MethodOptions)
+	authRouter.Handle("/webauthn/credentials/{id}", server.withCSRFProtection(server.withAuth(http.HandlerFunc(authController.RenameWebAuthnCredential)))).Methods(http.MethodPatch, http.MethodOptions)
+	authRouter.Handle("/webauthn/credentials/{id}", server.withCSRFProtection(server.withAuth(server.userIDRateLimiter.Limit(http.HandlerFunc(authController.DeleteWebAuthnCredential))))).Methods(http.MethodDelete, http.MethodOptions)
+	authRouter.Handle("/webauthn/login/begin", server.ipRateLimiter.Limit(http.HandlerFunc(authController.BeginWebAuthnLogin))).Methods(http.MethodPost, http.MethodOptions)
+	authRouter.Handle("/webauthn/login/finish", server.withCSRFProtection(server.ipRateLimiter.Limit(http.HandlerFunc(authController.TokenByWebAuthn)))).Methods(http.MethodPost, http.MethodOptions)
 	authRouter.Handle("/logout", server.withAuth(http.HandlerFunc(authController.Logout))).Methods(http.MethodPost, http.MethodOptions)
 	authRouter.Handle("/token", server.withCSRFProtection(server.ipRateLimiter.Limit(http.HandlerFunc(authController.Token)))).Methods(http.MethodPost, http.MethodOptions)
 	authRouter.Handle("/token-by-api-key", server.ipRateLimiter.Limit(http.HandlerFunc(authController.TokenByAPIKey))).Methods(http.MethodPost, http.MethodOptions)
@@ -410,6 +424,29 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, cons
 	authRouter.Handle("/limit-increase", server.withAuth(http.HandlerFunc(authController.RequestLimitIncrease))).Methods(http.MethodPatch, http.MethodOptions)
 	authRouter.Handle("/change-email", server.withCSRFProtection(server.withAuth(http.HandlerFunc(authController.ChangeEmail)))).Methods(http.MethodPost, http.MethodOptions)
 
+	if config.OrganizationsEnabled {
+		organizationsController := consoleapi.NewOrganizations(logger, service)
+		organizationsRouter := router.PathPrefix("/api/v0/organizations").Subrouter()
+		organizationsRouter.Use(server.withCORS)
+		organizationsRouter.Use(server.withAuth)
+		organizationsRouter.Handle("", http.HandlerFunc(organizationsController.GetOrganizations)).Methods(http.MethodGet, http.MethodOptions)
+		organizationsRouter.Handle("", server.withCSRFProtection(http.HandlerFunc(organizationsController.CreateOrganization))).Methods(http.MethodPost, http.MethodOptions)
+		organizationsRouter.Handle("/{id}", http.HandlerFunc(organizationsController.GetOrganization)).Methods(http.MethodGet, http.MethodOptions)
+		organizationsRouter.Handle("/{id}", server.withCSRFProtection(http.HandlerFunc(organizationsController.UpdateOrganization))).Methods(http.MethodPatch, http.MethodOptions)
+		organizationsRouter.Handle("/{id}", server.withCSRFProtection(http.HandlerFunc(organizationsController.DeleteOrganization))).Methods(http.MethodDelete, http.MethodOptions)
+		organizationsRouter.Handle("/{id}/members", http.HandlerFunc(organizationsController.GetMembers)).Methods(http.MethodGet, http.MethodOptions)
+		organizationsRouter.Handle("/{id}/members", server.withCSRFProtection(server.userIDRateLimiter.Limit(http.HandlerFunc(organizationsController.AddMember)))).Methods(http.MethodPost, http.MethodOptions)
+		organizationsRouter.Handle("/{id}/members/{memberID}", server.withCSRFProtection(http.HandlerFunc(organizationsController.UpdateMemberRole))).Methods(http.MethodPatch, http.MethodOptions)
+		organizationsRouter.Handle("/{id}/members/{memberID}", server.withCSRFProtection(http.HandlerFunc(organizationsController.RemoveMember))).Methods(http.MethodDelete, http.MethodOptions)
+		organizationsRouter.Handle("/{id}/projects", http.HandlerFunc(organizationsController.GetProjects)).Methods(http.MethodGet, http.MethodOptions)
+		organizationsRouter.Handle("/{id}/projects/{projectID}", server.withCSRFProtection(http.HandlerFunc(organizationsController.TransferProject))).Methods(http.MethodPost, http.MethodOptions)
+		organizationsRouter.Handle("/{id}/usage-limits", http.HandlerFunc(organizationsController.GetUsageLimits)).Methods(http.MethodGet, http.MethodOptions)
+		organizationsRouter.Handle("/{id}/cards", http.HandlerFunc(organizationsController.GetCreditCards)).Methods(http.MethodGet, http.MethodOptions)
+		organizationsRouter.Handle("/{id}/cards", server.withCSRFProtection(http.HandlerFunc(organizationsController.AddCreditCard))).Methods(http.MethodPost, http.MethodOptions)
+		organizationsRouter.Handle("/{id}/cards/{cardID}", server.withCSRFProtection(http.HandlerFunc(organizationsController.RemoveCreditCard))).Methods(http.MethodDelete, http.MethodOptions)
+		organizationsRouter.Handle("/{id}/invoices", http.HandlerFunc(organizationsController.GetInvoices)).Methods(http.MethodGet, http.MethodOptions)
+	}
+
 	domainsController := consoleapi.NewDomains(logger, service, config.DomainsPageEnabled)
 	domainsRouter := router.PathPrefix("/api/v0/domains").Subrouter()
 	domainsRouter.Use(server.withCORS)
@@ -496,6 +533,8 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, cons
 	bucketsRouter.HandleFunc("/bucket-metadata", bucketsController.GetBucketMetadata).Methods(http.MethodGet, http.MethodOptions)
 	bucketsRouter.HandleFunc("/usage-totals", bucketsController.GetBucketTotals).Methods(http.MethodGet, http.MethodOptions)
 	bucketsRouter.HandleFunc("/bucket-totals", bucketsController.GetSingleBucketTotals).Methods(http.MethodGet, http.MethodOptions)
+	bucketsRouter.HandleFunc("/bucket-limits", bucketsController.GetBucketLimits).Methods(http.MethodGet, http.MethodOptions)
+	bucketsRouter.Handle("/bucket-limits", server.withCSRFProtection(http.HandlerFunc(bucketsController.UpdateBucketLimits))).Methods(http.MethodPatch, http.MethodOptions)
 
 	apiKeysController := consoleapi.NewAPIKeys(logger, service)
 	apiKeysRouter := router.PathPrefix("/api/v0/api-keys").Subrouter()
@@ -568,6 +607,25 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, cons
 				http.Redirect(w, r, "/sso/"+provider, http.StatusFound)
 			},
 		)))
+
+		if server.ssoService.ScimEnabled() {
+			scimController := consoleapi.NewScim(logger, service, server.ssoService)
+			scimRouter := ssoRouter.PathPrefix("/{provider}/scim/v2").Subrouter()
+			scimRouter.Use(server.ipRateLimiter.Limit, scimController.Authenticate)
+			scimRouter.HandleFunc("/ServiceProviderConfig", scimController.GetServiceProviderConfig).Methods(http.MethodGet)
+			scimRouter.HandleFunc("/Users", scimController.ListUsers).Methods(http.MethodGet)
+			scimRouter.HandleFunc("/Users", scimController.CreateUser).Methods(http.MethodPost)
+			scimRouter.HandleFunc("/Users/{id}", scimController.GetUser).Methods(http.MethodGet)
+			scimRouter.HandleFunc("/Users/{id}", scimController.ReplaceUser).Methods(http.MethodPut)
+			scimRouter.HandleFunc("/Users/{id}", scimController.PatchUser).Methods(http.MethodPatch)
+			scimRouter.HandleFunc("/Users/{id}", scimController.DeleteUser).Methods(http.MethodDelete)
+			scimRouter.HandleFunc("/Groups", scimController.ListGroups).Methods(http.MethodGet)
+			scimRouter.HandleFunc("/Groups", scimController.CreateGroup).Methods(http.MethodPost)
+			scimRouter.HandleFunc("/Groups/{id}", scimController.GetGroup).Methods(http.MethodGet)
+			scimRouter.HandleFunc("/Groups/{id}", scimController.ReplaceGroup).Methods(http.MethodPut)
+			scimRouter.HandleFunc("/Groups/{id}", scimController.PatchGroup).Methods(http.MethodPatch)
+			scimRouter.HandleFunc("/Groups/{id}", scimController.DeleteGroup).Methods(http.MethodDelete)
+		}
 	}
 
 	if server.config.GeneratedAPIEnabled {
@@ -753,6 +811,17 @@ func (server *Server) RunFrontend(ctx context.Context) (err error) {
 	return group.Wait()
 }
 
+// Drain stops accepting new connections and waits until the in-flight requests are finished.
+func (server *Server) Drain(ctx context.Context) error {
+	return server.server.Shutdown(ctx)
+}
+
+// SetFlightRecorder records the served HTTP requests to the flight recorder. It must be
+// called before Run.
+func (server *Server) SetFlightRecorder(recorder *flightrecorder.Box) {
+	server.server.Handler = recorder.HTTPMiddleware(server.server.Handler)
+}
+
 // Close closes server and underlying listener.
 func (server *Server) Close() error {
 	return server.server.Close()
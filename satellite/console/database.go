@@ -21,6 +21,14 @@ type DB interface {
 	Projects() Projects
 	// ProjectMembers is a getter for ProjectMembers repository.
 	ProjectMembers() ProjectMembers
+	// ProjectRoles is a getter for ProjectRoles repository.
+	ProjectRoles() ProjectRoles
+	// ScimGroups is a getter for ScimGroups repository.
+	ScimGroups() ScimGroups
+	// WebAuthnCredentials is a getter for WebAuthnCredentials repository.
+	WebAuthnCredentials() WebAuthnCredentials
+	// Organizations is a getter for Organizations repository.
+	Organizations() Organizations
 	// ProjectInvitations is a getter for ProjectInvitations repository.
 	ProjectInvitations() ProjectInvitations
 	// APIKeys is a getter for APIKeys repository.
@@ -62,6 +62,11 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 			chore.log.Error("Error deleting expired webapp sessions", zap.Error(err))
 		}
 
+		_, err = chore.db.WebAuthnCredentials().DeleteExpiredChallenges(ctx, time.Now())
+		if err != nil {
+			chore.log.Error("Error deleting expired webauthn challenges", zap.Error(err))
+		}
+
 		err = chore.db.APIKeys().DeleteExpiredByNamePrefix(ctx, chore.consoleConfig.ObjectBrowserKeyLifetime, chore.consoleConfig.ObjectBrowserKeyNamePrefix, chore.config.AsOfSystemTimeInterval, chore.config.PageSize)
 		if err != nil {
 			chore.log.Error("Error deleting expired API keys", zap.Error(err))
@@ -12,6 +12,7 @@ import (
 
 	"storj.io/common/macaroon"
 	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/dbcleanup"
@@ -122,5 +123,20 @@ func TestChore(t *testing.T) {
 			require.Error(t, err)
 			require.Nil(t, createdKey2)
 		})
+
+		t.Run("delete expired webauthn challenges", func(t *testing.T) {
+			chore.Loop.Pause()
+
+			credentials := db.Console().WebAuthnCredentials()
+			expired, valid := testrand.Bytes(32), testrand.Bytes(32)
+			require.NoError(t, credentials.UseChallenge(ctx, expired, time.Now().Add(-time.Minute)))
+			require.NoError(t, credentials.UseChallenge(ctx, valid, time.Now().Add(time.Hour)))
+
+			chore.Loop.TriggerWait()
+
+			// the expired challenge is removed, the valid one is kept.
+			require.NoError(t, credentials.UseChallenge(ctx, expired, time.Now().Add(time.Hour)))
+			require.True(t, console.ErrWebAuthnChallengeUsed.Has(credentials.UseChallenge(ctx, valid, time.Now().Add(time.Hour))))
+		})
 	})
 }
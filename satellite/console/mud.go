@@ -14,7 +14,9 @@ func Module(ball *mud.Ball) {
 	mud.View[DB, APIKeyTails](ball, DB.APIKeyTails)
 	mud.View[DB, Projects](ball, DB.Projects)
 	mud.View[DB, ProjectMembers](ball, DB.ProjectMembers)
+	mud.View[DB, ProjectRoles](ball, DB.ProjectRoles)
 	mud.View[DB, Users](ball, DB.Users)
+	mud.View[DB, Organizations](ball, DB.Organizations)
 
 	mud.View[DB, restapikeys.DB](ball, DB.RestApiKeys)
 	mud.Provide[*AccountFreezeService](ball, NewAccountFreezeService)
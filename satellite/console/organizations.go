@@ -0,0 +1,970 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/payments"
+)
+
+var (
+	// ErrNoOrganization is used to indicate that the organization doesn't exist.
+	ErrNoOrganization = errs.Class("organization doesn't exist")
+
+	// ErrNoOrganizationMember is used to indicate that the user isn't a member of the organization.
+	ErrNoOrganizationMember = errs.Class("organization member doesn't exist")
+
+	// ErrOrganizationMemberAlreadyExists is used to indicate that the user is already a member of the organization.
+	ErrOrganizationMemberAlreadyExists = errs.Class("organization member already exists")
+
+	// ErrOrganizationProjectAlreadyExists is used to indicate that the project already belongs to an organization.
+	ErrOrganizationProjectAlreadyExists = errs.Class("organization project already exists")
+)
+
+const organizationsDisabledErrMsg = "Organizations are not enabled"
+
+// Organizations exposes methods to manage organizations, which own projects
+// on behalf of their members and are billed as a whole.
+//
+// architecture: Database
+type Organizations interface {
+	// Insert inserts an organization into the database.
+	Insert(ctx context.Context, organization Organization) (*Organization, error)
+	// Get returns the organization by its ID.
+	Get(ctx context.Context, id uuid.UUID) (*Organization, error)
+	// GetByMemberID returns the organizations the user is a member of ordered by name, together with the user's role.
+	GetByMemberID(ctx context.Context, memberID uuid.UUID) ([]MemberOrganization, error)
+	// GetByProjectID returns the organization owning the project.
+	GetByProjectID(ctx context.Context, projectID uuid.UUID) (*Organization, error)
+	// Update updates the name and the limits of the organization.
+	Update(ctx context.Context, organization Organization) error
+	// Delete deletes the organization together with its memberships.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// InsertMember adds the user to the organization with the role.
+	InsertMember(ctx context.Context, organizationID, memberID uuid.UUID, role OrganizationRole) (*OrganizationMember, error)
+	// GetMember returns the membership of the user in the organization.
+	GetMember(ctx context.Context, organizationID, memberID uuid.UUID) (*OrganizationMember, error)
+	// GetMembers returns the members of the organization ordered by email.
+	GetMembers(ctx context.Context, organizationID uuid.UUID) ([]OrganizationMember, error)
+	// UpdateMemberRole updates the role of the member of the organization.
+	UpdateMemberRole(ctx context.Context, organizationID, memberID uuid.UUID, role OrganizationRole) error
+	// DeleteMember removes the user from the organization.
+	DeleteMember(ctx context.Context, organizationID, memberID uuid.UUID) error
+
+	// AddProject adds the project to the organization and makes the
+	// organization's owner the owner of the project. It fails with ErrProjLimit
+	// when the project doesn't fit into the project limit of its new owner.
+	AddProject(ctx context.Context, organizationID, projectID uuid.UUID) error
+	// GetProjectIDs returns the IDs of the projects of the organization.
+	GetProjectIDs(ctx context.Context, organizationID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// Organization owns projects on behalf of its members. The projects of an
+// organization are owned by the organization's owner. Once a payment method is
+// added to the organization, it has its own payment account keyed by its ID, so
+// all of its projects are paid with that payment method on one invoice. Until
+// then, the projects are billed to the owner.
+type Organization struct {
+	ID      uuid.UUID `json:"id"`
+	Name    string    `json:"name"`
+	OwnerID uuid.UUID `json:"ownerId"`
+
+	// The limits cap the totals of the organization's projects. Nil limits
+	// aren't enforced.
+	ProjectLimit   *int         `json:"projectLimit"`
+	StorageLimit   *memory.Size `json:"storageLimit"`
+	BandwidthLimit *memory.Size `json:"bandwidthLimit"`
+	SegmentLimit   *int64       `json:"segmentLimit"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// MemberOrganization is an organization together with the role of a member.
+type MemberOrganization struct {
+	Organization
+	Role OrganizationRole `json:"role"`
+}
+
+// OrganizationMember is the membership of a user in an organization.
+type OrganizationMember struct {
+	OrganizationID uuid.UUID        `json:"-"`
+	MemberID       uuid.UUID        `json:"id"`
+	Role           OrganizationRole `json:"role"`
+	CreatedAt      time.Time        `json:"createdAt"`
+
+	// Email and FullName are populated only by Organizations.GetMembers.
+	Email    string `json:"email"`
+	FullName string `json:"fullName"`
+}
+
+// OrganizationRole is the role of a member in the organization.
+type OrganizationRole int
+
+const (
+	// OrganizationRoleMember can see the organization and access the projects
+	// they were added to.
+	OrganizationRoleMember OrganizationRole = 0
+	// OrganizationRoleBilling can additionally view the usage, the limits and
+	// the invoices of the organization and manage its payment method.
+	OrganizationRoleBilling OrganizationRole = 1
+	// OrganizationRoleAdmin can additionally manage the members, transfer projects
+	// into the organization and administrate all of its projects.
+	OrganizationRoleAdmin OrganizationRole = 2
+	// OrganizationRoleOwner can additionally delete the organization.
+	OrganizationRoleOwner OrganizationRole = 3
+)
+
+var organizationRoleNames = map[OrganizationRole]string{
+	OrganizationRoleMember:  "member",
+	OrganizationRoleBilling: "billing",
+	OrganizationRoleAdmin:   "admin",
+	OrganizationRoleOwner:   "owner",
+}
+
+// ParseOrganizationRole parses the name of the organization role.
+func ParseOrganizationRole(name string) (OrganizationRole, error) {
+	for role, roleName := range organizationRoleNames {
+		if roleName == name {
+			return role, nil
+		}
+	}
+	return 0, ErrValidation.New("unknown organization role %q", name)
+}
+
+// String implements fmt.Stringer.
+func (role OrganizationRole) String() string {
+	return organizationRoleNames[role]
+}
+
+// MarshalJSON encodes the role as its name.
+func (role OrganizationRole) MarshalJSON() ([]byte, error) {
+	return json.Marshal(role.String())
+}
+
+// UnmarshalJSON decodes the role from its name.
+func (role *OrganizationRole) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	parsed, err := ParseOrganizationRole(name)
+	if err != nil {
+		return err
+	}
+	*role = parsed
+	return nil
+}
+
+// canManage returns whether the role may manage the members and the projects of the organization.
+func (role OrganizationRole) canManage() bool {
+	return role == OrganizationRoleAdmin || role == OrganizationRoleOwner
+}
+
+// canManageBilling returns whether the role may view the usage, the limits and the
+// invoices of the organization and manage its payment method.
+func (role OrganizationRole) canManageBilling() bool {
+	return role == OrganizationRoleBilling || role.canManage()
+}
+
+// UpsertOrganizationInfo holds the data needed to create or update an organization.
+type UpsertOrganizationInfo struct {
+	Name string `json:"name"`
+}
+
+// OrganizationUsageLimits holds the usage and the limits of an organization. The
+// allocated values are the totals of the limits of the organization's projects.
+type OrganizationUsageLimits struct {
+	ProjectCount int  `json:"projectCount"`
+	ProjectLimit *int `json:"projectLimit"`
+
+	StorageUsed      int64  `json:"storageUsed"`
+	StorageAllocated int64  `json:"storageAllocated"`
+	StorageLimit     *int64 `json:"storageLimit"`
+
+	BandwidthUsed      int64  `json:"bandwidthUsed"`
+	BandwidthAllocated int64  `json:"bandwidthAllocated"`
+	BandwidthLimit     *int64 `json:"bandwidthLimit"`
+
+	SegmentUsed      int64  `json:"segmentUsed"`
+	SegmentAllocated int64  `json:"segmentAllocated"`
+	SegmentLimit     *int64 `json:"segmentLimit"`
+}
+
+// CreateOrganization creates an organization owned by the user.
+func (s *Service) CreateOrganization(ctx context.Context, info UpsertOrganizationInfo) (_ *Organization, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "create organization", zap.String("name", info.Name))
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	if err = s.checkOrganizationsEnabled(); err != nil {
+		return nil, err
+	}
+
+	name, err := s.validateOrganizationName(info.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.HasPaidPrivileges() {
+		return nil, ErrNotPaidTier.New("Only Pro users may create organizations")
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var organization *Organization
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		organization, err = tx.Organizations().Insert(ctx, Organization{
+			ID:      id,
+			Name:    name,
+			OwnerID: user.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Organizations().InsertMember(ctx, organization.ID, user.ID, OrganizationRoleOwner)
+		return err
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return organization, nil
+}
+
+// GetOrganizations returns the organizations the user is a member of.
+func (s *Service) GetOrganizations(ctx context.Context) (_ []MemberOrganization, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get organizations")
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	if err = s.checkOrganizationsEnabled(); err != nil {
+		return nil, err
+	}
+
+	organizations, err := s.store.Organizations().GetByMemberID(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return organizations, nil
+}
+
+// GetOrganization returns the organization together with the user's role.
+func (s *Service) GetOrganization(ctx context.Context, id uuid.UUID) (_ *MemberOrganization, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get organization", zap.String("organization_id", id.String()))
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	organization, member, err := s.getOrganizationMembership(ctx, user.ID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MemberOrganization{Organization: *organization, Role: member.Role}, nil
+}
+
+// UpdateOrganization renames the organization.
+func (s *Service) UpdateOrganization(ctx context.Context, id uuid.UUID, info UpsertOrganizationInfo) (_ *Organization, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "update organization", zap.String("organization_id", id.String()), zap.String("name", info.Name))
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	organization, member, err := s.getOrganizationMembership(ctx, user.ID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !member.Role.canManage() {
+		return nil, ErrForbidden.New("only organization owners and admins may update the organization")
+	}
+
+	organization.Name, err = s.validateOrganizationName(info.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.store.Organizations().Update(ctx, *organization)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return organization, nil
+}
+
+// DeleteOrganization deletes the organization. Only organizations without projects can be deleted.
+func (s *Service) DeleteOrganization(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "delete organization", zap.String("organization_id", id.String()))
+	if err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+
+	organization, member, err := s.getOrganizationMembership(ctx, user.ID, id)
+	if err != nil {
+		return err
+	}
+	if member.Role != OrganizationRoleOwner {
+		return ErrForbidden.New("only the organization owner may delete the organization")
+	}
+
+	projectIDs, err := s.store.Organizations().GetProjectIDs(ctx, organization.ID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if len(projectIDs) > 0 {
+		return ErrConflict.New("the organization still owns %d projects", len(projectIDs))
+	}
+
+	return Error.Wrap(s.store.Organizations().Delete(ctx, organization.ID))
+}
+
+// GetOrganizationMembers returns the members of the organization.
+func (s *Service) GetOrganizationMembers(ctx context.Context, id uuid.UUID) (_ []OrganizationMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get organization members", zap.String("organization_id", id.String()))
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	organization, _, err := s.getOrganizationMembership(ctx, user.ID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.store.Organizations().GetMembers(ctx, organization.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return members, nil
+}
+
+// AddOrganizationMember adds the user with the email to the organization with the role.
+func (s *Service) AddOrganizationMember(ctx context.Context, id uuid.UUID, email string, role OrganizationRole) (_ *OrganizationMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "add organization member", zap.String("organization_id", id.String()), zap.String("email", email), zap.Stringer("role", role))
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	organization, member, err := s.getOrganizationMembership(ctx, user.ID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !member.Role.canManage() {
+		return nil, ErrForbidden.New("only organization owners and admins may add members")
+	}
+	if role == OrganizationRoleOwner {
+		return nil, ErrValidation.New("an organization can only have one owner")
+	}
+
+	newMember, err := s.store.Users().GetByEmailAndTenant(ctx, strings.TrimSpace(email), user.TenantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrValidation.New("there is no account with the email %s", email)
+		}
+		return nil, Error.Wrap(err)
+	}
+	if newMember.Status != Active {
+		return nil, ErrValidation.New("the account with the email %s is not active", email)
+	}
+
+	added, err := s.store.Organizations().InsertMember(ctx, organization.ID, newMember.ID, role)
+	if err != nil {
+		if ErrOrganizationMemberAlreadyExists.Has(err) {
+			return nil, ErrConflict.New("%s is already a member of the organization", email)
+		}
+		return nil, Error.Wrap(err)
+	}
+	added.Email = newMember.Email
+	added.FullName = newMember.FullName
+
+	return added, nil
+}
+
+// UpdateOrganizationMemberRole changes the role of the member of the organization.
+// The owner's role can't be changed.
+func (s *Service) UpdateOrganizationMemberRole(ctx context.Context, id, memberID uuid.UUID, role OrganizationRole) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "update organization member role", zap.String("organization_id", id.String()), zap.String("member_id", memberID.String()), zap.Stringer("role", role))
+	if err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+
+	organization, member, err := s.getOrganizationMembership(ctx, user.ID, id)
+	if err != nil {
+		return err
+	}
+	if !member.Role.canManage() {
+		return ErrForbidden.New("only organization owners and admins may change member roles")
+	}
+	if role == OrganizationRoleOwner {
+		return ErrValidation.New("an organization can only have one owner")
+	}
+	if memberID == organization.OwnerID {
+		return ErrConflict.New("the organization owner's role can't be changed")
+	}
+
+	err = s.store.Organizations().UpdateMemberRole(ctx, organization.ID, memberID, role)
+	if err != nil {
+		if ErrNoOrganizationMember.Has(err) {
+			return ErrNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// RemoveOrganizationMember removes the member from the organization. Owners and
+// admins may remove other members, while any member may leave the organization.
+// The owner can't be removed.
+func (s *Service) RemoveOrganizationMember(ctx context.Context, id, memberID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "remove organization member", zap.String("organization_id", id.String()), zap.String("member_id", memberID.String()))
+	if err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+
+	organization, member, err := s.getOrganizationMembership(ctx, user.ID, id)
+	if err != nil {
+		return err
+	}
+	if memberID != user.ID && !member.Role.canManage() {
+		return ErrForbidden.New("only organization owners and admins may remove members")
+	}
+	if memberID == organization.OwnerID {
+		return ErrConflict.New("the organization owner can't be removed")
+	}
+
+	err = s.store.Organizations().DeleteMember(ctx, organization.ID, memberID)
+	if err != nil {
+		if ErrNoOrganizationMember.Has(err) {
+			return ErrNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// GetOrganizationProjects returns the projects of the organization.
+func (s *Service) GetOrganizationProjects(ctx context.Context, id uuid.UUID) (_ []Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get organization projects", zap.String("organization_id", id.String()))
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	organization, _, err := s.getOrganizationMembership(ctx, user.ID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getOrganizationProjects(ctx, organization.ID)
+}
+
+// TransferProjectToOrganization moves a project owned by the user into the
+// organization. The organization's owner becomes the owner of the project,
+// its usage is billed to the organization and the user stays an admin of the project.
+func (s *Service) TransferProjectToOrganization(ctx context.Context, projectID, organizationID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "transfer project to organization", zap.String("project_id", projectID.String()), zap.String("organization_id", organizationID.String()))
+	if err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+
+	organization, member, err := s.getOrganizationMembership(ctx, user.ID, organizationID)
+	if err != nil {
+		return err
+	}
+	if !member.Role.canManage() {
+		return ErrForbidden.New("only organization owners and admins may transfer projects into the organization")
+	}
+
+	_, project, err := s.isProjectOwner(ctx, user.ID, projectID)
+	if err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+
+	_, err = s.store.Organizations().GetByProjectID(ctx, project.ID)
+	if err == nil {
+		return ErrConflict.New("the project already belongs to an organization")
+	} else if !ErrNoOrganization.Has(err) {
+		return Error.Wrap(err)
+	}
+
+	if project.OwnerID != organization.OwnerID {
+		owner, err := s.store.Users().Get(ctx, organization.OwnerID)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if !owner.HasPaidPrivileges() {
+			return ErrNotPaidTier.New("the organization owner must be a Pro user")
+		}
+
+		if _, err = s.checkProjectName(ctx, UpsertProjectInfo{Name: project.Name}, owner.ID); err != nil {
+			if ErrProjName.Has(err) {
+				return ErrConflict.New("the organization already has a project named %q", project.Name)
+			}
+			return err
+		}
+	}
+
+	if err = CheckOrganizationLimits(ctx, s.store, s.config.UsageLimits, organization, project.ID, nil); err != nil {
+		return err
+	}
+
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		return AddProjectToOrganization(ctx, tx, organization, project.ID)
+	})
+	if err != nil {
+		if ErrOrganizationProjectAlreadyExists.Has(err) {
+			return ErrConflict.New("the project already belongs to an organization")
+		}
+		if ErrProjLimit.Has(err) {
+			return ErrConflict.New("the organization owner reached their project limit")
+		}
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// GetOrganizationUsageLimits returns the current usage and the limits of the organization.
+func (s *Service) GetOrganizationUsageLimits(ctx context.Context, id uuid.UUID) (_ *OrganizationUsageLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get organization usage limits", zap.String("organization_id", id.String()))
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	organization, member, err := s.getOrganizationMembership(ctx, user.ID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !member.Role.canManageBilling() {
+		return nil, ErrForbidden.New("you do not have permission to view the organization usage")
+	}
+
+	projectIDs, err := s.store.Organizations().GetProjectIDs(ctx, organization.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	usageLimits := &OrganizationUsageLimits{
+		ProjectCount:   len(projectIDs),
+		ProjectLimit:   organization.ProjectLimit,
+		StorageLimit:   sizeToInt64(organization.StorageLimit),
+		BandwidthLimit: sizeToInt64(organization.BandwidthLimit),
+		SegmentLimit:   organization.SegmentLimit,
+	}
+
+	for _, projectID := range projectIDs {
+		projectUsageLimits, err := s.getProjectUsageLimits(ctx, projectID, false)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		usageLimits.StorageUsed += projectUsageLimits.StorageUsed
+		usageLimits.StorageAllocated += projectUsageLimits.StorageLimit
+		usageLimits.BandwidthUsed += projectUsageLimits.BandwidthUsed
+		usageLimits.BandwidthAllocated += projectUsageLimits.BandwidthLimit
+		usageLimits.SegmentUsed += projectUsageLimits.SegmentUsed
+		usageLimits.SegmentAllocated += projectUsageLimits.SegmentLimit
+	}
+
+	return usageLimits, nil
+}
+
+// AddOrganizationCreditCard adds the credit card to the payment account of the
+// organization, setting the account up if needed, and makes it the default one.
+func (s *Service) AddOrganizationCreditCard(ctx context.Context, id uuid.UUID, pmID string) (_ payments.CreditCard, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	organization, err := s.getOrganizationBillingMembership(ctx, "add organization credit card", id)
+	if err != nil {
+		return payments.CreditCard{}, err
+	}
+
+	owner, err := s.store.Users().Get(ctx, organization.OwnerID)
+	if err != nil {
+		return payments.CreditCard{}, Error.Wrap(err)
+	}
+
+	err = s.accounts.EnsureUserHasCustomer(ctx, organization.ID, owner.Email, "")
+	if err != nil {
+		return payments.CreditCard{}, Error.Wrap(err)
+	}
+
+	card, err := s.accounts.CreditCards().AddByPaymentMethodID(ctx, organization.ID, pmID, false)
+	if err != nil {
+		return payments.CreditCard{}, Error.Wrap(err)
+	}
+
+	return card, nil
+}
+
+// GetOrganizationCreditCards returns the credit cards of the organization.
+func (s *Service) GetOrganizationCreditCards(ctx context.Context, id uuid.UUID) (_ []payments.CreditCard, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	organization, err := s.getOrganizationBillingMembership(ctx, "get organization credit cards", id)
+	if err != nil {
+		return nil, err
+	}
+
+	cards, err := s.accounts.CreditCards().List(ctx, organization.ID)
+	if err != nil {
+		if payments.ErrAccountNotSetup.Has(err) {
+			return []payments.CreditCard{}, nil
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return cards, nil
+}
+
+// RemoveOrganizationCreditCard removes the credit card from the payment account of the organization.
+func (s *Service) RemoveOrganizationCreditCard(ctx context.Context, id uuid.UUID, cardID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	organization, err := s.getOrganizationBillingMembership(ctx, "remove organization credit card", id)
+	if err != nil {
+		return err
+	}
+
+	err = s.accounts.CreditCards().Remove(ctx, organization.ID, cardID, false)
+	if err != nil {
+		if payments.ErrAccountNotSetup.Has(err) {
+			return ErrNotFound.New("credit card not found")
+		}
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// GetOrganizationInvoices returns the invoices of the organization.
+func (s *Service) GetOrganizationInvoices(ctx context.Context, id uuid.UUID) (_ []payments.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	organization, err := s.getOrganizationBillingMembership(ctx, "get organization invoices", id)
+	if err != nil {
+		return nil, err
+	}
+
+	invoices, err := s.accounts.Invoices().List(ctx, organization.ID)
+	if err != nil {
+		if payments.ErrAccountNotSetup.Has(err) {
+			return []payments.Invoice{}, nil
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return invoices, nil
+}
+
+// AddProjectToOrganization adds the project to the organization within the transaction.
+// The organization's owner becomes the owner and an admin of the project, while
+// the memberships of the project stay as they are.
+func AddProjectToOrganization(ctx context.Context, tx DBTx, organization *Organization, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = tx.Organizations().AddProject(ctx, organization.ID, projectID)
+	if err != nil {
+		return err
+	}
+
+	membership, err := tx.ProjectMembers().GetByMemberIDAndProjectID(ctx, organization.OwnerID, projectID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		_, err = tx.ProjectMembers().Insert(ctx, organization.OwnerID, projectID, RoleAdmin)
+		return err
+	}
+
+	if membership.Role != RoleAdmin {
+		_, err = tx.ProjectMembers().UpdateRole(ctx, organization.OwnerID, projectID, RoleAdmin)
+	}
+	return err
+}
+
+// CheckOrganizationLimits checks whether the projects of the organization fit into its
+// limits once the updates are applied to the limits of the project. A project that
+// doesn't belong to the organization yet is checked as if it was added to it. The
+// limits of the organization cap the totals of the effective limits of its projects,
+// where unset project limits count as the free tier defaults.
+func CheckOrganizationLimits(ctx context.Context, db DB, defaults UsageLimitsConfig, organization *Organization, projectID uuid.UUID, updates []Limit) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projectIDs, err := db.Organizations().GetProjectIDs(ctx, organization.ID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if !slices.Contains(projectIDs, projectID) {
+		if organization.ProjectLimit != nil && len(projectIDs) >= *organization.ProjectLimit {
+			return ErrConflict.New("the organization reached its limit of %d projects", *organization.ProjectLimit)
+		}
+		projectIDs = append(projectIDs, projectID)
+	}
+
+	if organization.StorageLimit == nil && organization.BandwidthLimit == nil && organization.SegmentLimit == nil {
+		return nil
+	}
+
+	var storage, bandwidth, segments int64
+	for _, id := range projectIDs {
+		project, err := db.Projects().Get(ctx, id)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if id == projectID {
+			applyLimitUpdates(project, updates)
+		}
+
+		storage += effectiveLimit(project.UserSpecifiedStorageLimit, project.StorageLimit, defaults.Storage.Free)
+		bandwidth += effectiveLimit(project.UserSpecifiedBandwidthLimit, project.BandwidthLimit, defaults.Bandwidth.Free)
+		if project.SegmentLimit != nil {
+			segments += *project.SegmentLimit
+		} else {
+			segments += defaults.Segment.Free
+		}
+	}
+
+	switch {
+	case organization.StorageLimit != nil && storage > organization.StorageLimit.Int64():
+		return ErrConflict.New("the project's storage limit exceeds the organization's remaining storage limit")
+	case organization.BandwidthLimit != nil && bandwidth > organization.BandwidthLimit.Int64():
+		return ErrConflict.New("the project's bandwidth limit exceeds the organization's remaining bandwidth limit")
+	case organization.SegmentLimit != nil && segments > *organization.SegmentLimit:
+		return ErrConflict.New("the project's segment limit exceeds the organization's remaining segment limit")
+	}
+
+	return nil
+}
+
+// CheckProjectLimitUpdates checks whether the updates of the limits of the project keep
+// the organization owning it within its limits. Projects that don't belong to an
+// organization aren't checked.
+func CheckProjectLimitUpdates(ctx context.Context, db DB, defaults UsageLimitsConfig, projectID uuid.UUID, updates []Limit) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	organization, err := db.Organizations().GetByProjectID(ctx, projectID)
+	if err != nil {
+		if ErrNoOrganization.Has(err) {
+			return nil
+		}
+		return Error.Wrap(err)
+	}
+
+	return CheckOrganizationLimits(ctx, db, defaults, organization, projectID, updates)
+}
+
+// applyLimitUpdates applies the updates of the storage, bandwidth and segment limits to the project.
+func applyLimitUpdates(project *Project, updates []Limit) {
+	for _, update := range updates {
+		switch update.Kind {
+		case StorageLimit:
+			project.StorageLimit = int64ToSize(update.Value)
+		case BandwidthLimit:
+			project.BandwidthLimit = int64ToSize(update.Value)
+		case UserSetStorageLimit:
+			project.UserSpecifiedStorageLimit = int64ToSize(update.Value)
+		case UserSetBandwidthLimit:
+			project.UserSpecifiedBandwidthLimit = int64ToSize(update.Value)
+		case SegmentLimit:
+			project.SegmentLimit = update.Value
+		}
+	}
+}
+
+// effectiveLimit returns the limit that applies to the project. The user specified
+// limit takes precedence, and unset limits fall back to the default.
+func effectiveLimit(userSpecified, limit *memory.Size, defaultLimit memory.Size) int64 {
+	switch {
+	case userSpecified != nil:
+		return userSpecified.Int64()
+	case limit != nil:
+		return limit.Int64()
+	default:
+		return defaultLimit.Int64()
+	}
+}
+
+// getOrganizationMembership returns the organization and the user's membership in it.
+// Organizations the user isn't a member of are reported as not found.
+func (s *Service) getOrganizationMembership(ctx context.Context, userID, organizationID uuid.UUID) (_ *Organization, _ *OrganizationMember, err error) {
+	if err = s.checkOrganizationsEnabled(); err != nil {
+		return nil, nil, err
+	}
+
+	member, err := s.store.Organizations().GetMember(ctx, organizationID, userID)
+	if err != nil {
+		if ErrNoOrganizationMember.Has(err) {
+			return nil, nil, ErrNotFound.New("organization not found")
+		}
+		return nil, nil, Error.Wrap(err)
+	}
+
+	organization, err := s.store.Organizations().Get(ctx, organizationID)
+	if err != nil {
+		if ErrNoOrganization.Has(err) {
+			return nil, nil, ErrNotFound.New("organization not found")
+		}
+		return nil, nil, Error.Wrap(err)
+	}
+
+	return organization, member, nil
+}
+
+// getOrganizationBillingMembership returns the organization, whose billing the
+// user is allowed to manage.
+func (s *Service) getOrganizationBillingMembership(ctx context.Context, operation string, organizationID uuid.UUID) (_ *Organization, err error) {
+	user, err := s.getUserAndAuditLog(ctx, operation, zap.String("organization_id", organizationID.String()))
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	organization, member, err := s.getOrganizationMembership(ctx, user.ID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if !member.Role.canManageBilling() {
+		return nil, ErrForbidden.New("you do not have permission to manage the organization billing")
+	}
+
+	return organization, nil
+}
+
+// getOrganizationProjects returns the active projects of the organization.
+func (s *Service) getOrganizationProjects(ctx context.Context, organizationID uuid.UUID) (_ []Project, err error) {
+	projectIDs, err := s.store.Organizations().GetProjectIDs(ctx, organizationID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	projects := make([]Project, 0, len(projectIDs))
+	for _, id := range projectIDs {
+		project, err := s.store.Projects().Get(ctx, id)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if project.Status != nil && *project.Status != ProjectActive {
+			continue
+		}
+		projects = append(projects, *project)
+	}
+
+	return projects, nil
+}
+
+// organizationAdminMembership returns the membership, which the owners and the
+// admins of the organization owning the project implicitly have. It returns
+// nil when the user has no such membership.
+func (s *Service) organizationAdminMembership(ctx context.Context, userID, projectID uuid.UUID) (_ *ProjectMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !s.config.OrganizationsEnabled {
+		return nil, nil
+	}
+
+	organization, err := s.store.Organizations().GetByProjectID(ctx, projectID)
+	if err != nil {
+		if ErrNoOrganization.Has(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	member, err := s.store.Organizations().GetMember(ctx, organization.ID, userID)
+	if err != nil {
+		if ErrNoOrganizationMember.Has(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !member.Role.canManage() {
+		return nil, nil
+	}
+
+	return &ProjectMember{
+		MemberID:  userID,
+		ProjectID: projectID,
+		Role:      RoleAdmin,
+		CreatedAt: member.CreatedAt,
+	}, nil
+}
+
+func (s *Service) checkOrganizationsEnabled() error {
+	if !s.config.OrganizationsEnabled {
+		return ErrForbidden.New(organizationsDisabledErrMsg)
+	}
+	return nil
+}
+
+func (s *Service) validateOrganizationName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", ErrValidation.New("organization name can't be empty")
+	}
+	if len([]rune(name)) > s.config.MaxNameCharacters {
+		return "", ErrValidation.New("organization name must not exceed %d characters", s.config.MaxNameCharacters)
+	}
+	return name, nil
+}
+
+func sizeToInt64(size *memory.Size) *int64 {
+	if size == nil {
+		return nil
+	}
+	value := size.Int64()
+	return &value
+}
+
+func int64ToSize(value *int64) *memory.Size {
+	if value == nil {
+		return nil
+	}
+	size := memory.Size(*value)
+	return &size
+}
@@ -0,0 +1,251 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	stripeLib "github.com/stripe/stripe-go/v81"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/payments"
+)
+
+func TestOrganizations(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.OrganizationsEnabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+
+		paidKind := console.PaidUser
+		addUser := func(t *testing.T, email string, paid bool) (*console.User, context.Context) {
+			user, err := sat.AddUser(ctx, console.CreateUser{FullName: "Test User", Email: email}, 5)
+			require.NoError(t, err)
+			if paid {
+				require.NoError(t, sat.DB.Console().Users().Update(ctx, user.ID, console.UpdateUserRequest{Kind: &paidKind}))
+			}
+			userCtx, err := sat.UserContext(ctx, user.ID)
+			require.NoError(t, err)
+			return user, userCtx
+		}
+
+		owner, ownerCtx := addUser(t, "owner@mail.test", true)
+		admin, adminCtx := addUser(t, "admin@mail.test", false)
+		billing, billingCtx := addUser(t, "billing@mail.test", false)
+		_, outsiderCtx := addUser(t, "outsider@mail.test", false)
+
+		_, err := service.CreateOrganization(adminCtx, console.UpsertOrganizationInfo{Name: "Free Org"})
+		require.True(t, console.ErrNotPaidTier.Has(err))
+
+		_, err = service.CreateOrganization(ownerCtx, console.UpsertOrganizationInfo{Name: " "})
+		require.True(t, console.ErrValidation.Has(err))
+
+		organization, err := service.CreateOrganization(ownerCtx, console.UpsertOrganizationInfo{Name: "Example Org"})
+		require.NoError(t, err)
+		require.Equal(t, owner.ID, organization.OwnerID)
+
+		t.Run("members", func(t *testing.T) {
+			_, err := service.AddOrganizationMember(ownerCtx, organization.ID, admin.Email, console.OrganizationRoleOwner)
+			require.True(t, console.ErrValidation.Has(err))
+
+			_, err = service.AddOrganizationMember(ownerCtx, organization.ID, "nobody@mail.test", console.OrganizationRoleMember)
+			require.True(t, console.ErrValidation.Has(err))
+
+			_, err = service.AddOrganizationMember(ownerCtx, organization.ID, admin.Email, console.OrganizationRoleAdmin)
+			require.NoError(t, err)
+			_, err = service.AddOrganizationMember(adminCtx, organization.ID, billing.Email, console.OrganizationRoleBilling)
+			require.NoError(t, err)
+
+			_, err = service.AddOrganizationMember(adminCtx, organization.ID, billing.Email, console.OrganizationRoleMember)
+			require.True(t, console.ErrConflict.Has(err))
+
+			_, err = service.AddOrganizationMember(billingCtx, organization.ID, "outsider@mail.test", console.OrganizationRoleMember)
+			require.True(t, console.ErrForbidden.Has(err))
+
+			// organizations are hidden from non-members.
+			_, err = service.GetOrganization(outsiderCtx, organization.ID)
+			require.True(t, console.ErrNotFound.Has(err))
+
+			require.True(t, console.ErrConflict.Has(service.UpdateOrganizationMemberRole(adminCtx, organization.ID, owner.ID, console.OrganizationRoleMember)))
+			require.True(t, console.ErrConflict.Has(service.RemoveOrganizationMember(adminCtx, organization.ID, owner.ID)))
+
+			members, err := service.GetOrganizationMembers(billingCtx, organization.ID)
+			require.NoError(t, err)
+			require.Len(t, members, 3)
+
+			organizations, err := service.GetOrganizations(billingCtx)
+			require.NoError(t, err)
+			require.Len(t, organizations, 1)
+			require.Equal(t, console.OrganizationRoleBilling, organizations[0].Role)
+		})
+
+		t.Run("transfer projects", func(t *testing.T) {
+			adminProject, err := sat.AddProject(ctx, admin.ID, "admin project")
+			require.NoError(t, err)
+			ownerProject, err := sat.AddProject(ctx, owner.ID, "owner project")
+			require.NoError(t, err)
+			_, err = sat.AddProject(ctx, owner.ID, "duplicate")
+			require.NoError(t, err)
+			duplicateProject, err := sat.AddProject(ctx, admin.ID, "duplicate")
+			require.NoError(t, err)
+
+			// only the owner of the project may transfer it.
+			err = service.TransferProjectToOrganization(ownerCtx, adminProject.PublicID, organization.ID)
+			require.True(t, console.ErrUnauthorized.Has(err))
+
+			require.NoError(t, service.TransferProjectToOrganization(adminCtx, adminProject.PublicID, organization.ID))
+			require.NoError(t, service.TransferProjectToOrganization(ownerCtx, ownerProject.PublicID, organization.ID))
+
+			err = service.TransferProjectToOrganization(ownerCtx, ownerProject.PublicID, organization.ID)
+			require.True(t, console.ErrConflict.Has(err))
+
+			// the organization's owner already has a project with the same name.
+			err = service.TransferProjectToOrganization(adminCtx, duplicateProject.PublicID, organization.ID)
+			require.True(t, console.ErrConflict.Has(err))
+
+			// the organization's owner pays for the transferred project.
+			project, err := sat.DB.Console().Projects().Get(ctx, adminProject.ID)
+			require.NoError(t, err)
+			require.Equal(t, owner.ID, project.OwnerID)
+
+			_, err = sat.DB.Console().ProjectMembers().GetByMemberIDAndProjectID(ctx, admin.ID, adminProject.ID)
+			require.NoError(t, err)
+			membership, err := sat.DB.Console().ProjectMembers().GetByMemberIDAndProjectID(ctx, owner.ID, adminProject.ID)
+			require.NoError(t, err)
+			require.Equal(t, console.RoleAdmin, membership.Role)
+
+			// organization admins may access all projects of the organization.
+			_, err = service.GetProject(adminCtx, ownerProject.PublicID)
+			require.NoError(t, err)
+			_, err = service.GetProject(billingCtx, ownerProject.PublicID)
+			require.True(t, console.ErrUnauthorized.Has(err))
+
+			projects, err := service.GetOrganizationProjects(billingCtx, organization.ID)
+			require.NoError(t, err)
+			require.Len(t, projects, 2)
+		})
+
+		t.Run("limits", func(t *testing.T) {
+			project, err := sat.AddProject(ctx, owner.ID, "limited project")
+			require.NoError(t, err)
+
+			got, err := sat.DB.Console().Organizations().Get(ctx, organization.ID)
+			require.NoError(t, err)
+
+			limits, err := service.GetOrganizationUsageLimits(billingCtx, organization.ID)
+			require.NoError(t, err)
+			require.Equal(t, 2, limits.ProjectCount)
+			require.Positive(t, limits.StorageAllocated)
+
+			// the project's storage limit doesn't fit into the organization's storage limit.
+			storageLimit := sat.Config.Console.UsageLimits.Storage.Free * 2
+			got.StorageLimit = &storageLimit
+			require.NoError(t, sat.DB.Console().Organizations().Update(ctx, *got))
+			err = service.TransferProjectToOrganization(ownerCtx, project.PublicID, organization.ID)
+			require.True(t, console.ErrConflict.Has(err))
+
+			// changing the limits of a project of the organization must keep the organization within its limits.
+			projects, err := service.GetOrganizationProjects(ownerCtx, organization.ID)
+			require.NoError(t, err)
+			projectLimits, err := service.GetProjectUsageLimits(ownerCtx, projects[0].ID)
+			require.NoError(t, err)
+			projectStorageLimit := memory.Size(projectLimits.StorageLimit)
+			storageLimit = memory.Size(limits.StorageAllocated) - memory.GB
+			got.StorageLimit = &storageLimit
+			require.NoError(t, sat.DB.Console().Organizations().Update(ctx, *got))
+			err = service.UpdateUserSpecifiedLimits(ownerCtx, projects[0].ID, console.UpdateLimitsInfo{StorageLimit: &projectStorageLimit})
+			require.True(t, console.ErrConflict.Has(err))
+			projectStorageLimit -= memory.GB
+			require.NoError(t, service.UpdateUserSpecifiedLimits(ownerCtx, projects[0].ID, console.UpdateLimitsInfo{StorageLimit: &projectStorageLimit}))
+
+			projectLimit := 2
+			got.StorageLimit = nil
+			got.ProjectLimit = &projectLimit
+			require.NoError(t, sat.DB.Console().Organizations().Update(ctx, *got))
+			err = service.TransferProjectToOrganization(ownerCtx, project.PublicID, organization.ID)
+			require.True(t, console.ErrConflict.Has(err))
+
+			require.NoError(t, service.UpdateOrganizationMemberRole(adminCtx, organization.ID, billing.ID, console.OrganizationRoleMember))
+			_, err = service.GetOrganizationUsageLimits(billingCtx, organization.ID)
+			require.True(t, console.ErrForbidden.Has(err))
+		})
+
+		t.Run("billing", func(t *testing.T) {
+			cards, err := service.GetOrganizationCreditCards(ownerCtx, organization.ID)
+			require.NoError(t, err)
+			require.Empty(t, cards)
+
+			addCard := func(t *testing.T, userCtx context.Context, token string) (payments.CreditCard, error) {
+				pm, err := sat.API.Payments.StripeClient.PaymentMethods().New(&stripeLib.PaymentMethodParams{
+					Type: stripeLib.String(string(stripeLib.PaymentMethodTypeCard)),
+					Card: &stripeLib.PaymentMethodCardParams{
+						Token: stripeLib.String(token),
+					},
+				})
+				require.NoError(t, err)
+				return service.AddOrganizationCreditCard(userCtx, organization.ID, pm.ID)
+			}
+
+			_, err = addCard(t, billingCtx, "test0")
+			require.True(t, console.ErrForbidden.Has(err))
+
+			card, err := addCard(t, ownerCtx, "test1")
+			require.NoError(t, err)
+
+			// the organization has its own payment account.
+			_, err = sat.API.DB.StripeCoinPayments().Customers().GetCustomerID(ctx, organization.ID)
+			require.NoError(t, err)
+
+			cards, err = service.GetOrganizationCreditCards(adminCtx, organization.ID)
+			require.NoError(t, err)
+			require.Len(t, cards, 1)
+			require.Equal(t, card.ID, cards[0].ID)
+
+			ownerCards, err := service.Payments().ListCreditCards(ownerCtx)
+			require.NoError(t, err)
+			require.Empty(t, ownerCards)
+
+			_, err = service.GetOrganizationInvoices(ownerCtx, organization.ID)
+			require.NoError(t, err)
+			_, err = service.GetOrganizationInvoices(billingCtx, organization.ID)
+			require.True(t, console.ErrForbidden.Has(err))
+
+			// the payment method of the organization may only be replaced.
+			err = service.RemoveOrganizationCreditCard(ownerCtx, organization.ID, card.ID)
+			require.True(t, payments.ErrDefaultCard.Has(err))
+
+			newCard, err := addCard(t, ownerCtx, "test2")
+			require.NoError(t, err)
+			require.NoError(t, service.RemoveOrganizationCreditCard(ownerCtx, organization.ID, card.ID))
+
+			cards, err = service.GetOrganizationCreditCards(ownerCtx, organization.ID)
+			require.NoError(t, err)
+			require.Len(t, cards, 1)
+			require.Equal(t, newCard.ID, cards[0].ID)
+		})
+
+		t.Run("delete", func(t *testing.T) {
+			require.True(t, console.ErrForbidden.Has(service.DeleteOrganization(adminCtx, organization.ID)))
+			require.True(t, console.ErrConflict.Has(service.DeleteOrganization(ownerCtx, organization.ID)))
+
+			// members may leave the organization.
+			require.NoError(t, service.RemoveOrganizationMember(billingCtx, organization.ID, billing.ID))
+			_, err := service.GetOrganization(billingCtx, organization.ID)
+			require.True(t, console.ErrNotFound.Has(err))
+		})
+	})
+}
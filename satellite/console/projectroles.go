@@ -0,0 +1,480 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+)
+
+var (
+	// ErrNoProjectRole is used to indicate that the custom project role doesn't exist.
+	ErrNoProjectRole = errs.Class("project role doesn't exist")
+
+	// ErrProjectRoleAlreadyExists is used to indicate that a custom project role with the same name already exists.
+	ErrProjectRoleAlreadyExists = errs.Class("project role already exists")
+)
+
+const maxProjectRoleNameLength = 100
+
+// Permission is a set of actions, which a project member is allowed to take.
+type Permission uint64
+
+const (
+	// PermissionManageMembers allows inviting and removing project members, assigning
+	// custom roles and removing API keys and domains created by other members.
+	PermissionManageMembers Permission = 1 << iota
+	// PermissionManageAPIKeys allows creating API keys and removing own API keys.
+	PermissionManageAPIKeys
+	// PermissionViewUsage allows viewing the project and bucket usage and limits.
+	PermissionViewUsage
+	// PermissionManageBuckets allows updating bucket settings, like bucket limits, and
+	// deleting buckets created by other members.
+	PermissionManageBuckets
+	// PermissionManageBilling allows updating the project limits, the limit notifications
+	// and migrating the project pricing.
+	PermissionManageBilling
+	// PermissionManageDomains allows creating domains and removing own domains.
+	PermissionManageDomains
+
+	// PermissionAll contains all the permissions.
+	PermissionAll = PermissionManageMembers | PermissionManageAPIKeys | PermissionViewUsage |
+		PermissionManageBuckets | PermissionManageBilling | PermissionManageDomains
+)
+
+var permissionNames = []struct {
+	permission Permission
+	name       string
+}{
+	{PermissionManageMembers, "manageMembers"},
+	{PermissionManageAPIKeys, "manageAPIKeys"},
+	{PermissionViewUsage, "viewUsage"},
+	{PermissionManageBuckets, "manageBuckets"},
+	{PermissionManageBilling, "manageBilling"},
+	{PermissionManageDomains, "manageDomains"},
+}
+
+// ParsePermissions parses the permission names into a permission set.
+func ParsePermissions(names []string) (Permission, error) {
+	var permissions Permission
+	for _, name := range names {
+		found := false
+		for _, p := range permissionNames {
+			if p.name == name {
+				permissions |= p.permission
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, ErrValidation.New("unknown permission %q", name)
+		}
+	}
+	return permissions, nil
+}
+
+// Has returns whether the permission set contains all the permissions of other.
+func (p Permission) Has(other Permission) bool {
+	return p&other == other
+}
+
+// Names returns the names of the permissions in the set.
+func (p Permission) Names() []string {
+	names := []string{}
+	for _, permission := range permissionNames {
+		if p.Has(permission.permission) {
+			names = append(names, permission.name)
+		}
+	}
+	return names
+}
+
+// String implements fmt.Stringer.
+func (p Permission) String() string {
+	return strings.Join(p.Names(), ",")
+}
+
+// MarshalJSON encodes the permission set as a list of permission names.
+func (p Permission) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.Names())
+}
+
+// UnmarshalJSON decodes the permission set from a list of permission names.
+func (p *Permission) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return err
+	}
+	permissions, err := ParsePermissions(names)
+	if err != nil {
+		return err
+	}
+	*p = permissions
+	return nil
+}
+
+// Permissions returns the permissions of the built-in role.
+func (mr ProjectMemberRole) Permissions() Permission {
+	switch mr {
+	case RoleAdmin:
+		return PermissionAll
+	case RoleMember:
+		return PermissionManageAPIKeys | PermissionViewUsage | PermissionManageDomains
+	}
+
+	return 0
+}
+
+// ProjectRoles exposes methods to manage custom project roles and their
+// assignment to project members.
+//
+// Members without a custom role have the permissions of their built-in
+// ProjectMemberRole.
+//
+// architecture: Database
+type ProjectRoles interface {
+	// Insert inserts a custom role into the database.
+	Insert(ctx context.Context, role ProjectRole) (*ProjectRole, error)
+	// Get returns the custom role by its ID.
+	Get(ctx context.Context, id uuid.UUID) (*ProjectRole, error)
+	// GetByProjectID returns the custom roles of the project ordered by name, together with the members they are assigned to.
+	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]ProjectRole, error)
+	// Update updates the name and the permissions of the custom role.
+	Update(ctx context.Context, role ProjectRole) (*ProjectRole, error)
+	// Delete deletes the custom role. The members it was assigned to fall back to their built-in role.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// Assign assigns the custom role to the project member, replacing the previous assignment.
+	Assign(ctx context.Context, memberID, projectID, roleID uuid.UUID) error
+	// Unassign removes the custom role assignment of the project member.
+	Unassign(ctx context.Context, memberID, projectID uuid.UUID) error
+	// GetAssigned returns the custom role assigned to the project member.
+	GetAssigned(ctx context.Context, memberID, projectID uuid.UUID) (*ProjectRole, error)
+}
+
+// ProjectRole is a custom role, which grants a set of permissions to the project members it is assigned to.
+type ProjectRole struct {
+	ID          uuid.UUID  `json:"id"`
+	ProjectID   uuid.UUID  `json:"-"`
+	Name        string     `json:"name"`
+	Permissions Permission `json:"permissions"`
+	CreatedAt   time.Time  `json:"createdAt"`
+
+	// MemberIDs are the IDs of the members the role is assigned to.
+	// It is populated only by ProjectRoles.GetByProjectID.
+	MemberIDs []uuid.UUID `json:"memberIDs"`
+}
+
+// UpsertProjectRoleInfo holds the data needed to create or update a custom project role.
+type UpsertProjectRoleInfo struct {
+	Name        string     `json:"name"`
+	Permissions Permission `json:"permissions"`
+}
+
+// validate validates the custom project role data.
+func (info UpsertProjectRoleInfo) validate() error {
+	name := strings.TrimSpace(info.Name)
+	if name == "" {
+		return ErrValidation.New("role name can't be empty")
+	}
+	if len(name) > maxProjectRoleNameLength {
+		return ErrValidation.New("role name must be at most %d characters", maxProjectRoleNameLength)
+	}
+	if info.Permissions&^PermissionAll != 0 {
+		return ErrValidation.New("unknown permissions")
+	}
+	return nil
+}
+
+// memberPermissions returns the effective permissions of the project member.
+// The project owner has every permission, a member with a custom role has the
+// permissions of that role and any other member has the permissions of their
+// built-in role.
+func (s *Service) memberPermissions(ctx context.Context, isMember isProjectMember) (_ Permission, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if isMember.project.OwnerID == isMember.membership.MemberID {
+		return PermissionAll, nil
+	}
+
+	role, err := s.store.ProjectRoles().GetAssigned(ctx, isMember.membership.MemberID, isMember.project.ID)
+	if err != nil {
+		if ErrNoProjectRole.Has(err) {
+			return isMember.membership.Role.Permissions(), nil
+		}
+		return 0, err
+	}
+
+	return role.Permissions, nil
+}
+
+// hasPermission returns whether the project member has all the requested permissions.
+func (s *Service) hasPermission(ctx context.Context, isMember isProjectMember, permission Permission) (bool, error) {
+	permissions, err := s.memberPermissions(ctx, isMember)
+	if err != nil {
+		return false, err
+	}
+	return permissions.Has(permission), nil
+}
+
+// checkPermission returns ErrForbidden when the project member doesn't have the permission
+// required for the action.
+func (s *Service) checkPermission(ctx context.Context, isMember isProjectMember, permission Permission, action string) error {
+	allowed, err := s.hasPermission(ctx, isMember, permission)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !allowed {
+		return ErrForbidden.New("you do not have permission to %s", action)
+	}
+	return nil
+}
+
+// requireRoleManagement checks whether the user may manage the custom roles of the project.
+func (s *Service) requireRoleManagement(ctx context.Context, userID, projectID uuid.UUID) (_ isProjectMember, permissions Permission, err error) {
+	isMember, err := s.isProjectMember(ctx, userID, projectID)
+	if err != nil {
+		return isProjectMember{}, 0, ErrUnauthorized.Wrap(err)
+	}
+
+	permissions, err = s.memberPermissions(ctx, isMember)
+	if err != nil {
+		return isProjectMember{}, 0, Error.Wrap(err)
+	}
+	if !permissions.Has(PermissionManageMembers) {
+		return isProjectMember{}, 0, ErrForbidden.New("you do not have permission to manage project roles")
+	}
+
+	return isMember, permissions, nil
+}
+
+// getProjectRole returns the custom role, making sure it belongs to the project.
+func (s *Service) getProjectRole(ctx context.Context, projectID, roleID uuid.UUID) (*ProjectRole, error) {
+	role, err := s.store.ProjectRoles().Get(ctx, roleID)
+	if err != nil {
+		if ErrNoProjectRole.Has(err) {
+			return nil, ErrNotFound.Wrap(err)
+		}
+		return nil, Error.Wrap(err)
+	}
+	if role.ProjectID != projectID {
+		return nil, ErrNotFound.New("project role doesn't exist")
+	}
+	return role, nil
+}
+
+// GetProjectPermissions returns the permissions the user has in the project.
+func (s *Service) GetProjectPermissions(ctx context.Context, projectID uuid.UUID) (_ Permission, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get project permissions", zap.String("project_id", projectID.String()))
+	if err != nil {
+		return 0, ErrUnauthorized.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return 0, ErrUnauthorized.Wrap(err)
+	}
+
+	permissions, err := s.memberPermissions(ctx, isMember)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	return permissions, nil
+}
+
+// GetProjectRoles returns the custom roles of the project.
+func (s *Service) GetProjectRoles(ctx context.Context, projectID uuid.UUID) (_ []ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get project roles", zap.String("project_id", projectID.String()))
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	roles, err := s.store.ProjectRoles().GetByProjectID(ctx, isMember.project.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return roles, nil
+}
+
+// CreateProjectRole creates a custom role in the project.
+// The role can't grant permissions the user doesn't have.
+func (s *Service) CreateProjectRole(ctx context.Context, projectID uuid.UUID, info UpsertProjectRoleInfo) (_ *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "create project role", zap.String("project_id", projectID.String()), zap.String("name", info.Name), zap.Stringer("permissions", info.Permissions))
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	if err = info.validate(); err != nil {
+		return nil, err
+	}
+
+	isMember, permissions, err := s.requireRoleManagement(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if !permissions.Has(info.Permissions) {
+		return nil, ErrForbidden.New("a role can't grant permissions you don't have")
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	role, err := s.store.ProjectRoles().Insert(ctx, ProjectRole{
+		ID:          id,
+		ProjectID:   isMember.project.ID,
+		Name:        strings.TrimSpace(info.Name),
+		Permissions: info.Permissions,
+	})
+	if err != nil {
+		if ErrProjectRoleAlreadyExists.Has(err) {
+			return nil, ErrConflict.Wrap(err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return role, nil
+}
+
+// UpdateProjectRole updates the name and the permissions of a custom role in the project.
+// The role can't grant permissions the user doesn't have.
+func (s *Service) UpdateProjectRole(ctx context.Context, projectID, roleID uuid.UUID, info UpsertProjectRoleInfo) (_ *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "update project role", zap.String("project_id", projectID.String()), zap.String("role_id", roleID.String()), zap.String("name", info.Name), zap.Stringer("permissions", info.Permissions))
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	if err = info.validate(); err != nil {
+		return nil, err
+	}
+
+	isMember, permissions, err := s.requireRoleManagement(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := s.getProjectRole(ctx, isMember.project.ID, roleID)
+	if err != nil {
+		return nil, err
+	}
+	if !permissions.Has(info.Permissions) || !permissions.Has(role.Permissions) {
+		return nil, ErrForbidden.New("a role can't grant permissions you don't have")
+	}
+
+	role.Name = strings.TrimSpace(info.Name)
+	role.Permissions = info.Permissions
+
+	role, err = s.store.ProjectRoles().Update(ctx, *role)
+	if err != nil {
+		if ErrProjectRoleAlreadyExists.Has(err) {
+			return nil, ErrConflict.Wrap(err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return role, nil
+}
+
+// DeleteProjectRole deletes a custom role from the project.
+// The members it was assigned to fall back to their built-in role.
+func (s *Service) DeleteProjectRole(ctx context.Context, projectID, roleID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "delete project role", zap.String("project_id", projectID.String()), zap.String("role_id", roleID.String()))
+	if err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+
+	isMember, permissions, err := s.requireRoleManagement(ctx, user.ID, projectID)
+	if err != nil {
+		return err
+	}
+
+	role, err := s.getProjectRole(ctx, isMember.project.ID, roleID)
+	if err != nil {
+		return err
+	}
+	if !permissions.Has(role.Permissions) {
+		return ErrForbidden.New("you can't delete a role with permissions you don't have")
+	}
+
+	return Error.Wrap(s.store.ProjectRoles().Delete(ctx, role.ID))
+}
+
+// AssignProjectRole assigns a custom role to a project member. A nil roleID
+// removes the custom role, so the member falls back to their built-in role.
+// The project owner's role can't be changed.
+func (s *Service) AssignProjectRole(ctx context.Context, projectID, memberID uuid.UUID, roleID *uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fields := []zap.Field{zap.String("project_id", projectID.String()), zap.String("member_id", memberID.String())}
+	if roleID != nil {
+		fields = append(fields, zap.String("role_id", roleID.String()))
+	}
+	user, err := s.getUserAndAuditLog(ctx, "assign project role", fields...)
+	if err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+
+	isMember, permissions, err := s.requireRoleManagement(ctx, user.ID, projectID)
+	if err != nil {
+		return err
+	}
+	project := isMember.project
+
+	if project.OwnerID == memberID {
+		return ErrConflict.New("project owner's role can't be changed")
+	}
+
+	target, err := s.isProjectMember(ctx, memberID, project.ID)
+	if err != nil {
+		return ErrNoMembership.Wrap(err)
+	}
+
+	// The current permissions of the member must not exceed the user's
+	// permissions either, otherwise the user could demote an administrator.
+	targetPermissions, err := s.memberPermissions(ctx, target)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !permissions.Has(targetPermissions) {
+		return ErrForbidden.New("you can't change the role of a member with permissions you don't have")
+	}
+
+	if roleID == nil {
+		return Error.Wrap(s.store.ProjectRoles().Unassign(ctx, memberID, project.ID))
+	}
+
+	role, err := s.getProjectRole(ctx, project.ID, *roleID)
+	if err != nil {
+		return err
+	}
+	if !permissions.Has(role.Permissions) {
+		return ErrForbidden.New("a role can't grant permissions you don't have")
+	}
+
+	return Error.Wrap(s.store.ProjectRoles().Assign(ctx, memberID, project.ID, role.ID))
+}
@@ -0,0 +1,196 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/console"
+)
+
+func TestPermissions(t *testing.T) {
+	permissions, err := console.ParsePermissions([]string{"viewUsage", "manageAPIKeys"})
+	require.NoError(t, err)
+	require.Equal(t, console.PermissionViewUsage|console.PermissionManageAPIKeys, permissions)
+	require.True(t, permissions.Has(console.PermissionViewUsage))
+	require.False(t, permissions.Has(console.PermissionViewUsage|console.PermissionManageBilling))
+	require.Equal(t, "manageAPIKeys,viewUsage", permissions.String())
+
+	_, err = console.ParsePermissions([]string{"viewUsage", "unknown"})
+	require.True(t, console.ErrValidation.Has(err))
+
+	data, err := json.Marshal(console.PermissionAll)
+	require.NoError(t, err)
+	require.JSONEq(t, `["manageMembers","manageAPIKeys","viewUsage","manageBuckets","manageBilling","manageDomains"]`, string(data))
+
+	var decoded console.Permission
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, console.PermissionAll, decoded)
+
+	data, err = json.Marshal(console.Permission(0))
+	require.NoError(t, err)
+	require.Equal(t, `[]`, string(data))
+
+	require.Error(t, json.Unmarshal([]byte(`["manageEverything"]`), &decoded))
+
+	require.Equal(t, console.PermissionAll, console.RoleAdmin.Permissions())
+	require.Equal(t, console.PermissionManageAPIKeys|console.PermissionViewUsage|console.PermissionManageDomains, console.RoleMember.Permissions())
+}
+
+func TestProjectRoles(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+
+		owner, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Owner",
+			Email:    "roles_owner@example.com",
+		}, 1)
+		require.NoError(t, err)
+		member, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Member",
+			Email:    "roles_member@example.com",
+		}, 1)
+		require.NoError(t, err)
+		manager, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Manager",
+			Email:    "roles_manager@example.com",
+		}, 1)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, owner.ID, "Roles Project")
+		require.NoError(t, err)
+
+		ownerCtx, err := sat.UserContext(ctx, owner.ID)
+		require.NoError(t, err)
+		memberCtx, err := sat.UserContext(ctx, member.ID)
+		require.NoError(t, err)
+		managerCtx, err := sat.UserContext(ctx, manager.ID)
+		require.NoError(t, err)
+
+		_, err = service.AddProjectMembers(ownerCtx, project.ID, []string{member.Email, manager.Email})
+		require.NoError(t, err)
+
+		permissions, err := service.GetProjectPermissions(ownerCtx, project.PublicID)
+		require.NoError(t, err)
+		require.Equal(t, console.PermissionAll, permissions)
+
+		// Members without a custom role have the permissions of their built-in role.
+		permissions, err = service.GetProjectPermissions(memberCtx, project.PublicID)
+		require.NoError(t, err)
+		require.Equal(t, console.RoleMember.Permissions(), permissions)
+
+		_, err = service.CreateProjectRole(memberCtx, project.PublicID, console.UpsertProjectRoleInfo{
+			Name:        "Viewer",
+			Permissions: console.PermissionViewUsage,
+		})
+		require.True(t, console.ErrForbidden.Has(err))
+
+		_, err = service.CreateProjectRole(ownerCtx, project.PublicID, console.UpsertProjectRoleInfo{
+			Name:        " ",
+			Permissions: console.PermissionViewUsage,
+		})
+		require.True(t, console.ErrValidation.Has(err))
+
+		viewer, err := service.CreateProjectRole(ownerCtx, project.PublicID, console.UpsertProjectRoleInfo{
+			Name:        "Viewer",
+			Permissions: console.PermissionViewUsage,
+		})
+		require.NoError(t, err)
+
+		_, err = service.CreateProjectRole(ownerCtx, project.PublicID, console.UpsertProjectRoleInfo{
+			Name:        "Viewer",
+			Permissions: console.PermissionViewUsage,
+		})
+		require.True(t, console.ErrConflict.Has(err))
+
+		teamManager, err := service.CreateProjectRole(ownerCtx, project.PublicID, console.UpsertProjectRoleInfo{
+			Name:        "Team manager",
+			Permissions: console.PermissionManageMembers | console.PermissionViewUsage,
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, service.AssignProjectRole(ownerCtx, project.PublicID, member.ID, &viewer.ID))
+		require.NoError(t, service.AssignProjectRole(ownerCtx, project.PublicID, manager.ID, &teamManager.ID))
+
+		roles, err := service.GetProjectRoles(memberCtx, project.PublicID)
+		require.NoError(t, err)
+		require.Len(t, roles, 2)
+		require.Equal(t, "Team manager", roles[0].Name)
+		require.Equal(t, []uuid.UUID{manager.ID}, roles[0].MemberIDs)
+		require.Equal(t, "Viewer", roles[1].Name)
+		require.Equal(t, []uuid.UUID{member.ID}, roles[1].MemberIDs)
+
+		// The viewer can view the usage, but can't create API keys anymore.
+		_, err = service.GetProjectUsageLimits(memberCtx, project.PublicID)
+		require.NoError(t, err)
+		_, _, err = service.CreateAPIKey(memberCtx, project.ID, "viewer key", macaroon.APIKeyVersionMin)
+		require.True(t, console.ErrForbidden.Has(err))
+
+		// The team manager can manage the roles, but can't grant permissions they don't have.
+		_, err = service.CreateProjectRole(managerCtx, project.PublicID, console.UpsertProjectRoleInfo{
+			Name:        "Billing",
+			Permissions: console.PermissionManageBilling,
+		})
+		require.True(t, console.ErrForbidden.Has(err))
+
+		_, err = service.UpdateProjectRole(managerCtx, project.PublicID, viewer.ID, console.UpsertProjectRoleInfo{
+			Name:        "Viewer",
+			Permissions: console.PermissionViewUsage | console.PermissionManageAPIKeys,
+		})
+		require.True(t, console.ErrForbidden.Has(err))
+
+		viewer, err = service.UpdateProjectRole(managerCtx, project.PublicID, viewer.ID, console.UpsertProjectRoleInfo{
+			Name:        "Usage viewer",
+			Permissions: console.PermissionViewUsage,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "Usage viewer", viewer.Name)
+
+		require.True(t, console.ErrConflict.Has(service.AssignProjectRole(managerCtx, project.PublicID, owner.ID, &viewer.ID)))
+
+		// The team manager can't change their own role to gain permissions.
+		admins, err := service.CreateProjectRole(ownerCtx, project.PublicID, console.UpsertProjectRoleInfo{
+			Name:        "Admins",
+			Permissions: console.PermissionAll,
+		})
+		require.NoError(t, err)
+		require.True(t, console.ErrForbidden.Has(service.AssignProjectRole(managerCtx, project.PublicID, manager.ID, &admins.ID)))
+
+		// Roles of other projects can't be assigned.
+		otherProject, err := sat.AddProject(ctx, owner.ID, "Other Project")
+		require.NoError(t, err)
+		otherRole, err := service.CreateProjectRole(ownerCtx, otherProject.PublicID, console.UpsertProjectRoleInfo{
+			Name:        "Viewer",
+			Permissions: console.PermissionViewUsage,
+		})
+		require.NoError(t, err)
+		require.True(t, console.ErrNotFound.Has(service.AssignProjectRole(ownerCtx, project.PublicID, member.ID, &otherRole.ID)))
+
+		// Members fall back to their built-in role, when the custom role is removed.
+		require.NoError(t, service.AssignProjectRole(managerCtx, project.PublicID, member.ID, nil))
+		_, _, err = service.CreateAPIKey(memberCtx, project.ID, "member key", macaroon.APIKeyVersionMin)
+		require.NoError(t, err)
+
+		require.NoError(t, service.AssignProjectRole(ownerCtx, project.PublicID, member.ID, &viewer.ID))
+		require.NoError(t, service.DeleteProjectRole(ownerCtx, project.PublicID, viewer.ID))
+		permissions, err = service.GetProjectPermissions(memberCtx, project.PublicID)
+		require.NoError(t, err)
+		require.Equal(t, console.RoleMember.Permissions(), permissions)
+
+		// Changing the built-in role removes the custom role.
+		_, err = service.UpdateProjectMemberRole(ownerCtx, manager.ID, project.ID, console.RoleAdmin)
+		require.NoError(t, err)
+		permissions, err = service.GetProjectPermissions(managerCtx, project.PublicID)
+		require.NoError(t, err)
+		require.Equal(t, console.PermissionAll, permissions)
+	})
+}
@@ -39,6 +39,12 @@ type Projects interface {
 	// GetEncryptedPassphrase gets the encrypted passphrase of this project.
 	// NB: projects that don't have satellite managed encryption will not have this.
 	GetEncryptedPassphrase(ctx context.Context, id uuid.UUID) ([]byte, *int, error)
+	// ListEncryptedPassphrases returns the passphrases encrypted with the key, ordered by project ID and starting after the cursor.
+	ListEncryptedPassphrases(ctx context.Context, keyID int, cursor uuid.UUID, limit int) ([]EncryptedPassphrase, error)
+	// CountEncryptedPassphrases returns the number of passphrases encrypted with the key.
+	CountEncryptedPassphrases(ctx context.Context, keyID int) (int64, error)
+	// UpdateEncryptedPassphrase replaces the encrypted passphrase of the project, if it is still encrypted with the old key.
+	UpdateEncryptedPassphrase(ctx context.Context, id uuid.UUID, oldKeyID int, passphraseEnc []byte, keyID int) (bool, error)
 	// GetByPublicID is a method for querying project from the database by public_id.
 	GetByPublicID(ctx context.Context, publicID uuid.UUID) (*Project, error)
 	// GetByPublicOrPrivateID is a method for querying project from the database by either publicID or id.
@@ -101,6 +107,13 @@ type Projects interface {
 	TestSetNowFn(func() time.Time)
 }
 
+// EncryptedPassphrase is the satellite managed passphrase of a project.
+type EncryptedPassphrase struct {
+	ProjectID     uuid.UUID
+	PassphraseEnc []byte
+	KeyID         int
+}
+
 // UsageLimitsConfig is a configuration struct for default per-project usage limits.
 type UsageLimitsConfig struct {
 	Storage   StorageLimitConfig
@@ -336,6 +349,7 @@ type ProjectConfig struct {
 	IsOwnerPaidTier      bool              `json:"isOwnerPaidTier"`
 	HasPaidPrivileges    bool              `json:"hasPaidPrivileges"`
 	Role                 ProjectMemberRole `json:"role"`
+	Permissions          Permission        `json:"permissions"`
 	Salt                 string            `json:"salt"`
 	MembersCount         uint64            `json:"membersCount"`
 	AvailablePlacements  []PlacementDetail `json:"availablePlacements"`
@@ -0,0 +1,78 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scim
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// Filter is an equality filter on a resource attribute, e.g. `userName eq "alice@example.com"`.
+//
+// Identity providers only use equality filters to look up the resources
+// they provision, so other operators and logical expressions aren't supported.
+type Filter struct {
+	// Attribute is the lowercase attribute name, stripped of the schema URN.
+	Attribute string
+	Value     string
+}
+
+var filterExpr = regexp.MustCompile(`^\s*([A-Za-z][A-Za-z0-9_.:\-]*)\s+(?i:eq)\s+("(?:[^"\\]|\\.)*")\s*$`)
+
+// ParseFilter parses an equality filter. An empty string returns a nil filter.
+func ParseFilter(s string) (*Filter, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	match := filterExpr.FindStringSubmatch(s)
+	if match == nil {
+		return nil, ErrInvalidFilter.New("only filters in the form 'attribute eq \"value\"' are supported: %s", s)
+	}
+
+	var value string
+	if err := json.Unmarshal([]byte(match[2]), &value); err != nil {
+		return nil, ErrInvalidFilter.Wrap(err)
+	}
+
+	return &Filter{
+		Attribute: attributeName(match[1]),
+		Value:     value,
+	}, nil
+}
+
+// attributeName lowercases the attribute name and strips the core schema URNs from it.
+func attributeName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, schema := range []string{SchemaUser, SchemaGroup} {
+		if prefix := strings.ToLower(schema) + ":"; strings.HasPrefix(name, prefix) {
+			return strings.TrimPrefix(name, prefix)
+		}
+	}
+	return name
+}
+
+// parseValuePath splits a path like `members[value eq "id"]` into the attribute and its filter.
+// Paths without a filter return a nil filter.
+func parseValuePath(path string) (attribute string, filter *Filter, err error) {
+	open := strings.IndexByte(path, '[')
+	if open < 0 {
+		return attributeName(path), nil, nil
+	}
+
+	end := strings.LastIndexByte(path, ']')
+	if end < open {
+		return "", nil, ErrInvalidPath.New("%s", path)
+	}
+
+	filter, err = ParseFilter(path[open+1 : end])
+	if err != nil || filter == nil {
+		return "", nil, ErrInvalidPath.New("%s", path)
+	}
+
+	// sub-attributes after the filter, e.g. `emails[type eq "work"].value`, address
+	// the same value for the single valued attributes we support.
+	return attributeName(path[:open]), filter, nil
+}
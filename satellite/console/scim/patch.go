@@ -0,0 +1,304 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scim
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// PatchRequest is the body of a PATCH request.
+type PatchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []PatchOperation `json:"Operations"`
+}
+
+// PatchOperation is a single operation of a PATCH request.
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+const (
+	opAdd     = "add"
+	opReplace = "replace"
+	opRemove  = "remove"
+)
+
+// projectAttribute is the lowercase name of the project extension attribute.
+var projectAttribute = strings.ToLower(SchemaProject)
+
+// ApplyUserPatch applies the operations to the user. Attributes that
+// aren't stored, like phone numbers or enterprise attributes, are ignored.
+func ApplyUserPatch(user *User, operations []PatchOperation) error {
+	for _, operation := range operations {
+		op := strings.ToLower(operation.Op)
+		switch op {
+		case opAdd, opReplace:
+			if operation.Path == "" {
+				var values map[string]json.RawMessage
+				if err := json.Unmarshal(operation.Value, &values); err != nil {
+					return ErrInvalidValue.Wrap(err)
+				}
+				for name, value := range values {
+					if err := setUserAttribute(user, name, value); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if err := setUserAttribute(user, operation.Path, operation.Value); err != nil {
+				return err
+			}
+		case opRemove:
+			if err := removeUserAttribute(user, operation.Path); err != nil {
+				return err
+			}
+		default:
+			return ErrInvalidValue.New("unsupported operation %q", operation.Op)
+		}
+	}
+	return nil
+}
+
+func setUserAttribute(user *User, path string, value json.RawMessage) error {
+	attribute, _, err := parseValuePath(path)
+	if err != nil {
+		return err
+	}
+
+	switch attribute {
+	case "active":
+		active, err := parseBool(value)
+		if err != nil {
+			return err
+		}
+		user.Active = &active
+	case "username":
+		return unmarshalString(value, &user.UserName)
+	case "displayname":
+		return unmarshalString(value, &user.DisplayName)
+	case "externalid":
+		return unmarshalString(value, &user.ExternalID)
+	case "name":
+		var name Name
+		if err := json.Unmarshal(value, &name); err != nil {
+			return ErrInvalidValue.Wrap(err)
+		}
+		user.Name = &name
+	case "name.formatted", "name.givenname", "name.familyname":
+		if user.Name == nil {
+			user.Name = &Name{}
+		}
+		target := &user.Name.Formatted
+		switch attribute {
+		case "name.givenname":
+			target = &user.Name.GivenName
+		case "name.familyname":
+			target = &user.Name.FamilyName
+		}
+		// the formatted name takes precedence, so it must not shadow updated parts.
+		if attribute != "name.formatted" {
+			user.Name.Formatted = ""
+		}
+		return unmarshalString(value, target)
+	case "emails", "emails.value":
+		if strings.HasPrefix(strings.TrimSpace(string(value)), "[") {
+			var emails []Email
+			if err := json.Unmarshal(value, &emails); err != nil {
+				return ErrInvalidValue.Wrap(err)
+			}
+			user.Emails = emails
+			return nil
+		}
+		var email string
+		if err := unmarshalString(value, &email); err != nil {
+			return err
+		}
+		user.Emails = []Email{{Value: email, Type: "work", Primary: true}}
+	}
+	return nil
+}
+
+func removeUserAttribute(user *User, path string) error {
+	attribute, _, err := parseValuePath(path)
+	if err != nil {
+		return err
+	}
+
+	switch attribute {
+	case "":
+		return ErrInvalidPath.New("remove operation requires a path")
+	case "username", "active":
+		return ErrInvalidPath.New("%s can't be removed", path)
+	case "displayname":
+		user.DisplayName = ""
+	case "externalid":
+		user.ExternalID = ""
+	case "name":
+		user.Name = nil
+	case "emails", "emails.value":
+		user.Emails = nil
+	}
+	return nil
+}
+
+// ApplyGroupPatch applies the operations to the group.
+func ApplyGroupPatch(group *Group, operations []PatchOperation) error {
+	for _, operation := range operations {
+		op := strings.ToLower(operation.Op)
+		if op != opAdd && op != opReplace && op != opRemove {
+			return ErrInvalidValue.New("unsupported operation %q", operation.Op)
+		}
+
+		if operation.Path == "" {
+			if op == opRemove {
+				return ErrInvalidPath.New("remove operation requires a path")
+			}
+			var values map[string]json.RawMessage
+			if err := json.Unmarshal(operation.Value, &values); err != nil {
+				return ErrInvalidValue.Wrap(err)
+			}
+			for name, value := range values {
+				if err := patchGroupAttribute(group, op, name, value); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if err := patchGroupAttribute(group, op, operation.Path, operation.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func patchGroupAttribute(group *Group, op, path string, value json.RawMessage) error {
+	attribute, filter, err := parseValuePath(path)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case attribute == "displayname":
+		if op == opRemove {
+			return ErrInvalidPath.New("%s can't be removed", path)
+		}
+		return unmarshalString(value, &group.DisplayName)
+	case attribute == "externalid":
+		if op == opRemove {
+			group.ExternalID = ""
+			return nil
+		}
+		return unmarshalString(value, &group.ExternalID)
+	case attribute == "members":
+		return patchGroupMembers(group, op, filter, value)
+	case attribute == projectAttribute:
+		if op == opRemove {
+			group.Project = nil
+			return nil
+		}
+		var project Project
+		if err := json.Unmarshal(value, &project); err != nil {
+			return ErrInvalidValue.Wrap(err)
+		}
+		group.Project = &project
+	case strings.HasPrefix(attribute, projectAttribute+":"):
+		if group.Project == nil {
+			group.Project = &Project{}
+		}
+		var target *string
+		switch strings.TrimPrefix(attribute, projectAttribute+":") {
+		case "projectid":
+			target = &group.Project.ProjectID
+		case "role":
+			target = &group.Project.Role
+		default:
+			return ErrInvalidPath.New("%s", path)
+		}
+		if op == opRemove {
+			*target = ""
+			return nil
+		}
+		return unmarshalString(value, target)
+	}
+	return nil
+}
+
+func patchGroupMembers(group *Group, op string, filter *Filter, value json.RawMessage) error {
+	var members []Member
+	if len(value) > 0 && string(value) != "null" {
+		if err := json.Unmarshal(value, &members); err != nil {
+			return ErrInvalidValue.Wrap(err)
+		}
+	}
+
+	if filter != nil {
+		if op != opRemove || filter.Attribute != "value" {
+			return ErrInvalidPath.New("only removing members by value is supported")
+		}
+		members = append(members, Member{Value: filter.Value})
+	}
+
+	switch op {
+	case opReplace:
+		group.Members = nil
+		fallthrough
+	case opAdd:
+		for _, member := range members {
+			if !hasMember(group.Members, member.Value) {
+				group.Members = append(group.Members, member)
+			}
+		}
+	case opRemove:
+		if len(members) == 0 {
+			group.Members = nil
+			return nil
+		}
+		kept := group.Members[:0]
+		for _, member := range group.Members {
+			if !hasMember(members, member.Value) {
+				kept = append(kept, member)
+			}
+		}
+		group.Members = kept
+	}
+	return nil
+}
+
+func hasMember(members []Member, value string) bool {
+	for _, member := range members {
+		if strings.EqualFold(member.Value, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseBool parses a boolean, which some identity providers send as a string.
+func parseBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return false, ErrInvalidValue.New("expected a boolean: %s", value)
+	}
+	b, err := strconv.ParseBool(strings.ToLower(s))
+	if err != nil {
+		return false, ErrInvalidValue.New("expected a boolean: %s", value)
+	}
+	return b, nil
+}
+
+func unmarshalString(value json.RawMessage, target *string) error {
+	if err := json.Unmarshal(value, target); err != nil {
+		return ErrInvalidValue.Wrap(err)
+	}
+	return nil
+}
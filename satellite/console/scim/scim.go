@@ -0,0 +1,246 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package scim contains the SCIM 2.0 (RFC 7643 and RFC 7644) resources and
+// helpers used to provision the users and groups of SSO tenants.
+package scim
+
+import (
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+const (
+	// SchemaUser is the schema of the core user resource.
+	SchemaUser = "urn:ietf:params:scim:schemas:core:2.0:User"
+	// SchemaGroup is the schema of the core group resource.
+	SchemaGroup = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	// SchemaProject is the schema of the group extension, which maps the group to a project.
+	SchemaProject = "urn:storj:params:scim:schemas:extension:2.0:Project"
+	// SchemaListResponse is the schema of list responses.
+	SchemaListResponse = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	// SchemaPatchOp is the schema of patch requests.
+	SchemaPatchOp = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	// SchemaError is the schema of error responses.
+	SchemaError = "urn:ietf:params:scim:api:messages:2.0:Error"
+	// SchemaServiceProviderConfig is the schema of the service provider configuration.
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+
+	// ContentType is the media type of SCIM requests and responses.
+	ContentType = "application/scim+json"
+
+	// MaxPageSize is the maximum number of resources returned in a list response.
+	MaxPageSize = 100
+)
+
+var (
+	// Error is the default error class for the package.
+	Error = errs.Class("scim")
+	// ErrInvalidFilter is returned when the filter is malformed or not supported.
+	ErrInvalidFilter = errs.Class("scim:invalid filter")
+	// ErrInvalidPath is returned when the patch path is malformed or not supported.
+	ErrInvalidPath = errs.Class("scim:invalid path")
+	// ErrInvalidValue is returned when a value of the request is malformed.
+	ErrInvalidValue = errs.Class("scim:invalid value")
+)
+
+// Error types sent in the scimType field of error responses.
+const (
+	ErrorTypeInvalidFilter = "invalidFilter"
+	ErrorTypeInvalidPath   = "invalidPath"
+	ErrorTypeInvalidValue  = "invalidValue"
+	ErrorTypeUniqueness    = "uniqueness"
+	ErrorTypeMutability    = "mutability"
+)
+
+// Meta holds the resource metadata.
+type Meta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	Location     string    `json:"location,omitempty"`
+}
+
+// Name holds the components of the user's name.
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// String returns the full name.
+func (n *Name) String() string {
+	if n == nil {
+		return ""
+	}
+	if n.Formatted != "" {
+		return n.Formatted
+	}
+	if n.GivenName != "" && n.FamilyName != "" {
+		return n.GivenName + " " + n.FamilyName
+	}
+	return n.GivenName + n.FamilyName
+}
+
+// Email is an email address of the user.
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// GroupRef references a group the user is a member of.
+type GroupRef struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+// User is the user resource.
+type User struct {
+	Schemas     []string   `json:"schemas"`
+	ID          string     `json:"id,omitempty"`
+	ExternalID  string     `json:"externalId,omitempty"`
+	UserName    string     `json:"userName"`
+	Name        *Name      `json:"name,omitempty"`
+	DisplayName string     `json:"displayName,omitempty"`
+	Emails      []Email    `json:"emails,omitempty"`
+	Active      *bool      `json:"active,omitempty"`
+	Groups      []GroupRef `json:"groups,omitempty"`
+	Meta        *Meta      `json:"meta,omitempty"`
+}
+
+// Email returns the primary email of the user, falling back to the user name.
+func (u *User) Email() string {
+	for _, email := range u.Emails {
+		if email.Primary && email.Value != "" {
+			return email.Value
+		}
+	}
+	if u.UserName == "" && len(u.Emails) > 0 {
+		return u.Emails[0].Value
+	}
+	return u.UserName
+}
+
+// FullName returns the full name of the user, falling back to the display name and the user name.
+func (u *User) FullName() string {
+	if name := u.Name.String(); name != "" {
+		return name
+	}
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	return u.UserName
+}
+
+// IsActive returns whether the user is active. Users are active unless stated otherwise.
+func (u *User) IsActive() bool {
+	return u.Active == nil || *u.Active
+}
+
+// Member references a member of a group.
+type Member struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+// Project is the group extension, which maps the members of the group to a project.
+type Project struct {
+	// ProjectID is the public ID of the project.
+	ProjectID string `json:"projectId,omitempty"`
+	// Role is "admin", "member" or the name of a custom role of the project.
+	Role string `json:"role,omitempty"`
+}
+
+// Group is the group resource.
+type Group struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id,omitempty"`
+	ExternalID  string   `json:"externalId,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Members     []Member `json:"members,omitempty"`
+	Project     *Project `json:"urn:storj:params:scim:schemas:extension:2.0:Project,omitempty"`
+	Meta        *Meta    `json:"meta,omitempty"`
+}
+
+// ListResponse is the response of a list request.
+type ListResponse[T any] struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []T      `json:"Resources"`
+}
+
+// NewListResponse creates a list response of the resources.
+func NewListResponse[T any](resources []T, totalResults, startIndex int) ListResponse[T] {
+	if resources == nil {
+		resources = []T{}
+	}
+	return ListResponse[T]{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: totalResults,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
+
+// ErrorResponse is the response of a failed request.
+type ErrorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// ServiceProviderConfig describes the supported SCIM features.
+type ServiceProviderConfig struct {
+	Schemas               []string               `json:"schemas"`
+	Patch                 Supported              `json:"patch"`
+	Bulk                  BulkSupported          `json:"bulk"`
+	Filter                FilterSupported        `json:"filter"`
+	ChangePassword        Supported              `json:"changePassword"`
+	Sort                  Supported              `json:"sort"`
+	ETag                  Supported              `json:"etag"`
+	AuthenticationSchemes []AuthenticationScheme `json:"authenticationSchemes"`
+}
+
+// Supported describes whether a feature is supported.
+type Supported struct {
+	Supported bool `json:"supported"`
+}
+
+// BulkSupported describes the bulk operation support.
+type BulkSupported struct {
+	Supported      bool `json:"supported"`
+	MaxOperations  int  `json:"maxOperations"`
+	MaxPayloadSize int  `json:"maxPayloadSize"`
+}
+
+// FilterSupported describes the filter support.
+type FilterSupported struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults"`
+}
+
+// AuthenticationScheme describes an authentication scheme.
+type AuthenticationScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// DefaultServiceProviderConfig returns the configuration of the supported features.
+func DefaultServiceProviderConfig() ServiceProviderConfig {
+	return ServiceProviderConfig{
+		Schemas: []string{SchemaServiceProviderConfig},
+		Patch:   Supported{Supported: true},
+		Filter:  FilterSupported{Supported: true, MaxResults: MaxPageSize},
+		AuthenticationSchemes: []AuthenticationScheme{{
+			Type:        "oauthbearertoken",
+			Name:        "OAuth Bearer Token",
+			Description: "Authentication with the bearer token configured for the SSO tenant.",
+		}},
+	}
+}
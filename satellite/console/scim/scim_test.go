@@ -0,0 +1,102 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scim_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/console/scim"
+)
+
+func TestParseFilter(t *testing.T) {
+	filter, err := scim.ParseFilter(`userName eq "alice@example.com"`)
+	require.NoError(t, err)
+	require.Equal(t, &scim.Filter{Attribute: "username", Value: "alice@example.com"}, filter)
+
+	filter, err = scim.ParseFilter(`urn:ietf:params:scim:schemas:core:2.0:User:externalId EQ "a \"quoted\" id"`)
+	require.NoError(t, err)
+	require.Equal(t, &scim.Filter{Attribute: "externalid", Value: `a "quoted" id`}, filter)
+
+	filter, err = scim.ParseFilter(" ")
+	require.NoError(t, err)
+	require.Nil(t, filter)
+
+	for _, invalid := range []string{
+		`userName co "alice"`,
+		`userName eq alice`,
+		`userName eq "alice" and active eq true`,
+	} {
+		_, err = scim.ParseFilter(invalid)
+		require.True(t, scim.ErrInvalidFilter.Has(err), invalid)
+	}
+}
+
+func TestApplyUserPatch(t *testing.T) {
+	patch := func(t *testing.T, user *scim.User, body string) error {
+		var request scim.PatchRequest
+		require.NoError(t, json.Unmarshal([]byte(body), &request))
+		return scim.ApplyUserPatch(user, request.Operations)
+	}
+
+	user := &scim.User{UserName: "alice@example.com", Name: &scim.Name{Formatted: "Alice"}}
+
+	// Okta style.
+	require.NoError(t, patch(t, user, `{"Operations":[{"op":"replace","value":{"active":false,"name":{"givenName":"Alice","familyName":"Smith"}}}]}`))
+	require.False(t, user.IsActive())
+	require.Equal(t, "Alice Smith", user.FullName())
+
+	// Entra ID style.
+	require.NoError(t, patch(t, user, `{"Operations":[
+		{"op":"Replace","path":"active","value":"True"},
+		{"op":"Replace","path":"emails[type eq \"work\"].value","value":"alice.smith@example.com"},
+		{"op":"Add","path":"name.givenName","value":"Alicia"},
+		{"op":"Add","path":"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department","value":"Sales"}
+	]}`))
+	require.True(t, user.IsActive())
+	require.Equal(t, "alice.smith@example.com", user.Email())
+	require.Equal(t, "Alicia Smith", user.FullName())
+
+	require.True(t, scim.ErrInvalidValue.Has(patch(t, user, `{"Operations":[{"op":"replace","path":"active","value":"maybe"}]}`)))
+	require.True(t, scim.ErrInvalidPath.Has(patch(t, user, `{"Operations":[{"op":"remove","path":"userName"}]}`)))
+	require.True(t, scim.ErrInvalidValue.Has(patch(t, user, `{"Operations":[{"op":"move","path":"userName"}]}`)))
+}
+
+func TestApplyGroupPatch(t *testing.T) {
+	patch := func(t *testing.T, group *scim.Group, body string) error {
+		var request scim.PatchRequest
+		require.NoError(t, json.Unmarshal([]byte(body), &request))
+		return scim.ApplyGroupPatch(group, request.Operations)
+	}
+
+	group := &scim.Group{DisplayName: "Engineering", Members: []scim.Member{{Value: "a"}}}
+
+	require.NoError(t, patch(t, group, `{"Operations":[{"op":"add","path":"members","value":[{"value":"b"},{"value":"a"},{"value":"c"}]}]}`))
+	require.Equal(t, []scim.Member{{Value: "a"}, {Value: "b"}, {Value: "c"}}, group.Members)
+
+	// Okta removes members with a value filter, Entra ID with a value.
+	require.NoError(t, patch(t, group, `{"Operations":[{"op":"remove","path":"members[value eq \"a\"]"}]}`))
+	require.NoError(t, patch(t, group, `{"Operations":[{"op":"Remove","path":"members","value":[{"value":"c"}]}]}`))
+	require.Equal(t, []scim.Member{{Value: "b"}}, group.Members)
+
+	require.NoError(t, patch(t, group, `{"Operations":[
+		{"op":"replace","value":{"id":"ignored","displayName":"Platform"}},
+		{"op":"add","path":"urn:storj:params:scim:schemas:extension:2.0:Project:projectId","value":"project"},
+		{"op":"replace","path":"urn:storj:params:scim:schemas:extension:2.0:Project:role","value":"admin"}
+	]}`))
+	require.Equal(t, "Platform", group.DisplayName)
+	require.Equal(t, &scim.Project{ProjectID: "project", Role: "admin"}, group.Project)
+
+	require.NoError(t, patch(t, group, `{"Operations":[{"op":"replace","path":"members","value":[{"value":"d"}]}]}`))
+	require.Equal(t, []scim.Member{{Value: "d"}}, group.Members)
+
+	require.NoError(t, patch(t, group, `{"Operations":[{"op":"remove","path":"members"},{"op":"remove","path":"urn:storj:params:scim:schemas:extension:2.0:Project"}]}`))
+	require.Empty(t, group.Members)
+	require.Nil(t, group.Project)
+
+	require.True(t, scim.ErrInvalidPath.Has(patch(t, group, `{"Operations":[{"op":"add","path":"members[value eq \"a\"]"}]}`)))
+	require.True(t, scim.ErrInvalidPath.Has(patch(t, group, `{"Operations":[{"op":"remove","path":"displayName"}]}`)))
+}
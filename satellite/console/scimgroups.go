@@ -0,0 +1,65 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+var (
+	// ErrNoScimGroup is used to indicate that the SCIM group doesn't exist.
+	ErrNoScimGroup = errs.Class("scim group doesn't exist")
+
+	// ErrScimGroupAlreadyExists is used to indicate that a SCIM group with the same display name already exists.
+	ErrScimGroupAlreadyExists = errs.Class("scim group already exists")
+)
+
+// ScimGroups exposes methods to manage the groups provisioned by the identity
+// providers of SSO tenants and their members.
+//
+// architecture: Database
+type ScimGroups interface {
+	// Insert inserts a group into the database.
+	Insert(ctx context.Context, group ScimGroup) (*ScimGroup, error)
+	// Get returns the group by its ID.
+	Get(ctx context.Context, id uuid.UUID) (*ScimGroup, error)
+	// GetByDisplayName returns the group of the provider by its display name.
+	GetByDisplayName(ctx context.Context, provider, displayName string) (*ScimGroup, error)
+	// GetByExternalID returns the group of the provider by its external ID.
+	GetByExternalID(ctx context.Context, provider, externalID string) (*ScimGroup, error)
+	// List returns the groups of the provider ordered by display name, and their total count.
+	List(ctx context.Context, provider string, offset, limit int) (groups []ScimGroup, total int, err error)
+	// GetByMember returns the groups of the provider the user is a member of.
+	GetByMember(ctx context.Context, provider string, userID uuid.UUID) ([]ScimGroup, error)
+	// Update updates the display name, the external ID and the project mapping of the group.
+	Update(ctx context.Context, group ScimGroup) error
+	// Delete deletes the group together with its memberships.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// GetMembers returns the IDs of the members of the group.
+	GetMembers(ctx context.Context, groupID uuid.UUID) ([]uuid.UUID, error)
+	// AddMember adds the user to the group. Adding an existing member is a no-op.
+	AddMember(ctx context.Context, groupID, userID uuid.UUID) error
+	// RemoveMember removes the user from the group.
+	RemoveMember(ctx context.Context, groupID, userID uuid.UUID) error
+}
+
+// ScimGroup is a group provisioned by the identity provider of an SSO tenant.
+// Groups mapped to a project grant their members the project membership and role.
+type ScimGroup struct {
+	ID          uuid.UUID
+	Provider    string
+	DisplayName string
+	ExternalID  string
+	// ProjectID is the ID of the project the group is mapped to, if any.
+	ProjectID *uuid.UUID
+	// Role is "admin", "member" or the name of a custom role of the project.
+	// An empty role is treated as "member".
+	Role      string
+	CreatedAt time.Time
+}
@@ -0,0 +1,870 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/mail"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console/scim"
+	"storj.io/storj/satellite/tenancy"
+)
+
+const (
+	scimRoleAdmin  = "admin"
+	scimRoleMember = "member"
+)
+
+// ScimGetUser returns the user of the SSO tenant.
+func (s *Service) ScimGetUser(ctx context.Context, provider string, id uuid.UUID) (_ *scim.User, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.scimTenantUser(ctx, provider, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := s.scimUser(provider, user)
+	return &resource, nil
+}
+
+// ScimListUsers returns the page of the users of the SSO tenant matching the filter,
+// and the total count of the matching users. startIndex is 1-based.
+func (s *Service) ScimListUsers(ctx context.Context, provider string, filter *scim.Filter, startIndex, count int) (_ []scim.User, total int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if filter != nil {
+		var user *User
+		switch filter.Attribute {
+		case "username", "emails", "emails.value":
+			user, err = s.scimUserByEmail(ctx, provider, filter.Value)
+		case "externalid":
+			user, err = s.scimUserByExternalID(ctx, provider, filter.Value)
+		default:
+			return nil, 0, scim.ErrInvalidFilter.New("filtering users by %q is not supported", filter.Attribute)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if user == nil || startIndex > 1 || count == 0 {
+			return []scim.User{}, boolToInt(user != nil), nil
+		}
+		return []scim.User{s.scimUser(provider, user)}, 1, nil
+	}
+
+	ids, total, err := s.store.Users().GetIDsByExternalIDPrefix(ctx, provider+":", tenantIDFromContext(ctx), startIndex-1, count)
+	if err != nil {
+		return nil, 0, Error.Wrap(err)
+	}
+
+	resources := make([]scim.User, 0, len(ids))
+	for _, id := range ids {
+		user, err := s.store.Users().Get(ctx, id)
+		if err != nil {
+			return nil, 0, Error.Wrap(err)
+		}
+		resources = append(resources, s.scimUser(provider, user))
+	}
+
+	return resources, total, nil
+}
+
+// ScimCreateUser creates a user of the SSO tenant, so they exist before their first login.
+func (s *Service) ScimCreateUser(ctx context.Context, provider string, resource scim.User) (_ *scim.User, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	email, err := s.scimValidateEmail(provider, resource.Email())
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.scimUserByEmail(ctx, provider, email)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrConflict.New("user with email %s already exists", email)
+	}
+
+	externalID := scimExternalID(provider, resource.ExternalID, email)
+	if _, err = s.GetUserByExternalID(ctx, externalID); err == nil {
+		return nil, ErrConflict.New("user with external ID %s already exists", resource.ExternalID)
+	} else if !ErrExternalIdNotFound.Has(err) {
+		return nil, err
+	}
+
+	fullName := strings.TrimSpace(resource.FullName())
+	if fullName == "" {
+		fullName = email
+	}
+
+	user, err := s.CreateSsoUser(ctx, CreateSsoUser{
+		FullName:   fullName,
+		ExternalId: externalID,
+		Email:      email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resource.IsActive() {
+		if err = s.scimSetActive(ctx, user, false); err != nil {
+			return nil, err
+		}
+	}
+
+	s.auditLog(ctx, "scim: create user", &user.ID, user.Email, zap.String("provider", provider))
+
+	created := s.scimUser(provider, user)
+	return &created, nil
+}
+
+// ScimReplaceUser updates the user of the SSO tenant to match the resource.
+// Deactivated users can't log in until they're activated again.
+func (s *Service) ScimReplaceUser(ctx context.Context, provider string, id uuid.UUID, resource scim.User) (_ *scim.User, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.scimTenantUser(ctx, provider, id)
+	if err != nil {
+		return nil, err
+	}
+
+	email, err := s.scimValidateEmail(provider, resource.Email())
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(email, user.Email) {
+		existing, err := s.scimUserByEmail(ctx, provider, email)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil && existing.ID != user.ID {
+			return nil, ErrConflict.New("user with email %s already exists", email)
+		}
+	}
+
+	externalID := scimExternalID(provider, resource.ExternalID, email)
+	if user.ExternalID == nil || (resource.ExternalID != "" && *user.ExternalID != externalID) {
+		if resource.ExternalID == "" && user.ExternalID != nil {
+			externalID = *user.ExternalID
+		}
+		existing, err := s.GetUserByExternalID(ctx, externalID)
+		if err == nil && existing.ID != user.ID {
+			return nil, ErrConflict.New("user with external ID %s already exists", resource.ExternalID)
+		} else if err != nil && !ErrExternalIdNotFound.Has(err) {
+			return nil, err
+		}
+
+		if err = s.UpdateExternalID(ctx, user, externalID); err != nil {
+			return nil, err
+		}
+		user.ExternalID = &externalID
+		if user.Status == Inactive {
+			user.Status = Active
+		}
+	}
+
+	fullName := strings.TrimSpace(resource.FullName())
+	if fullName == "" {
+		fullName = user.FullName
+	}
+
+	// UpdateUserFromIdPWebhook keeps the billing and analytics emails in sync.
+	err = s.UpdateUserFromIdPWebhook(ctx, User{ExternalID: user.ExternalID, FullName: fullName, Email: email}, true)
+	if err != nil {
+		return nil, err
+	}
+	user.FullName, user.Email = fullName, email
+
+	if active := resource.IsActive(); active != (user.Status != Deactivated) {
+		if err = s.scimSetActive(ctx, user, active); err != nil {
+			return nil, err
+		}
+	}
+
+	s.auditLog(ctx, "scim: update user", &user.ID, user.Email, zap.String("provider", provider), zap.Bool("active", resource.IsActive()))
+
+	updated := s.scimUser(provider, user)
+	return &updated, nil
+}
+
+// ScimDeleteUser removes the user of the SSO tenant from their groups and marks them for deletion.
+func (s *Service) ScimDeleteUser(ctx context.Context, provider string, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.scimTenantUser(ctx, provider, id)
+	if err != nil {
+		return err
+	}
+
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		groups, err := tx.ScimGroups().GetByMember(ctx, provider, user.ID)
+		if err != nil {
+			return err
+		}
+		for _, group := range groups {
+			if err = tx.ScimGroups().RemoveMember(ctx, group.ID, user.ID); err != nil {
+				return err
+			}
+			if group.ProjectID != nil {
+				if err = s.scimSyncMembership(ctx, tx, provider, user.ID, *group.ProjectID); err != nil {
+					return err
+				}
+			}
+		}
+
+		status := PendingDeletion
+		return tx.Users().Update(ctx, user.ID, UpdateUserRequest{
+			FullName:   new(string),
+			ShortName:  new(*string),
+			Status:     &status,
+			ExternalID: new(*string),
+		})
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if _, err = s.store.WebappSessions().DeleteAllByUserID(ctx, user.ID); err != nil {
+		s.log.Error("failed to invalidate sessions of deleted scim user", zap.String("user_id", user.ID.String()), zap.Error(err))
+	}
+
+	s.auditLog(ctx, "scim: delete user", &user.ID, user.Email, zap.String("provider", provider))
+	s.analytics.TrackDeleteUser(user.ID, user.Email, false, user.HubspotObjectID, user.TenantID)
+
+	return nil
+}
+
+// ScimGetGroup returns the group of the SSO tenant.
+func (s *Service) ScimGetGroup(ctx context.Context, provider string, id uuid.UUID) (_ *scim.Group, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	group, err := s.scimTenantGroup(ctx, s.store, provider, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.scimGroup(ctx, group, true)
+}
+
+// ScimListGroups returns the page of the groups of the SSO tenant matching the filter,
+// and the total count of the matching groups. startIndex is 1-based.
+func (s *Service) ScimListGroups(ctx context.Context, provider string, filter *scim.Filter, startIndex, count int, withMembers bool) (_ []scim.Group, total int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var groups []ScimGroup
+	if filter != nil {
+		var group *ScimGroup
+		switch filter.Attribute {
+		case "displayname":
+			group, err = s.store.ScimGroups().GetByDisplayName(ctx, provider, filter.Value)
+		case "externalid":
+			group, err = s.store.ScimGroups().GetByExternalID(ctx, provider, filter.Value)
+		case "id":
+			var id uuid.UUID
+			if id, err = uuid.FromString(filter.Value); err == nil {
+				group, err = s.scimTenantGroup(ctx, s.store, provider, id)
+			} else {
+				err = ErrNoScimGroup.New("")
+			}
+		default:
+			return nil, 0, scim.ErrInvalidFilter.New("filtering groups by %q is not supported", filter.Attribute)
+		}
+		if err != nil && !ErrNoScimGroup.Has(err) && !ErrNotFound.Has(err) {
+			return nil, 0, Error.Wrap(err)
+		}
+		if group != nil {
+			total = 1
+			if startIndex <= 1 && count > 0 {
+				groups = []ScimGroup{*group}
+			}
+		}
+	} else {
+		groups, total, err = s.store.ScimGroups().List(ctx, provider, startIndex-1, count)
+		if err != nil {
+			return nil, 0, Error.Wrap(err)
+		}
+	}
+
+	resources := make([]scim.Group, 0, len(groups))
+	for i := range groups {
+		resource, err := s.scimGroup(ctx, &groups[i], withMembers)
+		if err != nil {
+			return nil, 0, err
+		}
+		resources = append(resources, *resource)
+	}
+
+	return resources, total, nil
+}
+
+// ScimCreateGroup creates a group of the SSO tenant. When the group is mapped to a project,
+// its members become members of the project with the role of the group.
+func (s *Service) ScimCreateGroup(ctx context.Context, provider string, resource scim.Group) (_ *scim.Group, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	displayName := strings.TrimSpace(resource.DisplayName)
+	if displayName == "" {
+		return nil, ErrValidation.New("display name is required")
+	}
+
+	projectID, role, err := s.scimResolveProject(ctx, provider, resource.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.scimResolveMembers(ctx, provider, resource.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var group *ScimGroup
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) (err error) {
+		group, err = tx.ScimGroups().Insert(ctx, ScimGroup{
+			ID:          id,
+			Provider:    provider,
+			DisplayName: displayName,
+			ExternalID:  resource.ExternalID,
+			ProjectID:   projectID,
+			Role:        role,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, member := range members {
+			if err = tx.ScimGroups().AddMember(ctx, group.ID, member); err != nil {
+				return err
+			}
+			if projectID != nil {
+				if err = s.scimSyncMembership(ctx, tx, provider, member, *projectID); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if ErrScimGroupAlreadyExists.Has(err) {
+			return nil, ErrConflict.Wrap(err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	s.auditLog(ctx, "scim: create group", nil, "", zap.String("provider", provider), zap.String("group_id", group.ID.String()), zap.String("display_name", group.DisplayName))
+
+	return s.scimGroup(ctx, group, true)
+}
+
+// ScimReplaceGroup updates the group of the SSO tenant to match the resource, updating
+// the project memberships of the added and removed members.
+func (s *Service) ScimReplaceGroup(ctx context.Context, provider string, id uuid.UUID, resource scim.Group) (_ *scim.Group, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	displayName := strings.TrimSpace(resource.DisplayName)
+	if displayName == "" {
+		return nil, ErrValidation.New("display name is required")
+	}
+
+	projectID, role, err := s.scimResolveProject(ctx, provider, resource.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.scimResolveMembers(ctx, provider, resource.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	var group *ScimGroup
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) (err error) {
+		group, err = s.scimTenantGroup(ctx, tx, provider, id)
+		if err != nil {
+			return err
+		}
+		previousProjectID := group.ProjectID
+
+		group.DisplayName = displayName
+		group.ExternalID = resource.ExternalID
+		group.ProjectID = projectID
+		group.Role = role
+		if err = tx.ScimGroups().Update(ctx, *group); err != nil {
+			return err
+		}
+
+		previousMembers, err := tx.ScimGroups().GetMembers(ctx, group.ID)
+		if err != nil {
+			return err
+		}
+
+		affected := make(map[uuid.UUID]struct{}, len(previousMembers)+len(members))
+		for _, member := range previousMembers {
+			affected[member] = struct{}{}
+			if !containsUUID(members, member) {
+				if err = tx.ScimGroups().RemoveMember(ctx, group.ID, member); err != nil {
+					return err
+				}
+			}
+		}
+		for _, member := range members {
+			affected[member] = struct{}{}
+			if !containsUUID(previousMembers, member) {
+				if err = tx.ScimGroups().AddMember(ctx, group.ID, member); err != nil {
+					return err
+				}
+			}
+		}
+
+		var projectIDs []uuid.UUID
+		if previousProjectID != nil {
+			projectIDs = append(projectIDs, *previousProjectID)
+		}
+		if projectID != nil && (previousProjectID == nil || *previousProjectID != *projectID) {
+			projectIDs = append(projectIDs, *projectID)
+		}
+		for member := range affected {
+			for _, pid := range projectIDs {
+				if err = s.scimSyncMembership(ctx, tx, provider, member, pid); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		switch {
+		case ErrScimGroupAlreadyExists.Has(err):
+			return nil, ErrConflict.Wrap(err)
+		case ErrNotFound.Has(err):
+			return nil, err
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	s.auditLog(ctx, "scim: update group", nil, "", zap.String("provider", provider), zap.String("group_id", group.ID.String()), zap.String("display_name", group.DisplayName))
+
+	return s.scimGroup(ctx, group, true)
+}
+
+// ScimDeleteGroup deletes the group of the SSO tenant, removing the project
+// memberships its members were granted only by this group.
+func (s *Service) ScimDeleteGroup(ctx context.Context, provider string, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		group, err := s.scimTenantGroup(ctx, tx, provider, id)
+		if err != nil {
+			return err
+		}
+
+		members, err := tx.ScimGroups().GetMembers(ctx, group.ID)
+		if err != nil {
+			return err
+		}
+
+		if err = tx.ScimGroups().Delete(ctx, group.ID); err != nil {
+			return err
+		}
+
+		if group.ProjectID != nil {
+			for _, member := range members {
+				if err = s.scimSyncMembership(ctx, tx, provider, member, *group.ProjectID); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if ErrNotFound.Has(err) {
+			return err
+		}
+		return Error.Wrap(err)
+	}
+
+	s.auditLog(ctx, "scim: delete group", nil, "", zap.String("provider", provider), zap.String("group_id", id.String()))
+
+	return nil
+}
+
+// scimSyncMembership updates the membership of the user in the project to match the groups
+// of the provider the user is a member of. The user is removed from the project when no
+// group grants them the membership. The project owner's membership is never changed.
+//
+// When several groups grant the membership, the admin role takes precedence, then the
+// custom role of the first group ordered by display name.
+func (s *Service) scimSyncMembership(ctx context.Context, db DB, provider string, userID, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project, err := db.Projects().Get(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	if project.OwnerID == userID {
+		return nil
+	}
+
+	groups, err := db.ScimGroups().GetByMember(ctx, provider, userID)
+	if err != nil {
+		return err
+	}
+
+	granted := false
+	role := RoleMember
+	customRole := ""
+	for _, group := range groups {
+		if group.ProjectID == nil || *group.ProjectID != projectID {
+			continue
+		}
+		granted = true
+		switch group.Role {
+		case scimRoleAdmin:
+			role = RoleAdmin
+		case "", scimRoleMember:
+		default:
+			if customRole == "" {
+				customRole = group.Role
+			}
+		}
+	}
+
+	membership, err := db.ProjectMembers().GetByMemberIDAndProjectID(ctx, userID, projectID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	if !granted {
+		if membership == nil {
+			return nil
+		}
+		return db.ProjectMembers().Delete(ctx, userID, projectID)
+	}
+
+	switch {
+	case membership == nil:
+		if _, err = db.ProjectMembers().Insert(ctx, userID, projectID, role); err != nil {
+			return err
+		}
+	case membership.Role != role:
+		if _, err = db.ProjectMembers().UpdateRole(ctx, userID, projectID, role); err != nil {
+			return err
+		}
+	}
+
+	if role == RoleAdmin || customRole == "" {
+		return db.ProjectRoles().Unassign(ctx, userID, projectID)
+	}
+
+	roles, err := db.ProjectRoles().GetByProjectID(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	for _, r := range roles {
+		if r.Name == customRole {
+			return db.ProjectRoles().Assign(ctx, userID, projectID, r.ID)
+		}
+	}
+
+	// the custom role was deleted after it was mapped, so the member falls back to the built-in role.
+	return db.ProjectRoles().Unassign(ctx, userID, projectID)
+}
+
+// scimResolveProject validates the project mapping of a group. The project must be owned
+// by a user of the SSO tenant and the role must be a built-in role or a custom role of the project.
+func (s *Service) scimResolveProject(ctx context.Context, provider string, mapping *scim.Project) (projectID *uuid.UUID, role string, err error) {
+	if mapping == nil || mapping.ProjectID == "" {
+		return nil, "", nil
+	}
+
+	publicID, err := uuid.FromString(mapping.ProjectID)
+	if err != nil {
+		return nil, "", ErrValidation.New("invalid project ID %q", mapping.ProjectID)
+	}
+
+	project, err := s.store.Projects().GetByPublicID(ctx, publicID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, "", ErrValidation.New("project %s doesn't exist", mapping.ProjectID)
+		}
+		return nil, "", Error.Wrap(err)
+	}
+
+	owner, err := s.store.Users().Get(ctx, project.OwnerID)
+	if err != nil {
+		return nil, "", Error.Wrap(err)
+	}
+	if !s.scimUserInTenant(ctx, provider, owner) {
+		// projects of other tenants are reported as missing to not disclose them.
+		return nil, "", ErrValidation.New("project %s doesn't exist", mapping.ProjectID)
+	}
+
+	role = strings.TrimSpace(mapping.Role)
+	switch {
+	case role == "" || strings.EqualFold(role, scimRoleMember):
+		role = scimRoleMember
+	case strings.EqualFold(role, scimRoleAdmin):
+		role = scimRoleAdmin
+	default:
+		roles, err := s.store.ProjectRoles().GetByProjectID(ctx, project.ID)
+		if err != nil {
+			return nil, "", Error.Wrap(err)
+		}
+		found := false
+		for _, r := range roles {
+			if r.Name == role {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, "", ErrValidation.New("project role %q doesn't exist", role)
+		}
+	}
+
+	return &project.ID, role, nil
+}
+
+// scimResolveMembers returns the IDs of the group members, which must be users of the SSO tenant.
+func (s *Service) scimResolveMembers(ctx context.Context, provider string, members []scim.Member) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		id, err := uuid.FromString(member.Value)
+		if err != nil {
+			return nil, ErrValidation.New("invalid member %q", member.Value)
+		}
+		if containsUUID(ids, id) {
+			continue
+		}
+		if _, err = s.scimTenantUser(ctx, provider, id); err != nil {
+			if ErrNotFound.Has(err) {
+				return nil, ErrValidation.New("member %s doesn't exist", member.Value)
+			}
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// scimTenantUser returns the user, making sure it belongs to the SSO tenant and isn't deleted.
+func (s *Service) scimTenantUser(ctx context.Context, provider string, id uuid.UUID) (*User, error) {
+	user, err := s.store.Users().Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound.New("user %s doesn't exist", id)
+		}
+		return nil, Error.Wrap(err)
+	}
+	if !s.scimUserInTenant(ctx, provider, user) {
+		return nil, ErrNotFound.New("user %s doesn't exist", id)
+	}
+	return user, nil
+}
+
+// scimTenantGroup returns the group, making sure it belongs to the SSO tenant.
+func (s *Service) scimTenantGroup(ctx context.Context, db DB, provider string, id uuid.UUID) (*ScimGroup, error) {
+	group, err := db.ScimGroups().Get(ctx, id)
+	if err != nil {
+		if ErrNoScimGroup.Has(err) {
+			return nil, ErrNotFound.New("group %s doesn't exist", id)
+		}
+		return nil, err
+	}
+	if group.Provider != provider {
+		return nil, ErrNotFound.New("group %s doesn't exist", id)
+	}
+	return group, nil
+}
+
+// scimUserByEmail returns the user of the SSO tenant with the email, or nil if there is none.
+func (s *Service) scimUserByEmail(ctx context.Context, provider, email string) (*User, error) {
+	verified, unverified, err := s.store.Users().GetByEmailAndTenantWithUnverified(ctx, email, tenantIDFromContext(ctx))
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, Error.Wrap(err)
+	}
+
+	candidates := unverified
+	if verified != nil {
+		candidates = append([]User{*verified}, unverified...)
+	}
+	for i := range candidates {
+		if s.scimUserInTenant(ctx, provider, &candidates[i]) {
+			return &candidates[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// scimUserByExternalID returns the user of the SSO tenant with the external ID, or nil if there is none.
+func (s *Service) scimUserByExternalID(ctx context.Context, provider, externalID string) (*User, error) {
+	user, err := s.GetUserByExternalID(ctx, provider+":"+externalID)
+	if err != nil {
+		if ErrExternalIdNotFound.Has(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !s.scimUserInTenant(ctx, provider, user) {
+		return nil, nil
+	}
+	return user, nil
+}
+
+// scimUserInTenant returns whether the user belongs to the SSO tenant and isn't deleted.
+// Users belong to the tenant when they were linked to the provider or their email
+// is mapped to it.
+func (s *Service) scimUserInTenant(ctx context.Context, provider string, user *User) bool {
+	switch user.Status {
+	case Deleted, PendingDeletion, UserRequestedDeletion:
+		return false
+	}
+
+	tenantID := tenantIDFromContext(ctx)
+	if (tenantID == nil) != (user.TenantID == nil) || (tenantID != nil && *tenantID != *user.TenantID) {
+		return false
+	}
+
+	if user.ExternalID != nil && strings.HasPrefix(*user.ExternalID, provider+":") {
+		return true
+	}
+	return s.ssoService != nil && s.ssoService.GetProviderByEmail(user.Email) == provider
+}
+
+// scimValidateEmail validates the email of a user of the SSO tenant.
+func (s *Service) scimValidateEmail(provider, email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if _, err := mail.ParseAddress(email); err != nil {
+		return "", ErrValidation.New("invalid email %q", email)
+	}
+	if s.ssoService == nil || s.ssoService.GetProviderByEmail(email) != provider {
+		return "", ErrValidation.New("email %s doesn't belong to the SSO tenant", email)
+	}
+	return email, nil
+}
+
+// scimSetActive activates or deactivates the user. The sessions of deactivated users are invalidated.
+func (s *Service) scimSetActive(ctx context.Context, user *User, active bool) error {
+	status := Active
+	if !active {
+		status = Deactivated
+	}
+
+	if err := s.store.Users().Update(ctx, user.ID, UpdateUserRequest{Status: &status}); err != nil {
+		return Error.Wrap(err)
+	}
+	user.Status = status
+
+	if !active {
+		if _, err := s.store.WebappSessions().DeleteAllByUserID(ctx, user.ID); err != nil {
+			s.log.Error("failed to invalidate sessions of deactivated scim user", zap.String("user_id", user.ID.String()), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// scimUser converts the user into the SCIM resource.
+func (s *Service) scimUser(provider string, user *User) scim.User {
+	active := user.Status != Deactivated
+	resource := scim.User{
+		Schemas:     []string{scim.SchemaUser},
+		ID:          user.ID.String(),
+		UserName:    user.Email,
+		Name:        &scim.Name{Formatted: user.FullName},
+		DisplayName: user.FullName,
+		Emails:      []scim.Email{{Value: user.Email, Type: "work", Primary: true}},
+		Active:      &active,
+		Meta: &scim.Meta{
+			ResourceType: "User",
+			Created:      user.CreatedAt,
+		},
+	}
+	if user.ExternalID != nil {
+		resource.ExternalID = strings.TrimPrefix(*user.ExternalID, provider+":")
+	}
+	return resource
+}
+
+// scimGroup converts the group into the SCIM resource.
+func (s *Service) scimGroup(ctx context.Context, group *ScimGroup, withMembers bool) (*scim.Group, error) {
+	resource := scim.Group{
+		Schemas:     []string{scim.SchemaGroup},
+		ID:          group.ID.String(),
+		ExternalID:  group.ExternalID,
+		DisplayName: group.DisplayName,
+		Meta: &scim.Meta{
+			ResourceType: "Group",
+			Created:      group.CreatedAt,
+		},
+	}
+
+	if group.ProjectID != nil {
+		project, err := s.store.Projects().Get(ctx, *group.ProjectID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, Error.Wrap(err)
+		}
+		if project != nil {
+			resource.Schemas = append(resource.Schemas, scim.SchemaProject)
+			resource.Project = &scim.Project{ProjectID: project.PublicID.String(), Role: group.Role}
+		}
+	}
+
+	if withMembers {
+		members, err := s.store.ScimGroups().GetMembers(ctx, group.ID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		for _, member := range members {
+			resource.Members = append(resource.Members, scim.Member{Value: member.String()})
+		}
+	}
+
+	return &resource, nil
+}
+
+// scimExternalID returns the external ID of the user of the SSO tenant. Users provisioned
+// without an external ID are identified by their email until their first login.
+func scimExternalID(provider, externalID, email string) string {
+	if externalID == "" {
+		externalID = email
+	}
+	return provider + ":" + externalID
+}
+
+// tenantIDFromContext returns the ID of the tenant of the request, if any.
+func tenantIDFromContext(ctx context.Context) *string {
+	if tenantCtx := tenancy.GetContext(ctx); tenantCtx != nil {
+		return &tenantCtx.TenantID
+	}
+	return nil
+}
+
+func containsUUID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, other := range ids {
+		if other == id {
+			return true
+		}
+	}
+	return false
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -218,7 +218,7 @@ var (
 	// ErrBotUser occurs when a user must be verified by admin first in order to complete operation.
 	ErrBotUser = errs.Class("user has to be verified by admin first")
 
-	// ErrLoginRestricted occurs when a user with PendingBotVerification or LegalHold status tries to log in.
+	// ErrLoginRestricted occurs when a user with PendingBotVerification, LegalHold or Deactivated status tries to log in.
 	ErrLoginRestricted = errs.Class("user can't be authenticated")
 
 	// ErrFailedToUpgrade occurs when a user can't be upgraded to paid tier.
@@ -1888,6 +1888,10 @@ func (s *Service) GetUserForSsoAuth(ctx context.Context, claims sso.OidcSsoClaim
 		}
 	}
 
+	if user.Status == Deactivated {
+		return nil, ErrLoginRestricted.New("")
+	}
+
 	if user.ExternalID == nil || *user.ExternalID != externalID {
 		s.log.Info("updating external ID", zap.String("user_id", user.ID.String()), zap.String("email", user.Email))
 		// associate existing user with this external ID.
@@ -2441,7 +2445,7 @@ func (s *Service) Token(ctx context.Context, request AuthUser) (response *TokenI
 	}
 
 	captchaSkipped := true
-	if request.MFARecoveryCode == "" && request.MFAPasscode == "" {
+	if request.MFARecoveryCode == "" && request.MFAPasscode == "" && request.WebAuthnAssertion == nil {
 		// verify captcha on first login attempt.
 		// we only want to verify captcha if the user is not verifying MFA.
 		err = verifyCaptcha()
@@ -2502,12 +2506,17 @@ func (s *Service) Token(ctx context.Context, request AuthUser) (response *TokenI
 		return nil, ErrLoginCredentials.New(credentialsErrMsg)
 	}
 
-	if user.Status == PendingBotVerification || user.Status == LegalHold {
+	if user.Status == PendingBotVerification || user.Status == LegalHold || user.Status == Deactivated {
 		return nil, ErrLoginRestricted.New("")
 	}
 
-	if user.MFAEnabled {
-		err = s.logInVerifyMFA(ctx, user, request)
+	webAuthnCredentials, err := s.getWebAuthnCredentialsForLogin(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.MFAEnabled || len(webAuthnCredentials) > 0 {
+		err = s.logInVerifyMFA(ctx, user, webAuthnCredentials, request)
 		if err != nil {
 			return nil, err
 		}
@@ -2589,16 +2598,27 @@ func (s *Service) handleLogInLockAccount(ctx context.Context, user *User) error
 	return nil
 }
 
-func (s *Service) logInVerifyMFA(ctx context.Context, user *User, request AuthUser) (err error) {
+func (s *Service) logInVerifyMFA(ctx context.Context, user *User, webAuthnCredentials []WebAuthnCredential, request AuthUser) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	if request.MFARecoveryCode != "" && request.MFAPasscode != "" {
+	factors := 0
+	for _, provided := range []bool{request.MFARecoveryCode != "", request.MFAPasscode != "", request.WebAuthnAssertion != nil} {
+		if provided {
+			factors++
+		}
+	}
+	if factors > 1 {
 		mon.Counter("login_mfa_conflict").Inc(1)
 		s.auditLog(ctx, "login: failed mfa conflict", &user.ID, user.Email)
 		return ErrMFAConflict.New(mfaConflictErrMsg)
 	}
 
-	if request.MFARecoveryCode != "" {
+	if request.WebAuthnAssertion != nil {
+		err = s.logInVerifyWebAuthn(ctx, user, webAuthnCredentials, request)
+		if err != nil {
+			return err
+		}
+	} else if request.MFARecoveryCode != "" {
 		found := false
 		codeIndex := -1
 		for i, code := range user.MFARecoveryCodes {
@@ -2651,6 +2671,13 @@ func (s *Service) logInVerifyMFA(ctx context.Context, user *User, request AuthUs
 	} else {
 		mon.Counter("login_mfa_missing").Inc(1)
 		s.auditLog(ctx, "login: failed mfa missing", &user.ID, user.Email)
+		if len(webAuthnCredentials) > 0 {
+			required, err := s.newWebAuthnMFARequiredError(ctx, user, webAuthnCredentials)
+			if err != nil {
+				return err
+			}
+			return ErrMFAMissing.Wrap(required)
+		}
 		return ErrMFAMissing.New(mfaRequiredErrMsg)
 	}
 
@@ -4029,6 +4056,10 @@ func (s *Service) GetEmissionImpact(ctx context.Context, projectID uuid.UUID) (*
 		return nil, ErrUnauthorized.Wrap(err)
 	}
 
+	if err = s.checkPermission(ctx, isMember, PermissionViewUsage, "view project usage"); err != nil {
+		return nil, err
+	}
+
 	storageUsed, err := s.projectUsage.GetProjectStorageTotals(ctx, isMember.project.ID)
 	if err != nil {
 		return nil, Error.Wrap(err)
@@ -4122,8 +4153,13 @@ func (s *Service) GetProjectConfig(ctx context.Context, projectID uuid.UUID) (*P
 		return nil, err
 	}
 
+	permissions, err := s.memberPermissions(ctx, isMember)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
 	var computeAuthToken string
-	if s.entitlementsConfig.Enabled && s.config.ComputeUiEnabled && isMember.membership.Role == RoleAdmin {
+	if s.entitlementsConfig.Enabled && s.config.ComputeUiEnabled && permissions.Has(PermissionAll) {
 		features, err := s.entitlementsService.Projects().GetByPublicID(ctx, project.PublicID)
 		if err != nil {
 			s.log.Error("failed to get project entitlements", zap.Error(err))
@@ -4139,6 +4175,7 @@ func (s *Service) GetProjectConfig(ctx context.Context, projectID uuid.UUID) (*P
 		IsOwnerPaidTier:      ownerKind == PaidUser,
 		HasPaidPrivileges:    ownerKind == PaidUser || ownerKind == NFRUser || ownerKind == TenantUser,
 		Role:                 isMember.membership.Role,
+		Permissions:          permissions,
 		Salt:                 base64.StdEncoding.EncodeToString(salt),
 		MembersCount:         membersCount,
 		AvailablePlacements:  placementDetails,
@@ -4686,8 +4723,12 @@ func (s *Service) UpdateUserSpecifiedLimits(ctx context.Context, projectID uuid.
 	}
 	project := isMember.project
 
-	if isMember.membership.Role != RoleAdmin && project.OwnerID != user.ID {
-		return ErrUnauthorized.New("Only project owner or admin may update project limits")
+	allowed, err := s.hasPermission(ctx, isMember, PermissionManageBilling)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !allowed {
+		return ErrUnauthorized.New("you do not have permission to update project limits")
 	}
 
 	kind := user.Kind
@@ -4732,6 +4773,11 @@ func (s *Service) UpdateUserSpecifiedLimits(ctx context.Context, projectID uuid.
 		})
 	}
 
+	err = CheckProjectLimitUpdates(ctx, s.store, s.config.UsageLimits, project.ID, updates)
+	if err != nil {
+		return err
+	}
+
 	err = s.store.Projects().UpdateLimitsGeneric(ctx, project.ID, updates)
 	if err != nil {
 		return Error.Wrap(err)
@@ -4761,8 +4807,8 @@ func (s *Service) UpdateProjectNotificationFlags(ctx context.Context, projectID
 
 	project := isMember.project
 
-	if isMember.membership.Role != RoleAdmin && project.OwnerID != user.ID {
-		return ErrForbidden.New("only the project owner or admin may update notification flags")
+	if err = s.checkPermission(ctx, isMember, PermissionManageBilling, "update notification flags"); err != nil {
+		return err
 	}
 
 	flags := 0
@@ -4862,8 +4908,8 @@ func (s *Service) MigrateProjectPricing(ctx context.Context, publicProjectID uui
 	if err != nil {
 		return ErrUnauthorized.Wrap(err)
 	}
-	if isMember.membership.Role != RoleAdmin {
-		return ErrForbidden.New("only project owner or admin may migrate project pricing")
+	if err = s.checkPermission(ctx, isMember, PermissionManageBilling, "migrate project pricing"); err != nil {
+		return err
 	}
 
 	if !s.entitlementsConfig.Enabled || s.legacyPlacements == nil {
@@ -5135,10 +5181,14 @@ func (s *Service) DeleteProjectMembersAndInvitations(ctx context.Context, projec
 		return ErrUnauthorized.Wrap(err)
 	}
 
-	if isMember.membership.Role != RoleAdmin {
-		// We still allow user to remove themselves even with Member role.
+	allowed, err := s.hasPermission(ctx, isMember, PermissionManageMembers)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !allowed {
+		// We still allow user to remove themselves without the permission.
 		if len(data.Emails) != 1 || user.Email != data.Emails[0] {
-			return ErrForbidden.New("only project Owner or Admin can remove other members")
+			return ErrForbidden.New("you do not have permission to remove other members")
 		}
 	}
 
@@ -5210,7 +5260,7 @@ func (s *Service) DeleteProjectMembersAndInvitations(ctx context.Context, projec
 	return Error.Wrap(err)
 }
 
-// UpdateProjectMemberRole updates project member's role and returns an updated one.
+// UpdateProjectMemberRole updates project member's built-in role, removing their custom role, and returns an updated one.
 func (s *Service) UpdateProjectMemberRole(ctx context.Context, memberID, projectID uuid.UUID, newRole ProjectMemberRole) (pm *ProjectMember, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -5237,7 +5287,15 @@ func (s *Service) UpdateProjectMemberRole(ctx context.Context, memberID, project
 		return nil, ErrNoMembership.Wrap(err)
 	}
 
-	pm, err = s.store.ProjectMembers().UpdateRole(ctx, memberID, pr.ID, newRole)
+	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		pm, err = tx.ProjectMembers().UpdateRole(ctx, memberID, pr.ID, newRole)
+		if err != nil {
+			return err
+		}
+
+		// Changing the built-in role replaces the custom role of the member.
+		return tx.ProjectRoles().Unassign(ctx, memberID, pr.ID)
+	})
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -5309,6 +5367,10 @@ func (s *Service) CreateDomain(ctx context.Context, domain Domain) (created *Dom
 
 	project := isMember.project
 
+	if err = s.checkPermission(ctx, isMember, PermissionManageDomains, "create domains"); err != nil {
+		return nil, err
+	}
+
 	kind := user.Kind
 	if project.OwnerID != user.ID {
 		kind, err = s.store.Users().GetUserKind(ctx, project.OwnerID)
@@ -5343,8 +5405,16 @@ func (s *Service) DeleteDomain(ctx context.Context, projectID uuid.UUID, subdoma
 
 	pid := membership.project.ID
 
-	// If not project owner or admin, make sure the user is the creator of the domain.
-	if membership.project.OwnerID != user.ID && membership.membership.Role != RoleAdmin {
+	permissions, err := s.memberPermissions(ctx, membership)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !permissions.Has(PermissionManageDomains) {
+		return ErrForbidden.New("you do not have permission to delete domains")
+	}
+
+	// If the user can't manage other members, make sure the user is the creator of the domain.
+	if !permissions.Has(PermissionManageMembers) {
 		domain, err := s.store.Domains().GetByProjectIDAndSubdomain(ctx, pid, subdomain)
 		if err != nil {
 			return err
@@ -5416,6 +5486,10 @@ func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name st
 		return nil, nil, ErrUnauthorized.Wrap(err)
 	}
 
+	if err = s.checkPermission(ctx, isMember, PermissionManageAPIKeys, "create API keys"); err != nil {
+		return nil, nil, err
+	}
+
 	if isMember.project.PassphraseEnc != nil {
 		return nil, nil, ErrForbidden.New("API keys cannot be created for projects with managed encryption")
 	}
@@ -5487,6 +5561,17 @@ func (s *Service) GenCreateAPIKey(ctx context.Context, requestInfo CreateAPIKeyR
 		}
 	}
 
+	if err = s.checkPermission(ctx, isMember, PermissionManageAPIKeys, "create API keys"); err != nil {
+		status := http.StatusInternalServerError
+		if ErrForbidden.Has(err) {
+			status = http.StatusForbidden
+		}
+		return nil, api.HTTPError{
+			Status: status,
+			Err:    err,
+		}
+	}
+
 	if isMember.project.PassphraseEnc != nil {
 		return nil, api.HTTPError{
 			Status: http.StatusForbidden,
@@ -5680,7 +5765,12 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 			continue
 		}
 
-		if pm.membership.Role != RoleAdmin && key.CreatedBy != pm.membership.MemberID {
+		allowed, err := s.canDeleteAPIKey(ctx, pm, key)
+		if err != nil {
+			keysErr.Add(err)
+			continue
+		}
+		if !allowed {
 			keysErr.Add(ErrForbidden.Wrap(errs.New("you do not have permission to delete this API key: %s", key.Name)))
 			continue
 		}
@@ -5697,6 +5787,20 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 	return nil
 }
 
+// canDeleteAPIKey returns whether the project member may delete the API key.
+// Members may delete their own API keys, while deleting the API keys of other
+// members requires managing the members as well.
+func (s *Service) canDeleteAPIKey(ctx context.Context, isMember isProjectMember, key *APIKeyInfo) (bool, error) {
+	permissions, err := s.memberPermissions(ctx, isMember)
+	if err != nil {
+		return false, err
+	}
+	if !permissions.Has(PermissionManageAPIKeys) {
+		return false, nil
+	}
+	return key.CreatedBy == isMember.membership.MemberID || permissions.Has(PermissionManageMembers), nil
+}
+
 // GetAllAPIKeyNamesByProjectID returns all api key names by project ID.
 func (s *Service) GetAllAPIKeyNamesByProjectID(ctx context.Context, projectID uuid.UUID) (names []string, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -5739,7 +5843,11 @@ func (s *Service) DeleteAPIKeyByNameAndProjectID(ctx context.Context, name strin
 		return ErrNoAPIKey.New(apiKeyWithNameDoesntExistErrMsg)
 	}
 
-	if pm.membership.Role != RoleAdmin && key.CreatedBy != pm.membership.MemberID {
+	allowed, err := s.canDeleteAPIKey(ctx, pm, key)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !allowed {
 		return ErrForbidden.Wrap(errs.New("you do not have permission to delete this API key"))
 	}
 
@@ -5795,11 +5903,15 @@ func (s *Service) GetProjectUsage(ctx context.Context, projectID uuid.UUID, sinc
 		return nil, Error.Wrap(err)
 	}
 
-	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
 	if err != nil {
 		return nil, ErrUnauthorized.Wrap(err)
 	}
 
+	if err = s.checkPermission(ctx, isMember, PermissionViewUsage, "view project usage"); err != nil {
+		return nil, err
+	}
+
 	projectUsage, err := s.projectAccounting.GetProjectTotal(ctx, projectID, since, before)
 	if err != nil {
 		return nil, Error.Wrap(err)
@@ -5864,6 +5976,10 @@ func (s *Service) GetBucketTotals(ctx context.Context, projectID uuid.UUID, curs
 		return nil, ErrUnauthorized.Wrap(err)
 	}
 
+	if err = s.checkPermission(ctx, isMember, PermissionViewUsage, "view project usage"); err != nil {
+		return nil, err
+	}
+
 	usage, err := s.projectAccounting.GetBucketTotals(ctx, isMember.project.ID, cursor, since, before)
 	if err != nil {
 		return nil, Error.Wrap(err)
@@ -5895,6 +6011,10 @@ func (s *Service) GetSingleBucketTotals(ctx context.Context, projectID uuid.UUID
 		return nil, ErrUnauthorized.Wrap(err)
 	}
 
+	if err = s.checkPermission(ctx, isMember, PermissionViewUsage, "view project usage"); err != nil {
+		return nil, err
+	}
+
 	usage, err := s.projectAccounting.GetSingleBucketTotals(ctx, isMember.project.ID, bucketName, before)
 	if err != nil {
 		return nil, Error.Wrap(err)
@@ -5905,6 +6025,78 @@ func (s *Service) GetSingleBucketTotals(ctx context.Context, projectID uuid.UUID
 	return usage, nil
 }
 
+// GetBucketLimits retrieves the user-specified limits of a single bucket.
+func (s *Service) GetBucketLimits(ctx context.Context, projectID uuid.UUID, bucketName string) (_ buckets.Limits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get bucket limits", zap.String("project_id", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return buckets.Limits{}, ErrUnauthorized.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return buckets.Limits{}, ErrUnauthorized.Wrap(err)
+	}
+
+	exists, err := s.buckets.HasBucket(ctx, []byte(bucketName), isMember.project.ID)
+	if err != nil {
+		return buckets.Limits{}, Error.Wrap(err)
+	}
+	if !exists {
+		return buckets.Limits{}, ErrNotFound.New("bucket %q does not exist", bucketName)
+	}
+
+	limits, err := s.buckets.GetBucketLimits(ctx, []byte(bucketName), isMember.project.ID)
+	if err != nil {
+		return buckets.Limits{}, Error.Wrap(err)
+	}
+
+	return limits, nil
+}
+
+// UpdateBucketLimits sets the user-specified limits of a single bucket.
+// A nil limit removes it. Only the project owner or an admin may update bucket limits.
+func (s *Service) UpdateBucketLimits(ctx context.Context, projectID uuid.UUID, bucketName string, limits buckets.Limits) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "update bucket limits", zap.String("project_id", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+	project := isMember.project
+
+	if err = s.checkPermission(ctx, isMember, PermissionManageBuckets, "update bucket limits"); err != nil {
+		return err
+	}
+
+	for _, limit := range []*int64{limits.Storage, limits.Bandwidth, limits.Segments} {
+		if limit != nil && *limit < 0 {
+			return ErrValidation.New("bucket limits must not be negative")
+		}
+	}
+
+	exists, err := s.buckets.HasBucket(ctx, []byte(bucketName), project.ID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !exists {
+		return ErrNotFound.New("bucket %q does not exist", bucketName)
+	}
+
+	err = s.buckets.UpdateBucketLimits(ctx, []byte(bucketName), project.ID, limits)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
 // GetAllBucketNames retrieves all bucket names of a specific project.
 // projectID here may be Project.ID or Project.PublicID.
 func (s *Service) GetAllBucketNames(ctx context.Context, projectID uuid.UUID) (_ []string, err error) {
@@ -6321,6 +6513,17 @@ func (s *Service) GenGetBucketUsageRollups(ctx context.Context, reqProjectID uui
 		}
 	}
 
+	if err = s.checkPermission(ctx, isMember, PermissionViewUsage, "view project usage"); err != nil {
+		status := http.StatusInternalServerError
+		if ErrForbidden.Has(err) {
+			status = http.StatusForbidden
+		}
+		return nil, api.HTTPError{
+			Status: status,
+			Err:    err,
+		}
+	}
+
 	projectID := isMember.project.ID
 
 	rollups, err = s.projectAccounting.GetBucketUsageRollups(ctx, projectID, since, before, false)
@@ -6362,6 +6565,17 @@ func (s *Service) GenGetSingleBucketUsageRollup(ctx context.Context, reqProjectI
 		}
 	}
 
+	if err = s.checkPermission(ctx, isMember, PermissionViewUsage, "view project usage"); err != nil {
+		status := http.StatusInternalServerError
+		if ErrForbidden.Has(err) {
+			status = http.StatusForbidden
+		}
+		return nil, api.HTTPError{
+			Status: status,
+			Err:    err,
+		}
+	}
+
 	projectID := isMember.project.ID
 
 	rollup, err = s.projectAccounting.GetSingleBucketUsageRollup(ctx, projectID, bucket, since, before)
@@ -6393,6 +6607,10 @@ func (s *Service) GetDailyProjectUsage(ctx context.Context, projectID uuid.UUID,
 		return nil, ErrUnauthorized.Wrap(err)
 	}
 
+	if err = s.checkPermission(ctx, isMember, PermissionViewUsage, "view project usage"); err != nil {
+		return nil, err
+	}
+
 	usage, err := s.projectAccounting.GetProjectDailyUsageByDateRange(ctx, isMember.project.ID, from, to, s.config.AsOfSystemTimeDuration)
 	if err != nil {
 		return nil, Error.Wrap(err)
@@ -6418,6 +6636,10 @@ func (s *Service) GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID
 		return nil, ErrUnauthorized.Wrap(err)
 	}
 
+	if err = s.checkPermission(ctx, isMember, PermissionViewUsage, "view project usage"); err != nil {
+		return nil, err
+	}
+
 	prUsageLimits, err := s.getProjectUsageLimits(ctx, isMember.project.ID, false)
 	if err != nil {
 		return nil, Error.Wrap(err)
@@ -6804,6 +7026,17 @@ func (s *Service) isProjectMember(ctx context.Context, userID uuid.UUID, project
 		}, nil
 	}
 
+	orgMembership, err := s.organizationAdminMembership(ctx, userID, project.ID)
+	if err != nil {
+		return isProjectMember{}, err
+	}
+	if orgMembership != nil {
+		return isProjectMember{
+			project:    project,
+			membership: orgMembership,
+		}, nil
+	}
+
 	return isProjectMember{}, ErrNoMembership.New(unauthorizedErrMsg)
 }
 
@@ -7753,8 +7986,8 @@ func (s *Service) inviteProjectMembers(ctx context.Context, sender *User, projec
 		return nil, ErrUnauthorized.Wrap(err)
 	}
 
-	if isMember.membership.Role != RoleAdmin {
-		return nil, ErrForbidden.New("only project Owner or Admin can invite other members")
+	if err = s.checkPermission(ctx, isMember, PermissionManageMembers, "invite other members"); err != nil {
+		return nil, err
 	}
 
 	projectID = isMember.project.ID
@@ -7990,8 +8223,8 @@ func (s *Service) GetInviteLink(ctx context.Context, publicProjectID uuid.UUID,
 		return "", ErrUnauthorized.Wrap(err)
 	}
 
-	if isMember.membership.Role != RoleAdmin {
-		return "", ErrForbidden.New("only project Owner or Admin can get an invite link")
+	if err = s.checkPermission(ctx, isMember, PermissionManageMembers, "get an invite link"); err != nil {
+		return "", err
 	}
 
 	invite, err := s.store.ProjectInvitations().Get(ctx, isMember.project.ID, email)
@@ -16,6 +16,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/console/consoleauth/webauthn"
 )
 
 // Users exposes methods to manage User table in database.
@@ -47,6 +48,9 @@ type Users interface {
 	// GetByExternalID is a method for querying user by external ID from the database.
 	// If tenantID is non-nil and non-empty, only users with a matching tenantID are returned.
 	GetByExternalID(ctx context.Context, externalID string, tenantID *string) (user *User, err error)
+	// GetIDsByExternalIDPrefix returns the IDs of the users, whose external ID starts with the prefix,
+	// ordered by email, and their total count. Deleted users and users pending deletion are skipped.
+	GetIDsByExternalIDPrefix(ctx context.Context, prefix string, tenantID *string, offset, limit int) (ids []uuid.UUID, total int, err error)
 	// GetByStatus is a method for querying user by status from the database.
 	GetByStatus(ctx context.Context, status UserStatus, cursor UserCursor) (*UsersPage, error)
 	// GetUserInfoByProjectID gets the user info of the project (id) owner.
@@ -229,6 +233,11 @@ type AuthUser struct {
 	IP                 string `json:"-"`
 	UserAgent          string `json:"-"`
 	AnonymousID        string `json:"-"`
+
+	// WebAuthnToken and WebAuthnAssertion complete the WebAuthn ceremony
+	// started when the second factor was requested.
+	WebAuthnToken     string                      `json:"webauthnToken"`
+	WebAuthnAssertion *webauthn.AssertionResponse `json:"webauthnAssertion"`
 }
 
 // TokenInfo holds info for user authentication token responses.
@@ -255,11 +264,13 @@ const (
 	PendingBotVerification UserStatus = 5
 	// UserRequestedDeletion is a status that user receives after account owner completed delete account flow.
 	UserRequestedDeletion UserStatus = 6
+	// Deactivated is a status that user receives when deprovisioned by their identity provider.
+	Deactivated UserStatus = 7
 
 	// UserStatusCount indicates how many user status are currently supported.
 	// It is mainly used as a control that some UserStatus tests are updated when when the UserStatus
 	// valid values defined in this const block are updated.
-	UserStatusCount = 7
+	UserStatusCount = 8
 )
 
 // UserKind - is used to indicate kind of the user's account.
@@ -317,7 +328,7 @@ func (k UserKind) Info() KindInfo {
 }
 
 // UserStatuses holds all supported user statuses.
-var UserStatuses = []UserStatus{Inactive, Active, Deleted, PendingDeletion, LegalHold, PendingBotVerification, UserRequestedDeletion}
+var UserStatuses = []UserStatus{Inactive, Active, Deleted, PendingDeletion, LegalHold, PendingBotVerification, UserRequestedDeletion, Deactivated}
 
 // String returns a string representation of the user status.
 func (s *UserStatus) String() string {
@@ -336,6 +347,8 @@ func (s *UserStatus) String() string {
 		return "Pending Bot Verification"
 	case UserRequestedDeletion:
 		return "User Requested Deletion"
+	case Deactivated:
+		return "Deactivated"
 	default:
 		return ""
 	}
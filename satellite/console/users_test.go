@@ -799,6 +799,11 @@ func TestUserStatus(t *testing.T) {
 				isValid:  true,
 				expected: console.UserRequestedDeletion,
 			},
+			{
+				status:   "deactivated",
+				isValid:  true,
+				expected: console.Deactivated,
+			},
 			{
 				status:  "does not exists this status",
 				isValid: false,
@@ -0,0 +1,633 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/console/consoleauth/webauthn"
+)
+
+var (
+	// ErrNoWebAuthnCredential is used to indicate that the WebAuthn credential doesn't exist.
+	ErrNoWebAuthnCredential = errs.Class("webauthn credential doesn't exist")
+
+	// ErrWebAuthnCredentialAlreadyExists is used to indicate that the WebAuthn credential is already registered.
+	ErrWebAuthnCredentialAlreadyExists = errs.Class("webauthn credential already exists")
+
+	// ErrWebAuthnChallengeUsed is used to indicate that the challenge of a WebAuthn ceremony was already used.
+	ErrWebAuthnChallengeUsed = errs.Class("webauthn challenge already used")
+
+	// ErrWebAuthn is error type that represents a WebAuthn ceremony which couldn't be verified.
+	ErrWebAuthn = errs.Class("WebAuthn")
+)
+
+const (
+	webAuthnInvalidErrMsg  = "The security key or passkey could not be verified"
+	webAuthnDisabledErrMsg = "Security keys and passkeys are not enabled"
+)
+
+// Purposes of the WebAuthn ceremony tokens.
+const (
+	webAuthnPurposeRegister = "register"
+	webAuthnPurposeMFA      = "mfa"
+	webAuthnPurposeLogin    = "login"
+)
+
+// WebAuthnCredentials exposes methods to manage the security keys and passkeys of users.
+//
+// architecture: Database
+type WebAuthnCredentials interface {
+	// Insert inserts a credential into the database.
+	Insert(ctx context.Context, credential WebAuthnCredential) (*WebAuthnCredential, error)
+	// GetByUserID returns the credentials of the user ordered by creation date.
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]WebAuthnCredential, error)
+	// GetByCredentialID returns the credential by the ID assigned by its authenticator.
+	GetByCredentialID(ctx context.Context, credentialID []byte) (*WebAuthnCredential, error)
+	// UpdateName renames the credential of the user.
+	UpdateName(ctx context.Context, userID, id uuid.UUID, name string) error
+	// UpdateUsage updates the signature counter and the last usage time of the credential.
+	UpdateUsage(ctx context.Context, id uuid.UUID, signCount uint32, usedAt time.Time) error
+	// Delete deletes the credential of the user.
+	Delete(ctx context.Context, userID, id uuid.UUID) error
+	// DeleteByUserID deletes all credentials of the user and returns how many were deleted.
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
+	// UseChallenge records the challenge of a finished ceremony until the expiration of its token.
+	// It returns ErrWebAuthnChallengeUsed when the challenge was already used.
+	UseChallenge(ctx context.Context, challenge []byte, expiration time.Time) error
+	// DeleteExpiredChallenges deletes the used challenges, which expired before the time.
+	DeleteExpiredChallenges(ctx context.Context, before time.Time) (int64, error)
+}
+
+// WebAuthnCredential is a security key or passkey of a user.
+type WebAuthnCredential struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"-"`
+	// CredentialID is the ID assigned to the credential by its authenticator.
+	CredentialID []byte `json:"-"`
+	// PublicKey is the COSE encoded public key of the credential.
+	PublicKey  []byte     `json:"-"`
+	SignCount  uint32     `json:"-"`
+	Transports []string   `json:"-"`
+	Name       string     `json:"name"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+}
+
+// WebAuthnLogin holds the data of a passwordless login.
+type WebAuthnLogin struct {
+	Token              string                     `json:"token"`
+	Assertion          webauthn.AssertionResponse `json:"assertion"`
+	RememberForOneWeek bool                       `json:"rememberForOneWeek"`
+	IP                 string                     `json:"-"`
+	UserAgent          string                     `json:"-"`
+	AnonymousID        string                     `json:"-"`
+}
+
+// WebAuthnChallenge is a WebAuthn ceremony started by the satellite, which
+// the client completes by sending the assertion together with the token.
+type WebAuthnChallenge struct {
+	Options webauthn.RequestOptions `json:"options"`
+	Token   string                  `json:"token"`
+}
+
+// WebAuthnMFARequiredError is returned wrapped in ErrMFAMissing when the user may
+// complete the login with one of their security keys or passkeys.
+type WebAuthnMFARequiredError struct {
+	Challenge WebAuthnChallenge
+}
+
+// Error implements error.
+func (e *WebAuthnMFARequiredError) Error() string {
+	return "a security key or passkey may be used as second factor"
+}
+
+// webAuthnCeremony is the signed state of a started WebAuthn ceremony.
+type webAuthnCeremony struct {
+	Purpose    string     `json:"purpose"`
+	UserID     *uuid.UUID `json:"userId,omitempty"`
+	Challenge  []byte     `json:"challenge"`
+	Expiration time.Time  `json:"expires"`
+}
+
+// BeginWebAuthnRegistration starts the registration of a security key or passkey
+// for the user. It returns the options of the ceremony and the token completing it.
+func (s *Service) BeginWebAuthnRegistration(ctx context.Context) (_ *webauthn.CreationOptions, token string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = s.checkWebAuthnEnabled(false); err != nil {
+		return nil, "", err
+	}
+
+	user, err := s.getUserAndAuditLog(ctx, "begin webauthn registration")
+	if err != nil {
+		return nil, "", Error.Wrap(err)
+	}
+
+	credentials, err := s.store.WebAuthnCredentials().GetByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, "", Error.Wrap(err)
+	}
+	if len(credentials) >= s.config.WebAuthn.MaxCredentials {
+		return nil, "", ErrValidation.New("a maximum of %d security keys and passkeys can be registered", s.config.WebAuthn.MaxCredentials)
+	}
+
+	challenge, token, err := s.newWebAuthnCeremony(webAuthnPurposeRegister, &user.ID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	options := s.webAuthnRelyingParty(ctx).CreationOptions(challenge, webauthn.UserEntity{
+		ID:          user.ID.Bytes(),
+		Name:        user.Email,
+		DisplayName: user.FullName,
+	}, webAuthnDescriptors(credentials), s.config.WebAuthn.Timeout)
+
+	return &options, token, nil
+}
+
+// FinishWebAuthnRegistration verifies the response of the registration ceremony
+// started with the token and stores the security key or passkey with the name.
+func (s *Service) FinishWebAuthnRegistration(ctx context.Context, token, name string, response webauthn.AttestationResponse) (_ *WebAuthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = s.checkWebAuthnEnabled(false); err != nil {
+		return nil, err
+	}
+
+	user, err := s.getUserAndAuditLog(ctx, "finish webauthn registration")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	name, err = s.validateWebAuthnCredentialName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	ceremony, err := s.parseWebAuthnCeremony(ctx, token, webAuthnPurposeRegister)
+	if err != nil {
+		return nil, err
+	}
+	if ceremony.UserID == nil || *ceremony.UserID != user.ID {
+		return nil, ErrWebAuthn.New(webAuthnInvalidErrMsg)
+	}
+
+	verified, err := s.webAuthnRelyingParty(ctx).VerifyRegistration(ceremony.Challenge, response)
+	if err != nil {
+		mon.Counter("webauthn_registration_failure").Inc(1)
+		s.auditLog(ctx, "webauthn registration: failed verification", &user.ID, user.Email, zap.Error(err))
+		return nil, ErrWebAuthn.New(webAuthnInvalidErrMsg)
+	}
+
+	_, err = s.store.WebAuthnCredentials().GetByCredentialID(ctx, verified.ID)
+	if err == nil {
+		return nil, ErrConflict.New("this security key or passkey is already registered")
+	} else if !ErrNoWebAuthnCredential.Has(err) {
+		return nil, Error.Wrap(err)
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	credential, err := s.store.WebAuthnCredentials().Insert(ctx, WebAuthnCredential{
+		ID:           id,
+		UserID:       user.ID,
+		CredentialID: verified.ID,
+		PublicKey:    verified.PublicKey,
+		SignCount:    verified.SignCount,
+		Transports:   verified.Transports,
+		Name:         name,
+	})
+	if err != nil {
+		if ErrWebAuthnCredentialAlreadyExists.Has(err) {
+			return nil, ErrConflict.New("this security key or passkey is already registered")
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	mon.Counter("webauthn_registration_success").Inc(1)
+	s.auditLog(ctx, "webauthn registration: success", &user.ID, user.Email, zap.String("credential_id", credential.ID.String()))
+
+	return credential, nil
+}
+
+// GetWebAuthnCredentials returns the security keys and passkeys of the user.
+func (s *Service) GetWebAuthnCredentials(ctx context.Context) (_ []WebAuthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get webauthn credentials")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	credentials, err := s.store.WebAuthnCredentials().GetByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return credentials, nil
+}
+
+// RenameWebAuthnCredential renames the security key or passkey of the user.
+func (s *Service) RenameWebAuthnCredential(ctx context.Context, id uuid.UUID, name string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "rename webauthn credential", zap.String("credential_id", id.String()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	name, err = s.validateWebAuthnCredentialName(name)
+	if err != nil {
+		return err
+	}
+
+	err = s.store.WebAuthnCredentials().UpdateName(ctx, user.ID, id, name)
+	if err != nil {
+		if ErrNoWebAuthnCredential.Has(err) {
+			return ErrNotFound.New("security key or passkey not found")
+		}
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// DeleteWebAuthnCredential deletes the security key or passkey of the user.
+func (s *Service) DeleteWebAuthnCredential(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if s.config.AuthMigrationModeEnabled {
+		return ErrForbidden.New("this feature is temporarily unavailable during authentication system migration")
+	}
+
+	user, err := s.getUserAndAuditLog(ctx, "delete webauthn credential", zap.String("credential_id", id.String()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = s.store.WebAuthnCredentials().Delete(ctx, user.ID, id)
+	if err != nil {
+		if ErrNoWebAuthnCredential.Has(err) {
+			return ErrNotFound.New("security key or passkey not found")
+		}
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// BeginWebAuthnLogin starts a passwordless login, which the user completes
+// with any passkey registered for this satellite.
+func (s *Service) BeginWebAuthnLogin(ctx context.Context) (_ *WebAuthnChallenge, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = s.checkWebAuthnEnabled(true); err != nil {
+		return nil, err
+	}
+
+	challenge, token, err := s.newWebAuthnCeremony(webAuthnPurposeLogin, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WebAuthnChallenge{
+		Options: s.webAuthnRelyingParty(ctx).RequestOptions(challenge, nil, webauthn.UserVerificationRequired, s.config.WebAuthn.Timeout),
+		Token:   token,
+	}, nil
+}
+
+// TokenByWebAuthn authenticates the user by a passkey and returns a session token.
+// Passkeys verify the user themselves, so no other factor is required.
+func (s *Service) TokenByWebAuthn(ctx context.Context, request WebAuthnLogin) (response *TokenInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	mon.Counter("login_webauthn_attempt").Inc(1)
+
+	if err = s.checkWebAuthnEnabled(true); err != nil {
+		return nil, err
+	}
+
+	ceremony, err := s.parseWebAuthnCeremony(ctx, request.Token, webAuthnPurposeLogin)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := s.store.WebAuthnCredentials().GetByCredentialID(ctx, request.Assertion.RawID)
+	if err != nil {
+		if ErrNoWebAuthnCredential.Has(err) {
+			mon.Counter("login_webauthn_unknown_credential").Inc(1)
+			s.auditLog(ctx, "login: failed unknown webauthn credential", nil, "")
+			return nil, ErrLoginCredentials.New(credentialsErrMsg)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	user, err := s.store.Users().Get(ctx, credential.UserID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	tenantID := tenantIDFromContext(ctx)
+	if (tenantID == nil) != (user.TenantID == nil) || (tenantID != nil && *tenantID != *user.TenantID) {
+		s.auditLog(ctx, "login: failed webauthn tenant mismatch", &user.ID, user.Email)
+		return nil, ErrLoginCredentials.New(credentialsErrMsg)
+	}
+
+	switch user.Status {
+	case Active:
+	case PendingBotVerification, LegalHold, Deactivated:
+		return nil, ErrLoginRestricted.New("")
+	default:
+		s.auditLog(ctx, "login: failed webauthn user not active", &user.ID, user.Email)
+		return nil, ErrLoginCredentials.New(credentialsErrMsg)
+	}
+
+	if user.LoginLockoutExpiration.After(s.nowFn()) {
+		mon.Counter("login_locked_out").Inc(1)
+		s.auditLog(ctx, "login: failed account locked out", &user.ID, user.Email)
+		return nil, ErrLoginCredentials.New(credentialsErrMsg)
+	}
+
+	if s.ShouldRequireSsoByUser(user) {
+		s.auditLog(ctx, "login: attempted sso bypass", &user.ID, user.Email)
+		return nil, ErrSsoUserRestricted.New(credentialsErrMsg)
+	}
+
+	// the user handle of discoverable credentials must belong to the credential's owner.
+	if len(request.Assertion.Response.UserHandle) > 0 && !bytes.Equal(request.Assertion.Response.UserHandle, user.ID.Bytes()) {
+		err = s.handleLogInLockAccount(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+		s.auditLog(ctx, "login: failed webauthn user handle mismatch", &user.ID, user.Email)
+		return nil, ErrLoginCredentials.New(credentialsErrMsg)
+	}
+
+	err = s.verifyWebAuthnAssertion(ctx, user, credential, ceremony.Challenge, request.Assertion, true)
+	if err != nil {
+		if ErrWebAuthn.Has(err) {
+			return nil, ErrLoginCredentials.New(credentialsErrMsg)
+		}
+		return nil, err
+	}
+
+	if user.FailedLoginCount != 0 {
+		err = s.ResetAccountLock(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var customDurationPtr *time.Duration
+	if request.RememberForOneWeek {
+		weekDuration := 7 * 24 * time.Hour
+		customDurationPtr = &weekDuration
+	}
+	response, err = s.GenerateSessionToken(ctx, SessionTokenRequest{
+		UserID:          user.ID,
+		TenantID:        user.TenantID,
+		Email:           user.Email,
+		IP:              request.IP,
+		UserAgent:       request.UserAgent,
+		AnonymousID:     request.AnonymousID,
+		CustomDuration:  customDurationPtr,
+		HubspotObjectID: user.HubspotObjectID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mon.Counter("login_webauthn_success").Inc(1)
+	s.auditLog(ctx, "login: webauthn success", &user.ID, user.Email)
+
+	return response, nil
+}
+
+// getWebAuthnCredentialsForLogin returns the credentials the user can use as second factor.
+// No credentials are returned when WebAuthn is disabled.
+func (s *Service) getWebAuthnCredentialsForLogin(ctx context.Context, user *User) ([]WebAuthnCredential, error) {
+	if !s.config.WebAuthn.Enabled {
+		return nil, nil
+	}
+	credentials, err := s.store.WebAuthnCredentials().GetByUserID(ctx, user.ID)
+	return credentials, Error.Wrap(err)
+}
+
+// newWebAuthnMFARequiredError starts the WebAuthn ceremony completing the login of the user.
+func (s *Service) newWebAuthnMFARequiredError(ctx context.Context, user *User, credentials []WebAuthnCredential) (*WebAuthnMFARequiredError, error) {
+	challenge, token, err := s.newWebAuthnCeremony(webAuthnPurposeMFA, &user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WebAuthnMFARequiredError{Challenge: WebAuthnChallenge{
+		Options: s.webAuthnRelyingParty(ctx).RequestOptions(challenge, webAuthnDescriptors(credentials), webauthn.UserVerificationPreferred, s.config.WebAuthn.Timeout),
+		Token:   token,
+	}}, nil
+}
+
+// logInVerifyWebAuthn verifies the security key or passkey used as second factor of the login.
+func (s *Service) logInVerifyWebAuthn(ctx context.Context, user *User, credentials []WebAuthnCredential, request AuthUser) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ceremony, err := s.parseWebAuthnCeremony(ctx, request.WebAuthnToken, webAuthnPurposeMFA)
+	if err == nil && (ceremony.UserID == nil || *ceremony.UserID != user.ID) {
+		err = ErrWebAuthn.New(webAuthnInvalidErrMsg)
+	}
+	if err != nil {
+		if lockErr := s.handleLogInLockAccount(ctx, user); lockErr != nil {
+			return lockErr
+		}
+		mon.Counter("login_mfa_webauthn_failure").Inc(1)
+		s.auditLog(ctx, "login: failed mfa webauthn token invalid", &user.ID, user.Email)
+		return err
+	}
+
+	var credential *WebAuthnCredential
+	for i := range credentials {
+		if bytes.Equal(credentials[i].CredentialID, request.WebAuthnAssertion.RawID) {
+			credential = &credentials[i]
+			break
+		}
+	}
+	if credential == nil {
+		if err = s.handleLogInLockAccount(ctx, user); err != nil {
+			return err
+		}
+		mon.Counter("login_mfa_webauthn_failure").Inc(1)
+		s.auditLog(ctx, "login: failed mfa webauthn unknown credential", &user.ID, user.Email)
+		return ErrWebAuthn.New(webAuthnInvalidErrMsg)
+	}
+
+	err = s.verifyWebAuthnAssertion(ctx, user, credential, ceremony.Challenge, *request.WebAuthnAssertion, false)
+	if err != nil {
+		if ErrWebAuthn.Has(err) {
+			mon.Counter("login_mfa_webauthn_failure").Inc(1)
+		}
+		return err
+	}
+
+	mon.Counter("login_mfa_webauthn_success").Inc(1)
+	return nil
+}
+
+// verifyWebAuthnAssertion verifies the assertion of the credential and records its usage.
+// Failed verifications count towards the failed login lockout of the user.
+func (s *Service) verifyWebAuthnAssertion(ctx context.Context, user *User, credential *WebAuthnCredential, challenge []byte, assertion webauthn.AssertionResponse, requireUserVerification bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	signCount, err := s.webAuthnRelyingParty(ctx).VerifyAssertion(challenge, credential.PublicKey, credential.SignCount, assertion, requireUserVerification)
+	if err != nil {
+		if lockErr := s.handleLogInLockAccount(ctx, user); lockErr != nil {
+			return lockErr
+		}
+		s.auditLog(ctx, "login: failed webauthn assertion invalid", &user.ID, user.Email,
+			zap.String("credential_id", credential.ID.String()), zap.Error(err))
+		return ErrWebAuthn.New(webAuthnInvalidErrMsg)
+	}
+
+	err = s.store.WebAuthnCredentials().UpdateUsage(ctx, credential.ID, signCount, s.nowFn())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// webAuthnRelyingParty returns the relying party, which defaults to the satellite's external address.
+func (s *Service) webAuthnRelyingParty(ctx context.Context) webauthn.RelyingParty {
+	rp := webauthn.RelyingParty{
+		ID:      s.config.WebAuthn.RPID,
+		Name:    s.config.WebAuthn.RPName,
+		Origins: s.config.WebAuthn.Origins,
+	}
+
+	if rp.ID == "" || len(rp.Origins) == 0 {
+		if address, err := url.Parse(s.getSatelliteAddress(ctx)); err == nil && address.Host != "" {
+			if rp.ID == "" {
+				rp.ID = address.Hostname()
+			}
+			if len(rp.Origins) == 0 {
+				rp.Origins = []string{address.Scheme + "://" + address.Host}
+			}
+		}
+	}
+
+	return rp
+}
+
+func (s *Service) checkWebAuthnEnabled(passwordless bool) error {
+	if !s.config.WebAuthn.Enabled || (passwordless && !s.config.WebAuthn.PasswordlessEnabled) {
+		return ErrForbidden.New(webAuthnDisabledErrMsg)
+	}
+	return nil
+}
+
+func (s *Service) validateWebAuthnCredentialName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", ErrValidation.New("name is required")
+	}
+	if len([]rune(name)) > s.config.MaxNameCharacters {
+		return "", ErrValidation.New("name must not exceed %d characters", s.config.MaxNameCharacters)
+	}
+	return name, nil
+}
+
+// newWebAuthnCeremony returns the challenge of a new ceremony and the signed token holding its state.
+func (s *Service) newWebAuthnCeremony(purpose string, userID *uuid.UUID) (challenge []byte, token string, err error) {
+	challenge, err = webauthn.NewChallenge()
+	if err != nil {
+		return nil, "", Error.Wrap(err)
+	}
+
+	payload, err := json.Marshal(webAuthnCeremony{
+		Purpose:    purpose,
+		UserID:     userID,
+		Challenge:  challenge,
+		Expiration: s.nowFn().Add(s.config.WebAuthn.Timeout),
+	})
+	if err != nil {
+		return nil, "", Error.Wrap(err)
+	}
+
+	signed := consoleauth.Token{Payload: payload}
+	signed.Signature, err = s.tokens.SignToken(signed)
+	if err != nil {
+		return nil, "", Error.Wrap(err)
+	}
+
+	return challenge, signed.String(), nil
+}
+
+// parseWebAuthnCeremony returns the state of the ceremony of the token. The token
+// can be used only once, so a ceremony can't be completed again with a replayed response.
+func (s *Service) parseWebAuthnCeremony(ctx context.Context, token, purpose string) (*webAuthnCeremony, error) {
+	parsed, err := consoleauth.FromBase64URLString(token)
+	if err != nil {
+		return nil, ErrWebAuthn.New(webAuthnInvalidErrMsg)
+	}
+
+	valid, err := s.tokens.ValidateToken(parsed)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if !valid {
+		return nil, ErrWebAuthn.New(webAuthnInvalidErrMsg)
+	}
+
+	var ceremony webAuthnCeremony
+	if err = json.Unmarshal(parsed.Payload, &ceremony); err != nil {
+		return nil, ErrWebAuthn.New(webAuthnInvalidErrMsg)
+	}
+	if ceremony.Purpose != purpose {
+		return nil, ErrWebAuthn.New(webAuthnInvalidErrMsg)
+	}
+	if s.nowFn().After(ceremony.Expiration) {
+		return nil, ErrWebAuthn.New("the security key or passkey request has expired")
+	}
+
+	err = s.store.WebAuthnCredentials().UseChallenge(ctx, ceremony.Challenge, ceremony.Expiration)
+	if err != nil {
+		if ErrWebAuthnChallengeUsed.Has(err) {
+			mon.Counter("webauthn_challenge_reused").Inc(1)
+			return nil, ErrWebAuthn.New(webAuthnInvalidErrMsg)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return &ceremony, nil
+}
+
+func webAuthnDescriptors(credentials []WebAuthnCredential) []webauthn.CredentialDescriptor {
+	descriptors := make([]webauthn.CredentialDescriptor, 0, len(credentials))
+	for _, credential := range credentials {
+		descriptors = append(descriptors, webauthn.NewCredentialDescriptor(credential.CredentialID, credential.Transports))
+	}
+	return descriptors
+}
+
+// AsWebAuthnMFARequired returns the WebAuthn ceremony completing the login, if the error offers one.
+func AsWebAuthnMFARequired(err error) (*WebAuthnChallenge, bool) {
+	var required *WebAuthnMFARequiredError
+	if errors.As(err, &required) {
+		return &required.Challenge, true
+	}
+	return nil, false
+}
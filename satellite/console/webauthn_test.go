@@ -0,0 +1,175 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth/webauthn/webauthntest"
+)
+
+func TestWebAuthn(t *testing.T) {
+	const (
+		rpID   = "console.example.test"
+		origin = "https://console.example.test"
+	)
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.WebAuthn.Enabled = true
+				config.Console.WebAuthn.PasswordlessEnabled = true
+				config.Console.WebAuthn.RPID = rpID
+				config.Console.WebAuthn.Origins = []string{origin}
+				config.Console.WebAuthn.MaxCredentials = 2
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "WebAuthn User",
+			Email:    "webauthn@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		userCtx, err := sat.UserContext(ctx, user.ID)
+		require.NoError(t, err)
+
+		authenticator := webauthntest.New(t, rpID, origin)
+
+		var credential *console.WebAuthnCredential
+		t.Run("register", func(t *testing.T) {
+			options, token, err := service.BeginWebAuthnRegistration(userCtx)
+			require.NoError(t, err)
+			require.Equal(t, rpID, options.RP.ID)
+			require.Equal(t, user.ID.Bytes(), []byte(options.User.ID))
+			require.Empty(t, options.ExcludeCredentials)
+
+			response := authenticator.Register(t, options.Challenge)
+
+			_, err = service.FinishWebAuthnRegistration(userCtx, token, "", response)
+			require.True(t, console.ErrValidation.Has(err))
+
+			_, err = service.FinishWebAuthnRegistration(userCtx, "invalid", "YubiKey", response)
+			require.True(t, console.ErrWebAuthn.Has(err))
+
+			credential, err = service.FinishWebAuthnRegistration(userCtx, token, "YubiKey", response)
+			require.NoError(t, err)
+			require.Equal(t, "YubiKey", credential.Name)
+
+			// the ceremony can't be completed again.
+			_, err = service.FinishWebAuthnRegistration(userCtx, token, "Copy", response)
+			require.True(t, console.ErrWebAuthn.Has(err))
+
+			// the same authenticator can't be registered twice.
+			options, token, err = service.BeginWebAuthnRegistration(userCtx)
+			require.NoError(t, err)
+			require.Len(t, options.ExcludeCredentials, 1)
+
+			_, err = service.FinishWebAuthnRegistration(userCtx, token, "Again", authenticator.Register(t, options.Challenge))
+			require.True(t, console.ErrConflict.Has(err))
+		})
+
+		t.Run("manage", func(t *testing.T) {
+			require.NoError(t, service.RenameWebAuthnCredential(userCtx, credential.ID, "Backup key"))
+
+			credentials, err := service.GetWebAuthnCredentials(userCtx)
+			require.NoError(t, err)
+			require.Len(t, credentials, 1)
+			require.Equal(t, "Backup key", credentials[0].Name)
+		})
+
+		t.Run("second factor", func(t *testing.T) {
+			request := console.AuthUser{Email: user.Email, Password: user.FullName}
+
+			_, err := service.Token(ctx, request)
+			require.True(t, console.ErrMFAMissing.Has(err))
+			challenge, ok := console.AsWebAuthnMFARequired(err)
+			require.True(t, ok)
+			require.Len(t, challenge.Options.AllowCredentials, 1)
+
+			request.WebAuthnToken = challenge.Token
+			assertion := authenticator.Assert(t, challenge.Options.Challenge, nil)
+			request.WebAuthnAssertion = &assertion
+
+			request.MFAPasscode = "123456"
+			_, err = service.Token(ctx, request)
+			require.True(t, console.ErrMFAConflict.Has(err))
+			request.MFAPasscode = ""
+
+			tokenInfo, err := service.Token(ctx, request)
+			require.NoError(t, err)
+			require.NotEmpty(t, tokenInfo.Token)
+
+			// assertions can't be replayed.
+			_, err = service.Token(ctx, request)
+			require.Error(t, err)
+		})
+
+		t.Run("passwordless", func(t *testing.T) {
+			challenge, err := service.BeginWebAuthnLogin(ctx)
+			require.NoError(t, err)
+			require.Empty(t, challenge.Options.AllowCredentials)
+
+			// the token of a second factor challenge can't be used for passwordless logins.
+			_, err = service.Token(ctx, console.AuthUser{Email: user.Email, Password: user.FullName})
+			mfa, ok := console.AsWebAuthnMFARequired(err)
+			require.True(t, ok)
+			_, err = service.TokenByWebAuthn(ctx, console.WebAuthnLogin{
+				Token:     mfa.Token,
+				Assertion: authenticator.Assert(t, mfa.Options.Challenge, user.ID.Bytes()),
+			})
+			require.True(t, console.ErrWebAuthn.Has(err))
+
+			authenticator.UserVerified = false
+			_, err = service.TokenByWebAuthn(ctx, console.WebAuthnLogin{
+				Token:     challenge.Token,
+				Assertion: authenticator.Assert(t, challenge.Options.Challenge, user.ID.Bytes()),
+			})
+			require.True(t, console.ErrLoginCredentials.Has(err))
+			authenticator.UserVerified = true
+
+			// a failed ceremony can't be retried with the same token.
+			_, err = service.TokenByWebAuthn(ctx, console.WebAuthnLogin{
+				Token:     challenge.Token,
+				Assertion: authenticator.Assert(t, challenge.Options.Challenge, user.ID.Bytes()),
+			})
+			require.True(t, console.ErrWebAuthn.Has(err))
+
+			challenge, err = service.BeginWebAuthnLogin(ctx)
+			require.NoError(t, err)
+
+			login := console.WebAuthnLogin{
+				Token:     challenge.Token,
+				Assertion: authenticator.Assert(t, challenge.Options.Challenge, user.ID.Bytes()),
+			}
+			tokenInfo, err := service.TokenByWebAuthn(ctx, login)
+			require.NoError(t, err)
+			require.NotEmpty(t, tokenInfo.Token)
+
+			// the ceremony can't be completed again.
+			_, err = service.TokenByWebAuthn(ctx, login)
+			require.True(t, console.ErrWebAuthn.Has(err))
+		})
+
+		t.Run("delete", func(t *testing.T) {
+			require.NoError(t, service.DeleteWebAuthnCredential(userCtx, credential.ID))
+			require.True(t, console.ErrNotFound.Has(service.DeleteWebAuthnCredential(userCtx, credential.ID)))
+
+			// without credentials the password is enough again.
+			_, err := service.Token(ctx, console.AuthUser{Email: user.Email, Password: user.FullName})
+			require.NoError(t, err)
+		})
+	})
+}
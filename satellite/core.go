@@ -39,11 +39,14 @@ import (
 	"storj.io/storj/satellite/emission"
 	"storj.io/storj/satellite/entitlements"
 	"storj.io/storj/satellite/gc/sender"
+	"storj.io/storj/satellite/kms"
+	"storj.io/storj/satellite/kms/passphraserotation"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/mailservice/hubspotmails"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
+	"storj.io/storj/satellite/metainfo/lifecycledeletion"
 	"storj.io/storj/satellite/nodeevents"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/overlay/offlinenodes"
@@ -136,6 +139,10 @@ type Core struct {
 		Chore *zombiedeletion.Chore
 	}
 
+	LifecycleDeletion struct {
+		Chore *lifecycledeletion.Chore
+	}
+
 	Accounting struct {
 		Tally                 *tally.Service
 		Rollup                *rollup.Service
@@ -166,6 +173,11 @@ type Core struct {
 		PendingDeleteChore *pendingdelete.Chore
 	}
 
+	KeyManagement struct {
+		Service       *kms.Service
+		RotationChore *passphraserotation.Chore
+	}
+
 	GarbageCollection struct {
 		Sender *sender.Service
 	}
@@ -438,6 +450,22 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *metaba
 			debug.Cycle("Zombie Objects Chore", peer.ZombieDeletion.Chore.Loop))
 	}
 
+	{ // setup bucket lifecycle rules
+		peer.LifecycleDeletion.Chore = lifecycledeletion.NewChore(
+			peer.Log.Named("core-lifecycle-deletion"),
+			config.LifecycleDeletion,
+			peer.DB.Buckets(),
+			peer.Metainfo.Metabase,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "lifecycledeletion:chore",
+			Run:   peer.LifecycleDeletion.Chore.Run,
+			Close: peer.LifecycleDeletion.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Bucket Lifecycle Chore", peer.LifecycleDeletion.Chore.Loop))
+	}
+
 	{ // setup project limit events chore
 		peer.ProjectLimitEvents.DB = peer.DB.ProjectLimitEvents()
 		peer.ProjectLimitEvents.Chore = projectlimitevents.NewChore(
@@ -585,6 +613,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *metaba
 				BillingDB:            peer.DB.Billing(),
 				ProjectsDB:           peer.DB.Console().Projects(),
 				UsersDB:              peer.DB.Console().Users(),
+				OrganizationsDB:      peer.DB.Console().Organizations(),
 				UsageDB:              peer.DB.ProjectAccounting(),
 				RetentionRemainderDB: peer.DB.RetentionRemainderCharges(),
 				Analytics:            peer.Analytics.Service,
@@ -712,6 +741,30 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *metaba
 		})
 	}
 
+	{ // setup passphrase rotation
+		if config.KeyManagementRotation.Enabled && len(config.KeyManagement.KeyInfos.Values) > 0 {
+			peer.KeyManagement.Service = kms.NewService(config.KeyManagement)
+
+			peer.Services.Add(lifecycle.Item{
+				Name: "kms:service",
+				Run:  peer.KeyManagement.Service.Initialize,
+			})
+
+			peer.KeyManagement.RotationChore = passphraserotation.NewChore(
+				peer.Log.Named("kms.passphraserotation:chore"),
+				peer.KeyManagement.Service,
+				peer.DB.Console().Projects(),
+				config.KeyManagementRotation,
+			)
+
+			peer.Services.Add(lifecycle.Item{
+				Name:  "kms.passphraserotation:chore",
+				Run:   peer.KeyManagement.RotationChore.Run,
+				Close: peer.KeyManagement.RotationChore.Close,
+			})
+		}
+	}
+
 	{ // setup pending delete escalator
 		if config.PendingDeleteCleanup.Enabled {
 			var pendingDeleteRemainderRecorder *accounting.RemainderChargeRecorder
@@ -8,6 +8,7 @@ import (
 
 	"storj.io/common/memory"
 	"storj.io/common/storj"
+	"storj.io/storj/satellite/gc/packstore"
 )
 
 // Config contains configurable values for garbage collection.
@@ -23,11 +24,15 @@ type Config struct {
 	MaxBloomFilterSize   memory.Size `help:"maximum size of a single bloom filter" default:"2m"`
 	ExcludeExpiredPieces bool        `help:"do not include expired pieces into bloom filter" default:"true"`
 
+	BinaryFuseMinimumVersion string `help:"storage nodes running at least this version receive a binary fuse filter instead of a bloom filter when it has a lower false positive rate at the same size; it requires keeping 8 bytes per piece of these nodes in memory. Empty disables binary fuse filters" default:""`
+
 	AccessGrant  string        `help:"Access Grant which will be used to upload bloom filters to the bucket" default:""`
 	Bucket       string        `help:"Bucket which will be used to upload bloom filters" default:"" testDefault:"gc-queue"` // TODO do we need full location?
 	ZipBatchSize int           `help:"how many bloom filters will be packed in a single zip" default:"40" testDefault:"2"`
 	ExpireIn     time.Duration `help:"how long bloom filters will remain in the bucket for gc/sender to consume before being automatically deleted" default:"336h"`
 
+	PackStore packstore.Config
+
 	CollectNodesPieceIDs    storj.NodeIDList `help:"list of node IDs for which we will collect raw list of piece IDs" default:""`
 	NodesPieceIDsBufferSize int              `help:"buffer size of piece IDs before will be uploaded" default:"1000000" devDefault:"100"`
 	UploadPackConcurrency   int              `help:"number of concurrent zip compression and uploads of bloom filters" default:"4"`
@@ -17,6 +17,13 @@ segments can be processed in parallel to speed up process.
 The bloomfilter.Observer will send that requests to the Storj bucket after a full
 ranged loop iteration. After that bloom filters will be downloaded and sent
 to the storage nodes with separate service from storj/satellite/gc/sender package.
+Instead of a Storj bucket, the packs can be kept in a local directory or in an
+S3 compatible bucket, see storj/satellite/gc/packstore package.
+
+Storage nodes running at least BinaryFuseMinimumVersion can get a binary fuse
+filter instead of a bloom filter. It has a lower false positive rate at the same
+size, so less garbage remains on the node, but it can only be built after all
+the pieces of the node are known. Older nodes keep getting bloom filters.
 
 This bloom filter service should be run only against immutable database snapshot.
 
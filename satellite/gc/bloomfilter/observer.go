@@ -13,6 +13,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/storj"
+	"storj.io/common/version"
 	"storj.io/storj/satellite/metabase/rangedloop"
 	"storj.io/storj/shared/bloomfilter"
 	"storj.io/storj/shared/nodeidmap"
@@ -29,12 +30,19 @@ type TestingObserver interface {
 // Overlay minimal set of overlay functions that are needed for the observer.
 type Overlay interface {
 	ActiveNodesPieceCounts(ctx context.Context) (pieceCounts map[storj.NodeID]int64, err error)
+	ActiveNodesWithMinimumVersion(ctx context.Context, minimum version.SemVer) (nodeIDs storj.NodeIDList, err error)
 }
 
 // RetainInfo contains info needed for a storage node to retain important data and delete garbage data.
 type RetainInfo struct {
 	Filter *bloomfilter.Filter
 	Count  int
+
+	// Keys are collected instead of adding pieces to Filter for nodes which
+	// get a binary fuse filter, because it can only be built from all the keys.
+	Keys []uint64
+	// Size is the maximum size of the binary fuse filter.
+	Size int
 }
 
 // MinimalRetainInfoMap is what is exposed by the observer to the upload.
@@ -56,6 +64,7 @@ type Observer struct {
 	// The following fields are reset for each loop.
 	startTime       time.Time
 	lastPieceCounts map[storj.NodeID]int64
+	binaryFuseNodes map[storj.NodeID]struct{}
 	retainInfos     nodeidmap.Map[*RetainInfo]
 	creationTime    time.Time
 	seed            byte
@@ -98,8 +107,14 @@ func (obs *Observer) Start(ctx context.Context, startTime time.Time) (err error)
 		lastPieceCounts = make(map[storj.NodeID]int64)
 	}
 
+	binaryFuseNodes, err := loadBinaryFuseNodes(ctx, obs.log, obs.overlay, obs.config)
+	if err != nil {
+		return err
+	}
+
 	obs.startTime = startTime
 	obs.lastPieceCounts = lastPieceCounts
+	obs.binaryFuseNodes = binaryFuseNodes
 	obs.retainInfos = nodeidmap.MakeSized[*RetainInfo](len(lastPieceCounts))
 	obs.creationTime = time.Now()
 	obs.seed = bloomfilter.GenerateSeed()
@@ -110,7 +125,7 @@ func (obs *Observer) Start(ctx context.Context, startTime time.Time) (err error)
 func (obs *Observer) Fork(ctx context.Context) (_ rangedloop.Partial, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	return newObserverFork(obs.log.Named("gc observer"), obs.upload, obs.config, obs.lastPieceCounts, obs.seed, obs.startTime, obs.forcedTableSize), nil
+	return newObserverFork(obs.log.Named("gc observer"), obs.upload, obs.config, obs.lastPieceCounts, obs.binaryFuseNodes, obs.seed, obs.startTime, obs.forcedTableSize), nil
 }
 
 // Join merges the bloom filters gathered by each Partial.
@@ -126,8 +141,7 @@ func (obs *Observer) Join(ctx context.Context, partial rangedloop.Partial) (err
 	// Update the count and merge the bloom filters for each node.
 	obs.retainInfos.Add(pieceTracker.retainInfos,
 		func(old *RetainInfo, new *RetainInfo) *RetainInfo {
-			old.Count += new.Count
-			if err := old.Filter.AddFilter(new.Filter); err != nil {
+			if err := old.Merge(new); err != nil {
 				failures = append(failures, err)
 			}
 			return old
@@ -199,9 +213,10 @@ type observerFork struct {
 	identifier int
 	config     Config
 	// TODO: should we use int or int64 consistently for piece count (db type is int64)?
-	pieceCounts map[storj.NodeID]int64
-	seed        byte
-	startTime   time.Time
+	pieceCounts     map[storj.NodeID]int64
+	binaryFuseNodes map[storj.NodeID]struct{}
+	seed            byte
+	startTime       time.Time
 
 	retainInfos nodeidmap.Map[*RetainInfo]
 	// latestCreationTime will be used to set bloom filter CreationDate.
@@ -221,7 +236,7 @@ type observerFork struct {
 // newObserverFork instantiates a new observer fork to process different segment range.
 // The seed is passed so that it can be shared among all parallel forks.
 func newObserverFork(log *zap.Logger, upload *Upload, config Config, pieceCounts map[storj.NodeID]int64,
-	seed byte, startTime time.Time, forcedTableSize int) *observerFork {
+	binaryFuseNodes map[storj.NodeID]struct{}, seed byte, startTime time.Time, forcedTableSize int) *observerFork {
 
 	pieceIDs := nodeidmap.MakeSized[[]storj.PieceID](len(config.CollectNodesPieceIDs))
 	for _, nodeID := range config.CollectNodesPieceIDs {
@@ -234,6 +249,7 @@ func newObserverFork(log *zap.Logger, upload *Upload, config Config, pieceCounts
 		identifier:         rand.Int(),
 		config:             config,
 		pieceCounts:        pieceCounts,
+		binaryFuseNodes:    binaryFuseNodes,
 		seed:               seed,
 		startTime:          startTime,
 		forcedTableSize:    forcedTableSize,
@@ -297,21 +313,12 @@ func (fork *observerFork) add(nodeID storj.NodeID, pieceID storj.PieceID) {
 			return
 		}
 
-		hashCount, tableSize := bloomfilter.OptimalParameters(numPieces, fork.config.FalsePositiveRate, fork.config.MaxBloomFilterSize)
-		// limit size of bloom filter to ensure we are under the limit for RPC
-		if fork.forcedTableSize > 0 {
-			tableSize = fork.forcedTableSize
-		}
-
-		filter := bloomfilter.NewExplicit(fork.seed, hashCount, tableSize)
-		info = &RetainInfo{
-			Filter: filter,
-		}
+		_, binaryFuse := fork.binaryFuseNodes[nodeID]
+		info = newRetainInfo(fork.config, fork.seed, numPieces, fork.forcedTableSize, binaryFuse)
 		fork.retainInfos.Store(nodeID, info)
 	}
 
-	info.Filter.Add(pieceID)
-	info.Count++
+	info.Add(pieceID)
 }
 
 func (fork *observerFork) addPieceID(ctx context.Context, nodeID storj.NodeID, pieceID storj.PieceID) {
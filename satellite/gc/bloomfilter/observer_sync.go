@@ -27,6 +27,7 @@ type SyncObserver struct {
 	// The following fields are reset for each loop.
 	startTime       time.Time
 	lastPieceCounts map[storj.NodeID]int64
+	binaryFuseNodes map[storj.NodeID]struct{}
 	seed            byte
 
 	mu          sync.Mutex
@@ -74,8 +75,14 @@ func (obs *SyncObserver) Start(ctx context.Context, startTime time.Time) (err er
 		lastPieceCounts = make(map[storj.NodeID]int64)
 	}
 
+	binaryFuseNodes, err := loadBinaryFuseNodes(ctx, obs.log, obs.overlay, obs.config)
+	if err != nil {
+		return err
+	}
+
 	obs.startTime = startTime
 	obs.lastPieceCounts = lastPieceCounts
+	obs.binaryFuseNodes = binaryFuseNodes
 	obs.retainInfos = nodeidmap.MakeSized[*RetainInfo](len(lastPieceCounts))
 	obs.latestCreationTime = time.Time{}
 	obs.seed = bloomfilter.GenerateSeed()
@@ -180,18 +187,10 @@ func (obs *SyncObserver) add(nodeID storj.NodeID, pieceID storj.PieceID) {
 			return
 		}
 
-		hashCount, tableSize := bloomfilter.OptimalParameters(numPieces, obs.config.FalsePositiveRate, obs.config.MaxBloomFilterSize)
-		// limit size of bloom filter to ensure we are under the limit for RPC
-		if obs.forcedTableSize > 0 {
-			tableSize = obs.forcedTableSize
-		}
-		filter := bloomfilter.NewExplicit(obs.seed, hashCount, tableSize)
-		info = &RetainInfo{
-			Filter: filter,
-		}
+		_, binaryFuse := obs.binaryFuseNodes[nodeID]
+		info = newRetainInfo(obs.config, obs.seed, numPieces, obs.forcedTableSize, binaryFuse)
 		obs.retainInfos.Store(nodeID, info)
 	}
 
-	info.Filter.Add(pieceID)
-	info.Count++
+	info.Add(pieceID)
 }
@@ -74,6 +74,7 @@ type SyncObserverV2 struct {
 	// The following fields are reset for each loop.
 	startTime       time.Time
 	lastPieceCounts map[storj.NodeID]int64
+	binaryFuseNodes map[storj.NodeID]struct{}
 	seed            byte
 
 	inlineCount, remoteCount atomic.Uint64
@@ -118,8 +119,14 @@ func (observer *SyncObserverV2) Start(ctx context.Context, startTime time.Time)
 		lastPieceCounts = make(map[storj.NodeID]int64)
 	}
 
+	binaryFuseNodes, err := loadBinaryFuseNodes(ctx, observer.log, observer.overlay, observer.config)
+	if err != nil {
+		return err
+	}
+
 	observer.startTime = startTime
 	observer.lastPieceCounts = lastPieceCounts
+	observer.binaryFuseNodes = binaryFuseNodes
 	observer.retainInfos = &concurrentRetainInfos{}
 	observer.latestCreationTime = time.Time{}
 	observer.seed = bloomfilter.GenerateSeed()
@@ -227,18 +234,9 @@ func (observer *SyncObserverV2) add(nodeID storj.NodeID, pieceID storj.PieceID)
 			return
 		}
 
-		hashCount, tableSize := bloomfilter.OptimalParameters(numPieces, observer.config.FalsePositiveRate, observer.config.MaxBloomFilterSize)
-		// Limit the size of the bloom filter to ensure we are under the
-		// limit for RPC.
-		if observer.forcedTableSize > 0 {
-			tableSize = observer.forcedTableSize
-		}
-		filter := bloomfilter.NewExplicit(observer.seed, hashCount, tableSize)
-		cri.info = &RetainInfo{
-			Filter: filter,
-		}
+		_, binaryFuse := observer.binaryFuseNodes[nodeID]
+		cri.info = newRetainInfo(observer.config, observer.seed, numPieces, observer.forcedTableSize, binaryFuse)
 	}
 
-	cri.info.Filter.Add(pieceID)
-	cri.info.Count++
+	cri.info.Add(pieceID)
 }
@@ -24,6 +24,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/common/version"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/gc/bloomfilter"
@@ -32,6 +33,7 @@ import (
 	"storj.io/storj/satellite/metabase/rangedloop"
 	"storj.io/storj/satellite/metabase/rangedloop/rangedlooptest"
 	"storj.io/storj/satellite/overlay"
+	sharedbloomfilter "storj.io/storj/shared/bloomfilter"
 	"storj.io/uplink"
 )
 
@@ -424,9 +426,85 @@ func TestObserverGarbageCollection_CreationDate_MultipleSources(t *testing.T) {
 }
 
 type mockOverlay struct {
-	pieceCounts map[storj.NodeID]int64
+	pieceCounts     map[storj.NodeID]int64
+	binaryFuseNodes storj.NodeIDList
 }
 
 func (o *mockOverlay) ActiveNodesPieceCounts(ctx context.Context) (pieceCounts map[storj.NodeID]int64, err error) {
 	return o.pieceCounts, nil
 }
+
+func (o *mockOverlay) ActiveNodesWithMinimumVersion(ctx context.Context, minimum version.SemVer) (nodeIDs storj.NodeIDList, err error) {
+	return o.binaryFuseNodes, nil
+}
+
+func TestObserverGarbageCollection_BinaryFuse(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	const numSegments = 10000
+
+	newNode, oldNode := testrand.NodeID(), testrand.NodeID()
+	overlay := &mockOverlay{
+		pieceCounts: map[storj.NodeID]int64{
+			newNode: numSegments,
+			oldNode: numSegments,
+		},
+		binaryFuseNodes: storj.NodeIDList{newNode},
+	}
+
+	var segments []rangedloop.Segment
+	for i := 0; i < numSegments; i++ {
+		segments = append(segments, rangedloop.Segment{
+			RootPieceID: testrand.PieceID(),
+			Pieces: metabase.Pieces{
+				{Number: 0, StorageNode: newNode},
+				{Number: 1, StorageNode: oldNode},
+			},
+		})
+	}
+
+	config := bloomfilter.Config{
+		AccessGrant:              "test",
+		Bucket:                   "test",
+		FalsePositiveRate:        0.01,
+		MaxBloomFilterSize:       memory.MiB,
+		BinaryFuseMinimumVersion: "v1.0.0",
+	}
+
+	for _, observer := range []rangedloop.Observer{
+		bloomfilter.NewObserver(zaptest.NewLogger(t), config, overlay),
+		bloomfilter.NewSyncObserver(zaptest.NewLogger(t), config, overlay),
+		bloomfilter.NewSyncObserverV2(zaptest.NewLogger(t), config, overlay),
+	} {
+		rangedLoop := rangedloop.NewService(zap.NewNop(), rangedloop.Config{Parallelism: 2, BatchSize: 10},
+			&rangedlooptest.RangeSplitter{Segments: segments}, []rangedloop.Observer{observer})
+		_, err := rangedLoop.RunOnce(ctx)
+		require.NoError(t, err)
+
+		retainInfos := observer.(bloomfilter.TestingObserver).TestingRetainInfos()
+
+		newInfo, ok := retainInfos.Load(newNode)
+		require.True(t, ok)
+		require.Nil(t, newInfo.Filter)
+		require.Equal(t, numSegments, newInfo.Count)
+
+		oldInfo, ok := retainInfos.Load(oldNode)
+		require.True(t, ok)
+		require.NotNil(t, oldInfo.Filter)
+		require.Equal(t, numSegments, oldInfo.Count)
+
+		data, err := newInfo.FilterBytes()
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(data), int(oldInfo.Filter.Size()))
+
+		filter, err := sharedbloomfilter.Decode(data)
+		require.NoError(t, err)
+		require.IsType(t, &sharedbloomfilter.BinaryFuse{}, filter)
+
+		for _, segment := range segments {
+			require.True(t, filter.Contains(segment.RootPieceID.Derive(newNode, 0)))
+			require.True(t, oldInfo.Filter.Contains(segment.RootPieceID.Derive(oldNode, 1)))
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter
+
+import (
+	"context"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/version"
+	"storj.io/storj/shared/bloomfilter"
+)
+
+// loadBinaryFuseNodes returns the nodes which accept binary fuse filters.
+func loadBinaryFuseNodes(ctx context.Context, log *zap.Logger, overlay Overlay, config Config) (_ map[storj.NodeID]struct{}, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if config.BinaryFuseMinimumVersion == "" {
+		return nil, nil
+	}
+
+	minimum, err := version.NewSemVer(config.BinaryFuseMinimumVersion)
+	if err != nil {
+		return nil, errs.New("invalid binary fuse minimum version: %w", err)
+	}
+
+	nodeIDs, err := overlay.ActiveNodesWithMinimumVersion(ctx, minimum)
+	if err != nil {
+		log.Error("error getting nodes accepting binary fuse filters, only bloom filters will be created", zap.Error(err))
+		return nil, nil
+	}
+
+	nodes := make(map[storj.NodeID]struct{}, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		nodes[nodeID] = struct{}{}
+	}
+	return nodes, nil
+}
+
+// newRetainInfo creates a RetainInfo for a node with numPieces pieces. Nodes
+// which accept binary fuse filters get one when it has a lower false positive
+// rate than a bloom filter of the same size.
+func newRetainInfo(config Config, seed byte, numPieces int64, forcedTableSize int, binaryFuse bool) *RetainInfo {
+	hashCount, tableSize := bloomfilter.OptimalParameters(numPieces, config.FalsePositiveRate, config.MaxBloomFilterSize)
+	// limit size of bloom filter to ensure we are under the limit for RPC
+	if forcedTableSize > 0 {
+		tableSize = forcedTableSize
+	}
+
+	if binaryFuse {
+		fingerprintBits := bloomfilter.BinaryFuseFingerprintBits(numPieces, tableSize)
+		if fingerprintBits > 0 && bloomfilter.BinaryFuseFalsePositiveRate(fingerprintBits) < bloomfilter.ExpectedFalsePositiveRate(hashCount, tableSize, numPieces) {
+			return &RetainInfo{Size: tableSize}
+		}
+	}
+
+	return &RetainInfo{
+		Filter: bloomfilter.NewExplicit(seed, hashCount, tableSize),
+	}
+}
+
+// Add adds a piece ID to the filter of the node.
+func (info *RetainInfo) Add(pieceID storj.PieceID) {
+	if info.Filter != nil {
+		info.Filter.Add(pieceID)
+	} else {
+		info.Keys = append(info.Keys, bloomfilter.PieceKey(pieceID))
+	}
+	info.Count++
+}
+
+// Merge adds the pieces collected in other to the receiver.
+func (info *RetainInfo) Merge(other *RetainInfo) error {
+	if (info.Filter == nil) != (other.Filter == nil) {
+		return errs.New("cannot merge: mismatched filter formats")
+	}
+
+	info.Count += other.Count
+	if info.Filter != nil {
+		return info.Filter.AddFilter(other.Filter)
+	}
+	info.Keys = append(info.Keys, other.Keys...)
+	return nil
+}
+
+// FilterBytes encodes the filter which is sent to the node.
+func (info *RetainInfo) FilterBytes() ([]byte, error) {
+	if info.Filter != nil {
+		hashCount, size := info.Filter.Parameters()
+		mon.FloatVal("gc_filter_false_positive_rate", monkit.NewSeriesTag("format", "bloom")).
+			Observe(bloomfilter.ExpectedFalsePositiveRate(byte(hashCount), size, int64(info.Count)))
+		return info.Filter.Bytes(), nil
+	}
+
+	filter, err := bloomfilter.NewBinaryFuse(info.Keys, info.Size)
+	if err != nil {
+		return nil, err
+	}
+	mon.FloatVal("gc_filter_false_positive_rate", monkit.NewSeriesTag("format", "binary_fuse")).
+		Observe(bloomfilter.BinaryFuseFalsePositiveRate(filter.FingerprintBits()))
+	return filter.Bytes(), nil
+}
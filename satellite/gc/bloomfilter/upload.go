@@ -19,14 +19,14 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/satellite/gc/packstore"
 	"storj.io/storj/satellite/internalpb"
-	"storj.io/uplink"
 )
 
 // LATEST is the name of the file that contains the most recently completed bloomfilter generation prefix.
 const LATEST = "LATEST"
 
-// Upload is used to upload bloom filters to specified bucket.
+// Upload is used to upload bloom filters to the configured pack store.
 type Upload struct {
 	log    *zap.Logger
 	config Config
@@ -42,16 +42,10 @@ func NewUpload(log *zap.Logger, config Config) *Upload {
 
 // CheckConfig check configuration values.
 func (bfu *Upload) CheckConfig() error {
-	switch {
-	case bfu.config.AccessGrant == "":
-		return errs.New("Access Grant is not set")
-	case bfu.config.Bucket == "":
-		return errs.New("Bucket is not set")
-	}
-	return nil
+	return bfu.config.PackStore.Check(bfu.config.AccessGrant, bfu.config.Bucket)
 }
 
-// UploadBloomFilters stores a zipfile with multiple bloom filters in a bucket.
+// UploadBloomFilters stores a zipfile with multiple bloom filters in the pack store.
 func (bfu *Upload) UploadBloomFilters(ctx context.Context, creationDate time.Time, retainInfos MinimalRetainInfoMap) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -63,12 +57,7 @@ func (bfu *Upload) UploadBloomFilters(ctx context.Context, creationDate time.Tim
 
 	expirationTime := time.Now().Add(bfu.config.ExpireIn)
 
-	accessGrant, err := uplink.ParseAccess(bfu.config.AccessGrant)
-	if err != nil {
-		return err
-	}
-
-	project, err := uplink.OpenProject(ctx, accessGrant)
+	store, err := packstore.Open(ctx, bfu.config.PackStore, bfu.config.AccessGrant, bfu.config.Bucket)
 	if err != nil {
 		return err
 	}
@@ -76,29 +65,25 @@ func (bfu *Upload) UploadBloomFilters(ctx context.Context, creationDate time.Tim
 		// do cleanup in case of any error while uploading bloom filters
 		if err != nil {
 			// TODO should we drop whole bucket if cleanup will fail
-			err = errs.Combine(err, bfu.cleanup(ctx, project, prefix))
+			err = errs.Combine(err, bfu.cleanup(ctx, store, prefix))
 		}
-		err = errs.Combine(err, project.Close())
+		err = errs.Combine(err, store.Close())
 	}()
 
-	_, err = project.EnsureBucket(ctx, bfu.config.Bucket)
-	if err != nil {
-		return err
+	if err := store.DeleteExpired(ctx, time.Now()); err != nil {
+		bfu.log.Warn("failed to delete expired objects", zap.Error(err))
 	}
 
 	// TODO move it before segment loop is started
-	o := uplink.ListObjectsOptions{
-		Prefix: prefix + "/",
-	}
-	iterator := project.ListObjects(ctx, bfu.config.Bucket, &o)
-	for iterator.Next() {
-		if iterator.Item().IsPrefix {
-			continue
-		}
-
+	errNotEmpty := errs.New("not empty")
+	err = store.List(ctx, prefix+"/", func(string) error { return errNotEmpty })
+	if errors.Is(err, errNotEmpty) {
 		bfu.log.Warn("target bucket was not empty, stop operation and wait for next execution", zap.String("bucket", bfu.config.Bucket))
 		return nil
 	}
+	if err != nil {
+		return err
+	}
 
 	var limiter *sync2.Limiter
 	if l := bfu.config.UploadPackConcurrency; l <= 0 {
@@ -125,8 +110,15 @@ func (bfu *Upload) UploadBloomFilters(ctx context.Context, creationDate time.Tim
 			return false
 		}
 
+		filter, ferr := info.FilterBytes()
+		if ferr != nil {
+			// the node gets a filter in the next run.
+			bfu.log.Error("failed to create filter", zap.Stringer("node", nodeID), zap.Int("pieces", info.Count), zap.Error(ferr))
+			return true
+		}
+
 		*infos = append(*infos, internalpb.RetainInfo{
-			Filter: info.Filter.Bytes(),
+			Filter: filter,
 			// because bloom filters should be created from immutable database
 			// snapshot we are using latest segment creation date
 			CreationDate:  creationDate,
@@ -146,7 +138,7 @@ func (bfu *Upload) UploadBloomFilters(ctx context.Context, creationDate time.Tim
 					batches.Put(batch)
 				}()
 
-				err := bfu.uploadPack(rangeCtx, project, prefix, bNum, expirationTime, *batch)
+				err := bfu.uploadPack(rangeCtx, store, prefix, bNum, expirationTime, *batch)
 				if err != nil {
 					rangeCancel(err)
 					return
@@ -163,34 +155,32 @@ func (bfu *Upload) UploadBloomFilters(ctx context.Context, creationDate time.Tim
 	}
 
 	// upload rest of infos if any
-	if err := bfu.uploadPack(ctx, project, prefix, batchNumber, expirationTime, *infos); err != nil {
+	if err := bfu.uploadPack(ctx, store, prefix, batchNumber, expirationTime, *infos); err != nil {
 		return err
 	}
 
 	// update LATEST file
-	upload, err := project.UploadObject(ctx, bfu.config.Bucket, LATEST, nil)
+	upload, err := store.Create(ctx, LATEST, time.Time{})
 	if err != nil {
 		return err
 	}
 	_, err = upload.Write([]byte(prefix))
 	if err != nil {
-		return err
+		return errs.Combine(err, upload.Abort())
 	}
 
 	return upload.Commit()
 }
 
 // uploadPack uploads single zip pack with multiple bloom filters.
-func (bfu *Upload) uploadPack(ctx context.Context, project *uplink.Project, prefix string, batchNumber int, expirationTime time.Time, infos []internalpb.RetainInfo) (err error) {
+func (bfu *Upload) uploadPack(ctx context.Context, store packstore.Store, prefix string, batchNumber int, expirationTime time.Time, infos []internalpb.RetainInfo) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if len(infos) == 0 {
 		return nil
 	}
 
-	upload, err := project.UploadObject(ctx, bfu.config.Bucket, prefix+"/bloomfilters-"+strconv.Itoa(batchNumber)+".zip", &uplink.UploadOptions{
-		Expires: expirationTime,
-	})
+	upload, err := store.Create(ctx, prefix+"/bloomfilters-"+strconv.Itoa(batchNumber)+".zip", expirationTime)
 	if err != nil {
 		return err
 	}
@@ -230,31 +220,31 @@ func (bfu *Upload) uploadPack(ctx context.Context, project *uplink.Project, pref
 
 // cleanup moves all objects from root location to unique prefix. Objects will be deleted
 // automatically when expires.
-func (bfu *Upload) cleanup(ctx context.Context, project *uplink.Project, prefix string) (err error) {
+func (bfu *Upload) cleanup(ctx context.Context, store packstore.Store, prefix string) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	errPrefix := "upload-error-" + time.Now().Format(time.RFC3339)
-	o := uplink.ListObjectsOptions{
-		Prefix: prefix + "/",
-	}
-	iterator := project.ListObjects(ctx, bfu.config.Bucket, &o)
 
-	for iterator.Next() {
-		item := iterator.Item()
-		if item.IsPrefix {
-			continue
-		}
+	var keys []string
+	err = store.List(ctx, prefix+"/", func(key string) error {
+		keys = append(keys, key)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
 
-		err := project.MoveObject(ctx, bfu.config.Bucket, item.Key, bfu.config.Bucket, prefix+"/"+errPrefix+"/"+item.Key, nil)
+	for _, key := range keys {
+		err := store.Move(ctx, key, prefix+"/"+errPrefix+"/"+key)
 		if err != nil {
 			return err
 		}
 	}
 
-	return iterator.Err()
+	return nil
 }
 
-// UploadPieceIDs uploads piece IDs to the bucket.
+// UploadPieceIDs uploads piece IDs to the pack store.
 func (bfu *Upload) UploadPieceIDs(ctx context.Context, nodeID storj.NodeID, pieceIDs []storj.PieceID,
 	startTime time.Time, index int) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -263,36 +253,23 @@ func (bfu *Upload) UploadPieceIDs(ctx context.Context, nodeID storj.NodeID, piec
 		return nil
 	}
 
-	accessGrant, err := uplink.ParseAccess(bfu.config.AccessGrant)
-	if err != nil {
-		return err
-	}
-
-	project, err := uplink.OpenProject(ctx, accessGrant)
+	store, err := packstore.Open(ctx, bfu.config.PackStore, bfu.config.AccessGrant, bfu.config.Bucket)
 	if err != nil {
 		return err
 	}
 	defer func() {
-		err = errs.Combine(err, project.Close())
+		err = errs.Combine(err, store.Close())
 	}()
 
-	_, err = project.EnsureBucket(ctx, bfu.config.Bucket)
-	if err != nil {
-		return err
-	}
-
 	objectKey := fmt.Sprintf("piece-ids/%s/start-time-%s/ids-%d-%d", nodeID.String(), startTime.Format("2006-01-02"), time.Now().Unix(), index)
 	expirationTime := time.Now().Add(bfu.config.ExpireIn)
-	upload, err := project.UploadObject(ctx, bfu.config.Bucket, objectKey, &uplink.UploadOptions{
-		Expires: expirationTime,
-	})
+	upload, err := store.Create(ctx, objectKey, expirationTime)
 	if err != nil {
 		return err
 	}
 	defer func() {
-		abortErr := upload.Abort()
-		if err != nil && !errors.Is(err, uplink.ErrUploadDone) {
-			err = errs.Combine(err, abortErr)
+		if err != nil {
+			err = errs.Combine(err, upload.Abort())
 		}
 	}()
 
@@ -304,6 +281,9 @@ func (bfu *Upload) UploadPieceIDs(ctx context.Context, nodeID storj.NodeID, piec
 			return err
 		}
 	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
 
 	return upload.Commit()
 }
@@ -17,6 +17,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/common/version"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite/gc/bloomfilter"
 	"storj.io/storj/satellite/metabase"
@@ -591,13 +592,18 @@ func BenchmarkGarbageCollection(b *testing.B) {
 var observerDurations map[rangedloop.Observer]time.Duration
 
 type mockOverlay struct {
-	pieceCounts map[storj.NodeID]int64
+	pieceCounts     map[storj.NodeID]int64
+	binaryFuseNodes storj.NodeIDList
 }
 
 func (o *mockOverlay) ActiveNodesPieceCounts(context.Context) (map[storj.NodeID]int64, error) {
 	return o.pieceCounts, nil
 }
 
+func (o *mockOverlay) ActiveNodesWithMinimumVersion(context.Context, version.SemVer) (storj.NodeIDList, error) {
+	return o.binaryFuseNodes, nil
+}
+
 func randomSegments(nodesCount, segmentsCount, piecesPerSegment int) ([]rangedloop.Segment, map[storj.NodeID]int64) {
 	var nodes []storj.NodeID
 	for i := 0; i < nodesCount; i++ {
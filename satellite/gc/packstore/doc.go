@@ -0,0 +1,15 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+/*
+Package packstore implements the stores for the zip packs of garbage collection
+retain filters, which are written by storj/satellite/gc/bloomfilter and read by
+storj/satellite/gc/sender.
+
+By default the packs are kept in a Storj bucket, which requires a working uplink
+path into a satellite. Test, private and air-gapped satellites can keep them in
+a local directory or in a bucket of an S3 compatible service instead. Those
+stores keep the expiration time of the objects themselves and delete expired
+objects with DeleteExpired.
+*/
+package packstore
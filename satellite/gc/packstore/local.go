@@ -0,0 +1,318 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package packstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+const (
+	// localExpiresDir contains a file with the expiration time for each object which expires.
+	localExpiresDir = ".expires"
+	// localTempDir contains the objects which are being written.
+	localTempDir = ".tmp"
+)
+
+// Local is a store which keeps the objects in a local directory.
+//
+// The object with key "a/b" is kept in the file "a/b" relative to the
+// directory. The expiration time of the object is kept in the file
+// ".expires/a/b". Expired objects are hidden and they are deleted by
+// DeleteExpired.
+type Local struct {
+	dir string
+}
+
+var _ Store = (*Local)(nil)
+
+// OpenLocal opens the directory as a store and creates it when it doesn't exist.
+func OpenLocal(dir string) (*Local, error) {
+	for _, path := range []string{dir, filepath.Join(dir, localExpiresDir), filepath.Join(dir, localTempDir)} {
+		if err := os.MkdirAll(path, 0700); err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+	return &Local{dir: dir}, nil
+}
+
+func (store *Local) path(key string) string {
+	return filepath.Join(store.dir, filepath.FromSlash(key))
+}
+
+func (store *Local) expiresPath(key string) string {
+	return filepath.Join(store.dir, localExpiresDir, filepath.FromSlash(key))
+}
+
+// Create starts writing an object.
+func (store *Local) Create(ctx context.Context, key string, expires time.Time) (_ Upload, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+
+	file, err := os.CreateTemp(filepath.Join(store.dir, localTempDir), "upload-*")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &localUpload{
+		store:   store,
+		key:     key,
+		expires: expires,
+		file:    file,
+	}, nil
+}
+
+// Open opens an object for reading.
+func (store *Local) Open(ctx context.Context, key string) (_ io.ReadCloser, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+
+	expired, err := store.expired(key, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if expired {
+		return nil, ErrNotFound.New("%q", key)
+	}
+
+	file, err := os.Open(store.path(key))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrNotFound.New("%q", key)
+		}
+		return nil, Error.Wrap(err)
+	}
+	return file, nil
+}
+
+// List calls fn for the keys of the objects directly under prefix.
+func (store *Local) List(ctx context.Context, prefix string, fn func(key string) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dirPrefix, namePrefix := "", prefix
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		dirPrefix, namePrefix = prefix[:i+1], prefix[i+1:]
+	}
+
+	entries, err := os.ReadDir(store.path(dirPrefix))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return Error.Wrap(err)
+	}
+
+	now := time.Now()
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || !strings.HasPrefix(entry.Name(), namePrefix) {
+			continue
+		}
+
+		key := dirPrefix + entry.Name()
+		if checkKey(key) != nil {
+			continue
+		}
+
+		expired, err := store.expired(key, now)
+		if err != nil {
+			return err
+		}
+		if expired {
+			continue
+		}
+
+		if err := fn(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Move moves an object to a new key.
+func (store *Local) Move(ctx context.Context, oldKey, newKey string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := errs.Combine(checkKey(oldKey), checkKey(newKey)); err != nil {
+		return err
+	}
+
+	expires, err := store.readExpires(oldKey)
+	if err != nil {
+		return err
+	}
+	if !expires.IsZero() && !expires.After(time.Now()) {
+		return ErrNotFound.New("%q", oldKey)
+	}
+
+	if _, err := os.Stat(store.path(oldKey)); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return ErrNotFound.New("%q", oldKey)
+		}
+		return Error.Wrap(err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(store.path(newKey)), 0700); err != nil {
+		return Error.Wrap(err)
+	}
+	if err := os.Rename(store.path(oldKey), store.path(newKey)); err != nil {
+		return Error.Wrap(err)
+	}
+
+	return errs.Combine(store.writeExpires(newKey, expires), store.writeExpires(oldKey, time.Time{}))
+}
+
+// DeleteExpired deletes the objects which expired before now.
+func (store *Local) DeleteExpired(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	root := filepath.Join(store.dir, localExpiresDir)
+	var group errs.Group
+	err = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+
+		expired, err := store.expired(key, now)
+		if err != nil {
+			group.Add(err)
+			return nil
+		}
+		if !expired {
+			return nil
+		}
+
+		if err := os.Remove(store.path(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			group.Add(Error.Wrap(err))
+			return nil
+		}
+		group.Add(store.writeExpires(key, time.Time{}))
+		return nil
+	})
+	return errs.Combine(Error.Wrap(err), group.Err())
+}
+
+// Close does nothing.
+func (store *Local) Close() error { return nil }
+
+// readExpires returns the expiration time of the object or zero when it doesn't expire.
+func (store *Local) readExpires(key string) (time.Time, error) {
+	data, err := os.ReadFile(store.expiresPath(key))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, Error.Wrap(err)
+	}
+
+	expires, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(string(data)))
+	if err != nil {
+		return time.Time{}, Error.New("invalid expiration of %q: %w", key, err)
+	}
+	return expires, nil
+}
+
+// writeExpires writes the expiration time of the object. Zero time removes the expiration.
+func (store *Local) writeExpires(key string, expires time.Time) error {
+	path := store.expiresPath(key)
+	if expires.IsZero() {
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return Error.Wrap(err)
+		}
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(os.WriteFile(path, []byte(expires.UTC().Format(time.RFC3339Nano)), 0600))
+}
+
+func (store *Local) expired(key string, now time.Time) (bool, error) {
+	expires, err := store.readExpires(key)
+	if err != nil {
+		return false, err
+	}
+	return !expires.IsZero() && !expires.After(now), nil
+}
+
+// localUpload writes the object into a temporary file and moves it to the
+// final location on commit.
+type localUpload struct {
+	store   *Local
+	key     string
+	expires time.Time
+	file    *os.File
+	done    bool
+}
+
+// Write writes data to the object.
+func (upload *localUpload) Write(p []byte) (int, error) {
+	if upload.done {
+		return 0, Error.New("upload already finished")
+	}
+	n, err := upload.file.Write(p)
+	return n, Error.Wrap(err)
+}
+
+// Commit moves the object to the final location.
+func (upload *localUpload) Commit() (err error) {
+	if upload.done {
+		return Error.New("upload already finished")
+	}
+	upload.done = true
+
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, os.Remove(upload.file.Name()))
+		}
+	}()
+
+	if err := errs.Combine(upload.file.Sync(), upload.file.Close()); err != nil {
+		return Error.Wrap(err)
+	}
+
+	store := upload.store
+	if err := store.writeExpires(upload.key, upload.expires); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(store.path(upload.key)), 0700); err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(os.Rename(upload.file.Name(), store.path(upload.key)))
+}
+
+// Abort removes the temporary file.
+func (upload *localUpload) Abort() error {
+	if upload.done {
+		return nil
+	}
+	upload.done = true
+	return Error.Wrap(errs.Combine(upload.file.Close(), os.Remove(upload.file.Name())))
+}
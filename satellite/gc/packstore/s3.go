@@ -0,0 +1,489 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package packstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+const (
+	// s3ExpiresHeader is the object metadata which contains the expiration time.
+	s3ExpiresHeader = "X-Amz-Meta-Packstore-Expires"
+
+	s3TimeFormat = "20060102T150405Z"
+	s3DateFormat = "20060102"
+)
+
+// S3Config contains the configuration of an S3 compatible store.
+type S3Config struct {
+	Endpoint  string `help:"endpoint of the S3 compatible service, e.g. http://localhost:9000, when the type is s3" default:""`
+	Region    string `help:"region of the S3 compatible service" default:"us-east-1"`
+	AccessKey string `help:"access key of the S3 compatible service; requests are not signed when it is empty" default:""`
+	SecretKey string `help:"secret key of the S3 compatible service" default:""`
+}
+
+// S3 is a store which keeps the objects in a bucket of an S3 compatible
+// service, e.g. a local stand-in like MinIO.
+//
+// Buckets are addressed with path-style URLs. The expiration time is kept in
+// the object metadata. Expired objects are hidden by Open and they are
+// deleted by DeleteExpired, so they don't rely on lifecycle rules of the
+// service.
+type S3 struct {
+	config   S3Config
+	bucket   string
+	endpoint *url.URL
+	client   *http.Client
+}
+
+var _ Store = (*S3)(nil)
+
+// OpenS3 opens the bucket and creates it when it doesn't exist.
+func OpenS3(ctx context.Context, config S3Config, bucket string) (_ *S3, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" || endpoint.Host == "" {
+		return nil, Error.New("invalid S3 endpoint %q", config.Endpoint)
+	}
+	if bucket == "" {
+		return nil, Error.New("Bucket is not set")
+	}
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+
+	store := &S3{
+		config:   config,
+		bucket:   bucket,
+		endpoint: endpoint,
+		client:   &http.Client{},
+	}
+
+	resp, err := store.do(ctx, http.MethodHead, "", nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.Body.Close(); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		var body []byte
+		if config.Region != "us-east-1" {
+			body = []byte(`<CreateBucketConfiguration><LocationConstraint>` + config.Region + `</LocationConstraint></CreateBucketConfiguration>`)
+		}
+		resp, err := store.do(ctx, http.MethodPut, "", nil, nil, body)
+		if err != nil {
+			return nil, err
+		}
+		if err := errs.Combine(checkResponse(resp, "create bucket"), resp.Body.Close()); err != nil {
+			return nil, err
+		}
+	case resp.StatusCode/100 != 2:
+		return nil, Error.New("open bucket %q: %s", bucket, resp.Status)
+	}
+
+	return store, nil
+}
+
+// Create starts writing an object. The object is kept in memory until it's committed.
+func (store *S3) Create(ctx context.Context, key string, expires time.Time) (_ Upload, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+
+	return &s3Upload{
+		ctx:     ctx,
+		store:   store,
+		key:     key,
+		expires: expires,
+	}, nil
+}
+
+// Open opens an object for reading.
+func (store *S3) Open(ctx context.Context, key string) (_ io.ReadCloser, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+
+	resp, err := store.do(ctx, http.MethodGet, key, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errs.Combine(ErrNotFound.New("%q", key), resp.Body.Close())
+	}
+	if err := checkResponse(resp, "download "+key); err != nil {
+		return nil, err
+	}
+
+	expired, err := s3Expired(resp.Header, time.Now())
+	if err != nil || expired {
+		return nil, errs.Combine(err, ErrNotFound.New("%q", key), resp.Body.Close())
+	}
+	return resp.Body, nil
+}
+
+// List calls fn for the keys of the objects directly under prefix.
+func (store *S3) List(ctx context.Context, prefix string, fn func(key string) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return store.list(ctx, prefix, "/", fn)
+}
+
+// Move copies an object to a new key together with its metadata and deletes the old one.
+func (store *S3) Move(ctx context.Context, oldKey, newKey string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := errs.Combine(checkKey(oldKey), checkKey(newKey)); err != nil {
+		return err
+	}
+
+	header := http.Header{}
+	header.Set("X-Amz-Copy-Source", s3Escape("/"+store.bucket+"/"+oldKey, false))
+	header.Set("X-Amz-Metadata-Directive", "COPY")
+
+	resp, err := store.do(ctx, http.MethodPut, newKey, nil, header, nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return errs.Combine(ErrNotFound.New("%q", oldKey), resp.Body.Close())
+	}
+	// copy may fail after the response status has been sent.
+	body, err := io.ReadAll(resp.Body)
+	err = errs.Combine(err, resp.Body.Close())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if resp.StatusCode/100 != 2 || bytes.Contains(body, []byte("<Error>")) {
+		return Error.New("copy %s to %s: %s: %s", oldKey, newKey, resp.Status, bytes.TrimSpace(body))
+	}
+
+	return store.delete(ctx, oldKey)
+}
+
+// DeleteExpired deletes the objects which expired before now.
+func (store *S3) DeleteExpired(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var keys []string
+	err = store.list(ctx, "", "", func(key string) error {
+		keys = append(keys, key)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var group errs.Group
+	for _, key := range keys {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		resp, err := store.do(ctx, http.MethodHead, key, nil, nil, nil)
+		if err != nil {
+			group.Add(err)
+			continue
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			group.Add(Error.Wrap(resp.Body.Close()))
+			continue
+		}
+		if err := checkResponse(resp, "stat "+key); err != nil {
+			group.Add(err)
+			continue
+		}
+		group.Add(Error.Wrap(resp.Body.Close()))
+
+		expired, err := s3Expired(resp.Header, now)
+		if err != nil {
+			group.Add(err)
+			continue
+		}
+		if expired {
+			group.Add(store.delete(ctx, key))
+		}
+	}
+	return group.Err()
+}
+
+// Close releases idle connections.
+func (store *S3) Close() error {
+	store.client.CloseIdleConnections()
+	return nil
+}
+
+func (store *S3) list(ctx context.Context, prefix, delimiter string, fn func(key string) error) error {
+	query := url.Values{}
+	query.Set("list-type", "2")
+	query.Set("prefix", prefix)
+	if delimiter != "" {
+		query.Set("delimiter", delimiter)
+	}
+
+	for {
+		resp, err := store.do(ctx, http.MethodGet, "", query, nil, nil)
+		if err != nil {
+			return err
+		}
+		if err := checkResponse(resp, "list "+prefix); err != nil {
+			return err
+		}
+
+		var result struct {
+			IsTruncated           bool
+			NextContinuationToken string
+			Contents              []struct {
+				Key string
+			}
+		}
+		err = errs.Combine(xml.NewDecoder(resp.Body).Decode(&result), resp.Body.Close())
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		for _, object := range result.Contents {
+			if err := fn(object.Key); err != nil {
+				return err
+			}
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return nil
+		}
+		query.Set("continuation-token", result.NextContinuationToken)
+	}
+}
+
+func (store *S3) delete(ctx context.Context, key string) error {
+	resp, err := store.do(ctx, http.MethodDelete, key, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return Error.Wrap(resp.Body.Close())
+	}
+	return errs.Combine(checkResponse(resp, "delete "+key), resp.Body.Close())
+}
+
+// do sends a signed request for the object with the key, or for the bucket when the key is empty.
+func (store *S3) do(ctx context.Context, method, key string, query url.Values, header http.Header, body []byte) (*http.Response, error) {
+	path := "/" + store.bucket
+	if key != "" {
+		path += "/" + key
+	}
+
+	u := *store.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + path
+	u.RawPath = s3Escape(u.Path, false)
+	u.RawQuery = s3CanonicalQuery(query)
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	req.ContentLength = int64(len(body))
+	for name, values := range header {
+		req.Header[name] = values
+	}
+
+	if store.config.AccessKey != "" {
+		payloadHash := sha256.Sum256(body)
+		s3Sign(req, hex.EncodeToString(payloadHash[:]), time.Now(), store.config.Region, store.config.AccessKey, store.config.SecretKey)
+	}
+
+	resp, err := store.client.Do(req)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return resp, nil
+}
+
+// checkResponse returns an error with the response body when the request failed.
+// The body is closed in that case.
+func checkResponse(resp *http.Response, operation string) error {
+	if resp.StatusCode/100 == 2 {
+		return nil
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	return errs.Combine(Error.New("%s: %s: %s", operation, resp.Status, bytes.TrimSpace(body)), err, resp.Body.Close())
+}
+
+func s3Expired(header http.Header, now time.Time) (bool, error) {
+	value := header.Get(s3ExpiresHeader)
+	if value == "" {
+		return false, nil
+	}
+	expires, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return false, Error.New("invalid expiration %q: %w", value, err)
+	}
+	return !expires.After(now), nil
+}
+
+// s3Sign signs the request with AWS Signature Version 4.
+func s3Sign(req *http.Request, payloadHash string, now time.Time, region, accessKey, secretKey string) {
+	now = now.UTC()
+	req.Header.Set("X-Amz-Date", now.Format(s3TimeFormat))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		trimmed := make([]string, len(values))
+		for i, value := range values {
+			trimmed[i] = strings.Join(strings.Fields(value), " ")
+		}
+		headers[strings.ToLower(name)] = strings.Join(trimmed, ",")
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		s3Escape(req.URL.Path, false),
+		s3CanonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+
+	scope := now.Format(s3DateFormat) + "/" + region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + now.Format(s3TimeFormat) + "\n" + scope + "\n" + hex.EncodeToString(canonicalRequestHash[:])
+
+	key := []byte("AWS4" + secretKey)
+	for _, part := range []string{now.Format(s3DateFormat), region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+accessKey+"/"+scope+", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// s3CanonicalQuery encodes the query sorted by key as required by the signature.
+func s3CanonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, key := range keys {
+		values := append([]string(nil), query[key]...)
+		sort.Strings(values)
+		for _, value := range values {
+			parts = append(parts, s3Escape(key, true)+"="+s3Escape(value, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// s3Escape percent-encodes all bytes except the unreserved characters. The
+// slash is kept unless encodeSlash is set.
+func s3Escape(s string, encodeSlash bool) string {
+	const hexDigits = "0123456789ABCDEF"
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~',
+			c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			b.WriteByte('%')
+			b.WriteByte(hexDigits[c>>4])
+			b.WriteByte(hexDigits[c&15])
+		}
+	}
+	return b.String()
+}
+
+// s3Upload keeps the object in memory and sends it on commit.
+type s3Upload struct {
+	ctx     context.Context
+	store   *S3
+	key     string
+	expires time.Time
+	buffer  bytes.Buffer
+	done    bool
+}
+
+// Write writes data to the object.
+func (upload *s3Upload) Write(p []byte) (int, error) {
+	if upload.done {
+		return 0, Error.New("upload already finished")
+	}
+	return upload.buffer.Write(p)
+}
+
+// Commit sends the object to the service.
+func (upload *s3Upload) Commit() (err error) {
+	ctx := upload.ctx
+	defer mon.Task()(&ctx)(&err)
+
+	if upload.done {
+		return Error.New("upload already finished")
+	}
+	upload.done = true
+
+	header := http.Header{}
+	if !upload.expires.IsZero() {
+		header.Set(s3ExpiresHeader, upload.expires.UTC().Format(time.RFC3339Nano))
+	}
+
+	resp, err := upload.store.do(ctx, http.MethodPut, upload.key, nil, header, upload.buffer.Bytes())
+	if err != nil {
+		return err
+	}
+	return errs.Combine(checkResponse(resp, "upload "+upload.key), resp.Body.Close())
+}
+
+// Abort discards the object.
+func (upload *s3Upload) Abort() error {
+	upload.done = true
+	upload.buffer = bytes.Buffer{}
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package packstore
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+)
+
+var (
+	// Error is the error class for pack stores.
+	Error = errs.Class("packstore")
+	// ErrNotFound is returned when an object doesn't exist or has expired.
+	ErrNotFound = errs.Class("object not found")
+
+	mon = monkit.Package()
+)
+
+// Types of the stores.
+const (
+	TypeStorj = "storj"
+	TypeLocal = "local"
+	TypeS3    = "s3"
+)
+
+// Config contains the configuration of the store where bloom filter packs are kept.
+type Config struct {
+	Type string `help:"where bloom filter packs are stored (storj|local|s3)" default:"storj"`
+
+	LocalDir string `help:"directory for bloom filter packs, when the type is local" default:""`
+
+	S3 S3Config
+}
+
+// Store stores bloom filter packs and related objects under keys, which
+// use "/" as the separator.
+type Store interface {
+	// Create starts writing an object. The object expires at expires, unless it's zero.
+	Create(ctx context.Context, key string, expires time.Time) (Upload, error)
+	// Open opens an object for reading. It returns ErrNotFound when the object doesn't exist or has expired.
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// List calls fn for the keys of the objects directly under prefix in lexical order.
+	List(ctx context.Context, prefix string, fn func(key string) error) error
+	// Move moves an object to a new key and keeps its expiration.
+	Move(ctx context.Context, oldKey, newKey string) error
+	// DeleteExpired deletes the objects which expired before now.
+	DeleteExpired(ctx context.Context, now time.Time) error
+	// Close releases the resources used by the store.
+	Close() error
+}
+
+// Upload is an object being written to a Store.
+type Upload interface {
+	io.Writer
+	// Commit finishes the upload and makes the object visible.
+	Commit() error
+	// Abort discards the object.
+	Abort() error
+}
+
+// Check checks whether the configuration is valid for the access grant and bucket,
+// which are used by the storj and s3 types.
+func (config Config) Check(accessGrant, bucket string) error {
+	switch config.Type {
+	case TypeStorj, "":
+		switch {
+		case accessGrant == "":
+			return Error.New("Access Grant is not set")
+		case bucket == "":
+			return Error.New("Bucket is not set")
+		}
+	case TypeLocal:
+		if config.LocalDir == "" {
+			return Error.New("local directory is not set")
+		}
+	case TypeS3:
+		switch {
+		case config.S3.Endpoint == "":
+			return Error.New("S3 endpoint is not set")
+		case bucket == "":
+			return Error.New("Bucket is not set")
+		}
+	default:
+		return Error.New("unknown type %q", config.Type)
+	}
+	return nil
+}
+
+// Open opens the store selected by the configuration.
+func Open(ctx context.Context, config Config, accessGrant, bucket string) (_ Store, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := config.Check(accessGrant, bucket); err != nil {
+		return nil, err
+	}
+
+	switch config.Type {
+	case TypeLocal:
+		return OpenLocal(config.LocalDir)
+	case TypeS3:
+		return OpenS3(ctx, config.S3, bucket)
+	default:
+		return OpenStorj(ctx, accessGrant, bucket)
+	}
+}
+
+// checkKey checks whether the key can be used by all the stores.
+func checkKey(key string) error {
+	if key == "" {
+		return Error.New("empty key")
+	}
+	if strings.HasPrefix(key, ".") {
+		return Error.New("key %q starts with a dot", key)
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return Error.New("invalid key %q", key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,332 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package packstore_test
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/gc/packstore"
+)
+
+func TestLocal(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	store, err := packstore.Open(ctx, packstore.Config{
+		Type:     packstore.TypeLocal,
+		LocalDir: ctx.Dir("packs"),
+	}, "", "")
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	testStore(ctx, t, store)
+
+	_, err = packstore.OpenLocal(ctx.Dir("packs"))
+	require.NoError(t, err)
+}
+
+func TestS3(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	server := httptest.NewServer(newFakeS3())
+	defer server.Close()
+
+	store, err := packstore.Open(ctx, packstore.Config{
+		Type: packstore.TypeS3,
+		S3: packstore.S3Config{
+			Endpoint:  server.URL,
+			AccessKey: "access",
+			SecretKey: "secret",
+		},
+	}, "", "gc-queue")
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	testStore(ctx, t, store)
+}
+
+func TestStorj(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		access, err := planet.Uplinks[0].Access[planet.Satellites[0].ID()].Serialize()
+		require.NoError(t, err)
+
+		store, err := packstore.Open(ctx, packstore.Config{Type: packstore.TypeStorj}, access, "gc-queue")
+		require.NoError(t, err)
+		defer ctx.Check(store.Close)
+
+		put(ctx, t, store, "a/1.zip", "1", time.Time{})
+		put(ctx, t, store, "a/2.zip", "2", time.Now().Add(time.Hour))
+		put(ctx, t, store, "a/b/3.zip", "3", time.Time{})
+
+		require.Equal(t, []string{"a/1.zip", "a/2.zip"}, list(ctx, t, store, "a/"))
+		require.Equal(t, "2", get(ctx, t, store, "a/2.zip"))
+
+		require.NoError(t, store.Move(ctx, "a/2.zip", "sent-a/2.zip"))
+		require.Equal(t, "2", get(ctx, t, store, "sent-a/2.zip"))
+
+		_, err = store.Open(ctx, "a/2.zip")
+		require.True(t, packstore.ErrNotFound.Has(err))
+	})
+}
+
+func TestCheck(t *testing.T) {
+	require.NoError(t, packstore.Config{Type: packstore.TypeStorj}.Check("access", "bucket"))
+	require.Error(t, packstore.Config{Type: packstore.TypeStorj}.Check("", "bucket"))
+	require.Error(t, packstore.Config{Type: packstore.TypeStorj}.Check("access", ""))
+
+	require.NoError(t, packstore.Config{Type: packstore.TypeLocal, LocalDir: "dir"}.Check("", ""))
+	require.Error(t, packstore.Config{Type: packstore.TypeLocal}.Check("access", "bucket"))
+
+	require.NoError(t, packstore.Config{Type: packstore.TypeS3, S3: packstore.S3Config{Endpoint: "http://localhost"}}.Check("", "bucket"))
+	require.Error(t, packstore.Config{Type: packstore.TypeS3, S3: packstore.S3Config{Endpoint: "http://localhost"}}.Check("access", ""))
+	require.Error(t, packstore.Config{Type: packstore.TypeS3}.Check("", "bucket"))
+
+	require.Error(t, packstore.Config{Type: "unknown"}.Check("access", "bucket"))
+}
+
+func testStore(ctx context.Context, t *testing.T, store packstore.Store) {
+	now := time.Now()
+
+	_, err := store.Open(ctx, "LATEST")
+	require.True(t, packstore.ErrNotFound.Has(err), err)
+
+	put(ctx, t, store, "LATEST", "prefix", time.Time{})
+	put(ctx, t, store, "prefix/bloomfilters-0.zip", "0", now.Add(time.Hour))
+	put(ctx, t, store, "prefix/bloomfilters-1.zip", "1", now.Add(2*time.Hour))
+	put(ctx, t, store, "prefix/nested/bloomfilters-2.zip", "2", time.Time{})
+
+	require.Equal(t, "prefix", get(ctx, t, store, "LATEST"))
+	require.Equal(t, "0", get(ctx, t, store, "prefix/bloomfilters-0.zip"))
+
+	require.Equal(t, []string{"prefix/bloomfilters-0.zip", "prefix/bloomfilters-1.zip"}, list(ctx, t, store, "prefix/"))
+	require.Equal(t, []string{"prefix/bloomfilters-1.zip"}, list(ctx, t, store, "prefix/bloomfilters-1"))
+	require.Equal(t, []string{"LATEST"}, list(ctx, t, store, ""))
+	require.Empty(t, list(ctx, t, store, "missing/"))
+
+	// aborted uploads are not visible.
+	upload, err := store.Create(ctx, "prefix/aborted.zip", time.Time{})
+	require.NoError(t, err)
+	_, err = upload.Write([]byte("aborted"))
+	require.NoError(t, err)
+	require.NoError(t, upload.Abort())
+	require.Equal(t, []string{"prefix/bloomfilters-0.zip", "prefix/bloomfilters-1.zip"}, list(ctx, t, store, "prefix/"))
+
+	// moving keeps the expiration.
+	require.NoError(t, store.Move(ctx, "prefix/bloomfilters-0.zip", "sent-prefix/bloomfilters-0.zip"))
+	require.Equal(t, []string{"prefix/bloomfilters-1.zip"}, list(ctx, t, store, "prefix/"))
+	require.Equal(t, "0", get(ctx, t, store, "sent-prefix/bloomfilters-0.zip"))
+
+	_, err = store.Open(ctx, "prefix/bloomfilters-0.zip")
+	require.True(t, packstore.ErrNotFound.Has(err), err)
+
+	err = store.Move(ctx, "prefix/missing.zip", "sent-prefix/missing.zip")
+	require.True(t, packstore.ErrNotFound.Has(err), err)
+
+	// objects expiring before the time are deleted.
+	require.NoError(t, store.DeleteExpired(ctx, now.Add(90*time.Minute)))
+	require.Empty(t, list(ctx, t, store, "sent-prefix/"))
+	require.Equal(t, []string{"prefix/bloomfilters-1.zip"}, list(ctx, t, store, "prefix/"))
+	require.Equal(t, "prefix", get(ctx, t, store, "LATEST"))
+	require.Equal(t, "2", get(ctx, t, store, "prefix/nested/bloomfilters-2.zip"))
+
+	// expired objects are not visible.
+	put(ctx, t, store, "prefix/expired.zip", "expired", now.Add(-time.Minute))
+	_, err = store.Open(ctx, "prefix/expired.zip")
+	require.True(t, packstore.ErrNotFound.Has(err), err)
+
+	require.NoError(t, store.DeleteExpired(ctx, now))
+	require.Equal(t, []string{"prefix/bloomfilters-1.zip"}, list(ctx, t, store, "prefix/"))
+
+	// overwriting an object replaces the expiration.
+	put(ctx, t, store, "prefix/bloomfilters-1.zip", "new", time.Time{})
+	require.NoError(t, store.DeleteExpired(ctx, now.Add(24*time.Hour)))
+	require.Equal(t, "new", get(ctx, t, store, "prefix/bloomfilters-1.zip"))
+
+	for _, key := range []string{"", "/a", "a//b", "a/../b", ".expires/a"} {
+		_, err := store.Create(ctx, key, time.Time{})
+		require.Error(t, err, key)
+	}
+}
+
+func put(ctx context.Context, t *testing.T, store packstore.Store, key, data string, expires time.Time) {
+	upload, err := store.Create(ctx, key, expires)
+	require.NoError(t, err)
+	_, err = upload.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, upload.Commit())
+}
+
+func get(ctx context.Context, t *testing.T, store packstore.Store, key string) string {
+	reader, err := store.Open(ctx, key)
+	require.NoError(t, err)
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	return string(data)
+}
+
+func list(ctx context.Context, t *testing.T, store packstore.Store, prefix string) []string {
+	var keys []string
+	require.NoError(t, store.List(ctx, prefix, func(key string) error {
+		keys = append(keys, key)
+		return nil
+	}))
+	return keys
+}
+
+// fakeS3 is a minimal in-memory stand-in for an S3 compatible service.
+type fakeS3 struct {
+	mu      sync.Mutex
+	buckets map[string]map[string]fakeObject
+}
+
+type fakeObject struct {
+	data     []byte
+	metadata http.Header
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{buckets: map[string]map[string]fakeObject{}}
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") {
+		http.Error(w, "<Error><Code>AccessDenied</Code></Error>", http.StatusForbidden)
+		return
+	}
+
+	bucketName, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	bucket, bucketExists := s.buckets[bucketName]
+
+	if key == "" {
+		switch r.Method {
+		case http.MethodHead:
+			if !bucketExists {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		case http.MethodPut:
+			s.buckets[bucketName] = map[string]fakeObject{}
+		case http.MethodGet:
+			if !bucketExists {
+				http.Error(w, "<Error><Code>NoSuchBucket</Code></Error>", http.StatusNotFound)
+				return
+			}
+			s.list(w, r.URL.Query(), bucket)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+		return
+	}
+
+	if !bucketExists {
+		http.Error(w, "<Error><Code>NoSuchBucket</Code></Error>", http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		if source := r.Header.Get("X-Amz-Copy-Source"); source != "" {
+			source, err := url.PathUnescape(source)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			sourceBucket, sourceKey, _ := strings.Cut(strings.TrimPrefix(source, "/"), "/")
+			object, ok := s.buckets[sourceBucket][sourceKey]
+			if !ok {
+				http.Error(w, "<Error><Code>NoSuchKey</Code></Error>", http.StatusNotFound)
+				return
+			}
+			bucket[key] = object
+			_, _ = w.Write([]byte("<CopyObjectResult></CopyObjectResult>"))
+			return
+		}
+
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		metadata := http.Header{}
+		for name, values := range r.Header {
+			if strings.HasPrefix(name, "X-Amz-Meta-") {
+				metadata[name] = values
+			}
+		}
+		bucket[key] = fakeObject{data: data, metadata: metadata}
+	case http.MethodGet, http.MethodHead:
+		object, ok := bucket[key]
+		if !ok {
+			http.Error(w, "<Error><Code>NoSuchKey</Code></Error>", http.StatusNotFound)
+			return
+		}
+		for name, values := range object.metadata {
+			w.Header()[name] = values
+		}
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(object.data)
+		}
+	case http.MethodDelete:
+		delete(bucket, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// list returns a single key per page to exercise pagination.
+func (s *fakeS3) list(w http.ResponseWriter, query url.Values, bucket map[string]fakeObject) {
+	prefix, delimiter, token := query.Get("prefix"), query.Get("delimiter"), query.Get("continuation-token")
+
+	var keys []string
+	for key := range bucket {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if delimiter != "" && strings.Contains(key[len(prefix):], delimiter) {
+			continue
+		}
+		if key > token {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	type content struct {
+		Key string
+	}
+	result := struct {
+		XMLName               xml.Name `xml:"ListBucketResult"`
+		IsTruncated           bool
+		NextContinuationToken string    `xml:",omitempty"`
+		Contents              []content `xml:",omitempty"`
+	}{}
+	if len(keys) > 0 {
+		result.Contents = []content{{Key: keys[0]}}
+		result.IsTruncated = len(keys) > 1
+		if result.IsTruncated {
+			result.NextContinuationToken = keys[0]
+		}
+	}
+
+	_ = xml.NewEncoder(w).Encode(result)
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package packstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/uplink"
+)
+
+// Storj is a store which keeps the objects in a Storj bucket.
+//
+// Expired objects are deleted by the satellite which hosts the bucket.
+type Storj struct {
+	project *uplink.Project
+	bucket  string
+}
+
+var _ Store = (*Storj)(nil)
+
+// OpenStorj opens the bucket with the access grant and creates the bucket when it doesn't exist.
+func OpenStorj(ctx context.Context, accessGrant, bucket string) (_ *Storj, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	access, err := uplink.ParseAccess(accessGrant)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := uplink.OpenProject(ctx, access)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = project.EnsureBucket(ctx, bucket)
+	if err != nil {
+		return nil, errs.Combine(err, project.Close())
+	}
+
+	return &Storj{
+		project: project,
+		bucket:  bucket,
+	}, nil
+}
+
+// Create starts writing an object.
+func (store *Storj) Create(ctx context.Context, key string, expires time.Time) (_ Upload, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	upload, err := store.project.UploadObject(ctx, store.bucket, key, &uplink.UploadOptions{
+		Expires: expires,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return upload, nil
+}
+
+// Open opens an object for reading.
+func (store *Storj) Open(ctx context.Context, key string) (_ io.ReadCloser, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	download, err := store.project.DownloadObject(ctx, store.bucket, key, nil)
+	if err != nil {
+		if errors.Is(err, uplink.ErrObjectNotFound) {
+			return nil, ErrNotFound.Wrap(err)
+		}
+		return nil, err
+	}
+	return download, nil
+}
+
+// List calls fn for the keys of the objects directly under prefix.
+func (store *Storj) List(ctx context.Context, prefix string, fn func(key string) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	objects := store.project.ListObjects(ctx, store.bucket, &uplink.ListObjectsOptions{
+		System:    true,
+		Recursive: false,
+		Prefix:    prefix,
+	})
+
+	for objects.Next() {
+		object := objects.Item()
+		if object.IsPrefix {
+			continue
+		}
+
+		if err := fn(object.Key); err != nil {
+			return err
+		}
+	}
+
+	return objects.Err()
+}
+
+// Move moves an object to a new key.
+func (store *Storj) Move(ctx context.Context, oldKey, newKey string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return store.project.MoveObject(ctx, store.bucket, oldKey, store.bucket, newKey, nil)
+}
+
+// DeleteExpired does nothing, because the satellite deletes expired objects.
+func (store *Storj) DeleteExpired(ctx context.Context, now time.Time) error {
+	return nil
+}
+
+// Close closes the project.
+func (store *Storj) Close() error {
+	return store.project.Close()
+}
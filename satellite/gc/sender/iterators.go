@@ -14,53 +14,48 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/common/pb"
+	"storj.io/storj/satellite/gc/packstore"
 	"storj.io/storj/satellite/internalpb"
-	"storj.io/uplink"
 )
 
-// IterateZipObjectKeys checks inside the top-level of a bucket and yields the keys which look like zip files.
+// IterateZipObjectKeys checks inside the prefix of a pack store and yields the keys which look like zip files.
 func IterateZipObjectKeys(
 	ctx context.Context,
-	project uplink.Project,
-	bucket string,
+	store packstore.Store,
 	prefix string,
 	fn func(objectKey string) error,
 ) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	objects := project.ListObjects(ctx, bucket, &uplink.ListObjectsOptions{
-		System:    true,
-		Recursive: false,
-		Prefix:    prefix,
-	})
-
-	for objects.Next() {
-		object := objects.Item()
-		if object.IsPrefix {
-			continue
-		}
-
-		if !strings.HasSuffix(object.Key, ".zip") {
-			continue
+	// collect the keys first, because fn moves the objects.
+	var keys []string
+	err = store.List(ctx, prefix, func(key string) error {
+		if strings.HasSuffix(key, ".zip") {
+			keys = append(keys, key)
 		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
 
-		if err := fn(object.Key); err != nil {
+	for _, key := range keys {
+		if err := fn(key); err != nil {
 			return err
 		}
 	}
 
-	return objects.Err()
+	return nil
 }
 
 // IterateZipContent opens a zip file at an object key and yields the files inside.
 func IterateZipContent(
 	ctx context.Context,
-	project uplink.Project,
-	bucket string,
+	store packstore.Store,
 	objectKey string,
 	fn func(file *zip.File) error,
 ) (err error) {
-	download, err := project.DownloadObject(ctx, bucket, objectKey, nil)
+	download, err := store.Open(ctx, objectKey)
 	if err != nil {
 		return err
 	}
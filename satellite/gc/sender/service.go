@@ -6,7 +6,6 @@ package sender
 import (
 	"archive/zip"
 	"context"
-	"errors"
 	"io"
 	"time"
 
@@ -19,9 +18,9 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
 	"storj.io/storj/satellite/gc/bloomfilter"
+	"storj.io/storj/satellite/gc/packstore"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/overlay"
-	"storj.io/uplink"
 	"storj.io/uplink/private/piecestore"
 )
 
@@ -43,6 +42,8 @@ type Config struct {
 	AccessGrant string        `help:"Access to download the bloom filters. Needs read and write permission."`
 	Bucket      string        `help:"bucket where retain info is stored" default:"" testDefault:"gc-queue"`
 	ExpireIn    time.Duration `help:"Expiration of newly created objects in the bucket. These objects are under the prefix error-[timestamp] and store error messages." default:"336h"`
+
+	PackStore packstore.Config
 }
 
 // NewService creates a new instance of the gc sender service.
@@ -57,7 +58,7 @@ func NewService(log *zap.Logger, config Config, dialer rpc.Dialer, overlay overl
 	}
 }
 
-// Service reads bloom filters of piece IDs to retain from a Storj bucket
+// Service reads bloom filters of piece IDs to retain from a pack store
 // and sends them out to the storage nodes. This is intended to run on a live satellite,
 // not on a backup database.
 //
@@ -85,33 +86,25 @@ func (service *Service) Run(ctx context.Context) (err error) {
 	return service.Loop.Run(ctx, service.RunOnce)
 }
 
-// RunOnce opens the bucket and sends out all the retain filters located in it to the storage nodes.
+// RunOnce opens the pack store and sends out all the retain filters located in it to the storage nodes.
 func (service *Service) RunOnce(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	switch {
-	case service.Config.AccessGrant == "":
-		return errs.New("Access Grant is not set")
-	case service.Config.Bucket == "":
-		return errs.New("Bucket is not set")
-	}
-
-	access, err := uplink.ParseAccess(service.Config.AccessGrant)
-	if err != nil {
-		return err
-	}
-
-	project, err := uplink.OpenProject(ctx, access)
+	store, err := packstore.Open(ctx, service.Config.PackStore, service.Config.AccessGrant, service.Config.Bucket)
 	if err != nil {
 		return err
 	}
 	defer func() {
-		err = errs.Combine(err, project.Close())
+		err = errs.Combine(err, store.Close())
 	}()
 
-	download, err := project.DownloadObject(ctx, service.Config.Bucket, bloomfilter.LATEST, nil)
+	if err := store.DeleteExpired(ctx, time.Now()); err != nil {
+		service.log.Warn("failed to delete expired objects", zap.Error(err))
+	}
+
+	download, err := store.Open(ctx, bloomfilter.LATEST)
 	if err != nil {
-		if errors.Is(err, uplink.ErrObjectNotFound) {
+		if packstore.ErrNotFound.Has(err) {
 			service.log.Info("LATEST file does not exist in bucket", zap.String("bucket", service.Config.Bucket))
 			return nil
 		}
@@ -128,9 +121,9 @@ func (service *Service) RunOnce(ctx context.Context) (err error) {
 	}
 	prefix := string(value) + "/"
 
-	return IterateZipObjectKeys(ctx, *project, service.Config.Bucket, prefix, func(objectKey string) error {
+	return IterateZipObjectKeys(ctx, store, prefix, func(objectKey string) error {
 		limiter := sync2.NewLimiter(service.Config.ConcurrentSends)
-		err := IterateZipContent(ctx, *project, service.Config.Bucket, objectKey, func(zipEntry *zip.File) error {
+		err := IterateZipContent(ctx, store, objectKey, func(zipEntry *zip.File) error {
 			retainInfo, err := UnpackZipEntry(zipEntry)
 			if err != nil {
 				service.log.Warn("Skipping retain filter entry", zap.Error(err))
@@ -148,10 +141,10 @@ func (service *Service) RunOnce(ctx context.Context) (err error) {
 
 		if err != nil {
 			// We store the error in the bucket and then continue with the next zip file.
-			return service.moveToErrorPrefix(ctx, project, objectKey, err)
+			return service.moveToErrorPrefix(ctx, store, objectKey, err)
 		}
 
-		return service.moveToSentPrefix(ctx, project, objectKey)
+		return service.moveToSentPrefix(ctx, store, objectKey)
 	})
 }
 
@@ -196,25 +189,23 @@ func (service *Service) sendRetainRequest(ctx context.Context, retainInfo *inter
 
 // moveToErrorPrefix moves an object to prefix "error" and attaches the error to the metadata.
 func (service *Service) moveToErrorPrefix(
-	ctx context.Context, project *uplink.Project, objectKey string, previousErr error,
+	ctx context.Context, store packstore.Store, objectKey string, previousErr error,
 ) error {
 	newObjectKey := "error-" + objectKey
 
-	err := project.MoveObject(ctx, service.Config.Bucket, objectKey, service.Config.Bucket, newObjectKey, nil)
+	err := store.Move(ctx, objectKey, newObjectKey)
 	if err != nil {
 		return err
 	}
 
-	return service.uploadError(ctx, project, newObjectKey+".error.txt", previousErr)
+	return service.uploadError(ctx, store, newObjectKey+".error.txt", previousErr)
 }
 
 // uploadError saves an error under an object key.
 func (service *Service) uploadError(
-	ctx context.Context, project *uplink.Project, destinationObjectKey string, previousErr error,
+	ctx context.Context, store packstore.Store, destinationObjectKey string, previousErr error,
 ) (err error) {
-	upload, err := project.UploadObject(ctx, service.Config.Bucket, destinationObjectKey, &uplink.UploadOptions{
-		Expires: time.Now().Add(service.Config.ExpireIn),
-	})
+	upload, err := store.Create(ctx, destinationObjectKey, time.Now().Add(service.Config.ExpireIn))
 	if err != nil {
 		return err
 	}
@@ -235,9 +226,9 @@ func (service *Service) uploadError(
 
 // moveToSentPrefix moves an object to prefix "sent".
 func (service *Service) moveToSentPrefix(
-	ctx context.Context, project *uplink.Project, objectKey string,
+	ctx context.Context, store packstore.Store, objectKey string,
 ) error {
 	newObjectKey := "sent-" + objectKey
 
-	return project.MoveObject(ctx, service.Config.Bucket, objectKey, service.Config.Bucket, newObjectKey, nil)
+	return store.Move(ctx, objectKey, newObjectKey)
 }
@@ -18,6 +18,7 @@ import (
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite/gc/bloomfilter"
+	"storj.io/storj/satellite/gc/packstore"
 	"storj.io/storj/satellite/metabase/rangedloop"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/storagenode"
@@ -240,3 +241,77 @@ func TestSendInvalidZip(t *testing.T) {
 		require.Equal(t, "zip: not a valid zip file", string(all))
 	})
 }
+
+func TestSendRetainFiltersLocalPackStore(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 1,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNode: func(index int, config *storagenode.Config) {
+				// stop processing at storagenode side so it can be inspected
+				config.Retain.Concurrency = 0
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		// the satellite doesn't need access to any bucket
+		packStore := packstore.Config{
+			Type:     packstore.TypeLocal,
+			LocalDir: ctx.Dir("gc-queue"),
+		}
+
+		// configure sender
+		gcsender := planet.Satellites[0].GarbageCollection.Sender
+		gcsender.Config.AccessGrant = ""
+		gcsender.Config.PackStore = packStore
+
+		// upload 1 piece
+		upl := planet.Uplinks[0]
+		testData := testrand.Bytes(8 * memory.KiB)
+		err := upl.Upload(ctx, planet.Satellites[0], "testbucket", "test/path/1", testData)
+		require.NoError(t, err)
+
+		// configure filter uploader
+		config := planet.Satellites[0].Config.GarbageCollectionBF
+		config.AccessGrant = ""
+		config.PackStore = packStore
+
+		observer := bloomfilter.NewObserver(zaptest.NewLogger(t), config, planet.Satellites[0].Overlay.DB)
+		segments := rangedloop.NewMetabaseRangeSplitter(zap.NewNop(), planet.Satellites[0].Metabase.DB, planet.Satellites[0].Config.RangedLoop)
+		rangedLoop := rangedloop.NewService(zap.NewNop(), planet.Satellites[0].Config.RangedLoop, segments,
+			[]rangedloop.Observer{observer})
+
+		_, err = rangedLoop.RunOnce(ctx)
+		require.NoError(t, err)
+
+		// send to storagenode
+		require.NoError(t, gcsender.RunOnce(ctx))
+
+		require.Eventually(t, func() bool {
+			return !planet.StorageNodes[0].Storage2.BloomFilterManager.GetCreatedTime(planet.Satellites[0].ID()).IsZero()
+		}, 10*time.Second, 50*time.Millisecond)
+
+		// check that zip was moved to sent
+		store, err := packstore.OpenLocal(packStore.LocalDir)
+		require.NoError(t, err)
+
+		latest, err := store.Open(ctx, bloomfilter.LATEST)
+		require.NoError(t, err)
+		prefix, err := io.ReadAll(latest)
+		require.NoError(t, err)
+		require.NoError(t, latest.Close())
+
+		var keys []string
+		require.NoError(t, store.List(ctx, "sent-"+string(prefix)+"/", func(key string) error {
+			keys = append(keys, key)
+			return nil
+		}))
+		require.Len(t, keys, 1)
+		require.Regexp(t, "sent-.*/.*.zip$", keys[0])
+
+		// packs expire like in a bucket
+		require.NoError(t, store.DeleteExpired(ctx, time.Now().Add(config.ExpireIn+time.Minute)))
+		_, err = store.Open(ctx, keys[0])
+		require.True(t, packstore.ErrNotFound.Has(err))
+	})
+}
@@ -0,0 +1,138 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package geoip
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"net/netip"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// ASNTable is an offline IP to ASN table, loaded from a CSV file with
+// network,asn,organization rows (the format of the GeoLite2-ASN-Blocks CSV
+// files). The networks must not overlap.
+type ASNTable struct {
+	ranges []asnRange
+}
+
+type asnRange struct {
+	first, last netip.Addr
+	info        ASInfo
+}
+
+var _ IPToASN = &ASNTable{}
+
+// OpenASNTable loads the IP to ASN table from the provided filepath.
+func OpenASNTable(filepath string) (_ *ASNTable, err error) {
+	file, err := os.Open(filepath)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	return LoadASNTable(file)
+}
+
+// LoadASNTable loads the IP to ASN table from CSV data. The header row and the
+// lines starting with # are ignored.
+func LoadASNTable(r io.Reader) (*ASNTable, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	table := &ASNTable{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		if len(record) < 2 || len(record) > 3 {
+			line, _ := reader.FieldPos(0)
+			return nil, errs.New("invalid ASN table row on line %d: expected network,asn,organization", line)
+		}
+		if record[0] == "network" {
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		number, err := strconv.ParseUint(strings.TrimPrefix(strings.TrimSpace(record[1]), "AS"), 10, 32)
+		if err != nil {
+			return nil, errs.New("invalid ASN %q for network %s", record[1], prefix)
+		}
+
+		info := ASInfo{Number: uint32(number)}
+		if len(record) == 3 {
+			info.Organization = strings.TrimSpace(record[2])
+		}
+
+		prefix = prefix.Masked()
+		table.ranges = append(table.ranges, asnRange{
+			first: prefix.Addr(),
+			last:  lastAddr(prefix),
+			info:  info,
+		})
+	}
+
+	sort.Slice(table.ranges, func(i, k int) bool {
+		return table.ranges[i].first.Less(table.ranges[k].first)
+	})
+	for i := 1; i < len(table.ranges); i++ {
+		if table.ranges[i].first.Compare(table.ranges[i-1].last) <= 0 {
+			return nil, errs.New("overlapping networks in ASN table: %s and %s", table.ranges[i-1].first, table.ranges[i].first)
+		}
+	}
+
+	return table, nil
+}
+
+// Close does nothing for the ASNTable.
+func (t *ASNTable) Close() error {
+	return nil
+}
+
+// LookupASN accepts an IP address.
+func (t *ASNTable) LookupASN(address string) (ASInfo, error) {
+	ip, err := addressToIP(address)
+	if err != nil || ip == nil {
+		return ASInfo{}, err
+	}
+
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return ASInfo{}, nil
+	}
+	addr = addr.Unmap()
+
+	i := sort.Search(len(t.ranges), func(i int) bool {
+		return addr.Less(t.ranges[i].first)
+	}) - 1
+	if i < 0 || t.ranges[i].last.Less(addr) {
+		return ASInfo{}, nil
+	}
+	return t.ranges[i].info, nil
+}
+
+// lastAddr returns the last address of the network.
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	bytes := prefix.Addr().AsSlice()
+	for bit := prefix.Bits(); bit < len(bytes)*8; bit++ {
+		bytes[bit/8] |= 0x80 >> (bit % 8)
+	}
+	last, _ := netip.AddrFromSlice(bytes)
+	return last
+}
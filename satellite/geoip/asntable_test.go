@@ -0,0 +1,79 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package geoip_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/geoip"
+)
+
+const asnTableCSV = `network,autonomous_system_number,autonomous_system_organization
+# documentation networks
+192.0.2.0/24,64512,"Example Hosting, Inc."
+198.51.100.0/25,64513,Other Networks
+198.51.100.128/25,AS64514,
+2001:db8::/32,64515,IPv6 Networks
+`
+
+func TestASNTable(t *testing.T) {
+	table, err := geoip.LoadASNTable(strings.NewReader(asnTableCSV))
+	require.NoError(t, err)
+
+	cases := []struct {
+		address string
+		info    geoip.ASInfo
+	}{
+		{"192.0.2.0:1234", geoip.ASInfo{Number: 64512, Organization: "Example Hosting, Inc."}},
+		{"192.0.2.255:1234", geoip.ASInfo{Number: 64512, Organization: "Example Hosting, Inc."}},
+		{"198.51.100.127:1234", geoip.ASInfo{Number: 64513, Organization: "Other Networks"}},
+		{"198.51.100.128:1234", geoip.ASInfo{Number: 64514}},
+		{"[2001:db8:85a3::8a2e:370:7334]:1234", geoip.ASInfo{Number: 64515, Organization: "IPv6 Networks"}},
+		{"[::ffff:192.0.2.1]:1234", geoip.ASInfo{Number: 64512, Organization: "Example Hosting, Inc."}},
+		{"192.0.1.255:1234", geoip.ASInfo{}},
+		{"203.0.113.1:1234", geoip.ASInfo{}},
+		{"[2001:db9::1]:1234", geoip.ASInfo{}},
+	}
+	for _, c := range cases {
+		info, err := table.LookupASN(c.address)
+		require.NoError(t, err, c.address)
+		require.Equal(t, c.info, info, c.address)
+	}
+
+	_, err = table.LookupASN("192.0.2.1")
+	require.Error(t, err)
+}
+
+func TestASNTable_Invalid(t *testing.T) {
+	for _, data := range []string{
+		"192.0.2.0/24,64512,Example\n192.0.2.128/25,64513,Overlapping\n",
+		"192.0.2.0/33,64512,Example\n",
+		"192.0.2.0/24,example,Example\n",
+		"192.0.2.0/24\n",
+	} {
+		_, err := geoip.LoadASNTable(strings.NewReader(data))
+		require.Error(t, err, data)
+	}
+}
+
+func TestOpenASNDB(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	path := ctx.File("asn.csv")
+	require.NoError(t, os.WriteFile(path, []byte(asnTableCSV), 0644))
+
+	db, err := geoip.OpenASNDB(path)
+	require.NoError(t, err)
+	defer ctx.Check(db.Close)
+
+	info, err := db.LookupASN("192.0.2.1:1234")
+	require.NoError(t, err)
+	require.Equal(t, uint32(64512), info.Number)
+}
@@ -0,0 +1,33 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package geoip
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// ASInfo describes the autonomous system (network operator, hosting provider) an IP address belongs to.
+type ASInfo struct {
+	// Number is the autonomous system number, zero when it is unknown.
+	Number uint32
+	// Organization is the name of the organization owning the autonomous system.
+	Organization string
+}
+
+// IPToASN defines an abstraction for resolving the autonomous system given the string representation of an IP address.
+type IPToASN interface {
+	Close() error
+	LookupASN(address string) (ASInfo, error)
+}
+
+// OpenASNDB opens the IP to ASN database at the provided path. Files with the
+// .csv extension are loaded as an offline ASNTable, anything else is opened as
+// a maxmind ASN database.
+func OpenASNDB(path string) (IPToASN, error) {
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return OpenASNTable(path)
+	}
+	return OpenMaxmindDB(path)
+}
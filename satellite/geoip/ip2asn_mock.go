@@ -0,0 +1,51 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package geoip
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// MockIPToASN provides a mock solution for looking up autonomous systems in testplanet tests. This is done using the
+// last byte of the ip address and mod'ing it into an autonomous system.
+type MockIPToASN []ASInfo
+
+// NewMockIPToASN creates a mock IPToASN based on predefined list of autonomous systems, defined as asn:organization.
+func NewMockIPToASN(asns []string) (MockIPToASN, error) {
+	result := MockIPToASN{}
+	for _, asn := range asns {
+		number, organization, _ := strings.Cut(asn, ":")
+		parsed, err := strconv.ParseUint(strings.TrimPrefix(number, "AS"), 10, 32)
+		if err != nil {
+			return nil, errs.New("invalid mock ASN %q: %w", asn, err)
+		}
+		result = append(result, ASInfo{Number: uint32(parsed), Organization: organization})
+	}
+	return result, nil
+}
+
+// Close does nothing for the MockIPToASN.
+func (m MockIPToASN) Close() error {
+	return nil
+}
+
+// LookupASN accepts an IP address.
+func (m MockIPToASN) LookupASN(address string) (ASInfo, error) {
+	if len(m) == 0 {
+		return ASInfo{}, nil
+	}
+
+	ip, err := addressToIP(address)
+	if err != nil || ip == nil {
+		return ASInfo{}, err
+	}
+
+	lastBlock := int(ip[len(ip)-1])
+	return m[lastBlock%len(m)], nil
+}
+
+var _ IPToASN = MockIPToASN{}
@@ -0,0 +1,34 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package geoip_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/geoip"
+)
+
+func TestIP2ASNMock(t *testing.T) {
+	empty, err := geoip.NewMockIPToASN(nil)
+	require.NoError(t, err)
+	info, err := empty.LookupASN("127.0.0.1:1234")
+	require.NoError(t, err)
+	require.Equal(t, geoip.ASInfo{}, info)
+
+	mock, err := geoip.NewMockIPToASN([]string{"64512:Example Hosting", "AS64513"})
+	require.NoError(t, err)
+
+	info, err = mock.LookupASN("127.0.0.2:1234")
+	require.NoError(t, err)
+	require.Equal(t, geoip.ASInfo{Number: 64512, Organization: "Example Hosting"}, info)
+
+	info, err = mock.LookupASN("127.0.0.1:1234")
+	require.NoError(t, err)
+	require.Equal(t, geoip.ASInfo{Number: 64513}, info)
+
+	_, err = geoip.NewMockIPToASN([]string{"invalid"})
+	require.Error(t, err)
+}
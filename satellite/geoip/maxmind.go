@@ -30,12 +30,18 @@ type country struct {
 	IsoCode string `maxminddb:"iso_code"`
 }
 
+type asnInfo struct {
+	Number       uint32 `maxminddb:"autonomous_system_number"`
+	Organization string `maxminddb:"autonomous_system_organization"`
+}
+
 // MaxmindDB provides access to GeoIP data via the maxmind geoip databases.
 type MaxmindDB struct {
 	db *maxminddb.Reader
 }
 
 var _ IPToCountry = &MaxmindDB{}
+var _ IPToASN = &MaxmindDB{}
 
 // Close will disconnect the underlying connection to the database.
 func (m *MaxmindDB) Close() error {
@@ -58,6 +64,22 @@ func (m *MaxmindDB) LookupISOCountryCode(address string) (location.CountryCode,
 	return toCountryCode(info), nil
 }
 
+// LookupASN accepts an IP address. It requires a maxmind ASN (or ISP) database.
+func (m *MaxmindDB) LookupASN(address string) (ASInfo, error) {
+	ip, err := addressToIP(address)
+	if err != nil || ip == nil {
+		return ASInfo{}, err
+	}
+
+	info := &asnInfo{}
+	err = m.db.Lookup(ip, info)
+	if err != nil {
+		return ASInfo{}, err
+	}
+
+	return ASInfo{Number: info.Number, Organization: info.Organization}, nil
+}
+
 func toCountryCode(info *ipInfo) location.CountryCode {
 	// it's a tricky situation when represented_country is returned (like an embassy or military base).
 	// we have only 1-2 such nodes. it's more safe to exclude them from geofencing.
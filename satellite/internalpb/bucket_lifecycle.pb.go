@@ -0,0 +1,460 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: bucket_lifecycle.proto
+
+package internalpb
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	pb "storj.io/common/pb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type LifecycleRule struct {
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Enabled bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Encrypted object key prefix the rule applies to.
+	Prefix                    []byte   `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	ExpirationDays            int32    `protobuf:"varint,4,opt,name=expiration_days,json=expirationDays,proto3" json:"expiration_days,omitempty"`
+	NoncurrentDays            int32    `protobuf:"varint,5,opt,name=noncurrent_days,json=noncurrentDays,proto3" json:"noncurrent_days,omitempty"`
+	NewerNoncurrentVersions   int32    `protobuf:"varint,6,opt,name=newer_noncurrent_versions,json=newerNoncurrentVersions,proto3" json:"newer_noncurrent_versions,omitempty"`
+	AbortIncompleteUploadDays int32    `protobuf:"varint,7,opt,name=abort_incomplete_upload_days,json=abortIncompleteUploadDays,proto3" json:"abort_incomplete_upload_days,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
+}
+
+func (m *LifecycleRule) Reset()         { *m = LifecycleRule{} }
+func (m *LifecycleRule) String() string { return proto.CompactTextString(m) }
+func (*LifecycleRule) ProtoMessage()    {}
+func (*LifecycleRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f9c940b8ebf6dc, []int{0}
+}
+func (m *LifecycleRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LifecycleRule.Unmarshal(m, b)
+}
+func (m *LifecycleRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LifecycleRule.Marshal(b, m, deterministic)
+}
+func (m *LifecycleRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LifecycleRule.Merge(m, src)
+}
+func (m *LifecycleRule) XXX_Size() int {
+	return xxx_messageInfo_LifecycleRule.Size(m)
+}
+func (m *LifecycleRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_LifecycleRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LifecycleRule proto.InternalMessageInfo
+
+func (m *LifecycleRule) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *LifecycleRule) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *LifecycleRule) GetPrefix() []byte {
+	if m != nil {
+		return m.Prefix
+	}
+	return nil
+}
+
+func (m *LifecycleRule) GetExpirationDays() int32 {
+	if m != nil {
+		return m.ExpirationDays
+	}
+	return 0
+}
+
+func (m *LifecycleRule) GetNoncurrentDays() int32 {
+	if m != nil {
+		return m.NoncurrentDays
+	}
+	return 0
+}
+
+func (m *LifecycleRule) GetNewerNoncurrentVersions() int32 {
+	if m != nil {
+		return m.NewerNoncurrentVersions
+	}
+	return 0
+}
+
+func (m *LifecycleRule) GetAbortIncompleteUploadDays() int32 {
+	if m != nil {
+		return m.AbortIncompleteUploadDays
+	}
+	return 0
+}
+
+type LifecycleConfiguration struct {
+	Rules                []*LifecycleRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *LifecycleConfiguration) Reset()         { *m = LifecycleConfiguration{} }
+func (m *LifecycleConfiguration) String() string { return proto.CompactTextString(m) }
+func (*LifecycleConfiguration) ProtoMessage()    {}
+func (*LifecycleConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f9c940b8ebf6dc, []int{1}
+}
+func (m *LifecycleConfiguration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LifecycleConfiguration.Unmarshal(m, b)
+}
+func (m *LifecycleConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LifecycleConfiguration.Marshal(b, m, deterministic)
+}
+func (m *LifecycleConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LifecycleConfiguration.Merge(m, src)
+}
+func (m *LifecycleConfiguration) XXX_Size() int {
+	return xxx_messageInfo_LifecycleConfiguration.Size(m)
+}
+func (m *LifecycleConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_LifecycleConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LifecycleConfiguration proto.InternalMessageInfo
+
+func (m *LifecycleConfiguration) GetRules() []*LifecycleRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type GetBucketLifecycleConfigurationRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Name                 []byte            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetBucketLifecycleConfigurationRequest) Reset() {
+	*m = GetBucketLifecycleConfigurationRequest{}
+}
+func (m *GetBucketLifecycleConfigurationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBucketLifecycleConfigurationRequest) ProtoMessage()    {}
+func (*GetBucketLifecycleConfigurationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f9c940b8ebf6dc, []int{2}
+}
+func (m *GetBucketLifecycleConfigurationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketLifecycleConfigurationRequest.Unmarshal(m, b)
+}
+func (m *GetBucketLifecycleConfigurationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketLifecycleConfigurationRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBucketLifecycleConfigurationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketLifecycleConfigurationRequest.Merge(m, src)
+}
+func (m *GetBucketLifecycleConfigurationRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBucketLifecycleConfigurationRequest.Size(m)
+}
+func (m *GetBucketLifecycleConfigurationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketLifecycleConfigurationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketLifecycleConfigurationRequest proto.InternalMessageInfo
+
+func (m *GetBucketLifecycleConfigurationRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *GetBucketLifecycleConfigurationRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+type GetBucketLifecycleConfigurationResponse struct {
+	// Not set when the bucket has no lifecycle configuration.
+	Configuration        *LifecycleConfiguration `protobuf:"bytes,1,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GetBucketLifecycleConfigurationResponse) Reset() {
+	*m = GetBucketLifecycleConfigurationResponse{}
+}
+func (m *GetBucketLifecycleConfigurationResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBucketLifecycleConfigurationResponse) ProtoMessage()    {}
+func (*GetBucketLifecycleConfigurationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f9c940b8ebf6dc, []int{3}
+}
+func (m *GetBucketLifecycleConfigurationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketLifecycleConfigurationResponse.Unmarshal(m, b)
+}
+func (m *GetBucketLifecycleConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketLifecycleConfigurationResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBucketLifecycleConfigurationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketLifecycleConfigurationResponse.Merge(m, src)
+}
+func (m *GetBucketLifecycleConfigurationResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBucketLifecycleConfigurationResponse.Size(m)
+}
+func (m *GetBucketLifecycleConfigurationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketLifecycleConfigurationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketLifecycleConfigurationResponse proto.InternalMessageInfo
+
+func (m *GetBucketLifecycleConfigurationResponse) GetConfiguration() *LifecycleConfiguration {
+	if m != nil {
+		return m.Configuration
+	}
+	return nil
+}
+
+type SetBucketLifecycleConfigurationRequest struct {
+	Header               *pb.RequestHeader       `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Name                 []byte                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Configuration        *LifecycleConfiguration `protobuf:"bytes,2,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *SetBucketLifecycleConfigurationRequest) Reset() {
+	*m = SetBucketLifecycleConfigurationRequest{}
+}
+func (m *SetBucketLifecycleConfigurationRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBucketLifecycleConfigurationRequest) ProtoMessage()    {}
+func (*SetBucketLifecycleConfigurationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f9c940b8ebf6dc, []int{4}
+}
+func (m *SetBucketLifecycleConfigurationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketLifecycleConfigurationRequest.Unmarshal(m, b)
+}
+func (m *SetBucketLifecycleConfigurationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketLifecycleConfigurationRequest.Marshal(b, m, deterministic)
+}
+func (m *SetBucketLifecycleConfigurationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketLifecycleConfigurationRequest.Merge(m, src)
+}
+func (m *SetBucketLifecycleConfigurationRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBucketLifecycleConfigurationRequest.Size(m)
+}
+func (m *SetBucketLifecycleConfigurationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketLifecycleConfigurationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketLifecycleConfigurationRequest proto.InternalMessageInfo
+
+func (m *SetBucketLifecycleConfigurationRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SetBucketLifecycleConfigurationRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *SetBucketLifecycleConfigurationRequest) GetConfiguration() *LifecycleConfiguration {
+	if m != nil {
+		return m.Configuration
+	}
+	return nil
+}
+
+type SetBucketLifecycleConfigurationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBucketLifecycleConfigurationResponse) Reset() {
+	*m = SetBucketLifecycleConfigurationResponse{}
+}
+func (m *SetBucketLifecycleConfigurationResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBucketLifecycleConfigurationResponse) ProtoMessage()    {}
+func (*SetBucketLifecycleConfigurationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f9c940b8ebf6dc, []int{5}
+}
+func (m *SetBucketLifecycleConfigurationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketLifecycleConfigurationResponse.Unmarshal(m, b)
+}
+func (m *SetBucketLifecycleConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketLifecycleConfigurationResponse.Marshal(b, m, deterministic)
+}
+func (m *SetBucketLifecycleConfigurationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketLifecycleConfigurationResponse.Merge(m, src)
+}
+func (m *SetBucketLifecycleConfigurationResponse) XXX_Size() int {
+	return xxx_messageInfo_SetBucketLifecycleConfigurationResponse.Size(m)
+}
+func (m *SetBucketLifecycleConfigurationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketLifecycleConfigurationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketLifecycleConfigurationResponse proto.InternalMessageInfo
+
+type DeleteBucketLifecycleConfigurationRequest struct {
+	Header               *pb.RequestHeader `protobuf:"bytes,15,opt,name=header,proto3" json:"header,omitempty"`
+	Name                 []byte            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DeleteBucketLifecycleConfigurationRequest) Reset() {
+	*m = DeleteBucketLifecycleConfigurationRequest{}
+}
+func (m *DeleteBucketLifecycleConfigurationRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*DeleteBucketLifecycleConfigurationRequest) ProtoMessage() {}
+func (*DeleteBucketLifecycleConfigurationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f9c940b8ebf6dc, []int{6}
+}
+func (m *DeleteBucketLifecycleConfigurationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteBucketLifecycleConfigurationRequest.Unmarshal(m, b)
+}
+func (m *DeleteBucketLifecycleConfigurationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteBucketLifecycleConfigurationRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteBucketLifecycleConfigurationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteBucketLifecycleConfigurationRequest.Merge(m, src)
+}
+func (m *DeleteBucketLifecycleConfigurationRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteBucketLifecycleConfigurationRequest.Size(m)
+}
+func (m *DeleteBucketLifecycleConfigurationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteBucketLifecycleConfigurationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteBucketLifecycleConfigurationRequest proto.InternalMessageInfo
+
+func (m *DeleteBucketLifecycleConfigurationRequest) GetHeader() *pb.RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *DeleteBucketLifecycleConfigurationRequest) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+type DeleteBucketLifecycleConfigurationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteBucketLifecycleConfigurationResponse) Reset() {
+	*m = DeleteBucketLifecycleConfigurationResponse{}
+}
+func (m *DeleteBucketLifecycleConfigurationResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*DeleteBucketLifecycleConfigurationResponse) ProtoMessage() {}
+func (*DeleteBucketLifecycleConfigurationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48f9c940b8ebf6dc, []int{7}
+}
+func (m *DeleteBucketLifecycleConfigurationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteBucketLifecycleConfigurationResponse.Unmarshal(m, b)
+}
+func (m *DeleteBucketLifecycleConfigurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteBucketLifecycleConfigurationResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteBucketLifecycleConfigurationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteBucketLifecycleConfigurationResponse.Merge(m, src)
+}
+func (m *DeleteBucketLifecycleConfigurationResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteBucketLifecycleConfigurationResponse.Size(m)
+}
+func (m *DeleteBucketLifecycleConfigurationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteBucketLifecycleConfigurationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteBucketLifecycleConfigurationResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*LifecycleRule)(nil), "satellite.bucket_lifecycle.LifecycleRule")
+	proto.RegisterType((*LifecycleConfiguration)(nil), "satellite.bucket_lifecycle.LifecycleConfiguration")
+	proto.RegisterType((*GetBucketLifecycleConfigurationRequest)(nil), "satellite.bucket_lifecycle.GetBucketLifecycleConfigurationRequest")
+	proto.RegisterType((*GetBucketLifecycleConfigurationResponse)(nil), "satellite.bucket_lifecycle.GetBucketLifecycleConfigurationResponse")
+	proto.RegisterType((*SetBucketLifecycleConfigurationRequest)(nil), "satellite.bucket_lifecycle.SetBucketLifecycleConfigurationRequest")
+	proto.RegisterType((*SetBucketLifecycleConfigurationResponse)(nil), "satellite.bucket_lifecycle.SetBucketLifecycleConfigurationResponse")
+	proto.RegisterType((*DeleteBucketLifecycleConfigurationRequest)(nil), "satellite.bucket_lifecycle.DeleteBucketLifecycleConfigurationRequest")
+	proto.RegisterType((*DeleteBucketLifecycleConfigurationResponse)(nil), "satellite.bucket_lifecycle.DeleteBucketLifecycleConfigurationResponse")
+}
+
+func init() { proto.RegisterFile("bucket_lifecycle.proto", fileDescriptor_48f9c940b8ebf6dc) }
+
+var fileDescriptor_48f9c940b8ebf6dc = []byte{
+	// 513 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xd9, 0x34, 0x49, 0x61, 0xd2, 0x26, 0xd2, 0x1e, 0x52, 0x37, 0x42, 0xc2, 0x32, 0xa2,
+	0x71, 0x10, 0x72, 0xa4, 0x70, 0xe3, 0x52, 0x29, 0x2d, 0xff, 0x24, 0xc4, 0xc1, 0x11, 0x08, 0xb8,
+	0x44, 0x1b, 0x7b, 0x02, 0x0b, 0x9b, 0x5d, 0xb3, 0x5e, 0x43, 0x73, 0xe6, 0x45, 0xb8, 0x71, 0xe1,
+	0xca, 0x33, 0xf0, 0x5a, 0xa8, 0xeb, 0xc4, 0x6d, 0x0a, 0x34, 0x96, 0x52, 0xf5, 0xe6, 0xdd, 0xf9,
+	0xed, 0xcc, 0x37, 0xdf, 0x8c, 0x64, 0x68, 0x4f, 0xb2, 0xe8, 0x13, 0x9a, 0xb1, 0xe0, 0x53, 0x8c,
+	0xe6, 0x91, 0xc0, 0x20, 0xd1, 0xca, 0x28, 0xda, 0x49, 0x99, 0x41, 0x21, 0xb8, 0xc1, 0xe0, 0x22,
+	0xd1, 0x69, 0xce, 0xd0, 0x30, 0x2e, 0xa7, 0x2a, 0x67, 0xbd, 0xef, 0x15, 0xd8, 0x7d, 0xb1, 0x8c,
+	0x86, 0x99, 0x40, 0xda, 0x84, 0x0a, 0x8f, 0x1d, 0xe2, 0x12, 0xff, 0x56, 0x58, 0xe1, 0x31, 0x75,
+	0x60, 0x1b, 0x25, 0x9b, 0x08, 0x8c, 0x9d, 0x8a, 0x4b, 0xfc, 0x9b, 0xe1, 0xf2, 0x48, 0xdb, 0x50,
+	0x4f, 0x34, 0x4e, 0xf9, 0x89, 0xb3, 0xe5, 0x12, 0x7f, 0x27, 0x5c, 0x9c, 0x68, 0x17, 0x5a, 0x78,
+	0x92, 0x70, 0xcd, 0x0c, 0x57, 0x72, 0x1c, 0xb3, 0x79, 0xea, 0x54, 0x5d, 0xe2, 0xd7, 0xc2, 0xe6,
+	0xd9, 0xf5, 0x31, 0x9b, 0xa7, 0xa7, 0xa0, 0x54, 0x32, 0xca, 0xb4, 0x46, 0x69, 0x72, 0xb0, 0x96,
+	0x83, 0x67, 0xd7, 0x16, 0x7c, 0x04, 0xfb, 0x12, 0xbf, 0xa2, 0x1e, 0x9f, 0xc3, 0xbf, 0xa0, 0x4e,
+	0xb9, 0x92, 0xa9, 0x53, 0xb7, 0x4f, 0xf6, 0x2c, 0xf0, 0xb2, 0x88, 0xbf, 0x5e, 0x84, 0xe9, 0x21,
+	0xdc, 0x66, 0x13, 0xa5, 0xcd, 0x98, 0xcb, 0x48, 0xcd, 0x12, 0x81, 0x06, 0xc7, 0x59, 0x22, 0x14,
+	0x8b, 0xf3, 0x8a, 0xdb, 0xf6, 0xf9, 0xbe, 0x65, 0x9e, 0x17, 0xc8, 0x2b, 0x4b, 0x9c, 0x16, 0xf7,
+	0xde, 0x42, 0xbb, 0x70, 0xe8, 0x48, 0xc9, 0x29, 0x7f, 0x9f, 0xe5, 0x3d, 0xd0, 0x43, 0xa8, 0xe9,
+	0x4c, 0x60, 0xea, 0x10, 0x77, 0xcb, 0x6f, 0x0c, 0x7a, 0xc1, 0xff, 0x8d, 0x0f, 0x56, 0x4c, 0x0e,
+	0xf3, 0x77, 0xde, 0x0c, 0x0e, 0x9e, 0xa2, 0x19, 0x5a, 0xf6, 0xdf, 0x35, 0x42, 0xfc, 0x9c, 0x61,
+	0x6a, 0x68, 0x1f, 0xea, 0x1f, 0x90, 0xc5, 0xa8, 0x9d, 0x96, 0x4b, 0xfc, 0xc6, 0x60, 0x2f, 0x28,
+	0x06, 0xb9, 0x40, 0x9e, 0xd9, 0x70, 0xb8, 0xc0, 0x28, 0x85, 0xaa, 0x64, 0x33, 0xb4, 0x83, 0xdc,
+	0x09, 0xed, 0xb7, 0xf7, 0x8d, 0x40, 0x77, 0x6d, 0xbd, 0x34, 0x51, 0x32, 0x45, 0xfa, 0x06, 0x76,
+	0xa3, 0xf3, 0x01, 0x9b, 0xa8, 0x31, 0x18, 0x94, 0xea, 0x71, 0x35, 0xe5, 0x6a, 0x22, 0xef, 0x37,
+	0x81, 0x83, 0xd1, 0xf5, 0x75, 0xfd, 0x77, 0x27, 0x95, 0xab, 0xea, 0xa4, 0x07, 0xdd, 0x51, 0x39,
+	0x3b, 0xbd, 0x04, 0x7a, 0xc7, 0x28, 0xd0, 0xe0, 0xb5, 0x0d, 0xfb, 0x01, 0xdc, 0x2f, 0x53, 0x31,
+	0xd7, 0x37, 0xf8, 0x51, 0x85, 0xd6, 0x05, 0x90, 0xfe, 0x24, 0x70, 0x67, 0xcd, 0xba, 0xd0, 0xe1,
+	0x65, 0x2e, 0x96, 0xdb, 0xed, 0xce, 0xd1, 0x46, 0x39, 0x16, 0x06, 0xdf, 0xb0, 0x72, 0x47, 0x9b,
+	0xc8, 0x1d, 0x5d, 0x81, 0xdc, 0x51, 0x69, 0xb9, 0xbf, 0x08, 0x78, 0xeb, 0x07, 0x44, 0x1f, 0x5f,
+	0x56, 0xad, 0xf4, 0x4a, 0x75, 0x9e, 0x6c, 0x9a, 0x66, 0xa9, 0x7b, 0x78, 0xef, 0xdd, 0xdd, 0xd4,
+	0x28, 0xfd, 0x31, 0xe0, 0xaa, 0x6f, 0x3f, 0xfa, 0x45, 0xe6, 0x3e, 0x97, 0x06, 0xb5, 0x64, 0x22,
+	0x99, 0x4c, 0xea, 0xf6, 0xff, 0xf2, 0xf0, 0xcf, 0x00, 0x59, 0x15, 0xa3, 0xf6, 0xa5, 0x06, 0x00,
+	0x00,
+}
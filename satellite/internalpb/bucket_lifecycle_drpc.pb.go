@@ -0,0 +1,207 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.35-0.20250513201419-f7819ea69b55
+// source: bucket_lifecycle.proto
+
+package internalpb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_bucket_lifecycle_proto struct{}
+
+func (drpcEncoding_File_bucket_lifecycle_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_bucket_lifecycle_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_bucket_lifecycle_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_bucket_lifecycle_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCBucketLifecycleClient interface {
+	DRPCConn() drpc.Conn
+
+	GetBucketLifecycleConfiguration(ctx context.Context, in *GetBucketLifecycleConfigurationRequest) (*GetBucketLifecycleConfigurationResponse, error)
+	SetBucketLifecycleConfiguration(ctx context.Context, in *SetBucketLifecycleConfigurationRequest) (*SetBucketLifecycleConfigurationResponse, error)
+	DeleteBucketLifecycleConfiguration(ctx context.Context, in *DeleteBucketLifecycleConfigurationRequest) (*DeleteBucketLifecycleConfigurationResponse, error)
+}
+
+type drpcBucketLifecycleClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCBucketLifecycleClient(cc drpc.Conn) DRPCBucketLifecycleClient {
+	return &drpcBucketLifecycleClient{cc}
+}
+
+func (c *drpcBucketLifecycleClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcBucketLifecycleClient) GetBucketLifecycleConfiguration(ctx context.Context, in *GetBucketLifecycleConfigurationRequest) (*GetBucketLifecycleConfigurationResponse, error) {
+	out := new(GetBucketLifecycleConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/satellite.bucket_lifecycle.BucketLifecycle/GetBucketLifecycleConfiguration", drpcEncoding_File_bucket_lifecycle_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcBucketLifecycleClient) SetBucketLifecycleConfiguration(ctx context.Context, in *SetBucketLifecycleConfigurationRequest) (*SetBucketLifecycleConfigurationResponse, error) {
+	out := new(SetBucketLifecycleConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/satellite.bucket_lifecycle.BucketLifecycle/SetBucketLifecycleConfiguration", drpcEncoding_File_bucket_lifecycle_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcBucketLifecycleClient) DeleteBucketLifecycleConfiguration(ctx context.Context, in *DeleteBucketLifecycleConfigurationRequest) (*DeleteBucketLifecycleConfigurationResponse, error) {
+	out := new(DeleteBucketLifecycleConfigurationResponse)
+	err := c.cc.Invoke(ctx, "/satellite.bucket_lifecycle.BucketLifecycle/DeleteBucketLifecycleConfiguration", drpcEncoding_File_bucket_lifecycle_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCBucketLifecycleServer interface {
+	GetBucketLifecycleConfiguration(context.Context, *GetBucketLifecycleConfigurationRequest) (*GetBucketLifecycleConfigurationResponse, error)
+	SetBucketLifecycleConfiguration(context.Context, *SetBucketLifecycleConfigurationRequest) (*SetBucketLifecycleConfigurationResponse, error)
+	DeleteBucketLifecycleConfiguration(context.Context, *DeleteBucketLifecycleConfigurationRequest) (*DeleteBucketLifecycleConfigurationResponse, error)
+}
+
+type DRPCBucketLifecycleUnimplementedServer struct{}
+
+func (s *DRPCBucketLifecycleUnimplementedServer) GetBucketLifecycleConfiguration(context.Context, *GetBucketLifecycleConfigurationRequest) (*GetBucketLifecycleConfigurationResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCBucketLifecycleUnimplementedServer) SetBucketLifecycleConfiguration(context.Context, *SetBucketLifecycleConfigurationRequest) (*SetBucketLifecycleConfigurationResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCBucketLifecycleUnimplementedServer) DeleteBucketLifecycleConfiguration(context.Context, *DeleteBucketLifecycleConfigurationRequest) (*DeleteBucketLifecycleConfigurationResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCBucketLifecycleDescription struct{}
+
+func (DRPCBucketLifecycleDescription) NumMethods() int { return 3 }
+
+func (DRPCBucketLifecycleDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/satellite.bucket_lifecycle.BucketLifecycle/GetBucketLifecycleConfiguration", drpcEncoding_File_bucket_lifecycle_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCBucketLifecycleServer).
+					GetBucketLifecycleConfiguration(
+						ctx,
+						in1.(*GetBucketLifecycleConfigurationRequest),
+					)
+			}, DRPCBucketLifecycleServer.GetBucketLifecycleConfiguration, true
+	case 1:
+		return "/satellite.bucket_lifecycle.BucketLifecycle/SetBucketLifecycleConfiguration", drpcEncoding_File_bucket_lifecycle_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCBucketLifecycleServer).
+					SetBucketLifecycleConfiguration(
+						ctx,
+						in1.(*SetBucketLifecycleConfigurationRequest),
+					)
+			}, DRPCBucketLifecycleServer.SetBucketLifecycleConfiguration, true
+	case 2:
+		return "/satellite.bucket_lifecycle.BucketLifecycle/DeleteBucketLifecycleConfiguration", drpcEncoding_File_bucket_lifecycle_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCBucketLifecycleServer).
+					DeleteBucketLifecycleConfiguration(
+						ctx,
+						in1.(*DeleteBucketLifecycleConfigurationRequest),
+					)
+			}, DRPCBucketLifecycleServer.DeleteBucketLifecycleConfiguration, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterBucketLifecycle(mux drpc.Mux, impl DRPCBucketLifecycleServer) error {
+	return mux.Register(impl, DRPCBucketLifecycleDescription{})
+}
+
+type DRPCBucketLifecycle_GetBucketLifecycleConfigurationStream interface {
+	drpc.Stream
+	SendAndClose(*GetBucketLifecycleConfigurationResponse) error
+}
+
+type drpcBucketLifecycle_GetBucketLifecycleConfigurationStream struct {
+	drpc.Stream
+}
+
+func (x *drpcBucketLifecycle_GetBucketLifecycleConfigurationStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcBucketLifecycle_GetBucketLifecycleConfigurationStream) SendAndClose(m *GetBucketLifecycleConfigurationResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_bucket_lifecycle_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCBucketLifecycle_SetBucketLifecycleConfigurationStream interface {
+	drpc.Stream
+	SendAndClose(*SetBucketLifecycleConfigurationResponse) error
+}
+
+type drpcBucketLifecycle_SetBucketLifecycleConfigurationStream struct {
+	drpc.Stream
+}
+
+func (x *drpcBucketLifecycle_SetBucketLifecycleConfigurationStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcBucketLifecycle_SetBucketLifecycleConfigurationStream) SendAndClose(m *SetBucketLifecycleConfigurationResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_bucket_lifecycle_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCBucketLifecycle_DeleteBucketLifecycleConfigurationStream interface {
+	drpc.Stream
+	SendAndClose(*DeleteBucketLifecycleConfigurationResponse) error
+}
+
+type drpcBucketLifecycle_DeleteBucketLifecycleConfigurationStream struct {
+	drpc.Stream
+}
+
+func (x *drpcBucketLifecycle_DeleteBucketLifecycleConfigurationStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcBucketLifecycle_DeleteBucketLifecycleConfigurationStream) SendAndClose(m *DeleteBucketLifecycleConfigurationResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_bucket_lifecycle_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
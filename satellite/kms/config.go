@@ -7,17 +7,39 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/pflag"
 )
 
 // Config is a configuration struct for secret management Service.
 type Config struct {
-	Provider         string   `help:"the provider of the passphrase encryption keys: 'gsm' for google, 'local' for a local file" default:"gsm"`
-	KeyInfos         KeyInfos `help:"semicolon-separated key-id:version,checksum. With 'gsm' provider, version is the resource name. With 'local', it is the file path. Checksum is the integer crc32c checksum of the key data" default:""`
+	Provider         string   `help:"the provider of the passphrase encryption keys: 'gsm' for google, 'local' for a local file, 'vault-kv' for a HashiCorp Vault KV secret, 'vault-transit' for a key file wrapped by HashiCorp Vault Transit, 'pkcs11' for a PKCS#11 HSM" default:"gsm"`
+	KeyInfos         KeyInfos `help:"semicolon-separated key-id:version,checksum. With 'gsm' provider, version is the resource name. With 'local' and 'vault-transit', it is the file path. With 'vault-kv', it is the secret path, including the mount. With 'pkcs11', it is the label of the key object. Checksum is the integer crc32c checksum of the key data" default:""`
 	DefaultMasterKey int      `help:"the key ID to use for passphrase encryption." default:"1"`
 	TestMasterKey    string   `help:"[DEPRECATED] For testing, use --kms.mock-client and --kms.key-infos. A fake master key to be used for the purpose of testing." releaseDefault:"" devDefault:"test-master-key" hidden:"true"`
 	MockClient       bool     `help:"whether to use mock google secret manager service." releaseDefault:"false" devDefault:"false" testDefault:"true" hidden:"true"`
+
+	Vault  VaultConfig
+	PKCS11 PKCS11Config
+}
+
+// VaultConfig is the configuration of the HashiCorp Vault providers.
+type VaultConfig struct {
+	Address      string        `help:"address of the Vault server" default:"http://127.0.0.1:8200"`
+	Token        string        `help:"token to authenticate to Vault. The VAULT_TOKEN environment variable is used when empty" default:""`
+	Namespace    string        `help:"Vault Enterprise namespace of the secrets" default:""`
+	KVField      string        `help:"field of the KV secret which contains the key data" default:"key"`
+	TransitMount string        `help:"mount path of the Transit secrets engine" default:"transit"`
+	TransitKey   string        `help:"name of the Transit key which wraps the key files" default:""`
+	Timeout      time.Duration `help:"timeout of the requests to Vault" default:"30s"`
+}
+
+// PKCS11Config is the configuration of the PKCS#11 provider.
+type PKCS11Config struct {
+	Module     string `help:"path of the PKCS#11 module (shared library) of the HSM" default:""`
+	TokenLabel string `help:"label of the token which stores the keys" default:""`
+	PIN        string `help:"user PIN of the token" default:""`
 }
 
 // KeyInfo contains the location and checksum of a key.
@@ -0,0 +1,222 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package passphraserotation re-encrypts the satellite managed project
+// passphrases with the default master key, while the satellite is running.
+package passphraserotation
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/kms"
+)
+
+var (
+	// Error is the error class for the package.
+	Error = errs.Class("passphrase rotation")
+
+	mon = monkit.Package()
+)
+
+// Config contains the configuration of the passphrase rotation chore.
+type Config struct {
+	Enabled   bool          `help:"whether to re-encrypt the project passphrases, which are encrypted with other keys, with the default master key" default:"false"`
+	Interval  time.Duration `help:"how often to look for passphrases encrypted with other keys" default:"1h"`
+	BatchSize int           `help:"number of passphrases to re-encrypt in a batch" default:"100"`
+}
+
+// Progress is the progress of the rotation from one key to the default key.
+type Progress struct {
+	KeyID int
+	// Total is the number of passphrases encrypted with the key when the rotation started.
+	Total   int64
+	Rotated int64
+	Failed  int64
+	// LastProjectID is the last processed project.
+	LastProjectID uuid.UUID
+	StartedAt     time.Time
+	FinishedAt    time.Time
+}
+
+// Chore re-encrypts the project passphrases with the default master key.
+//
+// architecture: Chore
+type Chore struct {
+	log      *zap.Logger
+	kms      *kms.Service
+	projects console.Projects
+	config   Config
+
+	Loop *sync2.Cycle
+
+	mu       sync.Mutex
+	progress map[int]Progress
+}
+
+// NewChore creates a new passphrase rotation chore.
+func NewChore(log *zap.Logger, kmsService *kms.Service, projects console.Projects, config Config) *Chore {
+	return &Chore{
+		log:      log,
+		kms:      kmsService,
+		projects: projects,
+		config:   config,
+		Loop:     sync2.NewCycle(config.Interval),
+		progress: make(map[int]Progress),
+	}
+}
+
+// Run runs the passphrase rotation chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := chore.RotateAll(ctx); err != nil {
+			chore.log.Error("error rotating passphrases", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// RotateAll re-encrypts the passphrases of all the keys, except the default one.
+func (chore *Chore) RotateAll(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyIDs, err := chore.kms.KeyIDs(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var group errs.Group
+	for _, keyID := range keyIDs {
+		if keyID == chore.kms.DefaultKeyID() {
+			continue
+		}
+		group.Add(chore.rotateKey(ctx, keyID))
+	}
+	return group.Err()
+}
+
+// rotateKey re-encrypts the passphrases, which are encrypted with the key, in batches.
+func (chore *Chore) rotateKey(ctx context.Context, keyID int) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	total, err := chore.projects.CountEncryptedPassphrases(ctx, keyID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if total == 0 {
+		return nil
+	}
+
+	progress := Progress{
+		KeyID:     keyID,
+		Total:     total,
+		StartedAt: time.Now(),
+	}
+	chore.setProgress(progress)
+
+	log := chore.log.With(zap.Int("old_key_id", keyID), zap.Int("new_key_id", chore.kms.DefaultKeyID()))
+	log.Info("rotating project passphrases", zap.Int64("total", total))
+
+	for {
+		passphrases, err := chore.projects.ListEncryptedPassphrases(ctx, keyID, progress.LastProjectID, chore.config.BatchSize)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(passphrases) == 0 {
+			break
+		}
+
+		for _, passphrase := range passphrases {
+			progress.LastProjectID = passphrase.ProjectID
+
+			rotated, err := chore.rotate(ctx, passphrase)
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				// The passphrase will be retried in the next cycle.
+				progress.Failed++
+				log.Warn("unable to rotate project passphrase", zap.Stringer("project_id", passphrase.ProjectID), zap.Error(err))
+				continue
+			}
+			if rotated {
+				progress.Rotated++
+			}
+		}
+
+		chore.setProgress(progress)
+		mon.IntVal("passphrase_rotation_remaining").Observe(progress.Total - progress.Rotated)
+		log.Info("batch of project passphrases rotated",
+			zap.Int64("rotated", progress.Rotated),
+			zap.Int64("failed", progress.Failed),
+			zap.Int64("total", progress.Total),
+			zap.Stringer("last_project_id", progress.LastProjectID))
+	}
+
+	progress.FinishedAt = time.Now()
+	chore.setProgress(progress)
+	log.Info("project passphrases rotated",
+		zap.Int64("rotated", progress.Rotated),
+		zap.Int64("failed", progress.Failed),
+		zap.Duration("duration", progress.FinishedAt.Sub(progress.StartedAt)))
+
+	return nil
+}
+
+// rotate re-encrypts a single passphrase. It returns false, when the passphrase
+// was changed concurrently.
+func (chore *Chore) rotate(ctx context.Context, passphrase console.EncryptedPassphrase) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	plain, err := chore.kms.DecryptPassphrase(ctx, passphrase.KeyID, passphrase.PassphraseEnc)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+
+	encrypted, keyID, err := chore.kms.EncryptPassphrase(ctx, plain)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+
+	updated, err := chore.projects.UpdateEncryptedPassphrase(ctx, passphrase.ProjectID, passphrase.KeyID, encrypted, keyID)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	if updated {
+		mon.Counter("passphrase_rotation_rotated").Inc(1)
+	}
+	return updated, nil
+}
+
+func (chore *Chore) setProgress(progress Progress) {
+	chore.mu.Lock()
+	defer chore.mu.Unlock()
+	chore.progress[progress.KeyID] = progress
+}
+
+// Progress returns the progress of the latest rotation of each key.
+func (chore *Chore) Progress() map[int]Progress {
+	chore.mu.Lock()
+	defer chore.mu.Unlock()
+
+	progress := make(map[int]Progress, len(chore.progress))
+	for keyID, p := range chore.progress {
+		progress[keyID] = p
+	}
+	return progress
+}
+
+// Close stops the passphrase rotation chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Stop()
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package passphraserotation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/kms"
+	"storj.io/storj/satellite/kms/passphraserotation"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestChore(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		var keyInfos kms.KeyInfos
+		require.NoError(t, keyInfos.Set("1:secretversion1,1;2:secretversion2,1"))
+
+		// oldService encrypts with the old key, the chore rotates to the new key.
+		oldService := kms.NewService(kms.Config{
+			Provider:         "gsm",
+			MockClient:       true,
+			KeyInfos:         keyInfos,
+			DefaultMasterKey: 1,
+		})
+		require.NoError(t, oldService.Initialize(ctx))
+
+		newService := kms.NewService(kms.Config{
+			Provider:         "gsm",
+			MockClient:       true,
+			KeyInfos:         keyInfos,
+			DefaultMasterKey: 2,
+		})
+		require.NoError(t, newService.Initialize(ctx))
+
+		projects := db.Console().Projects()
+
+		owner, err := db.Console().Users().Insert(ctx, &console.User{
+			ID:           testrand.UUID(),
+			FullName:     "Owner",
+			Email:        "owner@mail.test",
+			PasswordHash: []byte("password"),
+		})
+		require.NoError(t, err)
+
+		const count = 5
+		passphrases := make(map[uuid.UUID][]byte)
+		for i := 0; i < count; i++ {
+			passphrase := testrand.BytesInt(32)
+			encrypted, keyID, err := oldService.EncryptPassphrase(ctx, passphrase)
+			require.NoError(t, err)
+
+			project, err := projects.Insert(ctx, &console.Project{
+				Name:               "project",
+				OwnerID:            owner.ID,
+				PassphraseEnc:      encrypted,
+				PassphraseEncKeyID: &keyID,
+			})
+			require.NoError(t, err)
+			passphrases[project.ID] = passphrase
+		}
+
+		// A project without managed passphrase must not be touched.
+		unmanaged, err := projects.Insert(ctx, &console.Project{
+			Name:    "unmanaged",
+			OwnerID: owner.ID,
+		})
+		require.NoError(t, err)
+
+		chore := passphraserotation.NewChore(zaptest.NewLogger(t), newService, projects, passphraserotation.Config{
+			BatchSize: 2,
+		})
+		require.NoError(t, chore.RotateAll(ctx))
+
+		progress := chore.Progress()[1]
+		require.EqualValues(t, count, progress.Total)
+		require.EqualValues(t, count, progress.Rotated)
+		require.Zero(t, progress.Failed)
+		require.False(t, progress.FinishedAt.IsZero())
+
+		remaining, err := projects.CountEncryptedPassphrases(ctx, 1)
+		require.NoError(t, err)
+		require.Zero(t, remaining)
+
+		rotated, err := projects.CountEncryptedPassphrases(ctx, 2)
+		require.NoError(t, err)
+		require.EqualValues(t, count, rotated)
+
+		for id, expected := range passphrases {
+			encrypted, keyID, err := projects.GetEncryptedPassphrase(ctx, id)
+			require.NoError(t, err)
+			require.NotNil(t, keyID)
+			require.Equal(t, 2, *keyID)
+
+			passphrase, err := newService.DecryptPassphrase(ctx, *keyID, encrypted)
+			require.NoError(t, err)
+			require.Equal(t, expected, passphrase)
+		}
+
+		encrypted, keyID, err := projects.GetEncryptedPassphrase(ctx, unmanaged.ID)
+		require.NoError(t, err)
+		require.Nil(t, encrypted)
+		require.Nil(t, keyID)
+
+		// Nothing is left to rotate.
+		require.NoError(t, chore.RotateAll(ctx))
+		require.EqualValues(t, count, chore.Progress()[1].Rotated)
+	})
+}
@@ -0,0 +1,15 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package passphraserotation
+
+import (
+	"storj.io/storj/shared/modular/config"
+	"storj.io/storj/shared/mud"
+)
+
+// Module is a mud module definition.
+func Module(ball *mud.Ball) {
+	config.RegisterConfig[Config](ball, "key-management-rotation")
+	mud.Provide[*Chore](ball, NewChore)
+}
@@ -0,0 +1,158 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+//go:build pkcs11
+
+package kms
+
+import (
+	"context"
+	"hash/crc32"
+	"strings"
+
+	"github.com/miekg/pkcs11"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+)
+
+// pkcs11Service gets encryption keys from a PKCS#11 token (HSM).
+//
+// The keys are looked up by label, and they must be readable (data objects, or
+// extractable and non-sensitive secret keys).
+type pkcs11Service struct {
+	config  Config
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+}
+
+func newPKCS11Service(config Config) (_ *pkcs11Service, err error) {
+	if config.PKCS11.Module == "" {
+		return nil, Error.New("PKCS#11 module is not set")
+	}
+
+	p11 := pkcs11.New(config.PKCS11.Module)
+	if p11 == nil {
+		return nil, Error.New("unable to load PKCS#11 module %q", config.PKCS11.Module)
+	}
+	if err := p11.Initialize(); err != nil {
+		p11.Destroy()
+		return nil, Error.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, Error.Wrap(p11.Finalize()))
+			p11.Destroy()
+		}
+	}()
+
+	slot, err := findSlot(p11, config.PKCS11.TokenLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := p11.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if err := p11.Login(session, pkcs11.CKU_USER, config.PKCS11.PIN); err != nil {
+		return nil, errs.Combine(Error.Wrap(err), Error.Wrap(p11.CloseSession(session)))
+	}
+
+	return &pkcs11Service{
+		config:  config,
+		ctx:     p11,
+		session: session,
+	}, nil
+}
+
+// findSlot returns the slot of the token with the label.
+func findSlot(p11 *pkcs11.Ctx, label string) (uint, error) {
+	slots, err := p11.GetSlotList(true)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	for _, slot := range slots {
+		info, err := p11.GetTokenInfo(slot)
+		if err != nil {
+			return 0, Error.Wrap(err)
+		}
+		if strings.TrimSpace(info.Label) == label {
+			return slot, nil
+		}
+	}
+	return 0, Error.New("PKCS#11 token %q not found", label)
+}
+
+// GetKeys gets keys from source.
+func (s *pkcs11Service) GetKeys(ctx context.Context) (keys map[int]*storj.Key, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keys = make(map[int]*storj.Key)
+
+	crc32c := crc32.MakeTable(crc32.Castagnoli)
+
+	for id, k := range s.config.KeyInfos.Values {
+		data, err := s.readValue(k.SecretVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(data) == 0 {
+			return nil, Error.New("empty key data")
+		}
+
+		if k.SecretChecksum != int64(crc32.Checksum(data, crc32c)) {
+			return nil, Error.New("checksum mismatch")
+		}
+
+		keys[id], err = storj.NewKey(data)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	return keys, nil
+}
+
+// readValue reads the value of the object with the label.
+func (s *pkcs11Service) readValue(label string) (_ []byte, err error) {
+	err = s.ctx.FindObjectsInit(s.session, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	objects, _, err := s.ctx.FindObjects(s.session, 2)
+	err = errs.Combine(err, s.ctx.FindObjectsFinal(s.session))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	switch len(objects) {
+	case 0:
+		return nil, Error.New("PKCS#11 object %q not found", label)
+	case 1:
+	default:
+		return nil, Error.New("multiple PKCS#11 objects with label %q", label)
+	}
+
+	attrs, err := s.ctx.GetAttributeValue(s.session, objects[0], []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE, nil),
+	})
+	if err != nil {
+		return nil, Error.New("unable to read PKCS#11 object %q: %w", label, err)
+	}
+	return attrs[0].Value, nil
+}
+
+// Close closes the service.
+func (s *pkcs11Service) Close() error {
+	err := errs.Combine(
+		s.ctx.Logout(s.session),
+		s.ctx.CloseSession(s.session),
+		s.ctx.Finalize(),
+	)
+	s.ctx.Destroy()
+	return Error.Wrap(err)
+}
@@ -0,0 +1,12 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+//go:build !pkcs11
+
+package kms
+
+// newPKCS11Service returns an error, as PKCS#11 requires cgo and the module
+// of the HSM, and it's only included with the pkcs11 build tag.
+func newPKCS11Service(config Config) (SecretsService, error) {
+	return nil, Error.New("PKCS#11 provider is not supported by this build, it requires the 'pkcs11' build tag")
+}
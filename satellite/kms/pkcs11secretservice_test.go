@@ -0,0 +1,133 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+//go:build pkcs11
+
+package kms_test
+
+import (
+	"fmt"
+	"hash/crc32"
+	"os"
+	"testing"
+
+	"github.com/miekg/pkcs11"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/kms"
+)
+
+// TestPKCS11Service requires an initialized token, e.g. with SoftHSM:
+//
+//	softhsm2-util --init-token --free --label storj --pin 1234 --so-pin 1234
+//	STORJ_TEST_PKCS11_MODULE=/usr/lib/softhsm/libsofthsm2.so STORJ_TEST_PKCS11_TOKEN=storj STORJ_TEST_PKCS11_PIN=1234 \
+//		go test -tags pkcs11 -run TestPKCS11Service ./satellite/kms
+func TestPKCS11Service(t *testing.T) {
+	module := os.Getenv("STORJ_TEST_PKCS11_MODULE")
+	if module == "" {
+		t.Skip("STORJ_TEST_PKCS11_MODULE is not set")
+	}
+	config := kms.PKCS11Config{
+		Module:     module,
+		TokenLabel: os.Getenv("STORJ_TEST_PKCS11_TOKEN"),
+		PIN:        os.Getenv("STORJ_TEST_PKCS11_PIN"),
+	}
+
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	label := "storj-test-" + testrand.UUID().String()
+	data := testrand.BytesInt(32)
+	createDataObject(t, config, label, data)
+
+	checksum := crc32.Checksum(data, crc32.MakeTable(crc32.Castagnoli))
+
+	initialize := func(keyInfos string) (*kms.Service, error) {
+		var ki kms.KeyInfos
+		require.NoError(t, ki.Set(keyInfos))
+		service := kms.NewService(kms.Config{
+			Provider:         "pkcs11",
+			KeyInfos:         ki,
+			DefaultMasterKey: 1,
+			PKCS11:           config,
+		})
+		return service, service.Initialize(ctx)
+	}
+
+	service, err := initialize(fmt.Sprintf("1:%s,%d", label, checksum))
+	require.NoError(t, err)
+
+	encrypted, keyID, err := service.GenerateEncryptedPassphrase(ctx)
+	require.NoError(t, err)
+	_, err = service.DecryptPassphrase(ctx, keyID, encrypted)
+	require.NoError(t, err)
+
+	_, err = initialize(fmt.Sprintf("1:%s,%d", label, checksum+1))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "checksum mismatch")
+
+	_, err = initialize("1:storj-test-missing,1")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "not found")
+}
+
+// createDataObject stores the data on the token, and removes it at the end of the test.
+func createDataObject(t *testing.T, config kms.PKCS11Config, label string, data []byte) {
+	withSession(t, config, func(p11 *pkcs11.Ctx, session pkcs11.SessionHandle) {
+		_, err := p11.CreateObject(session, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_DATA),
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+			pkcs11.NewAttribute(pkcs11.CKA_VALUE, data),
+		})
+		require.NoError(t, err)
+	})
+
+	t.Cleanup(func() {
+		withSession(t, config, func(p11 *pkcs11.Ctx, session pkcs11.SessionHandle) {
+			require.NoError(t, p11.FindObjectsInit(session, []*pkcs11.Attribute{
+				pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+			}))
+			objects, _, err := p11.FindObjects(session, 10)
+			require.NoError(t, err)
+			require.NoError(t, p11.FindObjectsFinal(session))
+
+			for _, object := range objects {
+				require.NoError(t, p11.DestroyObject(session, object))
+			}
+		})
+	})
+}
+
+func withSession(t *testing.T, config kms.PKCS11Config, fn func(p11 *pkcs11.Ctx, session pkcs11.SessionHandle)) {
+	p11 := pkcs11.New(config.Module)
+	require.NotNil(t, p11)
+	require.NoError(t, p11.Initialize())
+	defer p11.Destroy()
+	defer func() { require.NoError(t, p11.Finalize()) }()
+
+	slots, err := p11.GetSlotList(true)
+	require.NoError(t, err)
+
+	for _, slot := range slots {
+		info, err := p11.GetTokenInfo(slot)
+		require.NoError(t, err)
+		if info.Label != config.TokenLabel {
+			continue
+		}
+
+		session, err := p11.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+		require.NoError(t, err)
+		defer func() { require.NoError(t, p11.CloseSession(session)) }()
+
+		require.NoError(t, p11.Login(session, pkcs11.CKU_USER, config.PIN))
+		defer func() { require.NoError(t, p11.Logout(session)) }()
+
+		fn(p11, session)
+		return
+	}
+	t.Fatalf("token %q not found", config.TokenLabel)
+}
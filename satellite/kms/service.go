@@ -7,6 +7,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base64"
+	"sort"
 
 	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
@@ -62,6 +63,16 @@ func (s *Service) Initialize(ctx context.Context) (err error) {
 		}
 	case "local":
 		secretsService = newLocalFileService(s.config)
+	case "vault-kv", "vault-transit":
+		secretsService, err = newVaultService(s.config)
+		if err != nil {
+			return err
+		}
+	case "pkcs11":
+		secretsService, err = newPKCS11Service(s.config)
+		if err != nil {
+			return err
+		}
 	default:
 		return Error.New("invalid encryption key provider: '%s'. See description of --kms.provider for supported values.", s.config.Provider)
 	}
@@ -87,6 +98,25 @@ func (s *Service) Initialize(ctx context.Context) (err error) {
 	return nil
 }
 
+// DefaultKeyID returns the ID of the key, which is used to encrypt new passphrases.
+func (s *Service) DefaultKeyID() int {
+	return s.config.DefaultMasterKey
+}
+
+// KeyIDs returns the sorted IDs of all the keys, which can decrypt passphrases.
+func (s *Service) KeyIDs(ctx context.Context) ([]int, error) {
+	if !s.initialized.Wait(ctx) {
+		return nil, Error.New("service not initialized")
+	}
+
+	ids := make([]int, 0, len(s.keys))
+	for id := range s.keys {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids, nil
+}
+
 // GenerateEncryptedPassphrase generates a cryptographically random passphrase,
 // returning its encrypted form and the id of the encryption key.
 func (s *Service) GenerateEncryptedPassphrase(ctx context.Context) (_ []byte, keyID int, err error) {
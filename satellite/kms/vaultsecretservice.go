@@ -0,0 +1,208 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+)
+
+// vaultService gets encryption keys from HashiCorp Vault.
+//
+// With the 'vault-kv' provider the keys are stored in KV secrets (version 1
+// or 2). With the 'vault-transit' provider the keys are stored in local files,
+// wrapped by a Transit key, and Vault is only asked to unwrap them.
+type vaultService struct {
+	config  Config
+	address *url.URL
+	token   string
+	client  *http.Client
+}
+
+func newVaultService(config Config) (*vaultService, error) {
+	address, err := url.Parse(config.Vault.Address)
+	if err != nil {
+		return nil, Error.New("invalid Vault address: %w", err)
+	}
+
+	token := config.Vault.Token
+	if token == "" {
+		token = os.Getenv("VAULT_TOKEN")
+	}
+	if token == "" {
+		return nil, Error.New("Vault token is not set")
+	}
+
+	if config.Provider == "vault-transit" && config.Vault.TransitKey == "" {
+		return nil, Error.New("Vault Transit key is not set")
+	}
+
+	return &vaultService{
+		config:  config,
+		address: address,
+		token:   token,
+		client:  &http.Client{Timeout: config.Vault.Timeout},
+	}, nil
+}
+
+// GetKeys gets keys from source.
+func (s *vaultService) GetKeys(ctx context.Context) (keys map[int]*storj.Key, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keys = make(map[int]*storj.Key)
+
+	crc32c := crc32.MakeTable(crc32.Castagnoli)
+
+	for id, k := range s.config.KeyInfos.Values {
+		var data []byte
+		if s.config.Provider == "vault-transit" {
+			data, err = s.unwrap(ctx, k.SecretVersion)
+		} else {
+			data, err = s.readKV(ctx, k.SecretVersion)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(data) == 0 {
+			return nil, Error.New("no key found in vault")
+		}
+
+		if k.SecretChecksum != int64(crc32.Checksum(data, crc32c)) {
+			return nil, Error.New("checksum mismatch")
+		}
+
+		keys[id], err = storj.NewKey(data)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	return keys, nil
+}
+
+// readKV reads the key data from the configured field of a KV secret.
+func (s *vaultService) readKV(ctx context.Context, path string) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var response struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := s.do(ctx, http.MethodGet, path, nil, &response); err != nil {
+		return nil, err
+	}
+
+	// KV version 2 nests the fields of the secret in data.data.
+	var fields map[string]any
+	var versioned struct {
+		Data     map[string]any `json:"data"`
+		Metadata map[string]any `json:"metadata"`
+	}
+	if err := json.Unmarshal(response.Data, &versioned); err == nil && versioned.Data != nil && versioned.Metadata != nil {
+		fields = versioned.Data
+	} else if err := json.Unmarshal(response.Data, &fields); err != nil {
+		return nil, Error.New("invalid Vault response: %w", err)
+	}
+
+	value, ok := fields[s.config.Vault.KVField].(string)
+	if !ok {
+		return nil, Error.New("field %q not found in secret %q", s.config.Vault.KVField, path)
+	}
+	return bytes.TrimSpace([]byte(value)), nil
+}
+
+// unwrap decrypts the key file with the Transit key.
+func (s *vaultService) unwrap(ctx context.Context, path string) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ciphertext, err := os.ReadFile(path)
+	if err != nil {
+		return nil, Error.New("error reading wrapped key file: %w", err)
+	}
+
+	var response struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}
+	err = s.do(ctx, http.MethodPost, s.config.Vault.TransitMount+"/decrypt/"+s.config.Vault.TransitKey, map[string]string{
+		"ciphertext": string(bytes.TrimSpace(ciphertext)),
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := base64.StdEncoding.DecodeString(response.Data.Plaintext)
+	if err != nil {
+		return nil, Error.New("invalid plaintext in Vault response: %w", err)
+	}
+	return bytes.TrimSpace(data), nil
+}
+
+// do sends a request to the Vault HTTP API, and decodes the response.
+func (s *vaultService) do(ctx context.Context, method, path string, request, response any) (err error) {
+	// The path may contain a query, e.g. ?version=2 for KV secrets.
+	path, query, _ := strings.Cut(path, "?")
+	endpoint := s.address.JoinPath("v1", strings.TrimPrefix(path, "/"))
+	endpoint.RawQuery = query
+
+	var body io.Reader
+	if request != nil {
+		data, err := json.Marshal(request)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint.String(), body)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req.Header.Set("X-Vault-Token", s.token)
+	req.Header.Set("X-Vault-Request", "true")
+	if s.config.Vault.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", s.config.Vault.Namespace)
+	}
+	if request != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(resp.Body.Close())) }()
+
+	if resp.StatusCode != http.StatusOK {
+		var vaultErr struct {
+			Errors []string `json:"errors"`
+		}
+		_ = json.NewDecoder(io.LimitReader(resp.Body, 64*1024)).Decode(&vaultErr)
+		return Error.New("Vault request to %q failed with status %d: %s", path, resp.StatusCode, strings.Join(vaultErr.Errors, "; "))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return Error.New("invalid Vault response: %w", err)
+	}
+	return nil
+}
+
+// Close closes the service.
+func (s *vaultService) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,224 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kms_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/kms"
+)
+
+// fakeVault implements the subset of the Vault HTTP API, which is used by the
+// Vault providers, similar to a Vault dev server.
+type fakeVault struct {
+	token string
+	// secrets are the KV secrets by API path, with the KV version 2 layout
+	// when the path contains /data/.
+	secrets map[string]map[string]string
+	// transit "encrypts" by prefixing the base64 plaintext with the key name.
+	transitKey string
+}
+
+func (v *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("X-Vault-Token") != v.token {
+		w.WriteHeader(http.StatusForbidden)
+		_ = json.NewEncoder(w).Encode(map[string]any{"errors": []string{"permission denied"}})
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/v1/")
+	switch {
+	case r.Method == http.MethodGet:
+		secret, ok := v.secrets[path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]any{"errors": []string{}})
+			return
+		}
+		data := map[string]any{"data": secret}
+		if strings.Contains(path, "/data/") {
+			data = map[string]any{"data": map[string]any{
+				"data":     secret,
+				"metadata": map[string]any{"version": 1},
+			}}
+		}
+		_ = json.NewEncoder(w).Encode(data)
+	case r.Method == http.MethodPost && path == "transit/decrypt/"+v.transitKey:
+		var request struct {
+			Ciphertext string `json:"ciphertext"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		plaintext, ok := strings.CutPrefix(request.Ciphertext, "vault:v1:"+v.transitKey+":")
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]any{"errors": []string{"cipher: message authentication failed"}})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"plaintext": plaintext}})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (v *fakeVault) wrap(data string) string {
+	return "vault:v1:" + v.transitKey + ":" + base64.StdEncoding.EncodeToString([]byte(data))
+}
+
+func TestVaultService(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	crc32c := crc32.MakeTable(crc32.Castagnoli)
+	checksum := func(data string) uint32 {
+		return crc32.Checksum([]byte(data), crc32c)
+	}
+
+	vault := &fakeVault{
+		token: "root",
+		secrets: map[string]map[string]string{
+			"secret/data/storj/key1":  {"key": "vaultkey1"},
+			"kv/storj/key2":           {"key": "vaultkey2"},
+			"secret/data/storj/nokey": {"other": "vaultkey3"},
+		},
+		transitKey: "storj",
+	}
+	server := httptest.NewServer(vault)
+	defer server.Close()
+
+	vaultConfig := kms.VaultConfig{
+		Address:      server.URL,
+		Token:        vault.token,
+		KVField:      "key",
+		TransitMount: "transit",
+		TransitKey:   vault.transitKey,
+		Timeout:      10 * time.Second,
+	}
+
+	initialize := func(t *testing.T, provider string, vaultConfig kms.VaultConfig, keyInfos string) (*kms.Service, error) {
+		var ki kms.KeyInfos
+		require.NoError(t, ki.Set(keyInfos))
+		service := kms.NewService(kms.Config{
+			Provider:         provider,
+			KeyInfos:         ki,
+			DefaultMasterKey: 1,
+			Vault:            vaultConfig,
+		})
+		return service, service.Initialize(ctx)
+	}
+
+	t.Run("kv", func(t *testing.T) {
+		service, err := initialize(t, "vault-kv", vaultConfig, fmt.Sprintf("1:secret/data/storj/key1,%d;2:kv/storj/key2,%d", checksum("vaultkey1"), checksum("vaultkey2")))
+		require.NoError(t, err)
+
+		encrypted, keyID, err := service.GenerateEncryptedPassphrase(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, keyID)
+
+		_, err = service.DecryptPassphrase(ctx, keyID, encrypted)
+		require.NoError(t, err)
+
+		keyIDs, err := service.KeyIDs(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []int{1, 2}, keyIDs)
+	})
+
+	t.Run("kv checksum mismatch", func(t *testing.T) {
+		_, err := initialize(t, "vault-kv", vaultConfig, fmt.Sprintf("1:secret/data/storj/key1,%d", checksum("vaultkey1")+1))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "checksum mismatch")
+	})
+
+	t.Run("kv missing field", func(t *testing.T) {
+		_, err := initialize(t, "vault-kv", vaultConfig, fmt.Sprintf("1:secret/data/storj/nokey,%d", checksum("vaultkey3")))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `field "key" not found`)
+	})
+
+	t.Run("kv missing secret", func(t *testing.T) {
+		_, err := initialize(t, "vault-kv", vaultConfig, "1:secret/data/storj/missing,1")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "status 404")
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		invalidToken := vaultConfig
+		invalidToken.Token = "invalid"
+		_, err := initialize(t, "vault-kv", invalidToken, fmt.Sprintf("1:secret/data/storj/key1,%d", checksum("vaultkey1")))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "permission denied")
+	})
+
+	t.Run("transit", func(t *testing.T) {
+		keyFile := ctx.File("wrapped-key1")
+		require.NoError(t, os.WriteFile(keyFile, []byte(vault.wrap("vaultkey1")+"\n"), 0600))
+
+		service, err := initialize(t, "vault-transit", vaultConfig, fmt.Sprintf("1:%s,%d", keyFile, checksum("vaultkey1")))
+		require.NoError(t, err)
+
+		// The key must be the same as the one stored in KV.
+		kvService, err := initialize(t, "vault-kv", vaultConfig, fmt.Sprintf("1:secret/data/storj/key1,%d", checksum("vaultkey1")))
+		require.NoError(t, err)
+
+		encrypted, keyID, err := service.GenerateEncryptedPassphrase(ctx)
+		require.NoError(t, err)
+		passphrase, err := service.DecryptPassphrase(ctx, keyID, encrypted)
+		require.NoError(t, err)
+		kvPassphrase, err := kvService.DecryptPassphrase(ctx, keyID, encrypted)
+		require.NoError(t, err)
+		require.Equal(t, passphrase, kvPassphrase)
+	})
+
+	t.Run("transit invalid ciphertext", func(t *testing.T) {
+		keyFile := ctx.File("wrapped-key-invalid")
+		require.NoError(t, os.WriteFile(keyFile, []byte("vault:v1:other"), 0600))
+
+		_, err := initialize(t, "vault-transit", vaultConfig, fmt.Sprintf("1:%s,%d", keyFile, checksum("vaultkey1")))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "message authentication failed")
+	})
+
+	t.Run("transit key not set", func(t *testing.T) {
+		noTransitKey := vaultConfig
+		noTransitKey.TransitKey = ""
+		_, err := initialize(t, "vault-transit", noTransitKey, "1:file,1")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Transit key is not set")
+	})
+}
+
+func TestKeyIDs(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	var ki kms.KeyInfos
+	require.NoError(t, ki.Set("3:secretversion3,1;1:secretversion1,1;2:secretversion2,1"))
+
+	service := kms.NewService(kms.Config{
+		Provider:         "gsm",
+		MockClient:       true,
+		KeyInfos:         ki,
+		DefaultMasterKey: 2,
+	})
+	require.NoError(t, service.Initialize(ctx))
+
+	keyIDs, err := service.KeyIDs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, keyIDs)
+	require.Equal(t, 2, service.DefaultKeyID())
+}
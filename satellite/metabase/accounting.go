@@ -86,7 +86,13 @@ func (db *DB) CollectBucketTallies(ctx context.Context, opts CollectBucketTallie
 		if err != nil {
 			return nil, err
 		}
-		result = append(result, adapterResult...)
+		for _, tally := range adapterResult {
+			// skip the second copy of a project, which is being migrated.
+			if db.router.holdsCopy(tally.ProjectID, adapter.Name()) {
+				continue
+			}
+			result = append(result, tally)
+		}
 	}
 
 	// only a merge sort should be strictly required here, but this is much easier to implement for now
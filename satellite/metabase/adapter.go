@@ -128,6 +128,8 @@ type Adapter interface {
 	TestMigrateToLatest(ctx context.Context) error
 
 	copyObjectAdapter
+	projectRoutesAdapter
+	projectMigrationAdapter
 
 	Config() *Config
 }
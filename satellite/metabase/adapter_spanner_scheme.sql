@@ -51,6 +51,23 @@ CREATE TABLE IF NOT EXISTS node_aliases
 
 CREATE UNIQUE INDEX IF NOT EXISTS node_aliases_node_alias_key ON node_aliases(node_alias);
 
+CREATE TABLE IF NOT EXISTS project_adapters
+(
+    project_id         BYTES(16)   NOT NULL,
+    adapter            STRING(MAX) NOT NULL,
+    migration_adapter  STRING(MAX) NOT NULL DEFAULT (''),
+    migration_state    STRING(MAX) NOT NULL DEFAULT (''),
+    migration_pass     INT64       NOT NULL DEFAULT (0),
+    cursor_bucket_name STRING(MAX) NOT NULL DEFAULT (''),
+    cursor_object_key  BYTES(MAX)  NOT NULL DEFAULT (b''),
+    cursor_version     INT64       NOT NULL DEFAULT (0),
+    changed_objects    INT64       NOT NULL DEFAULT (0),
+    total_objects      INT64       NOT NULL DEFAULT (0),
+    total_segments     INT64       NOT NULL DEFAULT (0),
+    migration_error    STRING(MAX) NOT NULL DEFAULT (''),
+    updated_at         TIMESTAMP   NOT NULL DEFAULT (CURRENT_TIMESTAMP()),
+) PRIMARY KEY (project_id);
+
 CREATE CHANGE STREAM bucket_eventing FOR objects (stream_id, status, total_plain_size) OPTIONS ( value_capture_type = 'NEW_ROW_AND_OLD_VALUES', exclude_ttl_deletes = TRUE );
 
 CREATE TABLE IF NOT EXISTS bucket_eventing_metadata
@@ -0,0 +1,176 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/shared/tagsql"
+)
+
+// SQLiteAdapter uses SQLite related SQL queries.
+//
+// The queries are shared with PostgresAdapter and translated by
+// sqliteutil.PostgresDialectDriver. Only the queries, which cannot be
+// translated, are overridden.
+type SQLiteAdapter struct {
+	PostgresAdapter
+}
+
+// Name returns the name of the adapter.
+func (s *SQLiteAdapter) Name() string {
+	return "sqlite"
+}
+
+var _ Adapter = &SQLiteAdapter{}
+
+// sqliteCopyFrom inserts the rows from source one by one, since sqlite does not
+// support COPY FROM.
+func sqliteCopyFrom(ctx context.Context, tx tagsql.Tx, table string, source pgx.CopyFromSource, columns []string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO `+table+` (`+strings.Join(columns, ", ")+`)
+		VALUES (`+postgresPlaceholders(len(columns))+`)
+	`)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, stmt.Close()) }()
+
+	for source.Next() {
+		values, err := source.Values()
+		if err != nil {
+			return err
+		}
+		if _, err := stmt.ExecContext(ctx, values...); err != nil {
+			return err
+		}
+	}
+	return source.Err()
+}
+
+// sqlitePlaceholders returns n comma separated placeholders starting from $start.
+// It's used in place of array arguments, which sqlite doesn't support.
+func sqlitePlaceholders(start, n int) string {
+	var b strings.Builder
+	for i := range n {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "$%d", start+i)
+	}
+	return b.String()
+}
+
+// sqliteValuesList returns placeholders for inserting rows with the specified
+// number of columns, e.g. "($1, $2), ($3, $4)".
+func sqliteValuesList(rows, columns int) string {
+	var b strings.Builder
+	for i := range rows {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString("(")
+		b.WriteString(sqlitePlaceholders(i*columns+1, columns))
+		b.WriteString(")")
+	}
+	return b.String()
+}
+
+// sqliteInsertSegments inserts the transposed segments one by one, since sqlite
+// does not support UNNEST.
+func sqliteInsertSegments(ctx context.Context, tx tagsql.ExecQueryer, streamID uuid.UUID, segments transposedSegmentList) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// bytea matches the behavior of pgutil.ByteaArray, which stores nil as an empty value.
+	bytea := func(b []byte) []byte {
+		if b == nil {
+			return []byte{}
+		}
+		return b
+	}
+
+	for i := range segments.Positions {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO segments (
+				stream_id, position, expires_at,
+				encrypted_key_nonce, encrypted_key,
+				root_piece_id,
+				redundancy,
+				encrypted_size, plain_offset, plain_size,
+				remote_alias_pieces, placement,
+				inline_data
+			) VALUES (
+				$1, $2, $3,
+				$4, $5,
+				$6,
+				$7,
+				$8, $9, $10,
+				$11, $12,
+				$13
+			)
+		`, streamID, segments.Positions[i], segments.ExpiresAts[i],
+			bytea(segments.EncryptedKeyNonces[i]), bytea(segments.EncryptedKeys[i]),
+			bytea(segments.RootPieceIDs[i]),
+			segments.RedundancySchemes[i],
+			segments.EncryptedSizes[i], segments.PlainOffsets[i], segments.PlainSizes[i],
+			bytea(segments.PiecesLists[i]), segments.Placements[i],
+			bytea(segments.InlineDatas[i]),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sqliteDeleteObjects deletes the objects at location matching the condition
+// together with their segments, since sqlite does not support data-modifying
+// statements in WITH.
+func sqliteDeleteObjects(ctx context.Context, tx tagsql.ExecQueryer, location ObjectLocation, condition string, args ...any) (objects []Object, deletedSegmentCount int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = withRows(tx.QueryContext(ctx, `
+		DELETE FROM objects
+		WHERE `+condition+`
+		RETURNING
+			version, stream_id, created_at, expires_at, status, segment_count,
+			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key, encrypted_etag,
+			total_plain_size, total_encrypted_size,
+			fixed_segment_size, encryption,
+			retention_mode, retain_until,
+			0
+	`, args...))(func(rows tagsql.Rows) error {
+		objects, _, err = scanObjectDeletionPostgres(ctx, location, rows)
+		return err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, object := range objects {
+		count, err := sqliteDeleteSegments(ctx, tx, object.StreamID)
+		if err != nil {
+			return nil, 0, err
+		}
+		deletedSegmentCount += int(count)
+	}
+	return objects, deletedSegmentCount, nil
+}
+
+// sqliteDeleteSegments deletes all segments of the stream.
+func sqliteDeleteSegments(ctx context.Context, tx tagsql.ExecQueryer, streamID uuid.UUID) (deleted int64, err error) {
+	result, err := tx.ExecContext(ctx, `DELETE FROM segments WHERE stream_id = $1`, streamID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -43,7 +43,7 @@ func (db *DB) CheckSegmentPiecesAlteration(
 
 	// Check all adapters until a match is found
 	found := false
-	for _, adapter := range db.adapters {
+	for _, adapter := range db.segmentAdapters() {
 		altered, err = adapter.CheckSegmentPiecesAlteration(ctx, streamID, position, aliasPieces)
 		if err != nil {
 			if ErrSegmentNotFound.Has(err) {
@@ -17,6 +17,7 @@ import (
 	"storj.io/common/memory"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/shared/dbutil"
 	"storj.io/storj/shared/dbutil/pgutil"
 	"storj.io/storj/shared/dbutil/spannerutil"
 	"storj.io/storj/shared/dbutil/txutil"
@@ -874,6 +875,13 @@ func (ptx *postgresTransactionAdapter) precommitInsertSegments(ctx context.Conte
 		return Error.Wrap(err)
 	}
 
+	if ptx.postgresAdapter.impl == dbutil.SQLite3 {
+		if err := sqliteInsertSegments(ctx, ptx.tx, t.StreamID, t); err != nil {
+			return Error.New("unable to insert segments: %w", err)
+		}
+		return nil
+	}
+
 	_, err = ptx.tx.ExecContext(ctx, `
 			INSERT INTO segments (
 				stream_id, position, expires_at,
@@ -1140,6 +1148,20 @@ func (ptx *postgresTransactionAdapter) updateSegmentOffsets(ctx context.Context,
 		return nil
 	}
 
+	if ptx.postgresAdapter.impl == dbutil.SQLite3 {
+		for i, position := range batch.Positions {
+			_, err := ptx.tx.ExecContext(ctx, `
+				UPDATE segments
+				SET plain_offset = $3
+				WHERE stream_id = $1 AND position = $2
+			`, streamID, position, batch.PlainOffsets[i])
+			if err != nil {
+				return Error.New("unable to update segments offsets: %w", err)
+			}
+		}
+		return nil
+	}
+
 	updateResult, err := ptx.tx.ExecContext(ctx, `
 		UPDATE segments
 		SET plain_offset = P.plain_offset
@@ -1205,11 +1227,23 @@ func (ptx *postgresTransactionAdapter) deleteSegmentsNotInCommit(ctx context.Con
 		positions = append(positions, int64(p.Encode()))
 	}
 
-	// This potentially could be done together with the previous database call.
-	result, err := ptx.tx.ExecContext(ctx, `
+	query, args := `
 		DELETE FROM segments
 		WHERE stream_id = $1 AND position = ANY($2)
-	`, streamID, pgutil.Int8Array(positions))
+	`, []any{streamID, pgutil.Int8Array(positions)}
+	if ptx.postgresAdapter.impl == dbutil.SQLite3 {
+		query = `
+			DELETE FROM segments
+			WHERE stream_id = $1 AND position IN (` + sqlitePlaceholders(2, len(positions)) + `)
+		`
+		args = []any{streamID}
+		for _, position := range positions {
+			args = append(args, position)
+		}
+	}
+
+	// This potentially could be done together with the previous database call.
+	result, err := ptx.tx.ExecContext(ctx, query, args...)
 	if err != nil {
 		return 0, Error.New("unable to delete segments: %w", err)
 	}
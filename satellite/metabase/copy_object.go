@@ -13,6 +13,7 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/shared/dbutil"
 	"storj.io/storj/shared/dbutil/pgutil"
 	"storj.io/storj/shared/dbutil/spannerutil"
 	"storj.io/storj/shared/tagsql"
@@ -510,6 +511,13 @@ func (s *SpannerAdapter) getSegmentsForCopy(ctx context.Context, sourceObject Ob
 }
 
 func (p *PostgresAdapter) finalizeSegmentsCopy(ctx context.Context, opts FinishCopyObject, newSegments transposedSegmentList) (err error) {
+	if p.impl == dbutil.SQLite3 {
+		if err := sqliteInsertSegments(ctx, p.db, opts.NewStreamID, newSegments); err != nil {
+			return Error.New("unable to copy segments: %w", err)
+		}
+		return nil
+	}
+
 	_, err = p.db.ExecContext(ctx, `
 			INSERT INTO segments (
 				stream_id, position, expires_at,
@@ -28,6 +28,7 @@ import (
 	"storj.io/storj/shared/dbutil"
 	"storj.io/storj/shared/dbutil/pgutil"
 	"storj.io/storj/shared/dbutil/spannerutil"
+	"storj.io/storj/shared/dbutil/sqliteutil"
 	"storj.io/storj/shared/flightrecorder"
 	"storj.io/storj/shared/tagsql"
 )
@@ -54,6 +55,11 @@ type Config struct {
 
 	SpannerGRPCConnectionPool int
 
+	// ProjectRoutesRefreshInterval is how often the project routes are reloaded from the
+	// project_adapters table. The writes to the projects are rejected when the routes weren't
+	// reloaded for two intervals.
+	ProjectRoutesRefreshInterval time.Duration
+
 	Compression string
 
 	FlightRecorder *flightrecorder.Box
@@ -72,6 +78,10 @@ type DB struct {
 	adapters []Adapter
 
 	projectsAdapters map[uuid.UUID]Adapter
+
+	router     *projectRouter
+	stopRoutes context.CancelFunc
+	routesDone chan struct{}
 }
 
 // Open opens a connection to metabase.
@@ -80,6 +90,7 @@ func Open(ctx context.Context, log *zap.Logger, connstr string, config Config) (
 		log:         log,
 		testCleanup: func() error { return nil },
 		config:      config,
+		router:      &projectRouter{},
 	}
 	db.aliasCache = NewNodeAliasCache(db, false)
 
@@ -137,6 +148,24 @@ func Open(ctx context.Context, log *zap.Logger, connstr string, config Config) (
 					aliasCache: db.aliasCache,
 				},
 			}
+		case dbutil.SQLite3:
+			rawdb, err := tagsql.Open(ctx, sqliteutil.PostgresDialectDriverName, sqliteutil.WithDefaultOptions(source), config.FlightRecorder)
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+			dbutil.Configure(ctx, rawdb, "metabase", mon)
+
+			db_db := postgresRebind{rawdb}
+			db.adapters[i] = &SQLiteAdapter{
+				PostgresAdapter{
+					log:        log,
+					db:         db_db,
+					impl:       impl,
+					connstr:    connstr,
+					config:     &config,
+					aliasCache: db.aliasCache,
+				},
+			}
 		case dbutil.Spanner:
 			adapter, err := NewSpannerAdapterWithNodeAliasCache(ctx, log, SpannerConfig{
 				Database:        source,
@@ -168,6 +197,10 @@ func Open(ctx context.Context, log *zap.Logger, connstr string, config Config) (
 		}
 	}
 
+	if err := db.startProjectRoutes(ctx); err != nil {
+		return nil, errs.Combine(err, db.Close())
+	}
+
 	return db, nil
 }
 
@@ -177,14 +210,6 @@ func (db *DB) Implementation() dbutil.Implementation {
 	return db.adapters[0].Implementation()
 }
 
-// ChooseAdapter selects the right adapter based on configuration.
-func (db *DB) ChooseAdapter(projectID uuid.UUID) Adapter {
-	if adapter, ok := db.projectsAdapters[projectID]; ok {
-		return adapter
-	}
-	return db.adapters[0]
-}
-
 // Ping checks whether connection has been established to all adapters.
 func (db *DB) Ping(ctx context.Context) error {
 	for _, adapter := range db.adapters {
@@ -226,6 +251,11 @@ func (db *DB) TestingSetCleanup(cleanup func() error) {
 
 // Close closes the connection to database.
 func (db *DB) Close() error {
+	if db.stopRoutes != nil {
+		db.stopRoutes()
+		<-db.routesDone
+	}
+
 	var err error
 	for _, adapter := range db.adapters {
 		if c, isCloser := adapter.(io.Closer); isCloser {
@@ -302,6 +332,12 @@ func (s *SpannerAdapter) MigrateToLatest(ctx context.Context) error {
 	return migration.Run(ctx, s.log.Named("migrate"))
 }
 
+// MigrateToLatest migrates database to the latest version.
+func (s *SQLiteAdapter) MigrateToLatest(ctx context.Context) error {
+	migration := s.SQLiteMigration()
+	return migration.Run(ctx, s.log.Named("migrate"))
+}
+
 // CheckVersion checks the database is the correct version.
 func (db *DB) CheckVersion(ctx context.Context) error {
 	for _, a := range db.adapters {
@@ -319,6 +355,12 @@ func (p *PostgresAdapter) CheckVersion(ctx context.Context) error {
 	return migration.ValidateVersions(ctx, p.log)
 }
 
+// CheckVersion checks the database is the correct version.
+func (s *SQLiteAdapter) CheckVersion(ctx context.Context) error {
+	migration := s.SQLiteMigration()
+	return migration.ValidateVersions(ctx, s.log)
+}
+
 // CheckVersion checks the database is the correct version.
 func (s *SpannerAdapter) CheckVersion(ctx context.Context) error {
 	migration := s.SpannerMigration()
@@ -696,6 +738,42 @@ func (p *PostgresAdapter) PostgresMigration() *migrate.Migration {
 					`CREATE INDEX IF NOT EXISTS node_aliases_node_alias_order ON node_aliases(node_alias DESC)`,
 				},
 			},
+			{
+				DB:          &db,
+				Description: "add project_adapters table",
+				Version:     28,
+				Action: migrate.SQL{
+					`CREATE TABLE IF NOT EXISTS project_adapters (
+						project_id         BYTEA NOT NULL PRIMARY KEY,
+						adapter            TEXT  NOT NULL,
+						migration_adapter  TEXT  NOT NULL DEFAULT '',
+						migration_state    TEXT  NOT NULL DEFAULT '',
+						migration_pass     INT8  NOT NULL DEFAULT 0,
+						cursor_bucket_name BYTEA NOT NULL DEFAULT '',
+						cursor_object_key  BYTEA NOT NULL DEFAULT '',
+						cursor_version     INT8  NOT NULL DEFAULT 0,
+						changed_objects    INT8  NOT NULL DEFAULT 0,
+						total_objects      INT8  NOT NULL DEFAULT 0,
+						total_segments     INT8  NOT NULL DEFAULT 0,
+						migration_error    TEXT  NOT NULL DEFAULT '',
+						updated_at         TIMESTAMPTZ NOT NULL DEFAULT now()
+					)`,
+					`COMMENT ON TABLE  project_adapters is 'project_adapters routes projects to metabase adapters and tracks the migrations of projects between adapters.'`,
+					`COMMENT ON COLUMN project_adapters.project_id is 'project_id is a uuid referring to console.projects.id.'`,
+					`COMMENT ON COLUMN project_adapters.adapter is 'adapter is the name of the adapter serving the project.'`,
+					`COMMENT ON COLUMN project_adapters.migration_adapter is 'migration_adapter is the name of the adapter holding the second copy of the project during a migration.'`,
+					`COMMENT ON COLUMN project_adapters.migration_state is 'migration_state is the state of the migration, empty when the project is not migrated.'`,
+					`COMMENT ON COLUMN project_adapters.migration_pass is 'migration_pass is the number of the current copy pass.'`,
+					`COMMENT ON COLUMN project_adapters.cursor_bucket_name is 'cursor_bucket_name is the bucket of the last object processed in the current pass.'`,
+					`COMMENT ON COLUMN project_adapters.cursor_object_key is 'cursor_object_key is the key of the last object processed in the current pass.'`,
+					`COMMENT ON COLUMN project_adapters.cursor_version is 'cursor_version is the version of the last object processed in the current pass.'`,
+					`COMMENT ON COLUMN project_adapters.changed_objects is 'changed_objects is the number of objects changed in the current pass.'`,
+					`COMMENT ON COLUMN project_adapters.total_objects is 'total_objects is the number of objects seen in the current pass.'`,
+					`COMMENT ON COLUMN project_adapters.total_segments is 'total_segments is the number of segments seen in the current pass.'`,
+					`COMMENT ON COLUMN project_adapters.migration_error is 'migration_error is the reason why the last verification failed.'`,
+					`COMMENT ON COLUMN project_adapters.updated_at is 'updated_at is the time of the last change of the row.'`,
+				},
+			},
 		},
 	}
 }
@@ -781,10 +859,136 @@ func (s *SpannerAdapter) SpannerMigration() *migrate.Migration {
 					`CREATE INDEX IF NOT EXISTS node_aliases_node_alias_order ON node_aliases(node_alias DESC)`,
 				},
 			},
+			{
+				DB:          &db,
+				Description: "add project_adapters table",
+				Version:     28,
+				Action: migrate.SQL{
+					`CREATE TABLE IF NOT EXISTS project_adapters (
+						project_id         BYTES(16)   NOT NULL,
+						adapter            STRING(MAX) NOT NULL,
+						migration_adapter  STRING(MAX) NOT NULL DEFAULT (''),
+						migration_state    STRING(MAX) NOT NULL DEFAULT (''),
+						migration_pass     INT64       NOT NULL DEFAULT (0),
+						cursor_bucket_name STRING(MAX) NOT NULL DEFAULT (''),
+						cursor_object_key  BYTES(MAX)  NOT NULL DEFAULT (b''),
+						cursor_version     INT64       NOT NULL DEFAULT (0),
+						changed_objects    INT64       NOT NULL DEFAULT (0),
+						total_objects      INT64       NOT NULL DEFAULT (0),
+						total_segments     INT64       NOT NULL DEFAULT (0),
+						migration_error    STRING(MAX) NOT NULL DEFAULT (''),
+						updated_at         TIMESTAMP   NOT NULL DEFAULT (CURRENT_TIMESTAMP()),
+					) PRIMARY KEY (project_id)`,
+				},
+			},
 		},
 	}
 }
 
+// SQLiteMigration returns steps needed for migrating sqlite database.
+func (s *SQLiteAdapter) SQLiteMigration() *migrate.Migration {
+	var db tagsql.DB = s.db
+
+	return &migrate.Migration{
+		Table: "metabase_versions",
+		Steps: []*migrate.Step{
+			{
+				DB:          &db,
+				Description: "initial setup",
+				Version:     1,
+				Action: migrate.SQL{
+					`CREATE TABLE objects (
+						project_id   BLOB NOT NULL,
+						bucket_name  BLOB NOT NULL,
+						object_key   BLOB NOT NULL,
+						version      INT8 NOT NULL,
+						stream_id    BLOB NOT NULL,
+
+						product_id INTEGER,
+
+						created_at TIMESTAMP NOT NULL DEFAULT ` + sqliteNow + `,
+						expires_at TIMESTAMP,
+
+						status         INT2 NOT NULL DEFAULT ` + statusPending + `,
+						segment_count  INT4 NOT NULL DEFAULT 0,
+
+						encrypted_metadata_nonce         BLOB DEFAULT NULL,
+						encrypted_metadata               BLOB DEFAULT NULL,
+						encrypted_metadata_encrypted_key BLOB DEFAULT NULL,
+						encrypted_etag                   BLOB DEFAULT NULL,
+
+						total_plain_size     INT8 NOT NULL DEFAULT 0,
+						total_encrypted_size INT8 NOT NULL DEFAULT 0,
+						fixed_segment_size   INT4 NOT NULL DEFAULT 0,
+
+						encryption INT8 NOT NULL DEFAULT 0,
+
+						zombie_deletion_deadline TIMESTAMP DEFAULT ` + sqliteNowPlusDay + `,
+
+						retention_mode INT2,
+						retain_until   TIMESTAMP,
+
+						PRIMARY KEY (project_id, bucket_name, object_key, version)
+					)`,
+					`CREATE TABLE segments (
+						stream_id  BLOB NOT NULL,
+						position   INT8 NOT NULL,
+
+						root_piece_id       BLOB NOT NULL,
+						encrypted_key_nonce BLOB NOT NULL,
+						encrypted_key       BLOB NOT NULL,
+						remote_alias_pieces BLOB,
+
+						encrypted_size INT4 NOT NULL,
+						plain_offset   INT8 NOT NULL,
+						plain_size     INT4 NOT NULL,
+
+						redundancy INT8 NOT NULL DEFAULT 0,
+
+						inline_data  BLOB DEFAULT NULL,
+
+						created_at  TIMESTAMP NOT NULL DEFAULT ` + sqliteNow + `,
+						repaired_at TIMESTAMP,
+						expires_at  TIMESTAMP,
+
+						placement      INTEGER,
+						encrypted_etag BLOB DEFAULT NULL,
+
+						PRIMARY KEY (stream_id, position)
+					)`,
+					`CREATE TABLE node_aliases (
+						node_alias INTEGER PRIMARY KEY AUTOINCREMENT,
+						node_id    BLOB NOT NULL UNIQUE
+					)`,
+					`CREATE TABLE project_adapters (
+						project_id         BLOB NOT NULL PRIMARY KEY,
+						adapter            BLOB NOT NULL,
+						migration_adapter  BLOB NOT NULL DEFAULT X'',
+						migration_state    BLOB NOT NULL DEFAULT X'',
+						migration_pass     INT8 NOT NULL DEFAULT 0,
+						cursor_bucket_name BLOB NOT NULL DEFAULT X'',
+						cursor_object_key  BLOB NOT NULL DEFAULT X'',
+						cursor_version     INT8 NOT NULL DEFAULT 0,
+						changed_objects    INT8 NOT NULL DEFAULT 0,
+						total_objects      INT8 NOT NULL DEFAULT 0,
+						total_segments     INT8 NOT NULL DEFAULT 0,
+						migration_error    BLOB NOT NULL DEFAULT X'',
+						updated_at         TIMESTAMP NOT NULL DEFAULT ` + sqliteNow + `
+					)`,
+				},
+			},
+		},
+	}
+}
+
+// sqliteNow and sqliteNowPlusDay are the default values for timestamp columns,
+// formatted using sqliteutil.TimestampLayout. now() is registered by the
+// sqliteutil driver and, unlike strftime, has microsecond precision.
+const (
+	sqliteNow        = `(now())`
+	sqliteNowPlusDay = `(strftime('%Y-%m-%d %H:%M:%f000+00:00', 'now', '+1 day'))`
+)
+
 // createChangeStreamAction implements the Action interface to create a change stream
 // for the objects table with idempotency handling.
 type createChangeStreamAction struct {
@@ -52,6 +52,12 @@ func TestDisallowDoubleUnversioned(t *testing.T) {
 			t.Skip("not implemented for spanner")
 		}
 
+		// SQLite does not include the index name in constraint errors.
+		uniqueViolation := "objects_one_unversioned_per_location"
+		if db.Implementation() == dbutil.SQLite3 {
+			uniqueViolation = "UNIQUE constraint failed: objects.project_id, objects.bucket_name, objects.object_key"
+		}
+
 		// This checks that TestingUniqueUnversioned=true indeed works as needed.
 		objStream := metabasetest.RandObjectStream()
 		obj := metabasetest.CreateObject(ctx, t, db, objStream, 0)
@@ -70,13 +76,13 @@ func TestDisallowDoubleUnversioned(t *testing.T) {
 		err := db.TestingBatchInsertObjects(ctx, []metabase.RawObject{object})
 
 		require.True(t, pgerrcode.IsConstraintViolation(err))
-		require.ErrorContains(t, err, "objects_one_unversioned_per_location")
+		require.ErrorContains(t, err, uniqueViolation)
 
 		object.Status = metabase.DeleteMarkerUnversioned
 		err = db.TestingBatchInsertObjects(ctx, []metabase.RawObject{object})
 
 		require.True(t, pgerrcode.IsConstraintViolation(err))
-		require.ErrorContains(t, err, "objects_one_unversioned_per_location")
+		require.ErrorContains(t, err, uniqueViolation)
 
 		metabasetest.Verify{
 			Objects: []metabase.RawObject{
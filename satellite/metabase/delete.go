@@ -18,6 +18,7 @@ import (
 
 	"storj.io/common/uuid"
 	"storj.io/storj/shared/dbutil/spannerutil"
+	"storj.io/storj/shared/dbutil/txutil"
 	"storj.io/storj/shared/tagsql"
 )
 
@@ -410,6 +411,89 @@ func (s *SpannerAdapter) deleteObjectExactVersionUsingObjectLock(ctx context.Con
 	return result, err
 }
 
+// DeleteObjectExactVersion deletes an exact object version.
+func (s *SQLiteAdapter) DeleteObjectExactVersion(ctx context.Context, opts DeleteObjectExactVersion) (result DeleteObjectResult, err error) {
+	return s.deleteObjectExactVersion(ctx, opts)
+}
+
+func (s *SQLiteAdapter) deleteObjectExactVersion(ctx context.Context, opts DeleteObjectExactVersion) (result DeleteObjectResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = txutil.WithTx(ctx, s.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		result = DeleteObjectResult{}
+
+		if opts.ObjectLock.Enabled {
+			var (
+				status    ObjectStatus
+				retention Retention
+				legalHold bool
+			)
+
+			err := tx.QueryRowContext(ctx, `
+				SELECT status, retention_mode, retain_until
+				FROM objects
+				WHERE (project_id, bucket_name, object_key, version) = ($1, $2, $3, $4)
+			`, opts.ProjectID, opts.BucketName, opts.ObjectKey, opts.Version).Scan(
+				&status,
+				lockModeWrapper{
+					retentionMode: &retention.Mode,
+					legalHold:     &legalHold,
+				},
+				timeWrapper{&retention.RetainUntil},
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil
+				}
+				return errs.Wrap(err)
+			}
+
+			if status != Pending {
+				if err = retention.Verify(); err != nil {
+					return errs.Wrap(err)
+				}
+				switch {
+				case legalHold:
+					return ErrObjectLock.New(legalHoldErrMsg)
+				case retention.isProtected(opts.ObjectLock.BypassGovernance, time.Now()):
+					return ErrObjectLock.New(retentionErrMsg)
+				}
+			}
+		}
+
+		return s.deleteObjectExactVersionWithTx(ctx, tx, opts, &result)
+	})
+	if err != nil {
+		if ErrObjectLock.Has(err) {
+			return DeleteObjectResult{}, errs.Wrap(err)
+		}
+		return DeleteObjectResult{}, Error.Wrap(err)
+	}
+	return result, nil
+}
+
+func (s *SQLiteAdapter) deleteObjectExactVersionWithTx(ctx context.Context, tx tagsql.Tx, opts DeleteObjectExactVersion, result *DeleteObjectResult) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	args := []interface{}{
+		opts.ProjectID,
+		opts.BucketName,
+		opts.ObjectKey,
+		opts.Version,
+	}
+
+	var streamIDFilter string
+	if !opts.StreamIDSuffix.IsZero() {
+		streamIDFilter = "AND SUBSTR(stream_id, 9) = $5"
+		args = append(args, opts.StreamIDSuffix)
+	}
+
+	result.Removed, result.DeletedSegmentCount, err = sqliteDeleteObjects(ctx, tx, opts.ObjectLocation, `
+		(project_id, bucket_name, object_key, version) = ($1, $2, $3, $4)
+		`+streamIDFilter, args...)
+	return err
+}
+
 // DeletePendingObject contains arguments necessary for deleting a pending object.
 type DeletePendingObject struct {
 	ObjectStream
@@ -546,6 +630,32 @@ func (s *SpannerAdapter) DeletePendingObject(ctx context.Context, opts DeletePen
 	return result, nil
 }
 
+// DeletePendingObject deletes a pending object with specified version and streamID.
+func (s *SQLiteAdapter) DeletePendingObject(ctx context.Context, opts DeletePendingObject) (result DeleteObjectResult, err error) {
+	err = txutil.WithTx(ctx, s.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		// because delete is using full primary key we are sure only one object will be removed
+		result = DeleteObjectResult{}
+		result.Removed, _, err = sqliteDeleteObjects(ctx, tx, opts.Location(), `
+			(project_id, bucket_name, object_key, version, stream_id) = ($1, $2, $3, $4, $5) AND
+			status = `+statusPending,
+			opts.ProjectID, opts.BucketName, opts.ObjectKey, opts.Version, opts.StreamID)
+		return err
+	})
+	if err != nil {
+		return DeleteObjectResult{}, Error.Wrap(err)
+	}
+
+	if len(result.Removed) == 0 {
+		return DeleteObjectResult{}, nil
+	}
+
+	result.Removed = []Object{{
+		ObjectStream: opts.ObjectStream,
+		Status:       Pending,
+	}}
+	return result, nil
+}
+
 // scanObjectDeletionPostgres reads in the results of an object deletion from the database.
 func scanObjectDeletionPostgres(ctx context.Context, location ObjectLocation, rows tagsql.Rows) (objects []Object, deletedSegmentCount int, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -987,6 +1097,70 @@ func (s *SpannerAdapter) deleteObjectLastCommittedPlainUsingObjectLock(ctx conte
 	return result, nil
 }
 
+// DeleteObjectLastCommittedPlain deletes an object last committed version when
+// opts.Suspended and opts.Versioned are both false.
+func (s *SQLiteAdapter) DeleteObjectLastCommittedPlain(ctx context.Context, opts DeleteObjectLastCommitted) (result DeleteObjectResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = txutil.WithTx(ctx, s.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		result = DeleteObjectResult{}
+
+		var (
+			version   Version
+			retention Retention
+			legalHold bool
+		)
+
+		err := tx.QueryRowContext(ctx, `
+			SELECT version, retention_mode, retain_until
+			FROM objects
+			WHERE
+				(project_id, bucket_name, object_key) = ($1, $2, $3)
+				AND status = `+statusCommittedUnversioned+`
+				AND (expires_at IS NULL OR expires_at > now())
+			ORDER BY version DESC LIMIT 1
+		`, opts.ProjectID, opts.BucketName, opts.ObjectKey).Scan(
+			&version,
+			lockModeWrapper{
+				retentionMode: &retention.Mode,
+				legalHold:     &legalHold,
+			},
+			timeWrapper{&retention.RetainUntil},
+		)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return errs.Wrap(err)
+		}
+
+		if opts.ObjectLock.Enabled {
+			if err = retention.Verify(); err != nil {
+				return errs.Wrap(err)
+			}
+			switch {
+			case legalHold:
+				return ErrObjectLock.New(legalHoldErrMsg)
+			case retention.isProtected(opts.ObjectLock.BypassGovernance, time.Now()):
+				return ErrObjectLock.New(retentionErrMsg)
+			}
+		}
+
+		return s.deleteObjectExactVersionWithTx(ctx, tx, DeleteObjectExactVersion{
+			ObjectLocation: opts.ObjectLocation,
+			Version:        version,
+		}, &result)
+	})
+	if err != nil {
+		if ErrObjectLock.Has(err) {
+			return DeleteObjectResult{}, err
+		}
+		return DeleteObjectResult{}, Error.Wrap(err)
+	}
+
+	return result, nil
+}
+
 // DeleteObjectLastCommittedSuspended deletes an object last committed version when opts.Suspended is true.
 func (db *DB) DeleteObjectLastCommittedSuspended(ctx context.Context, opts DeleteObjectLastCommitted, deleterMarkerStreamID uuid.UUID) (result DeleteObjectResult, err error) {
 	var marker Object
@@ -15,6 +15,8 @@ import (
 
 	"storj.io/common/uuid"
 	"storj.io/storj/shared/dbutil/spannerutil"
+	"storj.io/storj/shared/dbutil/txutil"
+	"storj.io/storj/shared/tagsql"
 )
 
 const (
@@ -162,6 +164,88 @@ func tagsqlDeleteAllBucketObjects(ctx context.Context, db tagsqlAdapter, opts De
 	}
 }
 
+// DeleteAllBucketObjects deletes objects in the specified bucket in batches of opts.BatchSize number of objects.
+func (s *SQLiteAdapter) DeleteAllBucketObjects(ctx context.Context, opts DeleteAllBucketObjects) (totalDeletedObjects, totalDeletedSegments int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleteBatch := func(ctx context.Context, tx tagsql.Tx) (deletedObjects, deletedSegments int64, objectsInfo []DeleteObjectsInfo, err error) {
+		defer mon.Task()(&ctx)(&err)
+
+		var streamIDs []uuid.UUID
+		err = withRows(tx.QueryContext(ctx, `
+			DELETE FROM objects
+			WHERE (project_id, bucket_name) = ($1, $2) AND
+				stream_id IN (
+					SELECT stream_id FROM objects
+					WHERE (project_id, bucket_name) = ($1, $2)
+					LIMIT $3
+				)
+			RETURNING stream_id, version, status, created_at, total_encrypted_size, segment_count
+		`, opts.Bucket.ProjectID, opts.Bucket.BucketName, opts.BatchSize))(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				var streamID uuid.UUID
+				var version Version
+				var status ObjectStatus
+				var createdAt time.Time
+				var totalEncryptedSize int64
+				var segmentCount int64
+				if err := rows.Scan(&streamID, &version, &status, &createdAt, &totalEncryptedSize, &segmentCount); err != nil {
+					return err
+				}
+
+				deletedObjects++
+				deletedSegments += segmentCount
+				streamIDs = append(streamIDs, streamID)
+
+				if opts.OnObjectsDeleted != nil {
+					objectsInfo = append(objectsInfo, DeleteObjectsInfo{
+						StreamVersionID:    NewStreamVersionID(version, streamID),
+						Status:             status,
+						CreatedAt:          createdAt,
+						TotalEncryptedSize: totalEncryptedSize,
+					})
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return 0, 0, nil, Error.Wrap(err)
+		}
+
+		for _, streamID := range streamIDs {
+			if _, err := sqliteDeleteSegments(ctx, tx, streamID); err != nil {
+				return 0, 0, nil, Error.Wrap(err)
+			}
+		}
+		return deletedObjects, deletedSegments, objectsInfo, nil
+	}
+
+	for {
+		var (
+			deletedObjects, deletedSegments int64
+			batchObjectsInfo                []DeleteObjectsInfo
+		)
+		err := txutil.WithTx(ctx, s.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+			deletedObjects, deletedSegments, batchObjectsInfo, err = deleteBatch(ctx, tx)
+			return err
+		})
+		if err != nil {
+			return totalDeletedObjects, totalDeletedSegments, err
+		}
+
+		totalDeletedObjects += deletedObjects
+		totalDeletedSegments += deletedSegments
+
+		if opts.OnObjectsDeleted != nil && len(batchObjectsInfo) > 0 {
+			opts.OnObjectsDeleted(batchObjectsInfo)
+		}
+
+		if deletedObjects == 0 {
+			return totalDeletedObjects, totalDeletedSegments, nil
+		}
+	}
+}
+
 // DeleteAllBucketObjects deletes objects in the specified bucket in batches of opts.BatchSize number of objects.
 func (s *SpannerAdapter) DeleteAllBucketObjects(ctx context.Context, opts DeleteAllBucketObjects) (totalDeletedObjects, totalDeletedSegments int64, err error) {
 	defer mon.Task()(&ctx)(&err)
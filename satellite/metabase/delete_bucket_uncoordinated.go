@@ -64,6 +64,17 @@ func (p *PostgresAdapter) UncoordinatedDeleteAllBucketObjects(ctx context.Contex
 	})
 }
 
+// UncoordinatedDeleteAllBucketObjects deletes objects in the specified bucket in batches of opts.BatchSize number of objects.
+func (s *SQLiteAdapter) UncoordinatedDeleteAllBucketObjects(ctx context.Context, opts UncoordinatedDeleteAllBucketObjects) (totalDeletedObjects, totalDeletedSegments int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.DeleteAllBucketObjects(ctx, DeleteAllBucketObjects{
+		Bucket:           opts.Bucket,
+		BatchSize:        opts.BatchSize,
+		OnObjectsDeleted: opts.OnObjectsDeleted,
+	})
+}
+
 // UncoordinatedDeleteAllBucketObjects deletes objects in the specified bucket in batches of opts.BatchSize number of objects.
 func (s *SpannerAdapter) UncoordinatedDeleteAllBucketObjects(ctx context.Context, opts UncoordinatedDeleteAllBucketObjects) (totalDeletedObjects, totalDeletedSegments int64, err error) {
 	defer mon.Task()(&ctx)(&err)
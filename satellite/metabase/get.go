@@ -313,7 +313,7 @@ func (db *DB) GetSegmentByPosition(ctx context.Context, opts GetSegmentByPositio
 	// check all adapters until a match is found
 	var aliasPieces AliasPieces
 	found := false
-	for _, adapter := range db.adapters {
+	for _, adapter := range db.segmentAdapters() {
 		segment, aliasPieces, err = adapter.GetSegmentByPosition(ctx, opts)
 		if err != nil {
 			if ErrSegmentNotFound.Has(err) {
@@ -355,7 +355,7 @@ func (db *DB) GetSegmentByPositionForAudit(
 	// check all adapters until a match is found
 	var aliasPieces AliasPieces
 	found := false
-	for _, adapter := range db.adapters {
+	for _, adapter := range db.segmentAdapters() {
 		segment, aliasPieces, err = adapter.GetSegmentByPositionForAudit(ctx, opts)
 		if err != nil {
 			if ErrSegmentNotFound.Has(err) {
@@ -397,7 +397,7 @@ func (db *DB) GetSegmentByPositionForRepair(
 	// check all adapters until a match is found
 	var aliasPieces AliasPieces
 	found := false
-	for _, adapter := range db.adapters {
+	for _, adapter := range db.segmentAdapters() {
 		segment, aliasPieces, err = adapter.GetSegmentByPositionForRepair(ctx, opts)
 		if err != nil {
 			if ErrSegmentNotFound.Has(err) {
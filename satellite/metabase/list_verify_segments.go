@@ -12,6 +12,7 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/shared/dbutil"
 	"storj.io/storj/shared/dbutil/pgutil"
 	"storj.io/storj/shared/dbutil/spannerutil"
 	"storj.io/storj/shared/tagsql"
@@ -54,7 +55,7 @@ type VerifySegment struct {
 	AliasPieces AliasPieces
 }
 
-func (opts *ListVerifySegments) getQueryAndParameters(asof string) (string, []interface{}) {
+func (opts *ListVerifySegments) getQueryAndParameters(impl dbutil.Implementation, asof string) (string, []interface{}) {
 
 	if len(opts.StreamIDs) == 0 {
 		return `
@@ -75,13 +76,37 @@ func (opts *ListVerifySegments) getQueryAndParameters(asof string) (string, []in
 		ORDER BY stream_id ASC, position ASC
 		LIMIT $5
 	`, []interface{}{
-				opts.CursorStreamID,
-				opts.CursorPosition,
-				opts.CreatedAfter,
-				opts.CreatedBefore,
-				opts.Limit,
-			}
+			opts.CursorStreamID,
+			opts.CursorPosition,
+			opts.CreatedAfter,
+			opts.CreatedBefore,
+			opts.Limit,
+		}
+	}
+
+	streamIDs := `stream_id = ANY($1)`
+	var streamIDsParameter any = pgutil.UUIDArray(opts.StreamIDs)
+	if impl == dbutil.SQLite3 {
+		// sqlite does not support arrays, so the stream ID-s are
+		// passed as separate parameters after the rest.
+		streamIDs = `stream_id IN (` + sqlitePlaceholders(7, len(opts.StreamIDs)) + `)`
+		streamIDsParameter = nil
+	}
+
+	parameters := []interface{}{
+		streamIDsParameter,
+		opts.CursorStreamID,
+		opts.CursorPosition,
+		opts.CreatedAfter,
+		opts.CreatedBefore,
+		opts.Limit,
 	}
+	if impl == dbutil.SQLite3 {
+		for _, streamID := range opts.StreamIDs {
+			parameters = append(parameters, streamID)
+		}
+	}
+
 	return `
 		SELECT
 			segments.stream_id, segments.position,
@@ -91,7 +116,7 @@ func (opts *ListVerifySegments) getQueryAndParameters(asof string) (string, []in
 		FROM segments
 		` + asof + `
 		WHERE
-			stream_id = ANY($1) AND
+			` + streamIDs + ` AND
 			(segments.stream_id, segments.position) > ($2, $3) AND
 			segments.inline_data IS NULL AND
 			segments.remote_alias_pieces IS NOT NULL AND
@@ -100,14 +125,7 @@ func (opts *ListVerifySegments) getQueryAndParameters(asof string) (string, []in
 			($5::TIMESTAMPTZ IS NULL OR segments.created_at < $5)     -- created before
 		ORDER BY segments.stream_id ASC, segments.position ASC
 		LIMIT $6
-	`, []interface{}{
-			pgutil.UUIDArray(opts.StreamIDs),
-			opts.CursorStreamID,
-			opts.CursorPosition,
-			opts.CreatedAfter,
-			opts.CreatedBefore,
-			opts.Limit,
-		}
+	`, parameters
 }
 
 func (opts *ListVerifySegments) getSpannerQueryAndParameters() spanner.Statement {
@@ -204,7 +222,7 @@ func (db *DB) ListVerifySegments(ctx context.Context, opts ListVerifySegments) (
 // ListVerifySegments lists the segments in a specified stream.
 func (p *PostgresAdapter) ListVerifySegments(ctx context.Context, opts ListVerifySegments) (segments []VerifySegment, err error) {
 	asOfString := LimitedAsOfSystemTime(p.impl, time.Now(), opts.AsOfSystemTime, opts.AsOfSystemInterval)
-	query, parameters := opts.getQueryAndParameters(asOfString)
+	query, parameters := opts.getQueryAndParameters(p.impl, asOfString)
 
 	err = withRows(p.db.QueryContext(ctx, query, parameters...))(func(rows tagsql.Rows) error {
 		for rows.Next() {
@@ -15,8 +15,10 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+	"storj.io/storj/shared/dbutil"
 	"storj.io/storj/shared/dbutil/dbschema"
 	"storj.io/storj/shared/dbutil/pgutil"
+	"storj.io/storj/shared/dbutil/sqliteutil"
 	"storj.io/storj/shared/tagsql"
 )
 
@@ -66,7 +68,11 @@ func schemaFromMigration(t *testing.T, ctx *testcontext.Context, dbinfo satellit
 	pgAdapter, ok := adapter.(tagSqlDB)
 	require.True(t, ok, "Spanner not supported yet for testing snapshots and querying schema")
 
-	scheme, err = pgutil.QuerySnapshot(ctx, pgAdapter.UnderlyingDB())
+	if db.Implementation() == dbutil.SQLite3 {
+		scheme, err = sqliteutil.QuerySnapshot(ctx, pgAdapter.UnderlyingDB())
+	} else {
+		scheme, err = pgutil.QuerySnapshot(ctx, pgAdapter.UnderlyingDB())
+	}
 	require.NoError(t, err)
 
 	return scheme
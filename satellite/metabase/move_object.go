@@ -13,6 +13,7 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/shared/dbutil"
 	"storj.io/storj/shared/dbutil/pgutil"
 	"storj.io/storj/shared/dbutil/spannerutil"
 	"storj.io/storj/shared/tagsql"
@@ -368,6 +369,10 @@ func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err
 }
 
 func (ptx *postgresTransactionAdapter) objectMove(ctx context.Context, opts FinishMoveObject, newStatus ObjectStatus, nextVersion Version) (oldStatus ObjectStatus, segmentsCount int, hasMetadataOrETag bool, streamID uuid.UUID, info lockInfo, err error) {
+	if ptx.postgresAdapter.impl == dbutil.SQLite3 {
+		return ptx.sqliteObjectMove(ctx, opts, newStatus, nextVersion)
+	}
+
 	args := []any{
 		opts.NewBucket,
 		opts.NewEncryptedObjectKey,
@@ -442,6 +447,67 @@ func (ptx *postgresTransactionAdapter) objectMove(ctx context.Context, opts Fini
 	return oldStatus, segmentsCount, hasMetadataOrETag, streamID, info, nil
 }
 
+// sqliteObjectMove implements objectMove without data-modifying statements in WITH.
+func (ptx *postgresTransactionAdapter) sqliteObjectMove(ctx context.Context, opts FinishMoveObject, newStatus ObjectStatus, nextVersion Version) (oldStatus ObjectStatus, segmentsCount int, hasMetadataOrETag bool, streamID uuid.UUID, info lockInfo, err error) {
+	err = ptx.tx.QueryRowContext(ctx, `
+		SELECT status, expires_at, retention_mode, retain_until
+		FROM objects
+		WHERE (project_id, bucket_name, object_key, version) = ($1, $2, $3, $4)
+	`, opts.ProjectID, opts.BucketName, opts.ObjectKey, opts.Version).Scan(
+		&oldStatus,
+		&info.objectExpiresAt,
+		lockModeWrapper{retentionMode: &info.retention.Mode, legalHold: &info.legalHold},
+		timeWrapper{&info.retention.RetainUntil},
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, 0, false, uuid.UUID{}, lockInfo{}, ErrObjectNotFound.New("object not found")
+		}
+		return 0, 0, false, uuid.UUID{}, lockInfo{}, Error.New("unable to query object: %w", err)
+	}
+
+	err = ptx.tx.QueryRowContext(ctx, `
+		UPDATE objects SET
+			bucket_name = $1,
+			object_key = $2,
+			version = $12,
+			status = $9,
+			encrypted_metadata_encrypted_key =
+				CASE WHEN encrypted_metadata IS NOT NULL
+					THEN $3
+					ELSE encrypted_metadata_encrypted_key
+				END,
+			encrypted_metadata_nonce =
+				CASE WHEN encrypted_metadata IS NOT NULL
+					THEN $4
+					ELSE encrypted_metadata_nonce
+				END,
+			retention_mode = $10,
+			retain_until = $11
+		WHERE
+			(project_id, bucket_name, object_key, version) = ($5, $6, $7, $8)
+		RETURNING
+			segment_count,
+			(encrypted_metadata IS NOT NULL AND LENGTH(encrypted_metadata) > 0)
+				OR (encrypted_etag IS NOT NULL AND LENGTH(encrypted_etag) > 0) AS has_metadata,
+			stream_id
+	`,
+		opts.NewBucket,
+		opts.NewEncryptedObjectKey,
+		opts.NewEncryptedMetadataEncryptedKey,
+		opts.NewEncryptedMetadataNonce,
+		opts.ProjectID, opts.BucketName, opts.ObjectKey, opts.Version,
+		newStatus,
+		lockModeWrapper{retentionMode: &opts.Retention.Mode, legalHold: &opts.LegalHold},
+		timeWrapper{&opts.Retention.RetainUntil},
+		nextVersion,
+	).Scan(&segmentsCount, &hasMetadataOrETag, &streamID)
+	if err != nil {
+		return 0, 0, false, uuid.UUID{}, lockInfo{}, Error.New("unable to update object: %w", err)
+	}
+	return oldStatus, segmentsCount, hasMetadataOrETag, streamID, info, nil
+}
+
 func (stx *spannerTransactionAdapter) objectMove(ctx context.Context, opts FinishMoveObject, newStatus ObjectStatus, nextVersion Version) (oldStatus ObjectStatus, segmentsCount int, hasMetadata bool, streamID uuid.UUID, info lockInfo, err error) {
 	// We cannot UPDATE the object record in place, because some of the columns we need to update are
 	// part of the primary key. We must DELETE and INSERT instead.
@@ -571,6 +637,28 @@ func (stx *spannerTransactionAdapter) objectMove(ctx context.Context, opts Finis
 }
 
 func (ptx *postgresTransactionAdapter) objectMoveEncryption(ctx context.Context, opts FinishMoveObject, positions []int64, encryptedKeys [][]byte, encryptedKeyNonces [][]byte) (numAffected int64, err error) {
+	if ptx.postgresAdapter.impl == dbutil.SQLite3 {
+		for i, position := range positions {
+			updateResult, err := ptx.tx.ExecContext(ctx, `
+				UPDATE segments SET
+					encrypted_key_nonce = COALESCE($3, X''),
+					encrypted_key = COALESCE($4, X'')
+				WHERE
+					stream_id = $1 AND
+					position = $2
+			`, opts.StreamID, position, encryptedKeyNonces[i], encryptedKeys[i])
+			if err != nil {
+				return 0, Error.Wrap(err)
+			}
+			affected, err := updateResult.RowsAffected()
+			if err != nil {
+				return 0, Error.Wrap(err)
+			}
+			numAffected += affected
+		}
+		return numAffected, nil
+	}
+
 	updateResult, err := ptx.tx.ExecContext(ctx, `
 			UPDATE segments SET
 				encrypted_key_nonce = P.encrypted_key_nonce,
@@ -0,0 +1,1102 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/shared/dbutil/pgutil"
+	"storj.io/storj/shared/dbutil/spannerutil"
+	"storj.io/storj/shared/dbutil/txutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+// ProjectMigrationConfig contains configuration for migrating projects between adapters.
+type ProjectMigrationConfig struct {
+	// BatchSize is the number of objects copied in a single transaction.
+	BatchSize int
+	// MaxPasses is the number of copy passes, after which the project is frozen, even when
+	// the copy doesn't converge.
+	MaxPasses int
+	// FreezeThreshold is the number of changed objects in a copy pass, below which the project
+	// is frozen for the final pass.
+	FreezeThreshold int
+	// GracePeriod is how long to wait for all the processes to load the changed route. It
+	// must be longer than twice the refresh interval of the project routes.
+	GracePeriod time.Duration
+}
+
+// ProjectMigrator moves the objects and segments of a project between adapters, while the
+// project is in use.
+//
+// The objects are copied to the target adapter in passes, each of them comparing the objects
+// and segments of both adapters and fixing the differences in batches. Once a pass changes only
+// a few objects, the writes to the project are rejected for the final pass and the verification.
+// The route of the project is then changed to the target adapter and the copy in the source
+// adapter is deleted.
+//
+// The concurrent writes are captured by the copy passes instead of writing to both adapters.
+// Each pass compares both copies and fixes what changed since the previous one, so the passes
+// converge as long as the project changes slower than it's copied. Writing to both adapters
+// would need every write to commit atomically in two databases, which the adapters don't
+// support, and a failed second write would leave the copies silently diverged. The price is
+// that the project is read-only during the final pass and the verification, which is bounded
+// by the time needed to copy and verify FreezeThreshold objects.
+//
+// A process, which can't refresh the routes for twice the refresh interval, rejects the writes
+// to all the projects. The grace period is longer than that, so once it passes after a route
+// change, no process writes according to the previous route.
+//
+// While the project is migrated, both copies are visible to the segment loop and to lookups
+// by stream ID. Lookups prefer the adapter serving the project.
+type ProjectMigrator struct {
+	log    *zap.Logger
+	db     *DB
+	config ProjectMigrationConfig
+}
+
+// NewProjectMigrator creates a new project migrator.
+func NewProjectMigrator(log *zap.Logger, db *DB, config ProjectMigrationConfig) *ProjectMigrator {
+	if config.BatchSize <= 0 {
+		config.BatchSize = 1000
+	}
+	if config.MaxPasses <= 0 {
+		config.MaxPasses = 10
+	}
+	if config.FreezeThreshold <= 0 {
+		config.FreezeThreshold = 100
+	}
+	if config.GracePeriod <= 0 {
+		config.GracePeriod = 3 * db.config.ProjectRoutesRefreshInterval
+	}
+	return &ProjectMigrator{
+		log:    log,
+		db:     db,
+		config: config,
+	}
+}
+
+// Start starts the migration of the project to the target adapter. The migration is performed by Run.
+func (m *ProjectMigrator) Start(ctx context.Context, projectID uuid.UUID, target string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(m.db.adapters) < 2 || m.db.config.ProjectRoutesRefreshInterval <= 0 {
+		return Error.New("project routes are disabled")
+	}
+	if m.config.GracePeriod < m.db.routesStaleAfter() {
+		return Error.New("grace period %s must be longer than %s", m.config.GracePeriod, m.db.routesStaleAfter())
+	}
+	if m.db.adapterByName(target) == nil {
+		return Error.New("unknown adapter %q", target)
+	}
+
+	routes, err := m.db.adapters[0].listProjectRoutes(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var existing *ProjectRoute
+	for i, route := range routes {
+		if route.Active() {
+			return Error.New("project %s is already being migrated", route.ProjectID)
+		}
+		if route.ProjectID == projectID {
+			existing = &routes[i]
+		}
+	}
+
+	source := m.db.configuredAdapter(projectID).Name()
+	if existing != nil {
+		source = existing.Adapter
+	}
+	if source == target {
+		return Error.New("project %s is already stored in %q", projectID, target)
+	}
+
+	route := ProjectRoute{
+		ProjectID:        projectID,
+		Adapter:          source,
+		MigrationAdapter: target,
+		MigrationState:   ProjectMigrationCopying,
+		Pass:             1,
+	}
+
+	var ok bool
+	if existing != nil {
+		ok, err = m.db.adapters[0].updateProjectRoute(ctx, route, ProjectMigrationNone)
+	} else {
+		ok, err = m.db.adapters[0].insertProjectRoute(ctx, route)
+	}
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !ok {
+		return Error.New("project %s route was changed concurrently", projectID)
+	}
+
+	m.log.Info("project migration started",
+		zap.Stringer("Project ID", projectID),
+		zap.String("Source", source),
+		zap.String("Target", target))
+	return nil
+}
+
+// Abort aborts the migration of the project. The partial copy is deleted by Run.
+func (m *ProjectMigrator) Abort(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	route, err := m.db.GetProjectRoute(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	switch route.MigrationState {
+	case ProjectMigrationCopying, ProjectMigrationFrozen:
+	case ProjectMigrationAborting:
+		return nil
+	default:
+		return Error.New("project %s migration can't be aborted in state %q", projectID, route.MigrationState)
+	}
+
+	expected := route.MigrationState
+	route.MigrationState = ProjectMigrationAborting
+	route.Cursor = MigrationCursor{}
+	return m.updateRoute(ctx, route, expected)
+}
+
+// Run performs the migration of the project until it's finished. Run can be interrupted
+// and continued at any time.
+func (m *ProjectMigrator) Run(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		route, err := m.db.GetProjectRoute(ctx, projectID)
+		if err != nil {
+			return err
+		}
+
+		log := m.log.With(
+			zap.Stringer("Project ID", projectID),
+			zap.String("Adapter", route.Adapter),
+			zap.String("Migration Adapter", route.MigrationAdapter),
+			zap.String("State", string(route.MigrationState)))
+
+		source, target := m.db.adapterByName(route.Adapter), m.db.adapterByName(route.MigrationAdapter)
+		if route.Active() && (source == nil || target == nil) {
+			return Error.New("project %s is routed to an unknown adapter", projectID)
+		}
+
+		switch route.MigrationState {
+		case ProjectMigrationNone:
+			log.Info("project migration finished")
+			return nil
+		case ProjectMigrationCopying:
+			err = m.copyPass(ctx, log, route, source, target)
+		case ProjectMigrationFrozen:
+			err = m.finish(ctx, log, route, source, target)
+		case ProjectMigrationCleanup, ProjectMigrationAborting:
+			// the second copy is in the migration adapter in both cases.
+			err = m.deleteCopy(ctx, log, route, target)
+		default:
+			return Error.New("unknown project migration state %q", route.MigrationState)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// copyPass copies the changes of the project to the target adapter.
+func (m *ProjectMigrator) copyPass(ctx context.Context, log *zap.Logger, route ProjectRoute, source, target Adapter) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if route.Pass == 1 && route.Cursor.IsZero() {
+		// the processes must know about the copy before it's created, so they won't find
+		// the segments in the target adapter by stream ID.
+		if err := m.waitGrace(ctx, route); err != nil {
+			return err
+		}
+	}
+
+	for {
+		result, err := m.syncBatch(ctx, source, target, route.ProjectID, route.Cursor, false)
+		if err != nil {
+			return err
+		}
+
+		route.ChangedObjects += result.Changed
+		route.TotalObjects += result.Objects
+		route.TotalSegments += result.Segments
+		route.Cursor = result.Cursor
+
+		if !result.Done {
+			if err := m.updateRoute(ctx, route, ProjectMigrationCopying); err != nil {
+				return err
+			}
+			continue
+		}
+
+		log.Info("project migration pass finished",
+			zap.Int64("Pass", route.Pass),
+			zap.Int64("Changed Objects", route.ChangedObjects),
+			zap.Int64("Objects", route.TotalObjects),
+			zap.Int64("Segments", route.TotalSegments))
+
+		if route.ChangedObjects <= int64(m.config.FreezeThreshold) || route.Pass >= int64(m.config.MaxPasses) {
+			route.MigrationState = ProjectMigrationFrozen
+		} else {
+			route.Pass++
+		}
+		route.Cursor = MigrationCursor{}
+		route.ChangedObjects, route.TotalObjects, route.TotalSegments = 0, 0, 0
+		return m.updateRoute(ctx, route, ProjectMigrationCopying)
+	}
+}
+
+// finish copies the last changes, while the project is frozen, verifies the copy and routes
+// the project to the target adapter.
+func (m *ProjectMigrator) finish(ctx context.Context, log *zap.Logger, route ProjectRoute, source, target Adapter) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// all the processes must stop writing to the project before the final pass.
+	if err := m.waitGrace(ctx, route); err != nil {
+		return err
+	}
+
+	changed, _, err := m.fullPass(ctx, source, target, route.ProjectID, false)
+	if err != nil {
+		return err
+	}
+
+	verify, checksums, err := m.fullPass(ctx, source, target, route.ProjectID, true)
+	if err != nil {
+		return err
+	}
+
+	targetSummary, err := m.summarize(ctx, target, route.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	var failure string
+	switch {
+	case verify.Changed != 0:
+		failure = fmt.Sprintf("%d objects differ after the final pass", verify.Changed)
+	case verify.Objects != targetSummary.Objects || verify.Segments != targetSummary.Segments:
+		failure = fmt.Sprintf("counts differ: source has %d objects and %d segments, target has %d objects and %d segments",
+			verify.Objects, verify.Segments, targetSummary.Objects, targetSummary.Segments)
+	case !bytes.Equal(checksums, targetSummary.Checksum):
+		failure = "checksums differ"
+	}
+
+	if failure != "" {
+		log.Error("project migration verification failed", zap.String("Reason", failure))
+		route.MigrationState = ProjectMigrationCopying
+		route.Pass++
+		route.Cursor = MigrationCursor{}
+		route.Error = failure
+		return m.updateRoute(ctx, route, ProjectMigrationFrozen)
+	}
+
+	log.Info("project migration verified",
+		zap.Int64("Changed Objects", changed.Changed),
+		zap.Int64("Objects", verify.Objects),
+		zap.Int64("Segments", verify.Segments))
+
+	route.Adapter, route.MigrationAdapter = route.MigrationAdapter, route.Adapter
+	route.MigrationState = ProjectMigrationCleanup
+	route.Cursor = MigrationCursor{}
+	route.ChangedObjects = 0
+	route.TotalObjects = verify.Objects
+	route.TotalSegments = verify.Segments
+	route.Error = ""
+	return m.updateRoute(ctx, route, ProjectMigrationFrozen)
+}
+
+// deleteCopy deletes the second copy of the project from the migration adapter.
+func (m *ProjectMigrator) deleteCopy(ctx context.Context, log *zap.Logger, route ProjectRoute, adapter Adapter) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if route.Cursor.IsZero() {
+		// the processes still using the old route may read from the copy.
+		if err := m.waitGrace(ctx, route); err != nil {
+			return err
+		}
+	}
+
+	for {
+		objects, err := adapter.listMigrationObjects(ctx, route.ProjectID, route.Cursor, m.config.BatchSize)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(objects) > 0 {
+			if err := adapter.applyMigrationBatch(ctx, route.ProjectID, objects, nil, nil); err != nil {
+				return Error.Wrap(err)
+			}
+			route.Cursor = migrationCursorOf(&objects[len(objects)-1])
+		}
+
+		state := route.MigrationState
+		if len(objects) < m.config.BatchSize {
+			log.Info("project migration copy deleted")
+			route = ProjectRoute{
+				ProjectID: route.ProjectID,
+				Adapter:   route.Adapter,
+			}
+		}
+		if err := m.updateRoute(ctx, route, state); err != nil {
+			return err
+		}
+		if route.MigrationState == ProjectMigrationNone {
+			return nil
+		}
+	}
+}
+
+// waitGrace waits until the grace period since the last change of the route passes.
+func (m *ProjectMigrator) waitGrace(ctx context.Context, route ProjectRoute) error {
+	now, err := m.db.adapters[0].Now(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	wait := route.UpdatedAt.Add(m.config.GracePeriod).Sub(now)
+	if wait <= 0 {
+		return nil
+	}
+
+	m.log.Info("waiting for the processes to load the project route",
+		zap.Stringer("Project ID", route.ProjectID),
+		zap.Duration("Wait", wait))
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// updateRoute updates the route, when it's in the expected state.
+func (m *ProjectMigrator) updateRoute(ctx context.Context, route ProjectRoute, expected ProjectMigrationState) error {
+	ok, err := m.db.adapters[0].updateProjectRoute(ctx, route, expected)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !ok {
+		return Error.New("project %s route was changed concurrently", route.ProjectID)
+	}
+	return nil
+}
+
+// migrationBatch is the result of comparing a range of objects.
+type migrationBatch struct {
+	// Cursor is the last object in the range.
+	Cursor MigrationCursor
+	// Done is true, when the range contains the last object.
+	Done bool
+
+	// Objects and Segments are the numbers of objects and segments in the source adapter.
+	Objects  int64
+	Segments int64
+	// Changed is the number of objects inserted, updated or deleted in the target adapter.
+	Changed int64
+
+	// Checksums are the checksums of the objects in the source adapter.
+	Checksums [][sha256.Size]byte
+}
+
+// fullPass compares all the objects of the project without persisting the progress.
+func (m *ProjectMigrator) fullPass(ctx context.Context, source, target Adapter, projectID uuid.UUID, dryRun bool) (total migrationBatch, checksum []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	h := sha256.New()
+	var cursor MigrationCursor
+	for {
+		batch, err := m.syncBatch(ctx, source, target, projectID, cursor, dryRun)
+		if err != nil {
+			return migrationBatch{}, nil, err
+		}
+		total.Objects += batch.Objects
+		total.Segments += batch.Segments
+		total.Changed += batch.Changed
+		for _, sum := range batch.Checksums {
+			_, _ = h.Write(sum[:])
+		}
+
+		cursor = batch.Cursor
+		if batch.Done {
+			return total, h.Sum(nil), nil
+		}
+	}
+}
+
+// migrationSummary contains the counts and checksum of all the objects of a project in an adapter.
+type migrationSummary struct {
+	Objects  int64
+	Segments int64
+	Checksum []byte
+}
+
+// summarize counts the objects and segments of the project and computes the checksum of them.
+func (m *ProjectMigrator) summarize(ctx context.Context, adapter Adapter, projectID uuid.UUID) (summary migrationSummary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	h := sha256.New()
+	var cursor MigrationCursor
+	for {
+		objects, err := adapter.listMigrationObjects(ctx, projectID, cursor, m.config.BatchSize)
+		if err != nil {
+			return migrationSummary{}, Error.Wrap(err)
+		}
+		segments, err := listMigrationSegments(ctx, adapter, objects)
+		if err != nil {
+			return migrationSummary{}, err
+		}
+
+		for i := range objects {
+			sum := migrationChecksum(&objects[i], segments[objects[i].StreamID])
+			_, _ = h.Write(sum[:])
+			summary.Segments += int64(len(segments[objects[i].StreamID]))
+		}
+		summary.Objects += int64(len(objects))
+
+		if len(objects) < m.config.BatchSize {
+			summary.Checksum = h.Sum(nil)
+			return summary, nil
+		}
+		cursor = migrationCursorOf(&objects[len(objects)-1])
+	}
+}
+
+// syncBatch compares a batch of objects after the cursor in both adapters and fixes the
+// differences in the target adapter.
+func (m *ProjectMigrator) syncBatch(ctx context.Context, source, target Adapter, projectID uuid.UUID, cursor MigrationCursor, dryRun bool) (batch migrationBatch, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	sourceObjects, err := source.listMigrationObjects(ctx, projectID, cursor, m.config.BatchSize)
+	if err != nil {
+		return migrationBatch{}, Error.Wrap(err)
+	}
+
+	batch.Done = len(sourceObjects) < m.config.BatchSize
+	if len(sourceObjects) > 0 {
+		batch.Cursor = migrationCursorOf(&sourceObjects[len(sourceObjects)-1])
+	}
+
+	// collect the target objects in the same range.
+	var targetObjects []RawObject
+	targetCursor := cursor
+	for {
+		objects, err := target.listMigrationObjects(ctx, projectID, targetCursor, m.config.BatchSize)
+		if err != nil {
+			return migrationBatch{}, Error.Wrap(err)
+		}
+		for _, object := range objects {
+			if !batch.Done && batch.Cursor.Less(migrationCursorOf(&object)) {
+				break
+			}
+			targetObjects = append(targetObjects, object)
+		}
+		if len(objects) < m.config.BatchSize || len(objects) == 0 {
+			break
+		}
+		targetCursor = migrationCursorOf(&objects[len(objects)-1])
+		if !batch.Done && batch.Cursor.Less(targetCursor) {
+			break
+		}
+	}
+	if batch.Done && len(targetObjects) > 0 {
+		if last := migrationCursorOf(&targetObjects[len(targetObjects)-1]); batch.Cursor.Less(last) {
+			batch.Cursor = last
+		}
+	}
+
+	sourceSegments, err := listMigrationSegments(ctx, source, sourceObjects)
+	if err != nil {
+		return migrationBatch{}, err
+	}
+	targetSegments, err := listMigrationSegments(ctx, target, targetObjects)
+	if err != nil {
+		return migrationBatch{}, err
+	}
+
+	targetChecksums := make(map[MigrationCursor][sha256.Size]byte, len(targetObjects))
+	targetByKey := make(map[MigrationCursor]RawObject, len(targetObjects))
+	for i := range targetObjects {
+		key := migrationCursorOf(&targetObjects[i])
+		targetChecksums[key] = migrationChecksum(&targetObjects[i], targetSegments[targetObjects[i].StreamID])
+		targetByKey[key] = targetObjects[i]
+	}
+
+	var deletes, inserts []RawObject
+	var insertSegments []migrationSegment
+	batch.Checksums = make([][sha256.Size]byte, 0, len(sourceObjects))
+	for i := range sourceObjects {
+		object := &sourceObjects[i]
+		key := migrationCursorOf(object)
+		segments := sourceSegments[object.StreamID]
+
+		sum := migrationChecksum(object, segments)
+		batch.Checksums = append(batch.Checksums, sum)
+		batch.Objects++
+		batch.Segments += int64(len(segments))
+
+		targetSum, found := targetChecksums[key]
+		if found {
+			delete(targetChecksums, key)
+			if targetSum == sum {
+				continue
+			}
+			deletes = append(deletes, targetByKey[key])
+		}
+		inserts = append(inserts, *object)
+		insertSegments = append(insertSegments, segments...)
+	}
+	for key := range targetChecksums {
+		deletes = append(deletes, targetByKey[key])
+	}
+
+	batch.Changed = int64(len(inserts) + len(targetChecksums))
+	if dryRun || batch.Changed == 0 {
+		return batch, nil
+	}
+
+	if target != m.db.adapters[0] {
+		if err := m.copyNodeAliases(ctx, target, insertSegments); err != nil {
+			return migrationBatch{}, err
+		}
+	}
+
+	err = target.applyMigrationBatch(ctx, projectID, deletes, inserts, insertSegments)
+	return batch, Error.Wrap(err)
+}
+
+// copyNodeAliases copies the node aliases used by the segments from the first adapter, which
+// is the source of truth for the aliases.
+func (m *ProjectMigrator) copyNodeAliases(ctx context.Context, target Adapter, segments []migrationSegment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	seen := map[NodeAlias]bool{}
+	var aliases []NodeAlias
+	for _, segment := range segments {
+		for _, piece := range segment.AliasPieces {
+			if !seen[piece.Alias] {
+				seen[piece.Alias] = true
+				aliases = append(aliases, piece.Alias)
+			}
+		}
+	}
+	if len(aliases) == 0 {
+		return nil
+	}
+
+	entries, err := m.db.adapters[0].GetNodeAliasEntries(ctx, GetNodeAliasEntries{Aliases: aliases})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if len(entries) != len(aliases) {
+		return Error.New("segments refer to %d unknown node aliases", len(aliases)-len(entries))
+	}
+
+	err = target.insertNodeAliasEntries(ctx, entries)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// the target adapter may have assigned different aliases for the nodes.
+	existing, err := target.GetNodeAliasEntries(ctx, GetNodeAliasEntries{Aliases: aliases})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	expected := make(map[NodeAlias]NodeAliasEntry, len(entries))
+	for _, entry := range entries {
+		expected[entry.Alias] = entry
+	}
+	for _, entry := range existing {
+		if expected[entry.Alias] != entry {
+			return Error.New("node alias %d is %s in the target adapter, but %s in the source of truth",
+				entry.Alias, entry.ID, expected[entry.Alias].ID)
+		}
+	}
+	return nil
+}
+
+// migrationSegment is a segment with the pieces as stored in the database.
+type migrationSegment struct {
+	RawSegment
+	AliasPieces AliasPieces
+}
+
+// listMigrationSegments returns the segments of the objects grouped by stream ID.
+func listMigrationSegments(ctx context.Context, adapter Adapter, objects []RawObject) (map[uuid.UUID][]migrationSegment, error) {
+	streamIDs := make([]uuid.UUID, 0, len(objects))
+	for _, object := range objects {
+		if object.SegmentCount > 0 || object.Status.IsPending() {
+			streamIDs = append(streamIDs, object.StreamID)
+		}
+	}
+
+	result := make(map[uuid.UUID][]migrationSegment, len(streamIDs))
+	if len(streamIDs) == 0 {
+		return result, nil
+	}
+
+	segments, err := adapter.listMigrationSegments(ctx, streamIDs)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for _, segment := range segments {
+		result[segment.StreamID] = append(result[segment.StreamID], segment)
+	}
+	return result, nil
+}
+
+// migrationChecksum computes a checksum of the object and its segments, which doesn't depend
+// on the adapter the object is stored in.
+func migrationChecksum(object *RawObject, segments []migrationSegment) (sum [sha256.Size]byte) {
+	h := sha256.New()
+
+	writeBytes := func(data []byte) {
+		var length [4]byte
+		binary.BigEndian.PutUint32(length[:], uint32(len(data)))
+		_, _ = h.Write(length[:])
+		_, _ = h.Write(data)
+	}
+	writeInt := func(v int64) {
+		var data [8]byte
+		binary.BigEndian.PutUint64(data[:], uint64(v))
+		_, _ = h.Write(data[:])
+	}
+
+	writeBytes(object.ProjectID.Bytes())
+	writeBytes([]byte(object.BucketName))
+	writeBytes([]byte(object.ObjectKey))
+	writeInt(int64(object.Version))
+	writeBytes(object.StreamID.Bytes())
+	writeTime(h, &object.CreatedAt)
+	writeTime(h, object.ExpiresAt)
+	writeInt(int64(object.Status))
+	writeInt(int64(object.SegmentCount))
+	writeBytes(object.EncryptedMetadataNonce)
+	writeBytes(object.EncryptedMetadata)
+	writeBytes(object.EncryptedMetadataEncryptedKey)
+	writeBytes(object.EncryptedETag)
+	writeInt(object.TotalPlainSize)
+	writeInt(object.TotalEncryptedSize)
+	writeInt(int64(object.FixedSegmentSize))
+	writeInt(int64(object.Encryption.CipherSuite))
+	writeInt(int64(object.Encryption.BlockSize))
+	writeTime(h, object.ZombieDeletionDeadline)
+	writeInt(int64(object.Retention.Mode))
+	writeTime(h, &object.Retention.RetainUntil)
+	if object.LegalHold {
+		writeInt(1)
+	} else {
+		writeInt(0)
+	}
+
+	writeInt(int64(len(segments)))
+	for _, segment := range segments {
+		writeBytes(segment.StreamID.Bytes())
+		writeInt(int64(segment.Position.Encode()))
+		writeTime(h, &segment.CreatedAt)
+		writeTime(h, segment.RepairedAt)
+		writeTime(h, segment.ExpiresAt)
+		writeBytes(segment.RootPieceID.Bytes())
+		writeBytes(segment.EncryptedKeyNonce)
+		writeBytes(segment.EncryptedKey)
+		writeInt(int64(segment.EncryptedSize))
+		writeInt(int64(segment.PlainSize))
+		writeInt(segment.PlainOffset)
+		writeBytes(segment.EncryptedETag)
+		writeInt(int64(segment.Redundancy.Algorithm))
+		writeInt(int64(segment.Redundancy.ShareSize))
+		writeInt(int64(segment.Redundancy.RequiredShares))
+		writeInt(int64(segment.Redundancy.RepairShares))
+		writeInt(int64(segment.Redundancy.OptimalShares))
+		writeInt(int64(segment.Redundancy.TotalShares))
+		writeBytes(segment.InlineData)
+		writeInt(int64(segment.Placement))
+		writeInt(int64(len(segment.AliasPieces)))
+		for _, piece := range segment.AliasPieces {
+			writeInt(int64(piece.Number))
+			writeInt(int64(piece.Alias))
+		}
+	}
+
+	copy(sum[:], h.Sum(nil))
+	return sum
+}
+
+// writeTime writes the time with the precision supported by all adapters.
+func writeTime(h hash.Hash, t *time.Time) {
+	var data [9]byte
+	if t != nil && !t.IsZero() {
+		data[0] = 1
+		binary.BigEndian.PutUint64(data[1:], uint64(t.UnixMicro()))
+	}
+	_, _ = h.Write(data[:])
+}
+
+// projectMigrationAdapter contains the queries for migrating projects between adapters.
+type projectMigrationAdapter interface {
+	listMigrationObjects(ctx context.Context, projectID uuid.UUID, cursor MigrationCursor, limit int) ([]RawObject, error)
+	listMigrationSegments(ctx context.Context, streamIDs []uuid.UUID) ([]migrationSegment, error)
+	applyMigrationBatch(ctx context.Context, projectID uuid.UUID, deletes, inserts []RawObject, segments []migrationSegment) error
+	insertNodeAliasEntries(ctx context.Context, entries []NodeAliasEntry) error
+}
+
+const migrationSegmentColumns = `
+	stream_id, position,
+	created_at, repaired_at, expires_at,
+	root_piece_id, encrypted_key_nonce, encrypted_key,
+	encrypted_size, plain_offset, plain_size,
+	encrypted_etag, redundancy,
+	inline_data, remote_alias_pieces,
+	placement
+`
+
+// listMigrationObjects implements Adapter.
+func (p *PostgresAdapter) listMigrationObjects(ctx context.Context, projectID uuid.UUID, cursor MigrationCursor, limit int) (_ []RawObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := p.db.QueryContext(ctx, `
+		SELECT `+postgresObjectColumns()+`
+		FROM objects
+		WHERE project_id = $1
+			AND (bucket_name, object_key, version) > ($2, $3, $4)
+		ORDER BY project_id, bucket_name, object_key, version
+		LIMIT $5
+	`, projectID, cursor.BucketName, cursor.ObjectKey, cursor.Version, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var objects []RawObject
+	for rows.Next() {
+		var object RawObject
+		if err := rows.Scan(postgresObjectScan(&object)...); err != nil {
+			return nil, err
+		}
+		objects = append(objects, object)
+	}
+	return objects, rows.Err()
+}
+
+// listMigrationSegments implements Adapter.
+func (p *PostgresAdapter) listMigrationSegments(ctx context.Context, streamIDs []uuid.UUID) (_ []migrationSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := p.db.QueryContext(ctx, `
+		SELECT `+migrationSegmentColumns+`
+		FROM segments
+		WHERE stream_id = ANY($1::BYTEA[])
+		ORDER BY stream_id, position
+	`, pgutil.UUIDArray(streamIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var segments []migrationSegment
+	for rows.Next() {
+		var segment migrationSegment
+		err := rows.Scan(
+			&segment.StreamID, &segment.Position,
+			&segment.CreatedAt, &segment.RepairedAt, &segment.ExpiresAt,
+			&segment.RootPieceID, &segment.EncryptedKeyNonce, &segment.EncryptedKey,
+			&segment.EncryptedSize, &segment.PlainOffset, &segment.PlainSize,
+			&segment.EncryptedETag, &segment.Redundancy,
+			&segment.InlineData, &segment.AliasPieces,
+			&segment.Placement,
+		)
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, segment)
+	}
+	return segments, rows.Err()
+}
+
+// applyMigrationBatch implements Adapter.
+func (p *PostgresAdapter) applyMigrationBatch(ctx context.Context, projectID uuid.UUID, deletes, inserts []RawObject, segments []migrationSegment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	insertSegment := `INSERT INTO segments (` + strings.Join(rawSegmentColumns, ", ") + `) VALUES (` + postgresPlaceholders(len(rawSegmentColumns)) + `)`
+
+	return txutil.WithTx(ctx, p.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		for _, object := range deletes {
+			_, err := tx.ExecContext(ctx, `
+				DELETE FROM objects
+				WHERE (project_id, bucket_name, object_key, version) = ($1, $2, $3, $4)
+			`, projectID, object.BucketName, object.ObjectKey, object.Version)
+			if err != nil {
+				return err
+			}
+		}
+
+		streamIDs := make([]uuid.UUID, 0, len(deletes))
+		for _, object := range deletes {
+			streamIDs = append(streamIDs, object.StreamID)
+		}
+		if len(streamIDs) > 0 {
+			_, err := tx.ExecContext(ctx, `
+				DELETE FROM segments
+				WHERE stream_id = ANY($1::BYTEA[])
+			`, pgutil.UUIDArray(streamIDs))
+			if err != nil {
+				return err
+			}
+		}
+
+		for i := range inserts {
+			if err := postgresInsertObject(ctx, tx, &inserts[i]); err != nil {
+				return err
+			}
+		}
+
+		rawSegments, aliases := splitMigrationSegments(segments)
+		source := newCopyFromRawSegments(rawSegments, aliases)
+		for source.Next() {
+			values, err := source.Values()
+			if err != nil {
+				return err
+			}
+			if _, err := tx.ExecContext(ctx, insertSegment, values...); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// insertNodeAliasEntries implements Adapter.
+func (p *PostgresAdapter) insertNodeAliasEntries(ctx context.Context, entries []NodeAliasEntry) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ids := make([][]byte, 0, len(entries))
+	aliases := make([]NodeAlias, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.ID.Bytes())
+		aliases = append(aliases, entry.Alias)
+	}
+
+	return txutil.WithTx(ctx, p.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO node_aliases (node_id, node_alias)
+			SELECT unnest($1::BYTEA[]), unnest($2::INT4[])
+			ON CONFLICT DO NOTHING
+		`, pgutil.ByteaArray(ids), nodeAliasesArray(aliases))
+		if err != nil {
+			return err
+		}
+
+		// keep the sequence ahead of the copied aliases.
+		_, err = tx.ExecContext(ctx, `
+			SELECT setval('node_alias_seq', GREATEST((SELECT MAX(node_alias) FROM node_aliases), 1))
+		`)
+		return err
+	})
+}
+
+// listMigrationObjects implements Adapter.
+func (s *SpannerAdapter) listMigrationObjects(ctx context.Context, projectID uuid.UUID, cursor MigrationCursor, limit int) (_ []RawObject, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tuple, err := spannerutil.TupleGreaterThanSQL(
+		[]string{"bucket_name", "object_key", "version"},
+		[]string{"@bucket_name", "@object_key", "@version"},
+		false)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return spannerutil.CollectRows(s.client.Single().QueryWithOptions(ctx, spanner.Statement{
+		SQL: `
+			SELECT ` + spannerObjectColumns() + `
+			FROM objects
+			WHERE project_id = @project_id AND ` + tuple + `
+			ORDER BY project_id, bucket_name, object_key, version
+			LIMIT @limit
+		`,
+		Params: map[string]any{
+			"project_id":  projectID,
+			"bucket_name": cursor.BucketName,
+			"object_key":  cursor.ObjectKey,
+			"version":     cursor.Version,
+			"limit":       int64(limit),
+		},
+	}, spanner.QueryOptions{RequestTag: "list-migration-objects"}), spannerMigrationObjectScan)
+}
+
+func spannerMigrationObjectScan(row *spanner.Row, obj *RawObject) error {
+	return Error.Wrap(row.Columns(
+		&obj.ProjectID,
+		&obj.BucketName,
+		&obj.ObjectKey,
+		&obj.Version,
+		&obj.StreamID,
+
+		&obj.CreatedAt,
+		&obj.ExpiresAt,
+
+		&obj.Status,
+		spannerutil.Int(&obj.SegmentCount),
+
+		&obj.EncryptedMetadataNonce,
+		&obj.EncryptedMetadata,
+		&obj.EncryptedMetadataEncryptedKey,
+		&obj.EncryptedETag,
+
+		&obj.TotalPlainSize,
+		&obj.TotalEncryptedSize,
+		spannerutil.Int(&obj.FixedSegmentSize),
+
+		&obj.Encryption,
+		&obj.ZombieDeletionDeadline,
+		lockModeWrapper{
+			retentionMode: &obj.Retention.Mode,
+			legalHold:     &obj.LegalHold,
+		},
+		timeWrapper{&obj.Retention.RetainUntil},
+	))
+}
+
+// listMigrationSegments implements Adapter.
+func (s *SpannerAdapter) listMigrationSegments(ctx context.Context, streamIDs []uuid.UUID) (_ []migrationSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	streamIDBytes := make([][]byte, 0, len(streamIDs))
+	for _, streamID := range streamIDs {
+		streamIDBytes = append(streamIDBytes, streamID.Bytes())
+	}
+
+	return spannerutil.CollectRows(s.client.Single().QueryWithOptions(ctx, spanner.Statement{
+		SQL: `
+			SELECT ` + migrationSegmentColumns + `
+			FROM segments
+			WHERE stream_id IN UNNEST(@stream_ids)
+			ORDER BY stream_id, position
+		`,
+		Params: map[string]any{
+			"stream_ids": streamIDBytes,
+		},
+	}, spanner.QueryOptions{RequestTag: "list-migration-segments"}), func(row *spanner.Row, segment *migrationSegment) error {
+		return Error.Wrap(row.Columns(
+			&segment.StreamID, &segment.Position,
+			&segment.CreatedAt, &segment.RepairedAt, &segment.ExpiresAt,
+			&segment.RootPieceID, &segment.EncryptedKeyNonce, &segment.EncryptedKey,
+			spannerutil.Int(&segment.EncryptedSize), &segment.PlainOffset, spannerutil.Int(&segment.PlainSize),
+			&segment.EncryptedETag, &segment.Redundancy,
+			&segment.InlineData, &segment.AliasPieces,
+			&segment.Placement,
+		))
+	})
+}
+
+// applyMigrationBatch implements Adapter.
+func (s *SpannerAdapter) applyMigrationBatch(ctx context.Context, projectID uuid.UUID, deletes, inserts []RawObject, segments []migrationSegment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	mutations := make([]*spanner.Mutation, 0, 2*len(deletes)+len(inserts)+len(segments))
+	for _, object := range deletes {
+		mutations = append(mutations,
+			spanner.Delete("objects", spanner.Key{
+				projectID,
+				object.BucketName,
+				object.ObjectKey,
+				int64(object.Version),
+			}),
+			spanner.Delete("segments", spanner.KeyRange{
+				Start: spanner.Key{object.StreamID},
+				End:   spanner.Key{object.StreamID},
+				Kind:  spanner.ClosedClosed,
+			}),
+		)
+	}
+	for _, object := range inserts {
+		mutations = append(mutations, spannerInsertObject(object))
+	}
+	for _, segment := range segments {
+		aliasPieces, err := segment.AliasPieces.Bytes()
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		mutations = append(mutations, spannerInsertSegment(segment.RawSegment, aliasPieces))
+	}
+
+	_, err = s.client.ReadWriteTransactionWithOptions(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+		return tx.BufferWrite(mutations)
+	}, spanner.TransactionOptions{
+		TransactionTag:              "apply-migration-batch",
+		ExcludeTxnFromChangeStreams: true,
+	})
+	return err
+}
+
+// insertNodeAliasEntries implements Adapter.
+func (s *SpannerAdapter) insertNodeAliasEntries(ctx context.Context, entries []NodeAliasEntry) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stmts := make([]spanner.Statement, 0, len(entries))
+	for _, entry := range entries {
+		stmts = append(stmts, spanner.Statement{
+			SQL: `INSERT INTO node_aliases (node_id, node_alias)
+				SELECT @node_id, @node_alias
+				FROM (SELECT 1) AS x
+				WHERE NOT EXISTS (
+					SELECT 1 FROM node_aliases WHERE node_id = @node_id OR node_alias = @node_alias
+				)`,
+			Params: map[string]any{
+				"node_id":    entry.ID,
+				"node_alias": int64(entry.Alias),
+			},
+		})
+	}
+
+	_, err = s.client.ReadWriteTransactionWithOptions(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+		_, err := tx.BatchUpdate(ctx, stmts)
+		return err
+	}, spanner.TransactionOptions{
+		TransactionTag:              "insert-node-alias-entries",
+		ExcludeTxnFromChangeStreams: true,
+	})
+	return err
+}
+
+func splitMigrationSegments(segments []migrationSegment) ([]RawSegment, []AliasPieces) {
+	rawSegments := make([]RawSegment, len(segments))
+	aliases := make([]AliasPieces, len(segments))
+	for i, segment := range segments {
+		rawSegments[i] = segment.RawSegment
+		aliases[i] = segment.AliasPieces
+	}
+	return rawSegments, aliases
+}
+
+func postgresPlaceholders(n int) string {
+	var b strings.Builder
+	for i := range n {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "$%d", i+1)
+	}
+	return b.String()
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+	"storj.io/storj/shared/dbutil/dbtest"
+	"storj.io/storj/shared/dbutil/spannerutil"
+	"storj.io/storj/shared/dbutil/tempdb"
+)
+
+func TestProjectMigration(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+
+	ephemeral, err := spannerutil.CreateEphemeralDB(ctx, dbtest.PickOrStartSpanner(t), t.Name())
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ephemeral.Close(ctx)) }()
+
+	otherConnStr := dbtest.PickPostgresNoSkip()
+	if otherConnStr == "" || strings.EqualFold(otherConnStr, "omit") {
+		t.Skip("Spanner and Postgres is required to run this test.")
+	}
+
+	tempDB, err := tempdb.OpenUnique(ctx, log, otherConnStr, "project-migration", nil)
+	require.NoError(t, err)
+	defer ctx.Check(tempDB.Close)
+
+	db, err := metabase.Open(ctx, log.Named("metabase"), tempDB.ConnStr+";"+ephemeral.Params.ConnStr(), metabase.Config{
+		ApplicationName:              "test-project-migration",
+		ProjectRoutesRefreshInterval: 200 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	defer ctx.Check(db.Close)
+
+	require.NoError(t, db.TestMigrateToLatest(ctx))
+	require.NoError(t, db.RefreshProjectRoutes(ctx))
+
+	projectID := testrand.UUID()
+
+	var expectedObjects []metabase.RawObject
+	for range 5 {
+		obj := metabasetest.RandObjectStream()
+		obj.ProjectID = projectID
+		object := metabasetest.CreateObject(ctx, t, db, obj, 2)
+		expectedObjects = append(expectedObjects, metabase.RawObject(object))
+	}
+	other := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+
+	expectedSegments, err := db.TestingAllSegments(ctx)
+	require.NoError(t, err)
+
+	migrator := metabase.NewProjectMigrator(log.Named("migrator"), db, metabase.ProjectMigrationConfig{
+		BatchSize: 2,
+	})
+
+	t.Run("unknown adapter", func(t *testing.T) {
+		require.Error(t, migrator.Start(ctx, projectID, "unknown"))
+		require.Error(t, migrator.Start(ctx, projectID, "postgres"))
+	})
+
+	t.Run("migrate", func(t *testing.T) {
+		require.NoError(t, migrator.Start(ctx, projectID, "spanner"))
+		require.Error(t, migrator.Start(ctx, testrand.UUID(), "spanner"), "only a single migration is allowed")
+
+		require.NoError(t, migrator.Run(ctx, projectID))
+
+		route, err := db.GetProjectRoute(ctx, projectID)
+		require.NoError(t, err)
+		require.Equal(t, "spanner", route.Adapter)
+		require.Equal(t, metabase.ProjectMigrationNone, route.MigrationState)
+		require.EqualValues(t, 5, route.TotalObjects)
+		require.EqualValues(t, 10, route.TotalSegments)
+
+		require.NoError(t, db.RefreshProjectRoutes(ctx))
+		adapter := db.ChooseAdapter(projectID)
+		_, ok := adapter.(*metabase.SpannerAdapter)
+		require.True(t, ok)
+
+		objects, err := adapter.TestingGetAllObjects(ctx)
+		require.NoError(t, err)
+		sort.Slice(expectedObjects, func(i, j int) bool {
+			return expectedObjects[i].ObjectStream.Less(expectedObjects[j].ObjectStream)
+		})
+		require.Equal(t, expectedObjects, objects)
+
+		objects, err = db.ChooseAdapter(other.ProjectID).TestingGetAllObjects(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []metabase.RawObject{metabase.RawObject(other)}, objects)
+
+		segments, err := db.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Equal(t, expectedSegments, segments)
+
+		_, err = db.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+			ObjectLocation: expectedObjects[0].Location(),
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("abort", func(t *testing.T) {
+		require.NoError(t, migrator.Start(ctx, projectID, "postgres"))
+		require.NoError(t, migrator.Abort(ctx, projectID))
+		require.NoError(t, migrator.Run(ctx, projectID))
+
+		route, err := db.GetProjectRoute(ctx, projectID)
+		require.NoError(t, err)
+		require.Equal(t, "spanner", route.Adapter)
+		require.Equal(t, metabase.ProjectMigrationNone, route.MigrationState)
+
+		objects, err := db.ChooseAdapter(other.ProjectID).TestingGetAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+	})
+}
@@ -0,0 +1,671 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/shared/dbutil/pgutil/pgerrcode"
+	"storj.io/storj/shared/dbutil/spannerutil"
+)
+
+// ErrProjectMigrating is returned when a project doesn't accept writes, because it's being
+// migrated to another adapter.
+var ErrProjectMigrating = errs.Class("project is being migrated")
+
+// ProjectMigrationState is the state of the migration of a project between adapters.
+type ProjectMigrationState string
+
+// States of a project migration.
+const (
+	// ProjectMigrationNone means the project isn't migrated.
+	ProjectMigrationNone ProjectMigrationState = ""
+	// ProjectMigrationCopying means the project is copied to the migration adapter, while
+	// it's served by the adapter.
+	ProjectMigrationCopying ProjectMigrationState = "copying"
+	// ProjectMigrationFrozen means the writes to the project are rejected for the final copy
+	// and the verification.
+	ProjectMigrationFrozen ProjectMigrationState = "frozen"
+	// ProjectMigrationCleanup means the project was moved to the adapter and the old copy is
+	// deleted from the migration adapter.
+	ProjectMigrationCleanup ProjectMigrationState = "cleanup"
+	// ProjectMigrationAborting means the migration was aborted and the partial copy is deleted
+	// from the migration adapter.
+	ProjectMigrationAborting ProjectMigrationState = "aborting"
+)
+
+// ProjectRoute is the persisted routing of a project to an adapter and the state of its
+// migration between adapters.
+type ProjectRoute struct {
+	ProjectID uuid.UUID
+	// Adapter is the name of the adapter, which serves the project.
+	Adapter string
+
+	// MigrationAdapter is the name of the adapter, which holds a second copy of the project
+	// during the migration.
+	MigrationAdapter string
+	MigrationState   ProjectMigrationState
+	// Pass is the number of the current copy pass.
+	Pass int64
+	// Cursor is the last object processed in the current pass.
+	Cursor MigrationCursor
+
+	// ChangedObjects is the number of objects changed in the migration adapter in the current pass.
+	ChangedObjects int64
+	// TotalObjects and TotalSegments are the numbers of objects and segments seen in the
+	// current pass, or verified by the last pass.
+	TotalObjects  int64
+	TotalSegments int64
+
+	// Error is the reason why the last attempt to finish the migration failed.
+	Error     string
+	UpdatedAt time.Time
+}
+
+// MigrationCursor is a position in the objects of a project. The zero value is before the
+// first object.
+type MigrationCursor struct {
+	BucketName BucketName
+	ObjectKey  ObjectKey
+	Version    Version
+}
+
+// IsZero returns whether the cursor is before the first object.
+func (cursor MigrationCursor) IsZero() bool {
+	return cursor == MigrationCursor{}
+}
+
+// Less returns whether the cursor is before the other one.
+func (cursor MigrationCursor) Less(other MigrationCursor) bool {
+	if cursor.BucketName != other.BucketName {
+		return cursor.BucketName < other.BucketName
+	}
+	if cursor.ObjectKey != other.ObjectKey {
+		return cursor.ObjectKey < other.ObjectKey
+	}
+	return cursor.Version < other.Version
+}
+
+func migrationCursorOf(object *RawObject) MigrationCursor {
+	return MigrationCursor{
+		BucketName: object.BucketName,
+		ObjectKey:  object.ObjectKey,
+		Version:    object.Version,
+	}
+}
+
+// Active returns whether the project has a second copy in the migration adapter.
+func (route ProjectRoute) Active() bool {
+	return route.MigrationState != ProjectMigrationNone
+}
+
+// projectRouter keeps the routes loaded from the project_adapters table.
+type projectRouter struct {
+	mu       sync.RWMutex
+	routes   map[uuid.UUID]ProjectRoute
+	shadows  map[string]bool
+	loaded   bool
+	loadedAt time.Time
+}
+
+// set replaces the routes.
+func (router *projectRouter) set(routes []ProjectRoute, now time.Time) {
+	byProject := make(map[uuid.UUID]ProjectRoute, len(routes))
+	shadows := make(map[string]bool)
+	for _, route := range routes {
+		byProject[route.ProjectID] = route
+		if route.Active() {
+			shadows[route.MigrationAdapter] = true
+		}
+	}
+
+	router.mu.Lock()
+	defer router.mu.Unlock()
+	router.routes = byProject
+	router.shadows = shadows
+	router.loaded = true
+	router.loadedAt = now
+}
+
+// lookup returns the route of the project and whether the routes are too old to be trusted.
+func (router *projectRouter) lookup(projectID uuid.UUID, staleAfter time.Duration) (route ProjectRoute, found, stale bool) {
+	router.mu.RLock()
+	defer router.mu.RUnlock()
+
+	if !router.loaded {
+		return ProjectRoute{}, false, false
+	}
+	route, found = router.routes[projectID]
+	return route, found, staleAfter > 0 && time.Since(router.loadedAt) > staleAfter
+}
+
+// isShadow returns whether the adapter holds a second copy of an active migration.
+func (router *projectRouter) isShadow(adapterName string) bool {
+	router.mu.RLock()
+	defer router.mu.RUnlock()
+	return router.shadows[adapterName]
+}
+
+// holdsCopy returns whether the adapter holds the second copy of the project.
+func (router *projectRouter) holdsCopy(projectID uuid.UUID, adapterName string) bool {
+	router.mu.RLock()
+	defer router.mu.RUnlock()
+	route, ok := router.routes[projectID]
+	return ok && route.Active() && route.MigrationAdapter == adapterName
+}
+
+// ChooseAdapter selects the right adapter based on the project routes and configuration.
+//
+// When the routes couldn't be refreshed for a while, the writes to all the projects are
+// rejected. The migration of any project, including the ones which weren't migrated when
+// the routes were loaded, may have been frozen or finished since, so writing to the last
+// known adapter could lose the writes.
+func (db *DB) ChooseAdapter(projectID uuid.UUID) Adapter {
+	route, found, stale := db.router.lookup(projectID, db.routesStaleAfter())
+
+	adapter := db.configuredAdapter(projectID)
+	if found {
+		adapter = db.adapterByName(route.Adapter)
+		if adapter == nil {
+			// the project is served by an adapter, which isn't configured in this process,
+			// so it must not be written to another one.
+			return &frozenAdapter{Adapter: db.configuredAdapter(projectID)}
+		}
+	}
+
+	if stale || route.MigrationState == ProjectMigrationFrozen {
+		return &frozenAdapter{Adapter: adapter}
+	}
+	return adapter
+}
+
+// configuredAdapter selects the adapter based on configuration.
+func (db *DB) configuredAdapter(projectID uuid.UUID) Adapter {
+	if adapter, ok := db.projectsAdapters[projectID]; ok {
+		return adapter
+	}
+	return db.adapters[0]
+}
+
+// adapterByName returns the adapter with the name or nil.
+func (db *DB) adapterByName(name string) Adapter {
+	for _, adapter := range db.adapters {
+		if adapter.Name() == name {
+			return adapter
+		}
+	}
+	return nil
+}
+
+// segmentAdapters returns the adapters in the order they should be searched for a segment.
+// The adapters holding the second copy of a migrated project are searched last, so the
+// segments are read and updated in the adapter serving the project.
+func (db *DB) segmentAdapters() []Adapter {
+	ordered := make([]Adapter, 0, len(db.adapters))
+	var shadows []Adapter
+	for _, adapter := range db.adapters {
+		if db.router.isShadow(adapter.Name()) {
+			shadows = append(shadows, adapter)
+			continue
+		}
+		ordered = append(ordered, adapter)
+	}
+	return append(ordered, shadows...)
+}
+
+// routesStaleAfter returns how old the loaded routes can be, before the writes to the projects
+// are rejected. It's zero, when the routes aren't refreshed.
+func (db *DB) routesStaleAfter() time.Duration {
+	if len(db.adapters) < 2 {
+		return 0
+	}
+	return 2 * db.config.ProjectRoutesRefreshInterval
+}
+
+// RefreshProjectRoutes loads the project routes from the first adapter.
+func (db *DB) RefreshProjectRoutes(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	routes, err := db.adapters[0].listProjectRoutes(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, route := range routes {
+		if db.adapterByName(route.Adapter) == nil {
+			db.log.Error("project is routed to an unknown adapter",
+				zap.Stringer("Project ID", route.ProjectID),
+				zap.String("Adapter", route.Adapter))
+		}
+	}
+
+	db.router.set(routes, time.Now())
+	return nil
+}
+
+// refreshProjectRoutes periodically refreshes the project routes until the context is canceled.
+func (db *DB) refreshProjectRoutes(ctx context.Context) {
+	ticker := time.NewTicker(db.config.ProjectRoutesRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := db.RefreshProjectRoutes(ctx); err != nil && !errs.Is(err, context.Canceled) {
+			db.log.Warn("unable to refresh project routes", zap.Error(err))
+		}
+	}
+}
+
+// startProjectRoutes loads the project routes and starts refreshing them, when there
+// are multiple adapters.
+func (db *DB) startProjectRoutes(ctx context.Context) error {
+	if len(db.adapters) < 2 || db.config.ProjectRoutesRefreshInterval <= 0 {
+		return nil
+	}
+
+	err := db.RefreshProjectRoutes(ctx)
+	if err != nil {
+		// the table is created by the migration, which runs after opening the database.
+		if !isUndefinedTable(err) {
+			return err
+		}
+		db.log.Info("project routes table doesn't exist yet")
+	}
+
+	routesCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	db.stopRoutes = cancel
+	routesDone := make(chan struct{})
+	db.routesDone = routesDone
+	go func() {
+		defer close(routesDone)
+		db.refreshProjectRoutes(routesCtx)
+	}()
+	return nil
+}
+
+// GetProjectRoute returns the persisted route of the project.
+func (db *DB) GetProjectRoute(ctx context.Context, projectID uuid.UUID) (_ ProjectRoute, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	route, found, err := db.adapters[0].getProjectRoute(ctx, projectID)
+	if err != nil {
+		return ProjectRoute{}, Error.Wrap(err)
+	}
+	if !found {
+		return ProjectRoute{}, ErrObjectNotFound.New("project %s has no route", projectID)
+	}
+	return route, nil
+}
+
+// ListProjectRoutes returns all the persisted project routes.
+func (db *DB) ListProjectRoutes(ctx context.Context) (_ []ProjectRoute, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	routes, err := db.adapters[0].listProjectRoutes(ctx)
+	return routes, Error.Wrap(err)
+}
+
+func isUndefinedTable(err error) bool {
+	if pgerrcode.IsUndefinedTable(err) {
+		return true
+	}
+	code := spanner.ErrCode(err)
+	return (code == codes.NotFound || code == codes.InvalidArgument) && strings.Contains(err.Error(), "Table not found")
+}
+
+// projectRoutesAdapter contains the queries for the project_adapters table.
+type projectRoutesAdapter interface {
+	listProjectRoutes(ctx context.Context) ([]ProjectRoute, error)
+	getProjectRoute(ctx context.Context, projectID uuid.UUID) (_ ProjectRoute, found bool, err error)
+	insertProjectRoute(ctx context.Context, route ProjectRoute) (inserted bool, err error)
+	updateProjectRoute(ctx context.Context, route ProjectRoute, expected ProjectMigrationState) (updated bool, err error)
+}
+
+const projectRouteColumns = `
+	project_id, adapter,
+	migration_adapter, migration_state, migration_pass,
+	cursor_bucket_name, cursor_object_key, cursor_version,
+	changed_objects, total_objects, total_segments,
+	migration_error, updated_at
+`
+
+// listProjectRoutes implements Adapter.
+func (p *PostgresAdapter) listProjectRoutes(ctx context.Context) (_ []ProjectRoute, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := p.db.QueryContext(ctx, `SELECT `+projectRouteColumns+` FROM project_adapters`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var routes []ProjectRoute
+	for rows.Next() {
+		var route ProjectRoute
+		if err := rows.Scan(postgresProjectRouteScan(&route)...); err != nil {
+			return nil, err
+		}
+		routes = append(routes, route)
+	}
+	return routes, rows.Err()
+}
+
+// getProjectRoute implements Adapter.
+func (p *PostgresAdapter) getProjectRoute(ctx context.Context, projectID uuid.UUID) (_ ProjectRoute, found bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var route ProjectRoute
+	err = p.db.QueryRowContext(ctx, `
+		SELECT `+projectRouteColumns+`
+		FROM project_adapters
+		WHERE project_id = $1
+	`, projectID).Scan(postgresProjectRouteScan(&route)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ProjectRoute{}, false, nil
+	}
+	if err != nil {
+		return ProjectRoute{}, false, err
+	}
+	return route, true, nil
+}
+
+// insertProjectRoute implements Adapter.
+func (p *PostgresAdapter) insertProjectRoute(ctx context.Context, route ProjectRoute) (inserted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := p.db.ExecContext(ctx, `
+		INSERT INTO project_adapters (`+projectRouteColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, now())
+		ON CONFLICT (project_id) DO NOTHING
+	`, route.ProjectID, route.Adapter,
+		route.MigrationAdapter, string(route.MigrationState), route.Pass,
+		route.Cursor.BucketName, route.Cursor.ObjectKey, route.Cursor.Version,
+		route.ChangedObjects, route.TotalObjects, route.TotalSegments,
+		route.Error,
+	)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+// updateProjectRoute implements Adapter.
+func (p *PostgresAdapter) updateProjectRoute(ctx context.Context, route ProjectRoute, expected ProjectMigrationState) (updated bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := p.db.ExecContext(ctx, `
+		UPDATE project_adapters SET
+			adapter = $2,
+			migration_adapter = $3, migration_state = $4, migration_pass = $5,
+			cursor_bucket_name = $6, cursor_object_key = $7, cursor_version = $8,
+			changed_objects = $9, total_objects = $10, total_segments = $11,
+			migration_error = $12, updated_at = now()
+		WHERE project_id = $1 AND migration_state = $13
+	`, route.ProjectID, route.Adapter,
+		route.MigrationAdapter, string(route.MigrationState), route.Pass,
+		route.Cursor.BucketName, route.Cursor.ObjectKey, route.Cursor.Version,
+		route.ChangedObjects, route.TotalObjects, route.TotalSegments,
+		route.Error, string(expected),
+	)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+func postgresProjectRouteScan(route *ProjectRoute) []any {
+	return []any{
+		&route.ProjectID, &route.Adapter,
+		&route.MigrationAdapter, (*string)(&route.MigrationState), &route.Pass,
+		&route.Cursor.BucketName, &route.Cursor.ObjectKey, &route.Cursor.Version,
+		&route.ChangedObjects, &route.TotalObjects, &route.TotalSegments,
+		&route.Error, &route.UpdatedAt,
+	}
+}
+
+// listProjectRoutes implements Adapter.
+func (s *SpannerAdapter) listProjectRoutes(ctx context.Context) (_ []ProjectRoute, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return spannerutil.CollectRows(
+		s.client.Single().QueryWithOptions(ctx, spanner.Statement{
+			SQL: `SELECT ` + projectRouteColumns + ` FROM project_adapters`,
+		}, spanner.QueryOptions{RequestTag: "list-project-routes"}),
+		spannerProjectRouteScan)
+}
+
+// getProjectRoute implements Adapter.
+func (s *SpannerAdapter) getProjectRoute(ctx context.Context, projectID uuid.UUID) (_ ProjectRoute, found bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	routes, err := spannerutil.CollectRows(
+		s.client.Single().QueryWithOptions(ctx, spanner.Statement{
+			SQL: `SELECT ` + projectRouteColumns + ` FROM project_adapters WHERE project_id = @project_id`,
+			Params: map[string]any{
+				"project_id": projectID,
+			},
+		}, spanner.QueryOptions{RequestTag: "get-project-route"}),
+		spannerProjectRouteScan)
+	if err != nil || len(routes) == 0 {
+		return ProjectRoute{}, false, err
+	}
+	return routes[0], true, nil
+}
+
+// insertProjectRoute implements Adapter.
+func (s *SpannerAdapter) insertProjectRoute(ctx context.Context, route ProjectRoute) (inserted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = s.client.ReadWriteTransactionWithOptions(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+		count, err := tx.UpdateWithOptions(ctx, spanner.Statement{
+			SQL: `
+				INSERT INTO project_adapters (` + projectRouteColumns + `)
+				SELECT
+					@project_id, @adapter,
+					@migration_adapter, @migration_state, @migration_pass,
+					@cursor_bucket_name, @cursor_object_key, @cursor_version,
+					@changed_objects, @total_objects, @total_segments,
+					@migration_error, CURRENT_TIMESTAMP()
+				FROM (SELECT 1) AS x
+				WHERE NOT EXISTS (SELECT 1 FROM project_adapters WHERE project_id = @project_id)
+			`,
+			Params: spannerProjectRouteParams(route),
+		}, spanner.QueryOptions{RequestTag: "insert-project-route"})
+		inserted = count > 0
+		return err
+	}, spanner.TransactionOptions{
+		TransactionTag:              "insert-project-route",
+		ExcludeTxnFromChangeStreams: true,
+	})
+	return inserted, err
+}
+
+// updateProjectRoute implements Adapter.
+func (s *SpannerAdapter) updateProjectRoute(ctx context.Context, route ProjectRoute, expected ProjectMigrationState) (updated bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	params := spannerProjectRouteParams(route)
+	params["expected_state"] = string(expected)
+
+	_, err = s.client.ReadWriteTransactionWithOptions(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+		count, err := tx.UpdateWithOptions(ctx, spanner.Statement{
+			SQL: `
+				UPDATE project_adapters SET
+					adapter = @adapter,
+					migration_adapter = @migration_adapter, migration_state = @migration_state, migration_pass = @migration_pass,
+					cursor_bucket_name = @cursor_bucket_name, cursor_object_key = @cursor_object_key, cursor_version = @cursor_version,
+					changed_objects = @changed_objects, total_objects = @total_objects, total_segments = @total_segments,
+					migration_error = @migration_error, updated_at = CURRENT_TIMESTAMP()
+				WHERE project_id = @project_id AND migration_state = @expected_state
+			`,
+			Params: params,
+		}, spanner.QueryOptions{RequestTag: "update-project-route"})
+		updated = count > 0
+		return err
+	}, spanner.TransactionOptions{
+		TransactionTag:              "update-project-route",
+		ExcludeTxnFromChangeStreams: true,
+	})
+	return updated, err
+}
+
+func spannerProjectRouteParams(route ProjectRoute) map[string]any {
+	return map[string]any{
+		"project_id":         route.ProjectID,
+		"adapter":            route.Adapter,
+		"migration_adapter":  route.MigrationAdapter,
+		"migration_state":    string(route.MigrationState),
+		"migration_pass":     route.Pass,
+		"cursor_bucket_name": route.Cursor.BucketName,
+		"cursor_object_key":  route.Cursor.ObjectKey,
+		"cursor_version":     route.Cursor.Version,
+		"changed_objects":    route.ChangedObjects,
+		"total_objects":      route.TotalObjects,
+		"total_segments":     route.TotalSegments,
+		"migration_error":    route.Error,
+	}
+}
+
+func spannerProjectRouteScan(row *spanner.Row, route *ProjectRoute) error {
+	var state string
+	err := row.Columns(
+		&route.ProjectID, &route.Adapter,
+		&route.MigrationAdapter, &state, &route.Pass,
+		&route.Cursor.BucketName, &route.Cursor.ObjectKey, &route.Cursor.Version,
+		&route.ChangedObjects, &route.TotalObjects, &route.TotalSegments,
+		&route.Error, &route.UpdatedAt,
+	)
+	route.MigrationState = ProjectMigrationState(state)
+	return Error.Wrap(err)
+}
+
+// frozenAdapter rejects the writes to a project, which is being migrated. The reads are
+// passed through to the adapter.
+type frozenAdapter struct {
+	Adapter
+}
+
+func (a *frozenAdapter) err() error {
+	return ErrProjectMigrating.New("try again later")
+}
+
+// BeginObjectExactVersion implements Adapter.
+func (a *frozenAdapter) BeginObjectExactVersion(ctx context.Context, opts BeginObjectExactVersion) (Object, error) {
+	return Object{}, a.err()
+}
+
+// BeginObjectNextVersion implements Adapter.
+func (a *frozenAdapter) BeginObjectNextVersion(ctx context.Context, opts BeginObjectNextVersion) (Object, error) {
+	return Object{}, a.err()
+}
+
+// CommitObject implements Adapter.
+func (a *frozenAdapter) CommitObject(ctx context.Context, opts CommitObject) (Object, error) {
+	return Object{}, a.err()
+}
+
+// CommitInlineObject implements Adapter.
+func (a *frozenAdapter) CommitInlineObject(ctx context.Context, opts CommitInlineObject) (Object, error) {
+	return Object{}, a.err()
+}
+
+// CommitPendingObjectSegment implements Adapter.
+func (a *frozenAdapter) CommitPendingObjectSegment(ctx context.Context, opts CommitSegment, aliasPieces AliasPieces) error {
+	return a.err()
+}
+
+// CommitInlineSegment implements Adapter.
+func (a *frozenAdapter) CommitInlineSegment(ctx context.Context, opts CommitInlineSegment) error {
+	return a.err()
+}
+
+// SetObjectExactVersionRetention implements Adapter.
+func (a *frozenAdapter) SetObjectExactVersionRetention(ctx context.Context, opts SetObjectExactVersionRetention) error {
+	return a.err()
+}
+
+// SetObjectLastCommittedRetention implements Adapter.
+func (a *frozenAdapter) SetObjectLastCommittedRetention(ctx context.Context, opts SetObjectLastCommittedRetention) error {
+	return a.err()
+}
+
+// SetObjectExactVersionLegalHold implements Adapter.
+func (a *frozenAdapter) SetObjectExactVersionLegalHold(ctx context.Context, opts SetObjectExactVersionLegalHold) error {
+	return a.err()
+}
+
+// SetObjectLastCommittedLegalHold implements Adapter.
+func (a *frozenAdapter) SetObjectLastCommittedLegalHold(ctx context.Context, opts SetObjectLastCommittedLegalHold) error {
+	return a.err()
+}
+
+// WithTx implements Adapter.
+func (a *frozenAdapter) WithTx(ctx context.Context, opts TransactionOptions, f func(context.Context, TransactionAdapter) error) error {
+	return a.err()
+}
+
+// UpdateObjectLastCommittedMetadata implements Adapter.
+func (a *frozenAdapter) UpdateObjectLastCommittedMetadata(ctx context.Context, opts UpdateObjectLastCommittedMetadata) (int64, error) {
+	return 0, a.err()
+}
+
+// DeleteObjectExactVersion implements Adapter.
+func (a *frozenAdapter) DeleteObjectExactVersion(ctx context.Context, opts DeleteObjectExactVersion) (DeleteObjectResult, error) {
+	return DeleteObjectResult{}, a.err()
+}
+
+// DeletePendingObject implements Adapter.
+func (a *frozenAdapter) DeletePendingObject(ctx context.Context, opts DeletePendingObject) (DeleteObjectResult, error) {
+	return DeleteObjectResult{}, a.err()
+}
+
+// DeleteObjectLastCommittedPlain implements Adapter.
+func (a *frozenAdapter) DeleteObjectLastCommittedPlain(ctx context.Context, opts DeleteObjectLastCommitted) (DeleteObjectResult, error) {
+	return DeleteObjectResult{}, a.err()
+}
+
+// DeleteObjectLastCommittedVersioned implements Adapter.
+func (a *frozenAdapter) DeleteObjectLastCommittedVersioned(ctx context.Context, opts DeleteObjectLastCommitted, deleterMarkerStreamID uuid.UUID) (DeleteObjectResult, error) {
+	return DeleteObjectResult{}, a.err()
+}
+
+// DeleteAllBucketObjects implements Adapter.
+func (a *frozenAdapter) DeleteAllBucketObjects(ctx context.Context, opts DeleteAllBucketObjects) (int64, int64, error) {
+	return 0, 0, a.err()
+}
+
+// UncoordinatedDeleteAllBucketObjects implements Adapter.
+func (a *frozenAdapter) UncoordinatedDeleteAllBucketObjects(ctx context.Context, opts UncoordinatedDeleteAllBucketObjects) (int64, int64, error) {
+	return 0, 0, a.err()
+}
+
+func (a *frozenAdapter) finalizeSegmentsCopy(ctx context.Context, opts FinishCopyObject, newSegments transposedSegmentList) error {
+	return a.err()
+}
+
+func (a *frozenAdapter) insertPendingCopyObject(ctx context.Context, opts FinishCopyObject, sourceObject Object, encryptedUserData EncryptedUserData) (Object, error) {
+	return Object{}, a.err()
+}
+
+func (a *frozenAdapter) deleteObjectExactVersion(ctx context.Context, opts DeleteObjectExactVersion) (DeleteObjectResult, error) {
+	return DeleteObjectResult{}, a.err()
+}
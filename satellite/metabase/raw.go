@@ -23,6 +23,7 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/shared/dbutil/pgxutil"
 	"storj.io/storj/shared/dbutil/spannerutil"
+	"storj.io/storj/shared/dbutil/txutil"
 	"storj.io/storj/shared/tagsql"
 )
 
@@ -141,6 +142,7 @@ func (db *DB) TestingDeleteAll(ctx context.Context) (err error) {
 			return err
 		}
 	}
+	db.router.set(nil, time.Now())
 	return nil
 }
 
@@ -150,17 +152,31 @@ func (p *PostgresAdapter) TestingDeleteAll(ctx context.Context) (err error) {
 		WITH ignore_full_scan_for_test AS (SELECT 1) DELETE FROM objects;
 		WITH ignore_full_scan_for_test AS (SELECT 1) DELETE FROM segments;
 		WITH ignore_full_scan_for_test AS (SELECT 1) DELETE FROM node_aliases;
+		WITH ignore_full_scan_for_test AS (SELECT 1) DELETE FROM project_adapters;
 		WITH ignore_full_scan_for_test AS (SELECT 1) SELECT setval('node_alias_seq', 1, false);
 	`)
 	return Error.Wrap(err)
 }
 
+// TestingDeleteAll implements Adapter.
+func (s *SQLiteAdapter) TestingDeleteAll(ctx context.Context) (err error) {
+	_, err = s.db.ExecContext(ctx, `
+		DELETE FROM objects;
+		DELETE FROM segments;
+		DELETE FROM node_aliases;
+		DELETE FROM project_adapters;
+		DELETE FROM sqlite_sequence WHERE name = 'node_aliases';
+	`)
+	return Error.Wrap(err)
+}
+
 // TestingDeleteAll implements Adapter.
 func (s *SpannerAdapter) TestingDeleteAll(ctx context.Context) (err error) {
 	_, err = s.client.Apply(ctx, []*spanner.Mutation{
 		spanner.Delete("objects", spanner.AllKeys()),
 		spanner.Delete("segments", spanner.AllKeys()),
 		spanner.Delete("node_aliases", spanner.AllKeys()),
+		spanner.Delete("project_adapters", spanner.AllKeys()),
 	})
 	return Error.Wrap(err)
 }
@@ -344,6 +360,14 @@ func (p *PostgresAdapter) TestingBatchInsertObjects(ctx context.Context, objects
 		}))
 }
 
+// TestingBatchInsertObjects batch inserts objects for testing.
+func (s *SQLiteAdapter) TestingBatchInsertObjects(ctx context.Context, objects []RawObject) (err error) {
+	return Error.Wrap(txutil.WithTx(ctx, s.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		source := newCopyFromRawObjects(objects)
+		return sqliteCopyFrom(ctx, tx, "objects", source, source.Columns())
+	}))
+}
+
 // TestingBatchInsertObjects batch inserts objects for testing.
 func (s *SpannerAdapter) TestingBatchInsertObjects(ctx context.Context, objects []RawObject) (err error) {
 	const maxRowsPerBatch = 250000
@@ -611,6 +635,22 @@ func (p *PostgresAdapter) TestingBatchInsertSegments(ctx context.Context, aliasC
 		}))
 }
 
+// TestingBatchInsertSegments implements Adapter.
+func (s *SQLiteAdapter) TestingBatchInsertSegments(ctx context.Context, aliasCache *NodeAliasCache, segments []RawSegment) (err error) {
+	aliases := make([]AliasPieces, len(segments))
+	for i, segment := range segments {
+		aliases[i], err = aliasCache.EnsurePiecesToAliases(ctx, segment.Pieces)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	return Error.Wrap(txutil.WithTx(ctx, s.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		source := newCopyFromRawSegments(segments, aliases)
+		return sqliteCopyFrom(ctx, tx, "segments", source, source.Columns())
+	}))
+}
+
 var rawSegmentColumns = []string{
 	"stream_id",
 	"position",
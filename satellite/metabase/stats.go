@@ -92,6 +92,15 @@ func (c *CockroachAdapter) GetTableStats(ctx context.Context, opts GetTableStats
 	return result, nil
 }
 
+// GetTableStats implements Adapter.
+func (s *SQLiteAdapter) GetTableStats(ctx context.Context, opts GetTableStats) (result TableStats, err error) {
+	err = s.db.QueryRowContext(ctx, `SELECT count(1) FROM segments`).Scan(&result.SegmentCount)
+	if err != nil {
+		return TableStats{}, err
+	}
+	return result, nil
+}
+
 // GetTableStats (will) implement Adapter.
 func (s *SpannerAdapter) GetTableStats(ctx context.Context, opts GetTableStats) (result TableStats, err error) {
 	// TODO:spanner gather a total number of bytes stored instead of rows
@@ -139,6 +148,12 @@ func (c *CockroachAdapter) UpdateTableStats(ctx context.Context) error {
 	return Error.Wrap(err)
 }
 
+// UpdateTableStats forces an update of table statistics. Probably useful mostly in test scenarios.
+func (s *SQLiteAdapter) UpdateTableStats(ctx context.Context) error {
+	_, err := s.db.ExecContext(ctx, "ANALYZE segments")
+	return Error.Wrap(err)
+}
+
 // UpdateTableStats forces an update of table statistics. Probably useful mostly in test scenarios.
 func (s *SpannerAdapter) UpdateTableStats(ctx context.Context) error {
 	return nil
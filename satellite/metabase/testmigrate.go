@@ -169,7 +169,38 @@ func (p *PostgresAdapter) testMigrateToLatest(ctx context.Context) error {
 					COMMENT ON COLUMN node_aliases.node_id    is 'node_id refers to the storj.NodeID';
 					COMMENT ON COLUMN node_aliases.node_alias is 'node_alias is a unique integer value assigned for the node_id. It is used for compressing segments.remote_alias_pieces.';
 
-					CREATE INDEX IF NOT EXISTS node_aliases_node_alias_order ON node_aliases(node_alias DESC);`,
+					CREATE INDEX IF NOT EXISTS node_aliases_node_alias_order ON node_aliases(node_alias DESC);
+
+					CREATE TABLE project_adapters (
+						project_id         BYTEA NOT NULL PRIMARY KEY,
+						adapter            TEXT  NOT NULL,
+						migration_adapter  TEXT  NOT NULL DEFAULT '',
+						migration_state    TEXT  NOT NULL DEFAULT '',
+						migration_pass     INT8  NOT NULL DEFAULT 0,
+						cursor_bucket_name BYTEA NOT NULL DEFAULT '',
+						cursor_object_key  BYTEA NOT NULL DEFAULT '',
+						cursor_version     INT8  NOT NULL DEFAULT 0,
+						changed_objects    INT8  NOT NULL DEFAULT 0,
+						total_objects      INT8  NOT NULL DEFAULT 0,
+						total_segments     INT8  NOT NULL DEFAULT 0,
+						migration_error    TEXT  NOT NULL DEFAULT '',
+						updated_at         TIMESTAMPTZ NOT NULL DEFAULT now()
+					);
+
+					COMMENT ON TABLE  project_adapters is 'project_adapters routes projects to metabase adapters and tracks the migrations of projects between adapters.';
+					COMMENT ON COLUMN project_adapters.project_id is 'project_id is a uuid referring to console.projects.id.';
+					COMMENT ON COLUMN project_adapters.adapter is 'adapter is the name of the adapter serving the project.';
+					COMMENT ON COLUMN project_adapters.migration_adapter is 'migration_adapter is the name of the adapter holding the second copy of the project during a migration.';
+					COMMENT ON COLUMN project_adapters.migration_state is 'migration_state is the state of the migration, empty when the project is not migrated.';
+					COMMENT ON COLUMN project_adapters.migration_pass is 'migration_pass is the number of the current copy pass.';
+					COMMENT ON COLUMN project_adapters.cursor_bucket_name is 'cursor_bucket_name is the bucket of the last object processed in the current pass.';
+					COMMENT ON COLUMN project_adapters.cursor_object_key is 'cursor_object_key is the key of the last object processed in the current pass.';
+					COMMENT ON COLUMN project_adapters.cursor_version is 'cursor_version is the version of the last object processed in the current pass.';
+					COMMENT ON COLUMN project_adapters.changed_objects is 'changed_objects is the number of objects changed in the current pass.';
+					COMMENT ON COLUMN project_adapters.total_objects is 'total_objects is the number of objects seen in the current pass.';
+					COMMENT ON COLUMN project_adapters.total_segments is 'total_segments is the number of segments seen in the current pass.';
+					COMMENT ON COLUMN project_adapters.migration_error is 'migration_error is the reason why the last verification failed.';
+					COMMENT ON COLUMN project_adapters.updated_at is 'updated_at is the time of the last change of the row.';`,
 				},
 			},
 		},
@@ -206,6 +237,25 @@ func (c *CockroachAdapter) TestMigrateToLatest(ctx context.Context) error {
 	return c.PostgresAdapter.testMigrateToLatest(ctx)
 }
 
+// TestMigrateToLatest creates a database and applies all the migration for test purposes.
+func (s *SQLiteAdapter) TestMigrateToLatest(ctx context.Context) error {
+	migration := s.SQLiteMigration()
+
+	if s.config.TestingUniqueUnversioned {
+		// This is only part of testing, because we do not want to affect the production performance.
+		migration.Steps = append(migration.Steps, &migrate.Step{
+			DB:          migration.Steps[0].DB,
+			Description: "Constraint for ensuring our metabase correctness.",
+			Version:     2,
+			Action: migrate.SQL{
+				`CREATE UNIQUE INDEX objects_one_unversioned_per_location ON objects (project_id, bucket_name, object_key) WHERE status IN ` + statusesUnversioned + `;`,
+			},
+		})
+	}
+
+	return migration.Run(ctx, s.log.Named("migrate"))
+}
+
 // TestMigrateToLatest creates a database and applies all the migration for test purposes.
 func (s *SpannerAdapter) TestMigrateToLatest(ctx context.Context) error {
 	var statements []string
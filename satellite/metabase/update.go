@@ -17,6 +17,8 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/shared/dbutil/spannerutil"
+	"storj.io/storj/shared/dbutil/txutil"
+	"storj.io/storj/shared/tagsql"
 )
 
 const (
@@ -105,7 +107,7 @@ func (db *DB) UpdateSegmentPieces(ctx context.Context, opts UpdateSegmentPieces)
 	}
 
 	var resultPieces AliasPieces
-	for _, adapter := range db.adapters {
+	for _, adapter := range db.segmentAdapters() {
 		if opts.DBAdapterName == "" || opts.DBAdapterName == adapter.Name() {
 			resultPieces, err = adapter.UpdateSegmentPieces(ctx, opts, oldPieces, newPieces)
 			if err != nil {
@@ -368,6 +370,13 @@ func (s *SpannerAdapter) SetObjectExactVersionLegalHold(ctx context.Context, opt
 	return nil
 }
 
+// SetObjectExactVersionLegalHold sets the legal hold configuration of an exact version of an object.
+func (s *SQLiteAdapter) SetObjectExactVersionLegalHold(ctx context.Context, opts SetObjectExactVersionLegalHold) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.setObjectLegalHold(ctx, opts.ObjectLocation, &opts.Version, opts.Enabled)
+}
+
 // SetObjectLastCommittedLegalHold contains arguments necessary for setting
 // the legal hold configuration of the most recently committed version of an object.
 type SetObjectLastCommittedLegalHold struct {
@@ -553,6 +562,55 @@ func (s *SpannerAdapter) setObjectExactVersionLegalHold(ctx context.Context, tx
 	return nil
 }
 
+// SetObjectLastCommittedLegalHold sets the legal hold configuration
+// of the most recently committed version of an object.
+func (s *SQLiteAdapter) SetObjectLastCommittedLegalHold(ctx context.Context, opts SetObjectLastCommittedLegalHold) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.setObjectLegalHold(ctx, opts.ObjectLocation, nil, opts.Enabled)
+}
+
+// setObjectLegalHold sets the legal hold configuration of the specified version
+// or the most recently committed version of an object, when version is nil.
+func (s *SQLiteAdapter) setObjectLegalHold(ctx context.Context, location ObjectLocation, version *Version, enabled bool) (err error) {
+	err = txutil.WithTx(ctx, s.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		objectVersion, info, err := sqlitePreUpdateRetentionInfo(ctx, tx, location, version)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case info.Status.IsDeleteMarker():
+			return ErrObjectStatus.New(noLockOnDeleteMarkerErrMsg)
+		case !info.Status.IsCommitted():
+			return ErrObjectStatus.New(noLockOnUncommittedErrMsg)
+		case info.ExpiresAt != nil:
+			return ErrObjectExpiration.New(noLockWithExpirationErrMsg)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE objects
+			SET
+				retention_mode = CASE
+					WHEN $5 THEN COALESCE(retention_mode, 0) | `+retentionModeLegalHold+` -- Enable legal hold
+					ELSE retention_mode & ~`+retentionModeLegalHold+` -- Disable legal hold
+				END
+			WHERE (project_id, bucket_name, object_key, version) = ($1, $2, $3, $4)
+		`, location.ProjectID, location.BucketName, location.ObjectKey, objectVersion, enabled)
+		if err != nil {
+			return errs.New("unable to update object legal hold configuration: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		if ErrObjectNotFound.Has(err) || ErrObjectExpiration.Has(err) || ErrObjectStatus.Has(err) {
+			return errs.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
 // SetObjectExactVersionRetention contains arguments necessary for setting
 // the retention configuration of an exact version of an object.
 type SetObjectExactVersionRetention struct {
@@ -755,6 +813,13 @@ func (s *SpannerAdapter) setObjectExactVersionRetention(ctx context.Context, tx
 	return nil
 }
 
+// SetObjectExactVersionRetention sets the retention configuration of an exact version of an object.
+func (s *SQLiteAdapter) SetObjectExactVersionRetention(ctx context.Context, opts SetObjectExactVersionRetention) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.setObjectRetention(ctx, opts.ObjectLocation, &opts.Version, opts.Retention, opts.BypassGovernance)
+}
+
 // SetObjectLastCommittedRetention contains arguments necessary for setting
 // the retention configuration of the most recently committed version of an object.
 type SetObjectLastCommittedRetention struct {
@@ -942,6 +1007,94 @@ func (s *SpannerAdapter) SetObjectLastCommittedRetention(ctx context.Context, op
 
 // preUpdateRetentionInfo contains information about an object that is collected
 // before the object's retention configuration is updated.
+// SetObjectLastCommittedRetention sets the retention configuration
+// of the most recently committed version of an object.
+func (s *SQLiteAdapter) SetObjectLastCommittedRetention(ctx context.Context, opts SetObjectLastCommittedRetention) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.setObjectRetention(ctx, opts.ObjectLocation, nil, opts.Retention, opts.BypassGovernance)
+}
+
+// setObjectRetention sets the retention configuration of the specified version
+// or the most recently committed version of an object, when version is nil.
+func (s *SQLiteAdapter) setObjectRetention(ctx context.Context, location ObjectLocation, version *Version, retention Retention, bypassGovernance bool) (err error) {
+	now := time.Now()
+
+	err = txutil.WithTx(ctx, s.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		objectVersion, info, err := sqlitePreUpdateRetentionInfo(ctx, tx, location, version)
+		if err != nil {
+			return err
+		}
+
+		if err = info.verify(retention, bypassGovernance, now); err != nil {
+			return errs.Wrap(err)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE objects
+			SET
+				retention_mode = CASE
+					WHEN $5 != `+retentionModeNone+` THEN (COALESCE(retention_mode, `+retentionModeNone+`) & ~`+retentionModeComplianceAndGovernanceMask+`) | $5
+					ELSE retention_mode & ~`+retentionModeComplianceAndGovernanceMask+`
+				END,
+				retain_until   = $6
+			WHERE (project_id, bucket_name, object_key, version) = ($1, $2, $3, $4)
+		`, location.ProjectID, location.BucketName, location.ObjectKey, objectVersion,
+			lockModeWrapper{retentionMode: &retention.Mode},
+			timeWrapper{&retention.RetainUntil},
+		)
+		if err != nil {
+			return errs.New("unable to update object retention configuration: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		if ErrObjectNotFound.Has(err) || ErrObjectExpiration.Has(err) || ErrObjectLock.Has(err) || ErrObjectStatus.Has(err) {
+			return errs.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
+// sqlitePreUpdateRetentionInfo queries the Object Lock related information of the
+// specified version or the most recently committed version of an object, when version is nil.
+func sqlitePreUpdateRetentionInfo(ctx context.Context, tx tagsql.Tx, location ObjectLocation, version *Version) (objectVersion Version, info preUpdateRetentionInfo, err error) {
+	query := `
+		SELECT version, status, expires_at, retention_mode, retain_until
+		FROM objects
+		WHERE
+			(project_id, bucket_name, object_key) = ($1, $2, $3)
+			AND status <> ` + statusPending + `
+		ORDER BY version DESC
+		LIMIT 1
+	`
+	args := []any{location.ProjectID, location.BucketName, location.ObjectKey}
+	if version != nil {
+		query = `
+			SELECT version, status, expires_at, retention_mode, retain_until
+			FROM objects
+			WHERE (project_id, bucket_name, object_key, version) = ($1, $2, $3, $4)
+		`
+		args = append(args, *version)
+	}
+
+	err = tx.QueryRowContext(ctx, query, args...).Scan(
+		&objectVersion,
+		&info.Status,
+		&info.ExpiresAt,
+		lockModeWrapper{retentionMode: &info.Retention.Mode},
+		timeWrapper{&info.Retention.RetainUntil},
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, preUpdateRetentionInfo{}, ErrObjectNotFound.New("")
+		}
+		return 0, preUpdateRetentionInfo{}, errs.New("unable to query object info before setting Object Lock configuration: %w", err)
+	}
+	return objectVersion, info, nil
+}
+
 type preUpdateRetentionInfo struct {
 	Status    ObjectStatus
 	ExpiresAt *time.Time
@@ -171,6 +171,13 @@ type DownloadLimiterConfig struct {
 	SizeExponent      int           `help:"two to this power is the amount of rate limits to store in ram. higher has less collisions." releaseDefault:"21" devDefault:"17" testDefault:"16"`
 }
 
+// BucketLimitsConfig is a configuration struct for the storage, bandwidth and segment limits of buckets.
+type BucketLimitsConfig struct {
+	Enabled         bool          `help:"whether the storage, bandwidth and segment limits of buckets are enforced" default:"false"`
+	CacheExpiration time.Duration `help:"bucket limits cache expiration" default:"5m"`
+	CacheCapacity   int           `help:"bucket limits cache capacity" default:"10000"`
+}
+
 // ProjectLimitConfig is a configuration struct for default project limits.
 type ProjectLimitConfig struct {
 	MaxBuckets int `help:"max bucket count for a project." default:"100" testDefault:"10"`
@@ -223,6 +230,7 @@ type Config struct {
 	UploadLimiter                UploadLimiterConfig   `help:"object upload limiter configuration"`
 	DownloadLimiter              DownloadLimiterConfig `help:"object download limiter configuration"`
 	ProjectLimits                ProjectLimitConfig    `help:"project limit configuration"`
+	BucketLimits                 BucketLimitsConfig    `help:"bucket limits configuration"`
 	SuccessTrackerKind           string                `default:"percent" help:"success tracker kind, bitshift or percent"`
 	SuccessTrackerTickDuration   time.Duration         `default:"10m" help:"how often to bump the generation in the node success tracker"`
 	FailureTrackerTickDuration   time.Duration         `default:"5s" help:"how often to bump the generation in the node failure tracker"`
@@ -284,6 +292,8 @@ type Config struct {
 	MetabaseCompression       string `help:"Compression type to be used in spanner client for gRPC calls, disabled by default (gzip)" default:"" devDefault:"gzip"`
 	SpannerGRPCConnectionPool int    `help:"Number of gRPC connections to Spanner. Each connection supports ~100 concurrent streams. 0 means use default (4)." default:"0" hidden:"true"`
 
+	ProjectRoutesRefreshInterval time.Duration `help:"how often to reload the routing of projects to metabase adapters, 0 to disable, used only with multiple metabase adapters" default:"30s"`
+
 	CreateRemainderChargeOnObjectDelete bool `help:"whether to create a remainder charge when an object is deleted before minimum retention" default:"false"`
 }
 
@@ -298,6 +308,8 @@ func (c Config) Metabase(applicationName string) metabase.Config {
 		TestingTimestampVersioning: c.TestingTimestampVersioning,
 		SpannerGRPCConnectionPool:  c.SpannerGRPCConnectionPool,
 		Compression:                c.MetabaseCompression,
+
+		ProjectRoutesRefreshInterval: c.ProjectRoutesRefreshInterval,
 	}
 }
 
@@ -88,6 +88,7 @@ type Endpoint struct {
 	projectUsage                   *accounting.Service
 	projects                       console.Projects
 	projectMembers                 console.ProjectMembers
+	projectRoles                   console.ProjectRoles
 	users                          console.Users
 	apiKeys                        APIKeys
 	apiKeyTails                    console.APIKeyTails
@@ -96,6 +97,7 @@ type Endpoint struct {
 	singleObjectUploadLimitCache   *bloomrate.BloomRate
 	singleObjectDownloadLimitCache *bloomrate.BloomRate
 	userInfoCache                  *lrucache.ExpiringLRUOf[*console.UserInfo]
+	bucketLimitsCache              *lrucache.ExpiringLRUOf[buckets.Limits]
 	encInlineSegmentSize           int64 // max inline segment size + encryption overhead
 	revocations                    revocation.DB
 	config                         Config
@@ -125,7 +127,7 @@ func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase
 	remainderChargeRecorder *accounting.RemainderChargeRecorder,
 	orders *orders.Service, cache *overlay.Service, attributions attribution.DB, peerIdentities overlay.PeerIdentities,
 	apiKeys APIKeys, apiKeyTails console.APIKeyTails, projectUsage *accounting.Service, projects console.Projects,
-	projectMembers console.ProjectMembers, users console.Users, satellite signing.Signer, revocations revocation.DB,
+	projectMembers console.ProjectMembers, projectRoles console.ProjectRoles, users console.Users, satellite signing.Signer, revocations revocation.DB,
 	successTrackers *SuccessTrackers, failureTracker SuccessTracker, trustedUplinks *trust.TrustedPeersList, config Config,
 	migrationModeFlag *MigrationModeFlagExtension, placement nodeselection.PlacementDefinitions, consoleConfig consoleweb.Config,
 	ordersConfig orders.Config, nodeSelectionStats *NodeSelectionStats,
@@ -178,6 +180,7 @@ func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase
 		projectUsage:            projectUsage,
 		projects:                projects,
 		projectMembers:          projectMembers,
+		projectRoles:            projectRoles,
 		users:                   users,
 		satellite:               satellite,
 		limiterCache: lrucache.NewOf[*rate.Limiter](lrucache.Options{
@@ -199,6 +202,7 @@ func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase
 			Expiration: config.UserInfoValidation.CacheExpiration,
 			Capacity:   config.UserInfoValidation.CacheCapacity,
 		}),
+		bucketLimitsCache:         newBucketLimitsCache(config.BucketLimits),
 		encInlineSegmentSize:      encInlineSegmentSize,
 		revocations:               revocations,
 		config:                    config,
@@ -232,6 +236,14 @@ func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase
 	return e, nil
 }
 
+func newBucketLimitsCache(config BucketLimitsConfig) *lrucache.ExpiringLRUOf[buckets.Limits] {
+	return lrucache.NewOf[buckets.Limits](lrucache.Options{
+		Expiration: config.CacheExpiration,
+		Capacity:   config.CacheCapacity,
+		Name:       "metainfo-bucket-limits",
+	})
+}
+
 // TestingNewAPIKeysEndpoint returns an endpoint suitable for testing api keys behaviour.
 func TestingNewAPIKeysEndpoint(log *zap.Logger, apiKeys APIKeys) *Endpoint {
 	return &Endpoint{
@@ -270,6 +282,30 @@ func (endpoint *Endpoint) Run(ctx context.Context) error {
 	}
 }
 
+// Drain waits until the queued API key tails are written to the database. It's called
+// during shutdown, before Close drops the tails which weren't written yet.
+func (endpoint *Endpoint) Drain(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if endpoint.keyTailsHandler == nil {
+		return nil
+	}
+	combiner := endpoint.keyTailsHandler.combiner.Load()
+	if combiner == nil {
+		return nil
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- combiner.Wait(ctx) }()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // Close closes resources.
 func (endpoint *Endpoint) Close() error {
 	if endpoint.keyTailsHandler != nil {
@@ -566,6 +602,8 @@ func (endpoint *Endpoint) ConvertKnownErrWithMessage(err error, message string)
 		return rpcstatus.Error(rpcstatus.NotFound, err.Error())
 	case metabase.ErrPermissionDenied.Has(err):
 		return rpcstatus.Error(rpcstatus.PermissionDenied, err.Error())
+	case metabase.ErrProjectMigrating.Has(err):
+		return rpcstatus.Error(rpcstatus.Unavailable, err.Error())
 	case spanner.ErrCode(err) == codes.Canceled:
 		// TODO(spanner): it's far from perfect we should be handling this on lower level
 		return rpcstatus.Wrap(rpcstatus.Canceled, context.Canceled)
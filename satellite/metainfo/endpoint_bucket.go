@@ -23,6 +23,7 @@ import (
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/eventing"
+	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
 )
 
@@ -469,7 +470,17 @@ func (endpoint *Endpoint) DeleteBucket(ctx context.Context, req *pb.BucketDelete
 			return nil, rpcstatus.Error(rpcstatus.PermissionDenied, err.Error())
 		}
 
-		if member.Role != console.RoleAdmin && bucket.CreatedBy != keyInfo.CreatedBy {
+		// Deleting buckets created by other members requires the permission to manage buckets.
+		permissions := member.Role.Permissions()
+		role, err := endpoint.projectRoles.GetAssigned(ctx, keyInfo.CreatedBy, keyInfo.ProjectID)
+		switch {
+		case err == nil:
+			permissions = role.Permissions
+		case !console.ErrNoProjectRole.Has(err):
+			return nil, endpoint.ConvertKnownErrWithMessage(err, "unable to get project role")
+		}
+
+		if !permissions.Has(console.PermissionManageBuckets) && bucket.CreatedBy != keyInfo.CreatedBy {
 			return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "not enough access to delete this bucket")
 		}
 	}
@@ -1024,3 +1035,174 @@ func convertMinimalBucketToProto(bucket buckets.MinimalBucket, rs *pb.Redundancy
 		},
 	}, nil
 }
+
+// GetBucketLifecycleConfiguration retrieves the lifecycle configuration of a bucket.
+func (endpoint *Endpoint) GetBucketLifecycleConfiguration(ctx context.Context, req *internalpb.GetBucketLifecycleConfigurationRequest) (resp *internalpb.GetBucketLifecycleConfigurationResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
+		Op:     macaroon.ActionRead,
+		Bucket: req.Name,
+		Time:   time.Now(),
+	}, console.RateLimitHead)
+	if err != nil {
+		return nil, err
+	}
+	endpoint.usageTracking(keyInfo, req.Header, fmt.Sprintf("%T", req))
+
+	if err := endpoint.checkLifecycleBucket(ctx, keyInfo, req.Name); err != nil {
+		return nil, err
+	}
+
+	config, err := endpoint.buckets.GetBucketLifecycleConfig(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, endpoint.ConvertKnownErrWithMessage(err, "unable to get bucket lifecycle configuration")
+	}
+
+	return &internalpb.GetBucketLifecycleConfigurationResponse{
+		Configuration: convertLifecycleConfigurationToProto(config),
+	}, nil
+}
+
+// SetBucketLifecycleConfiguration replaces the lifecycle configuration of a bucket.
+func (endpoint *Endpoint) SetBucketLifecycleConfiguration(ctx context.Context, req *internalpb.SetBucketLifecycleConfigurationRequest) (resp *internalpb.SetBucketLifecycleConfigurationResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	now := time.Now()
+	// lifecycle rules delete objects, so the key must be allowed to delete too.
+	keyInfo, err := endpoint.ValidateAuthN(ctx, req.Header, console.RateLimitPut,
+		VerifyPermission{
+			Action: macaroon.Action{
+				Op:     macaroon.ActionWrite,
+				Bucket: req.Name,
+				Time:   now,
+			},
+		},
+		VerifyPermission{
+			Action: macaroon.Action{
+				Op:     macaroon.ActionDelete,
+				Bucket: req.Name,
+				Time:   now,
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	endpoint.usageTracking(keyInfo, req.Header, fmt.Sprintf("%T", req))
+
+	if err := endpoint.checkLifecycleBucket(ctx, keyInfo, req.Name); err != nil {
+		return nil, err
+	}
+
+	config := convertProtoToLifecycleConfiguration(req.Configuration)
+	if err := config.Validate(); err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	err = endpoint.buckets.UpdateBucketLifecycleConfig(ctx, req.Name, keyInfo.ProjectID, config)
+	if err != nil {
+		return nil, endpoint.ConvertKnownErrWithMessage(err, "unable to set bucket lifecycle configuration")
+	}
+
+	return &internalpb.SetBucketLifecycleConfigurationResponse{}, nil
+}
+
+// DeleteBucketLifecycleConfiguration removes the lifecycle configuration of a bucket.
+func (endpoint *Endpoint) DeleteBucketLifecycleConfiguration(ctx context.Context, req *internalpb.DeleteBucketLifecycleConfigurationRequest) (resp *internalpb.DeleteBucketLifecycleConfigurationResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
+
+	now := time.Now()
+	// managing lifecycle rules requires the same permissions as setting them.
+	keyInfo, err := endpoint.ValidateAuthN(ctx, req.Header, console.RateLimitDelete,
+		VerifyPermission{
+			Action: macaroon.Action{
+				Op:     macaroon.ActionWrite,
+				Bucket: req.Name,
+				Time:   now,
+			},
+		},
+		VerifyPermission{
+			Action: macaroon.Action{
+				Op:     macaroon.ActionDelete,
+				Bucket: req.Name,
+				Time:   now,
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	endpoint.usageTracking(keyInfo, req.Header, fmt.Sprintf("%T", req))
+
+	if err := endpoint.checkLifecycleBucket(ctx, keyInfo, req.Name); err != nil {
+		return nil, err
+	}
+
+	err = endpoint.buckets.DeleteBucketLifecycleConfig(ctx, req.Name, keyInfo.ProjectID)
+	if err != nil {
+		return nil, endpoint.ConvertKnownErrWithMessage(err, "unable to delete bucket lifecycle configuration")
+	}
+
+	return &internalpb.DeleteBucketLifecycleConfigurationResponse{}, nil
+}
+
+// checkLifecycleBucket checks that the bucket of a lifecycle request exists.
+func (endpoint *Endpoint) checkLifecycleBucket(ctx context.Context, keyInfo *console.APIKeyInfo, bucketName []byte) error {
+	if len(bucketName) == 0 {
+		return rpcstatus.Error(rpcstatus.BucketNameMissing, "A bucket name is required")
+	}
+
+	exists, err := endpoint.buckets.HasBucket(ctx, bucketName, keyInfo.ProjectID)
+	if err != nil {
+		return endpoint.ConvertKnownErrWithMessage(err, "unable to check if bucket exists")
+	}
+	if !exists {
+		return rpcstatus.Error(rpcstatus.NotFound, "bucket not found")
+	}
+	return nil
+}
+
+func convertLifecycleConfigurationToProto(config *buckets.LifecycleConfiguration) *internalpb.LifecycleConfiguration {
+	if config == nil {
+		return nil
+	}
+
+	pbConfig := &internalpb.LifecycleConfiguration{
+		Rules: make([]*internalpb.LifecycleRule, 0, len(config.Rules)),
+	}
+	for _, rule := range config.Rules {
+		pbConfig.Rules = append(pbConfig.Rules, &internalpb.LifecycleRule{
+			Id:                        rule.ID,
+			Enabled:                   rule.Enabled,
+			Prefix:                    rule.Prefix,
+			ExpirationDays:            int32(rule.ExpirationDays),
+			NoncurrentDays:            int32(rule.NoncurrentDays),
+			NewerNoncurrentVersions:   int32(rule.NewerNoncurrentVersions),
+			AbortIncompleteUploadDays: int32(rule.AbortIncompleteUploadDays),
+		})
+	}
+	return pbConfig
+}
+
+func convertProtoToLifecycleConfiguration(pbConfig *internalpb.LifecycleConfiguration) buckets.LifecycleConfiguration {
+	var config buckets.LifecycleConfiguration
+	for _, rule := range pbConfig.GetRules() {
+		config.Rules = append(config.Rules, buckets.LifecycleRule{
+			ID:                        rule.Id,
+			Enabled:                   rule.Enabled,
+			Prefix:                    rule.Prefix,
+			ExpirationDays:            int(rule.ExpirationDays),
+			NoncurrentDays:            int(rule.NoncurrentDays),
+			NewerNoncurrentVersions:   int(rule.NewerNoncurrentVersions),
+			AbortIncompleteUploadDays: int(rule.AbortIncompleteUploadDays),
+		})
+	}
+	return config
+}
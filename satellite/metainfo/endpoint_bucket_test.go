@@ -30,6 +30,7 @@ import (
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/entitlements"
+	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/kms"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/nodeselection"
@@ -2721,3 +2722,93 @@ func TestBucketNotificationConfiguration(t *testing.T) {
 		})
 	})
 }
+
+func TestBucketLifecycleConfiguration(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[sat.ID()]
+		bucketName := []byte("test-bucket")
+
+		require.NoError(t, planet.Uplinks[0].TestingCreateBucket(ctx, sat, string(bucketName)))
+
+		conn, err := planet.Uplinks[0].Dialer.DialNodeURL(ctx, sat.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+
+		client := internalpb.NewDRPCBucketLifecycleClient(conn)
+		header := &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()}
+
+		getResp, err := client.GetBucketLifecycleConfiguration(ctx, &internalpb.GetBucketLifecycleConfigurationRequest{
+			Header: header,
+			Name:   bucketName,
+		})
+		require.NoError(t, err)
+		require.Nil(t, getResp.Configuration)
+
+		configuration := &internalpb.LifecycleConfiguration{
+			Rules: []*internalpb.LifecycleRule{
+				{Id: "logs", Enabled: true, Prefix: []byte("logs/"), ExpirationDays: 30},
+				{Id: "uploads", Enabled: true, AbortIncompleteUploadDays: 7},
+				{Id: "versions", NoncurrentDays: 10, NewerNoncurrentVersions: 2},
+			},
+		}
+		_, err = client.SetBucketLifecycleConfiguration(ctx, &internalpb.SetBucketLifecycleConfigurationRequest{
+			Header:        header,
+			Name:          bucketName,
+			Configuration: configuration,
+		})
+		require.NoError(t, err)
+
+		getResp, err = client.GetBucketLifecycleConfiguration(ctx, &internalpb.GetBucketLifecycleConfigurationRequest{
+			Header: header,
+			Name:   bucketName,
+		})
+		require.NoError(t, err)
+		require.Equal(t, configuration.String(), getResp.Configuration.String())
+
+		t.Run("invalid configuration", func(t *testing.T) {
+			_, err := client.SetBucketLifecycleConfiguration(ctx, &internalpb.SetBucketLifecycleConfigurationRequest{
+				Header: header,
+				Name:   bucketName,
+				Configuration: &internalpb.LifecycleConfiguration{
+					Rules: []*internalpb.LifecycleRule{{Id: "empty", Enabled: true}},
+				},
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+		})
+
+		t.Run("missing bucket", func(t *testing.T) {
+			_, err := client.GetBucketLifecycleConfiguration(ctx, &internalpb.GetBucketLifecycleConfigurationRequest{
+				Header: header,
+				Name:   []byte("missing-bucket"),
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+		})
+
+		t.Run("read only key", func(t *testing.T) {
+			readOnlyKey, err := apiKey.Restrict(macaroon.Caveat{DisallowWrites: true, DisallowDeletes: true})
+			require.NoError(t, err)
+
+			_, err = client.DeleteBucketLifecycleConfiguration(ctx, &internalpb.DeleteBucketLifecycleConfigurationRequest{
+				Header: &pb.RequestHeader{ApiKey: readOnlyKey.SerializeRaw()},
+				Name:   bucketName,
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+		})
+
+		_, err = client.DeleteBucketLifecycleConfiguration(ctx, &internalpb.DeleteBucketLifecycleConfigurationRequest{
+			Header: header,
+			Name:   bucketName,
+		})
+		require.NoError(t, err)
+
+		getResp, err = client.GetBucketLifecycleConfiguration(ctx, &internalpb.GetBucketLifecycleConfigurationRequest{
+			Header: header,
+			Name:   bucketName,
+		})
+		require.NoError(t, err)
+		require.Nil(t, getResp.Configuration)
+	})
+}
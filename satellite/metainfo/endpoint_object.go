@@ -151,7 +151,7 @@ func (endpoint *Endpoint) beginObject(ctx context.Context, req *pb.ObjectBeginRe
 		return nil, err
 	}
 
-	err = endpoint.checkUploadLimits(ctx, keyInfo)
+	err = endpoint.checkUploadLimits(ctx, keyInfo, req.Bucket)
 	if err != nil {
 		return nil, err
 	}
@@ -626,7 +626,7 @@ func (endpoint *Endpoint) CommitInlineObject(ctx context.Context, beginObjectReq
 		return nil, nil, nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "key length is too big, got %v, maximum allowed is %v", objectKeyLength, endpoint.config.MaxEncryptedObjectKeyLength)
 	}
 
-	err = endpoint.checkUploadLimits(ctx, keyInfo)
+	err = endpoint.checkUploadLimits(ctx, keyInfo, beginObjectReq.Bucket)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -751,7 +751,7 @@ func (endpoint *Endpoint) CommitInlineObject(ctx context.Context, beginObjectReq
 		return nil, nil, nil, endpoint.ConvertKnownErrWithMessage(err, "unable to update PUT inline order")
 	}
 
-	endpoint.addSegmentToUploadLimits(ctx, keyInfo, inlineUsed)
+	endpoint.addSegmentToUploadLimits(ctx, keyInfo, beginObjectReq.Bucket, inlineUsed)
 
 	if endpoint.config.LimitEmailNotificationsEnabled {
 		thresholds, resets := endpoint.projectUsage.DetectStorageThresholds(ctx, keyInfo.ProjectID, inlineUsed, keyInfoToLimits(keyInfo))
@@ -1003,7 +1003,7 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 		return nil, err
 	}
 
-	if err := endpoint.checkDownloadLimits(ctx, keyInfo); err != nil {
+	if err := endpoint.checkDownloadLimits(ctx, keyInfo, req.Bucket); err != nil {
 		return nil, err
 	}
 	if endpoint.config.DownloadLimiter.Enabled {
@@ -1107,6 +1107,8 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 			)
 		}
 
+		endpoint.addBucketBandwidthUsage(ctx, keyInfo, req.Bucket, downloadSizes.encryptedSize)
+
 		encryptedKeyNonce, err := storj.NonceFromBytes(segment.EncryptedKeyNonce)
 		if err != nil {
 			return nil, endpoint.ConvertKnownErrWithMessage(err, "unable to get encryption key nonce from metadata")
@@ -2939,7 +2941,7 @@ func (endpoint *Endpoint) BeginCopyObject(ctx context.Context, req *pb.ObjectBeg
 		Version:      version,
 		SegmentLimit: endpoint.config.CopyMoveSegmentLimit,
 		VerifyLimits: func(encryptedObjectSize int64, nSegments int64) error {
-			return endpoint.checkUploadLimitsForNewObject(ctx, keyInfo, encryptedObjectSize, nSegments)
+			return endpoint.checkUploadLimitsForNewObject(ctx, keyInfo, req.NewBucket, encryptedObjectSize, nSegments)
 		},
 	})
 	if err != nil {
@@ -3134,7 +3136,7 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 		LegalHold: req.LegalHold,
 
 		VerifyLimits: func(encryptedObjectSize int64, nSegments int64) error {
-			return endpoint.addStorageUsageUpToLimit(ctx, keyInfo, encryptedObjectSize, nSegments)
+			return endpoint.addStorageUsageUpToLimit(ctx, keyInfo, req.NewBucket, encryptedObjectSize, nSegments)
 		},
 
 		IfNoneMatch: req.IfNoneMatch,
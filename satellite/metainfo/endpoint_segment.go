@@ -74,7 +74,7 @@ func (endpoint *Endpoint) beginSegment(ctx context.Context, req *pb.SegmentBegin
 	if !objectJustCreated {
 		// we need check limits only if object wasn't just created,
 		// begin object is checking limits on it' own
-		if err := endpoint.checkUploadLimits(ctx, keyInfo); err != nil {
+		if err := endpoint.checkUploadLimits(ctx, keyInfo, streamID.Bucket); err != nil {
 			return nil, err
 		}
 	}
@@ -252,7 +252,7 @@ func (endpoint *Endpoint) RetryBeginSegmentPieces(ctx context.Context, req *pb.R
 		retryingPieceNumberSet[pieceNumber] = struct{}{}
 	}
 
-	if err := endpoint.checkUploadLimits(ctx, keyInfo); err != nil {
+	if err := endpoint.checkUploadLimits(ctx, keyInfo, segmentID.StreamId.Bucket); err != nil {
 		return nil, err
 	}
 
@@ -493,7 +493,7 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
-	if err := endpoint.checkUploadLimits(ctx, keyInfo); err != nil {
+	if err := endpoint.checkUploadLimits(ctx, keyInfo, streamID.Bucket); err != nil {
 		return nil, err
 	}
 
@@ -519,7 +519,7 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 		return nil, endpoint.ConvertMetabaseErr(err)
 	}
 
-	endpoint.addSegmentToUploadLimits(ctx, keyInfo, segmentSize)
+	endpoint.addSegmentToUploadLimits(ctx, keyInfo, streamID.Bucket, segmentSize)
 
 	// increment our counters in the success tracker appropriate to the committing uplink
 	{
@@ -592,7 +592,7 @@ func (endpoint *Endpoint) MakeInlineSegment(ctx context.Context, req *pb.Segment
 		return nil, endpoint.ConvertKnownErrWithMessage(err, "unable to parse stream id")
 	}
 
-	if err := endpoint.checkUploadLimits(ctx, keyInfo); err != nil {
+	if err := endpoint.checkUploadLimits(ctx, keyInfo, streamID.Bucket); err != nil {
 		return nil, err
 	}
 
@@ -642,7 +642,7 @@ func (endpoint *Endpoint) MakeInlineSegment(ctx context.Context, req *pb.Segment
 		return nil, endpoint.ConvertKnownErrWithMessage(err, "unable to update PUT inline order")
 	}
 
-	endpoint.addSegmentToUploadLimits(ctx, keyInfo, inlineUsed)
+	endpoint.addSegmentToUploadLimits(ctx, keyInfo, streamID.Bucket, inlineUsed)
 
 	endpoint.versionCollector.collectTransferStats(req.Header.UserAgent, upload, int(req.PlainSize))
 
@@ -772,7 +772,7 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 		return nil, err
 	}
 
-	if err := endpoint.checkDownloadLimits(ctx, keyInfo); err != nil {
+	if err := endpoint.checkDownloadLimits(ctx, keyInfo, streamID.Bucket); err != nil {
 		return nil, err
 	}
 
@@ -824,6 +824,8 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 		)
 	}
 
+	endpoint.addBucketBandwidthUsage(ctx, keyInfo, streamID.Bucket, int64(segment.EncryptedSize))
+
 	encryptedKeyNonce, err := storj.NonceFromBytes(segment.EncryptedKeyNonce)
 	if err != nil {
 		return nil, endpoint.ConvertKnownErrWithMessage(err, "unable to get encryption key nonce from metadata")
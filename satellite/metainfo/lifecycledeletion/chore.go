@@ -0,0 +1,403 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lifecycledeletion
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// Error defines the lifecycledeletion chore errors class.
+	Error = errs.Class("lifecycle deletion")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for applying bucket lifecycle rules.
+type Config struct {
+	Interval            time.Duration `help:"the time between each attempt to apply bucket lifecycle rules" releaseDefault:"24h" devDefault:"10s" testDefault:"$TESTINTERVAL"`
+	Enabled             bool          `help:"set if bucket lifecycle rules are applied" default:"false"`
+	ListLimit           int           `help:"how many buckets or objects to query in a batch" default:"100"`
+	MaxDeletesPerBucket int           `help:"how many objects to remove from a single bucket in one iteration; the rest is removed in the next iterations" default:"10000"`
+}
+
+// Chore implements the bucket lifecycle rules chore.
+//
+// architecture: Chore
+type Chore struct {
+	log      *zap.Logger
+	config   Config
+	buckets  buckets.DB
+	metabase *metabase.DB
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore creates a new instance of the lifecycledeletion chore.
+func NewChore(log *zap.Logger, config Config, buckets buckets.DB, metabase *metabase.DB) *Chore {
+	return &Chore{
+		log:      log,
+		config:   config,
+		buckets:  buckets,
+		metabase: metabase,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the lifecycledeletion loop service.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, chore.applyLifecycleRules)
+}
+
+// Close stops the lifecycledeletion chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// TestingSetNow allows tests to have the server act as if the current time is whatever they want.
+func (chore *Chore) TestingSetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+func (chore *Chore) applyLifecycleRules(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var cursor metabase.BucketLocation
+	for {
+		configs, err := chore.buckets.ListBucketLifecycleConfigs(ctx, cursor, chore.config.ListLimit)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		for _, config := range configs {
+			if err := chore.applyBucketRules(ctx, config); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				chore.log.Warn("failed to apply bucket lifecycle rules",
+					zap.Stringer("project_id", config.Location.ProjectID),
+					zap.String("bucket_name", config.Location.BucketName.String()),
+					zap.Error(err))
+			}
+		}
+
+		if len(configs) < chore.config.ListLimit {
+			return nil
+		}
+		cursor = configs[len(configs)-1].Location
+	}
+}
+
+// action is an object removal decided by the lifecycle rules.
+type action struct {
+	kind   actionKind
+	object metabase.ObjectStream
+}
+
+type actionKind int
+
+const (
+	expireCurrent actionKind = iota
+	expireNoncurrent
+	abortUpload
+)
+
+func (chore *Chore) applyBucketRules(ctx context.Context, config buckets.BucketLifecycle) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var rules []buckets.LifecycleRule
+	for _, rule := range config.Configuration.Rules {
+		if rule.Enabled {
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	bucket, err := chore.buckets.GetBucket(ctx, []byte(config.Location.BucketName), config.Location.ProjectID)
+	if err != nil {
+		if buckets.ErrBucketNotFound.Has(err) {
+			return nil
+		}
+		return Error.Wrap(err)
+	}
+
+	actions, cursor, protected, err := chore.collectActions(ctx, config, rules, bucket)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var expired, aborted int
+	for _, action := range actions {
+		err := chore.apply(ctx, action, bucket.Versioning)
+		switch {
+		case err == nil:
+			if action.kind == abortUpload {
+				aborted++
+			} else {
+				expired++
+			}
+		case metabase.ErrObjectLock.Has(err):
+			// the object was locked after it was listed.
+			protected++
+		case metabase.ErrObjectNotFound.Has(err):
+			// the object was removed in the meantime.
+		default:
+			return Error.Wrap(err)
+		}
+	}
+
+	// the cursor is only saved once the actions before it are applied, so that
+	// objects are never skipped when an iteration fails.
+	if cursor != config.DeletionCursor {
+		if err := chore.buckets.UpdateBucketLifecycleDeletionCursor(ctx, config.Location, cursor); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	mon.Meter("lifecycle_objects_expired").Mark(expired)
+	mon.Meter("lifecycle_uploads_aborted").Mark(aborted)
+	mon.Meter("lifecycle_objects_protected").Mark(protected)
+
+	if len(actions) > 0 || protected > 0 {
+		chore.log.Debug("applied bucket lifecycle rules",
+			zap.Stringer("project_id", config.Location.ProjectID),
+			zap.String("bucket_name", config.Location.BucketName.String()),
+			zap.Int("expired", expired),
+			zap.Int("aborted", aborted),
+			zap.Int("protected", protected))
+	}
+
+	return nil
+}
+
+// collectActions lists the objects of the bucket, starting from the deletion cursor, and
+// returns the removals required by the rules, up to MaxDeletesPerBucket. Objects protected
+// by retention or a legal hold are skipped and don't count towards the limit.
+//
+// The returned cursor is the object key to continue from in the next iteration, or empty
+// when the whole bucket has been listed.
+func (chore *Chore) collectActions(ctx context.Context, config buckets.BucketLifecycle, rules []buckets.LifecycleRule, bucketInfo buckets.Bucket) (actions []action, cursor metabase.ObjectKey, protected int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucket := config.Location
+	now := chore.nowFn()
+	full := func() bool { return len(actions) >= chore.config.MaxDeletesPerBucket }
+
+	// isProtected reports whether deleting the exact version of the object would
+	// fail because of object lock.
+	isProtected := func(ctx context.Context, object metabase.ObjectStream) (bool, error) {
+		if !bucketInfo.ObjectLock.Enabled {
+			return false, nil
+		}
+		retention, err := chore.metabase.GetObjectExactVersionRetention(ctx, metabase.GetObjectExactVersionRetention{
+			ObjectLocation: object.Location(),
+			Version:        object.Version,
+		})
+		if err != nil {
+			return false, err
+		}
+		if retention.Active(now) {
+			return true, nil
+		}
+		return chore.metabase.GetObjectExactVersionLegalHold(ctx, metabase.GetObjectExactVersionLegalHold{
+			ObjectLocation: object.Location(),
+			Version:        object.Version,
+		})
+	}
+
+	// addAction adds the action unless the object is protected.
+	addAction := func(ctx context.Context, kind actionKind, object metabase.ObjectStream) error {
+		// in versioned buckets the current version is hidden behind a delete marker,
+		// which is allowed for protected objects too.
+		if kind == expireNoncurrent || (kind == expireCurrent && !bucketInfo.Versioning.IsVersioned()) {
+			isProtected, err := isProtected(ctx, object)
+			if err != nil {
+				if metabase.ErrObjectNotFound.Has(err) {
+					return nil
+				}
+				return err
+			}
+			if isProtected {
+				protected++
+				return nil
+			}
+		}
+		actions = append(actions, action{kind: kind, object: object})
+		return nil
+	}
+
+	var expiresCommitted, abortsPending bool
+	for _, rule := range rules {
+		expiresCommitted = expiresCommitted || rule.ExpirationDays > 0 || rule.NoncurrentDays > 0 || rule.NewerNoncurrentVersions > 0
+		abortsPending = abortsPending || rule.AbortIncompleteUploadDays > 0
+	}
+
+	if expiresCommitted {
+		opts := metabase.IterateObjectsWithStatus{
+			ProjectID:  bucket.ProjectID,
+			BucketName: bucket.BucketName,
+			Recursive:  true,
+			BatchSize:  chore.config.ListLimit,
+		}
+		if config.DeletionCursor != "" {
+			// the cursor is exclusive, so start from the newest version of the key
+			// to count its noncurrent versions again.
+			opts.Cursor = metabase.IterateCursor{Key: config.DeletionCursor, Version: metabase.MaxVersion}
+		}
+
+		err = chore.metabase.IterateObjectsAllVersionsWithStatus(ctx, opts, func(ctx context.Context, it metabase.ObjectsIterator) error {
+			var entry, newer metabase.ObjectEntry
+			// noncurrent is the number of noncurrent versions of the current key
+			// seen so far; -1 means that the next entry is the current version.
+			noncurrent := -1
+
+			// versions of a key are listed from the newest to the oldest.
+			for it.Next(ctx, &entry) {
+				if full() {
+					cursor = entry.ObjectKey
+					return nil
+				}
+
+				if noncurrent >= 0 && entry.ObjectKey != newer.ObjectKey {
+					noncurrent = -1
+				}
+
+				object := metabase.ObjectStream{
+					ProjectID:  bucket.ProjectID,
+					BucketName: bucket.BucketName,
+					ObjectKey:  entry.ObjectKey,
+					Version:    entry.Version,
+					StreamID:   entry.StreamID,
+				}
+
+				if noncurrent < 0 {
+					if entry.Status.IsCommitted() && anyRule(rules, entry.ObjectKey, func(rule buckets.LifecycleRule) bool {
+						return rule.ExpiresCurrent(entry.CreatedAt, now)
+					}) {
+						if err := addAction(ctx, expireCurrent, object); err != nil {
+							return err
+						}
+					}
+				} else if anyRule(rules, entry.ObjectKey, func(rule buckets.LifecycleRule) bool {
+					// a version becomes noncurrent when the next version is created.
+					return rule.ExpiresNoncurrent(newer.CreatedAt, noncurrent, now)
+				}) {
+					if err := addAction(ctx, expireNoncurrent, object); err != nil {
+						return err
+					}
+				}
+
+				noncurrent++
+				newer = entry
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, "", 0, err
+		}
+	}
+
+	if abortsPending && !full() {
+		err = chore.metabase.IterateObjectsAllVersionsWithStatus(ctx, metabase.IterateObjectsWithStatus{
+			ProjectID:  bucket.ProjectID,
+			BucketName: bucket.BucketName,
+			Recursive:  true,
+			BatchSize:  chore.config.ListLimit,
+			Pending:    true,
+		}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+			var entry metabase.ObjectEntry
+			for !full() && it.Next(ctx, &entry) {
+				if anyRule(rules, entry.ObjectKey, func(rule buckets.LifecycleRule) bool {
+					return rule.AbortsUpload(entry.CreatedAt, now)
+				}) {
+					// pending objects can't be protected.
+					actions = append(actions, action{kind: abortUpload, object: metabase.ObjectStream{
+						ProjectID:  bucket.ProjectID,
+						BucketName: bucket.BucketName,
+						ObjectKey:  entry.ObjectKey,
+						Version:    entry.Version,
+						StreamID:   entry.StreamID,
+					}})
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, "", 0, err
+		}
+	}
+
+	return actions, cursor, protected, nil
+}
+
+func (chore *Chore) apply(ctx context.Context, action action, versioning buckets.Versioning) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	objectLock := metabase.ObjectLockDeleteOptions{Enabled: true}
+
+	switch action.kind {
+	case expireCurrent:
+		if versioning.IsVersioned() {
+			// versioned buckets keep the data as a noncurrent version.
+			_, err := chore.metabase.DeleteObjectLastCommitted(ctx, metabase.DeleteObjectLastCommitted{
+				ObjectLocation: action.object.Location(),
+				Versioned:      versioning == buckets.VersioningEnabled,
+				Suspended:      versioning == buckets.VersioningSuspended,
+				ObjectLock:     objectLock,
+			})
+			return err
+		}
+		// delete the exact version, so that an object uploaded in the meantime is kept.
+		_, err := chore.metabase.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+			ObjectLocation: action.object.Location(),
+			Version:        action.object.Version,
+			ObjectLock:     objectLock,
+		})
+		return err
+	case expireNoncurrent:
+		_, err := chore.metabase.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+			ObjectLocation: action.object.Location(),
+			Version:        action.object.Version,
+			ObjectLock:     objectLock,
+		})
+		return err
+	case abortUpload:
+		_, err := chore.metabase.DeletePendingObject(ctx, metabase.DeletePendingObject{
+			ObjectStream: action.object,
+		})
+		return err
+	default:
+		return errs.New("unknown lifecycle action %d", action.kind)
+	}
+}
+
+// anyRule returns whether any of the rules which match the key satisfies fn.
+func anyRule(rules []buckets.LifecycleRule, key metabase.ObjectKey, fn func(buckets.LifecycleRule) bool) bool {
+	for _, rule := range rules {
+		if rule.Matches(key) && fn(rule) {
+			return true
+		}
+	}
+	return false
+}
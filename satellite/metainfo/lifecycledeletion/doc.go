@@ -0,0 +1,20 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+/*
+Package lifecycledeletion contains the chore which applies bucket lifecycle rules.
+
+On every iteration the chore goes through all buckets with a lifecycle
+configuration and lists their objects. Current versions older than a rule's
+expiration are deleted, or hidden behind a delete marker in versioned buckets.
+Noncurrent versions are deleted after they have been noncurrent long enough or
+when there are too many newer noncurrent versions, and pending uploads are
+aborted once they are older than the configured number of days.
+
+Objects protected by retention or a legal hold are never deleted; they are
+skipped without counting towards the per-bucket deletion limit and retried on
+the next iteration. When a bucket has more objects to remove than the limit,
+the chore saves the object key it stopped at as the deletion cursor of the
+bucket and continues from there on the next iteration.
+*/
+package lifecycledeletion
@@ -0,0 +1,217 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lifecycledeletion_test
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestLifecycleDeletion(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.LifecycleDeletion.Enabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		chore := sat.Core.LifecycleDeletion.Chore
+		chore.Loop.Pause()
+
+		project, err := sat.DB.Console().Projects().Insert(ctx, &console.Project{Name: "testproject"})
+		require.NoError(t, err)
+
+		createBucket := func(name string, versioning buckets.Versioning) {
+			_, err := sat.API.Buckets.Service.CreateBucket(ctx, buckets.Bucket{
+				ID:         testrand.UUID(),
+				Name:       name,
+				ProjectID:  project.ID,
+				Versioning: versioning,
+			})
+			require.NoError(t, err)
+		}
+		objectStream := func(bucket, key string, version metabase.Version) metabase.ObjectStream {
+			return metabase.ObjectStream{
+				ProjectID:  project.ID,
+				BucketName: metabase.BucketName(bucket),
+				ObjectKey:  metabase.ObjectKey(key),
+				Version:    version,
+				StreamID:   testrand.UUID(),
+			}
+		}
+
+		createBucket("unversioned", buckets.Unversioned)
+		metabasetest.CreateObject(ctx, t, sat.Metabase.DB, objectStream("unversioned", "logs/old", 1), 1)
+		metabasetest.CreateObject(ctx, t, sat.Metabase.DB, objectStream("unversioned", "data/old", 1), 1)
+		metabasetest.CreatePendingObject(ctx, t, sat.Metabase.DB, objectStream("unversioned", "logs/pending", 1), 1)
+		metabasetest.CreateObjectWithRetention(ctx, t, sat.Metabase.DB, objectStream("unversioned", "logs/locked", 1), 1, metabase.Retention{
+			Mode:        storj.ComplianceMode,
+			RetainUntil: time.Now().Add(365 * 24 * time.Hour),
+		})
+
+		require.NoError(t, sat.API.Buckets.Service.UpdateBucketLifecycleConfig(ctx, []byte("unversioned"), project.ID, buckets.LifecycleConfiguration{
+			Rules: []buckets.LifecycleRule{{
+				ID:                        "logs",
+				Enabled:                   true,
+				Prefix:                    []byte("logs/"),
+				ExpirationDays:            30,
+				AbortIncompleteUploadDays: 7,
+			}},
+		}))
+
+		createBucket("versioned", buckets.VersioningEnabled)
+		for version := metabase.Version(1); version <= 3; version++ {
+			metabasetest.CreateObjectVersioned(ctx, t, sat.Metabase.DB, objectStream("versioned", "object", version), 1)
+		}
+
+		require.NoError(t, sat.API.Buckets.Service.UpdateBucketLifecycleConfig(ctx, []byte("versioned"), project.ID, buckets.LifecycleConfiguration{
+			Rules: []buckets.LifecycleRule{{
+				ID:                      "versions",
+				Enabled:                 true,
+				NewerNoncurrentVersions: 1,
+			}},
+		}))
+
+		remaining := func() (keys []string) {
+			objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			for _, object := range objects {
+				keys = append(keys, fmt.Sprintf("%s/%s@%d", object.BucketName, object.ObjectKey, object.Version))
+			}
+			sort.Strings(keys)
+			return keys
+		}
+
+		// nothing is old enough yet; only the version count rule applies.
+		chore.Loop.TriggerWait()
+		require.Equal(t, []string{
+			"unversioned/data/old@1",
+			"unversioned/logs/locked@1",
+			"unversioned/logs/old@1",
+			"unversioned/logs/pending@1",
+			"versioned/object@2",
+			"versioned/object@3",
+		}, remaining())
+
+		chore.TestingSetNow(func() time.Time {
+			return time.Now().Add(31 * 24 * time.Hour)
+		})
+		chore.Loop.TriggerWait()
+		require.Equal(t, []string{
+			"unversioned/data/old@1",
+			"unversioned/logs/locked@1",
+			"versioned/object@2",
+			"versioned/object@3",
+		}, remaining())
+	})
+}
+
+func TestLifecycleDeletionCursor(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.LifecycleDeletion.Enabled = true
+				config.LifecycleDeletion.MaxDeletesPerBucket = 2
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		chore := sat.Core.LifecycleDeletion.Chore
+		chore.Loop.Pause()
+
+		project, err := sat.DB.Console().Projects().Insert(ctx, &console.Project{Name: "testproject"})
+		require.NoError(t, err)
+
+		_, err = sat.API.Buckets.Service.CreateBucket(ctx, buckets.Bucket{
+			ID:         testrand.UUID(),
+			Name:       "locked",
+			ProjectID:  project.ID,
+			Versioning: buckets.VersioningEnabled,
+			ObjectLock: buckets.ObjectLockSettings{Enabled: true},
+		})
+		require.NoError(t, err)
+
+		for _, key := range []string{"a", "b", "c"} {
+			for version := metabase.Version(1); version <= 3; version++ {
+				obj := metabase.ObjectStream{
+					ProjectID:  project.ID,
+					BucketName: "locked",
+					ObjectKey:  metabase.ObjectKey(key),
+					Version:    version,
+					StreamID:   testrand.UUID(),
+				}
+				metabasetest.BeginObjectExactVersion{
+					Opts: metabase.BeginObjectExactVersion{
+						ObjectStream: obj,
+						Encryption:   metabasetest.DefaultEncryption,
+						LegalHold:    key == "a",
+					},
+				}.Check(ctx, t, sat.Metabase.DB)
+				metabasetest.CommitObject{
+					Opts: metabase.CommitObject{
+						ObjectStream: obj,
+						Versioned:    true,
+					},
+				}.Check(ctx, t, sat.Metabase.DB)
+			}
+		}
+
+		require.NoError(t, sat.API.Buckets.Service.UpdateBucketLifecycleConfig(ctx, []byte("locked"), project.ID, buckets.LifecycleConfiguration{
+			Rules: []buckets.LifecycleRule{{
+				ID:             "noncurrent",
+				Enabled:        true,
+				NoncurrentDays: 1,
+			}},
+		}))
+
+		remaining := func() (keys []string) {
+			objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			for _, object := range objects {
+				keys = append(keys, fmt.Sprintf("%s@%d", object.ObjectKey, object.Version))
+			}
+			sort.Strings(keys)
+			return keys
+		}
+		deletionCursor := func() metabase.ObjectKey {
+			configs, err := sat.API.Buckets.Service.ListBucketLifecycleConfigs(ctx, metabase.BucketLocation{}, 10)
+			require.NoError(t, err)
+			require.Len(t, configs, 1)
+			return configs[0].DeletionCursor
+		}
+
+		chore.TestingSetNow(func() time.Time {
+			return time.Now().Add(2 * 24 * time.Hour)
+		})
+
+		// the noncurrent versions of "a" are on legal hold, so they don't count towards
+		// the limit and the versions of "b" are deleted instead.
+		chore.Loop.TriggerWait()
+		require.Equal(t, []string{"a@1", "a@2", "a@3", "b@3", "c@1", "c@2", "c@3"}, remaining())
+		require.Equal(t, metabase.ObjectKey("c"), deletionCursor())
+
+		// the next iteration continues from the cursor and reaches the end of the bucket.
+		chore.Loop.TriggerWait()
+		require.Equal(t, []string{"a@1", "a@2", "a@3", "b@3", "c@3"}, remaining())
+		require.Empty(t, deletionCursor())
+	})
+}
@@ -0,0 +1,15 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package lifecycledeletion
+
+import (
+	"storj.io/storj/shared/modular/config"
+	"storj.io/storj/shared/mud"
+)
+
+// Module is a mud module definition.
+func Module(ball *mud.Ball) {
+	config.RegisterConfig[Config](ball, "lifecycle-deletion")
+	mud.Provide[*Chore](ball, NewChore)
+}
@@ -811,7 +811,7 @@ func (endpoint *Endpoint) validateRemoteSegment(ctx context.Context, commitReque
 	return nil
 }
 
-func (endpoint *Endpoint) checkDownloadLimits(ctx context.Context, keyInfo *console.APIKeyInfo) error {
+func (endpoint *Endpoint) checkDownloadLimits(ctx context.Context, keyInfo *console.APIKeyInfo, bucketName []byte) error {
 	bwLimit, err := endpoint.projectUsage.ExceedsBandwidthUsage(ctx, keyInfoToLimits(keyInfo), endpoint.config.LimitEmailNotificationsEnabled)
 	if err != nil {
 		// don't log errors if it was user cancellation
@@ -838,15 +838,69 @@ func (endpoint *Endpoint) checkDownloadLimits(ctx context.Context, keyInfo *cons
 		}
 		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Usage Limit")
 	}
+
+	return endpoint.checkBucketDownloadLimits(ctx, keyInfo, bucketName)
+}
+
+func (endpoint *Endpoint) checkBucketDownloadLimits(ctx context.Context, keyInfo *console.APIKeyInfo, bucketName []byte) error {
+	limits, ok := endpoint.getBucketLimits(ctx, keyInfo, bucketName)
+	if !ok || limits.Bandwidth == nil {
+		return nil
+	}
+
+	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: metabase.BucketName(bucketName)}
+	bwLimit, err := endpoint.projectUsage.ExceedsBucketBandwidthUsage(ctx, bucket, limits)
+	if err != nil {
+		// don't log errors if it was user cancellation
+		if !errors.Is(ctx.Err(), context.Canceled) {
+			endpoint.log.Error(
+				"Retrieving bucket bandwidth total failed; bucket bandwidth limit won't be enforced",
+				zap.Stringer("public_id", keyInfo.ProjectPublicID),
+				zap.Error(err),
+			)
+		}
+		return nil
+	}
+
+	if bwLimit.Exceeds {
+		endpoint.log.Warn("Monthly bucket bandwidth limit exceeded",
+			zap.Stringer("limit", bwLimit.Limit),
+			zap.Stringer("public_id", keyInfo.ProjectPublicID),
+		)
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Bucket Usage Limit")
+	}
+
 	return nil
 }
 
-func (endpoint *Endpoint) checkUploadLimits(ctx context.Context, keyInfo *console.APIKeyInfo) error {
-	return endpoint.checkUploadLimitsForNewObject(ctx, keyInfo, 1, 1)
+// addBucketBandwidthUsage adds the downloaded bytes to the bandwidth usage of the bucket when it
+// has a bandwidth limit.
+func (endpoint *Endpoint) addBucketBandwidthUsage(ctx context.Context, keyInfo *console.APIKeyInfo, bucketName []byte, size int64) {
+	limits, ok := endpoint.getBucketLimits(ctx, keyInfo, bucketName)
+	if !ok {
+		return
+	}
+
+	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: metabase.BucketName(bucketName)}
+	if err := endpoint.projectUsage.UpdateBucketBandwidthUsage(ctx, bucket, limits, size); err != nil {
+		// don't log errors if it was user cancellation
+		if !errors.Is(ctx.Err(), context.Canceled) {
+			endpoint.log.Error("Could not track the bucket's bandwidth usage",
+				zap.Stringer("public_id", keyInfo.ProjectPublicID),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// checkUploadLimits checks the upload limits of the project and, when bucketName isn't empty,
+// the upload limits of the bucket.
+func (endpoint *Endpoint) checkUploadLimits(ctx context.Context, keyInfo *console.APIKeyInfo, bucketName []byte) error {
+	return endpoint.checkUploadLimitsForNewObject(ctx, keyInfo, bucketName, 1, 1)
 }
 
 func (endpoint *Endpoint) checkUploadLimitsForNewObject(
-	ctx context.Context, keyInfo *console.APIKeyInfo, newObjectSize int64, newObjectSegmentCount int64,
+	ctx context.Context, keyInfo *console.APIKeyInfo, bucketName []byte, newObjectSize int64, newObjectSegmentCount int64,
 ) error {
 	limit := endpoint.projectUsage.ExceedsUploadLimits(ctx, newObjectSize, newObjectSegmentCount, keyInfoToLimits(keyInfo))
 
@@ -870,9 +924,74 @@ func (endpoint *Endpoint) checkUploadLimitsForNewObject(
 		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Storage Limit")
 	}
 
+	return endpoint.checkBucketUploadLimits(ctx, keyInfo, bucketName, newObjectSize, newObjectSegmentCount)
+}
+
+func (endpoint *Endpoint) checkBucketUploadLimits(
+	ctx context.Context, keyInfo *console.APIKeyInfo, bucketName []byte, newObjectSize int64, newObjectSegmentCount int64,
+) error {
+	limits, ok := endpoint.getBucketLimits(ctx, keyInfo, bucketName)
+	if !ok {
+		return nil
+	}
+
+	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: metabase.BucketName(bucketName)}
+	limit, err := endpoint.projectUsage.ExceedsBucketUploadLimits(ctx, bucket, newObjectSize, newObjectSegmentCount, limits)
+	if err != nil {
+		// don't log errors if it was user cancellation
+		if !errors.Is(ctx.Err(), context.Canceled) {
+			endpoint.log.Error(
+				"Retrieving bucket storage and segment totals failed; bucket limits won't be enforced",
+				zap.Stringer("public_id", keyInfo.ProjectPublicID),
+				zap.Error(err),
+			)
+		}
+		return nil
+	}
+
+	if limit.ExceedsSegments {
+		endpoint.log.Warn("Bucket segment limit exceeded",
+			zap.String("limit", strconv.Itoa(int(limit.SegmentsLimit))),
+			zap.Stringer("public_id", keyInfo.ProjectPublicID),
+		)
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Bucket Segments Limit")
+	}
+
+	if limit.ExceedsStorage {
+		endpoint.log.Warn("Bucket storage limit exceeded",
+			zap.String("limit", strconv.Itoa(limit.StorageLimit.Int())),
+			zap.Stringer("public_id", keyInfo.ProjectPublicID),
+		)
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Bucket Storage Limit")
+	}
+
 	return nil
 }
 
+// getBucketLimits returns the limits of the bucket. It returns false if the bucket limits aren't
+// enforced, bucketName is empty, the bucket has no limits or they cannot be retrieved.
+func (endpoint *Endpoint) getBucketLimits(ctx context.Context, keyInfo *console.APIKeyInfo, bucketName []byte) (_ buckets.Limits, ok bool) {
+	if !endpoint.config.BucketLimits.Enabled || len(bucketName) == 0 {
+		return buckets.Limits{}, false
+	}
+
+	limits, err := endpoint.bucketLimitsCache.Get(ctx, string(keyInfo.ProjectID[:])+"/"+string(bucketName), func() (buckets.Limits, error) {
+		return endpoint.buckets.GetBucketLimits(ctx, bucketName, keyInfo.ProjectID)
+	})
+	if err != nil {
+		// don't log errors if it was user cancellation
+		if !errors.Is(ctx.Err(), context.Canceled) {
+			endpoint.log.Error("Retrieving bucket limits failed; bucket limits won't be enforced",
+				zap.Stringer("public_id", keyInfo.ProjectPublicID),
+				zap.Error(err),
+			)
+		}
+		return buckets.Limits{}, false
+	}
+
+	return limits, !limits.IsZero()
+}
+
 // enqueueThresholdEvents inserts threshold and reset events into the project limit events queue.
 // Individual inserts are used intentionally: thresholds contains at most one event (only the highest
 // newly-crossed threshold is emitted) and resets contains at most two, so the overhead is negligible.
@@ -899,11 +1018,11 @@ func (endpoint *Endpoint) enqueueThresholdEvents(ctx context.Context, keyInfo *c
 	}
 }
 
-func (endpoint *Endpoint) addSegmentToUploadLimits(ctx context.Context, keyInfo *console.APIKeyInfo, segmentSize int64) {
-	endpoint.addToUploadLimits(ctx, keyInfo, segmentSize, 1)
+func (endpoint *Endpoint) addSegmentToUploadLimits(ctx context.Context, keyInfo *console.APIKeyInfo, bucketName []byte, segmentSize int64) {
+	endpoint.addToUploadLimits(ctx, keyInfo, bucketName, segmentSize, 1)
 }
 
-func (endpoint *Endpoint) addToUploadLimits(ctx context.Context, keyInfo *console.APIKeyInfo, size, segmentCount int64) {
+func (endpoint *Endpoint) addToUploadLimits(ctx context.Context, keyInfo *console.APIKeyInfo, bucketName []byte, size, segmentCount int64) {
 	if err := endpoint.projectUsage.UpdateProjectStorageAndSegmentUsage(ctx, keyInfoToLimits(keyInfo), size, segmentCount); err != nil {
 		// don't log errors if it was user cancellation
 		if !errors.Is(ctx.Err(), context.Canceled) {
@@ -916,10 +1035,27 @@ func (endpoint *Endpoint) addToUploadLimits(ctx context.Context, keyInfo *consol
 			)
 		}
 	}
+
+	limits, ok := endpoint.getBucketLimits(ctx, keyInfo, bucketName)
+	if !ok {
+		return
+	}
+
+	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: metabase.BucketName(bucketName)}
+	if err := endpoint.projectUsage.UpdateBucketStorageAndSegmentUsage(ctx, bucket, limits, size, segmentCount); err != nil {
+		// don't log errors if it was user cancellation
+		if !errors.Is(ctx.Err(), context.Canceled) {
+			endpoint.log.Error("Could not track new bucket's storage and segment usage",
+				zap.Stringer("public_id", keyInfo.ProjectPublicID),
+				zap.Error(err),
+			)
+		}
+	}
 }
 
-func (endpoint *Endpoint) addStorageUsageUpToLimit(ctx context.Context, keyInfo *console.APIKeyInfo, storage int64, segments int64) (err error) {
+func (endpoint *Endpoint) addStorageUsageUpToLimit(ctx context.Context, keyInfo *console.APIKeyInfo, bucketName []byte, storage int64, segments int64) (err error) {
 	err = endpoint.projectUsage.AddProjectUsageUpToLimit(ctx, keyInfo.ProjectID, storage, segments, keyInfoToLimits(keyInfo))
+	projectUsageAdded := err == nil
 
 	if err != nil {
 		if accounting.ErrProjectLimitExceeded.Has(err) {
@@ -940,6 +1076,38 @@ func (endpoint *Endpoint) addStorageUsageUpToLimit(ctx context.Context, keyInfo
 		}
 	}
 
+	limits, ok := endpoint.getBucketLimits(ctx, keyInfo, bucketName)
+	if !ok {
+		return nil
+	}
+
+	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: metabase.BucketName(bucketName)}
+	err = endpoint.projectUsage.AddBucketUsageUpToLimit(ctx, bucket, storage, segments, limits)
+	if err != nil {
+		if accounting.ErrBucketLimitExceeded.Has(err) {
+			endpoint.log.Warn("Bucket upload limit exceeded",
+				zap.Stringer("public_id", keyInfo.ProjectPublicID),
+				zap.Error(err),
+			)
+
+			if projectUsageAdded {
+				// roll back the project usage increase.
+				endpoint.addToUploadLimits(ctx, keyInfo, nil, -storage, -segments)
+			}
+
+			return rpcstatus.Error(rpcstatus.ResourceExhausted, err.Error())
+		}
+
+		// don't log errors if it was user cancellation
+		if !errors.Is(ctx.Err(), context.Canceled) {
+			endpoint.log.Error(
+				"Updating bucket upload limits failed; bucket limits won't be enforced",
+				zap.Stringer("public_id", keyInfo.ProjectPublicID),
+				zap.Error(err),
+			)
+		}
+	}
+
 	return nil
 }
 
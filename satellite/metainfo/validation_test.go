@@ -17,6 +17,7 @@ import (
 
 	"storj.io/common/errs2"
 	"storj.io/common/macaroon"
+	"storj.io/common/memory"
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcpeer"
 	"storj.io/common/rpc/rpcstatus"
@@ -602,3 +603,82 @@ func TestEndpoint_ValidateAuthAny(t *testing.T) {
 	require.False(t, canList)
 	require.True(t, canDelete)
 }
+
+func TestEndpoint_BucketLimits(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.BucketLimits.Enabled = true
+				// effectively disable caching so limit changes apply immediately.
+				config.Metainfo.BucketLimits.CacheExpiration = time.Nanosecond
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+		projectID := uplink.Projects[0].ID
+
+		limit := func(v int64) *int64 { return &v }
+
+		for _, name := range []string{"segments-limited", "egress-limited", "unlimited"} {
+			require.NoError(t, uplink.TestingCreateBucket(ctx, sat, name))
+		}
+
+		require.NoError(t, sat.API.Buckets.Service.UpdateBucketLimits(ctx, []byte("segments-limited"), projectID, buckets.Limits{
+			Segments: limit(1),
+		}))
+		require.NoError(t, sat.API.Buckets.Service.UpdateBucketLimits(ctx, []byte("egress-limited"), projectID, buckets.Limits{
+			Bandwidth: limit(1),
+		}))
+
+		t.Run("segments", func(t *testing.T) {
+			require.NoError(t, uplink.Upload(ctx, sat, "segments-limited", "first", testrand.Bytes(memory.KiB)))
+
+			err := uplink.Upload(ctx, sat, "segments-limited", "second", testrand.Bytes(memory.KiB))
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "Exceeded Bucket Segments Limit")
+
+			// other buckets of the project aren't affected.
+			require.NoError(t, uplink.Upload(ctx, sat, "unlimited", "second", testrand.Bytes(memory.KiB)))
+		})
+
+		t.Run("egress", func(t *testing.T) {
+			require.NoError(t, uplink.Upload(ctx, sat, "egress-limited", "object", testrand.Bytes(memory.KiB)))
+
+			_, err := uplink.Download(ctx, sat, "egress-limited", "object")
+			require.NoError(t, err)
+
+			_, err = uplink.Download(ctx, sat, "egress-limited", "object")
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "Exceeded Bucket Usage Limit")
+
+			_, err = uplink.Download(ctx, sat, "unlimited", "second")
+			require.NoError(t, err)
+		})
+
+		t.Run("copy", func(t *testing.T) {
+			project, err := uplink.OpenProject(ctx, sat)
+			require.NoError(t, err)
+			defer ctx.Check(project.Close)
+
+			// the limits of the destination bucket are the ones enforced.
+			_, err = project.CopyObject(ctx, "unlimited", "second", "segments-limited", "copy", nil)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "Exceeded Bucket Segments Limit")
+
+			_, err = project.CopyObject(ctx, "segments-limited", "first", "unlimited", "copy", nil)
+			require.NoError(t, err)
+		})
+
+		t.Run("removed limits", func(t *testing.T) {
+			require.NoError(t, sat.API.Buckets.Service.UpdateBucketLimits(ctx, []byte("segments-limited"), projectID, buckets.Limits{}))
+
+			limits, err := sat.API.Buckets.Service.GetBucketLimits(ctx, []byte("segments-limited"), projectID)
+			require.NoError(t, err)
+			require.True(t, limits.IsZero())
+
+			require.NoError(t, uplink.Upload(ctx, sat, "segments-limited", "second", testrand.Bytes(memory.KiB)))
+		})
+	})
+}
@@ -48,14 +48,17 @@ import (
 	"storj.io/storj/satellite/eventing/eventingconfig"
 	"storj.io/storj/satellite/gc/bloomfilter"
 	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/jobq"
 	"storj.io/storj/satellite/kms"
+	"storj.io/storj/satellite/kms/passphraserotation"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/mailservice/hubspotmails"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/changestream"
 	"storj.io/storj/satellite/metabase/rangedloop"
 	"storj.io/storj/satellite/metainfo"
+	"storj.io/storj/satellite/metainfo/lifecycledeletion"
 	"storj.io/storj/satellite/nodeapiversion"
 	"storj.io/storj/satellite/nodeaudit"
 	"storj.io/storj/satellite/nodeevents"
@@ -145,6 +148,7 @@ func Module(ball *mud.Ball) {
 	rangedloop.Module(ball)
 	bloomfilter.Module(ball)
 	metainfo.Module(ball)
+	lifecycledeletion.Module(ball)
 	metabase.Module(ball)
 	eventingconfig.Module(ball)
 	nodeaudit.Module(ball)
@@ -210,7 +214,7 @@ func Module(ball *mud.Ball) {
 
 	// TODO: should be defined here due to circular dependencies (accounting vs live/console config)
 	mud.Provide[*accounting.Service](ball, func(log *zap.Logger, projectAccountingDB accounting.ProjectAccounting, liveAccounting accounting.Cache, metabaseDB metabase.DB, cc console.Config, config, lc live.Config) *accounting.Service {
-		return accounting.NewService(log, projectAccountingDB, liveAccounting, metabaseDB, lc.BandwidthCacheTTL, cc.UsageLimits.Storage.Free, cc.UsageLimits.Bandwidth.Free, cc.UsageLimits.Segment.Free, lc.AsOfSystemInterval)
+		return accounting.NewService(log, projectAccountingDB, liveAccounting, metabaseDB, lc.BandwidthCacheTTL, lc.BucketUsageCacheTTL, cc.UsageLimits.Storage.Free, cc.UsageLimits.Bandwidth.Free, cc.UsageLimits.Segment.Free, lc.AsOfSystemInterval)
 	})
 	accounting.Module(ball)
 	mud.View[DB, accounting.ProjectAccounting](ball, DB.ProjectAccounting)
@@ -254,6 +258,11 @@ func Module(ball *mud.Ball) {
 			return nil, err
 		}
 
+		err = internalpb.DRPCRegisterBucketLifecycle(srv.DRPC(), metainfoEndpoint)
+		if err != nil {
+			return nil, err
+		}
+
 		err = pb.DRPCRegisterOrders(srv.DRPC(), oe)
 		if err != nil {
 			return nil, err
@@ -385,6 +394,7 @@ func Module(ball *mud.Ball) {
 	stripe.Module(ball)
 	emission.Module(ball)
 	kms.Module(ball)
+	passphraserotation.Module(ball)
 
 	// TODO: remove circular dependency and move it to storjscan.Module
 	mud.View[paymentsconfig.Config, storjscan.Config](ball, func(pc paymentsconfig.Config) storjscan.Config {
@@ -93,9 +93,11 @@ func NewChecker(
 	return service
 }
 
-// Process implements taskqueue.Processor[Job].
-func (service *PieceAudit) Process(ctx context.Context, job Job) {
+// Process implements taskqueue.Processor[Job]. Audit failures are recorded by
+// processJob, so the job is never retried.
+func (service *PieceAudit) Process(ctx context.Context, job Job) error {
 	service.processJob(ctx, job)
+	return nil
 }
 
 // Run starts the nodeaudit service loop.
@@ -212,6 +212,9 @@ var supportedFilters = map[any]any{
 	"continent": func(continent string) (NodeFilter, error) {
 		return NewContinentFilterFromString(continent)
 	},
+	"asn": func(asns ...int64) (NodeFilter, error) {
+		return NewASNFilter(asns...)
+	},
 	"all": func(filters ...NodeFilter) (NodeFilters, error) {
 		res := NodeFilters{}
 		for _, filter := range filters {
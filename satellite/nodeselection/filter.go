@@ -6,8 +6,11 @@ package nodeselection
 import (
 	"encoding/hex"
 	"fmt"
+	"math"
 	"os"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/jtolio/mito"
@@ -287,6 +290,36 @@ func (p *CountryFilter) String() string {
 
 var _ NodeFilter = &CountryFilter{}
 
+// ASNFilter selects nodes located in one of the autonomous systems.
+type ASNFilter []uint32
+
+// NewASNFilter creates a new ASNFilter from autonomous system numbers.
+func NewASNFilter(asns ...int64) (ASNFilter, error) {
+	filter := make(ASNFilter, 0, len(asns))
+	for _, asn := range asns {
+		if asn <= 0 || asn > math.MaxUint32 {
+			return nil, errs.New("invalid autonomous system number %d", asn)
+		}
+		filter = append(filter, uint32(asn))
+	}
+	return filter, nil
+}
+
+// Match implements NodeFilter interface.
+func (a ASNFilter) Match(node *SelectedNode) bool {
+	return slices.Contains(a, node.ASN)
+}
+
+func (a ASNFilter) String() string {
+	var asns []string
+	for _, asn := range a {
+		asns = append(asns, strconv.FormatUint(uint64(asn), 10))
+	}
+	return fmt.Sprintf(`asn(%s)`, strings.Join(asns, ","))
+}
+
+var _ NodeFilter = ASNFilter{}
+
 // ExcludedNetworks will exclude nodes with specified networks.
 type ExcludedNetworks []string
 
@@ -196,6 +196,25 @@ func TestCountryFilter_FromString(t *testing.T) {
 	}
 }
 
+func TestASNFilter(t *testing.T) {
+	filter, err := FilterFromString(`asn(64512,64513)`, NewPlacementConfigEnvironment(nil, nil))
+	require.NoError(t, err)
+	require.Equal(t, "asn(64512,64513)", filter.(fmt.Stringer).String())
+
+	require.True(t, filter.Match(&SelectedNode{ASN: 64512}))
+	require.True(t, filter.Match(&SelectedNode{ASN: 64513}))
+	require.False(t, filter.Match(&SelectedNode{ASN: 64514}))
+	require.False(t, filter.Match(&SelectedNode{}))
+
+	filter, err = FilterFromString(`select("org","==","Example Hosting")`, NewPlacementConfigEnvironment(nil, nil))
+	require.NoError(t, err)
+	require.True(t, filter.Match(&SelectedNode{ASN: 64512, ASNOrg: "Example Hosting"}))
+	require.False(t, filter.Match(&SelectedNode{ASN: 64513, ASNOrg: "Other Networks"}))
+
+	_, err = FilterFromString(`asn(0)`, NewPlacementConfigEnvironment(nil, nil))
+	require.Error(t, err)
+}
+
 func TestContinentFilter_FromString(t *testing.T) {
 	cases := []struct {
 		code            string
@@ -52,6 +52,8 @@ type SelectedNode struct {
 	LastNet     string
 	LastIPPort  string
 	CountryCode location.CountryCode
+	ASN         uint32
+	ASNOrg      string
 	Exiting     bool
 	Suspended   bool
 	Online      bool
@@ -235,6 +237,17 @@ func CreateNodeAttribute(attr string) (NodeAttribute, error) {
 		return func(node SelectedNode) string {
 			return strconv.FormatBool(node.Vetted)
 		}, nil
+	case "asn":
+		return func(node SelectedNode) string {
+			if node.ASN == 0 {
+				return ""
+			}
+			return strconv.FormatUint(uint64(node.ASN), 10)
+		}, nil
+	case "org":
+		return func(node SelectedNode) string {
+			return node.ASNOrg
+		}, nil
 	default:
 		return nil, errors.New("Unsupported node attribute: " + attr)
 	}
@@ -75,6 +75,17 @@ func TestNodeAttribute(t *testing.T) {
 		},
 	}))
 
+	assert.Equal(t, "64512", must(CreateNodeAttribute("asn"))(SelectedNode{
+		ASN: 64512,
+	}))
+
+	assert.Equal(t, "", must(CreateNodeAttribute("asn"))(SelectedNode{}))
+
+	assert.Equal(t, "Example Hosting", must(CreateNodeAttribute("org"))(SelectedNode{
+		ASN:    64512,
+		ASNOrg: "Example Hosting",
+	}))
+
 	assert.Equal(t, "true", must(CreateNodeAttribute("vetted"))(SelectedNode{
 		Vetted: true,
 	}))
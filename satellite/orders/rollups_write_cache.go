@@ -114,6 +114,13 @@ func (cache *RollupsWriteCache) Flush(ctx context.Context) {
 	cache.flush(ctx, pendingRollups)
 }
 
+// Drain flushes the cached rollups to the database. It's called during
+// shutdown, after the endpoints stopped accepting new requests.
+func (cache *RollupsWriteCache) Drain(ctx context.Context) error {
+	cache.Flush(ctx)
+	return nil
+}
+
 // CloseAndFlush flushes anything in the cache and marks the cache as stopped.
 func (cache *RollupsWriteCache) CloseAndFlush(ctx context.Context) error {
 	cache.mu.Lock()
@@ -12,6 +12,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/pb"
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
@@ -117,3 +118,54 @@ func TestCheckIn(t *testing.T) {
 		infoCheck("Within wait period - changed: FreeDisk", now, now, lastFail)
 	})
 }
+
+func TestCheckInASN(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(logger *zap.Logger, index int, config *satellite.Config) {
+				config.Overlay.GeoIP.MockAutonomousSystems = []string{"64512:Example Hosting", "64513:Other Networks"}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		nodeInfo := overlay.NodeCheckInInfo{
+			NodeID:     testrand.NodeID(),
+			Address:    &pb.NodeAddress{Address: "127.0.1.0"},
+			LastNet:    "127.0.1",
+			LastIPPort: "127.0.1.0:8080",
+			IsUp:       true,
+			Operator: &pb.NodeOperator{
+				Wallet:         "0x" + strings.Repeat("00", 20),
+				Email:          "abc123@mail.test",
+				WalletFeatures: []string{},
+			},
+			Capacity: &pb.NodeCapacity{},
+			Version:  &pb.NodeVersion{Version: "v1.0.0"},
+		}
+
+		check := func(asn uint32, org string) {
+			dossier, err := sat.Overlay.Service.Get(ctx, nodeInfo.NodeID)
+			require.NoError(t, err)
+			require.Equal(t, asn, dossier.ASN)
+			require.Equal(t, org, dossier.ASNOrg)
+
+			nodes, err := sat.Overlay.DB.GetParticipatingNodes(ctx, storj.NodeIDList{nodeInfo.NodeID}, time.Hour, 0)
+			require.NoError(t, err)
+			require.Len(t, nodes, 1)
+			require.Equal(t, asn, nodes[0].ASN)
+			require.Equal(t, org, nodes[0].ASNOrg)
+		}
+
+		_, err := sat.Overlay.Service.UpdateCheckIn(ctx, nodeInfo, time.Now())
+		require.NoError(t, err)
+		check(64512, "Example Hosting")
+
+		// the node moved to a different network within the wait period.
+		nodeInfo.LastIPPort = "127.0.1.1:8080"
+		_, err = sat.Overlay.Service.UpdateCheckIn(ctx, nodeInfo, time.Now())
+		require.NoError(t, err)
+		check(64513, "Other Networks")
+	})
+}
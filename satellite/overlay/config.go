@@ -58,8 +58,10 @@ type NodeSelectionConfig struct {
 
 // GeoIPConfig is a configuration struct that helps configure the GeoIP lookup features on the satellite.
 type GeoIPConfig struct {
-	DB            string   `help:"the location of the maxmind database containing geoip country information"`
-	MockCountries []string `help:"a mock list of countries the satellite will attribute to nodes (useful for testing)"`
+	DB                    string   `help:"the location of the maxmind database containing geoip country information"`
+	MockCountries         []string `help:"a mock list of countries the satellite will attribute to nodes (useful for testing)"`
+	ASNDatabase           string   `help:"the location of the maxmind ASN database, or of a CSV file with network,asn,organization rows, used to resolve the network operator of nodes"`
+	MockAutonomousSystems []string `help:"a mock list of autonomous systems (asn:organization) the satellite will attribute to nodes (useful for testing)"`
 }
 
 func (aost *AsOfSystemTimeConfig) isValid() error {
@@ -120,6 +120,11 @@ func (m *Mockdb) ActiveNodesPieceCounts(ctx context.Context) (pieceCounts map[st
 	panic("implement me")
 }
 
+// ActiveNodesWithMinimumVersion satisfies nodeevents.DB interface.
+func (m *Mockdb) ActiveNodesWithMinimumVersion(ctx context.Context, minimum version.SemVer) (nodeIDs storj.NodeIDList, err error) {
+	panic("implement me")
+}
+
 // UpdatePieceCounts satisfies nodeevents.DB interface.
 func (m *Mockdb) UpdatePieceCounts(ctx context.Context, pieceCounts map[storj.NodeID]int64) (err error) {
 	panic("implement me")
@@ -96,6 +96,9 @@ type DB interface {
 	// ActiveNodesPieceCounts returns a map of node IDs to piece counts from the db.
 	// Returns only pieces for nodes that are not disqualified.
 	ActiveNodesPieceCounts(ctx context.Context) (pieceCounts map[storj.NodeID]int64, err error)
+	// ActiveNodesWithMinimumVersion returns the IDs of nodes that are not disqualified and are not
+	// exiting, which run at least the given version.
+	ActiveNodesWithMinimumVersion(ctx context.Context, minimum version.SemVer) (nodeIDs storj.NodeIDList, err error)
 	// UpdatePieceCounts sets the piece count field for the given node IDs.
 	UpdatePieceCounts(ctx context.Context, pieceCounts map[storj.NodeID]int64) (err error)
 
@@ -202,6 +205,8 @@ type NodeCheckInInfo struct {
 	Capacity                *pb.NodeCapacity
 	Version                 *pb.NodeVersion
 	CountryCode             location.CountryCode
+	ASN                     uint32
+	ASNOrg                  string
 	SoftwareUpdateEmailSent bool
 	VersionBelowMin         bool
 }
@@ -290,6 +295,8 @@ type NodeDossier struct {
 	LastOfflineEmail        *time.Time
 	LastSoftwareUpdateEmail *time.Time
 	CountryCode             location.CountryCode
+	ASN                     uint32
+	ASNOrg                  string
 }
 
 // NodeStats contains statistics about a node.
@@ -338,6 +345,7 @@ type Service struct {
 	config               Config
 
 	GeoIP                  geoip.IPToCountry
+	ASN                    geoip.IPToASN
 	UploadSelectionCache   *UploadSelectionCache
 	DownloadSelectionCache *DownloadSelectionCache
 	LastNetFunc            LastNetFunc
@@ -363,6 +371,18 @@ func NewService(log *zap.Logger, db DB, nodeEvents nodeevents.DB, placements nod
 		}
 	}
 
+	asn, err := geoip.NewMockIPToASN(config.GeoIP.MockAutonomousSystems)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	var ipToASN geoip.IPToASN = asn
+	if config.GeoIP.ASNDatabase != "" {
+		ipToASN, err = geoip.OpenASNDB(config.GeoIP.ASNDatabase)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+
 	defaultSelection := nodeselection.NodeFilters{}
 
 	if len(config.Node.UploadExcludedCountryCodes) > 0 {
@@ -409,6 +429,7 @@ func NewService(log *zap.Logger, db DB, nodeEvents nodeevents.DB, placements nod
 		config:               config,
 
 		GeoIP: geoIP,
+		ASN:   ipToASN,
 
 		UploadSelectionCache:   uploadSelectionCache,
 		DownloadSelectionCache: downloadSelectionCache,
@@ -421,7 +442,7 @@ func NewService(log *zap.Logger, db DB, nodeEvents nodeevents.DB, placements nod
 
 // Close closes resources.
 func (service *Service) Close() error {
-	return service.GeoIP.Close()
+	return errs.Combine(service.GeoIP.Close(), service.ASN.Close())
 }
 
 // Get looks up the provided nodeID from the overlay.
@@ -691,6 +712,7 @@ func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo,
 				zap.Stringer("node_id", node.NodeID),
 				zap.Error(err))
 		}
+		service.lookupASN(&node)
 
 		return CheckInResult{}, service.db.UpdateCheckIn(ctx, node, timestamp, service.config.Node)
 	}
@@ -723,6 +745,7 @@ func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo,
 			zap.Stringer("node_id", node.NodeID),
 			zap.Error(err))
 	}
+	service.lookupASN(&node)
 
 	if service.config.SendNodeEmails && service.config.Node.MinimumVersion != "" {
 		min, err := version.NewSemVer(service.config.Node.MinimumVersion)
@@ -750,7 +773,8 @@ func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo,
 
 	if dbStale || addrChanged || walletChanged || verChanged || spaceChanged ||
 		oldInfo.LastNet != node.LastNet || oldInfo.LastIPPort != node.LastIPPort ||
-		oldInfo.CountryCode != node.CountryCode || node.SoftwareUpdateEmailSent {
+		oldInfo.CountryCode != node.CountryCode || oldInfo.ASN != node.ASN || oldInfo.ASNOrg != node.ASNOrg ||
+		node.SoftwareUpdateEmailSent {
 		err = service.db.UpdateCheckIn(ctx, node, timestamp, service.config.Node)
 		if err != nil {
 			return CheckInResult{}, Error.Wrap(err)
@@ -780,6 +804,20 @@ func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo,
 	return CheckInResult{}, nil
 }
 
+// lookupASN resolves the autonomous system of the node. Failures are only
+// logged, the node keeps checking in without the network operator.
+func (service *Service) lookupASN(node *NodeCheckInInfo) {
+	info, err := service.ASN.LookupASN(node.LastIPPort)
+	if err != nil {
+		mon.Meter("asn_lookup_failed").Mark(1)
+		service.log.Debug("failed to resolve autonomous system for node",
+			zap.String("node_address", node.Address.Address),
+			zap.Stringer("node_id", node.NodeID),
+			zap.Error(err))
+	}
+	node.ASN, node.ASNOrg = info.Number, info.Organization
+}
+
 // DQNodesLastSeenBefore disqualifies nodes who have not been contacted since the cutoff time.
 func (service *Service) DQNodesLastSeenBefore(ctx context.Context, cutoff time.Time, limit int) (count int, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -623,7 +623,7 @@ func (accounts *accounts) ProductCharges(ctx context.Context, userID uuid.UUID,
 
 	charges = make(payments.ProductChargesResponse)
 
-	projects, err := accounts.service.projectsDB.GetOwnActive(ctx, userID)
+	projects, err := accounts.service.getBilledProjects(ctx, userID)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -50,7 +50,7 @@ func TestSignupCouponCodes(t *testing.T) {
 		cache, err := live.OpenCache(ctx, log.Named("cache"), live.Config{StorageBackend: "redis://" + redis.Addr() + "?db=0"})
 		require.NoError(t, err)
 
-		projectUsage := accounting.NewService(log, db.ProjectAccounting(), cache, *sat.API.Metainfo.Metabase, 5*time.Minute, 0, 0, 0, -10*time.Second)
+		projectUsage := accounting.NewService(log, db.ProjectAccounting(), cache, *sat.API.Metainfo.Metabase, 5*time.Minute, time.Hour, 0, 0, 0, -10*time.Second)
 
 		pc := paymentsconfig.Config{
 			UsagePrice: paymentsconfig.ProjectUsagePrice{
@@ -83,6 +83,7 @@ func TestSignupCouponCodes(t *testing.T) {
 				BillingDB:            db.Billing(),
 				ProjectsDB:           db.Console().Projects(),
 				UsersDB:              db.Console().Users(),
+				OrganizationsDB:      db.Console().Organizations(),
 				UsageDB:              db.ProjectAccounting(),
 				RetentionRemainderDB: db.RetentionRemainderCharges(),
 				Analytics:            nil,
@@ -36,6 +36,9 @@ func (creditCards *creditCards) List(ctx context.Context, userID uuid.UUID) (car
 
 	customerID, err := creditCards.service.db.Customers().GetCustomerID(ctx, userID)
 	if err != nil {
+		if errors.Is(err, ErrNoCustomer) {
+			return nil, payments.ErrAccountNotSetup.Wrap(err)
+		}
 		return nil, Error.Wrap(err)
 	}
 
@@ -311,6 +311,9 @@ func (invoices *invoices) List(ctx context.Context, userID uuid.UUID) (invoicesL
 
 	customerID, err := invoices.service.db.Customers().GetCustomerID(ctx, userID)
 	if err != nil {
+		if errors.Is(err, ErrNoCustomer) {
+			return nil, payments.ErrAccountNotSetup.Wrap(err)
+		}
 		return nil, Error.Wrap(err)
 	}
 
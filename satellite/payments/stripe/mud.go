@@ -18,7 +18,7 @@ import (
 // Module is a mud module definition.
 func Module(ball *mud.Ball) {
 	mud.Provide[*Service](ball, NewService)
-	mud.Provide[ServiceDependencies](ball, func(db DB, walletsDB storjscan.WalletsDB, billingDB billing.TransactionsDB, projectsDB console.Projects, usersDB console.Users,
+	mud.Provide[ServiceDependencies](ball, func(db DB, walletsDB storjscan.WalletsDB, billingDB billing.TransactionsDB, projectsDB console.Projects, usersDB console.Users, organizationsDB console.Organizations,
 		usageDB accounting.ProjectAccounting, retentionRemainderDB accounting.RetentionRemainderDB, analytics *analytics.Service, emission *emission.Service, entitlements *entitlements.Service) ServiceDependencies {
 		return ServiceDependencies{
 			DB:                   db,
@@ -26,6 +26,7 @@ func Module(ball *mud.Ball) {
 			BillingDB:            billingDB,
 			ProjectsDB:           projectsDB,
 			UsersDB:              usersDB,
+			OrganizationsDB:      organizationsDB,
 			UsageDB:              usageDB,
 			RetentionRemainderDB: retentionRemainderDB,
 			Analytics:            analytics,
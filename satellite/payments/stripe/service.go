@@ -67,6 +67,7 @@ type ServiceDependencies struct {
 	BillingDB            billing.TransactionsDB
 	ProjectsDB           console.Projects
 	UsersDB              console.Users
+	OrganizationsDB      console.Organizations
 	UsageDB              accounting.ProjectAccounting
 	RetentionRemainderDB accounting.RetentionRemainderDB
 	Analytics            *analytics.Service
@@ -105,6 +106,7 @@ type Service struct {
 	billingDB            billing.TransactionsDB
 	projectsDB           console.Projects
 	usersDB              console.Users
+	organizationsDB      console.Organizations
 	usageDB              accounting.ProjectAccounting
 	retentionRemainderDB accounting.RetentionRemainderDB
 	analytics            *analytics.Service
@@ -151,6 +153,7 @@ func NewService(
 		billingDB:            deps.BillingDB,
 		projectsDB:           deps.ProjectsDB,
 		usersDB:              deps.UsersDB,
+		organizationsDB:      deps.OrganizationsDB,
 		usageDB:              deps.UsageDB,
 		retentionRemainderDB: deps.RetentionRemainderDB,
 		analytics:            deps.Analytics,
@@ -229,7 +232,7 @@ func (service *Service) processCustomers(ctx context.Context, customers []Custom
 		}
 
 		// We include only active projects in the invoice.
-		projects, err := service.projectsDB.GetOwnActive(ctx, customer.UserID)
+		projects, err := service.getBilledProjects(ctx, customer.UserID)
 		if err != nil {
 			return 0, Error.New("unable to get own projects: %w", err)
 		}
@@ -371,7 +374,7 @@ func (service *Service) InvoiceApplyProjectRecordsGrouped(ctx context.Context, p
 					totalSkipped.Add(1)
 					return
 				}
-				projects, err := service.projectsDB.GetOwnActive(ctx, c.UserID)
+				projects, err := service.getBilledProjects(ctx, c.UserID)
 				if err != nil {
 					addErr(&mu, err)
 					return
@@ -2212,10 +2215,10 @@ func (service *Service) payInvoicesWithTokenBalance(ctx context.Context, cusID s
 // mustSkipUser checks whether a user should be skipped based on their status and tier.
 // It returns true if any of the following conditions are met:
 // 1. The user has requested deletion and their final invoice has been generated.
-// 2. The user's status is neither 'Active' nor 'UserRequestedDeletion'.
+// 2. The user's status is neither 'Active', 'Deactivated' nor 'UserRequestedDeletion'.
 // 3. The user is not on a paid tier.
 func (service *Service) mustSkipUser(ctx context.Context, userID uuid.UUID) (*console.User, bool, error) {
-	user, err := service.usersDB.Get(ctx, userID)
+	user, err := service.getBillingUser(ctx, userID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, true, nil
@@ -2224,10 +2227,92 @@ func (service *Service) mustSkipUser(ctx context.Context, userID uuid.UUID) (*co
 	}
 
 	return user, (user.Status == console.UserRequestedDeletion && user.FinalInvoiceGenerated) ||
-		(user.Status != console.Active && user.Status != console.UserRequestedDeletion) ||
+		(user.Status != console.Active && user.Status != console.Deactivated && user.Status != console.UserRequestedDeletion) ||
 		!user.IsPaid(), nil
 }
 
+// getBillingUser returns the user whose account the customer is billed under.
+// The customer of an organization is billed under the organization's owner.
+func (service *Service) getBillingUser(ctx context.Context, userID uuid.UUID) (*console.User, error) {
+	user, err := service.usersDB.Get(ctx, userID)
+	if err == nil || !errors.Is(err, sql.ErrNoRows) || service.organizationsDB == nil {
+		return user, err
+	}
+
+	organization, orgErr := service.organizationsDB.Get(ctx, userID)
+	if orgErr != nil {
+		if console.ErrNoOrganization.Has(orgErr) {
+			return nil, err
+		}
+		return nil, orgErr
+	}
+
+	return service.usersDB.Get(ctx, organization.OwnerID)
+}
+
+// getBilledProjects returns the active projects billed to the customer. The
+// customer of an organization is billed for the projects of the organization.
+// The projects of organizations having their own customer are excluded from
+// the projects billed to their owner.
+func (service *Service) getBilledProjects(ctx context.Context, userID uuid.UUID) (_ []console.Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if service.organizationsDB == nil {
+		return service.projectsDB.GetOwnActive(ctx, userID)
+	}
+
+	organization, err := service.organizationsDB.Get(ctx, userID)
+	if err == nil {
+		projectIDs, err := service.organizationsDB.GetProjectIDs(ctx, organization.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		var projects []console.Project
+		for _, projectID := range projectIDs {
+			project, err := service.projectsDB.Get(ctx, projectID)
+			if err != nil {
+				return nil, err
+			}
+			if project.Status != nil && *project.Status != console.ProjectActive {
+				continue
+			}
+			projects = append(projects, *project)
+		}
+		return projects, nil
+	} else if !console.ErrNoOrganization.Has(err) {
+		return nil, err
+	}
+
+	ownProjects, err := service.projectsDB.GetOwnActive(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var projects []console.Project
+	for _, project := range ownProjects {
+		organization, err := service.organizationsDB.GetByProjectID(ctx, project.ID)
+		if err != nil {
+			if console.ErrNoOrganization.Has(err) {
+				projects = append(projects, project)
+				continue
+			}
+			return nil, err
+		}
+
+		_, err = service.db.Customers().GetCustomerID(ctx, organization.ID)
+		if err != nil {
+			if errors.Is(err, ErrNoCustomer) {
+				projects = append(projects, project)
+				continue
+			}
+			return nil, err
+		}
+	}
+
+	return projects, nil
+}
+
 // projectUsagePrice represents pricing for project usage.
 type projectUsagePrice struct {
 	Storage  decimal.Decimal
@@ -2278,7 +2363,7 @@ func (service *Service) TestSetSkuEnabled(enabled bool) {
 
 // getFromToDates returns from/to date values used for data usage calculations depending on users upgrade time and status.
 func (service *Service) getFromToDates(ctx context.Context, userID uuid.UUID, start, end time.Time) (time.Time, time.Time, error) {
-	user, err := service.usersDB.Get(ctx, userID)
+	user, err := service.getBillingUser(ctx, userID)
 	if err != nil {
 		return time.Time{}, time.Time{}, err
 	}
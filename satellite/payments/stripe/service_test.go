@@ -678,6 +678,83 @@ func TestService_InvoiceUserWithManyProjects(t *testing.T) {
 	})
 }
 
+func TestService_InvoiceOrganization(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		payments := satellite.API.Payments
+
+		period := time.Date(time.Now().Year(), time.Now().Month()+1, 20, 0, 0, 0, 0, time.UTC)
+
+		payments.StripeService.SetNow(func() time.Time {
+			return time.Date(period.Year(), period.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		})
+		start := time.Date(period.Year(), period.Month(), 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(period.Year(), period.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+
+		owner, err := satellite.AddUser(ctx, console.CreateUser{
+			FullName: "testuser",
+			Email:    "user@test",
+			Kind:     console.PaidUser,
+		}, 2)
+		require.NoError(t, err)
+
+		ownProject, err := satellite.AddProject(ctx, owner.ID, "own project")
+		require.NoError(t, err)
+		orgProject, err := satellite.AddProject(ctx, owner.ID, "organization project")
+		require.NoError(t, err)
+
+		organization, err := satellite.DB.Console().Organizations().Insert(ctx, console.Organization{
+			ID:      testrand.UUID(),
+			Name:    "Example Org",
+			OwnerID: owner.ID,
+		})
+		require.NoError(t, err)
+		require.NoError(t, satellite.DB.Console().Organizations().AddProject(ctx, organization.ID, orgProject.ID))
+
+		for _, project := range []*console.Project{ownProject, orgProject} {
+			generateProjectStorage(ctx, t, satellite.DB, project.ID, period, period.Add(24*time.Hour), memory.GiB.Int64(), memory.TiB.Int64(), 1)
+		}
+
+		// the projects of an organization without a payment account are billed to the owner.
+		charges, err := payments.Accounts.ProductCharges(ctx, owner.ID, start, end)
+		require.NoError(t, err)
+		require.Len(t, charges, 2)
+
+		_, err = payments.Accounts.Setup(ctx, organization.ID, owner.Email, "")
+		require.NoError(t, err)
+
+		charges, err = payments.Accounts.ProductCharges(ctx, owner.ID, start, end)
+		require.NoError(t, err)
+		require.Len(t, charges, 1)
+		require.Contains(t, charges, ownProject.PublicID)
+
+		charges, err = payments.Accounts.ProductCharges(ctx, organization.ID, start, end)
+		require.NoError(t, err)
+		require.Len(t, charges, 1)
+		require.Contains(t, charges, orgProject.PublicID)
+
+		require.NoError(t, payments.StripeService.PrepareInvoiceProjectRecords(ctx, period))
+		require.NoError(t, payments.StripeService.InvoiceApplyProjectRecordsGrouped(ctx, period))
+		require.NoError(t, payments.StripeService.CreateInvoices(ctx, period))
+
+		ownerCustomerID, err := satellite.DB.StripeCoinPayments().Customers().GetCustomerID(ctx, owner.ID)
+		require.NoError(t, err)
+		orgCustomerID, err := satellite.DB.StripeCoinPayments().Customers().GetCustomerID(ctx, organization.ID)
+		require.NoError(t, err)
+
+		// the organization's projects are billed on its own invoice.
+		invoiced := make(map[string]int)
+		itr := payments.StripeClient.Invoices().List(&stripe.InvoiceListParams{})
+		for itr.Next() {
+			invoiced[itr.Invoice().Customer.ID]++
+		}
+		require.NoError(t, itr.Err())
+		require.Equal(t, map[string]int{ownerCustomerID: 1, orgCustomerID: 1}, invoiced)
+	})
+}
+
 func TestService_FinalizeInvoices(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
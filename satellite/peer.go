@@ -26,6 +26,7 @@ import (
 	"storj.io/storj/satellite/accounting/rolluparchive"
 	"storj.io/storj/satellite/accounting/tally"
 	backoffice "storj.io/storj/satellite/admin"
+	"storj.io/storj/satellite/admin/approvals"
 	"storj.io/storj/satellite/admin/changehistory"
 	"storj.io/storj/satellite/analytics"
 	"storj.io/storj/satellite/attribution"
@@ -53,6 +54,7 @@ import (
 	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/jobq"
 	"storj.io/storj/satellite/kms"
+	"storj.io/storj/satellite/kms/passphraserotation"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/mailservice/hubspotmails"
 	"storj.io/storj/satellite/mailservice/simulate"
@@ -60,6 +62,7 @@ import (
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
+	"storj.io/storj/satellite/metainfo/lifecycledeletion"
 	"storj.io/storj/satellite/nodeapiversion"
 	"storj.io/storj/satellite/nodeevents"
 	"storj.io/storj/satellite/nodeselection"
@@ -130,6 +133,8 @@ type DB interface {
 	Console() console.DB
 	// AdminChangeHistory returns the database for storing admin change history.
 	AdminChangeHistory() changehistory.DB
+	// AdminApprovals returns the database for storing the approval requests of admin operations.
+	AdminApprovals() approvals.DB
 	// OIDC returns the database for OIDC resources.
 	OIDC() oidc.DB
 	// Orders returns database for orders
@@ -217,8 +222,9 @@ type Config struct {
 	RangedLoop rangedloop.Config
 	Durability durability.Config
 
-	ExpiredDeletion expireddeletion.Config
-	ZombieDeletion  zombiedeletion.Config
+	ExpiredDeletion   expireddeletion.Config
+	ZombieDeletion    zombiedeletion.Config
+	LifecycleDeletion lifecycledeletion.Config
 
 	Tally            tally.Config
 	NodeTally        nodetally.Config
@@ -258,7 +264,8 @@ type Config struct {
 
 	DurabilityReport durability.ReportConfig
 
-	KeyManagement kms.Config
+	KeyManagement         kms.Config
+	KeyManagementRotation passphraserotation.Config
 
 	SSO sso.Config
 
@@ -4,6 +4,10 @@
 package checker
 
 import (
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/shared/modular/config"
 	"storj.io/storj/shared/mud"
 )
@@ -11,4 +15,18 @@ import (
 // Module is a mud.Ball module definition.
 func Module(ball *mud.Ball) {
 	config.RegisterConfig[Config](ball, "checker")
+	mud.Provide[Health](ball, func(overlay *overlay.Service, config Config) (Health, error) {
+		switch config.HealthScore {
+		case "probability":
+			reliabilityCache := NewReliabilityCache(overlay, config.ReliabilityCacheStaleness, config.OnlineWindow)
+			return NewProbabilityHealth(config.NodeFailureRate, reliabilityCache), nil
+		case "normalized":
+			return NewNormalizedHealth(), nil
+		default:
+			return nil, errs.New("invalid health score: %q", config.HealthScore)
+		}
+	})
+	mud.Provide[*Observer](ball, NewObserver)
+	mud.Implementation[[]rangedloop.Observer, *Observer](ball)
+	mud.Tag[*Observer, mud.Optional](ball, mud.Optional{})
 }
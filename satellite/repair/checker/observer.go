@@ -56,6 +56,11 @@ type Observer struct {
 
 	mu             sync.Mutex
 	statsCollector map[redundancyStyle]*observerRSStats
+
+	// buffers are the insert buffers of the running forks.
+	buffersMu sync.Mutex
+	buffers   map[*queue.InsertBuffer]struct{}
+	draining  bool
 }
 
 type redundancyStyle struct {
@@ -94,6 +99,7 @@ func NewObserver(logger *zap.Logger, repairQueue queue.RepairQueue, overlay *ove
 		placements:               placements,
 		health:                   health,
 		statsCollector:           make(map[redundancyStyle]*observerRSStats),
+		buffers:                  make(map[*queue.InsertBuffer]struct{}),
 	}
 }
 
@@ -121,8 +127,48 @@ func (observer *Observer) getNodesEstimate(ctx context.Context) (int, error) {
 	return totalNumNodes, nil
 }
 
-func (observer *Observer) createInsertBuffer() *queue.InsertBuffer {
-	return queue.NewInsertBuffer(observer.repairQueue, observer.repairQueueBatchSize)
+func (observer *Observer) createInsertBuffer(ctx context.Context) (*queue.InsertBuffer, error) {
+	buffer := queue.NewInsertBuffer(observer.repairQueue, observer.repairQueueBatchSize)
+
+	observer.buffersMu.Lock()
+	defer observer.buffersMu.Unlock()
+
+	if observer.draining {
+		if err := buffer.Drain(ctx); err != nil {
+			return nil, err
+		}
+	}
+	observer.buffers[buffer] = struct{}{}
+	return buffer, nil
+}
+
+// releaseInsertBuffer forgets the insert buffer of a joined fork.
+func (observer *Observer) releaseInsertBuffer(buffer *queue.InsertBuffer) {
+	observer.buffersMu.Lock()
+	defer observer.buffersMu.Unlock()
+
+	delete(observer.buffers, buffer)
+}
+
+// Drain inserts the injured segments buffered by the running forks into the repair queue,
+// and makes the forks insert the segments found afterwards without buffering, so they
+// aren't lost when the ranged loop is canceled during shutdown.
+func (observer *Observer) Drain(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	observer.buffersMu.Lock()
+	observer.draining = true
+	buffers := make([]*queue.InsertBuffer, 0, len(observer.buffers))
+	for buffer := range observer.buffers {
+		buffers = append(buffers, buffer)
+	}
+	observer.buffersMu.Unlock()
+
+	var group errs.Group
+	for _, buffer := range buffers {
+		group.Add(buffer.Drain(ctx))
+	}
+	return Error.Wrap(group.Err())
 }
 
 // TestingCompareInjuredSegmentIDs compares stream id of injured segment.
@@ -171,7 +217,11 @@ func (observer *Observer) Start(ctx context.Context, startTime time.Time) (err e
 func (observer *Observer) Fork(ctx context.Context) (_ rangedloop.Partial, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	return newObserverFork(observer), nil
+	buffer, err := observer.createInsertBuffer(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return newObserverFork(observer, buffer), nil
 }
 
 // Join is called after the chunk for Partial is done.
@@ -187,6 +237,7 @@ func (observer *Observer) Join(ctx context.Context, partial rangedloop.Partial)
 	if err := repPartial.repairQueue.Flush(ctx); err != nil {
 		return Error.Wrap(err)
 	}
+	observer.releaseInsertBuffer(repPartial.repairQueue)
 
 	for rs, partialStats := range repPartial.rsStats {
 		observer.statsCollector[rs].iterationAggregates.combine(partialStats.iterationAggregates)
@@ -292,10 +343,10 @@ type observerFork struct {
 }
 
 // newObserverFork creates new observer partial instance.
-func newObserverFork(observer *Observer) rangedloop.Partial {
+func newObserverFork(observer *Observer, repairQueue *queue.InsertBuffer) rangedloop.Partial {
 	// we can only share thread-safe objects.
 	return &observerFork{
-		repairQueue:              observer.createInsertBuffer(),
+		repairQueue:              repairQueue,
 		nodesCache:               observer.nodesCache,
 		rsStats:                  make(map[redundancyStyle]*partialRSStats),
 		repairThresholdOverrides: observer.repairThresholdOverrides,
@@ -5,6 +5,7 @@ package queue
 
 import (
 	"context"
+	"sync"
 
 	"github.com/spacemonkeygo/monkit/v3"
 )
@@ -12,11 +13,15 @@ import (
 var mon = monkit.Package()
 
 // InsertBuffer exposes a synchronous API to buffer a batch of segments
-// and insert them at once. Not threadsafe. Call Flush() before discarding.
+// and insert them at once. Call Flush() or Drain() before discarding.
 type InsertBuffer struct {
 	queue     RepairQueue
 	batchSize int
 
+	mu sync.Mutex
+	// drained is set by Drain, after that the segments are inserted without buffering.
+	drained bool
+
 	batch []*InjuredSegment
 	// newInsertCallbacks contains callback called when the InjuredSegment
 	// is flushed to the queue and it is determined that it wasn't already queued for repair.
@@ -50,20 +55,47 @@ func (r *InsertBuffer) Insert(
 ) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	r.batch = append(r.batch, segment)
 	r.newInsertCallbacks[segment] = newInsertCallback
 
-	if len(r.batch) < r.batchSize {
+	if len(r.batch) < r.batchSize && !r.drained {
 		return nil
 	}
 
-	return r.Flush(ctx)
+	return r.flush(ctx)
 }
 
 // Flush inserts the remaining segments into the database.
 func (r *InsertBuffer) Flush(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.flush(ctx)
+}
+
+// Drain inserts the remaining segments into the database, and makes the following
+// inserts synchronous. It's called during shutdown and may be called concurrently
+// with Insert.
+func (r *InsertBuffer) Drain(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.drained = true
+	return r.flush(ctx)
+}
+
+func (r *InsertBuffer) flush(ctx context.Context) error {
+	if len(r.batch) == 0 {
+		return nil
+	}
+
 	newlyInsertedSegments, err := r.queue.InsertBatch(ctx, r.batch)
 	if err != nil {
 		return err
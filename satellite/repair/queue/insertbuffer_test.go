@@ -50,6 +50,31 @@ func TestInsertBufferNoCallback(t *testing.T) {
 	})
 }
 
+func TestInsertBufferDrain(t *testing.T) {
+	repairqueuetest.Run(t, func(ctx *testcontext.Context, t *testing.T, repairQueue queue.RepairQueue) {
+		insertBuffer := queue.NewInsertBuffer(repairQueue, 10)
+
+		err := insertBuffer.Insert(ctx, createInjuredSegment(), nil)
+		require.NoError(t, err)
+		count, err := repairQueue.Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 0, count)
+
+		// draining inserts the buffered segments.
+		require.NoError(t, insertBuffer.Drain(ctx))
+		count, err = repairQueue.Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		// after draining the segments aren't buffered anymore.
+		err = insertBuffer.Insert(ctx, createInjuredSegment(), nil)
+		require.NoError(t, err)
+		count, err = repairQueue.Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+	})
+}
+
 func TestInsertBufferSingleUniqueObject(t *testing.T) {
 	repairqueuetest.Run(t, func(ctx *testcontext.Context, t *testing.T, repairQueue queue.RepairQueue) {
 		insertBuffer := queue.NewInsertBuffer(repairQueue, 1)
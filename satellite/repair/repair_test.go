@@ -208,6 +208,193 @@ func testDataRepair(t *testing.T, inMemoryRepair bool, hashAlgo pb.PieceHashAlgo
 	})
 }
 
+// TestDelegatedDataRepair checks that the repair is done by a trusted storage
+// node when delegated repair is enabled.
+func TestDelegatedDataRepair(t *testing.T) {
+	const (
+		minThreshold     = 3
+		successThreshold = 7
+	)
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 14,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.ReconfigureRS(minThreshold, 5, successThreshold, 9),
+			StorageNode: func(index int, config *storagenode.Config) {
+				config.DelegatedRepair.Enabled = true
+				config.DelegatedRepair.MaxConcurrent = 1
+				config.DelegatedRepair.DialTimeout = 5 * time.Second
+				config.DelegatedRepair.DownloadTimeout = time.Minute
+				config.DelegatedRepair.UploadTimeout = time.Minute
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		uplinkPeer := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+		satellite.Audit.Worker.Loop.Pause()
+		satellite.RangedLoop.RangedLoop.Service.Loop.Stop()
+		satellite.Repair.Repairer.Loop.Pause()
+
+		testData := testrand.Bytes(8 * memory.KiB)
+		err := uplinkPeer.Upload(ctx, satellite, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		segment := getRemoteSegment(ctx, t, satellite)
+
+		// kill the nodes of all pieces, except the minimum required.
+		killed := map[storj.NodeID]bool{}
+		for _, piece := range segment.Pieces[minThreshold:] {
+			killed[piece.StorageNode] = true
+			require.NoError(t, planet.StopNodeAndUpdate(ctx, planet.FindNode(piece.StorageNode)))
+		}
+
+		// the delegate is a node without a piece of the segment.
+		var delegate *testplanet.StorageNode
+		for _, node := range planet.StorageNodes {
+			if !killed[node.ID()] && !slices.ContainsFunc(segment.Pieces, func(piece metabase.Piece) bool {
+				return piece.StorageNode == node.ID()
+			}) {
+				delegate = node
+				break
+			}
+		}
+		require.NotNil(t, delegate)
+
+		placements, err := satellite.Config.Placement.Parse(satellite.Config.Overlay.Node.CreateDefaultPlacement, nil)
+		require.NoError(t, err)
+		satellite.Repairer.SegmentRepairer.SetDelegator(repairer.NewDelegator(
+			zaptest.NewLogger(t),
+			satellite.Dialer,
+			satellite.Overlay.Service,
+			satellite.DB.PeerIdentities(),
+			placements,
+			repairer.DelegatedRepairConfig{
+				Enabled: true,
+				Nodes:   storj.NodeIDList{delegate.ID()},
+				Timeout: time.Minute,
+			},
+			satellite.Config.Repairer.OnlineWindow,
+		))
+
+		// the satellite downloads the pieces only when the delegated repair fails.
+		satellite.Repairer.SegmentRepairer.OnTestingPiecesReportHook = func(report repairer.FetchResultReport) {
+			t.Error("segment is repaired by the satellite")
+		}
+
+		_, err = satellite.RangedLoop.RangedLoop.Service.RunOnce(ctx)
+		require.NoError(t, err)
+
+		satellite.Repair.Repairer.Loop.TriggerWait()
+		require.NoError(t, satellite.Repair.Repairer.WaitForPendingRepairs(ctx))
+
+		segmentAfter := getRemoteSegment(ctx, t, satellite)
+		require.GreaterOrEqual(t, len(segmentAfter.Pieces), successThreshold)
+		for _, piece := range segmentAfter.Pieces {
+			require.NotContains(t, killed, piece.StorageNode)
+		}
+
+		newData, err := uplinkPeer.Download(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+		require.Equal(t, testData, newData)
+	})
+}
+
+// TestDelegatedDataRepairFallback checks that the satellite repairs the segment
+// with new order limits, when the delegate fails after using the order limits.
+func TestDelegatedDataRepairFallback(t *testing.T) {
+	const (
+		minThreshold     = 3
+		successThreshold = 7
+	)
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 14,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.ReconfigureRS(minThreshold, 5, successThreshold, 9),
+			StorageNode: func(index int, config *storagenode.Config) {
+				config.DelegatedRepair.Enabled = true
+				config.DelegatedRepair.MaxConcurrent = 1
+				config.DelegatedRepair.DialTimeout = 5 * time.Second
+				config.DelegatedRepair.DownloadTimeout = time.Minute
+				// the delegate downloads the pieces, but can't upload the new ones.
+				config.DelegatedRepair.UploadTimeout = time.Nanosecond
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		uplinkPeer := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+		satellite.Audit.Worker.Loop.Pause()
+		satellite.RangedLoop.RangedLoop.Service.Loop.Stop()
+		satellite.Repair.Repairer.Loop.Pause()
+
+		testData := testrand.Bytes(8 * memory.KiB)
+		err := uplinkPeer.Upload(ctx, satellite, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		segment := getRemoteSegment(ctx, t, satellite)
+
+		// kill the nodes of all pieces, except the minimum required.
+		killed := map[storj.NodeID]bool{}
+		for _, piece := range segment.Pieces[minThreshold:] {
+			killed[piece.StorageNode] = true
+			require.NoError(t, planet.StopNodeAndUpdate(ctx, planet.FindNode(piece.StorageNode)))
+		}
+
+		var delegate *testplanet.StorageNode
+		for _, node := range planet.StorageNodes {
+			if !killed[node.ID()] && !slices.ContainsFunc(segment.Pieces, func(piece metabase.Piece) bool {
+				return piece.StorageNode == node.ID()
+			}) {
+				delegate = node
+				break
+			}
+		}
+		require.NotNil(t, delegate)
+
+		placements, err := satellite.Config.Placement.Parse(satellite.Config.Overlay.Node.CreateDefaultPlacement, nil)
+		require.NoError(t, err)
+		satellite.Repairer.SegmentRepairer.SetDelegator(repairer.NewDelegator(
+			zaptest.NewLogger(t),
+			satellite.Dialer,
+			satellite.Overlay.Service,
+			satellite.DB.PeerIdentities(),
+			placements,
+			repairer.DelegatedRepairConfig{
+				Enabled: true,
+				Nodes:   storj.NodeIDList{delegate.ID()},
+				Timeout: time.Minute,
+			},
+			satellite.Config.Repairer.OnlineWindow,
+		))
+
+		var report repairer.FetchResultReport
+		satellite.Repairer.SegmentRepairer.OnTestingPiecesReportHook = func(pieces repairer.FetchResultReport) {
+			report = pieces
+		}
+
+		_, err = satellite.RangedLoop.RangedLoop.Service.RunOnce(ctx)
+		require.NoError(t, err)
+
+		satellite.Repair.Repairer.Loop.TriggerWait()
+		require.NoError(t, satellite.Repair.Repairer.WaitForPendingRepairs(ctx))
+
+		// the order limits used by the delegate would be rejected by the nodes.
+		require.Len(t, report.Successful, minThreshold)
+		require.Empty(t, report.Failed)
+
+		segmentAfter := getRemoteSegment(ctx, t, satellite)
+		require.GreaterOrEqual(t, len(segmentAfter.Pieces), successThreshold)
+
+		newData, err := uplinkPeer.Download(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+		require.Equal(t, testData, newData)
+	})
+}
+
 // TestDataRepairPendingObject does the following:
 //   - Starts new multipart upload with one part of test data. Does not complete the multipart upload.
 //   - Kills some nodes and disqualifies 1
@@ -0,0 +1,308 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/spf13/pflag"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+// PlacementBandwidth is a configurable, comma separated list of placement:size pairs.
+type PlacementBandwidth struct {
+	Limits map[storj.PlacementConstraint]memory.Size
+}
+
+// String implements pflag.Value.
+func (p *PlacementBandwidth) String() string {
+	placements := make([]storj.PlacementConstraint, 0, len(p.Limits))
+	for placement := range p.Limits {
+		placements = append(placements, placement)
+	}
+	slices.Sort(placements)
+
+	var s []string
+	for _, placement := range placements {
+		s = append(s, fmt.Sprintf("%d:%s", placement, p.Limits[placement].String()))
+	}
+	return strings.Join(s, ",")
+}
+
+// Set implements pflag.Value.
+func (p *PlacementBandwidth) Set(s string) error {
+	limits := map[storj.PlacementConstraint]memory.Size{}
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		placementStr, sizeStr, ok := strings.Cut(part, ":")
+		if !ok {
+			return errs.New("placement bandwidth should be in placement:size format: %s", part)
+		}
+		placement, err := strconv.Atoi(strings.TrimSpace(placementStr))
+		if err != nil {
+			return errs.New("invalid placement in %q: %w", part, err)
+		}
+		var size memory.Size
+		if err := size.Set(strings.TrimSpace(sizeStr)); err != nil {
+			return errs.New("invalid size in %q: %w", part, err)
+		}
+		limits[storj.PlacementConstraint(placement)] = size
+	}
+	p.Limits = limits
+	return nil
+}
+
+// Type implements pflag.Value.
+func (p PlacementBandwidth) Type() string {
+	return "placement-bandwidth"
+}
+
+var _ pflag.Value = &PlacementBandwidth{}
+
+// Budgets limits the repair traffic (bytes/second) globally and per placement.
+//
+// Every budget is a token bucket which holds at most one second of traffic.
+// A repair is allowed to start while the bucket is not empty, and it is charged
+// with its estimated traffic before the worker starts, so the bucket can go into
+// debt, which is paid back before the next repair of the placement.
+type Budgets struct {
+	mu         sync.Mutex
+	nowFn      func() time.Time
+	global     *budget
+	placements map[storj.PlacementConstraint]*budget
+}
+
+// budget is a token bucket for a single limit.
+type budget struct {
+	rate    float64
+	tokens  float64
+	updated time.Time
+}
+
+// NewBudgets creates the bandwidth budgets from the repairer configuration.
+func NewBudgets(config Config) *Budgets {
+	budgets := &Budgets{
+		nowFn:      time.Now,
+		placements: map[storj.PlacementConstraint]*budget{},
+	}
+	now := budgets.nowFn()
+	if config.BandwidthBudget > 0 {
+		budgets.global = newBudget(config.BandwidthBudget, now)
+	}
+	for placement, limit := range config.PlacementBandwidthBudget.Limits {
+		if limit > 0 {
+			budgets.placements[placement] = newBudget(limit, now)
+		}
+	}
+	return budgets
+}
+
+func newBudget(limit memory.Size, now time.Time) *budget {
+	return &budget{
+		rate:    float64(limit),
+		tokens:  float64(limit),
+		updated: now,
+	}
+}
+
+// refill adds the tokens accumulated since the last update.
+func (b *budget) refill(now time.Time) {
+	if elapsed := now.Sub(b.updated); elapsed > 0 {
+		b.tokens = math.Min(b.rate, b.tokens+elapsed.Seconds()*b.rate)
+		b.updated = now
+	}
+}
+
+// wait returns the time until the budget is available again.
+func (b *budget) wait() time.Duration {
+	if b.tokens > 0 {
+		return 0
+	}
+	return time.Duration((-b.tokens/b.rate)*float64(time.Second)) + time.Millisecond
+}
+
+// Limited returns true when any budget is configured.
+func (budgets *Budgets) Limited() bool {
+	return budgets.global != nil || len(budgets.placements) > 0
+}
+
+// Select returns the placement filters for the next queue pop, where the
+// placements without budget are left out. Wait is the time until the next
+// depleted budget is available again, and ok is false when no placement can be
+// repaired at the moment.
+func (budgets *Budgets) Select(included, excluded []storj.PlacementConstraint) (_, _ []storj.PlacementConstraint, wait time.Duration, ok bool) {
+	budgets.mu.Lock()
+	defer budgets.mu.Unlock()
+
+	now := budgets.nowFn()
+	if budgets.global != nil {
+		budgets.global.refill(now)
+		if wait := budgets.global.wait(); wait > 0 {
+			return nil, nil, wait, false
+		}
+	}
+
+	var depleted []storj.PlacementConstraint
+	for placement, budget := range budgets.placements {
+		budget.refill(now)
+		if placementWait := budget.wait(); placementWait > 0 {
+			depleted = append(depleted, placement)
+			if wait == 0 || placementWait < wait {
+				wait = placementWait
+			}
+		}
+	}
+	if len(depleted) == 0 {
+		return included, excluded, 0, true
+	}
+	slices.Sort(depleted)
+
+	if len(included) > 0 {
+		var available []storj.PlacementConstraint
+		for _, placement := range included {
+			if !slices.Contains(depleted, placement) {
+				available = append(available, placement)
+			}
+		}
+		return available, excluded, wait, len(available) > 0
+	}
+
+	excludedWithDepleted := slices.Clone(excluded)
+	for _, placement := range depleted {
+		if !slices.Contains(excludedWithDepleted, placement) {
+			excludedWithDepleted = append(excludedWithDepleted, placement)
+		}
+	}
+	return included, excludedWithDepleted, wait, true
+}
+
+// Reserve charges the budgets of the placement with the estimated traffic of a
+// repair, if none of them is depleted. Otherwise, it returns the time until the
+// depleted budgets are available again.
+func (budgets *Budgets) Reserve(placement storj.PlacementConstraint, bytes int64) (wait time.Duration, ok bool) {
+	budgets.mu.Lock()
+	defer budgets.mu.Unlock()
+
+	now := budgets.nowFn()
+	limits := make([]*budget, 0, 2)
+	if budgets.global != nil {
+		limits = append(limits, budgets.global)
+	}
+	if budget, ok := budgets.placements[placement]; ok {
+		limits = append(limits, budget)
+	}
+
+	for _, budget := range limits {
+		budget.refill(now)
+		wait = max(wait, budget.wait())
+	}
+	if wait > 0 {
+		return wait, false
+	}
+	for _, budget := range limits {
+		budget.tokens -= float64(bytes)
+	}
+
+	mon.Counter("repair_budget_charged_bytes", monkit.NewSeriesTag("placement", strconv.Itoa(int(placement)))).Inc(bytes)
+	return 0, true
+}
+
+// repairJob is a segment from the repair queue with the estimated traffic of its repair.
+type repairJob struct {
+	segment queue.InjuredSegment
+	cost    int64
+}
+
+// prioritize orders the jobs by their segment health, increased by their
+// estimated repair traffic (in GiB) multiplied by costWeight. Jobs with lower
+// values are repaired first.
+func prioritize(jobs []repairJob, costWeight float64) {
+	if costWeight == 0 || len(jobs) < 2 {
+		return
+	}
+
+	priority := func(job repairJob) float64 {
+		return job.segment.SegmentHealth + costWeight*float64(job.cost)/float64(memory.GiB)
+	}
+	sort.SliceStable(jobs, func(i, j int) bool {
+		return priority(jobs[i]) < priority(jobs[j])
+	})
+}
+
+// Stats implements monkit.StatSource.
+func (budgets *Budgets) Stats(cb func(key monkit.SeriesKey, field string, val float64)) {
+	budgets.mu.Lock()
+	defer budgets.mu.Unlock()
+
+	now := budgets.nowFn()
+	if budgets.global != nil {
+		budgets.global.refill(now)
+		cb(monkit.NewSeriesKey("repair_budget"), "available_bytes", budgets.global.tokens)
+		cb(monkit.NewSeriesKey("repair_budget"), "limit_bytes", budgets.global.rate)
+	}
+	for placement, budget := range budgets.placements {
+		budget.refill(now)
+		key := monkit.NewSeriesKey("repair_budget").WithTag("placement", strconv.Itoa(int(placement)))
+		cb(key, "available_bytes", budget.tokens)
+		cb(key, "limit_bytes", budget.rate)
+	}
+}
+
+// activeBudgets reports the budgets of the running repairer service. It's
+// registered with monkit only once, because a process may create multiple
+// services (eg. in tests).
+var (
+	activeBudgets         budgetStats
+	activeBudgetsInitOnce sync.Once
+)
+
+// budgetStats implements monkit.StatSource for the budgets of the latest repairer service.
+type budgetStats struct {
+	mu      sync.Mutex
+	budgets *Budgets
+}
+
+// register makes budgets the reported budgets.
+func (stats *budgetStats) register(budgets *Budgets) {
+	stats.mu.Lock()
+	stats.budgets = budgets
+	stats.mu.Unlock()
+
+	activeBudgetsInitOnce.Do(func() {
+		mon.Chain(stats)
+	})
+}
+
+// Stats implements monkit.StatSource.
+func (stats *budgetStats) Stats(cb func(key monkit.SeriesKey, field string, val float64)) {
+	stats.mu.Lock()
+	budgets := stats.budgets
+	stats.mu.Unlock()
+
+	if budgets != nil {
+		budgets.Stats(cb)
+	}
+}
+
+// TestingSetNow allows tests to set the current time of the budgets.
+func (budgets *Budgets) TestingSetNow(nowFn func() time.Time) {
+	budgets.mu.Lock()
+	defer budgets.mu.Unlock()
+	budgets.nowFn = nowFn
+}
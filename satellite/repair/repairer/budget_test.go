@@ -0,0 +1,174 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/structs"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+func TestPlacementBandwidth(t *testing.T) {
+	config := Config{}
+
+	decode := structs.Decode(map[string]string{
+		"placement-bandwidth-budget": "0:100MB, 12:1.5GiB",
+	}, &config)
+	require.NoError(t, decode.Error)
+
+	require.Equal(t, map[storj.PlacementConstraint]memory.Size{
+		0:  100 * memory.MB,
+		12: 1536 * memory.MiB,
+	}, config.PlacementBandwidthBudget.Limits)
+	require.Equal(t, "0:100.00 MB,12:1.5 GiB", config.PlacementBandwidthBudget.String())
+
+	require.Error(t, config.PlacementBandwidthBudget.Set("12"))
+	require.Error(t, config.PlacementBandwidthBudget.Set("x:1MB"))
+}
+
+func TestBudgets(t *testing.T) {
+	now := time.Now()
+
+	t.Run("unlimited", func(t *testing.T) {
+		budgets := NewBudgets(Config{})
+		require.False(t, budgets.Limited())
+
+		_, ok := budgets.Reserve(0, 10*memory.GB.Int64())
+		require.True(t, ok)
+		_, ok = budgets.Reserve(0, 10*memory.GB.Int64())
+		require.True(t, ok)
+		included, excluded, wait, ok := budgets.Select([]storj.PlacementConstraint{1}, []storj.PlacementConstraint{2})
+		require.True(t, ok)
+		require.Zero(t, wait)
+		require.Equal(t, []storj.PlacementConstraint{1}, included)
+		require.Equal(t, []storj.PlacementConstraint{2}, excluded)
+	})
+
+	t.Run("global", func(t *testing.T) {
+		budgets := NewBudgets(Config{BandwidthBudget: memory.MB})
+		budgets.TestingSetNow(func() time.Time { return now })
+		require.True(t, budgets.Limited())
+
+		_, ok := budgets.Reserve(0, 3*memory.MB.Int64())
+		require.True(t, ok)
+		_, _, wait, ok := budgets.Select(nil, nil)
+		require.False(t, ok)
+		require.InDelta(t, 2*time.Second, wait, float64(10*time.Millisecond))
+
+		// the next job has to wait until the debt is paid back
+		wait, ok = budgets.Reserve(0, memory.MB.Int64())
+		require.False(t, ok)
+		require.InDelta(t, 2*time.Second, wait, float64(10*time.Millisecond))
+
+		budgets.TestingSetNow(func() time.Time { return now.Add(3 * time.Second) })
+		_, _, wait, ok = budgets.Select(nil, nil)
+		require.True(t, ok)
+		require.Zero(t, wait)
+	})
+
+	t.Run("placement", func(t *testing.T) {
+		budgets := NewBudgets(Config{PlacementBandwidthBudget: PlacementBandwidth{
+			Limits: map[storj.PlacementConstraint]memory.Size{
+				1: memory.MB,
+				2: memory.MB,
+			},
+		}})
+		budgets.TestingSetNow(func() time.Time { return now })
+
+		_, ok := budgets.Reserve(1, 2*memory.MB.Int64())
+		require.True(t, ok)
+		_, ok = budgets.Reserve(3, 2*memory.MB.Int64())
+		require.True(t, ok)
+
+		// placements without budget are not limited
+		_, ok = budgets.Reserve(3, 2*memory.MB.Int64())
+		require.True(t, ok)
+		_, ok = budgets.Reserve(1, memory.MB.Int64())
+		require.False(t, ok)
+
+		// depleted placements are excluded
+		included, excluded, wait, ok := budgets.Select(nil, []storj.PlacementConstraint{5})
+		require.True(t, ok)
+		require.Positive(t, wait)
+		require.Empty(t, included)
+		require.Equal(t, []storj.PlacementConstraint{5, 1}, excluded)
+
+		// or removed from the included placements
+		included, _, _, ok = budgets.Select([]storj.PlacementConstraint{1, 2}, nil)
+		require.True(t, ok)
+		require.Equal(t, []storj.PlacementConstraint{2}, included)
+
+		_, _, _, ok = budgets.Select([]storj.PlacementConstraint{1}, nil)
+		require.False(t, ok)
+
+		budgets.TestingSetNow(func() time.Time { return now.Add(2 * time.Second) })
+		included, excluded, wait, ok = budgets.Select([]storj.PlacementConstraint{1}, nil)
+		require.True(t, ok)
+		require.Zero(t, wait)
+		require.Equal(t, []storj.PlacementConstraint{1}, included)
+		require.Empty(t, excluded)
+	})
+}
+
+func TestReserveBudget(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	service := &Service{repairer: &SegmentRepairer{
+		budgets: NewBudgets(Config{BandwidthBudget: 10 * memory.MB}),
+	}}
+	job := repairJob{cost: memory.MB.Int64()}
+
+	// the debt is paid back after ~100ms
+	_, ok := service.repairer.budgets.Reserve(0, 11*memory.MB.Int64())
+	require.True(t, ok)
+
+	// the job is dropped, when the budget isn't available before its timeout
+	jobCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	reserved, err := service.reserveBudget(jobCtx, job)
+	require.NoError(t, err)
+	require.False(t, reserved)
+	require.NoError(t, jobCtx.Err(), "the job must be dropped without waiting")
+
+	// otherwise it waits for the budget
+	jobCtx, cancel = context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	reserved, err = service.reserveBudget(jobCtx, job)
+	require.NoError(t, err)
+	require.True(t, reserved)
+}
+
+func TestPrioritize(t *testing.T) {
+	jobs := func() []repairJob {
+		return []repairJob{
+			{segment: queue.InjuredSegment{Placement: 1, SegmentHealth: 1}, cost: 10 * memory.GiB.Int64()},
+			{segment: queue.InjuredSegment{Placement: 1, SegmentHealth: 2}, cost: memory.GiB.Int64()},
+			{segment: queue.InjuredSegment{Placement: 1, SegmentHealth: 3}},
+		}
+	}
+	healths := func(jobs []repairJob) (result []float64) {
+		for _, job := range jobs {
+			result = append(result, job.segment.SegmentHealth)
+		}
+		return result
+	}
+
+	// health only
+	ordered := jobs()
+	prioritize(ordered, 0)
+	require.Equal(t, []float64{1, 2, 3}, healths(ordered))
+
+	// the cost of each job counts, even within the same placement: 1+10*0.5 > 3+0 > 2+1*0.5
+	ordered = jobs()
+	prioritize(ordered, 0.5)
+	require.Equal(t, []float64{2, 3, 1}, healths(ordered))
+}
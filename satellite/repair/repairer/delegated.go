@@ -0,0 +1,218 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/identity"
+	"storj.io/common/pb"
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/storj/private/delegatedrepairpb"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo/pointerverification"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/overlay"
+)
+
+// ErrDelegatedRepair is the error class for the repairs delegated to storage nodes.
+var ErrDelegatedRepair = errs.Class("delegated repair")
+
+// DelegatedRepairConfig contains the configuration of the repairs delegated to
+// trusted storage nodes.
+type DelegatedRepairConfig struct {
+	Enabled bool             `help:"ask trusted storage nodes to download, reconstruct and upload the repaired segments instead of the satellite" default:"false"`
+	Nodes   storj.NodeIDList `help:"comma separated list of the trusted storage node IDs which can repair segments on behalf of the satellite" default:""`
+	Timeout time.Duration    `help:"time limit for a repair delegated to a storage node" default:"10m"`
+}
+
+// DelegatedRepairJob contains everything which is required to delegate the
+// repair of a segment.
+type DelegatedRepairJob struct {
+	Segment          metabase.SegmentForRepair
+	GetLimits        []*pb.AddressedOrderLimit
+	GetPrivateKey    storj.PiecePrivateKey
+	PutLimits        []*pb.AddressedOrderLimit
+	PutPrivateKey    storj.PiecePrivateKey
+	NewRedundancy    storj.RedundancyScheme
+	SuccessfulNeeded int
+}
+
+// DelegatedRepairResult is the verified outcome of a delegated repair.
+type DelegatedRepairResult struct {
+	// SuccessfulNodes are the nodes with valid uploaded pieces, indexed by piece
+	// number, like the result of ECRepairer.Repair.
+	SuccessfulNodes []*pb.Node
+	// FailedPieceNums are the piece numbers of the downloaded pieces which failed
+	// hash verification on the delegate.
+	FailedPieceNums []uint16
+}
+
+// Delegator sends repair jobs to trusted storage nodes and verifies the pieces
+// which are uploaded by them.
+type Delegator struct {
+	log          *zap.Logger
+	dialer       rpc.Dialer
+	overlay      *overlay.Service
+	verification *pointerverification.Service
+	placements   nodeselection.PlacementDefinitions
+	config       DelegatedRepairConfig
+	onlineWindow time.Duration
+
+	next atomic.Uint64
+}
+
+// NewDelegator creates a new delegator.
+func NewDelegator(log *zap.Logger, dialer rpc.Dialer, overlaysvc *overlay.Service, identities overlay.PeerIdentities, placements nodeselection.PlacementDefinitions, config DelegatedRepairConfig, onlineWindow time.Duration) *Delegator {
+	return &Delegator{
+		log:          log,
+		dialer:       dialer,
+		overlay:      overlaysvc,
+		verification: pointerverification.NewService(identities, overlaysvc, nil, false),
+		placements:   placements,
+		config:       config,
+		onlineWindow: onlineWindow,
+	}
+}
+
+// Repair delegates the repair of the segment to one of the trusted nodes, and
+// returns the verified result. It fails when fewer than job.SuccessfulNeeded
+// pieces were uploaded successfully.
+func (delegator *Delegator) Repair(ctx context.Context, job DelegatedRepairJob) (_ *DelegatedRepairResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	node, err := delegator.selectNode(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, delegator.config.Timeout)
+	defer cancel()
+
+	conn, err := delegator.dialer.DialNodeURL(ctx, storj.NodeURL{
+		ID:      node.ID,
+		Address: node.Address.Address,
+	})
+	if err != nil {
+		return nil, ErrDelegatedRepair.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	response, err := delegatedrepairpb.NewDRPCDelegatedRepairClient(conn).Repair(ctx, &delegatedrepairpb.RepairRequest{
+		GetOrders:        job.GetLimits,
+		PrivateKeyForGet: job.GetPrivateKey.Bytes(),
+		PutOrders:        job.PutLimits,
+		PrivateKeyForPut: job.PutPrivateKey.Bytes(),
+		Redundancy:       redundancyToProto(job.Segment.Redundancy),
+		NewRedundancy:    redundancyToProto(job.NewRedundancy),
+		SegmentSize:      int64(job.Segment.EncryptedSize),
+		SuccessfulNeeded: int32(job.SuccessfulNeeded),
+	})
+	if err != nil {
+		return nil, ErrDelegatedRepair.Wrap(err)
+	}
+	if len(response.FailedPieceNums) > 0 {
+		mon.Meter("delegated_repair_failed_piece_hashes").Mark(len(response.FailedPieceNums))
+	}
+
+	successfulNodes, err := delegator.verify(ctx, node.ID, job, response.UploadedPieces)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DelegatedRepairResult{SuccessfulNodes: successfulNodes}
+	for _, pieceNum := range response.FailedPieceNums {
+		// only the pieces which the delegate was allowed to download can fail
+		if pieceNum < 0 || int(pieceNum) >= len(job.GetLimits) || job.GetLimits[pieceNum] == nil {
+			continue
+		}
+		result.FailedPieceNums = append(result.FailedPieceNums, uint16(pieceNum))
+	}
+	return result, nil
+}
+
+// selectNode selects one of the online trusted nodes, which are allowed by the
+// placement of the segment.
+func (delegator *Delegator) selectNode(ctx context.Context, job DelegatedRepairJob) (_ nodeselection.SelectedNode, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodes, err := delegator.overlay.GetParticipatingNodesForRepair(ctx, delegator.config.Nodes, delegator.onlineWindow)
+	if err != nil {
+		return nodeselection.SelectedNode{}, ErrDelegatedRepair.Wrap(err)
+	}
+
+	filter, _ := delegator.placements.CreateFilters(job.Segment.Placement)
+	var eligible []nodeselection.SelectedNode
+	for _, node := range nodes {
+		if node.ID.IsZero() || !node.Online || node.Suspended || node.Exiting {
+			continue
+		}
+		if !filter.Match(&node) {
+			continue
+		}
+		eligible = append(eligible, node)
+	}
+	if len(eligible) == 0 {
+		return nodeselection.SelectedNode{}, ErrDelegatedRepair.New("no eligible node for placement %d", job.Segment.Placement)
+	}
+
+	return eligible[delegator.next.Add(1)%uint64(len(eligible))], nil
+}
+
+// verify checks the pieces uploaded by the delegate, and returns the valid ones.
+func (delegator *Delegator) verify(ctx context.Context, delegate storj.NodeID, job DelegatedRepairJob, uploaded []*pb.SegmentPieceUploadResult) (_ []*pb.Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := delegator.verification.VerifySizes(ctx, job.NewRedundancy, int64(job.Segment.EncryptedSize), uploaded); err != nil {
+		return nil, ErrDelegatedRepair.Wrap(err)
+	}
+
+	originalLimits := make([]*pb.OrderLimit, len(job.PutLimits))
+	for i, limit := range job.PutLimits {
+		originalLimits[i] = limit.GetLimit()
+	}
+
+	valid, invalid, err := delegator.verification.SelectValidPieces(ctx, &identity.PeerIdentity{ID: delegate}, uploaded, originalLimits)
+	if err != nil {
+		return nil, ErrDelegatedRepair.Wrap(err)
+	}
+	for _, piece := range invalid {
+		delegator.log.Debug("invalid piece uploaded by delegated repair",
+			zap.Stringer("delegate", delegate),
+			zap.Stringer("node_id", piece.NodeID),
+			zap.Int32("piece_num", piece.PieceNum),
+			zap.Error(piece.Reason))
+	}
+	mon.Meter("delegated_repair_invalid_pieces").Mark(len(invalid))
+
+	if len(valid) == 0 || len(valid) < job.SuccessfulNeeded {
+		return nil, ErrDelegatedRepair.New("%d valid pieces uploaded by %s, %d needed", len(valid), delegate, job.SuccessfulNeeded)
+	}
+
+	successfulNodes := make([]*pb.Node, len(job.PutLimits))
+	for _, piece := range valid {
+		successfulNodes[piece.PieceNum] = &pb.Node{
+			Id:      piece.NodeId,
+			Address: job.PutLimits[piece.PieceNum].GetStorageNodeAddress(),
+		}
+	}
+	return successfulNodes, nil
+}
+
+func redundancyToProto(redundancy storj.RedundancyScheme) *pb.RedundancyScheme {
+	return &pb.RedundancyScheme{
+		Type:             pb.RedundancyScheme_SchemeType(redundancy.Algorithm),
+		MinReq:           int32(redundancy.RequiredShares),
+		Total:            int32(redundancy.TotalShares),
+		RepairThreshold:  int32(redundancy.RepairShares),
+		SuccessThreshold: int32(redundancy.OptimalShares),
+		ErasureShareSize: redundancy.ShareSize,
+	}
+}
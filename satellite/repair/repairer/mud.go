@@ -23,8 +23,15 @@ func Module(ball *mud.Ball) {
 	mud.Provide[*ECRepairer](ball, func(dialer rpc.Dialer, satelliteSignee signing.Signee, cfg Config) *ECRepairer {
 		return NewECRepairer(dialer, satelliteSignee, cfg.DialTimeout, cfg.DownloadTimeout, cfg.InMemoryRepair, cfg.InMemoryUpload, cfg.DownloadLongTail)
 	})
-	mud.Provide[*SegmentRepairer](ball, func(log *zap.Logger, metabase *metabase.DB, orders *orders.Service, overlay *overlay.Service, reporter audit.Reporter, ecRepairer *ECRepairer, placements nodeselection.PlacementDefinitions, config Config, checkerConfig checker.Config) (*SegmentRepairer, error) {
-		return NewSegmentRepairer(log, metabase, orders, overlay, reporter, ecRepairer, placements, checkerConfig.RepairThresholdOverrides, checkerConfig.RepairTargetOverrides, config)
+	mud.Provide[*SegmentRepairer](ball, func(log *zap.Logger, metabase *metabase.DB, orders *orders.Service, overlaysvc *overlay.Service, reporter audit.Reporter, ecRepairer *ECRepairer, placements nodeselection.PlacementDefinitions, config Config, checkerConfig checker.Config, dialer rpc.Dialer, identities overlay.PeerIdentities) (*SegmentRepairer, error) {
+		repairer, err := NewSegmentRepairer(log, metabase, orders, overlaysvc, reporter, ecRepairer, placements, checkerConfig.RepairThresholdOverrides, checkerConfig.RepairTargetOverrides, config)
+		if err != nil {
+			return nil, err
+		}
+		if config.DelegatedRepair.Enabled {
+			repairer.SetDelegator(NewDelegator(log.Named("delegated-repair"), dialer, overlaysvc, identities, placements, config.DelegatedRepair, config.OnlineWindow))
+		}
+		return repairer, nil
 	})
 	config.RegisterConfig[Config](ball, "repairer")
 	mud.Provide[*Service](ball, NewService)
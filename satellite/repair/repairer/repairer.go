@@ -17,6 +17,7 @@ import (
 	"golang.org/x/sync/semaphore"
 
 	"storj.io/common/context2"
+	"storj.io/common/memory"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
 	"storj.io/storj/satellite/nodeselection"
@@ -69,6 +70,12 @@ type Config struct {
 	IncludedPlacements PlacementList `help:"comma separated placement IDs (numbers), which should checked by the repairer (other placements are ignored)" default:""`
 	ExcludedPlacements PlacementList `help:"comma separated placement IDs (numbers), placements which should be ignored by the repairer" default:""`
 
+	BandwidthBudget          memory.Size        `help:"maximum repair traffic (download and upload) per second over all placements (0 means unlimited)" default:"0"`
+	PlacementBandwidthBudget PlacementBandwidth `help:"maximum repair traffic per second for the listed placements, as comma separated placement:size pairs (eg. 0:100MB,12:10MB)" default:""`
+	CostWeight               float64            `help:"how much the estimated repair traffic (in GiB) lowers the priority of a job compared to its health, when ordering a batch of jobs (0 orders by health only)" default:"0"`
+
+	DelegatedRepair DelegatedRepairConfig
+
 	ConnectionPool ConnectionPoolConfig
 }
 
@@ -152,6 +159,9 @@ type Service struct {
 
 // NewService creates repairing service.
 func NewService(log *zap.Logger, queue queue.RepairQueue, config *Config, repairer *SegmentRepairer) *Service {
+	if repairer.budgets.Limited() {
+		activeBudgets.register(repairer.budgets)
+	}
 	return &Service{
 		log:        log,
 		queue:      queue,
@@ -222,16 +232,29 @@ func (service *Service) processWhileQueueHasItems(ctx context.Context) error {
 func (service *Service) process(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	included, excluded, wait, ok := service.repairer.budgets.Select(
+		service.config.IncludedPlacements.Placements, service.config.ExcludedPlacements.Placements)
+	if !ok {
+		// no placement has budget left
+		return service.waitForBudget(ctx, wait)
+	}
+
 	selectCtx, selectCancel := context.WithTimeout(ctx, service.config.TotalTimeout)
 	defer selectCancel()
-	segments, err := service.queue.Select(selectCtx, service.config.SegmentsSelectBatchSize,
-		service.config.IncludedPlacements.Placements, service.config.ExcludedPlacements.Placements)
+	segments, err := service.queue.Select(selectCtx, service.config.SegmentsSelectBatchSize, included, excluded)
 	if err != nil {
+		if wait > 0 && queue.ErrEmpty.Has(err) {
+			// the remaining jobs may be waiting for the budget of their placement
+			return service.waitForBudget(ctx, wait)
+		}
 		return err
 	}
 
-	for _, seg := range segments {
-		seg := seg
+	jobs := service.estimateCosts(ctx, segments)
+	prioritize(jobs, service.config.CostWeight)
+
+	for _, job := range jobs {
+		seg := job.segment
 		// wait until we are allowed to spawn a new job
 		if err := service.JobLimiter.Acquire(ctx, 1); err != nil {
 			return err
@@ -247,7 +270,30 @@ func (service *Service) process(ctx context.Context) (err error) {
 		// time from the semaphore acquisition, but it _must_ include the queue fetch time. At the
 		// same time, we don't want to do the queue pop in a separate goroutine, because we want to
 		// return from service.Run when queue fetch fails.
-		ctx, cancel := context.WithTimeout(ctx, service.config.TotalTimeout)
+		//
+		// The timeout includes the wait for the bandwidth budgets, as the segment has already
+		// been marked as attempted.
+		jobCtx, cancel := context.WithTimeout(ctx, service.config.TotalTimeout)
+
+		// charge the bandwidth budgets before the job starts, so concurrent
+		// jobs can't exceed them.
+		reserved, err := service.reserveBudget(jobCtx, job)
+		if !reserved {
+			cancel()
+			service.JobLimiter.Release(1)
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			// the segment stays in the queue and is selected again after its retry interval.
+			mon.Meter("repair_budget_job_dropped").Mark(1)
+			service.log.Debug("dropped repair job, bandwidth budget not available before the job timeout",
+				zap.Stringer("stream_id", seg.StreamID),
+				zap.Uint64("position", seg.Position.Encode()),
+				zap.Uint16("placement", uint16(seg.Placement)),
+				zap.Error(err))
+			continue
+		}
 
 		log := service.log.With(zap.Stringer("stream_id", seg.StreamID), zap.Uint64("position", seg.Position.Encode()))
 		log.Debug("Retrieved segment from repair queue")
@@ -257,7 +303,7 @@ func (service *Service) process(ctx context.Context) (err error) {
 		go func() {
 			defer service.JobLimiter.Release(1)
 			defer cancel()
-			if err := service.worker(ctx, seg); err != nil {
+			if err := service.worker(jobCtx, seg); err != nil {
 				log.Error("repair worker failed", zap.Uint16("placement", uint16(seg.Placement)), zap.Error(err))
 			}
 		}()
@@ -265,6 +311,59 @@ func (service *Service) process(ctx context.Context) (err error) {
 	return nil
 }
 
+// estimateCosts estimates the repair traffic of the segments, when it's needed
+// for the bandwidth budgets or the ordering of the jobs.
+func (service *Service) estimateCosts(ctx context.Context, segments []queue.InjuredSegment) []repairJob {
+	estimate := service.repairer.budgets.Limited() || service.config.CostWeight != 0
+
+	jobs := make([]repairJob, len(segments))
+	for i, seg := range segments {
+		jobs[i].segment = seg
+		if !estimate {
+			continue
+		}
+
+		cost, err := service.repairer.EstimateCost(ctx, seg)
+		if err != nil {
+			// the repair will fail the same way, without using the budgets
+			service.log.Debug("failed to estimate repair cost",
+				zap.Stringer("stream_id", seg.StreamID),
+				zap.Uint64("position", seg.Position.Encode()),
+				zap.Error(err))
+		}
+		jobs[i].cost = cost
+	}
+	return jobs
+}
+
+// reserveBudget waits until the bandwidth budgets of the job's placement are
+// available and charges them with the estimated cost of the job. It returns
+// false without waiting, when the budgets aren't available before the deadline
+// of ctx.
+func (service *Service) reserveBudget(ctx context.Context, job repairJob) (reserved bool, err error) {
+	for {
+		wait, ok := service.repairer.budgets.Reserve(job.segment.Placement, job.cost)
+		if ok {
+			return true, nil
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			return false, nil
+		}
+		if err := service.waitForBudget(ctx, wait); err != nil {
+			return false, err
+		}
+	}
+}
+
+// waitForBudget waits until a depleted bandwidth budget is available again.
+func (service *Service) waitForBudget(ctx context.Context, wait time.Duration) error {
+	mon.Meter("repair_budget_exhausted").Mark(1)
+	if !sync2.Sleep(ctx, wait) {
+		return ctx.Err()
+	}
+	return nil
+}
+
 func (service *Service) worker(ctx context.Context, seg queue.InjuredSegment) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -124,6 +124,8 @@ type SegmentRepairer struct {
 	OnTestingCheckSegmentAlteredHook func()
 	OnTestingPiecesReportHook        func(pieces FetchResultReport)
 	placements                       nodeselection.PlacementDefinitions
+	budgets                          *Budgets
+	delegator                        *Delegator
 	// onlineWindow to consider if storage nodes are online according to their last successful contact.
 	onlineWindow time.Duration
 }
@@ -175,6 +177,7 @@ func NewSegmentRepairer(
 		doDeclumping:               config.DoDeclumping,
 		doPlacementCheck:           config.DoPlacementCheck,
 		placements:                 placements,
+		budgets:                    NewBudgets(config),
 		onlineWindow:               config.OnlineWindow,
 
 		nowFn: time.Now,
@@ -421,14 +424,7 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 		}
 	}
 
-	var requestCount int
-	{
-		totalNeeded := int(math.Ceil(float64(newRedundancy.OptimalShares) * repairer.multiplierOptimalThreshold))
-		if totalNeeded > int(newRedundancy.TotalShares) {
-			totalNeeded = int(newRedundancy.TotalShares)
-		}
-		requestCount = totalNeeded - piecesCheck.Healthy.Count()
-	}
+	requestCount := repairer.requestCount(newRedundancy, piecesCheck.Healthy.Count())
 	minSuccessfulNeeded := int(newRedundancy.OptimalShares) - piecesCheck.Healthy.Count()
 
 	var alreadySelected []*nodeselection.SelectedNode
@@ -472,233 +468,6 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 		return true, invalidRepairError.New("invalid redundancy strategy: %w", err)
 	}
 
-	log.Debug("fetching pieces for segment",
-		zap.Int("num_order_limits", len(getOrderLimits)),
-		zap.Stringer("rs", segment.Redundancy))
-
-	// this will pass additional info to restored from trash event sent by underlying libuplink
-	//nolint: revive
-	//lint:ignore SA1029 this is a temporary solution
-	ctx = context.WithValue(ctx, "restored_from_trash", map[string]string{
-		"StreamID":       queueSegment.StreamID.String(),
-		"StreamPosition": strconv.Itoa(int(queueSegment.Position.Encode())),
-	})
-	// Download the segment using just the retrievable pieces
-	segmentReader, piecesReport, err := repairer.ec.Get(ctx, log, getOrderLimits, cachedNodesInfo, getPrivateKey, oldRedundancyStrategy, int64(segment.EncryptedSize))
-
-	// ensure we get values, even if only zero values, so that redash can have an alert based on this
-	stats.repairTooManyNodesFailed.Mark(0)
-	stats.repairSuspectedNetworkProblem.Mark(0)
-
-	if repairer.OnTestingPiecesReportHook != nil {
-		repairer.OnTestingPiecesReportHook(piecesReport)
-	}
-
-	// Check if segment has been altered
-	checkSegmentError := repairer.checkIfSegmentAltered(ctx, segment)
-	if checkSegmentError != nil {
-		if segmentDeletedError.Has(checkSegmentError) {
-			log.Info("segment deleted during Repair")
-			return true, nil
-		}
-		if segmentModifiedError.Has(checkSegmentError) {
-			log.Info("segment modified during Repair")
-			return true, nil
-		}
-		return false, segmentVerificationError.Wrap(checkSegmentError)
-	}
-
-	if len(piecesReport.Contained) > 0 {
-		log.Debug("unexpected contained pieces during repair", zap.Int("count", len(piecesReport.Contained)))
-	}
-
-	if err != nil {
-		// If the context was closed during the Get phase, it will appear here as though
-		// we just failed to download enough pieces to reconstruct the segment. Check for
-		// a closed context before doing any further error processing.
-		if ctxErr := ctx.Err(); ctxErr != nil {
-			return false, ctxErr
-		}
-		// If Get failed because of input validation, then it will keep failing. But if it
-		// gave us irreparableError, then we failed to download enough pieces and must try
-		// to wait for nodes to come back online.
-		var irreparableErr *irreparableError
-		if errors.As(err, &irreparableErr) {
-			// piecesReport.Offline:
-			// Nodes which were online recently, but which we couldn't contact for
-			// this operation.
-			//
-			// piecesReport.Failed:
-			// Nodes which we contacted successfully but which indicated they
-			// didn't have the piece we wanted.
-			//
-			// piecesReport.Contained:
-			// Nodes which we contacted successfully but timed out after we asked
-			// for the piece.
-			//
-			// piecesReport.Unknown:
-			// Something else went wrong, and we don't know what.
-			//
-			// In a network failure scenario, we expect more than half of the outcomes
-			// will be in Offline or Contained.
-			if len(piecesReport.Offline)+len(piecesReport.Contained) > len(piecesReport.Successful)+len(piecesReport.Failed)+len(piecesReport.Unknown) {
-				stats.repairSuspectedNetworkProblem.Mark(1)
-			} else {
-				stats.repairTooManyNodesFailed.Mark(1)
-			}
-			stats.repairTooManyNodesFailed.Mark(1)
-
-			failedNodeIDs := make([]storj.NodeID, 0, len(piecesReport.Failed))
-			offlineNodeIDs := make([]storj.NodeID, 0, len(piecesReport.Offline))
-			timedOutNodeIDs := make([]storj.NodeID, 0, len(piecesReport.Contained))
-			unknownErrs := make([]string, 0, len(piecesReport.Unknown))
-			for _, outcome := range piecesReport.Failed {
-				failedNodeIDs = append(failedNodeIDs, outcome.Piece.StorageNode)
-			}
-			for _, outcome := range piecesReport.Offline {
-				offlineNodeIDs = append(offlineNodeIDs, outcome.Piece.StorageNode)
-			}
-			for _, outcome := range piecesReport.Contained {
-				timedOutNodeIDs = append(timedOutNodeIDs, outcome.Piece.StorageNode)
-			}
-			for _, outcome := range piecesReport.Unknown {
-				// We are purposefully using the error's string here, as opposed
-				// to wrapping the error. It is not likely that we need the local-side
-				// traceback of where this error was initially wrapped, and this will
-				// keep the logs more readable.
-				unknownErrs = append(unknownErrs, fmt.Sprintf("node ID [%s] err: %v", outcome.Piece.StorageNode, outcome.Err))
-			}
-
-			log.Warn("irreparable segment: could not acquire enough shares",
-				zap.Int32("pieces_available", irreparableErr.piecesAvailable),
-				zap.Int32("pieces_required", irreparableErr.piecesRequired),
-				zap.Int("failed_nodes", len(failedNodeIDs)),
-				zap.Stringers("failed_nodes_list", failedNodeIDs),
-				zap.Int("offline_nodes", len(offlineNodeIDs)),
-				zap.Stringers("offline_nodes_list", offlineNodeIDs),
-				zap.Int("timed_out_nodes", len(timedOutNodeIDs)),
-				zap.Stringers("timed_out_nodes_list", timedOutNodeIDs),
-				zap.Strings("unknown_errors_list", unknownErrs),
-				zap.Uint16("placement", uint16(segment.Placement)),
-			)
-
-			tags := make([]eventkit.Tag, 0, 23)
-			tags = append(tags,
-				eventkit.Bytes("stream-id", queueSegment.StreamID.Bytes()),
-				eventkit.Int64("stream-position", int64(queueSegment.Position.Encode())),
-				eventkit.Int64("segment-size", int64(segment.EncryptedSize)),
-				eventkit.Int64("placement", int64(segment.Placement)),
-				eventkit.Int64("pieces-required", int64(newRedundancy.RequiredShares)),
-				eventkit.Int64("pieces-missing", int64(piecesCheck.Missing.Count())),
-				eventkit.Int64("pieces-retrievable", int64(piecesCheck.Retrievable.Count())),
-				eventkit.Int64("pieces-suspended", int64(piecesCheck.Suspended.Count())),
-				eventkit.Int64("pieces-clumped", int64(piecesCheck.Clumped.Count())),
-				eventkit.Int64("pieces-exiting", int64(piecesCheck.Exiting.Count())),
-				eventkit.Int64("pieces-out-of-placement", int64(piecesCheck.OutOfPlacement.Count())),
-				eventkit.Int64("pieces-in-excluded-country", int64(piecesCheck.InExcludedCountry.Count())),
-				eventkit.Int64("pieces-forcing-repair", int64(piecesCheck.ForcingRepair.Count())),
-				eventkit.Int64("pieces-unhealthy", int64(piecesCheck.Unhealthy.Count())),
-				eventkit.Int64("pieces-healthy", int64(piecesCheck.Healthy.Count())),
-				eventkit.Timestamp("created-at", segment.CreatedAt),
-				eventkit.Int64("piece-fetch-successful", int64(len(piecesReport.Successful))),
-				eventkit.Int64("piece-fetch-failed", int64(len(piecesReport.Failed))),
-				eventkit.Int64("piece-fetch-offline", int64(len(piecesReport.Offline))),
-				eventkit.Int64("piece-fetch-contained", int64(len(piecesReport.Contained))),
-				eventkit.Int64("piece-fetch-unknown", int64(len(piecesReport.Unknown))),
-			)
-			if segment.RepairedAt != nil {
-				tags = append(tags, eventkit.Timestamp("repaired-at", *segment.RepairedAt))
-			}
-			if segment.ExpiresAt != nil {
-				tags = append(tags, eventkit.Timestamp("expires-at", *segment.ExpiresAt))
-			}
-			ek.Event("irretrievable_segment", tags...)
-
-			// repair will be attempted again if the segment remains unhealthy.
-			return false, nil
-		}
-		// The segment's redundancy strategy is invalid, or else there was an internal error.
-		return false, repairReconstructError.New("segment could not be reconstructed: %w", err)
-	}
-	defer func() { err = errs.Combine(err, segmentReader.Close()) }()
-
-	// Reconstruct the segment from the pieces. This should ideally happen in
-	// tandem with the new piece uploads (have Repair() read directly from
-	// segmentReader), but this causes a situation where slow uploads cause
-	// reads from the piece files to block due to backpressure, but then the
-	// slow reads are marked as inactive (a "internal: quiescence" error is
-	// returned). This causes all uploads to fail. Instead, for now, we will
-	// write the reconstructed segment to a tempfile and then upload the pieces
-	// from that.
-	//
-	// Once it is possible to suppress or avoid the quiescence error in
-	// eestream.decodedReader, we can remove this tempfile step.
-	if !repairer.ec.inmemoryDownload {
-		err := func() (err error) {
-			tempfile, err := tmpfile.New("", "repaired-segment-*")
-			if err != nil {
-				return repairReconstructError.New("could not open tempfile: %w", err)
-			}
-			defer func() {
-				if recoverErr := recover(); recoverErr != nil {
-					err = repairReconstructError.New("panic during segment reconstruction: %v", recoverErr)
-				}
-				if err != nil {
-					_ = tempfile.Close()
-				}
-			}()
-			_, err = io.Copy(tempfile, segmentReader)
-			if err != nil {
-				return repairReconstructError.New("could not reconstruct segment: %w", err)
-			}
-			_, err = tempfile.Seek(0, io.SeekStart)
-			if err != nil {
-				return repairReconstructError.New("could not seek to beginning of tempfile: %w", err)
-			}
-			err = segmentReader.Close()
-			if err != nil {
-				return repairReconstructError.New("could not close segmentReader: %w", err)
-			}
-			// assign tempfile before proceeding, because we've already defer-closed segmentReader
-			segmentReader = tempfile
-			return nil
-		}()
-		if err != nil {
-			return false, err
-		}
-	}
-
-	// only report audit result when segment can be successfully downloaded
-	cachedNodesReputation := make(map[storj.NodeID]overlay.ReputationStatus, len(cachedNodesInfo))
-	for id, info := range cachedNodesInfo {
-		cachedNodesReputation[id] = info.Reputation
-	}
-
-	segmentAudit := metabase.SegmentForAudit(segment)
-	report := audit.Report{
-		Segment:         &segmentAudit,
-		NodesReputation: cachedNodesReputation,
-	}
-
-	for _, outcome := range piecesReport.Successful {
-		report.Successes = append(report.Successes, outcome.Piece.StorageNode)
-	}
-	for _, outcome := range piecesReport.Failed {
-		report.Fails = append(report.Fails, metabase.Piece{
-			StorageNode: outcome.Piece.StorageNode,
-			Number:      outcome.Piece.Number,
-		})
-	}
-	for _, outcome := range piecesReport.Offline {
-		report.Offlines = append(report.Offlines, outcome.Piece.StorageNode)
-	}
-	for _, outcome := range piecesReport.Unknown {
-		report.Unknown = append(report.Unknown, outcome.Piece.StorageNode)
-	}
-	if repairer.reputationUpdateEnabled {
-		repairer.reporter.RecordAudits(ctx, report)
-	}
-
 	// Create the order limits for the PUT_REPAIR action. We want to keep pieces in Healthy
 	// as well as pieces in InExcludedCountry (our policy is to let those nodes keep the
 	// pieces they have, as long as they are kept intact and retrievable).
@@ -730,15 +499,267 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 		return true, invalidRepairError.New("invalid redundancy strategy: %w", err)
 	}
 
-	log.Debug("putting pieces for segment",
-		zap.Int("num_order_limits", len(putLimits)),
-		zap.Int("min_successful_needed", minSuccessfulNeeded),
-		zap.Stringer("rs", newRedundancy))
+	var successfulNodes []*pb.Node
+	var piecesReport FetchResultReport
+	if repairer.delegator != nil {
+		result := repairer.delegateRepair(ctx, log, DelegatedRepairJob{
+			Segment:          segment,
+			GetLimits:        getOrderLimits,
+			GetPrivateKey:    getPrivateKey,
+			PutLimits:        putLimits,
+			PutPrivateKey:    putPrivateKey,
+			NewRedundancy:    newRedundancy,
+			SuccessfulNeeded: minSuccessfulNeeded,
+		})
+		if result != nil {
+			checkSegmentError := repairer.checkIfSegmentAltered(ctx, segment)
+			if checkSegmentError != nil {
+				if segmentDeletedError.Has(checkSegmentError) {
+					log.Info("segment deleted during delegated repair")
+					return true, nil
+				}
+				if segmentModifiedError.Has(checkSegmentError) {
+					log.Info("segment modified during delegated repair")
+					return true, nil
+				}
+				return false, segmentVerificationError.Wrap(checkSegmentError)
+			}
 
-	// Upload the repaired pieces
-	successfulNodes, _, err := repairer.ec.Repair(ctx, log, putLimits, putPrivateKey, newRedundancyStrategy, segmentReader, repairer.timeout, minSuccessfulNeeded)
-	if err != nil {
-		return false, repairPutError.Wrap(err)
+			successfulNodes = result.SuccessfulNodes
+			for _, pieceNum := range result.FailedPieceNums {
+				if piece, ok := segment.Pieces.FindByNum(int(pieceNum)); ok {
+					piecesReport.Failed = append(piecesReport.Failed, PieceFetchResult{Piece: piece})
+				}
+			}
+			// the delegate only reports the pieces which failed hash verification
+			repairer.recordAudits(ctx, segment, cachedNodesInfo, piecesReport)
+		} else {
+			// the order limits were sent to the delegate, which may have used them
+			// already, so the satellite needs new ones to repair the segment.
+			getOrderLimits, getPrivateKey, cachedNodesInfo, err = repairer.orders.CreateGetRepairOrderLimits(
+				ctx, segment, retrievablePieces, repairer.getNodesForRepair,
+			)
+			if err != nil {
+				return false, orderLimitFailureError.New("could not create GET_REPAIR order limits: %w", err)
+			}
+			putLimits, putPrivateKey, err = repairer.orders.CreatePutRepairOrderLimits(ctx, segment, newRedundancy, getOrderLimits, toKeep, newNodes)
+			if err != nil {
+				return false, orderLimitFailureError.New("could not create PUT_REPAIR order limits: %w", err)
+			}
+		}
+	}
+
+	if successfulNodes == nil {
+		log.Debug("fetching pieces for segment",
+			zap.Int("num_order_limits", len(getOrderLimits)),
+			zap.Stringer("rs", segment.Redundancy))
+
+		// this will pass additional info to restored from trash event sent by underlying libuplink
+		//nolint: revive
+		//lint:ignore SA1029 this is a temporary solution
+		ctx = context.WithValue(ctx, "restored_from_trash", map[string]string{
+			"StreamID":       queueSegment.StreamID.String(),
+			"StreamPosition": strconv.Itoa(int(queueSegment.Position.Encode())),
+		})
+		// Download the segment using just the retrievable pieces
+		var segmentReader io.ReadCloser
+		segmentReader, piecesReport, err = repairer.ec.Get(ctx, log, getOrderLimits, cachedNodesInfo, getPrivateKey, oldRedundancyStrategy, int64(segment.EncryptedSize))
+
+		// ensure we get values, even if only zero values, so that redash can have an alert based on this
+		stats.repairTooManyNodesFailed.Mark(0)
+		stats.repairSuspectedNetworkProblem.Mark(0)
+
+		if repairer.OnTestingPiecesReportHook != nil {
+			repairer.OnTestingPiecesReportHook(piecesReport)
+		}
+
+		// Check if segment has been altered
+		checkSegmentError := repairer.checkIfSegmentAltered(ctx, segment)
+		if checkSegmentError != nil {
+			if segmentDeletedError.Has(checkSegmentError) {
+				log.Info("segment deleted during Repair")
+				return true, nil
+			}
+			if segmentModifiedError.Has(checkSegmentError) {
+				log.Info("segment modified during Repair")
+				return true, nil
+			}
+			return false, segmentVerificationError.Wrap(checkSegmentError)
+		}
+
+		if len(piecesReport.Contained) > 0 {
+			log.Debug("unexpected contained pieces during repair", zap.Int("count", len(piecesReport.Contained)))
+		}
+
+		if err != nil {
+			// If the context was closed during the Get phase, it will appear here as though
+			// we just failed to download enough pieces to reconstruct the segment. Check for
+			// a closed context before doing any further error processing.
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return false, ctxErr
+			}
+			// If Get failed because of input validation, then it will keep failing. But if it
+			// gave us irreparableError, then we failed to download enough pieces and must try
+			// to wait for nodes to come back online.
+			var irreparableErr *irreparableError
+			if errors.As(err, &irreparableErr) {
+				// piecesReport.Offline:
+				// Nodes which were online recently, but which we couldn't contact for
+				// this operation.
+				//
+				// piecesReport.Failed:
+				// Nodes which we contacted successfully but which indicated they
+				// didn't have the piece we wanted.
+				//
+				// piecesReport.Contained:
+				// Nodes which we contacted successfully but timed out after we asked
+				// for the piece.
+				//
+				// piecesReport.Unknown:
+				// Something else went wrong, and we don't know what.
+				//
+				// In a network failure scenario, we expect more than half of the outcomes
+				// will be in Offline or Contained.
+				if len(piecesReport.Offline)+len(piecesReport.Contained) > len(piecesReport.Successful)+len(piecesReport.Failed)+len(piecesReport.Unknown) {
+					stats.repairSuspectedNetworkProblem.Mark(1)
+				} else {
+					stats.repairTooManyNodesFailed.Mark(1)
+				}
+				stats.repairTooManyNodesFailed.Mark(1)
+
+				failedNodeIDs := make([]storj.NodeID, 0, len(piecesReport.Failed))
+				offlineNodeIDs := make([]storj.NodeID, 0, len(piecesReport.Offline))
+				timedOutNodeIDs := make([]storj.NodeID, 0, len(piecesReport.Contained))
+				unknownErrs := make([]string, 0, len(piecesReport.Unknown))
+				for _, outcome := range piecesReport.Failed {
+					failedNodeIDs = append(failedNodeIDs, outcome.Piece.StorageNode)
+				}
+				for _, outcome := range piecesReport.Offline {
+					offlineNodeIDs = append(offlineNodeIDs, outcome.Piece.StorageNode)
+				}
+				for _, outcome := range piecesReport.Contained {
+					timedOutNodeIDs = append(timedOutNodeIDs, outcome.Piece.StorageNode)
+				}
+				for _, outcome := range piecesReport.Unknown {
+					// We are purposefully using the error's string here, as opposed
+					// to wrapping the error. It is not likely that we need the local-side
+					// traceback of where this error was initially wrapped, and this will
+					// keep the logs more readable.
+					unknownErrs = append(unknownErrs, fmt.Sprintf("node ID [%s] err: %v", outcome.Piece.StorageNode, outcome.Err))
+				}
+
+				log.Warn("irreparable segment: could not acquire enough shares",
+					zap.Int32("pieces_available", irreparableErr.piecesAvailable),
+					zap.Int32("pieces_required", irreparableErr.piecesRequired),
+					zap.Int("failed_nodes", len(failedNodeIDs)),
+					zap.Stringers("failed_nodes_list", failedNodeIDs),
+					zap.Int("offline_nodes", len(offlineNodeIDs)),
+					zap.Stringers("offline_nodes_list", offlineNodeIDs),
+					zap.Int("timed_out_nodes", len(timedOutNodeIDs)),
+					zap.Stringers("timed_out_nodes_list", timedOutNodeIDs),
+					zap.Strings("unknown_errors_list", unknownErrs),
+					zap.Uint16("placement", uint16(segment.Placement)),
+				)
+
+				tags := make([]eventkit.Tag, 0, 23)
+				tags = append(tags,
+					eventkit.Bytes("stream-id", queueSegment.StreamID.Bytes()),
+					eventkit.Int64("stream-position", int64(queueSegment.Position.Encode())),
+					eventkit.Int64("segment-size", int64(segment.EncryptedSize)),
+					eventkit.Int64("placement", int64(segment.Placement)),
+					eventkit.Int64("pieces-required", int64(newRedundancy.RequiredShares)),
+					eventkit.Int64("pieces-missing", int64(piecesCheck.Missing.Count())),
+					eventkit.Int64("pieces-retrievable", int64(piecesCheck.Retrievable.Count())),
+					eventkit.Int64("pieces-suspended", int64(piecesCheck.Suspended.Count())),
+					eventkit.Int64("pieces-clumped", int64(piecesCheck.Clumped.Count())),
+					eventkit.Int64("pieces-exiting", int64(piecesCheck.Exiting.Count())),
+					eventkit.Int64("pieces-out-of-placement", int64(piecesCheck.OutOfPlacement.Count())),
+					eventkit.Int64("pieces-in-excluded-country", int64(piecesCheck.InExcludedCountry.Count())),
+					eventkit.Int64("pieces-forcing-repair", int64(piecesCheck.ForcingRepair.Count())),
+					eventkit.Int64("pieces-unhealthy", int64(piecesCheck.Unhealthy.Count())),
+					eventkit.Int64("pieces-healthy", int64(piecesCheck.Healthy.Count())),
+					eventkit.Timestamp("created-at", segment.CreatedAt),
+					eventkit.Int64("piece-fetch-successful", int64(len(piecesReport.Successful))),
+					eventkit.Int64("piece-fetch-failed", int64(len(piecesReport.Failed))),
+					eventkit.Int64("piece-fetch-offline", int64(len(piecesReport.Offline))),
+					eventkit.Int64("piece-fetch-contained", int64(len(piecesReport.Contained))),
+					eventkit.Int64("piece-fetch-unknown", int64(len(piecesReport.Unknown))),
+				)
+				if segment.RepairedAt != nil {
+					tags = append(tags, eventkit.Timestamp("repaired-at", *segment.RepairedAt))
+				}
+				if segment.ExpiresAt != nil {
+					tags = append(tags, eventkit.Timestamp("expires-at", *segment.ExpiresAt))
+				}
+				ek.Event("irretrievable_segment", tags...)
+
+				// repair will be attempted again if the segment remains unhealthy.
+				return false, nil
+			}
+			// The segment's redundancy strategy is invalid, or else there was an internal error.
+			return false, repairReconstructError.New("segment could not be reconstructed: %w", err)
+		}
+		defer func() { err = errs.Combine(err, segmentReader.Close()) }()
+
+		// Reconstruct the segment from the pieces. This should ideally happen in
+		// tandem with the new piece uploads (have Repair() read directly from
+		// segmentReader), but this causes a situation where slow uploads cause
+		// reads from the piece files to block due to backpressure, but then the
+		// slow reads are marked as inactive (a "internal: quiescence" error is
+		// returned). This causes all uploads to fail. Instead, for now, we will
+		// write the reconstructed segment to a tempfile and then upload the pieces
+		// from that.
+		//
+		// Once it is possible to suppress or avoid the quiescence error in
+		// eestream.decodedReader, we can remove this tempfile step.
+		if !repairer.ec.inmemoryDownload {
+			err := func() (err error) {
+				tempfile, err := tmpfile.New("", "repaired-segment-*")
+				if err != nil {
+					return repairReconstructError.New("could not open tempfile: %w", err)
+				}
+				defer func() {
+					if recoverErr := recover(); recoverErr != nil {
+						err = repairReconstructError.New("panic during segment reconstruction: %v", recoverErr)
+					}
+					if err != nil {
+						_ = tempfile.Close()
+					}
+				}()
+				_, err = io.Copy(tempfile, segmentReader)
+				if err != nil {
+					return repairReconstructError.New("could not reconstruct segment: %w", err)
+				}
+				_, err = tempfile.Seek(0, io.SeekStart)
+				if err != nil {
+					return repairReconstructError.New("could not seek to beginning of tempfile: %w", err)
+				}
+				err = segmentReader.Close()
+				if err != nil {
+					return repairReconstructError.New("could not close segmentReader: %w", err)
+				}
+				// assign tempfile before proceeding, because we've already defer-closed segmentReader
+				segmentReader = tempfile
+				return nil
+			}()
+			if err != nil {
+				return false, err
+			}
+		}
+
+		// only report audit result when segment can be successfully downloaded
+		repairer.recordAudits(ctx, segment, cachedNodesInfo, piecesReport)
+
+		log.Debug("putting pieces for segment",
+			zap.Int("num_order_limits", len(putLimits)),
+			zap.Int("min_successful_needed", minSuccessfulNeeded),
+			zap.Stringer("rs", newRedundancy))
+
+		// Upload the repaired pieces
+		successfulNodes, _, err = repairer.ec.Repair(ctx, log, putLimits, putPrivateKey, newRedundancyStrategy, segmentReader, repairer.timeout, minSuccessfulNeeded)
+		if err != nil {
+			return false, repairPutError.Wrap(err)
+		}
 	}
 
 	pieceSize := newRedundancy.PieceSize(int64(segment.EncryptedSize))
@@ -877,6 +898,81 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 }
 
 // checkIfSegmentAltered checks if oldSegment has been altered since it was selected for audit.
+// recordAudits reports the outcome of downloading the pieces of the segment to
+// the reputation service.
+func (repairer *SegmentRepairer) recordAudits(ctx context.Context, segment metabase.SegmentForRepair, cachedNodesInfo map[storj.NodeID]overlay.NodeReputation, piecesReport FetchResultReport) {
+	if !repairer.reputationUpdateEnabled {
+		return
+	}
+
+	cachedNodesReputation := make(map[storj.NodeID]overlay.ReputationStatus, len(cachedNodesInfo))
+	for id, info := range cachedNodesInfo {
+		cachedNodesReputation[id] = info.Reputation
+	}
+
+	segmentAudit := metabase.SegmentForAudit(segment)
+	report := audit.Report{
+		Segment:         &segmentAudit,
+		NodesReputation: cachedNodesReputation,
+	}
+
+	for _, outcome := range piecesReport.Successful {
+		report.Successes = append(report.Successes, outcome.Piece.StorageNode)
+	}
+	for _, outcome := range piecesReport.Failed {
+		report.Fails = append(report.Fails, metabase.Piece{
+			StorageNode: outcome.Piece.StorageNode,
+			Number:      outcome.Piece.Number,
+		})
+	}
+	for _, outcome := range piecesReport.Offline {
+		report.Offlines = append(report.Offlines, outcome.Piece.StorageNode)
+	}
+	for _, outcome := range piecesReport.Unknown {
+		report.Unknown = append(report.Unknown, outcome.Piece.StorageNode)
+	}
+	repairer.reporter.RecordAudits(ctx, report)
+}
+
+// requestCount returns the number of new pieces to upload to reach the repair
+// target, given the number of healthy pieces.
+func (repairer *SegmentRepairer) requestCount(newRedundancy storj.RedundancyScheme, healthy int) int {
+	totalNeeded := int(math.Ceil(float64(newRedundancy.OptimalShares) * repairer.multiplierOptimalThreshold))
+	if totalNeeded > int(newRedundancy.TotalShares) {
+		totalNeeded = int(newRedundancy.TotalShares)
+	}
+	return totalNeeded - healthy
+}
+
+// EstimateCost returns the estimated traffic of repairing the segment: downloading
+// the required pieces and uploading the new pieces. The number of healthy pieces
+// is taken from the repair queue, so the segment's pieces don't have to be
+// classified again.
+func (repairer *SegmentRepairer) EstimateCost(ctx context.Context, queueSegment queue.InjuredSegment) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	segment, err := repairer.metabase.GetSegmentByPositionForRepair(ctx, metabase.GetSegmentByPosition{
+		StreamID: queueSegment.StreamID,
+		Position: queueSegment.Position,
+	})
+	if err != nil {
+		if metabase.ErrSegmentNotFound.Has(err) {
+			// the repair will only remove it from the queue
+			return 0, nil
+		}
+		return 0, metainfoGetError.Wrap(err)
+	}
+	if segment.Inline() {
+		return 0, nil
+	}
+
+	newRedundancy := checker.AdjustRedundancy(segment.Redundancy, repairer.repairThresholdOverrides, repairer.repairTargetOverrides, repairer.placements[segment.Placement])
+	healthy := int(queueSegment.NumNormalizedHealthy) + int(segment.Redundancy.RequiredShares)
+	requestCount := max(repairer.requestCount(newRedundancy, healthy), 0)
+
+	return segment.PieceSize() * int64(int(segment.Redundancy.RequiredShares)+requestCount), nil
+}
+
 func (repairer *SegmentRepairer) checkIfSegmentAltered(ctx context.Context, oldSegment metabase.SegmentForRepair) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -904,6 +1000,26 @@ func (repairer *SegmentRepairer) getStats(r storj.RedundancyScheme, p storj.Plac
 	return repairer.statsCollector.getStats(getRSString(r), getPlacementString(p))
 }
 
+// SetDelegator sets the delegator, which is used to repair the segments by
+// trusted storage nodes. When the delegated repair fails, the segment is
+// repaired by the satellite.
+func (repairer *SegmentRepairer) SetDelegator(delegator *Delegator) {
+	repairer.delegator = delegator
+}
+
+// delegateRepair delegates the repair to a trusted storage node, and returns
+// its result, or nil when the satellite should repair the segment itself.
+func (repairer *SegmentRepairer) delegateRepair(ctx context.Context, log *zap.Logger, job DelegatedRepairJob) *DelegatedRepairResult {
+	result, err := repairer.delegator.Repair(ctx, job)
+	if err != nil {
+		mon.Meter("delegated_repair_fallback").Mark(1)
+		log.Debug("delegated repair failed, repairing on the satellite", zap.Error(err))
+		return nil
+	}
+	mon.Meter("delegated_repair_success").Mark(1)
+	return result
+}
+
 // SetNow allows tests to have the server act as if the current time is whatever they want.
 func (repairer *SegmentRepairer) SetNow(nowFn func() time.Time) {
 	repairer.nowFn = nowFn
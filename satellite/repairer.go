@@ -84,6 +84,7 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 	nodeEvents nodeevents.DB,
 	reputationdb reputation.DB,
 	containmentDB audit.Containment,
+	peerIdentities overlay.PeerIdentities,
 	versionInfo version.Info, config *Config, atomicLogLevel *zap.AtomicLevel,
 ) (*Repairer, error) {
 	peer := &Repairer{
@@ -263,6 +264,17 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 		if err != nil {
 			return nil, err
 		}
+		if config.Repairer.DelegatedRepair.Enabled {
+			peer.SegmentRepairer.SetDelegator(repairer.NewDelegator(
+				log.Named("delegated-repair"),
+				peer.Dialer,
+				peer.Overlay,
+				peerIdentities,
+				placement,
+				config.Repairer.DelegatedRepair,
+				config.Repairer.OnlineWindow,
+			))
+		}
 		peer.Services.Add(lifecycle.Item{
 			Name:  "segment-repair",
 			Run:   peer.SegmentRepairer.Run,
@@ -0,0 +1,63 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package root
+
+import (
+	"go.uber.org/zap"
+
+	"storj.io/storj/satellite/balancer"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/shared/modular"
+	"storj.io/storj/shared/mud"
+)
+
+// BalancerPlan is a subcommand to run the balancer once, and write the
+// rebalancing plan to a file instead of the queue.
+//
+// Example:
+//
+//	satellite-mud balancer-plan --balancer.plan-output=plan.json
+type BalancerPlan struct {
+}
+
+// GetSelector implements mud.ComponentSelectorProvider.
+func (b *BalancerPlan) GetSelector(ball *mud.Ball) mud.ComponentSelector {
+	mud.Provide[*BalancerPlanRunner](ball, NewBalancerPlanRunner)
+	return mud.Or(
+		Observability(ball),
+		mud.Select[*balancer.Balancer](ball),
+		mud.Select[*BalancerPlanRunner](ball))
+}
+
+// BalancerPlanRunner runs the ranged loop once, only with the balancer observer.
+type BalancerPlanRunner struct {
+	*rangedloop.RunOnce
+}
+
+// NewBalancerPlanRunner creates a new BalancerPlanRunner. It refuses to start
+// without a plan output, as the balancer would push the jobs to the queue.
+func NewBalancerPlanRunner(log *zap.Logger, stop *modular.StopTrigger, config rangedloop.Config, provider rangedloop.RangeSplitter, observer *balancer.Balancer, balancerConfig balancer.Config) (*BalancerPlanRunner, error) {
+	if balancerConfig.PlanOutput == "" {
+		return nil, balancer.Error.New("--balancer.plan-output is required to create a plan")
+	}
+	return &BalancerPlanRunner{
+		RunOnce: rangedloop.NewRunOnce(log, stop, config, provider, []rangedloop.Observer{observer}),
+	}, nil
+}
+
+// BalancerEnqueuePlan is a subcommand to push the jobs of a reviewed
+// rebalancing plan to the queue.
+//
+// Example:
+//
+//	satellite-mud balancer-enqueue-plan --balancer.enqueue-plan.path=plan.json
+type BalancerEnqueuePlan struct {
+}
+
+// GetSelector implements mud.ComponentSelectorProvider.
+func (b *BalancerEnqueuePlan) GetSelector(ball *mud.Ball) mud.ComponentSelector {
+	return mud.Or(
+		Observability(ball),
+		mud.Select[*balancer.EnqueuePlan](ball))
+}
@@ -64,6 +64,14 @@ func Module(ball *mud.Ball) {
 		return &RangedLoopOnce{}
 	})
 	cli.RegisterSubcommand[*RangedLoopOnce](ball, "ranged-loop-once", "run ranged loop once with configurable observers and stop (use --components to enable)")
+	mud.Provide[*BalancerPlan](ball, func() *BalancerPlan {
+		return &BalancerPlan{}
+	})
+	cli.RegisterSubcommand[*BalancerPlan](ball, "balancer-plan", "run the balancer once and write the rebalancing plan to a file instead of the queue")
+	mud.Provide[*BalancerEnqueuePlan](ball, func() *BalancerEnqueuePlan {
+		return &BalancerEnqueuePlan{}
+	})
+	cli.RegisterSubcommand[*BalancerEnqueuePlan](ball, "balancer-enqueue-plan", "push the jobs of a reviewed rebalancing plan to the queue")
 	mud.Provide[*Migrate](ball, NewMigrate)
 	cli.RegisterSubcommand[*Migrate](ball, "migrate", "run the satellite database migration")
 }
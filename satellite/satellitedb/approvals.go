@@ -0,0 +1,141 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"encoding/json"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/admin/approvals"
+	"storj.io/storj/satellite/admin/changehistory"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ApprovalRequests implements approvals.DB.
+type ApprovalRequests struct {
+	db dbx.DriverMethods
+}
+
+var _ approvals.DB = (*ApprovalRequests)(nil)
+
+// Create stores a new approval request. The creation time is set by the database.
+func (a *ApprovalRequests) Create(ctx context.Context, request approvals.Request) (_ *approvals.Request, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fields := dbx.AdminApprovalRequest_Create_Fields{}
+	if request.ProjectID != nil {
+		fields.ProjectId = dbx.AdminApprovalRequest_ProjectId(request.ProjectID.Bytes())
+	} else {
+		fields.ProjectId = dbx.AdminApprovalRequest_ProjectId_Null()
+	}
+
+	details, err := json.Marshal(request.Details)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := request.Payload
+	if len(payload) == 0 {
+		payload = json.RawMessage("{}")
+	}
+
+	dbxRequest, err := a.db.Create_AdminApprovalRequest(ctx,
+		dbx.AdminApprovalRequest_Id(request.ID.Bytes()),
+		dbx.AdminApprovalRequest_Operation(request.Operation),
+		dbx.AdminApprovalRequest_ItemType(string(request.ItemType)),
+		dbx.AdminApprovalRequest_UserId(request.UserID.Bytes()),
+		dbx.AdminApprovalRequest_RequestedBy(request.RequestedBy),
+		dbx.AdminApprovalRequest_Reason(request.Reason),
+		dbx.AdminApprovalRequest_Details(details),
+		dbx.AdminApprovalRequest_Payload(payload),
+		dbx.AdminApprovalRequest_ExpiresAt(request.ExpiresAt),
+		fields,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromDBXApprovalRequest(dbxRequest)
+}
+
+// Get retrieves the approval request with the given ID.
+func (a *ApprovalRequests) Get(ctx context.Context, id uuid.UUID) (_ *approvals.Request, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxRequest, err := a.db.Get_AdminApprovalRequest_By_Id(ctx, dbx.AdminApprovalRequest_Id(id.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+
+	return fromDBXApprovalRequest(dbxRequest)
+}
+
+// List retrieves all the approval requests ordered by creation time.
+func (a *ApprovalRequests) List(ctx context.Context) (_ []approvals.Request, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxRequests, err := a.db.All_AdminApprovalRequest_OrderBy_Asc_CreatedAt(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]approvals.Request, 0, len(dbxRequests))
+	for _, dbxRequest := range dbxRequests {
+		request, err := fromDBXApprovalRequest(dbxRequest)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, *request)
+	}
+
+	return requests, nil
+}
+
+// Delete deletes the approval request with the given ID.
+func (a *ApprovalRequests) Delete(ctx context.Context, id uuid.UUID) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return a.db.Delete_AdminApprovalRequest_By_Id(ctx, dbx.AdminApprovalRequest_Id(id.Bytes()))
+}
+
+func fromDBXApprovalRequest(dbxRequest *dbx.AdminApprovalRequest) (*approvals.Request, error) {
+	id, err := uuid.FromBytes(dbxRequest.Id)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuid.FromBytes(dbxRequest.UserId)
+	if err != nil {
+		return nil, err
+	}
+	var projectID *uuid.UUID
+	if dbxRequest.ProjectId != nil {
+		projectID = new(uuid.UUID)
+		*projectID, err = uuid.FromBytes(dbxRequest.ProjectId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	details := make(map[string]any)
+	if len(dbxRequest.Details) > 0 {
+		if err := json.Unmarshal(dbxRequest.Details, &details); err != nil {
+			return nil, err
+		}
+	}
+
+	return &approvals.Request{
+		ID:          id,
+		Operation:   dbxRequest.Operation,
+		ItemType:    changehistory.ItemType(dbxRequest.ItemType),
+		UserID:      userID,
+		ProjectID:   projectID,
+		RequestedBy: dbxRequest.RequestedBy,
+		Reason:      dbxRequest.Reason,
+		Details:     details,
+		Payload:     json.RawMessage(dbxRequest.Payload),
+		CreatedAt:   dbxRequest.CreatedAt,
+		ExpiresAt:   dbxRequest.ExpiresAt,
+	}, nil
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/admin/approvals"
+	"storj.io/storj/satellite/admin/changehistory"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestApprovalRequests(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		approvalsDB := db.AdminApprovals()
+
+		projectID := testrand.UUID()
+		expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+		userRequest, err := approvalsDB.Create(ctx, approvals.Request{
+			ID:          testrand.UUID(),
+			Operation:   "disable_user",
+			ItemType:    changehistory.ItemTypeUser,
+			UserID:      testrand.UUID(),
+			RequestedBy: "requester@example.com",
+			Reason:      "test",
+			Details:     map[string]any{"setPendingDeletion": false},
+			Payload:     json.RawMessage(`{"reason":"test"}`),
+			ExpiresAt:   expiresAt,
+		})
+		require.NoError(t, err)
+		require.Nil(t, userRequest.ProjectID)
+		require.False(t, userRequest.CreatedAt.IsZero())
+
+		projectRequest, err := approvalsDB.Create(ctx, approvals.Request{
+			ID:          testrand.UUID(),
+			Operation:   "disable_project",
+			ItemType:    changehistory.ItemTypeProject,
+			UserID:      testrand.UUID(),
+			ProjectID:   &projectID,
+			RequestedBy: "requester@example.com",
+			Reason:      "test",
+			ExpiresAt:   expiresAt,
+		})
+		require.NoError(t, err)
+
+		got, err := approvalsDB.Get(ctx, userRequest.ID)
+		require.NoError(t, err)
+		require.Equal(t, "disable_user", got.Operation)
+		require.Equal(t, changehistory.ItemTypeUser, got.ItemType)
+		require.Equal(t, userRequest.UserID, got.UserID)
+		require.Equal(t, false, got.Details["setPendingDeletion"])
+		require.JSONEq(t, `{"reason":"test"}`, string(got.Payload))
+		require.True(t, expiresAt.Equal(got.ExpiresAt))
+
+		got, err = approvalsDB.Get(ctx, projectRequest.ID)
+		require.NoError(t, err)
+		require.NotNil(t, got.ProjectID)
+		require.Equal(t, projectID, *got.ProjectID)
+
+		list, err := approvalsDB.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+
+		deleted, err := approvalsDB.Delete(ctx, userRequest.ID)
+		require.NoError(t, err)
+		require.True(t, deleted)
+
+		deleted, err = approvalsDB.Delete(ctx, userRequest.ID)
+		require.NoError(t, err)
+		require.False(t, deleted)
+
+		_, err = approvalsDB.Get(ctx, userRequest.ID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		list, err = approvalsDB.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, projectRequest.ID, list[0].ID)
+	})
+}
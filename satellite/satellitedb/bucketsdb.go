@@ -6,10 +6,13 @@ package satellitedb
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"time"
 
 	"cloud.google.com/go/spanner"
 	"github.com/jackc/pgtype"
+	"github.com/zeebo/errs"
 
 	"storj.io/common/macaroon"
 	"storj.io/common/storj"
@@ -20,6 +23,7 @@ import (
 	"storj.io/storj/shared/dbutil"
 	"storj.io/storj/shared/dbutil/pgutil"
 	"storj.io/storj/shared/dbutil/spannerutil"
+	"storj.io/storj/shared/tagsql"
 )
 
 type bucketsDB struct {
@@ -473,7 +477,21 @@ func (db *bucketsDB) DeleteBucket(ctx context.Context, bucketName []byte, projec
 	if !deleted {
 		return buckets.ErrBucketNotFound.New("%s", bucketName)
 	}
-	return nil
+
+	// the limits and the lifecycle configuration aren't removed by a foreign key, so they
+	// must not be left behind for a bucket created later with the same name.
+	_, err = db.db.Delete_BucketLimit_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketLimit_ProjectId(projectID[:]),
+		dbx.BucketLimit_BucketName(bucketName),
+	)
+	if err != nil {
+		return buckets.ErrBucket.Wrap(err)
+	}
+	_, err = db.db.Delete_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketLifecycleConfig_ProjectId(projectID[:]),
+		dbx.BucketLifecycleConfig_BucketName(bucketName),
+	)
+	return buckets.ErrBucket.Wrap(err)
 }
 
 // ListBuckets returns a list of buckets for a project.
@@ -951,3 +969,204 @@ func (db *bucketsDB) DeleteBucketNotificationConfig(ctx context.Context, bucketN
 	// No error if configuration doesn't exist
 	return nil
 }
+
+// GetBucketLimits returns the storage, bandwidth and segment limits of a bucket.
+// Returns zero limits if the bucket has no limits.
+func (db *bucketsDB) GetBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID) (limits buckets.Limits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxLimits, err := db.db.Get_BucketLimit_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketLimit_ProjectId(projectID[:]),
+		dbx.BucketLimit_BucketName(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return buckets.Limits{}, nil
+		}
+		return buckets.Limits{}, buckets.ErrBucket.Wrap(err)
+	}
+
+	return buckets.Limits{
+		Storage:   dbxLimits.StorageLimit,
+		Bandwidth: dbxLimits.BandwidthLimit,
+		Segments:  dbxLimits.SegmentLimit,
+	}, nil
+}
+
+// UpdateBucketLimits sets the storage, bandwidth and segment limits of a bucket.
+// Setting zero limits removes all the limits of the bucket.
+func (db *bucketsDB) UpdateBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID, limits buckets.Limits) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if limits.IsZero() {
+		_, err = db.db.Delete_BucketLimit_By_ProjectId_And_BucketName(ctx,
+			dbx.BucketLimit_ProjectId(projectID[:]),
+			dbx.BucketLimit_BucketName(bucketName),
+		)
+		return buckets.ErrBucket.Wrap(err)
+	}
+
+	_, err = db.db.Replace_BucketLimit(ctx,
+		dbx.BucketLimit_ProjectId(projectID[:]),
+		dbx.BucketLimit_BucketName(bucketName),
+		dbx.BucketLimit_Create_Fields{
+			StorageLimit:   dbx.BucketLimit_StorageLimit_Raw(limits.Storage),
+			BandwidthLimit: dbx.BucketLimit_BandwidthLimit_Raw(limits.Bandwidth),
+			SegmentLimit:   dbx.BucketLimit_SegmentLimit_Raw(limits.Segments),
+		},
+	)
+	return buckets.ErrBucket.Wrap(err)
+}
+
+// GetBucketLifecycleConfig retrieves the lifecycle configuration of a bucket.
+// Returns nil if no configuration exists.
+func (db *bucketsDB) GetBucketLifecycleConfig(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ *buckets.LifecycleConfiguration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxConfig, err := db.db.Get_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketLifecycleConfig_ProjectId(projectID[:]),
+		dbx.BucketLifecycleConfig_BucketName(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, buckets.ErrBucket.Wrap(err)
+	}
+
+	config := buckets.LifecycleConfiguration{
+		CreatedAt: dbxConfig.CreatedAt,
+		UpdatedAt: dbxConfig.UpdatedAt,
+	}
+	if err := json.Unmarshal(dbxConfig.Rules, &config.Rules); err != nil {
+		return nil, buckets.ErrBucket.New("error decoding lifecycle rules: %w", err)
+	}
+
+	return &config, nil
+}
+
+// UpdateBucketLifecycleConfig replaces the lifecycle configuration of a bucket.
+func (db *bucketsDB) UpdateBucketLifecycleConfig(ctx context.Context, bucketName []byte, projectID uuid.UUID, config buckets.LifecycleConfiguration) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	rules, err := json.Marshal(config.Rules)
+	if err != nil {
+		return buckets.ErrBucket.New("error encoding lifecycle rules: %w", err)
+	}
+
+	// the rules which the deletion cursor was reached with don't apply anymore, so the
+	// lifecycle deletion chore starts again from the beginning of the bucket.
+	updated, err := db.db.Update_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketLifecycleConfig_ProjectId(projectID[:]),
+		dbx.BucketLifecycleConfig_BucketName(bucketName),
+		dbx.BucketLifecycleConfig_Update_Fields{
+			Rules:          dbx.BucketLifecycleConfig_Rules(rules),
+			DeletionCursor: dbx.BucketLifecycleConfig_DeletionCursor_Null(),
+			UpdatedAt:      dbx.BucketLifecycleConfig_UpdatedAt(time.Now()),
+		},
+	)
+	if err != nil {
+		return buckets.ErrBucket.Wrap(err)
+	}
+	if updated != nil {
+		return nil
+	}
+
+	_, err = db.db.Create_BucketLifecycleConfig(ctx,
+		dbx.BucketLifecycleConfig_ProjectId(projectID[:]),
+		dbx.BucketLifecycleConfig_BucketName(bucketName),
+		dbx.BucketLifecycleConfig_Rules(rules),
+		dbx.BucketLifecycleConfig_Create_Fields{},
+	)
+	return buckets.ErrBucket.Wrap(err)
+}
+
+// DeleteBucketLifecycleConfig removes the lifecycle configuration of a bucket.
+func (db *bucketsDB) DeleteBucketLifecycleConfig(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.Delete_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketLifecycleConfig_ProjectId(projectID[:]),
+		dbx.BucketLifecycleConfig_BucketName(bucketName),
+	)
+	return buckets.ErrBucket.Wrap(err)
+}
+
+// UpdateBucketLifecycleDeletionCursor sets the object key from which the lifecycle deletion
+// chore continues applying the rules of the bucket. An empty cursor starts from the beginning.
+func (db *bucketsDB) UpdateBucketLifecycleDeletionCursor(ctx context.Context, bucket metabase.BucketLocation, cursor metabase.ObjectKey) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deletionCursor := dbx.BucketLifecycleConfig_DeletionCursor_Null()
+	if cursor != "" {
+		deletionCursor = dbx.BucketLifecycleConfig_DeletionCursor([]byte(cursor))
+	}
+
+	// the configuration may have been removed in the meantime, which isn't an error.
+	_, err = db.db.Update_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketLifecycleConfig_ProjectId(bucket.ProjectID[:]),
+		dbx.BucketLifecycleConfig_BucketName([]byte(bucket.BucketName)),
+		dbx.BucketLifecycleConfig_Update_Fields{
+			DeletionCursor: deletionCursor,
+		},
+	)
+	return buckets.ErrBucket.Wrap(err)
+}
+
+// ListBucketLifecycleConfigs returns up to limit lifecycle configurations of buckets
+// that are ordered after the cursor.
+func (db *bucketsDB) ListBucketLifecycleConfigs(ctx context.Context, cursor metabase.BucketLocation, limit int) (_ []buckets.BucketLifecycle, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var rows tagsql.Rows
+	switch db.db.impl {
+	case dbutil.Postgres, dbutil.Cockroach:
+		rows, err = db.db.QueryContext(ctx, `
+			SELECT project_id, bucket_name, rules, deletion_cursor, created_at, updated_at
+			FROM bucket_lifecycle_configs
+			WHERE (project_id, bucket_name) > ($1, $2)
+			ORDER BY project_id, bucket_name
+			LIMIT $3
+		`, cursor.ProjectID[:], []byte(cursor.BucketName), limit)
+
+	case dbutil.Spanner:
+		rows, err = db.db.QueryContext(ctx, `
+			SELECT project_id, bucket_name, rules, deletion_cursor, created_at, updated_at
+			FROM bucket_lifecycle_configs
+			WHERE project_id > @project_id
+				OR (project_id = @project_id AND bucket_name > @bucket_name)
+			ORDER BY project_id, bucket_name
+			LIMIT @limit
+		`, sql.Named("project_id", cursor.ProjectID.Bytes()), sql.Named("bucket_name", []byte(cursor.BucketName)), sql.Named("limit", int64(limit)))
+
+	default:
+		return nil, buckets.ErrBucket.New("unsupported database implementation: %v", db.db.impl)
+	}
+	if err != nil {
+		return nil, buckets.ErrBucket.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var configs []buckets.BucketLifecycle
+	for rows.Next() {
+		var config buckets.BucketLifecycle
+		var bucketName, rules, deletionCursor []byte
+		err := rows.Scan(&config.Location.ProjectID, &bucketName, &rules, &deletionCursor, &config.Configuration.CreatedAt, &config.Configuration.UpdatedAt)
+		if err != nil {
+			return nil, buckets.ErrBucket.Wrap(err)
+		}
+		config.Location.BucketName = metabase.BucketName(bucketName)
+		config.DeletionCursor = metabase.ObjectKey(deletionCursor)
+
+		if err := json.Unmarshal(rules, &config.Configuration.Rules); err != nil {
+			return nil, buckets.ErrBucket.New("error decoding lifecycle rules: %w", err)
+		}
+		configs = append(configs, config)
+	}
+
+	return configs, buckets.ErrBucket.Wrap(rows.Err())
+}
@@ -64,6 +64,26 @@ func (db *ConsoleDB) ProjectMembers() console.ProjectMembers {
 	return &projectMembers{db: db.Methods, impl: db.Impl}
 }
 
+// ProjectRoles is a getter for ProjectRoles repository.
+func (db *ConsoleDB) ProjectRoles() console.ProjectRoles {
+	return &projectRoles{db: db.Methods}
+}
+
+// ScimGroups is a getter for ScimGroups repository.
+func (db *ConsoleDB) ScimGroups() console.ScimGroups {
+	return &scimGroups{db: db.Methods}
+}
+
+// WebAuthnCredentials is a getter for WebAuthnCredentials repository.
+func (db *ConsoleDB) WebAuthnCredentials() console.WebAuthnCredentials {
+	return &webAuthnCredentials{db: db.Methods}
+}
+
+// Organizations is a getter for Organizations repository.
+func (db *ConsoleDB) Organizations() console.Organizations {
+	return &organizations{db: db.Methods}
+}
+
 // Entitlements is a getter for Entitlements repository.
 func (db *ConsoleDB) Entitlements() entitlements.DB {
 	return &entitlementsDB{db: db.Methods}
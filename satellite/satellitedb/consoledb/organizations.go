@@ -0,0 +1,431 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoledb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that organizations implements console.Organizations.
+var _ console.Organizations = (*organizations)(nil)
+
+// organizations exposes methods to manage the organizations, organization_members
+// and organization_projects tables in database.
+type organizations struct {
+	db dbx.DriverMethods
+}
+
+// Insert is a method for inserting an organization into the database.
+func (orgs *organizations) Insert(ctx context.Context, organization console.Organization) (_ *console.Organization, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxOrganization, err := orgs.db.Create_Organization(ctx,
+		dbx.Organization_Id(organization.ID[:]),
+		dbx.Organization_Name(organization.Name),
+		dbx.Organization_OwnerId(organization.OwnerID[:]),
+		dbx.Organization_Create_Fields{
+			ProjectLimit:   dbx.Organization_ProjectLimit_Raw(organization.ProjectLimit),
+			StorageLimit:   dbx.Organization_StorageLimit_Raw(sizeToInt64(organization.StorageLimit)),
+			BandwidthLimit: dbx.Organization_BandwidthLimit_Raw(sizeToInt64(organization.BandwidthLimit)),
+			SegmentLimit:   dbx.Organization_SegmentLimit_Raw(organization.SegmentLimit),
+		},
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return organizationFromDBX(dbxOrganization)
+}
+
+// Get is a method for querying an organization by its ID.
+func (orgs *organizations) Get(ctx context.Context, id uuid.UUID) (_ *console.Organization, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxOrganization, err := orgs.db.Get_Organization_By_Id(ctx, dbx.Organization_Id(id[:]))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, console.ErrNoOrganization.New("%s", id)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return organizationFromDBX(dbxOrganization)
+}
+
+// GetByMemberID is a method for querying the organizations the user is a member of ordered by name.
+func (orgs *organizations) GetByMemberID(ctx context.Context, memberID uuid.UUID) (_ []console.MemberOrganization, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := orgs.db.QueryContext(ctx, orgs.db.Rebind(`
+		SELECT o.id, o.name, o.owner_id, o.project_limit, o.storage_limit, o.bandwidth_limit, o.segment_limit, o.created_at, m.role
+		FROM organization_members m
+		JOIN organizations o ON o.id = m.organization_id
+		WHERE m.member_id = ?
+		ORDER BY o.name, o.id
+	`), memberID[:])
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	result := []console.MemberOrganization{}
+	for rows.Next() {
+		var organization console.MemberOrganization
+		var role int64
+		err = scanOrganizationInto(rows, &organization.Organization, &role)
+		if err != nil {
+			return nil, err
+		}
+		organization.Role = console.OrganizationRole(role)
+		result = append(result, organization)
+	}
+
+	return result, Error.Wrap(rows.Err())
+}
+
+// GetByProjectID is a method for querying the organization owning the project.
+func (orgs *organizations) GetByProjectID(ctx context.Context, projectID uuid.UUID) (_ *console.Organization, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxProject, err := orgs.db.Get_OrganizationProject_By_ProjectId(ctx, dbx.OrganizationProject_ProjectId(projectID[:]))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, console.ErrNoOrganization.New("project %s", projectID)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	organizationID, err := uuid.FromBytes(dbxProject.OrganizationId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return orgs.Get(ctx, organizationID)
+}
+
+// Update is a method for updating the name and the limits of the organization.
+func (orgs *organizations) Update(ctx context.Context, organization console.Organization) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxOrganization, err := orgs.db.Update_Organization_By_Id(ctx,
+		dbx.Organization_Id(organization.ID[:]),
+		dbx.Organization_Update_Fields{
+			Name:           dbx.Organization_Name(organization.Name),
+			ProjectLimit:   dbx.Organization_ProjectLimit_Raw(organization.ProjectLimit),
+			StorageLimit:   dbx.Organization_StorageLimit_Raw(sizeToInt64(organization.StorageLimit)),
+			BandwidthLimit: dbx.Organization_BandwidthLimit_Raw(sizeToInt64(organization.BandwidthLimit)),
+			SegmentLimit:   dbx.Organization_SegmentLimit_Raw(organization.SegmentLimit),
+		},
+	)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if dbxOrganization == nil {
+		return console.ErrNoOrganization.New("%s", organization.ID)
+	}
+
+	return nil
+}
+
+// Delete is a method for deleting the organization. The memberships of the organization are deleted with it.
+func (orgs *organizations) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err := orgs.db.Delete_Organization_By_Id(ctx, dbx.Organization_Id(id[:]))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !deleted {
+		return console.ErrNoOrganization.New("%s", id)
+	}
+
+	return nil
+}
+
+// InsertMember is a method for adding the user to the organization.
+func (orgs *organizations) InsertMember(ctx context.Context, organizationID, memberID uuid.UUID, role console.OrganizationRole) (_ *console.OrganizationMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxMember, err := orgs.db.Create_OrganizationMember(ctx,
+		dbx.OrganizationMember_OrganizationId(organizationID[:]),
+		dbx.OrganizationMember_MemberId(memberID[:]),
+		dbx.OrganizationMember_Role(int(role)),
+	)
+	if err != nil {
+		if dbx.IsConstraintError(err) {
+			return nil, console.ErrOrganizationMemberAlreadyExists.New("%s", memberID)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return organizationMemberFromDBX(dbxMember)
+}
+
+// GetMember is a method for querying the membership of the user in the organization.
+func (orgs *organizations) GetMember(ctx context.Context, organizationID, memberID uuid.UUID) (_ *console.OrganizationMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxMember, err := orgs.db.Get_OrganizationMember_By_OrganizationId_And_MemberId(ctx,
+		dbx.OrganizationMember_OrganizationId(organizationID[:]),
+		dbx.OrganizationMember_MemberId(memberID[:]),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, console.ErrNoOrganizationMember.New("%s", memberID)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return organizationMemberFromDBX(dbxMember)
+}
+
+// GetMembers is a method for querying the members of the organization ordered by email.
+func (orgs *organizations) GetMembers(ctx context.Context, organizationID uuid.UUID) (_ []console.OrganizationMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := orgs.db.QueryContext(ctx, orgs.db.Rebind(`
+		SELECT m.member_id, m.role, m.created_at, u.email, u.full_name
+		FROM organization_members m
+		JOIN users u ON u.id = m.member_id
+		WHERE m.organization_id = ?
+		ORDER BY u.email, m.member_id
+	`), organizationID[:])
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	result := []console.OrganizationMember{}
+	for rows.Next() {
+		member := console.OrganizationMember{OrganizationID: organizationID}
+		var role int64
+		err = rows.Scan(&member.MemberID, &role, &member.CreatedAt, &member.Email, &member.FullName)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		member.Role = console.OrganizationRole(role)
+		result = append(result, member)
+	}
+
+	return result, Error.Wrap(rows.Err())
+}
+
+// UpdateMemberRole is a method for updating the role of the member of the organization.
+func (orgs *organizations) UpdateMemberRole(ctx context.Context, organizationID, memberID uuid.UUID, role console.OrganizationRole) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxMember, err := orgs.db.Update_OrganizationMember_By_OrganizationId_And_MemberId(ctx,
+		dbx.OrganizationMember_OrganizationId(organizationID[:]),
+		dbx.OrganizationMember_MemberId(memberID[:]),
+		dbx.OrganizationMember_Update_Fields{
+			Role: dbx.OrganizationMember_Role(int(role)),
+		},
+	)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if dbxMember == nil {
+		return console.ErrNoOrganizationMember.New("%s", memberID)
+	}
+
+	return nil
+}
+
+// DeleteMember is a method for removing the user from the organization.
+func (orgs *organizations) DeleteMember(ctx context.Context, organizationID, memberID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err := orgs.db.Delete_OrganizationMember_By_OrganizationId_And_MemberId(ctx,
+		dbx.OrganizationMember_OrganizationId(organizationID[:]),
+		dbx.OrganizationMember_MemberId(memberID[:]),
+	)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !deleted {
+		return console.ErrNoOrganizationMember.New("%s", memberID)
+	}
+
+	return nil
+}
+
+// AddProject is a method for adding the project to the organization. The
+// organization's owner becomes the owner of the project, so the project is
+// billed to them.
+func (orgs *organizations) AddProject(ctx context.Context, organizationID, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	organization, err := orgs.Get(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+
+	project, err := orgs.db.Get_Project_By_Id(ctx, dbx.Project_Id(projectID[:]))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Error.New("project %s doesn't exist", projectID)
+		}
+		return Error.Wrap(err)
+	}
+
+	ownerChanges := !bytes.Equal(project.OwnerId, organization.OwnerID[:])
+	if ownerChanges {
+		// the project counts towards the project limit of its new owner.
+		row, err := orgs.db.Get_User_ProjectLimit_By_Id(ctx, dbx.User_Id(organization.OwnerID[:]))
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		ownProjects, err := orgs.db.All_Project_By_OwnerId_And_Status_OrderBy_Asc_CreatedAt(ctx,
+			dbx.Project_OwnerId(organization.OwnerID[:]),
+			dbx.Project_Status(int(console.ProjectActive)))
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(ownProjects) >= row.ProjectLimit {
+			return console.ErrProjLimit.New("the organization owner reached their project limit")
+		}
+	}
+
+	_, err = orgs.db.Create_OrganizationProject(ctx,
+		dbx.OrganizationProject_ProjectId(projectID[:]),
+		dbx.OrganizationProject_OrganizationId(organizationID[:]),
+	)
+	if err != nil {
+		if dbx.IsConstraintError(err) {
+			return console.ErrOrganizationProjectAlreadyExists.New("%s", projectID)
+		}
+		return Error.Wrap(err)
+	}
+
+	if !ownerChanges {
+		return nil
+	}
+
+	// projects.owner_id isn't updatable through dbx.
+	_, err = orgs.db.ExecContext(ctx, orgs.db.Rebind(`
+		UPDATE projects
+		SET owner_id = ?
+		WHERE id = ?
+	`), organization.OwnerID[:], projectID[:])
+	return Error.Wrap(err)
+}
+
+// GetProjectIDs is a method for querying the IDs of the projects of the organization.
+func (orgs *organizations) GetProjectIDs(ctx context.Context, organizationID uuid.UUID) (_ []uuid.UUID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxProjects, err := orgs.db.All_OrganizationProject_By_OrganizationId_OrderBy_Asc_CreatedAt_Asc_ProjectId(ctx,
+		dbx.OrganizationProject_OrganizationId(organizationID[:]))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	projectIDs := make([]uuid.UUID, 0, len(dbxProjects))
+	for _, dbxProject := range dbxProjects {
+		projectID, err := uuid.FromBytes(dbxProject.ProjectId)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		projectIDs = append(projectIDs, projectID)
+	}
+
+	return projectIDs, nil
+}
+
+// organizationFromDBX converts a dbx organization into console.Organization.
+func organizationFromDBX(organization *dbx.Organization) (*console.Organization, error) {
+	id, err := uuid.FromBytes(organization.Id)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	ownerID, err := uuid.FromBytes(organization.OwnerId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &console.Organization{
+		ID:             id,
+		Name:           organization.Name,
+		OwnerID:        ownerID,
+		ProjectLimit:   organization.ProjectLimit,
+		StorageLimit:   int64ToSize(organization.StorageLimit),
+		BandwidthLimit: int64ToSize(organization.BandwidthLimit),
+		SegmentLimit:   organization.SegmentLimit,
+		CreatedAt:      organization.CreatedAt,
+	}, nil
+}
+
+// organizationMemberFromDBX converts a dbx organization member into console.OrganizationMember.
+func organizationMemberFromDBX(member *dbx.OrganizationMember) (*console.OrganizationMember, error) {
+	organizationID, err := uuid.FromBytes(member.OrganizationId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	memberID, err := uuid.FromBytes(member.MemberId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &console.OrganizationMember{
+		OrganizationID: organizationID,
+		MemberID:       memberID,
+		Role:           console.OrganizationRole(member.Role),
+		CreatedAt:      member.CreatedAt,
+	}, nil
+}
+
+// scanOrganizationInto scans the organization columns followed by the extra columns from the row.
+func scanOrganizationInto(row interface{ Scan(...any) error }, organization *console.Organization, extra ...any) error {
+	var projectLimit, storageLimit, bandwidthLimit, segmentLimit sql.NullInt64
+	err := row.Scan(append([]any{&organization.ID, &organization.Name, &organization.OwnerID,
+		&projectLimit, &storageLimit, &bandwidthLimit, &segmentLimit, &organization.CreatedAt}, extra...)...)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if projectLimit.Valid {
+		limit := int(projectLimit.Int64)
+		organization.ProjectLimit = &limit
+	}
+	if storageLimit.Valid {
+		organization.StorageLimit = int64ToSize(&storageLimit.Int64)
+	}
+	if bandwidthLimit.Valid {
+		organization.BandwidthLimit = int64ToSize(&bandwidthLimit.Int64)
+	}
+	if segmentLimit.Valid {
+		organization.SegmentLimit = &segmentLimit.Int64
+	}
+
+	return nil
+}
+
+// sizeToInt64 converts a nil size into a nil int64.
+func sizeToInt64(value *memory.Size) *int64 {
+	if value == nil {
+		return nil
+	}
+	v := value.Int64()
+	return &v
+}
+
+// int64ToSize converts a nil int64 into a nil size.
+func int64ToSize(value *int64) *memory.Size {
+	if value == nil {
+		return nil
+	}
+	v := memory.Size(*value)
+	return &v
+}
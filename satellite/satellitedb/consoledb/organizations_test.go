@@ -0,0 +1,158 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoledb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestOrganizationsRepository(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		organizations := db.Console().Organizations()
+
+		var users []*console.User
+		for _, email := range []string{"alice@example.test", "bob@example.test"} {
+			user, err := db.Console().Users().Insert(ctx, &console.User{
+				ID:           testrand.UUID(),
+				FullName:     "test",
+				Email:        email,
+				PasswordHash: []byte("testPass"),
+				ProjectLimit: 1,
+			})
+			require.NoError(t, err)
+			users = append(users, user)
+		}
+		owner, member := users[0], users[1]
+
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{
+			ID:      testrand.UUID(),
+			Name:    "project",
+			OwnerID: member.ID,
+		})
+		require.NoError(t, err)
+
+		storageLimit := 10 * memory.GB
+		organization, err := organizations.Insert(ctx, console.Organization{
+			ID:           testrand.UUID(),
+			Name:         "Example Org",
+			OwnerID:      owner.ID,
+			StorageLimit: &storageLimit,
+		})
+		require.NoError(t, err)
+
+		got, err := organizations.Get(ctx, organization.ID)
+		require.NoError(t, err)
+		require.Equal(t, "Example Org", got.Name)
+		require.Equal(t, storageLimit, *got.StorageLimit)
+		require.Nil(t, got.ProjectLimit)
+		require.Nil(t, got.BandwidthLimit)
+		require.Nil(t, got.SegmentLimit)
+
+		_, err = organizations.Get(ctx, testrand.UUID())
+		require.True(t, console.ErrNoOrganization.Has(err))
+
+		projectLimit, segmentLimit := 5, int64(1000)
+		got.Name = "Renamed Org"
+		got.ProjectLimit = &projectLimit
+		got.StorageLimit = nil
+		got.SegmentLimit = &segmentLimit
+		require.NoError(t, organizations.Update(ctx, *got))
+
+		got, err = organizations.Get(ctx, organization.ID)
+		require.NoError(t, err)
+		require.Equal(t, "Renamed Org", got.Name)
+		require.Equal(t, projectLimit, *got.ProjectLimit)
+		require.Nil(t, got.StorageLimit)
+		require.Equal(t, segmentLimit, *got.SegmentLimit)
+
+		t.Run("members", func(t *testing.T) {
+			_, err := organizations.InsertMember(ctx, organization.ID, owner.ID, console.OrganizationRoleOwner)
+			require.NoError(t, err)
+			_, err = organizations.InsertMember(ctx, organization.ID, member.ID, console.OrganizationRoleBilling)
+			require.NoError(t, err)
+
+			_, err = organizations.InsertMember(ctx, organization.ID, member.ID, console.OrganizationRoleAdmin)
+			require.True(t, console.ErrOrganizationMemberAlreadyExists.Has(err))
+
+			members, err := organizations.GetMembers(ctx, organization.ID)
+			require.NoError(t, err)
+			require.Len(t, members, 2)
+			require.Equal(t, owner.Email, members[0].Email)
+			require.Equal(t, console.OrganizationRoleOwner, members[0].Role)
+			require.Equal(t, member.Email, members[1].Email)
+
+			require.NoError(t, organizations.UpdateMemberRole(ctx, organization.ID, member.ID, console.OrganizationRoleAdmin))
+			require.True(t, console.ErrNoOrganizationMember.Has(organizations.UpdateMemberRole(ctx, organization.ID, testrand.UUID(), console.OrganizationRoleAdmin)))
+
+			got, err := organizations.GetMember(ctx, organization.ID, member.ID)
+			require.NoError(t, err)
+			require.Equal(t, console.OrganizationRoleAdmin, got.Role)
+
+			list, err := organizations.GetByMemberID(ctx, member.ID)
+			require.NoError(t, err)
+			require.Len(t, list, 1)
+			require.Equal(t, organization.ID, list[0].ID)
+			require.Equal(t, console.OrganizationRoleAdmin, list[0].Role)
+
+			require.NoError(t, organizations.DeleteMember(ctx, organization.ID, member.ID))
+			require.True(t, console.ErrNoOrganizationMember.Has(organizations.DeleteMember(ctx, organization.ID, member.ID)))
+
+			_, err = organizations.GetMember(ctx, organization.ID, member.ID)
+			require.True(t, console.ErrNoOrganizationMember.Has(err))
+		})
+
+		t.Run("projects", func(t *testing.T) {
+			_, err := organizations.GetByProjectID(ctx, project.ID)
+			require.True(t, console.ErrNoOrganization.Has(err))
+
+			require.NoError(t, organizations.AddProject(ctx, organization.ID, project.ID))
+			require.True(t, console.ErrOrganizationProjectAlreadyExists.Has(organizations.AddProject(ctx, organization.ID, project.ID)))
+			require.True(t, console.ErrNoOrganization.Has(organizations.AddProject(ctx, testrand.UUID(), project.ID)))
+
+			// the organization's owner becomes the owner of the project.
+			got, err := db.Console().Projects().Get(ctx, project.ID)
+			require.NoError(t, err)
+			require.Equal(t, owner.ID, got.OwnerID)
+
+			org, err := organizations.GetByProjectID(ctx, project.ID)
+			require.NoError(t, err)
+			require.Equal(t, organization.ID, org.ID)
+
+			projectIDs, err := organizations.GetProjectIDs(ctx, organization.ID)
+			require.NoError(t, err)
+			require.Equal(t, []uuid.UUID{project.ID}, projectIDs)
+
+			// the project doesn't fit into the project limit of the organization's owner.
+			otherProject, err := db.Console().Projects().Insert(ctx, &console.Project{
+				ID:      testrand.UUID(),
+				Name:    "other project",
+				OwnerID: member.ID,
+			})
+			require.NoError(t, err)
+			require.True(t, console.ErrProjLimit.Has(organizations.AddProject(ctx, organization.ID, otherProject.ID)))
+			require.NoError(t, db.Console().Projects().Delete(ctx, otherProject.ID))
+		})
+
+		t.Run("delete", func(t *testing.T) {
+			require.NoError(t, db.Console().Projects().Delete(ctx, project.ID))
+
+			require.NoError(t, organizations.Delete(ctx, organization.ID))
+			require.True(t, console.ErrNoOrganization.Has(organizations.Delete(ctx, organization.ID)))
+
+			list, err := organizations.GetByMemberID(ctx, owner.ID)
+			require.NoError(t, err)
+			require.Empty(t, list)
+		})
+	})
+}
@@ -332,8 +332,18 @@ func (pm *projectMembers) Insert(ctx context.Context, memberID, projectID uuid.U
 }
 
 // Delete is a method for deleting project member by memberID and projectID from the database.
+// The custom role assigned to the member is removed with it.
 func (pm *projectMembers) Delete(ctx context.Context, memberID, projectID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
+	_, err = pm.db.Delete_ProjectMemberRole_By_MemberId_And_ProjectId(
+		ctx,
+		dbx.ProjectMemberRole_MemberId(memberID[:]),
+		dbx.ProjectMemberRole_ProjectId(projectID[:]),
+	)
+	if err != nil {
+		return err
+	}
+
 	_, err = pm.db.Delete_ProjectMember_By_MemberId_And_ProjectId(
 		ctx,
 		dbx.ProjectMember_MemberId(memberID[:]),
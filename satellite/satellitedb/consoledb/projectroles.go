@@ -0,0 +1,209 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoledb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that projectRoles implements console.ProjectRoles.
+var _ console.ProjectRoles = (*projectRoles)(nil)
+
+// projectRoles exposes methods to manage the project_roles and project_member_roles tables in database.
+type projectRoles struct {
+	db dbx.DriverMethods
+}
+
+// Insert is a method for inserting a custom role into the database.
+func (roles *projectRoles) Insert(ctx context.Context, role console.ProjectRole) (_ *console.ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxRole, err := roles.db.Create_ProjectRole(ctx,
+		dbx.ProjectRole_Id(role.ID[:]),
+		dbx.ProjectRole_ProjectId(role.ProjectID[:]),
+		dbx.ProjectRole_Name(role.Name),
+		dbx.ProjectRole_Permissions(int64(role.Permissions)),
+	)
+	if err != nil {
+		if dbx.IsConstraintError(err) {
+			return nil, console.ErrProjectRoleAlreadyExists.New("%s", role.Name)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return projectRoleFromDBX(dbxRole)
+}
+
+// Get is a method for querying a custom role by its ID.
+func (roles *projectRoles) Get(ctx context.Context, id uuid.UUID) (_ *console.ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxRole, err := roles.db.Get_ProjectRole_By_Id(ctx, dbx.ProjectRole_Id(id[:]))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, console.ErrNoProjectRole.New("%s", id)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return projectRoleFromDBX(dbxRole)
+}
+
+// GetByProjectID is a method for querying the custom roles of the project ordered by name,
+// together with the members they are assigned to.
+func (roles *projectRoles) GetByProjectID(ctx context.Context, projectID uuid.UUID) (_ []console.ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxRoles, err := roles.db.All_ProjectRole_By_ProjectId_OrderBy_Asc_Name(ctx, dbx.ProjectRole_ProjectId(projectID[:]))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	result := make([]console.ProjectRole, 0, len(dbxRoles))
+	index := make(map[uuid.UUID]int, len(dbxRoles))
+	for _, dbxRole := range dbxRoles {
+		role, err := projectRoleFromDBX(dbxRole)
+		if err != nil {
+			return nil, err
+		}
+		role.MemberIDs = []uuid.UUID{}
+		index[role.ID] = len(result)
+		result = append(result, *role)
+	}
+	if len(result) == 0 {
+		return result, nil
+	}
+
+	assignments, err := roles.db.All_ProjectMemberRole_By_ProjectId_OrderBy_Asc_MemberId(ctx, dbx.ProjectMemberRole_ProjectId(projectID[:]))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	for _, assignment := range assignments {
+		roleID, err := uuid.FromBytes(assignment.RoleId)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		memberID, err := uuid.FromBytes(assignment.MemberId)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if i, ok := index[roleID]; ok {
+			result[i].MemberIDs = append(result[i].MemberIDs, memberID)
+		}
+	}
+
+	return result, nil
+}
+
+// Update is a method for updating the name and the permissions of the custom role.
+func (roles *projectRoles) Update(ctx context.Context, role console.ProjectRole) (_ *console.ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxRole, err := roles.db.Update_ProjectRole_By_Id(ctx,
+		dbx.ProjectRole_Id(role.ID[:]),
+		dbx.ProjectRole_Update_Fields{
+			Name:        dbx.ProjectRole_Name(role.Name),
+			Permissions: dbx.ProjectRole_Permissions(int64(role.Permissions)),
+		},
+	)
+	if err != nil {
+		if dbx.IsConstraintError(err) {
+			return nil, console.ErrProjectRoleAlreadyExists.New("%s", role.Name)
+		}
+		return nil, Error.Wrap(err)
+	}
+	if dbxRole == nil {
+		return nil, console.ErrNoProjectRole.New("%s", role.ID)
+	}
+
+	return projectRoleFromDBX(dbxRole)
+}
+
+// Delete is a method for deleting the custom role. The assignments of the role are deleted with it.
+func (roles *projectRoles) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = roles.db.Delete_ProjectRole_By_Id(ctx, dbx.ProjectRole_Id(id[:]))
+	return Error.Wrap(err)
+}
+
+// Assign is a method for assigning the custom role to the project member.
+func (roles *projectRoles) Assign(ctx context.Context, memberID, projectID, roleID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = roles.db.Replace_ProjectMemberRole(ctx,
+		dbx.ProjectMemberRole_MemberId(memberID[:]),
+		dbx.ProjectMemberRole_ProjectId(projectID[:]),
+		dbx.ProjectMemberRole_RoleId(roleID[:]),
+	)
+	return Error.Wrap(err)
+}
+
+// Unassign is a method for removing the custom role assignment of the project member.
+func (roles *projectRoles) Unassign(ctx context.Context, memberID, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = roles.db.Delete_ProjectMemberRole_By_MemberId_And_ProjectId(ctx,
+		dbx.ProjectMemberRole_MemberId(memberID[:]),
+		dbx.ProjectMemberRole_ProjectId(projectID[:]),
+	)
+	return Error.Wrap(err)
+}
+
+// GetAssigned is a method for querying the custom role assigned to the project member.
+func (roles *projectRoles) GetAssigned(ctx context.Context, memberID, projectID uuid.UUID) (_ *console.ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	assignment, err := roles.db.Get_ProjectMemberRole_By_MemberId_And_ProjectId(ctx,
+		dbx.ProjectMemberRole_MemberId(memberID[:]),
+		dbx.ProjectMemberRole_ProjectId(projectID[:]),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, console.ErrNoProjectRole.New("")
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	dbxRole, err := roles.db.Get_ProjectRole_By_Id(ctx, dbx.ProjectRole_Id(assignment.RoleId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, console.ErrNoProjectRole.New("")
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return projectRoleFromDBX(dbxRole)
+}
+
+// projectRoleFromDBX converts a custom role from the database to a *console.ProjectRole.
+func projectRoleFromDBX(dbxRole *dbx.ProjectRole) (_ *console.ProjectRole, err error) {
+	if dbxRole == nil {
+		return nil, Error.New("dbx project role is nil")
+	}
+
+	id, err := uuid.FromBytes(dbxRole.Id)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	projectID, err := uuid.FromBytes(dbxRole.ProjectId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &console.ProjectRole{
+		ID:          id,
+		ProjectID:   projectID,
+		Name:        dbxRole.Name,
+		Permissions: console.Permission(dbxRole.Permissions),
+		CreatedAt:   dbxRole.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoledb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestProjectRolesRepository(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		roles := db.Console().ProjectRoles()
+
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{Name: "ProjectName"})
+		require.NoError(t, err)
+
+		user, err := db.Console().Users().Insert(ctx, &console.User{
+			ID:           testrand.UUID(),
+			FullName:     "test",
+			Email:        "test@example.test",
+			PasswordHash: []byte("testPass"),
+		})
+		require.NoError(t, err)
+
+		_, err = db.Console().ProjectMembers().Insert(ctx, user.ID, project.ID, console.RoleMember)
+		require.NoError(t, err)
+
+		viewer, err := roles.Insert(ctx, console.ProjectRole{
+			ID:          testrand.UUID(),
+			ProjectID:   project.ID,
+			Name:        "Viewer",
+			Permissions: console.PermissionViewUsage,
+		})
+		require.NoError(t, err)
+		require.False(t, viewer.CreatedAt.IsZero())
+
+		_, err = roles.Insert(ctx, console.ProjectRole{
+			ID:          testrand.UUID(),
+			ProjectID:   project.ID,
+			Name:        "Viewer",
+			Permissions: console.PermissionManageBuckets,
+		})
+		require.True(t, console.ErrProjectRoleAlreadyExists.Has(err))
+
+		billing, err := roles.Insert(ctx, console.ProjectRole{
+			ID:          testrand.UUID(),
+			ProjectID:   project.ID,
+			Name:        "Billing",
+			Permissions: console.PermissionManageBilling | console.PermissionViewUsage,
+		})
+		require.NoError(t, err)
+
+		role, err := roles.Get(ctx, viewer.ID)
+		require.NoError(t, err)
+		require.Equal(t, viewer.Name, role.Name)
+		require.Equal(t, project.ID, role.ProjectID)
+		require.Equal(t, viewer.Permissions, role.Permissions)
+
+		_, err = roles.Get(ctx, testrand.UUID())
+		require.True(t, console.ErrNoProjectRole.Has(err))
+
+		_, err = roles.GetAssigned(ctx, user.ID, project.ID)
+		require.True(t, console.ErrNoProjectRole.Has(err))
+
+		require.NoError(t, roles.Assign(ctx, user.ID, project.ID, viewer.ID))
+		require.NoError(t, roles.Assign(ctx, user.ID, project.ID, billing.ID))
+
+		assigned, err := roles.GetAssigned(ctx, user.ID, project.ID)
+		require.NoError(t, err)
+		require.Equal(t, billing.ID, assigned.ID)
+
+		list, err := roles.GetByProjectID(ctx, project.ID)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, billing.ID, list[0].ID)
+		require.Equal(t, []uuid.UUID{user.ID}, list[0].MemberIDs)
+		require.Equal(t, viewer.ID, list[1].ID)
+		require.Empty(t, list[1].MemberIDs)
+
+		billing.Name = "Finance"
+		billing.Permissions = console.PermissionManageBilling
+		updated, err := roles.Update(ctx, *billing)
+		require.NoError(t, err)
+		require.Equal(t, "Finance", updated.Name)
+		require.Equal(t, console.PermissionManageBilling, updated.Permissions)
+
+		_, err = roles.Update(ctx, console.ProjectRole{ID: testrand.UUID(), Name: "Missing"})
+		require.True(t, console.ErrNoProjectRole.Has(err))
+
+		require.NoError(t, roles.Unassign(ctx, user.ID, project.ID))
+		_, err = roles.GetAssigned(ctx, user.ID, project.ID)
+		require.True(t, console.ErrNoProjectRole.Has(err))
+
+		// Deleting the role removes its assignments.
+		require.NoError(t, roles.Assign(ctx, user.ID, project.ID, billing.ID))
+		require.NoError(t, roles.Delete(ctx, billing.ID))
+		_, err = roles.GetAssigned(ctx, user.ID, project.ID)
+		require.True(t, console.ErrNoProjectRole.Has(err))
+
+		// Removing the member removes their assignment.
+		require.NoError(t, roles.Assign(ctx, user.ID, project.ID, viewer.ID))
+		require.NoError(t, db.Console().ProjectMembers().Delete(ctx, user.ID, project.ID))
+		list, err = roles.GetByProjectID(ctx, project.ID)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Empty(t, list[0].MemberIDs)
+	})
+}
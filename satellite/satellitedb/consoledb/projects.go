@@ -223,6 +223,68 @@ func (projects *projects) GetEncryptedPassphrase(ctx context.Context, id uuid.UU
 	return encPassphrase, keyID, err
 }
 
+// ListEncryptedPassphrases returns the passphrases encrypted with the key, ordered by project ID and starting after the cursor.
+func (projects *projects) ListEncryptedPassphrases(ctx context.Context, keyID int, cursor uuid.UUID, limit int) (_ []console.EncryptedPassphrase, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := projects.db.QueryContext(ctx, projects.db.Rebind(`
+		SELECT id, passphrase_enc
+		FROM projects
+		WHERE id > ? AND passphrase_enc_key_id = ? AND passphrase_enc IS NOT NULL
+		ORDER BY id
+		LIMIT ?
+	`), cursor, keyID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var passphrases []console.EncryptedPassphrase
+	for rows.Next() {
+		passphrase := console.EncryptedPassphrase{KeyID: keyID}
+		if err := rows.Scan(&passphrase.ProjectID, &passphrase.PassphraseEnc); err != nil {
+			return nil, err
+		}
+		passphrases = append(passphrases, passphrase)
+	}
+
+	return passphrases, rows.Err()
+}
+
+// CountEncryptedPassphrases returns the number of passphrases encrypted with the key.
+func (projects *projects) CountEncryptedPassphrases(ctx context.Context, keyID int) (count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = projects.db.QueryRowContext(ctx, projects.db.Rebind(`
+		SELECT COUNT(*)
+		FROM projects
+		WHERE passphrase_enc_key_id = ? AND passphrase_enc IS NOT NULL
+	`), keyID).Scan(&count)
+
+	return count, err
+}
+
+// UpdateEncryptedPassphrase replaces the encrypted passphrase of the project, if it is still encrypted with the old key.
+func (projects *projects) UpdateEncryptedPassphrase(ctx context.Context, id uuid.UUID, oldKeyID int, passphraseEnc []byte, keyID int) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := projects.db.ExecContext(ctx, projects.db.Rebind(`
+		UPDATE projects
+		SET passphrase_enc = ?, passphrase_enc_key_id = ?
+		WHERE id = ? AND passphrase_enc_key_id = ?
+	`), passphraseEnc, keyID, id, oldKeyID)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
 // GetByPublicID is a method for querying project from the database by public_id.
 func (projects *projects) GetByPublicID(ctx context.Context, publicID uuid.UUID) (_ *console.Project, err error) {
 	defer mon.Task()(&ctx)(&err)
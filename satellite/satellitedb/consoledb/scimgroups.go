@@ -0,0 +1,294 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoledb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that scimGroups implements console.ScimGroups.
+var _ console.ScimGroups = (*scimGroups)(nil)
+
+// scimGroups exposes methods to manage the scim_groups and scim_group_members tables in database.
+type scimGroups struct {
+	db dbx.DriverMethods
+}
+
+// Insert is a method for inserting a group into the database.
+func (groups *scimGroups) Insert(ctx context.Context, group console.ScimGroup) (_ *console.ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxGroup, err := groups.db.Create_ScimGroup(ctx,
+		dbx.ScimGroup_Id(group.ID[:]),
+		dbx.ScimGroup_Provider(group.Provider),
+		dbx.ScimGroup_DisplayName(group.DisplayName),
+		dbx.ScimGroup_Role(group.Role),
+		dbx.ScimGroup_Create_Fields{
+			ExternalId: scimGroupExternalID(group.ExternalID),
+			ProjectId:  scimGroupProjectID(group.ProjectID),
+		},
+	)
+	if err != nil {
+		if dbx.IsConstraintError(err) {
+			return nil, console.ErrScimGroupAlreadyExists.New("%s", group.DisplayName)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return scimGroupFromDBX(dbxGroup)
+}
+
+// Get is a method for querying a group by its ID.
+func (groups *scimGroups) Get(ctx context.Context, id uuid.UUID) (_ *console.ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxGroup, err := groups.db.Get_ScimGroup_By_Id(ctx, dbx.ScimGroup_Id(id[:]))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, console.ErrNoScimGroup.New("%s", id)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return scimGroupFromDBX(dbxGroup)
+}
+
+// GetByDisplayName is a method for querying a group of the provider by its display name.
+func (groups *scimGroups) GetByDisplayName(ctx context.Context, provider, displayName string) (_ *console.ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxGroup, err := groups.db.Get_ScimGroup_By_Provider_And_DisplayName(ctx,
+		dbx.ScimGroup_Provider(provider),
+		dbx.ScimGroup_DisplayName(displayName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, console.ErrNoScimGroup.New("%s", displayName)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return scimGroupFromDBX(dbxGroup)
+}
+
+// GetByExternalID is a method for querying a group of the provider by its external ID.
+func (groups *scimGroups) GetByExternalID(ctx context.Context, provider, externalID string) (_ *console.ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxGroup, err := groups.db.First_ScimGroup_By_Provider_And_ExternalId(ctx,
+		dbx.ScimGroup_Provider(provider),
+		dbx.ScimGroup_ExternalId(externalID),
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if dbxGroup == nil {
+		return nil, console.ErrNoScimGroup.New("%s", externalID)
+	}
+
+	return scimGroupFromDBX(dbxGroup)
+}
+
+// List is a method for querying the groups of the provider ordered by display name, and their total count.
+func (groups *scimGroups) List(ctx context.Context, provider string, offset, limit int) (_ []console.ScimGroup, total int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	count, err := groups.db.Count_ScimGroup_By_Provider(ctx, dbx.ScimGroup_Provider(provider))
+	if err != nil {
+		return nil, 0, Error.Wrap(err)
+	}
+	total = int(count)
+	if total == 0 || offset >= total {
+		return []console.ScimGroup{}, total, nil
+	}
+
+	dbxGroups, err := groups.db.Limited_ScimGroup_By_Provider_OrderBy_Asc_DisplayName(ctx,
+		dbx.ScimGroup_Provider(provider),
+		limit, int64(offset),
+	)
+	if err != nil {
+		return nil, 0, Error.Wrap(err)
+	}
+
+	result, err := scimGroupsFromDBX(dbxGroups)
+	return result, total, err
+}
+
+// GetByMember is a method for querying the groups of the provider the user is a member of.
+func (groups *scimGroups) GetByMember(ctx context.Context, provider string, userID uuid.UUID) (_ []console.ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := groups.db.QueryContext(ctx, groups.db.Rebind(`
+		SELECT g.id, g.provider, g.display_name, g.external_id, g.project_id, g.role, g.created_at
+		FROM scim_group_members m
+		JOIN scim_groups g ON g.id = m.group_id
+		WHERE m.user_id = ? AND g.provider = ?
+		ORDER BY g.display_name
+	`), userID[:], provider)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var dbxGroups []*dbx.ScimGroup
+	for rows.Next() {
+		var group dbx.ScimGroup
+		err = rows.Scan(&group.Id, &group.Provider, &group.DisplayName, &group.ExternalId, &group.ProjectId, &group.Role, &group.CreatedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		dbxGroups = append(dbxGroups, &group)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return scimGroupsFromDBX(dbxGroups)
+}
+
+// Update is a method for updating the display name, the external ID and the project mapping of the group.
+func (groups *scimGroups) Update(ctx context.Context, group console.ScimGroup) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxGroup, err := groups.db.Update_ScimGroup_By_Id(ctx,
+		dbx.ScimGroup_Id(group.ID[:]),
+		dbx.ScimGroup_Update_Fields{
+			DisplayName: dbx.ScimGroup_DisplayName(group.DisplayName),
+			ExternalId:  scimGroupExternalID(group.ExternalID),
+			ProjectId:   scimGroupProjectID(group.ProjectID),
+			Role:        dbx.ScimGroup_Role(group.Role),
+		},
+	)
+	if err != nil {
+		if dbx.IsConstraintError(err) {
+			return console.ErrScimGroupAlreadyExists.New("%s", group.DisplayName)
+		}
+		return Error.Wrap(err)
+	}
+	if dbxGroup == nil {
+		return console.ErrNoScimGroup.New("%s", group.ID)
+	}
+
+	return nil
+}
+
+// Delete is a method for deleting the group. The memberships of the group are deleted with it.
+func (groups *scimGroups) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = groups.db.Delete_ScimGroup_By_Id(ctx, dbx.ScimGroup_Id(id[:]))
+	return Error.Wrap(err)
+}
+
+// GetMembers is a method for querying the IDs of the members of the group.
+func (groups *scimGroups) GetMembers(ctx context.Context, groupID uuid.UUID) (_ []uuid.UUID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxMembers, err := groups.db.All_ScimGroupMember_By_GroupId_OrderBy_Asc_UserId(ctx, dbx.ScimGroupMember_GroupId(groupID[:]))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	members := make([]uuid.UUID, 0, len(dbxMembers))
+	for _, dbxMember := range dbxMembers {
+		userID, err := uuid.FromBytes(dbxMember.UserId)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		members = append(members, userID)
+	}
+
+	return members, nil
+}
+
+// AddMember is a method for adding the user to the group.
+func (groups *scimGroups) AddMember(ctx context.Context, groupID, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = groups.db.Replace_ScimGroupMember(ctx,
+		dbx.ScimGroupMember_GroupId(groupID[:]),
+		dbx.ScimGroupMember_UserId(userID[:]),
+	)
+	return Error.Wrap(err)
+}
+
+// RemoveMember is a method for removing the user from the group.
+func (groups *scimGroups) RemoveMember(ctx context.Context, groupID, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = groups.db.Delete_ScimGroupMember_By_GroupId_And_UserId(ctx,
+		dbx.ScimGroupMember_GroupId(groupID[:]),
+		dbx.ScimGroupMember_UserId(userID[:]),
+	)
+	return Error.Wrap(err)
+}
+
+// scimGroupFromDBX converts a group from the database to a *console.ScimGroup.
+func scimGroupFromDBX(dbxGroup *dbx.ScimGroup) (_ *console.ScimGroup, err error) {
+	if dbxGroup == nil {
+		return nil, Error.New("dbx scim group is nil")
+	}
+
+	id, err := uuid.FromBytes(dbxGroup.Id)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	group := &console.ScimGroup{
+		ID:          id,
+		Provider:    dbxGroup.Provider,
+		DisplayName: dbxGroup.DisplayName,
+		Role:        dbxGroup.Role,
+		CreatedAt:   dbxGroup.CreatedAt,
+	}
+	if dbxGroup.ExternalId != nil {
+		group.ExternalID = *dbxGroup.ExternalId
+	}
+	if dbxGroup.ProjectId != nil {
+		projectID, err := uuid.FromBytes(dbxGroup.ProjectId)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		group.ProjectID = &projectID
+	}
+
+	return group, nil
+}
+
+// scimGroupsFromDBX converts a slice of groups from the database to a slice of console.ScimGroup.
+func scimGroupsFromDBX(dbxGroups []*dbx.ScimGroup) (_ []console.ScimGroup, err error) {
+	result := make([]console.ScimGroup, 0, len(dbxGroups))
+	for _, dbxGroup := range dbxGroups {
+		group, err := scimGroupFromDBX(dbxGroup)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *group)
+	}
+	return result, nil
+}
+
+// scimGroupExternalID stores an empty external ID as NULL.
+func scimGroupExternalID(externalID string) dbx.ScimGroup_ExternalId_Field {
+	if externalID == "" {
+		return dbx.ScimGroup_ExternalId_Null()
+	}
+	return dbx.ScimGroup_ExternalId(externalID)
+}
+
+// scimGroupProjectID stores a nil project ID as NULL.
+func scimGroupProjectID(projectID *uuid.UUID) dbx.ScimGroup_ProjectId_Field {
+	if projectID == nil {
+		return dbx.ScimGroup_ProjectId_Null()
+	}
+	return dbx.ScimGroup_ProjectId(projectID.Bytes())
+}
@@ -0,0 +1,145 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoledb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestScimGroupsRepository(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		groups := db.Console().ScimGroups()
+
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{Name: "ProjectName"})
+		require.NoError(t, err)
+
+		var users []*console.User
+		for _, email := range []string{"alice@example.test", "bob@example.test"} {
+			user, err := db.Console().Users().Insert(ctx, &console.User{
+				ID:           testrand.UUID(),
+				FullName:     "test",
+				Email:        email,
+				PasswordHash: []byte("testPass"),
+			})
+			require.NoError(t, err)
+			users = append(users, user)
+		}
+
+		engineering, err := groups.Insert(ctx, console.ScimGroup{
+			ID:          testrand.UUID(),
+			Provider:    "okta",
+			DisplayName: "Engineering",
+			ExternalID:  "00g1",
+			ProjectID:   &project.ID,
+			Role:        "admin",
+		})
+		require.NoError(t, err)
+		require.False(t, engineering.CreatedAt.IsZero())
+
+		_, err = groups.Insert(ctx, console.ScimGroup{ID: testrand.UUID(), Provider: "okta", DisplayName: "Engineering"})
+		require.True(t, console.ErrScimGroupAlreadyExists.Has(err))
+
+		// display names are unique per provider.
+		_, err = groups.Insert(ctx, console.ScimGroup{ID: testrand.UUID(), Provider: "entra", DisplayName: "Engineering"})
+		require.NoError(t, err)
+
+		billing, err := groups.Insert(ctx, console.ScimGroup{ID: testrand.UUID(), Provider: "okta", DisplayName: "Billing"})
+		require.NoError(t, err)
+
+		group, err := groups.Get(ctx, engineering.ID)
+		require.NoError(t, err)
+		require.Equal(t, engineering.DisplayName, group.DisplayName)
+		require.Equal(t, "00g1", group.ExternalID)
+		require.Equal(t, &project.ID, group.ProjectID)
+		require.Equal(t, "admin", group.Role)
+
+		group, err = groups.GetByDisplayName(ctx, "okta", "Engineering")
+		require.NoError(t, err)
+		require.Equal(t, engineering.ID, group.ID)
+
+		group, err = groups.GetByExternalID(ctx, "okta", "00g1")
+		require.NoError(t, err)
+		require.Equal(t, engineering.ID, group.ID)
+
+		_, err = groups.GetByExternalID(ctx, "entra", "00g1")
+		require.True(t, console.ErrNoScimGroup.Has(err))
+
+		_, err = groups.Get(ctx, testrand.UUID())
+		require.True(t, console.ErrNoScimGroup.Has(err))
+
+		list, total, err := groups.List(ctx, "okta", 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, 2, total)
+		require.Len(t, list, 2)
+		require.Equal(t, "Billing", list[0].DisplayName)
+		require.Nil(t, list[0].ProjectID)
+		require.Empty(t, list[0].ExternalID)
+
+		list, total, err = groups.List(ctx, "okta", 1, 10)
+		require.NoError(t, err)
+		require.Equal(t, 2, total)
+		require.Len(t, list, 1)
+		require.Equal(t, "Engineering", list[0].DisplayName)
+
+		require.NoError(t, groups.AddMember(ctx, engineering.ID, users[0].ID))
+		require.NoError(t, groups.AddMember(ctx, engineering.ID, users[0].ID))
+		require.NoError(t, groups.AddMember(ctx, engineering.ID, users[1].ID))
+		require.NoError(t, groups.AddMember(ctx, billing.ID, users[0].ID))
+
+		members, err := groups.GetMembers(ctx, engineering.ID)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []uuid.UUID{users[0].ID, users[1].ID}, members)
+
+		memberOf, err := groups.GetByMember(ctx, "okta", users[0].ID)
+		require.NoError(t, err)
+		require.Len(t, memberOf, 2)
+		require.Equal(t, billing.ID, memberOf[0].ID)
+		require.Equal(t, engineering.ID, memberOf[1].ID)
+
+		memberOf, err = groups.GetByMember(ctx, "entra", users[0].ID)
+		require.NoError(t, err)
+		require.Empty(t, memberOf)
+
+		require.NoError(t, groups.RemoveMember(ctx, engineering.ID, users[1].ID))
+		members, err = groups.GetMembers(ctx, engineering.ID)
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{users[0].ID}, members)
+
+		billing.DisplayName = "Engineering"
+		require.True(t, console.ErrScimGroupAlreadyExists.Has(groups.Update(ctx, *billing)))
+
+		billing.DisplayName = "Finance"
+		billing.ExternalID = "00g2"
+		billing.ProjectID = &project.ID
+		billing.Role = "Billing"
+		require.NoError(t, groups.Update(ctx, *billing))
+
+		group, err = groups.Get(ctx, billing.ID)
+		require.NoError(t, err)
+		require.Equal(t, "Finance", group.DisplayName)
+		require.Equal(t, "00g2", group.ExternalID)
+		require.Equal(t, &project.ID, group.ProjectID)
+		require.Equal(t, "Billing", group.Role)
+
+		require.True(t, console.ErrNoScimGroup.Has(groups.Update(ctx, console.ScimGroup{ID: testrand.UUID(), DisplayName: "Missing"})))
+
+		require.NoError(t, groups.Delete(ctx, engineering.ID))
+		_, err = groups.Get(ctx, engineering.ID)
+		require.True(t, console.ErrNoScimGroup.Has(err))
+
+		memberOf, err = groups.GetByMember(ctx, "okta", users[0].ID)
+		require.NoError(t, err)
+		require.Len(t, memberOf, 1)
+		require.Equal(t, billing.ID, memberOf[0].ID)
+	})
+}
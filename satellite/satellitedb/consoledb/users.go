@@ -230,6 +230,54 @@ func (users *users) GetByExternalID(ctx context.Context, externalID string, tena
 	return u, nil
 }
 
+// likePatternEscaper escapes the wildcards of LIKE patterns.
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// GetIDsByExternalIDPrefix returns the IDs of the users, whose external ID starts with the prefix,
+// ordered by email, and their total count. Deleted users and users pending deletion are skipped.
+func (users *users) GetIDsByExternalIDPrefix(ctx context.Context, prefix string, tenantID *string, offset, limit int) (ids []uuid.UUID, total int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conditions := `external_id LIKE ? AND status NOT IN (?, ?)`
+	args := []any{likePatternEscaper.Replace(prefix) + "%", console.Deleted, console.PendingDeletion}
+	if tenantID == nil || *tenantID == "" {
+		conditions += ` AND tenant_id IS NULL`
+	} else {
+		conditions += ` AND tenant_id = ?`
+		args = append(args, *tenantID)
+	}
+
+	err = users.db.QueryRowContext(ctx, users.db.Rebind(`SELECT COUNT(*) FROM users WHERE `+conditions), args...).Scan(&total)
+	if err != nil {
+		return nil, 0, Error.Wrap(err)
+	}
+	if total == 0 || offset >= total {
+		return []uuid.UUID{}, total, nil
+	}
+
+	rows, err := users.db.QueryContext(ctx, users.db.Rebind(`
+		SELECT id FROM users
+		WHERE `+conditions+`
+		ORDER BY normalized_email, id
+		LIMIT ? OFFSET ?
+	`), append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	ids = []uuid.UUID{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err = rows.Scan(&id); err != nil {
+			return nil, 0, Error.Wrap(err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, total, Error.Wrap(rows.Err())
+}
+
 func (users *users) GetByStatus(ctx context.Context, status console.UserStatus, cursor console.UserCursor) (page *console.UsersPage, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -0,0 +1,214 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoledb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that webAuthnCredentials implements console.WebAuthnCredentials.
+var _ console.WebAuthnCredentials = (*webAuthnCredentials)(nil)
+
+// webAuthnCredentials exposes methods to manage the webauthn_credentials table in database.
+type webAuthnCredentials struct {
+	db dbx.DriverMethods
+}
+
+// Insert is a method for inserting a credential into the database.
+func (credentials *webAuthnCredentials) Insert(ctx context.Context, credential console.WebAuthnCredential) (_ *console.WebAuthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxCredential, err := credentials.db.Create_WebauthnCredential(ctx,
+		dbx.WebauthnCredential_Id(credential.ID[:]),
+		dbx.WebauthnCredential_UserId(credential.UserID[:]),
+		dbx.WebauthnCredential_CredentialId(credential.CredentialID),
+		dbx.WebauthnCredential_PublicKey(credential.PublicKey),
+		dbx.WebauthnCredential_SignCount(int64(credential.SignCount)),
+		dbx.WebauthnCredential_Transports(strings.Join(credential.Transports, ",")),
+		dbx.WebauthnCredential_Name(credential.Name),
+		dbx.WebauthnCredential_Create_Fields{},
+	)
+	if err != nil {
+		if dbx.IsConstraintError(err) {
+			return nil, console.ErrWebAuthnCredentialAlreadyExists.New("")
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return webAuthnCredentialFromDBX(dbxCredential)
+}
+
+// GetByUserID is a method for querying the credentials of the user ordered by creation date.
+func (credentials *webAuthnCredentials) GetByUserID(ctx context.Context, userID uuid.UUID) (_ []console.WebAuthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxCredentials, err := credentials.db.All_WebauthnCredential_By_UserId_OrderBy_Asc_CreatedAt_Asc_Id(ctx,
+		dbx.WebauthnCredential_UserId(userID[:]),
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	result := make([]console.WebAuthnCredential, 0, len(dbxCredentials))
+	for _, dbxCredential := range dbxCredentials {
+		credential, err := webAuthnCredentialFromDBX(dbxCredential)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *credential)
+	}
+
+	return result, nil
+}
+
+// GetByCredentialID is a method for querying a credential by the ID assigned by its authenticator.
+func (credentials *webAuthnCredentials) GetByCredentialID(ctx context.Context, credentialID []byte) (_ *console.WebAuthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxCredential, err := credentials.db.Get_WebauthnCredential_By_CredentialId(ctx,
+		dbx.WebauthnCredential_CredentialId(credentialID),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, console.ErrNoWebAuthnCredential.New("")
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return webAuthnCredentialFromDBX(dbxCredential)
+}
+
+// UpdateName is a method for renaming the credential of the user.
+func (credentials *webAuthnCredentials) UpdateName(ctx context.Context, userID, id uuid.UUID, name string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxCredential, err := credentials.db.Update_WebauthnCredential_By_Id_And_UserId(ctx,
+		dbx.WebauthnCredential_Id(id[:]),
+		dbx.WebauthnCredential_UserId(userID[:]),
+		dbx.WebauthnCredential_Update_Fields{
+			Name: dbx.WebauthnCredential_Name(name),
+		},
+	)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if dbxCredential == nil {
+		return console.ErrNoWebAuthnCredential.New("%s", id)
+	}
+
+	return nil
+}
+
+// UpdateUsage is a method for updating the signature counter and the last usage time of the credential.
+func (credentials *webAuthnCredentials) UpdateUsage(ctx context.Context, id uuid.UUID, signCount uint32, usedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxCredential, err := credentials.db.Update_WebauthnCredential_By_Id(ctx,
+		dbx.WebauthnCredential_Id(id[:]),
+		dbx.WebauthnCredential_Update_Fields{
+			SignCount:  dbx.WebauthnCredential_SignCount(int64(signCount)),
+			LastUsedAt: dbx.WebauthnCredential_LastUsedAt(usedAt.UTC()),
+		},
+	)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if dbxCredential == nil {
+		return console.ErrNoWebAuthnCredential.New("%s", id)
+	}
+
+	return nil
+}
+
+// Delete is a method for deleting the credential of the user.
+func (credentials *webAuthnCredentials) Delete(ctx context.Context, userID, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err := credentials.db.Delete_WebauthnCredential_By_Id_And_UserId(ctx,
+		dbx.WebauthnCredential_Id(id[:]),
+		dbx.WebauthnCredential_UserId(userID[:]),
+	)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !deleted {
+		return console.ErrNoWebAuthnCredential.New("%s", id)
+	}
+
+	return nil
+}
+
+// DeleteByUserID is a method for deleting all credentials of the user.
+func (credentials *webAuthnCredentials) DeleteByUserID(ctx context.Context, userID uuid.UUID) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err := credentials.db.Delete_WebauthnCredential_By_UserId(ctx, dbx.WebauthnCredential_UserId(userID[:]))
+	return deleted, Error.Wrap(err)
+}
+
+// UseChallenge is a method for recording the challenge of a finished ceremony until the expiration of its token.
+func (credentials *webAuthnCredentials) UseChallenge(ctx context.Context, challenge []byte, expiration time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = credentials.db.Create_WebauthnUsedChallenge(ctx,
+		dbx.WebauthnUsedChallenge_Challenge(challenge),
+		dbx.WebauthnUsedChallenge_ExpiresAt(expiration.UTC()),
+	)
+	if err != nil {
+		if dbx.IsConstraintError(err) {
+			return console.ErrWebAuthnChallengeUsed.New("")
+		}
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// DeleteExpiredChallenges is a method for deleting the used challenges, which expired before the time.
+func (credentials *webAuthnCredentials) DeleteExpiredChallenges(ctx context.Context, before time.Time) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err := credentials.db.Delete_WebauthnUsedChallenge_By_ExpiresAt_Less(ctx, dbx.WebauthnUsedChallenge_ExpiresAt(before.UTC()))
+	return deleted, Error.Wrap(err)
+}
+
+// webAuthnCredentialFromDBX converts a credential from the database to a *console.WebAuthnCredential.
+func webAuthnCredentialFromDBX(dbxCredential *dbx.WebauthnCredential) (_ *console.WebAuthnCredential, err error) {
+	if dbxCredential == nil {
+		return nil, Error.New("dbx webauthn credential is nil")
+	}
+
+	id, err := uuid.FromBytes(dbxCredential.Id)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	userID, err := uuid.FromBytes(dbxCredential.UserId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	credential := &console.WebAuthnCredential{
+		ID:           id,
+		UserID:       userID,
+		CredentialID: dbxCredential.CredentialId,
+		PublicKey:    dbxCredential.PublicKey,
+		SignCount:    uint32(dbxCredential.SignCount),
+		Name:         dbxCredential.Name,
+		CreatedAt:    dbxCredential.CreatedAt,
+		LastUsedAt:   dbxCredential.LastUsedAt,
+	}
+	if dbxCredential.Transports != "" {
+		credential.Transports = strings.Split(dbxCredential.Transports, ",")
+	}
+
+	return credential, nil
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoledb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestWebAuthnCredentialsRepository(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		credentials := db.Console().WebAuthnCredentials()
+
+		var users []*console.User
+		for _, email := range []string{"alice@example.test", "bob@example.test"} {
+			user, err := db.Console().Users().Insert(ctx, &console.User{
+				ID:           testrand.UUID(),
+				FullName:     "test",
+				Email:        email,
+				PasswordHash: []byte("testPass"),
+			})
+			require.NoError(t, err)
+			users = append(users, user)
+		}
+
+		yubiKey, err := credentials.Insert(ctx, console.WebAuthnCredential{
+			ID:           testrand.UUID(),
+			UserID:       users[0].ID,
+			CredentialID: testrand.BytesInt(16),
+			PublicKey:    testrand.BytesInt(77),
+			SignCount:    1<<32 - 1,
+			Transports:   []string{"usb", "nfc"},
+			Name:         "YubiKey",
+		})
+		require.NoError(t, err)
+		require.False(t, yubiKey.CreatedAt.IsZero())
+
+		_, err = credentials.Insert(ctx, console.WebAuthnCredential{
+			ID:           testrand.UUID(),
+			UserID:       users[1].ID,
+			CredentialID: yubiKey.CredentialID,
+			PublicKey:    testrand.BytesInt(77),
+			Name:         "Copy",
+		})
+		require.True(t, console.ErrWebAuthnCredentialAlreadyExists.Has(err))
+
+		passkey, err := credentials.Insert(ctx, console.WebAuthnCredential{
+			ID:           testrand.UUID(),
+			UserID:       users[0].ID,
+			CredentialID: testrand.BytesInt(32),
+			PublicKey:    testrand.BytesInt(77),
+			Name:         "Phone",
+		})
+		require.NoError(t, err)
+
+		credential, err := credentials.GetByCredentialID(ctx, yubiKey.CredentialID)
+		require.NoError(t, err)
+		require.Equal(t, yubiKey.ID, credential.ID)
+		require.Equal(t, yubiKey.PublicKey, credential.PublicKey)
+		require.Equal(t, yubiKey.SignCount, credential.SignCount)
+		require.Equal(t, []string{"usb", "nfc"}, credential.Transports)
+		require.Nil(t, credential.LastUsedAt)
+
+		_, err = credentials.GetByCredentialID(ctx, testrand.BytesInt(16))
+		require.True(t, console.ErrNoWebAuthnCredential.Has(err))
+
+		list, err := credentials.GetByUserID(ctx, users[0].ID)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Equal(t, yubiKey.ID, list[0].ID)
+		require.Equal(t, passkey.ID, list[1].ID)
+		require.Empty(t, list[1].Transports)
+
+		list, err = credentials.GetByUserID(ctx, users[1].ID)
+		require.NoError(t, err)
+		require.Empty(t, list)
+
+		// credentials can only be renamed and deleted by their owner.
+		require.True(t, console.ErrNoWebAuthnCredential.Has(credentials.UpdateName(ctx, users[1].ID, yubiKey.ID, "Mine")))
+		require.True(t, console.ErrNoWebAuthnCredential.Has(credentials.Delete(ctx, users[1].ID, yubiKey.ID)))
+
+		require.NoError(t, credentials.UpdateName(ctx, users[0].ID, yubiKey.ID, "Backup key"))
+
+		usedAt := time.Now().Truncate(time.Second)
+		require.NoError(t, credentials.UpdateUsage(ctx, yubiKey.ID, 7, usedAt))
+		require.True(t, console.ErrNoWebAuthnCredential.Has(credentials.UpdateUsage(ctx, testrand.UUID(), 7, usedAt)))
+
+		credential, err = credentials.GetByCredentialID(ctx, yubiKey.CredentialID)
+		require.NoError(t, err)
+		require.Equal(t, "Backup key", credential.Name)
+		require.EqualValues(t, 7, credential.SignCount)
+		require.NotNil(t, credential.LastUsedAt)
+		require.WithinDuration(t, usedAt, *credential.LastUsedAt, time.Second)
+
+		require.NoError(t, credentials.Delete(ctx, users[0].ID, passkey.ID))
+
+		deleted, err := credentials.DeleteByUserID(ctx, users[0].ID)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, deleted)
+
+		list, err = credentials.GetByUserID(ctx, users[0].ID)
+		require.NoError(t, err)
+		require.Empty(t, list)
+
+		// challenges can only be used once until they're deleted after expiration.
+		now := time.Now()
+		expired, valid := testrand.Bytes(32), testrand.Bytes(32)
+		require.NoError(t, credentials.UseChallenge(ctx, expired, now.Add(-time.Minute)))
+		require.NoError(t, credentials.UseChallenge(ctx, valid, now.Add(time.Minute)))
+		require.True(t, console.ErrWebAuthnChallengeUsed.Has(credentials.UseChallenge(ctx, valid, now.Add(time.Minute))))
+
+		deleted, err = credentials.DeleteExpiredChallenges(ctx, now)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, deleted)
+
+		require.NoError(t, credentials.UseChallenge(ctx, expired, now.Add(time.Minute)))
+		require.True(t, console.ErrWebAuthnChallengeUsed.Has(credentials.UseChallenge(ctx, valid, now.Add(time.Minute))))
+	})
+}
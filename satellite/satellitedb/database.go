@@ -15,6 +15,7 @@ import (
 	"storj.io/storj/private/migrate"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/admin/approvals"
 	"storj.io/storj/satellite/admin/changehistory"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/audit"
@@ -101,7 +102,8 @@ var safelyPartitionableDBs = map[string]bool{
 	"overlaycache":  true, // tables: nodes, node_tags
 }
 
-// Open creates instance of satellite.DB.
+// Open creates instance of satellite.DB. The database must be Postgres, CockroachDB or
+// Spanner, SQLite is supported only for the metabase.
 func Open(ctx context.Context, log *zap.Logger, databaseURL string, opts Options) (rv satellite.DB, err error) {
 	dbMapping, err := dbutil.ParseDBMapping(databaseURL)
 	if err != nil {
@@ -135,6 +137,9 @@ func open(ctx context.Context, log *zap.Logger, databaseURL string, opts Options
 	if err != nil {
 		return nil, err
 	}
+	if impl == dbutil.SQLite3 {
+		return nil, Error.New("SQLite is supported only for the metabase, the satellite database requires Postgres, CockroachDB or Spanner")
+	}
 	if impl != dbutil.Postgres && impl != dbutil.Cockroach && impl != dbutil.Spanner {
 		return nil, Error.New("unsupported driver %q", driver)
 	}
@@ -294,6 +299,11 @@ func (dbc *satelliteDBCollection) AdminChangeHistory() changehistory.DB {
 	return &ChangeHistories{db: dbc.getByName("adminchangehistory")}
 }
 
+// AdminApprovals returns the database for storing the approval requests of admin operations.
+func (dbc *satelliteDBCollection) AdminApprovals() approvals.DB {
+	return &ApprovalRequests{db: dbc.getByName("adminapprovals")}
+}
+
 // OIDC returns the database for storing OAuth and OIDC information.
 func (dbc *satelliteDBCollection) OIDC() oidc.DB {
 	db := dbc.getByName("oidc")
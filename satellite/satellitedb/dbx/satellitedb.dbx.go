@@ -387,6 +387,21 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( name )
 )`,
 
+		`CREATE TABLE admin_approval_requests (
+	id bytea NOT NULL,
+	operation text NOT NULL,
+	item_type text NOT NULL,
+	user_id bytea NOT NULL,
+	project_id bytea,
+	requested_by text NOT NULL,
+	reason text NOT NULL,
+	details jsonb NOT NULL,
+	payload jsonb NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+)`,
+
 		`CREATE TABLE billing_balances (
 	user_id bytea NOT NULL,
 	balance bigint NOT NULL,
@@ -435,6 +450,26 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 )`,
 
+		`CREATE TABLE bucket_lifecycle_configs (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	rules bytea NOT NULL,
+	deletion_cursor bytea,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+)`,
+
+		`CREATE TABLE bucket_limits (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	storage_limit bigint,
+	bandwidth_limit bigint,
+	segment_limit bigint,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+)`,
+
 		`CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -527,6 +562,8 @@ func (obj *pgxDB) Schema() []string {
 	noise_public_key bytea,
 	debounce_limit integer NOT NULL DEFAULT 0,
 	features integer NOT NULL DEFAULT 0,
+	asn bigint,
+	asn_org text,
 	PRIMARY KEY ( id )
 )`,
 
@@ -594,6 +631,18 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( token )
 )`,
 
+		`CREATE TABLE organizations (
+	id bytea NOT NULL,
+	name text NOT NULL,
+	owner_id bytea NOT NULL,
+	project_limit integer,
+	storage_limit bigint,
+	bandwidth_limit bigint,
+	segment_limit bigint,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+)`,
+
 		`CREATE TABLE peer_identities (
 	node_id bytea NOT NULL,
 	leaf_serial_number bytea NOT NULL,
@@ -743,6 +792,18 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( revoked )
 )`,
 
+		`CREATE TABLE scim_groups (
+	id bytea NOT NULL,
+	provider text NOT NULL,
+	display_name text NOT NULL,
+	external_id text,
+	project_id bytea,
+	role text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( provider, display_name )
+)`,
+
 		`CREATE TABLE segment_pending_audits (
 	node_id bytea NOT NULL,
 	stream_id bytea NOT NULL,
@@ -960,6 +1021,12 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( id )
 )`,
 
+		`CREATE TABLE webauthn_used_challenges (
+	challenge bytea NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( challenge )
+)`,
+
 		`CREATE TABLE api_keys (
 	id bytea NOT NULL,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
@@ -1028,6 +1095,21 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( project_id, subdomain )
 )`,
 
+		`CREATE TABLE organization_members (
+	organization_id bytea NOT NULL REFERENCES organizations( id ) ON DELETE CASCADE,
+	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	role integer NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( organization_id, member_id )
+)`,
+
+		`CREATE TABLE organization_projects (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	organization_id bytea NOT NULL REFERENCES organizations( id ),
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+)`,
+
 		`CREATE TABLE project_invitations (
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
 	email text NOT NULL,
@@ -1044,6 +1126,16 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( member_id, project_id )
 )`,
 
+		`CREATE TABLE project_roles (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	name text NOT NULL,
+	permissions bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( project_id, name )
+)`,
+
 		`CREATE TABLE rest_api_keys (
 	id bytea NOT NULL,
 	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
@@ -1055,6 +1147,12 @@ func (obj *pgxDB) Schema() []string {
 	UNIQUE ( token )
 )`,
 
+		`CREATE TABLE scim_group_members (
+	group_id bytea NOT NULL REFERENCES scim_groups( id ) ON DELETE CASCADE,
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	PRIMARY KEY ( group_id, user_id )
+)`,
+
 		`CREATE TABLE stripecoinpayments_apply_balance_intents (
 	tx_id text NOT NULL REFERENCES coinpayments_transactions( id ) ON DELETE CASCADE,
 	state integer NOT NULL,
@@ -1062,6 +1160,20 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( tx_id )
 )`,
 
+		`CREATE TABLE webauthn_credentials (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	credential_id bytea NOT NULL,
+	public_key bytea NOT NULL,
+	sign_count bigint NOT NULL,
+	transports text NOT NULL,
+	name text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	last_used_at timestamp with time zone,
+	PRIMARY KEY ( id ),
+	UNIQUE ( credential_id )
+)`,
+
 		`CREATE TABLE api_key_tails (
 	root_key_id bytea REFERENCES api_keys( id ) ON DELETE CASCADE,
 	tail bytea NOT NULL,
@@ -1071,6 +1183,13 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( tail )
 )`,
 
+		`CREATE TABLE project_member_roles (
+	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	role_id bytea NOT NULL REFERENCES project_roles( id ) ON DELETE CASCADE,
+	PRIMARY KEY ( member_id, project_id )
+)`,
+
 		`CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time )`,
 
 		`CREATE INDEX billing_transactions_tx_timestamp_index ON billing_transactions ( tx_timestamp )`,
@@ -1107,6 +1226,8 @@ func (obj *pgxDB) Schema() []string {
 
 		`CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id )`,
 
+		`CREATE INDEX organizations_owner_id_index ON organizations ( owner_id )`,
+
 		`CREATE INDEX projects_public_id_index ON projects ( public_id )`,
 
 		`CREATE INDEX projects_owner_id_index ON projects ( owner_id )`,
@@ -1157,8 +1278,14 @@ func (obj *pgxDB) Schema() []string {
 
 		`CREATE INDEX webapp_sessions_user_id_index ON webapp_sessions ( user_id )`,
 
+		`CREATE INDEX webauthn_used_challenges_expires_at_index ON webauthn_used_challenges ( expires_at )`,
+
 		`CREATE INDEX bucket_migrations_state_created_at_index ON bucket_migrations ( state, created_at )`,
 
+		`CREATE INDEX organization_members_member_id_index ON organization_members ( member_id )`,
+
+		`CREATE INDEX organization_projects_organization_id_index ON organization_projects ( organization_id )`,
+
 		`CREATE INDEX project_invitations_project_id_index ON project_invitations ( project_id )`,
 
 		`CREATE INDEX project_invitations_email_index ON project_invitations ( email )`,
@@ -1168,22 +1295,40 @@ func (obj *pgxDB) Schema() []string {
 		`CREATE INDEX rest_api_keys_user_id_index ON rest_api_keys ( user_id )`,
 
 		`CREATE INDEX rest_api_keys_name_index ON rest_api_keys ( name )`,
+
+		`CREATE INDEX scim_group_members_user_id_index ON scim_group_members ( user_id )`,
+
+		`CREATE INDEX webauthn_credentials_user_id_index ON webauthn_credentials ( user_id )`,
+
+		`CREATE INDEX project_member_roles_role_id_index ON project_member_roles ( role_id )`,
 	}
 }
 
 func (obj *pgxDB) DropSchema() []string {
 	return []string{
 
+		`DROP TABLE IF EXISTS project_member_roles`,
+
 		`DROP TABLE IF EXISTS api_key_tails`,
 
+		`DROP TABLE IF EXISTS webauthn_credentials`,
+
 		`DROP TABLE IF EXISTS stripecoinpayments_apply_balance_intents`,
 
+		`DROP TABLE IF EXISTS scim_group_members`,
+
 		`DROP TABLE IF EXISTS rest_api_keys`,
 
+		`DROP TABLE IF EXISTS project_roles`,
+
 		`DROP TABLE IF EXISTS project_members`,
 
 		`DROP TABLE IF EXISTS project_invitations`,
 
+		`DROP TABLE IF EXISTS organization_projects`,
+
+		`DROP TABLE IF EXISTS organization_members`,
+
 		`DROP TABLE IF EXISTS domains`,
 
 		`DROP TABLE IF EXISTS bucket_migrations`,
@@ -1192,6 +1337,8 @@ func (obj *pgxDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS api_keys`,
 
+		`DROP TABLE IF EXISTS webauthn_used_challenges`,
+
 		`DROP TABLE IF EXISTS webapp_sessions`,
 
 		`DROP TABLE IF EXISTS verification_audits`,
@@ -1224,6 +1371,8 @@ func (obj *pgxDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS segment_pending_audits`,
 
+		`DROP TABLE IF EXISTS scim_groups`,
+
 		`DROP TABLE IF EXISTS revocations`,
 
 		`DROP TABLE IF EXISTS reverification_audits`,
@@ -1246,6 +1395,8 @@ func (obj *pgxDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS peer_identities`,
 
+		`DROP TABLE IF EXISTS organizations`,
+
 		`DROP TABLE IF EXISTS oauth_tokens`,
 
 		`DROP TABLE IF EXISTS oauth_codes`,
@@ -1268,6 +1419,10 @@ func (obj *pgxDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS bucket_storage_tallies`,
 
+		`DROP TABLE IF EXISTS bucket_limits`,
+
+		`DROP TABLE IF EXISTS bucket_lifecycle_configs`,
+
 		`DROP TABLE IF EXISTS bucket_bandwidth_rollup_archives`,
 
 		`DROP TABLE IF EXISTS bucket_bandwidth_rollups`,
@@ -1276,6 +1431,8 @@ func (obj *pgxDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS billing_balances`,
 
+		`DROP TABLE IF EXISTS admin_approval_requests`,
+
 		`DROP TABLE IF EXISTS accounting_timestamps`,
 
 		`DROP TABLE IF EXISTS accounting_rollups`,
@@ -1412,6 +1569,21 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( name )
 )`,
 
+		`CREATE TABLE admin_approval_requests (
+	id bytea NOT NULL,
+	operation text NOT NULL,
+	item_type text NOT NULL,
+	user_id bytea NOT NULL,
+	project_id bytea,
+	requested_by text NOT NULL,
+	reason text NOT NULL,
+	details jsonb NOT NULL,
+	payload jsonb NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+)`,
+
 		`CREATE TABLE billing_balances (
 	user_id bytea NOT NULL,
 	balance bigint NOT NULL,
@@ -1460,6 +1632,26 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 )`,
 
+		`CREATE TABLE bucket_lifecycle_configs (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	rules bytea NOT NULL,
+	deletion_cursor bytea,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+)`,
+
+		`CREATE TABLE bucket_limits (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	storage_limit bigint,
+	bandwidth_limit bigint,
+	segment_limit bigint,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+)`,
+
 		`CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -1552,6 +1744,8 @@ func (obj *pgxcockroachDB) Schema() []string {
 	noise_public_key bytea,
 	debounce_limit integer NOT NULL DEFAULT 0,
 	features integer NOT NULL DEFAULT 0,
+	asn bigint,
+	asn_org text,
 	PRIMARY KEY ( id )
 )`,
 
@@ -1619,6 +1813,18 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( token )
 )`,
 
+		`CREATE TABLE organizations (
+	id bytea NOT NULL,
+	name text NOT NULL,
+	owner_id bytea NOT NULL,
+	project_limit integer,
+	storage_limit bigint,
+	bandwidth_limit bigint,
+	segment_limit bigint,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+)`,
+
 		`CREATE TABLE peer_identities (
 	node_id bytea NOT NULL,
 	leaf_serial_number bytea NOT NULL,
@@ -1768,6 +1974,18 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( revoked )
 )`,
 
+		`CREATE TABLE scim_groups (
+	id bytea NOT NULL,
+	provider text NOT NULL,
+	display_name text NOT NULL,
+	external_id text,
+	project_id bytea,
+	role text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( provider, display_name )
+)`,
+
 		`CREATE TABLE segment_pending_audits (
 	node_id bytea NOT NULL,
 	stream_id bytea NOT NULL,
@@ -1985,6 +2203,12 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( id )
 )`,
 
+		`CREATE TABLE webauthn_used_challenges (
+	challenge bytea NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( challenge )
+)`,
+
 		`CREATE TABLE api_keys (
 	id bytea NOT NULL,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
@@ -2053,6 +2277,21 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( project_id, subdomain )
 )`,
 
+		`CREATE TABLE organization_members (
+	organization_id bytea NOT NULL REFERENCES organizations( id ) ON DELETE CASCADE,
+	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	role integer NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( organization_id, member_id )
+)`,
+
+		`CREATE TABLE organization_projects (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	organization_id bytea NOT NULL REFERENCES organizations( id ),
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+)`,
+
 		`CREATE TABLE project_invitations (
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
 	email text NOT NULL,
@@ -2069,6 +2308,16 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( member_id, project_id )
 )`,
 
+		`CREATE TABLE project_roles (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	name text NOT NULL,
+	permissions bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( project_id, name )
+)`,
+
 		`CREATE TABLE rest_api_keys (
 	id bytea NOT NULL,
 	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
@@ -2080,6 +2329,12 @@ func (obj *pgxcockroachDB) Schema() []string {
 	UNIQUE ( token )
 )`,
 
+		`CREATE TABLE scim_group_members (
+	group_id bytea NOT NULL REFERENCES scim_groups( id ) ON DELETE CASCADE,
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	PRIMARY KEY ( group_id, user_id )
+)`,
+
 		`CREATE TABLE stripecoinpayments_apply_balance_intents (
 	tx_id text NOT NULL REFERENCES coinpayments_transactions( id ) ON DELETE CASCADE,
 	state integer NOT NULL,
@@ -2087,6 +2342,20 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( tx_id )
 )`,
 
+		`CREATE TABLE webauthn_credentials (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	credential_id bytea NOT NULL,
+	public_key bytea NOT NULL,
+	sign_count bigint NOT NULL,
+	transports text NOT NULL,
+	name text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	last_used_at timestamp with time zone,
+	PRIMARY KEY ( id ),
+	UNIQUE ( credential_id )
+)`,
+
 		`CREATE TABLE api_key_tails (
 	root_key_id bytea REFERENCES api_keys( id ) ON DELETE CASCADE,
 	tail bytea NOT NULL,
@@ -2096,6 +2365,13 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( tail )
 )`,
 
+		`CREATE TABLE project_member_roles (
+	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	role_id bytea NOT NULL REFERENCES project_roles( id ) ON DELETE CASCADE,
+	PRIMARY KEY ( member_id, project_id )
+)`,
+
 		`CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time )`,
 
 		`CREATE INDEX billing_transactions_tx_timestamp_index ON billing_transactions ( tx_timestamp )`,
@@ -2132,6 +2408,8 @@ func (obj *pgxcockroachDB) Schema() []string {
 
 		`CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id )`,
 
+		`CREATE INDEX organizations_owner_id_index ON organizations ( owner_id )`,
+
 		`CREATE INDEX projects_public_id_index ON projects ( public_id )`,
 
 		`CREATE INDEX projects_owner_id_index ON projects ( owner_id )`,
@@ -2182,8 +2460,14 @@ func (obj *pgxcockroachDB) Schema() []string {
 
 		`CREATE INDEX webapp_sessions_user_id_index ON webapp_sessions ( user_id )`,
 
+		`CREATE INDEX webauthn_used_challenges_expires_at_index ON webauthn_used_challenges ( expires_at )`,
+
 		`CREATE INDEX bucket_migrations_state_created_at_index ON bucket_migrations ( state, created_at )`,
 
+		`CREATE INDEX organization_members_member_id_index ON organization_members ( member_id )`,
+
+		`CREATE INDEX organization_projects_organization_id_index ON organization_projects ( organization_id )`,
+
 		`CREATE INDEX project_invitations_project_id_index ON project_invitations ( project_id )`,
 
 		`CREATE INDEX project_invitations_email_index ON project_invitations ( email )`,
@@ -2193,22 +2477,40 @@ func (obj *pgxcockroachDB) Schema() []string {
 		`CREATE INDEX rest_api_keys_user_id_index ON rest_api_keys ( user_id )`,
 
 		`CREATE INDEX rest_api_keys_name_index ON rest_api_keys ( name )`,
+
+		`CREATE INDEX scim_group_members_user_id_index ON scim_group_members ( user_id )`,
+
+		`CREATE INDEX webauthn_credentials_user_id_index ON webauthn_credentials ( user_id )`,
+
+		`CREATE INDEX project_member_roles_role_id_index ON project_member_roles ( role_id )`,
 	}
 }
 
 func (obj *pgxcockroachDB) DropSchema() []string {
 	return []string{
 
+		`DROP TABLE IF EXISTS project_member_roles`,
+
 		`DROP TABLE IF EXISTS api_key_tails`,
 
+		`DROP TABLE IF EXISTS webauthn_credentials`,
+
 		`DROP TABLE IF EXISTS stripecoinpayments_apply_balance_intents`,
 
+		`DROP TABLE IF EXISTS scim_group_members`,
+
 		`DROP TABLE IF EXISTS rest_api_keys`,
 
+		`DROP TABLE IF EXISTS project_roles`,
+
 		`DROP TABLE IF EXISTS project_members`,
 
 		`DROP TABLE IF EXISTS project_invitations`,
 
+		`DROP TABLE IF EXISTS organization_projects`,
+
+		`DROP TABLE IF EXISTS organization_members`,
+
 		`DROP TABLE IF EXISTS domains`,
 
 		`DROP TABLE IF EXISTS bucket_migrations`,
@@ -2217,6 +2519,8 @@ func (obj *pgxcockroachDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS api_keys`,
 
+		`DROP TABLE IF EXISTS webauthn_used_challenges`,
+
 		`DROP TABLE IF EXISTS webapp_sessions`,
 
 		`DROP TABLE IF EXISTS verification_audits`,
@@ -2249,6 +2553,8 @@ func (obj *pgxcockroachDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS segment_pending_audits`,
 
+		`DROP TABLE IF EXISTS scim_groups`,
+
 		`DROP TABLE IF EXISTS revocations`,
 
 		`DROP TABLE IF EXISTS reverification_audits`,
@@ -2271,6 +2577,8 @@ func (obj *pgxcockroachDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS peer_identities`,
 
+		`DROP TABLE IF EXISTS organizations`,
+
 		`DROP TABLE IF EXISTS oauth_tokens`,
 
 		`DROP TABLE IF EXISTS oauth_codes`,
@@ -2293,6 +2601,10 @@ func (obj *pgxcockroachDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS bucket_storage_tallies`,
 
+		`DROP TABLE IF EXISTS bucket_limits`,
+
+		`DROP TABLE IF EXISTS bucket_lifecycle_configs`,
+
 		`DROP TABLE IF EXISTS bucket_bandwidth_rollup_archives`,
 
 		`DROP TABLE IF EXISTS bucket_bandwidth_rollups`,
@@ -2301,6 +2613,8 @@ func (obj *pgxcockroachDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS billing_balances`,
 
+		`DROP TABLE IF EXISTS admin_approval_requests`,
+
 		`DROP TABLE IF EXISTS accounting_timestamps`,
 
 		`DROP TABLE IF EXISTS accounting_rollups`,
@@ -2434,6 +2748,20 @@ func (obj *spannerDB) Schema() []string {
 	value TIMESTAMP NOT NULL
 ) PRIMARY KEY ( name )`,
 
+		`CREATE TABLE admin_approval_requests (
+	id BYTES(MAX) NOT NULL,
+	operation STRING(MAX) NOT NULL,
+	item_type STRING(MAX) NOT NULL,
+	user_id BYTES(MAX) NOT NULL,
+	project_id BYTES(MAX),
+	requested_by STRING(MAX) NOT NULL,
+	reason STRING(MAX) NOT NULL,
+	details JSON NOT NULL,
+	payload JSON NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	expires_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( id )`,
+
 		`CREATE TABLE billing_balances (
 	user_id BYTES(MAX) NOT NULL,
 	balance INT64 NOT NULL,
@@ -2480,6 +2808,24 @@ func (obj *spannerDB) Schema() []string {
 	settled INT64 NOT NULL
 ) PRIMARY KEY ( bucket_name, project_id, interval_start, action )`,
 
+		`CREATE TABLE bucket_lifecycle_configs (
+	project_id BYTES(MAX) NOT NULL,
+	bucket_name BYTES(MAX) NOT NULL,
+	rules BYTES(MAX) NOT NULL,
+	deletion_cursor BYTES(MAX),
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( project_id, bucket_name )`,
+
+		`CREATE TABLE bucket_limits (
+	project_id BYTES(MAX) NOT NULL,
+	bucket_name BYTES(MAX) NOT NULL,
+	storage_limit INT64,
+	bandwidth_limit INT64,
+	segment_limit INT64,
+	updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( project_id, bucket_name )`,
+
 		`CREATE TABLE bucket_storage_tallies (
 	bucket_name BYTES(MAX) NOT NULL,
 	project_id BYTES(MAX) NOT NULL,
@@ -2567,7 +2913,9 @@ func (obj *spannerDB) Schema() []string {
 	noise_proto INT64,
 	noise_public_key BYTES(MAX),
 	debounce_limit INT64 NOT NULL DEFAULT (0),
-	features INT64 NOT NULL DEFAULT (0)
+	features INT64 NOT NULL DEFAULT (0),
+	asn INT64,
+	asn_org STRING(MAX)
 ) PRIMARY KEY ( id )`,
 
 		`CREATE TABLE node_api_versions (
@@ -2628,6 +2976,17 @@ func (obj *spannerDB) Schema() []string {
 	expires_at TIMESTAMP NOT NULL
 ) PRIMARY KEY ( token )`,
 
+		`CREATE TABLE organizations (
+	id BYTES(MAX) NOT NULL,
+	name STRING(MAX) NOT NULL,
+	owner_id BYTES(MAX) NOT NULL,
+	project_limit INT64,
+	storage_limit INT64,
+	bandwidth_limit INT64,
+	segment_limit INT64,
+	created_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( id )`,
+
 		`CREATE TABLE peer_identities (
 	node_id BYTES(MAX) NOT NULL,
 	leaf_serial_number BYTES(MAX) NOT NULL,
@@ -2768,6 +3127,18 @@ func (obj *spannerDB) Schema() []string {
 	api_key_id BYTES(MAX) NOT NULL
 ) PRIMARY KEY ( revoked )`,
 
+		`CREATE TABLE scim_groups (
+	id BYTES(MAX) NOT NULL,
+	provider STRING(MAX) NOT NULL,
+	display_name STRING(MAX) NOT NULL,
+	external_id STRING(MAX),
+	project_id BYTES(MAX),
+	role STRING(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( id )`,
+
+		`CREATE UNIQUE INDEX index_scim_groups_provider_display_name ON scim_groups ( provider, display_name )`,
+
 		`CREATE TABLE segment_pending_audits (
 	node_id BYTES(MAX) NOT NULL,
 	stream_id BYTES(MAX) NOT NULL,
@@ -2973,6 +3344,11 @@ func (obj *spannerDB) Schema() []string {
 	expires_at TIMESTAMP NOT NULL
 ) PRIMARY KEY ( id )`,
 
+		`CREATE TABLE webauthn_used_challenges (
+	challenge BYTES(MAX) NOT NULL,
+	expires_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( challenge )`,
+
 		`CREATE TABLE api_keys (
 	id BYTES(MAX) NOT NULL,
 	project_id BYTES(MAX) NOT NULL,
@@ -3046,6 +3422,23 @@ func (obj *spannerDB) Schema() []string {
 	CONSTRAINT domains_created_by_fkey FOREIGN KEY (created_by) REFERENCES users (id)
 ) PRIMARY KEY ( project_id, subdomain )`,
 
+		`CREATE TABLE organization_members (
+	organization_id BYTES(MAX) NOT NULL,
+	member_id BYTES(MAX) NOT NULL,
+	role INT64 NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	CONSTRAINT organization_members_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations (id) ON DELETE CASCADE ,
+	CONSTRAINT organization_members_member_id_fkey FOREIGN KEY (member_id) REFERENCES users (id) ON DELETE CASCADE 
+) PRIMARY KEY ( organization_id, member_id )`,
+
+		`CREATE TABLE organization_projects (
+	project_id BYTES(MAX) NOT NULL,
+	organization_id BYTES(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	CONSTRAINT organization_projects_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE ,
+	CONSTRAINT organization_projects_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations (id)
+) PRIMARY KEY ( project_id )`,
+
 		`CREATE TABLE project_invitations (
 	project_id BYTES(MAX) NOT NULL,
 	email STRING(MAX) NOT NULL,
@@ -3064,6 +3457,17 @@ func (obj *spannerDB) Schema() []string {
 	CONSTRAINT project_members_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE 
 ) PRIMARY KEY ( member_id, project_id )`,
 
+		`CREATE TABLE project_roles (
+	id BYTES(MAX) NOT NULL,
+	project_id BYTES(MAX) NOT NULL,
+	name STRING(MAX) NOT NULL,
+	permissions INT64 NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	CONSTRAINT project_roles_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE 
+) PRIMARY KEY ( id )`,
+
+		`CREATE UNIQUE INDEX index_project_roles_project_id_name ON project_roles ( project_id, name )`,
+
 		`CREATE TABLE rest_api_keys (
 	id BYTES(MAX) NOT NULL,
 	user_id BYTES(MAX) NOT NULL,
@@ -3076,6 +3480,13 @@ func (obj *spannerDB) Schema() []string {
 
 		`CREATE UNIQUE INDEX index_rest_api_keys_token ON rest_api_keys ( token )`,
 
+		`CREATE TABLE scim_group_members (
+	group_id BYTES(MAX) NOT NULL,
+	user_id BYTES(MAX) NOT NULL,
+	CONSTRAINT scim_group_members_group_id_fkey FOREIGN KEY (group_id) REFERENCES scim_groups (id) ON DELETE CASCADE ,
+	CONSTRAINT scim_group_members_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE 
+) PRIMARY KEY ( group_id, user_id )`,
+
 		`CREATE TABLE stripecoinpayments_apply_balance_intents (
 	tx_id STRING(MAX) NOT NULL,
 	state INT64 NOT NULL,
@@ -3083,6 +3494,21 @@ func (obj *spannerDB) Schema() []string {
 	CONSTRAINT stripecoinpayments_apply_balance_intents_tx_id_fkey FOREIGN KEY (tx_id) REFERENCES coinpayments_transactions (id) ON DELETE CASCADE 
 ) PRIMARY KEY ( tx_id )`,
 
+		`CREATE TABLE webauthn_credentials (
+	id BYTES(MAX) NOT NULL,
+	user_id BYTES(MAX) NOT NULL,
+	credential_id BYTES(MAX) NOT NULL,
+	public_key BYTES(MAX) NOT NULL,
+	sign_count INT64 NOT NULL,
+	transports STRING(MAX) NOT NULL,
+	name STRING(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	last_used_at TIMESTAMP,
+	CONSTRAINT webauthn_credentials_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE 
+) PRIMARY KEY ( id )`,
+
+		`CREATE UNIQUE INDEX index_webauthn_credentials_credential_id ON webauthn_credentials ( credential_id )`,
+
 		`CREATE TABLE api_key_tails (
 	root_key_id BYTES(MAX),
 	tail BYTES(MAX) NOT NULL,
@@ -3092,6 +3518,15 @@ func (obj *spannerDB) Schema() []string {
 	CONSTRAINT api_key_tails_root_key_id_fkey FOREIGN KEY (root_key_id) REFERENCES api_keys (id) ON DELETE CASCADE 
 ) PRIMARY KEY ( tail )`,
 
+		`CREATE TABLE project_member_roles (
+	member_id BYTES(MAX) NOT NULL,
+	project_id BYTES(MAX) NOT NULL,
+	role_id BYTES(MAX) NOT NULL,
+	CONSTRAINT project_member_roles_member_id_fkey FOREIGN KEY (member_id) REFERENCES users (id) ON DELETE CASCADE ,
+	CONSTRAINT project_member_roles_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE ,
+	CONSTRAINT project_member_roles_role_id_fkey FOREIGN KEY (role_id) REFERENCES project_roles (id) ON DELETE CASCADE 
+) PRIMARY KEY ( member_id, project_id )`,
+
 		`CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time )`,
 
 		`CREATE INDEX billing_transactions_tx_timestamp_index ON billing_transactions ( tx_timestamp )`,
@@ -3128,6 +3563,8 @@ func (obj *spannerDB) Schema() []string {
 
 		`CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id )`,
 
+		`CREATE INDEX organizations_owner_id_index ON organizations ( owner_id )`,
+
 		`CREATE INDEX projects_public_id_index ON projects ( public_id )`,
 
 		`CREATE INDEX projects_owner_id_index ON projects ( owner_id )`,
@@ -3178,8 +3615,14 @@ func (obj *spannerDB) Schema() []string {
 
 		`CREATE INDEX webapp_sessions_user_id_index ON webapp_sessions ( user_id )`,
 
+		`CREATE INDEX webauthn_used_challenges_expires_at_index ON webauthn_used_challenges ( expires_at )`,
+
 		`CREATE INDEX bucket_migrations_state_created_at_index ON bucket_migrations ( state, created_at )`,
 
+		`CREATE INDEX organization_members_member_id_index ON organization_members ( member_id )`,
+
+		`CREATE INDEX organization_projects_organization_id_index ON organization_projects ( organization_id )`,
+
 		`CREATE INDEX project_invitations_project_id_index ON project_invitations ( project_id )`,
 
 		`CREATE INDEX project_invitations_email_index ON project_invitations ( email )`,
@@ -3189,20 +3632,44 @@ func (obj *spannerDB) Schema() []string {
 		`CREATE INDEX rest_api_keys_user_id_index ON rest_api_keys ( user_id )`,
 
 		`CREATE INDEX rest_api_keys_name_index ON rest_api_keys ( name )`,
+
+		`CREATE INDEX scim_group_members_user_id_index ON scim_group_members ( user_id )`,
+
+		`CREATE INDEX webauthn_credentials_user_id_index ON webauthn_credentials ( user_id )`,
+
+		`CREATE INDEX project_member_roles_role_id_index ON project_member_roles ( role_id )`,
 	}
 }
 
 func (obj *spannerDB) DropSchema() []string {
 	return []string{
 
+		`ALTER TABLE project_member_roles DROP CONSTRAINT project_member_roles_member_id_fkey`,
+
+		`ALTER TABLE project_member_roles DROP CONSTRAINT project_member_roles_project_id_fkey`,
+
+		`ALTER TABLE project_member_roles DROP CONSTRAINT project_member_roles_role_id_fkey`,
+
 		`ALTER TABLE api_key_tails DROP CONSTRAINT api_key_tails_root_key_id_fkey`,
 
+		`ALTER TABLE webauthn_credentials DROP CONSTRAINT webauthn_credentials_user_id_fkey`,
+
+		`DROP INDEX IF EXISTS index_webauthn_credentials_credential_id`,
+
 		`ALTER TABLE stripecoinpayments_apply_balance_intents DROP CONSTRAINT stripecoinpayments_apply_balance_intents_tx_id_fkey`,
 
+		`ALTER TABLE scim_group_members DROP CONSTRAINT scim_group_members_group_id_fkey`,
+
+		`ALTER TABLE scim_group_members DROP CONSTRAINT scim_group_members_user_id_fkey`,
+
 		`ALTER TABLE rest_api_keys DROP CONSTRAINT rest_api_keys_user_id_fkey`,
 
 		`DROP INDEX IF EXISTS index_rest_api_keys_token`,
 
+		`ALTER TABLE project_roles DROP CONSTRAINT project_roles_project_id_fkey`,
+
+		`DROP INDEX IF EXISTS index_project_roles_project_id_name`,
+
 		`ALTER TABLE project_members DROP CONSTRAINT project_members_member_id_fkey`,
 
 		`ALTER TABLE project_members DROP CONSTRAINT project_members_project_id_fkey`,
@@ -3211,6 +3678,14 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`ALTER TABLE project_invitations DROP CONSTRAINT project_invitations_inviter_id_fkey`,
 
+		`ALTER TABLE organization_projects DROP CONSTRAINT organization_projects_project_id_fkey`,
+
+		`ALTER TABLE organization_projects DROP CONSTRAINT organization_projects_organization_id_fkey`,
+
+		`ALTER TABLE organization_members DROP CONSTRAINT organization_members_organization_id_fkey`,
+
+		`ALTER TABLE organization_members DROP CONSTRAINT organization_members_member_id_fkey`,
+
 		`ALTER TABLE domains DROP CONSTRAINT domains_project_id_fkey`,
 
 		`ALTER TABLE domains DROP CONSTRAINT domains_created_by_fkey`,
@@ -3237,6 +3712,8 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP INDEX IF EXISTS index_registration_tokens_owner_id`,
 
+		`DROP INDEX IF EXISTS index_scim_groups_provider_display_name`,
+
 		`DROP INDEX IF EXISTS accounting_rollups_start_time_index`,
 
 		`DROP INDEX IF EXISTS billing_transactions_tx_timestamp_index`,
@@ -3273,6 +3750,8 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP INDEX IF EXISTS oauth_tokens_client_id_index`,
 
+		`DROP INDEX IF EXISTS organizations_owner_id_index`,
+
 		`DROP INDEX IF EXISTS projects_public_id_index`,
 
 		`DROP INDEX IF EXISTS projects_owner_id_index`,
@@ -3323,8 +3802,14 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP INDEX IF EXISTS webapp_sessions_user_id_index`,
 
+		`DROP INDEX IF EXISTS webauthn_used_challenges_expires_at_index`,
+
 		`DROP INDEX IF EXISTS bucket_migrations_state_created_at_index`,
 
+		`DROP INDEX IF EXISTS organization_members_member_id_index`,
+
+		`DROP INDEX IF EXISTS organization_projects_organization_id_index`,
+
 		`DROP INDEX IF EXISTS project_invitations_project_id_index`,
 
 		`DROP INDEX IF EXISTS project_invitations_email_index`,
@@ -3335,24 +3820,62 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP INDEX IF EXISTS rest_api_keys_name_index`,
 
+		`DROP INDEX IF EXISTS scim_group_members_user_id_index`,
+
+		`DROP INDEX IF EXISTS webauthn_credentials_user_id_index`,
+
+		`DROP INDEX IF EXISTS project_member_roles_role_id_index`,
+
+		`ALTER TABLE  project_member_roles ALTER member_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS project_member_roles_member_id`,
+
+		`ALTER TABLE  project_member_roles ALTER project_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS project_member_roles_project_id`,
+
+		`DROP TABLE IF EXISTS project_member_roles`,
+
 		`ALTER TABLE  api_key_tails ALTER tail SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS api_key_tails_tail`,
 
 		`DROP TABLE IF EXISTS api_key_tails`,
 
+		`ALTER TABLE  webauthn_credentials ALTER id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS webauthn_credentials_id`,
+
+		`DROP TABLE IF EXISTS webauthn_credentials`,
+
 		`ALTER TABLE  stripecoinpayments_apply_balance_intents ALTER tx_id SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS stripecoinpayments_apply_balance_intents_tx_id`,
 
 		`DROP TABLE IF EXISTS stripecoinpayments_apply_balance_intents`,
 
+		`ALTER TABLE  scim_group_members ALTER group_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS scim_group_members_group_id`,
+
+		`ALTER TABLE  scim_group_members ALTER user_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS scim_group_members_user_id`,
+
+		`DROP TABLE IF EXISTS scim_group_members`,
+
 		`ALTER TABLE  rest_api_keys ALTER id SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS rest_api_keys_id`,
 
 		`DROP TABLE IF EXISTS rest_api_keys`,
 
+		`ALTER TABLE  project_roles ALTER id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS project_roles_id`,
+
+		`DROP TABLE IF EXISTS project_roles`,
+
 		`ALTER TABLE  project_members ALTER member_id SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS project_members_member_id`,
@@ -3373,6 +3896,22 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS project_invitations`,
 
+		`ALTER TABLE  organization_projects ALTER project_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS organization_projects_project_id`,
+
+		`DROP TABLE IF EXISTS organization_projects`,
+
+		`ALTER TABLE  organization_members ALTER organization_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS organization_members_organization_id`,
+
+		`ALTER TABLE  organization_members ALTER member_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS organization_members_member_id`,
+
+		`DROP TABLE IF EXISTS organization_members`,
+
 		`ALTER TABLE  domains ALTER project_id SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS domains_project_id`,
@@ -3405,6 +3944,12 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS api_keys`,
 
+		`ALTER TABLE  webauthn_used_challenges ALTER challenge SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS webauthn_used_challenges_challenge`,
+
+		`DROP TABLE IF EXISTS webauthn_used_challenges`,
+
 		`ALTER TABLE  webapp_sessions ALTER id SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS webapp_sessions_id`,
@@ -3545,6 +4090,12 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS segment_pending_audits`,
 
+		`ALTER TABLE  scim_groups ALTER id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS scim_groups_id`,
+
+		`DROP TABLE IF EXISTS scim_groups`,
+
 		`ALTER TABLE  revocations ALTER revoked SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS revocations_revoked`,
@@ -3635,6 +4186,12 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS peer_identities`,
 
+		`ALTER TABLE  organizations ALTER id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS organizations_id`,
+
+		`DROP TABLE IF EXISTS organizations`,
+
 		`ALTER TABLE  oauth_tokens ALTER token SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS oauth_tokens_token`,
@@ -3717,6 +4274,26 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS bucket_storage_tallies`,
 
+		`ALTER TABLE  bucket_limits ALTER project_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS bucket_limits_project_id`,
+
+		`ALTER TABLE  bucket_limits ALTER bucket_name SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS bucket_limits_bucket_name`,
+
+		`DROP TABLE IF EXISTS bucket_limits`,
+
+		`ALTER TABLE  bucket_lifecycle_configs ALTER project_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS bucket_lifecycle_configs_project_id`,
+
+		`ALTER TABLE  bucket_lifecycle_configs ALTER bucket_name SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS bucket_lifecycle_configs_bucket_name`,
+
+		`DROP TABLE IF EXISTS bucket_lifecycle_configs`,
+
 		`ALTER TABLE  bucket_bandwidth_rollup_archives ALTER bucket_name SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS bucket_bandwidth_rollup_archives_bucket_name`,
@@ -3765,6 +4342,12 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS billing_balances`,
 
+		`ALTER TABLE  admin_approval_requests ALTER id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS admin_approval_requests_id`,
+
+		`DROP TABLE IF EXISTS admin_approval_requests`,
+
 		`ALTER TABLE  accounting_timestamps ALTER name SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS accounting_timestamps_name`,
@@ -4243,6 +4826,231 @@ func (f AccountingTimestamps_Value_Field) value() any {
 	return f._value
 }
 
+type AdminApprovalRequest struct {
+	Id          []byte
+	Operation   string
+	ItemType    string
+	UserId      []byte
+	ProjectId   []byte
+	RequestedBy string
+	Reason      string
+	Details     []byte
+	Payload     []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+func (AdminApprovalRequest) _Table() string { return "admin_approval_requests" }
+
+type AdminApprovalRequest_Create_Fields struct {
+	ProjectId AdminApprovalRequest_ProjectId_Field
+}
+
+type AdminApprovalRequest_Update_Fields struct {
+}
+
+type AdminApprovalRequest_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AdminApprovalRequest_Id(v []byte) AdminApprovalRequest_Id_Field {
+	return AdminApprovalRequest_Id_Field{_set: true, _value: v}
+}
+
+func (f AdminApprovalRequest_Id_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminApprovalRequest_Operation_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func AdminApprovalRequest_Operation(v string) AdminApprovalRequest_Operation_Field {
+	return AdminApprovalRequest_Operation_Field{_set: true, _value: v}
+}
+
+func (f AdminApprovalRequest_Operation_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminApprovalRequest_ItemType_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func AdminApprovalRequest_ItemType(v string) AdminApprovalRequest_ItemType_Field {
+	return AdminApprovalRequest_ItemType_Field{_set: true, _value: v}
+}
+
+func (f AdminApprovalRequest_ItemType_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminApprovalRequest_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AdminApprovalRequest_UserId(v []byte) AdminApprovalRequest_UserId_Field {
+	return AdminApprovalRequest_UserId_Field{_set: true, _value: v}
+}
+
+func (f AdminApprovalRequest_UserId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminApprovalRequest_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AdminApprovalRequest_ProjectId(v []byte) AdminApprovalRequest_ProjectId_Field {
+	return AdminApprovalRequest_ProjectId_Field{_set: true, _value: v}
+}
+
+func AdminApprovalRequest_ProjectId_Raw(v []byte) AdminApprovalRequest_ProjectId_Field {
+	if v == nil {
+		return AdminApprovalRequest_ProjectId_Null()
+	}
+	return AdminApprovalRequest_ProjectId(v)
+}
+
+func AdminApprovalRequest_ProjectId_Null() AdminApprovalRequest_ProjectId_Field {
+	return AdminApprovalRequest_ProjectId_Field{_set: true, _null: true}
+}
+
+func (f AdminApprovalRequest_ProjectId_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f AdminApprovalRequest_ProjectId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminApprovalRequest_RequestedBy_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func AdminApprovalRequest_RequestedBy(v string) AdminApprovalRequest_RequestedBy_Field {
+	return AdminApprovalRequest_RequestedBy_Field{_set: true, _value: v}
+}
+
+func (f AdminApprovalRequest_RequestedBy_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminApprovalRequest_Reason_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func AdminApprovalRequest_Reason(v string) AdminApprovalRequest_Reason_Field {
+	return AdminApprovalRequest_Reason_Field{_set: true, _value: v}
+}
+
+func (f AdminApprovalRequest_Reason_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminApprovalRequest_Details_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AdminApprovalRequest_Details(v []byte) AdminApprovalRequest_Details_Field {
+	return AdminApprovalRequest_Details_Field{_set: true, _value: v}
+}
+
+func (f AdminApprovalRequest_Details_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminApprovalRequest_Payload_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AdminApprovalRequest_Payload(v []byte) AdminApprovalRequest_Payload_Field {
+	return AdminApprovalRequest_Payload_Field{_set: true, _value: v}
+}
+
+func (f AdminApprovalRequest_Payload_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminApprovalRequest_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func AdminApprovalRequest_CreatedAt(v time.Time) AdminApprovalRequest_CreatedAt_Field {
+	return AdminApprovalRequest_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f AdminApprovalRequest_CreatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AdminApprovalRequest_ExpiresAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func AdminApprovalRequest_ExpiresAt(v time.Time) AdminApprovalRequest_ExpiresAt_Field {
+	return AdminApprovalRequest_ExpiresAt_Field{_set: true, _value: v}
+}
+
+func (f AdminApprovalRequest_ExpiresAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type BillingBalance struct {
 	UserId      []byte
 	Balance     int64
@@ -4898,6 +5706,308 @@ func (f BucketBandwidthRollupArchive_Settled_Field) value() any {
 	return f._value
 }
 
+type BucketLifecycleConfig struct {
+	ProjectId      []byte
+	BucketName     []byte
+	Rules          []byte
+	DeletionCursor []byte
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (BucketLifecycleConfig) _Table() string { return "bucket_lifecycle_configs" }
+
+type BucketLifecycleConfig_Create_Fields struct {
+	DeletionCursor BucketLifecycleConfig_DeletionCursor_Field
+}
+
+type BucketLifecycleConfig_Update_Fields struct {
+	Rules          BucketLifecycleConfig_Rules_Field
+	DeletionCursor BucketLifecycleConfig_DeletionCursor_Field
+	UpdatedAt      BucketLifecycleConfig_UpdatedAt_Field
+}
+
+type BucketLifecycleConfig_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketLifecycleConfig_ProjectId(v []byte) BucketLifecycleConfig_ProjectId_Field {
+	return BucketLifecycleConfig_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f BucketLifecycleConfig_ProjectId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketLifecycleConfig_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketLifecycleConfig_BucketName(v []byte) BucketLifecycleConfig_BucketName_Field {
+	return BucketLifecycleConfig_BucketName_Field{_set: true, _value: v}
+}
+
+func (f BucketLifecycleConfig_BucketName_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketLifecycleConfig_Rules_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketLifecycleConfig_Rules(v []byte) BucketLifecycleConfig_Rules_Field {
+	return BucketLifecycleConfig_Rules_Field{_set: true, _value: v}
+}
+
+func (f BucketLifecycleConfig_Rules_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketLifecycleConfig_DeletionCursor_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketLifecycleConfig_DeletionCursor(v []byte) BucketLifecycleConfig_DeletionCursor_Field {
+	return BucketLifecycleConfig_DeletionCursor_Field{_set: true, _value: v}
+}
+
+func BucketLifecycleConfig_DeletionCursor_Raw(v []byte) BucketLifecycleConfig_DeletionCursor_Field {
+	if v == nil {
+		return BucketLifecycleConfig_DeletionCursor_Null()
+	}
+	return BucketLifecycleConfig_DeletionCursor(v)
+}
+
+func BucketLifecycleConfig_DeletionCursor_Null() BucketLifecycleConfig_DeletionCursor_Field {
+	return BucketLifecycleConfig_DeletionCursor_Field{_set: true, _null: true}
+}
+
+func (f BucketLifecycleConfig_DeletionCursor_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketLifecycleConfig_DeletionCursor_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketLifecycleConfig_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BucketLifecycleConfig_CreatedAt(v time.Time) BucketLifecycleConfig_CreatedAt_Field {
+	return BucketLifecycleConfig_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f BucketLifecycleConfig_CreatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketLifecycleConfig_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BucketLifecycleConfig_UpdatedAt(v time.Time) BucketLifecycleConfig_UpdatedAt_Field {
+	return BucketLifecycleConfig_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f BucketLifecycleConfig_UpdatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketLimit struct {
+	ProjectId      []byte
+	BucketName     []byte
+	StorageLimit   *int64
+	BandwidthLimit *int64
+	SegmentLimit   *int64
+	UpdatedAt      time.Time
+}
+
+func (BucketLimit) _Table() string { return "bucket_limits" }
+
+type BucketLimit_Create_Fields struct {
+	StorageLimit   BucketLimit_StorageLimit_Field
+	BandwidthLimit BucketLimit_BandwidthLimit_Field
+	SegmentLimit   BucketLimit_SegmentLimit_Field
+}
+
+type BucketLimit_Update_Fields struct {
+	StorageLimit   BucketLimit_StorageLimit_Field
+	BandwidthLimit BucketLimit_BandwidthLimit_Field
+	SegmentLimit   BucketLimit_SegmentLimit_Field
+}
+
+type BucketLimit_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketLimit_ProjectId(v []byte) BucketLimit_ProjectId_Field {
+	return BucketLimit_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f BucketLimit_ProjectId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketLimit_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketLimit_BucketName(v []byte) BucketLimit_BucketName_Field {
+	return BucketLimit_BucketName_Field{_set: true, _value: v}
+}
+
+func (f BucketLimit_BucketName_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketLimit_StorageLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func BucketLimit_StorageLimit(v int64) BucketLimit_StorageLimit_Field {
+	return BucketLimit_StorageLimit_Field{_set: true, _value: &v}
+}
+
+func BucketLimit_StorageLimit_Raw(v *int64) BucketLimit_StorageLimit_Field {
+	if v == nil {
+		return BucketLimit_StorageLimit_Null()
+	}
+	return BucketLimit_StorageLimit(*v)
+}
+
+func BucketLimit_StorageLimit_Null() BucketLimit_StorageLimit_Field {
+	return BucketLimit_StorageLimit_Field{_set: true, _null: true}
+}
+
+func (f BucketLimit_StorageLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f BucketLimit_StorageLimit_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketLimit_BandwidthLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func BucketLimit_BandwidthLimit(v int64) BucketLimit_BandwidthLimit_Field {
+	return BucketLimit_BandwidthLimit_Field{_set: true, _value: &v}
+}
+
+func BucketLimit_BandwidthLimit_Raw(v *int64) BucketLimit_BandwidthLimit_Field {
+	if v == nil {
+		return BucketLimit_BandwidthLimit_Null()
+	}
+	return BucketLimit_BandwidthLimit(*v)
+}
+
+func BucketLimit_BandwidthLimit_Null() BucketLimit_BandwidthLimit_Field {
+	return BucketLimit_BandwidthLimit_Field{_set: true, _null: true}
+}
+
+func (f BucketLimit_BandwidthLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f BucketLimit_BandwidthLimit_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketLimit_SegmentLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func BucketLimit_SegmentLimit(v int64) BucketLimit_SegmentLimit_Field {
+	return BucketLimit_SegmentLimit_Field{_set: true, _value: &v}
+}
+
+func BucketLimit_SegmentLimit_Raw(v *int64) BucketLimit_SegmentLimit_Field {
+	if v == nil {
+		return BucketLimit_SegmentLimit_Null()
+	}
+	return BucketLimit_SegmentLimit(*v)
+}
+
+func BucketLimit_SegmentLimit_Null() BucketLimit_SegmentLimit_Field {
+	return BucketLimit_SegmentLimit_Field{_set: true, _null: true}
+}
+
+func (f BucketLimit_SegmentLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f BucketLimit_SegmentLimit_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketLimit_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BucketLimit_UpdatedAt(v time.Time) BucketLimit_UpdatedAt_Field {
+	return BucketLimit_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f BucketLimit_UpdatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type BucketStorageTally struct {
 	BucketName          []byte
 	ProjectId           []byte
@@ -5696,6 +6806,8 @@ type Node struct {
 	NoisePublicKey          []byte
 	DebounceLimit           int
 	Features                int
+	Asn                     *int64
+	AsnOrg                  *string
 }
 
 func (Node) _Table() string { return "nodes" }
@@ -5733,6 +6845,8 @@ type Node_Create_Fields struct {
 	NoisePublicKey          Node_NoisePublicKey_Field
 	DebounceLimit           Node_DebounceLimit_Field
 	Features                Node_Features_Field
+	Asn                     Node_Asn_Field
+	AsnOrg                  Node_AsnOrg_Field
 }
 
 type Node_Update_Fields struct {
@@ -5772,6 +6886,8 @@ type Node_Update_Fields struct {
 	NoisePublicKey          Node_NoisePublicKey_Field
 	DebounceLimit           Node_DebounceLimit_Field
 	Features                Node_Features_Field
+	Asn                     Node_Asn_Field
+	AsnOrg                  Node_AsnOrg_Field
 }
 
 type Node_Id_Field struct {
@@ -6649,6 +7765,66 @@ func (f Node_Features_Field) value() any {
 	return f._value
 }
 
+type Node_Asn_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func Node_Asn(v int64) Node_Asn_Field {
+	return Node_Asn_Field{_set: true, _value: &v}
+}
+
+func Node_Asn_Raw(v *int64) Node_Asn_Field {
+	if v == nil {
+		return Node_Asn_Null()
+	}
+	return Node_Asn(*v)
+}
+
+func Node_Asn_Null() Node_Asn_Field {
+	return Node_Asn_Field{_set: true, _null: true}
+}
+
+func (f Node_Asn_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_Asn_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Node_AsnOrg_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func Node_AsnOrg(v string) Node_AsnOrg_Field {
+	return Node_AsnOrg_Field{_set: true, _value: &v}
+}
+
+func Node_AsnOrg_Raw(v *string) Node_AsnOrg_Field {
+	if v == nil {
+		return Node_AsnOrg_Null()
+	}
+	return Node_AsnOrg(*v)
+}
+
+func Node_AsnOrg_Null() Node_AsnOrg_Field {
+	return Node_AsnOrg_Field{_set: true, _null: true}
+}
+
+func (f Node_AsnOrg_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_AsnOrg_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type NodeApiVersion struct {
 	Id         []byte
 	ApiVersion int
@@ -7489,6 +8665,224 @@ func (f OauthToken_ExpiresAt_Field) value() any {
 	return f._value
 }
 
+type Organization struct {
+	Id             []byte
+	Name           string
+	OwnerId        []byte
+	ProjectLimit   *int
+	StorageLimit   *int64
+	BandwidthLimit *int64
+	SegmentLimit   *int64
+	CreatedAt      time.Time
+}
+
+func (Organization) _Table() string { return "organizations" }
+
+type Organization_Create_Fields struct {
+	ProjectLimit   Organization_ProjectLimit_Field
+	StorageLimit   Organization_StorageLimit_Field
+	BandwidthLimit Organization_BandwidthLimit_Field
+	SegmentLimit   Organization_SegmentLimit_Field
+}
+
+type Organization_Update_Fields struct {
+	Name           Organization_Name_Field
+	ProjectLimit   Organization_ProjectLimit_Field
+	StorageLimit   Organization_StorageLimit_Field
+	BandwidthLimit Organization_BandwidthLimit_Field
+	SegmentLimit   Organization_SegmentLimit_Field
+}
+
+type Organization_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Organization_Id(v []byte) Organization_Id_Field {
+	return Organization_Id_Field{_set: true, _value: v}
+}
+
+func (f Organization_Id_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Organization_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Organization_Name(v string) Organization_Name_Field {
+	return Organization_Name_Field{_set: true, _value: v}
+}
+
+func (f Organization_Name_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Organization_OwnerId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Organization_OwnerId(v []byte) Organization_OwnerId_Field {
+	return Organization_OwnerId_Field{_set: true, _value: v}
+}
+
+func (f Organization_OwnerId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Organization_ProjectLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func Organization_ProjectLimit(v int) Organization_ProjectLimit_Field {
+	return Organization_ProjectLimit_Field{_set: true, _value: &v}
+}
+
+func Organization_ProjectLimit_Raw(v *int) Organization_ProjectLimit_Field {
+	if v == nil {
+		return Organization_ProjectLimit_Null()
+	}
+	return Organization_ProjectLimit(*v)
+}
+
+func Organization_ProjectLimit_Null() Organization_ProjectLimit_Field {
+	return Organization_ProjectLimit_Field{_set: true, _null: true}
+}
+
+func (f Organization_ProjectLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Organization_ProjectLimit_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Organization_StorageLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func Organization_StorageLimit(v int64) Organization_StorageLimit_Field {
+	return Organization_StorageLimit_Field{_set: true, _value: &v}
+}
+
+func Organization_StorageLimit_Raw(v *int64) Organization_StorageLimit_Field {
+	if v == nil {
+		return Organization_StorageLimit_Null()
+	}
+	return Organization_StorageLimit(*v)
+}
+
+func Organization_StorageLimit_Null() Organization_StorageLimit_Field {
+	return Organization_StorageLimit_Field{_set: true, _null: true}
+}
+
+func (f Organization_StorageLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Organization_StorageLimit_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Organization_BandwidthLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func Organization_BandwidthLimit(v int64) Organization_BandwidthLimit_Field {
+	return Organization_BandwidthLimit_Field{_set: true, _value: &v}
+}
+
+func Organization_BandwidthLimit_Raw(v *int64) Organization_BandwidthLimit_Field {
+	if v == nil {
+		return Organization_BandwidthLimit_Null()
+	}
+	return Organization_BandwidthLimit(*v)
+}
+
+func Organization_BandwidthLimit_Null() Organization_BandwidthLimit_Field {
+	return Organization_BandwidthLimit_Field{_set: true, _null: true}
+}
+
+func (f Organization_BandwidthLimit_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f Organization_BandwidthLimit_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Organization_SegmentLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func Organization_SegmentLimit(v int64) Organization_SegmentLimit_Field {
+	return Organization_SegmentLimit_Field{_set: true, _value: &v}
+}
+
+func Organization_SegmentLimit_Raw(v *int64) Organization_SegmentLimit_Field {
+	if v == nil {
+		return Organization_SegmentLimit_Null()
+	}
+	return Organization_SegmentLimit(*v)
+}
+
+func Organization_SegmentLimit_Null() Organization_SegmentLimit_Field {
+	return Organization_SegmentLimit_Field{_set: true, _null: true}
+}
+
+func (f Organization_SegmentLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Organization_SegmentLimit_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Organization_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Organization_CreatedAt(v time.Time) Organization_CreatedAt_Field {
+	return Organization_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f Organization_CreatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type PeerIdentity struct {
 	NodeId           []byte
 	LeafSerialNumber []byte
@@ -10207,6 +11601,175 @@ func (f Revocation_ApiKeyId_Field) value() any {
 	return f._value
 }
 
+type ScimGroup struct {
+	Id          []byte
+	Provider    string
+	DisplayName string
+	ExternalId  *string
+	ProjectId   []byte
+	Role        string
+	CreatedAt   time.Time
+}
+
+func (ScimGroup) _Table() string { return "scim_groups" }
+
+type ScimGroup_Create_Fields struct {
+	ExternalId ScimGroup_ExternalId_Field
+	ProjectId  ScimGroup_ProjectId_Field
+}
+
+type ScimGroup_Update_Fields struct {
+	DisplayName ScimGroup_DisplayName_Field
+	ExternalId  ScimGroup_ExternalId_Field
+	ProjectId   ScimGroup_ProjectId_Field
+	Role        ScimGroup_Role_Field
+}
+
+type ScimGroup_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ScimGroup_Id(v []byte) ScimGroup_Id_Field {
+	return ScimGroup_Id_Field{_set: true, _value: v}
+}
+
+func (f ScimGroup_Id_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ScimGroup_Provider_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ScimGroup_Provider(v string) ScimGroup_Provider_Field {
+	return ScimGroup_Provider_Field{_set: true, _value: v}
+}
+
+func (f ScimGroup_Provider_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ScimGroup_DisplayName_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ScimGroup_DisplayName(v string) ScimGroup_DisplayName_Field {
+	return ScimGroup_DisplayName_Field{_set: true, _value: v}
+}
+
+func (f ScimGroup_DisplayName_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ScimGroup_ExternalId_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func ScimGroup_ExternalId(v string) ScimGroup_ExternalId_Field {
+	return ScimGroup_ExternalId_Field{_set: true, _value: &v}
+}
+
+func ScimGroup_ExternalId_Raw(v *string) ScimGroup_ExternalId_Field {
+	if v == nil {
+		return ScimGroup_ExternalId_Null()
+	}
+	return ScimGroup_ExternalId(*v)
+}
+
+func ScimGroup_ExternalId_Null() ScimGroup_ExternalId_Field {
+	return ScimGroup_ExternalId_Field{_set: true, _null: true}
+}
+
+func (f ScimGroup_ExternalId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f ScimGroup_ExternalId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ScimGroup_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ScimGroup_ProjectId(v []byte) ScimGroup_ProjectId_Field {
+	return ScimGroup_ProjectId_Field{_set: true, _value: v}
+}
+
+func ScimGroup_ProjectId_Raw(v []byte) ScimGroup_ProjectId_Field {
+	if v == nil {
+		return ScimGroup_ProjectId_Null()
+	}
+	return ScimGroup_ProjectId(v)
+}
+
+func ScimGroup_ProjectId_Null() ScimGroup_ProjectId_Field {
+	return ScimGroup_ProjectId_Field{_set: true, _null: true}
+}
+
+func (f ScimGroup_ProjectId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f ScimGroup_ProjectId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ScimGroup_Role_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ScimGroup_Role(v string) ScimGroup_Role_Field {
+	return ScimGroup_Role_Field{_set: true, _value: v}
+}
+
+func (f ScimGroup_Role_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ScimGroup_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ScimGroup_CreatedAt(v time.Time) ScimGroup_CreatedAt_Field {
+	return ScimGroup_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ScimGroup_CreatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type SegmentPendingAudits struct {
 	NodeId            []byte
 	StreamId          []byte
@@ -13718,6 +15281,53 @@ func (f WebappSession_ExpiresAt_Field) value() any {
 	return f._value
 }
 
+type WebauthnUsedChallenge struct {
+	Challenge []byte
+	ExpiresAt time.Time
+}
+
+func (WebauthnUsedChallenge) _Table() string { return "webauthn_used_challenges" }
+
+type WebauthnUsedChallenge_Create_Fields struct {
+}
+
+type WebauthnUsedChallenge_Update_Fields struct {
+}
+
+type WebauthnUsedChallenge_Challenge_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func WebauthnUsedChallenge_Challenge(v []byte) WebauthnUsedChallenge_Challenge_Field {
+	return WebauthnUsedChallenge_Challenge_Field{_set: true, _value: v}
+}
+
+func (f WebauthnUsedChallenge_Challenge_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type WebauthnUsedChallenge_ExpiresAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func WebauthnUsedChallenge_ExpiresAt(v time.Time) WebauthnUsedChallenge_ExpiresAt_Field {
+	return WebauthnUsedChallenge_ExpiresAt_Field{_set: true, _value: v}
+}
+
+func (f WebauthnUsedChallenge_ExpiresAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type ApiKey struct {
 	Id        []byte
 	ProjectId []byte
@@ -14851,6 +16461,155 @@ func (f Domain_CreatedAt_Field) value() any {
 	return f._value
 }
 
+type OrganizationMember struct {
+	OrganizationId []byte
+	MemberId       []byte
+	Role           int
+	CreatedAt      time.Time
+}
+
+func (OrganizationMember) _Table() string { return "organization_members" }
+
+type OrganizationMember_Create_Fields struct {
+}
+
+type OrganizationMember_Update_Fields struct {
+	Role OrganizationMember_Role_Field
+}
+
+type OrganizationMember_OrganizationId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OrganizationMember_OrganizationId(v []byte) OrganizationMember_OrganizationId_Field {
+	return OrganizationMember_OrganizationId_Field{_set: true, _value: v}
+}
+
+func (f OrganizationMember_OrganizationId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type OrganizationMember_MemberId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OrganizationMember_MemberId(v []byte) OrganizationMember_MemberId_Field {
+	return OrganizationMember_MemberId_Field{_set: true, _value: v}
+}
+
+func (f OrganizationMember_MemberId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type OrganizationMember_Role_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func OrganizationMember_Role(v int) OrganizationMember_Role_Field {
+	return OrganizationMember_Role_Field{_set: true, _value: v}
+}
+
+func (f OrganizationMember_Role_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type OrganizationMember_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func OrganizationMember_CreatedAt(v time.Time) OrganizationMember_CreatedAt_Field {
+	return OrganizationMember_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f OrganizationMember_CreatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type OrganizationProject struct {
+	ProjectId      []byte
+	OrganizationId []byte
+	CreatedAt      time.Time
+}
+
+func (OrganizationProject) _Table() string { return "organization_projects" }
+
+type OrganizationProject_Create_Fields struct {
+}
+
+type OrganizationProject_Update_Fields struct {
+}
+
+type OrganizationProject_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OrganizationProject_ProjectId(v []byte) OrganizationProject_ProjectId_Field {
+	return OrganizationProject_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f OrganizationProject_ProjectId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type OrganizationProject_OrganizationId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OrganizationProject_OrganizationId(v []byte) OrganizationProject_OrganizationId_Field {
+	return OrganizationProject_OrganizationId_Field{_set: true, _value: v}
+}
+
+func (f OrganizationProject_OrganizationId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type OrganizationProject_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func OrganizationProject_CreatedAt(v time.Time) OrganizationProject_CreatedAt_Field {
+	return OrganizationProject_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f OrganizationProject_CreatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type ProjectInvitation struct {
 	ProjectId []byte
 	Email     string
@@ -15037,6 +16796,109 @@ func (f ProjectMember_CreatedAt_Field) value() any {
 	return f._value
 }
 
+type ProjectRole struct {
+	Id          []byte
+	ProjectId   []byte
+	Name        string
+	Permissions int64
+	CreatedAt   time.Time
+}
+
+func (ProjectRole) _Table() string { return "project_roles" }
+
+type ProjectRole_Create_Fields struct {
+}
+
+type ProjectRole_Update_Fields struct {
+	Name        ProjectRole_Name_Field
+	Permissions ProjectRole_Permissions_Field
+}
+
+type ProjectRole_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectRole_Id(v []byte) ProjectRole_Id_Field {
+	return ProjectRole_Id_Field{_set: true, _value: v}
+}
+
+func (f ProjectRole_Id_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ProjectRole_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectRole_ProjectId(v []byte) ProjectRole_ProjectId_Field {
+	return ProjectRole_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectRole_ProjectId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ProjectRole_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ProjectRole_Name(v string) ProjectRole_Name_Field {
+	return ProjectRole_Name_Field{_set: true, _value: v}
+}
+
+func (f ProjectRole_Name_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ProjectRole_Permissions_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func ProjectRole_Permissions(v int64) ProjectRole_Permissions_Field {
+	return ProjectRole_Permissions_Field{_set: true, _value: v}
+}
+
+func (f ProjectRole_Permissions_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ProjectRole_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectRole_CreatedAt(v time.Time) ProjectRole_CreatedAt_Field {
+	return ProjectRole_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectRole_CreatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type RestApiKey struct {
 	Id        []byte
 	UserId    []byte
@@ -15171,6 +17033,53 @@ func (f RestApiKey_CreatedAt_Field) value() any {
 	return f._value
 }
 
+type ScimGroupMember struct {
+	GroupId []byte
+	UserId  []byte
+}
+
+func (ScimGroupMember) _Table() string { return "scim_group_members" }
+
+type ScimGroupMember_Create_Fields struct {
+}
+
+type ScimGroupMember_Update_Fields struct {
+}
+
+type ScimGroupMember_GroupId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ScimGroupMember_GroupId(v []byte) ScimGroupMember_GroupId_Field {
+	return ScimGroupMember_GroupId_Field{_set: true, _value: v}
+}
+
+func (f ScimGroupMember_GroupId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ScimGroupMember_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ScimGroupMember_UserId(v []byte) ScimGroupMember_UserId_Field {
+	return ScimGroupMember_UserId_Field{_set: true, _value: v}
+}
+
+func (f ScimGroupMember_UserId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type StripecoinpaymentsApplyBalanceIntent struct {
 	TxId      string
 	State     int
@@ -15236,6 +17145,198 @@ func (f StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field) value() any {
 	return f._value
 }
 
+type WebauthnCredential struct {
+	Id           []byte
+	UserId       []byte
+	CredentialId []byte
+	PublicKey    []byte
+	SignCount    int64
+	Transports   string
+	Name         string
+	CreatedAt    time.Time
+	LastUsedAt   *time.Time
+}
+
+func (WebauthnCredential) _Table() string { return "webauthn_credentials" }
+
+type WebauthnCredential_Create_Fields struct {
+	LastUsedAt WebauthnCredential_LastUsedAt_Field
+}
+
+type WebauthnCredential_Update_Fields struct {
+	SignCount  WebauthnCredential_SignCount_Field
+	Name       WebauthnCredential_Name_Field
+	LastUsedAt WebauthnCredential_LastUsedAt_Field
+}
+
+type WebauthnCredential_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func WebauthnCredential_Id(v []byte) WebauthnCredential_Id_Field {
+	return WebauthnCredential_Id_Field{_set: true, _value: v}
+}
+
+func (f WebauthnCredential_Id_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type WebauthnCredential_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func WebauthnCredential_UserId(v []byte) WebauthnCredential_UserId_Field {
+	return WebauthnCredential_UserId_Field{_set: true, _value: v}
+}
+
+func (f WebauthnCredential_UserId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type WebauthnCredential_CredentialId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func WebauthnCredential_CredentialId(v []byte) WebauthnCredential_CredentialId_Field {
+	return WebauthnCredential_CredentialId_Field{_set: true, _value: v}
+}
+
+func (f WebauthnCredential_CredentialId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type WebauthnCredential_PublicKey_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func WebauthnCredential_PublicKey(v []byte) WebauthnCredential_PublicKey_Field {
+	return WebauthnCredential_PublicKey_Field{_set: true, _value: v}
+}
+
+func (f WebauthnCredential_PublicKey_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type WebauthnCredential_SignCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func WebauthnCredential_SignCount(v int64) WebauthnCredential_SignCount_Field {
+	return WebauthnCredential_SignCount_Field{_set: true, _value: v}
+}
+
+func (f WebauthnCredential_SignCount_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type WebauthnCredential_Transports_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func WebauthnCredential_Transports(v string) WebauthnCredential_Transports_Field {
+	return WebauthnCredential_Transports_Field{_set: true, _value: v}
+}
+
+func (f WebauthnCredential_Transports_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type WebauthnCredential_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func WebauthnCredential_Name(v string) WebauthnCredential_Name_Field {
+	return WebauthnCredential_Name_Field{_set: true, _value: v}
+}
+
+func (f WebauthnCredential_Name_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type WebauthnCredential_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func WebauthnCredential_CreatedAt(v time.Time) WebauthnCredential_CreatedAt_Field {
+	return WebauthnCredential_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f WebauthnCredential_CreatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type WebauthnCredential_LastUsedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func WebauthnCredential_LastUsedAt(v time.Time) WebauthnCredential_LastUsedAt_Field {
+	return WebauthnCredential_LastUsedAt_Field{_set: true, _value: &v}
+}
+
+func WebauthnCredential_LastUsedAt_Raw(v *time.Time) WebauthnCredential_LastUsedAt_Field {
+	if v == nil {
+		return WebauthnCredential_LastUsedAt_Null()
+	}
+	return WebauthnCredential_LastUsedAt(*v)
+}
+
+func WebauthnCredential_LastUsedAt_Null() WebauthnCredential_LastUsedAt_Field {
+	return WebauthnCredential_LastUsedAt_Field{_set: true, _null: true}
+}
+
+func (f WebauthnCredential_LastUsedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f WebauthnCredential_LastUsedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type ApiKeyTail struct {
 	RootKeyId  []byte
 	Tail       []byte
@@ -15352,6 +17453,71 @@ func (f ApiKeyTail_LastUsed_Field) value() any {
 	return f._value
 }
 
+type ProjectMemberRole struct {
+	MemberId  []byte
+	ProjectId []byte
+	RoleId    []byte
+}
+
+func (ProjectMemberRole) _Table() string { return "project_member_roles" }
+
+type ProjectMemberRole_Create_Fields struct {
+}
+
+type ProjectMemberRole_Update_Fields struct {
+}
+
+type ProjectMemberRole_MemberId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectMemberRole_MemberId(v []byte) ProjectMemberRole_MemberId_Field {
+	return ProjectMemberRole_MemberId_Field{_set: true, _value: v}
+}
+
+func (f ProjectMemberRole_MemberId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ProjectMemberRole_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectMemberRole_ProjectId(v []byte) ProjectMemberRole_ProjectId_Field {
+	return ProjectMemberRole_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectMemberRole_ProjectId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type ProjectMemberRole_RoleId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectMemberRole_RoleId(v []byte) ProjectMemberRole_RoleId_Field {
+	return ProjectMemberRole_RoleId_Field{_set: true, _value: v}
+}
+
+func (f ProjectMemberRole_RoleId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 func toUTC(t time.Time) time.Time {
 	return t.UTC()
 }
@@ -16476,6 +18642,53 @@ func (obj *pgxImpl) Create_ChangeHistory(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) Create_AdminApprovalRequest(ctx context.Context,
+	admin_approval_request_id AdminApprovalRequest_Id_Field,
+	admin_approval_request_operation AdminApprovalRequest_Operation_Field,
+	admin_approval_request_item_type AdminApprovalRequest_ItemType_Field,
+	admin_approval_request_user_id AdminApprovalRequest_UserId_Field,
+	admin_approval_request_requested_by AdminApprovalRequest_RequestedBy_Field,
+	admin_approval_request_reason AdminApprovalRequest_Reason_Field,
+	admin_approval_request_details AdminApprovalRequest_Details_Field,
+	admin_approval_request_payload AdminApprovalRequest_Payload_Field,
+	admin_approval_request_expires_at AdminApprovalRequest_ExpiresAt_Field,
+	optional AdminApprovalRequest_Create_Fields) (
+	admin_approval_request *AdminApprovalRequest, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := admin_approval_request_id.value()
+	__operation_val := admin_approval_request_operation.value()
+	__item_type_val := admin_approval_request_item_type.value()
+	__user_id_val := admin_approval_request_user_id.value()
+	__project_id_val := optional.ProjectId.value()
+	__requested_by_val := admin_approval_request_requested_by.value()
+	__reason_val := admin_approval_request_reason.value()
+	__details_val := admin_approval_request_details.value()
+	__payload_val := admin_approval_request_payload.value()
+	__created_at_val := __now
+	__expires_at_val := admin_approval_request_expires_at.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO admin_approval_requests ( id, operation, item_type, user_id, project_id, requested_by, reason, details, payload, created_at, expires_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING admin_approval_requests.id, admin_approval_requests.operation, admin_approval_requests.item_type, admin_approval_requests.user_id, admin_approval_requests.project_id, admin_approval_requests.requested_by, admin_approval_requests.reason, admin_approval_requests.details, admin_approval_requests.payload, admin_approval_requests.created_at, admin_approval_requests.expires_at")
+
+	var __values []any
+	__values = append(__values, __id_val, __operation_val, __item_type_val, __user_id_val, __project_id_val, __requested_by_val, __reason_val, __details_val, __payload_val, __created_at_val, __expires_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	admin_approval_request = &AdminApprovalRequest{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&admin_approval_request.Id, &admin_approval_request.Operation, &admin_approval_request.ItemType, &admin_approval_request.UserId, &admin_approval_request.ProjectId, &admin_approval_request.RequestedBy, &admin_approval_request.Reason, &admin_approval_request.Details, &admin_approval_request.Payload, &admin_approval_request.CreatedAt, &admin_approval_request.ExpiresAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return admin_approval_request, nil
+
+}
+
 func (obj *pgxImpl) Create_Domain(ctx context.Context,
 	domain_subdomain Domain_Subdomain_Field,
 	domain_project_id Domain_ProjectId_Field,
@@ -17056,6 +19269,108 @@ func (obj *pgxImpl) CreateNoReturn_OauthToken(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) Create_Organization(ctx context.Context,
+	organization_id Organization_Id_Field,
+	organization_name Organization_Name_Field,
+	organization_owner_id Organization_OwnerId_Field,
+	optional Organization_Create_Fields) (
+	organization *Organization, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := organization_id.value()
+	__name_val := organization_name.value()
+	__owner_id_val := organization_owner_id.value()
+	__project_limit_val := optional.ProjectLimit.value()
+	__storage_limit_val := optional.StorageLimit.value()
+	__bandwidth_limit_val := optional.BandwidthLimit.value()
+	__segment_limit_val := optional.SegmentLimit.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO organizations ( id, name, owner_id, project_limit, storage_limit, bandwidth_limit, segment_limit, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING organizations.id, organizations.name, organizations.owner_id, organizations.project_limit, organizations.storage_limit, organizations.bandwidth_limit, organizations.segment_limit, organizations.created_at")
+
+	var __values []any
+	__values = append(__values, __id_val, __name_val, __owner_id_val, __project_limit_val, __storage_limit_val, __bandwidth_limit_val, __segment_limit_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	organization = &Organization{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&organization.Id, &organization.Name, &organization.OwnerId, &organization.ProjectLimit, &organization.StorageLimit, &organization.BandwidthLimit, &organization.SegmentLimit, &organization.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return organization, nil
+
+}
+
+func (obj *pgxImpl) Create_OrganizationMember(ctx context.Context,
+	organization_member_organization_id OrganizationMember_OrganizationId_Field,
+	organization_member_member_id OrganizationMember_MemberId_Field,
+	organization_member_role OrganizationMember_Role_Field) (
+	organization_member *OrganizationMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__organization_id_val := organization_member_organization_id.value()
+	__member_id_val := organization_member_member_id.value()
+	__role_val := organization_member_role.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO organization_members ( organization_id, member_id, role, created_at ) VALUES ( ?, ?, ?, ? ) RETURNING organization_members.organization_id, organization_members.member_id, organization_members.role, organization_members.created_at")
+
+	var __values []any
+	__values = append(__values, __organization_id_val, __member_id_val, __role_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	organization_member = &OrganizationMember{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&organization_member.OrganizationId, &organization_member.MemberId, &organization_member.Role, &organization_member.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return organization_member, nil
+
+}
+
+func (obj *pgxImpl) Create_OrganizationProject(ctx context.Context,
+	organization_project_project_id OrganizationProject_ProjectId_Field,
+	organization_project_organization_id OrganizationProject_OrganizationId_Field) (
+	organization_project *OrganizationProject, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := organization_project_project_id.value()
+	__organization_id_val := organization_project_organization_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO organization_projects ( project_id, organization_id, created_at ) VALUES ( ?, ?, ? ) RETURNING organization_projects.project_id, organization_projects.organization_id, organization_projects.created_at")
+
+	var __values []any
+	__values = append(__values, __project_id_val, __organization_id_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	organization_project = &OrganizationProject{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&organization_project.ProjectId, &organization_project.OrganizationId, &organization_project.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return organization_project, nil
+
+}
+
 func (obj *pgxImpl) Create_Project(ctx context.Context,
 	project_id Project_Id_Field,
 	project_name Project_Name_Field,
@@ -17403,6 +19718,71 @@ func (obj *pgxImpl) Replace_ApiKeyTail(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) Create_ProjectRole(ctx context.Context,
+	project_role_id ProjectRole_Id_Field,
+	project_role_project_id ProjectRole_ProjectId_Field,
+	project_role_name ProjectRole_Name_Field,
+	project_role_permissions ProjectRole_Permissions_Field) (
+	project_role *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := project_role_id.value()
+	__project_id_val := project_role_project_id.value()
+	__name_val := project_role_name.value()
+	__permissions_val := project_role_permissions.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_roles ( id, project_id, name, permissions, created_at ) VALUES ( ?, ?, ?, ?, ? ) RETURNING project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.created_at")
+
+	var __values []any
+	__values = append(__values, __id_val, __project_id_val, __name_val, __permissions_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_role = &ProjectRole{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_role, nil
+
+}
+
+func (obj *pgxImpl) Replace_ProjectMemberRole(ctx context.Context,
+	project_member_role_member_id ProjectMemberRole_MemberId_Field,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field,
+	project_member_role_role_id ProjectMemberRole_RoleId_Field) (
+	project_member_role *ProjectMemberRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+	__member_id_val := project_member_role_member_id.value()
+	__project_id_val := project_member_role_project_id.value()
+	__role_id_val := project_member_role_role_id.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_member_roles ( member_id, project_id, role_id ) VALUES ( ?, ?, ? ) ON CONFLICT ( member_id, project_id ) DO UPDATE SET member_id = EXCLUDED.member_id, project_id = EXCLUDED.project_id, role_id = EXCLUDED.role_id RETURNING project_member_roles.member_id, project_member_roles.project_id, project_member_roles.role_id")
+
+	var __values []any
+	__values = append(__values, __member_id_val, __project_id_val, __role_id_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_member_role = &ProjectMemberRole{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_member_role.MemberId, &project_member_role.ProjectId, &project_member_role.RoleId)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_member_role, nil
+
+}
+
 func (obj *pgxImpl) Create_BucketMetainfo(ctx context.Context,
 	bucket_metainfo_id BucketMetainfo_Id_Field,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
@@ -17591,6 +19971,77 @@ func (obj *pgxImpl) Create_BucketMigration(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) Replace_BucketLimit(ctx context.Context,
+	bucket_limit_project_id BucketLimit_ProjectId_Field,
+	bucket_limit_bucket_name BucketLimit_BucketName_Field,
+	optional BucketLimit_Create_Fields) (
+	bucket_limit *BucketLimit, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_limit_project_id.value()
+	__bucket_name_val := bucket_limit_bucket_name.value()
+	__storage_limit_val := optional.StorageLimit.value()
+	__bandwidth_limit_val := optional.BandwidthLimit.value()
+	__segment_limit_val := optional.SegmentLimit.value()
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_limits ( project_id, bucket_name, storage_limit, bandwidth_limit, segment_limit, updated_at ) VALUES ( ?, ?, ?, ?, ?, ? ) ON CONFLICT ( project_id, bucket_name ) DO UPDATE SET project_id = EXCLUDED.project_id, bucket_name = EXCLUDED.bucket_name, storage_limit = EXCLUDED.storage_limit, bandwidth_limit = EXCLUDED.bandwidth_limit, segment_limit = EXCLUDED.segment_limit, updated_at = EXCLUDED.updated_at RETURNING bucket_limits.project_id, bucket_limits.bucket_name, bucket_limits.storage_limit, bucket_limits.bandwidth_limit, bucket_limits.segment_limit, bucket_limits.updated_at")
+
+	var __values []any
+	__values = append(__values, __project_id_val, __bucket_name_val, __storage_limit_val, __bandwidth_limit_val, __segment_limit_val, __updated_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_limit = &BucketLimit{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_limit.ProjectId, &bucket_limit.BucketName, &bucket_limit.StorageLimit, &bucket_limit.BandwidthLimit, &bucket_limit.SegmentLimit, &bucket_limit.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_limit, nil
+
+}
+
+func (obj *pgxImpl) Create_BucketLifecycleConfig(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field,
+	bucket_lifecycle_config_rules BucketLifecycleConfig_Rules_Field,
+	optional BucketLifecycleConfig_Create_Fields) (
+	bucket_lifecycle_config *BucketLifecycleConfig, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_lifecycle_config_project_id.value()
+	__bucket_name_val := bucket_lifecycle_config_bucket_name.value()
+	__rules_val := bucket_lifecycle_config_rules.value()
+	__deletion_cursor_val := optional.DeletionCursor.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_lifecycle_configs ( project_id, bucket_name, rules, deletion_cursor, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ? ) RETURNING bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name, bucket_lifecycle_configs.rules, bucket_lifecycle_configs.deletion_cursor, bucket_lifecycle_configs.created_at, bucket_lifecycle_configs.updated_at")
+
+	var __values []any
+	__values = append(__values, __project_id_val, __bucket_name_val, __rules_val, __deletion_cursor_val, __created_at_val, __updated_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_lifecycle_config = &BucketLifecycleConfig{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_lifecycle_config.ProjectId, &bucket_lifecycle_config.BucketName, &bucket_lifecycle_config.Rules, &bucket_lifecycle_config.DeletionCursor, &bucket_lifecycle_config.CreatedAt, &bucket_lifecycle_config.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_lifecycle_config, nil
+
+}
+
 func (obj *pgxImpl) CreateNoReturn_RestApiKey(ctx context.Context,
 	rest_api_key_id RestApiKey_Id_Field,
 	rest_api_key_user_id RestApiKey_UserId_Field,
@@ -18000,6 +20451,143 @@ func (obj *pgxImpl) CreateNoReturn_UserSettings(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) Create_ScimGroup(ctx context.Context,
+	scim_group_id ScimGroup_Id_Field,
+	scim_group_provider ScimGroup_Provider_Field,
+	scim_group_display_name ScimGroup_DisplayName_Field,
+	scim_group_role ScimGroup_Role_Field,
+	optional ScimGroup_Create_Fields) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := scim_group_id.value()
+	__provider_val := scim_group_provider.value()
+	__display_name_val := scim_group_display_name.value()
+	__external_id_val := optional.ExternalId.value()
+	__project_id_val := optional.ProjectId.value()
+	__role_val := scim_group_role.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO scim_groups ( id, provider, display_name, external_id, project_id, role, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? ) RETURNING scim_groups.id, scim_groups.provider, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at")
+
+	var __values []any
+	__values = append(__values, __id_val, __provider_val, __display_name_val, __external_id_val, __project_id_val, __role_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group = &ScimGroup{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.Provider, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return scim_group, nil
+
+}
+
+func (obj *pgxImpl) Replace_ScimGroupMember(ctx context.Context,
+	scim_group_member_group_id ScimGroupMember_GroupId_Field,
+	scim_group_member_user_id ScimGroupMember_UserId_Field) (
+	scim_group_member *ScimGroupMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+	__group_id_val := scim_group_member_group_id.value()
+	__user_id_val := scim_group_member_user_id.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO scim_group_members ( group_id, user_id ) VALUES ( ?, ? ) ON CONFLICT ( group_id, user_id ) DO UPDATE SET group_id = EXCLUDED.group_id, user_id = EXCLUDED.user_id RETURNING scim_group_members.group_id, scim_group_members.user_id")
+
+	var __values []any
+	__values = append(__values, __group_id_val, __user_id_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group_member = &ScimGroupMember{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&scim_group_member.GroupId, &scim_group_member.UserId)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return scim_group_member, nil
+
+}
+
+func (obj *pgxImpl) Create_WebauthnCredential(ctx context.Context,
+	webauthn_credential_id WebauthnCredential_Id_Field,
+	webauthn_credential_user_id WebauthnCredential_UserId_Field,
+	webauthn_credential_credential_id WebauthnCredential_CredentialId_Field,
+	webauthn_credential_public_key WebauthnCredential_PublicKey_Field,
+	webauthn_credential_sign_count WebauthnCredential_SignCount_Field,
+	webauthn_credential_transports WebauthnCredential_Transports_Field,
+	webauthn_credential_name WebauthnCredential_Name_Field,
+	optional WebauthnCredential_Create_Fields) (
+	webauthn_credential *WebauthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := webauthn_credential_id.value()
+	__user_id_val := webauthn_credential_user_id.value()
+	__credential_id_val := webauthn_credential_credential_id.value()
+	__public_key_val := webauthn_credential_public_key.value()
+	__sign_count_val := webauthn_credential_sign_count.value()
+	__transports_val := webauthn_credential_transports.value()
+	__name_val := webauthn_credential_name.value()
+	__created_at_val := __now
+	__last_used_at_val := optional.LastUsedAt.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO webauthn_credentials ( id, user_id, credential_id, public_key, sign_count, transports, name, created_at, last_used_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING webauthn_credentials.id, webauthn_credentials.user_id, webauthn_credentials.credential_id, webauthn_credentials.public_key, webauthn_credentials.sign_count, webauthn_credentials.transports, webauthn_credentials.name, webauthn_credentials.created_at, webauthn_credentials.last_used_at")
+
+	var __values []any
+	__values = append(__values, __id_val, __user_id_val, __credential_id_val, __public_key_val, __sign_count_val, __transports_val, __name_val, __created_at_val, __last_used_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	webauthn_credential = &WebauthnCredential{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&webauthn_credential.Id, &webauthn_credential.UserId, &webauthn_credential.CredentialId, &webauthn_credential.PublicKey, &webauthn_credential.SignCount, &webauthn_credential.Transports, &webauthn_credential.Name, &webauthn_credential.CreatedAt, &webauthn_credential.LastUsedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return webauthn_credential, nil
+
+}
+
+func (obj *pgxImpl) Create_WebauthnUsedChallenge(ctx context.Context,
+	webauthn_used_challenge_challenge WebauthnUsedChallenge_Challenge_Field,
+	webauthn_used_challenge_expires_at WebauthnUsedChallenge_ExpiresAt_Field) (
+	webauthn_used_challenge *WebauthnUsedChallenge, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+	__challenge_val := webauthn_used_challenge_challenge.value()
+	__expires_at_val := webauthn_used_challenge_expires_at.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO webauthn_used_challenges ( challenge, expires_at ) VALUES ( ?, ? ) RETURNING webauthn_used_challenges.challenge, webauthn_used_challenges.expires_at")
+
+	var __values []any
+	__values = append(__values, __challenge_val, __expires_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	webauthn_used_challenge = &WebauthnUsedChallenge{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&webauthn_used_challenge.Challenge, &webauthn_used_challenge.ExpiresAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return webauthn_used_challenge, nil
+
+}
+
 func (obj *pgxImpl) Find_AccountingTimestamps_Value_By_Name(ctx context.Context,
 	accounting_timestamps_name AccountingTimestamps_Name_Field) (
 	row *Value_Row, err error) {
@@ -19552,25 +22140,75 @@ func (obj *pgxImpl) All_ChangeHistory_By_ProjectId_And_ItemType_Not_OrderBy_Desc
 
 }
 
-func (obj *pgxImpl) All_ChangeHistory_By_ProjectId_And_ItemType_OrderBy_Desc_Timestamp(ctx context.Context,
-	change_history_project_id ChangeHistory_ProjectId_Field,
-	change_history_item_type ChangeHistory_ItemType_Field) (
+func (obj *pgxImpl) All_ChangeHistory_By_ProjectId_And_ItemType_OrderBy_Desc_Timestamp(ctx context.Context,
+	change_history_project_id ChangeHistory_ProjectId_Field,
+	change_history_item_type ChangeHistory_ItemType_Field) (
+	rows []*ChangeHistory, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.project_id", Equal: true, Right: "?", Null: true}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" AND change_histories.item_type = ? ORDER BY change_histories.timestamp DESC")}}
+
+	var __values []any
+	if !change_history_project_id.isnull() {
+		__cond_0.Null = false
+		__values = append(__values, change_history_project_id.value())
+	}
+	__values = append(__values, change_history_item_type.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ChangeHistory, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				change_history := &ChangeHistory{}
+				err = __rows.Scan(&change_history.Id, &change_history.AdminEmail, &change_history.UserId, &change_history.ProjectId, &change_history.BucketName, &change_history.ItemType, &change_history.Operation, &change_history.Reason, &change_history.Changes, &change_history.Timestamp)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, change_history)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxImpl) All_ChangeHistory_By_BucketName_OrderBy_Desc_Timestamp(ctx context.Context,
+	change_history_bucket_name ChangeHistory_BucketName_Field) (
 	rows []*ChangeHistory, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
 		panic("using DB when inside of a transaction")
 	}
 
-	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.project_id", Equal: true, Right: "?", Null: true}
+	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.bucket_name", Equal: true, Right: "?", Null: true}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" AND change_histories.item_type = ? ORDER BY change_histories.timestamp DESC")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" ORDER BY change_histories.timestamp DESC")}}
 
 	var __values []any
-	if !change_history_project_id.isnull() {
+	if !change_history_bucket_name.isnull() {
 		__cond_0.Null = false
-		__values = append(__values, change_history_project_id.value())
+		__values = append(__values, change_history_bucket_name.value())
 	}
-	__values = append(__values, change_history_item_type.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -19604,29 +22242,47 @@ func (obj *pgxImpl) All_ChangeHistory_By_ProjectId_And_ItemType_OrderBy_Desc_Tim
 
 }
 
-func (obj *pgxImpl) All_ChangeHistory_By_BucketName_OrderBy_Desc_Timestamp(ctx context.Context,
-	change_history_bucket_name ChangeHistory_BucketName_Field) (
-	rows []*ChangeHistory, err error) {
+func (obj *pgxImpl) Get_AdminApprovalRequest_By_Id(ctx context.Context,
+	admin_approval_request_id AdminApprovalRequest_Id_Field) (
+	admin_approval_request *AdminApprovalRequest, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
 		panic("using DB when inside of a transaction")
 	}
 
-	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.bucket_name", Equal: true, Right: "?", Null: true}
-
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" ORDER BY change_histories.timestamp DESC")}}
+	var __embed_stmt = __sqlbundle_Literal("SELECT admin_approval_requests.id, admin_approval_requests.operation, admin_approval_requests.item_type, admin_approval_requests.user_id, admin_approval_requests.project_id, admin_approval_requests.requested_by, admin_approval_requests.reason, admin_approval_requests.details, admin_approval_requests.payload, admin_approval_requests.created_at, admin_approval_requests.expires_at FROM admin_approval_requests WHERE admin_approval_requests.id = ?")
 
 	var __values []any
-	if !change_history_bucket_name.isnull() {
-		__cond_0.Null = false
-		__values = append(__values, change_history_bucket_name.value())
+	__values = append(__values, admin_approval_request_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	admin_approval_request = &AdminApprovalRequest{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&admin_approval_request.Id, &admin_approval_request.Operation, &admin_approval_request.ItemType, &admin_approval_request.UserId, &admin_approval_request.ProjectId, &admin_approval_request.RequestedBy, &admin_approval_request.Reason, &admin_approval_request.Details, &admin_approval_request.Payload, &admin_approval_request.CreatedAt, &admin_approval_request.ExpiresAt)
+	if err != nil {
+		return (*AdminApprovalRequest)(nil), obj.makeErr(err)
+	}
+	return admin_approval_request, nil
+
+}
+
+func (obj *pgxImpl) All_AdminApprovalRequest_OrderBy_Asc_CreatedAt(ctx context.Context) (
+	rows []*AdminApprovalRequest, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
 	}
 
+	var __embed_stmt = __sqlbundle_Literal("SELECT admin_approval_requests.id, admin_approval_requests.operation, admin_approval_requests.item_type, admin_approval_requests.user_id, admin_approval_requests.project_id, admin_approval_requests.requested_by, admin_approval_requests.reason, admin_approval_requests.details, admin_approval_requests.payload, admin_approval_requests.created_at, admin_approval_requests.expires_at FROM admin_approval_requests ORDER BY admin_approval_requests.created_at")
+
+	var __values []any
+
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	for {
-		rows, err = func() (rows []*ChangeHistory, err error) {
+		rows, err = func() (rows []*AdminApprovalRequest, err error) {
 			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 			if err != nil {
 				return nil, err
@@ -19634,12 +22290,12 @@ func (obj *pgxImpl) All_ChangeHistory_By_BucketName_OrderBy_Desc_Timestamp(ctx c
 			defer closeRows(__rows, &err)
 
 			for __rows.Next() {
-				change_history := &ChangeHistory{}
-				err = __rows.Scan(&change_history.Id, &change_history.AdminEmail, &change_history.UserId, &change_history.ProjectId, &change_history.BucketName, &change_history.ItemType, &change_history.Operation, &change_history.Reason, &change_history.Changes, &change_history.Timestamp)
+				admin_approval_request := &AdminApprovalRequest{}
+				err = __rows.Scan(&admin_approval_request.Id, &admin_approval_request.Operation, &admin_approval_request.ItemType, &admin_approval_request.UserId, &admin_approval_request.ProjectId, &admin_approval_request.RequestedBy, &admin_approval_request.Reason, &admin_approval_request.Details, &admin_approval_request.Payload, &admin_approval_request.CreatedAt, &admin_approval_request.ExpiresAt)
 				if err != nil {
 					return nil, err
 				}
-				rows = append(rows, change_history)
+				rows = append(rows, admin_approval_request)
 			}
 			return rows, nil
 		}()
@@ -19808,7 +22464,7 @@ func (obj *pgxImpl) Get_Node_By_Id(ctx context.Context,
 		panic("using DB when inside of a transaction")
 	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.asn_org FROM nodes WHERE nodes.id = ?")
 
 	var __values []any
 	__values = append(__values, node_id.value())
@@ -19817,7 +22473,7 @@ func (obj *pgxImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.Asn, &node.AsnOrg)
 	if err != nil {
 		return (*Node)(nil), obj.makeErr(err)
 	}
@@ -19876,9 +22532,9 @@ func (obj *pgxImpl) Paged_Node(ctx context.Context,
 		panic("using DB when inside of a transaction")
 	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.asn_org, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.asn_org, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
 
 	var __values []any
 
@@ -19905,7 +22561,7 @@ func (obj *pgxImpl) Paged_Node(ctx context.Context,
 
 			for __rows.Next() {
 				node := &Node{}
-				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &__continuation._value_id)
+				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.Asn, &node.AsnOrg, &__continuation._value_id)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -19935,9 +22591,9 @@ func (obj *pgxImpl) Paged_Node_By_Email(ctx context.Context,
 		panic("using DB when inside of a transaction")
 	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes WHERE nodes.email = ? AND (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.asn_org, nodes.id FROM nodes WHERE nodes.email = ? AND (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes WHERE nodes.email = ? ORDER BY nodes.id LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.asn_org, nodes.id FROM nodes WHERE nodes.email = ? ORDER BY nodes.id LIMIT ?")
 
 	var __values []any
 	__values = append(__values, node_email.value())
@@ -19965,7 +22621,7 @@ func (obj *pgxImpl) Paged_Node_By_Email(ctx context.Context,
 
 			for __rows.Next() {
 				node := &Node{}
-				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &__continuation._value_id)
+				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.Asn, &node.AsnOrg, &__continuation._value_id)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -20527,6 +23183,127 @@ func (obj *pgxImpl) Get_OauthToken_By_Kind_And_Token(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) Get_Organization_By_Id(ctx context.Context,
+	organization_id Organization_Id_Field) (
+	organization *Organization, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT organizations.id, organizations.name, organizations.owner_id, organizations.project_limit, organizations.storage_limit, organizations.bandwidth_limit, organizations.segment_limit, organizations.created_at FROM organizations WHERE organizations.id = ?")
+
+	var __values []any
+	__values = append(__values, organization_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	organization = &Organization{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&organization.Id, &organization.Name, &organization.OwnerId, &organization.ProjectLimit, &organization.StorageLimit, &organization.BandwidthLimit, &organization.SegmentLimit, &organization.CreatedAt)
+	if err != nil {
+		return (*Organization)(nil), obj.makeErr(err)
+	}
+	return organization, nil
+
+}
+
+func (obj *pgxImpl) Get_OrganizationMember_By_OrganizationId_And_MemberId(ctx context.Context,
+	organization_member_organization_id OrganizationMember_OrganizationId_Field,
+	organization_member_member_id OrganizationMember_MemberId_Field) (
+	organization_member *OrganizationMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT organization_members.organization_id, organization_members.member_id, organization_members.role, organization_members.created_at FROM organization_members WHERE organization_members.organization_id = ? AND organization_members.member_id = ?")
+
+	var __values []any
+	__values = append(__values, organization_member_organization_id.value(), organization_member_member_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	organization_member = &OrganizationMember{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&organization_member.OrganizationId, &organization_member.MemberId, &organization_member.Role, &organization_member.CreatedAt)
+	if err != nil {
+		return (*OrganizationMember)(nil), obj.makeErr(err)
+	}
+	return organization_member, nil
+
+}
+
+func (obj *pgxImpl) Get_OrganizationProject_By_ProjectId(ctx context.Context,
+	organization_project_project_id OrganizationProject_ProjectId_Field) (
+	organization_project *OrganizationProject, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT organization_projects.project_id, organization_projects.organization_id, organization_projects.created_at FROM organization_projects WHERE organization_projects.project_id = ?")
+
+	var __values []any
+	__values = append(__values, organization_project_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	organization_project = &OrganizationProject{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&organization_project.ProjectId, &organization_project.OrganizationId, &organization_project.CreatedAt)
+	if err != nil {
+		return (*OrganizationProject)(nil), obj.makeErr(err)
+	}
+	return organization_project, nil
+
+}
+
+func (obj *pgxImpl) All_OrganizationProject_By_OrganizationId_OrderBy_Asc_CreatedAt_Asc_ProjectId(ctx context.Context,
+	organization_project_organization_id OrganizationProject_OrganizationId_Field) (
+	rows []*OrganizationProject, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT organization_projects.project_id, organization_projects.organization_id, organization_projects.created_at FROM organization_projects WHERE organization_projects.organization_id = ? ORDER BY organization_projects.created_at, organization_projects.project_id")
+
+	var __values []any
+	__values = append(__values, organization_project_organization_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*OrganizationProject, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				organization_project := &OrganizationProject{}
+				err = __rows.Scan(&organization_project.ProjectId, &organization_project.OrganizationId, &organization_project.CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, organization_project)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
 func (obj *pgxImpl) Get_Project_PassphraseEnc_Project_PassphraseEncKeyId_By_Id(ctx context.Context,
 	project_id Project_Id_Field) (
 	row *PassphraseEnc_PassphraseEncKeyId_Row, err error) {
@@ -21728,6 +24505,147 @@ func (obj *pgxImpl) Get_ApiKeyTail_By_Tail(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) Get_ProjectRole_By_Id(ctx context.Context,
+	project_role_id ProjectRole_Id_Field) (
+	project_role *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.created_at FROM project_roles WHERE project_roles.id = ?")
+
+	var __values []any
+	__values = append(__values, project_role_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_role = &ProjectRole{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.CreatedAt)
+	if err != nil {
+		return (*ProjectRole)(nil), obj.makeErr(err)
+	}
+	return project_role, nil
+
+}
+
+func (obj *pgxImpl) All_ProjectRole_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
+	project_role_project_id ProjectRole_ProjectId_Field) (
+	rows []*ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.created_at FROM project_roles WHERE project_roles.project_id = ? ORDER BY project_roles.name")
+
+	var __values []any
+	__values = append(__values, project_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ProjectRole, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				project_role := &ProjectRole{}
+				err = __rows.Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, project_role)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxImpl) Get_ProjectMemberRole_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_role_member_id ProjectMemberRole_MemberId_Field,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+	project_member_role *ProjectMemberRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_member_roles.member_id, project_member_roles.project_id, project_member_roles.role_id FROM project_member_roles WHERE project_member_roles.member_id = ? AND project_member_roles.project_id = ?")
+
+	var __values []any
+	__values = append(__values, project_member_role_member_id.value(), project_member_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_member_role = &ProjectMemberRole{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_member_role.MemberId, &project_member_role.ProjectId, &project_member_role.RoleId)
+	if err != nil {
+		return (*ProjectMemberRole)(nil), obj.makeErr(err)
+	}
+	return project_member_role, nil
+
+}
+
+func (obj *pgxImpl) All_ProjectMemberRole_By_ProjectId_OrderBy_Asc_MemberId(ctx context.Context,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+	rows []*ProjectMemberRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_member_roles.member_id, project_member_roles.project_id, project_member_roles.role_id FROM project_member_roles WHERE project_member_roles.project_id = ? ORDER BY project_member_roles.member_id")
+
+	var __values []any
+	__values = append(__values, project_member_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ProjectMemberRole, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				project_member_role := &ProjectMemberRole{}
+				err = __rows.Scan(&project_member_role.MemberId, &project_member_role.ProjectId, &project_member_role.RoleId)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, project_member_role)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
 func (obj *pgxImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 	bucket_metainfo_name BucketMetainfo_Name_Field) (
@@ -22338,6 +25256,58 @@ func (obj *pgxImpl) Limited_BucketMigration_By_State_OrderBy_Asc_CreatedAt(ctx c
 
 }
 
+func (obj *pgxImpl) Get_BucketLimit_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_limit_project_id BucketLimit_ProjectId_Field,
+	bucket_limit_bucket_name BucketLimit_BucketName_Field) (
+	bucket_limit *BucketLimit, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_limits.project_id, bucket_limits.bucket_name, bucket_limits.storage_limit, bucket_limits.bandwidth_limit, bucket_limits.segment_limit, bucket_limits.updated_at FROM bucket_limits WHERE bucket_limits.project_id = ? AND bucket_limits.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_limit_project_id.value(), bucket_limit_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_limit = &BucketLimit{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_limit.ProjectId, &bucket_limit.BucketName, &bucket_limit.StorageLimit, &bucket_limit.BandwidthLimit, &bucket_limit.SegmentLimit, &bucket_limit.UpdatedAt)
+	if err != nil {
+		return (*BucketLimit)(nil), obj.makeErr(err)
+	}
+	return bucket_limit, nil
+
+}
+
+func (obj *pgxImpl) Get_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field) (
+	bucket_lifecycle_config *BucketLifecycleConfig, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name, bucket_lifecycle_configs.rules, bucket_lifecycle_configs.deletion_cursor, bucket_lifecycle_configs.created_at, bucket_lifecycle_configs.updated_at FROM bucket_lifecycle_configs WHERE bucket_lifecycle_configs.project_id = ? AND bucket_lifecycle_configs.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_lifecycle_config_project_id.value(), bucket_lifecycle_config_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_lifecycle_config = &BucketLifecycleConfig{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_lifecycle_config.ProjectId, &bucket_lifecycle_config.BucketName, &bucket_lifecycle_config.Rules, &bucket_lifecycle_config.DeletionCursor, &bucket_lifecycle_config.CreatedAt, &bucket_lifecycle_config.UpdatedAt)
+	if err != nil {
+		return (*BucketLifecycleConfig)(nil), obj.makeErr(err)
+	}
+	return bucket_lifecycle_config, nil
+
+}
+
 func (obj *pgxImpl) Get_RestApiKey_By_Id(ctx context.Context,
 	rest_api_key_id RestApiKey_Id_Field) (
 	rest_api_key *RestApiKey, err error) {
@@ -23308,6 +26278,293 @@ func (obj *pgxImpl) Get_UserSettings_By_UserId(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) Get_ScimGroup_By_Id(ctx context.Context,
+	scim_group_id ScimGroup_Id_Field) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_groups.id, scim_groups.provider, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at FROM scim_groups WHERE scim_groups.id = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group = &ScimGroup{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.Provider, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt)
+	if err != nil {
+		return (*ScimGroup)(nil), obj.makeErr(err)
+	}
+	return scim_group, nil
+
+}
+
+func (obj *pgxImpl) Get_ScimGroup_By_Provider_And_DisplayName(ctx context.Context,
+	scim_group_provider ScimGroup_Provider_Field,
+	scim_group_display_name ScimGroup_DisplayName_Field) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_groups.id, scim_groups.provider, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at FROM scim_groups WHERE scim_groups.provider = ? AND scim_groups.display_name = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_provider.value(), scim_group_display_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group = &ScimGroup{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.Provider, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt)
+	if err != nil {
+		return (*ScimGroup)(nil), obj.makeErr(err)
+	}
+	return scim_group, nil
+
+}
+
+func (obj *pgxImpl) First_ScimGroup_By_Provider_And_ExternalId(ctx context.Context,
+	scim_group_provider ScimGroup_Provider_Field,
+	scim_group_external_id ScimGroup_ExternalId_Field) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_groups.id, scim_groups.provider, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at FROM scim_groups WHERE scim_groups.provider = ? AND scim_groups.external_id = ? LIMIT 1 OFFSET 0")
+
+	var __values []any
+	__values = append(__values, scim_group_provider.value(), scim_group_external_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		scim_group, err = func() (scim_group *ScimGroup, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			if !__rows.Next() {
+				return nil, nil
+			}
+
+			scim_group = &ScimGroup{}
+			err = __rows.Scan(&scim_group.Id, &scim_group.Provider, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt)
+			if err != nil {
+				return nil, err
+			}
+
+			return scim_group, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return scim_group, nil
+	}
+
+}
+
+func (obj *pgxImpl) Count_ScimGroup_By_Provider(ctx context.Context,
+	scim_group_provider ScimGroup_Provider_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT COUNT(*) FROM scim_groups WHERE scim_groups.provider = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_provider.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&count)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *pgxImpl) Limited_ScimGroup_By_Provider_OrderBy_Asc_DisplayName(ctx context.Context,
+	scim_group_provider ScimGroup_Provider_Field,
+	limit int, offset int64) (
+	rows []*ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_groups.id, scim_groups.provider, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at FROM scim_groups WHERE scim_groups.provider = ? ORDER BY scim_groups.display_name LIMIT ? OFFSET ?")
+
+	var __values []any
+	__values = append(__values, scim_group_provider.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ScimGroup, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				scim_group := &ScimGroup{}
+				err = __rows.Scan(&scim_group.Id, &scim_group.Provider, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, scim_group)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxImpl) All_ScimGroupMember_By_GroupId_OrderBy_Asc_UserId(ctx context.Context,
+	scim_group_member_group_id ScimGroupMember_GroupId_Field) (
+	rows []*ScimGroupMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_group_members.group_id, scim_group_members.user_id FROM scim_group_members WHERE scim_group_members.group_id = ? ORDER BY scim_group_members.user_id")
+
+	var __values []any
+	__values = append(__values, scim_group_member_group_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ScimGroupMember, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				scim_group_member := &ScimGroupMember{}
+				err = __rows.Scan(&scim_group_member.GroupId, &scim_group_member.UserId)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, scim_group_member)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxImpl) Get_WebauthnCredential_By_CredentialId(ctx context.Context,
+	webauthn_credential_credential_id WebauthnCredential_CredentialId_Field) (
+	webauthn_credential *WebauthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT webauthn_credentials.id, webauthn_credentials.user_id, webauthn_credentials.credential_id, webauthn_credentials.public_key, webauthn_credentials.sign_count, webauthn_credentials.transports, webauthn_credentials.name, webauthn_credentials.created_at, webauthn_credentials.last_used_at FROM webauthn_credentials WHERE webauthn_credentials.credential_id = ?")
+
+	var __values []any
+	__values = append(__values, webauthn_credential_credential_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	webauthn_credential = &WebauthnCredential{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&webauthn_credential.Id, &webauthn_credential.UserId, &webauthn_credential.CredentialId, &webauthn_credential.PublicKey, &webauthn_credential.SignCount, &webauthn_credential.Transports, &webauthn_credential.Name, &webauthn_credential.CreatedAt, &webauthn_credential.LastUsedAt)
+	if err != nil {
+		return (*WebauthnCredential)(nil), obj.makeErr(err)
+	}
+	return webauthn_credential, nil
+
+}
+
+func (obj *pgxImpl) All_WebauthnCredential_By_UserId_OrderBy_Asc_CreatedAt_Asc_Id(ctx context.Context,
+	webauthn_credential_user_id WebauthnCredential_UserId_Field) (
+	rows []*WebauthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT webauthn_credentials.id, webauthn_credentials.user_id, webauthn_credentials.credential_id, webauthn_credentials.public_key, webauthn_credentials.sign_count, webauthn_credentials.transports, webauthn_credentials.name, webauthn_credentials.created_at, webauthn_credentials.last_used_at FROM webauthn_credentials WHERE webauthn_credentials.user_id = ? ORDER BY webauthn_credentials.created_at, webauthn_credentials.id")
+
+	var __values []any
+	__values = append(__values, webauthn_credential_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*WebauthnCredential, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				webauthn_credential := &WebauthnCredential{}
+				err = __rows.Scan(&webauthn_credential.Id, &webauthn_credential.UserId, &webauthn_credential.CredentialId, &webauthn_credential.PublicKey, &webauthn_credential.SignCount, &webauthn_credential.Transports, &webauthn_credential.Name, &webauthn_credential.CreatedAt, &webauthn_credential.LastUsedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, webauthn_credential)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
 func (obj *pgxImpl) UpdateNoReturn_AccountingTimestamps_By_Name(ctx context.Context,
 	accounting_timestamps_name AccountingTimestamps_Name_Field,
 	update AccountingTimestamps_Update_Fields) (
@@ -23651,7 +26908,238 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.asn_org")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.Address._set {
+		__values = append(__values, update.Address.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("address = ?"))
+	}
+
+	if update.LastNet._set {
+		__values = append(__values, update.LastNet.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_net = ?"))
+	}
+
+	if update.LastIpPort._set {
+		__values = append(__values, update.LastIpPort.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_ip_port = ?"))
+	}
+
+	if update.CountryCode._set {
+		__values = append(__values, update.CountryCode.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
+	}
+
+	if update.Protocol._set {
+		__values = append(__values, update.Protocol.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
+	}
+
+	if update.Email._set {
+		__values = append(__values, update.Email.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("email = ?"))
+	}
+
+	if update.Wallet._set {
+		__values = append(__values, update.Wallet.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("wallet = ?"))
+	}
+
+	if update.WalletFeatures._set {
+		__values = append(__values, update.WalletFeatures.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("wallet_features = ?"))
+	}
+
+	if update.FreeDisk._set {
+		__values = append(__values, update.FreeDisk.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_disk = ?"))
+	}
+
+	if update.PieceCount._set {
+		__values = append(__values, update.PieceCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("piece_count = ?"))
+	}
+
+	if update.Major._set {
+		__values = append(__values, update.Major.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("major = ?"))
+	}
+
+	if update.Minor._set {
+		__values = append(__values, update.Minor.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("minor = ?"))
+	}
+
+	if update.Patch._set {
+		__values = append(__values, update.Patch.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("patch = ?"))
+	}
+
+	if update.CommitHash._set {
+		__values = append(__values, update.CommitHash.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("commit_hash = ?"))
+	}
+
+	if update.ReleaseTimestamp._set {
+		__values = append(__values, update.ReleaseTimestamp.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("release_timestamp = ?"))
+	}
+
+	if update.Release._set {
+		__values = append(__values, update.Release.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("release = ?"))
+	}
+
+	if update.Latency90._set {
+		__values = append(__values, update.Latency90.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("latency_90 = ?"))
+	}
+
+	if update.VettedAt._set {
+		__values = append(__values, update.VettedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("vetted_at = ?"))
+	}
+
+	if update.LastContactSuccess._set {
+		__values = append(__values, update.LastContactSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_success = ?"))
+	}
+
+	if update.LastContactFailure._set {
+		__values = append(__values, update.LastContactFailure.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_failure = ?"))
+	}
+
+	if update.Disqualified._set {
+		__values = append(__values, update.Disqualified.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
+	}
+
+	if update.DisqualificationReason._set {
+		__values = append(__values, update.DisqualificationReason.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualification_reason = ?"))
+	}
+
+	if update.UnknownAuditSuspended._set {
+		__values = append(__values, update.UnknownAuditSuspended.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_suspended = ?"))
+	}
+
+	if update.OfflineSuspended._set {
+		__values = append(__values, update.OfflineSuspended.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("offline_suspended = ?"))
+	}
+
+	if update.UnderReview._set {
+		__values = append(__values, update.UnderReview.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
+	}
+
+	if update.ExitInitiatedAt._set {
+		__values = append(__values, update.ExitInitiatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_initiated_at = ?"))
+	}
+
+	if update.ExitLoopCompletedAt._set {
+		__values = append(__values, update.ExitLoopCompletedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_loop_completed_at = ?"))
+	}
+
+	if update.ExitFinishedAt._set {
+		__values = append(__values, update.ExitFinishedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_finished_at = ?"))
+	}
+
+	if update.ExitSuccess._set {
+		__values = append(__values, update.ExitSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
+	}
+
+	if update.Contained._set {
+		__values = append(__values, update.Contained.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("contained = ?"))
+	}
+
+	if update.LastOfflineEmail._set {
+		__values = append(__values, update.LastOfflineEmail.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_offline_email = ?"))
+	}
+
+	if update.LastSoftwareUpdateEmail._set {
+		__values = append(__values, update.LastSoftwareUpdateEmail.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_software_update_email = ?"))
+	}
+
+	if update.NoiseProto._set {
+		__values = append(__values, update.NoiseProto.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("noise_proto = ?"))
+	}
+
+	if update.NoisePublicKey._set {
+		__values = append(__values, update.NoisePublicKey.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("noise_public_key = ?"))
+	}
+
+	if update.DebounceLimit._set {
+		__values = append(__values, update.DebounceLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("debounce_limit = ?"))
+	}
+
+	if update.Features._set {
+		__values = append(__values, update.Features.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
+	}
+
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
+	if update.AsnOrg._set {
+		__values = append(__values, update.AsnOrg.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn_org = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node = &Node{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.Asn, &node.AsnOrg)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node, nil
+}
+
+func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
+	node_id Node_Id_Field,
+	update Node_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ?")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
@@ -23837,6 +27325,16 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
+	if update.AsnOrg._set {
+		__values = append(__values, update.AsnOrg.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn_org = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -23850,18 +27348,14 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return obj.makeErr(err)
 	}
-	return node, nil
+	return nil
 }
 
-func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
+func (obj *pgxImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_ExitFinishedAt_Is_Null(ctx context.Context,
 	node_id Node_Id_Field,
 	update Node_Update_Fields) (
 	err error) {
@@ -23872,7 +27366,7 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ?")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? AND nodes.disqualified is NULL AND nodes.exit_finished_at is NULL")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
@@ -24058,6 +27552,16 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
+	if update.AsnOrg._set {
+		__values = append(__values, update.AsnOrg.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn_org = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -24078,9 +27582,10 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 	return nil
 }
 
-func (obj *pgxImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_ExitFinishedAt_Is_Null(ctx context.Context,
-	node_id Node_Id_Field,
-	update Node_Update_Fields) (
+func (obj *pgxImpl) UpdateNoReturn_NodeApiVersion_By_Id_And_ApiVersion_Less(ctx context.Context,
+	node_api_version_id NodeApiVersion_Id_Field,
+	node_api_version_api_version_less NodeApiVersion_ApiVersion_Field,
+	update NodeApiVersion_Update_Fields) (
 	err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
@@ -24089,95 +27594,62 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_ExitF
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? AND nodes.disqualified is NULL AND nodes.exit_finished_at is NULL")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE node_api_versions SET "), __sets, __sqlbundle_Literal(" WHERE node_api_versions.id = ? AND node_api_versions.api_version < ?")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
 	var __args []any
 
-	if update.Address._set {
-		__values = append(__values, update.Address.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("address = ?"))
-	}
-
-	if update.LastNet._set {
-		__values = append(__values, update.LastNet.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_net = ?"))
-	}
-
-	if update.LastIpPort._set {
-		__values = append(__values, update.LastIpPort.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_ip_port = ?"))
-	}
-
-	if update.CountryCode._set {
-		__values = append(__values, update.CountryCode.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
-	}
-
-	if update.Protocol._set {
-		__values = append(__values, update.Protocol.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
+	if update.ApiVersion._set {
+		__values = append(__values, update.ApiVersion.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("api_version = ?"))
 	}
 
-	if update.Email._set {
-		__values = append(__values, update.Email.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("email = ?"))
-	}
+	__now := obj.db.Hooks.Now().UTC()
 
-	if update.Wallet._set {
-		__values = append(__values, update.Wallet.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("wallet = ?"))
-	}
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
 
-	if update.WalletFeatures._set {
-		__values = append(__values, update.WalletFeatures.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("wallet_features = ?"))
-	}
+	__args = append(__args, node_api_version_id.value(), node_api_version_api_version_less.value())
 
-	if update.FreeDisk._set {
-		__values = append(__values, update.FreeDisk.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_disk = ?"))
-	}
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
 
-	if update.PieceCount._set {
-		__values = append(__values, update.PieceCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("piece_count = ?"))
-	}
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
 
-	if update.Major._set {
-		__values = append(__values, update.Major.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("major = ?"))
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
 	}
+	return nil
+}
 
-	if update.Minor._set {
-		__values = append(__values, update.Minor.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("minor = ?"))
+func (obj *pgxImpl) Update_Reputation_By_Id(ctx context.Context,
+	reputation_id Reputation_Id_Field,
+	update Reputation_Update_Fields) (
+	reputation *Reputation, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
 	}
 
-	if update.Patch._set {
-		__values = append(__values, update.Patch.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("patch = ?"))
-	}
+	var __sets = &__sqlbundle_Hole{}
 
-	if update.CommitHash._set {
-		__values = append(__values, update.CommitHash.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("commit_hash = ?"))
-	}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE reputations SET "), __sets, __sqlbundle_Literal(" WHERE reputations.id = ? RETURNING reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta")}}
 
-	if update.ReleaseTimestamp._set {
-		__values = append(__values, update.ReleaseTimestamp.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("release_timestamp = ?"))
-	}
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
 
-	if update.Release._set {
-		__values = append(__values, update.Release.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("release = ?"))
+	if update.AuditSuccessCount._set {
+		__values = append(__values, update.AuditSuccessCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
 	}
 
-	if update.Latency90._set {
-		__values = append(__values, update.Latency90.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("latency_90 = ?"))
+	if update.TotalAuditCount._set {
+		__values = append(__values, update.TotalAuditCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_audit_count = ?"))
 	}
 
 	if update.VettedAt._set {
@@ -24185,16 +27657,6 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_ExitF
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("vetted_at = ?"))
 	}
 
-	if update.LastContactSuccess._set {
-		__values = append(__values, update.LastContactSuccess.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_success = ?"))
-	}
-
-	if update.LastContactFailure._set {
-		__values = append(__values, update.LastContactFailure.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_failure = ?"))
-	}
-
 	if update.Disqualified._set {
 		__values = append(__values, update.Disqualified.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
@@ -24220,59 +27682,34 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_ExitF
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
 	}
 
-	if update.ExitInitiatedAt._set {
-		__values = append(__values, update.ExitInitiatedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_initiated_at = ?"))
-	}
-
-	if update.ExitLoopCompletedAt._set {
-		__values = append(__values, update.ExitLoopCompletedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_loop_completed_at = ?"))
-	}
-
-	if update.ExitFinishedAt._set {
-		__values = append(__values, update.ExitFinishedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_finished_at = ?"))
-	}
-
-	if update.ExitSuccess._set {
-		__values = append(__values, update.ExitSuccess.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
-	}
-
-	if update.Contained._set {
-		__values = append(__values, update.Contained.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("contained = ?"))
-	}
-
-	if update.LastOfflineEmail._set {
-		__values = append(__values, update.LastOfflineEmail.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_offline_email = ?"))
+	if update.OnlineScore._set {
+		__values = append(__values, update.OnlineScore.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("online_score = ?"))
 	}
 
-	if update.LastSoftwareUpdateEmail._set {
-		__values = append(__values, update.LastSoftwareUpdateEmail.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_software_update_email = ?"))
+	if update.AuditHistory._set {
+		__values = append(__values, update.AuditHistory.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_history = ?"))
 	}
 
-	if update.NoiseProto._set {
-		__values = append(__values, update.NoiseProto.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("noise_proto = ?"))
+	if update.AuditReputationAlpha._set {
+		__values = append(__values, update.AuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
 	}
 
-	if update.NoisePublicKey._set {
-		__values = append(__values, update.NoisePublicKey.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("noise_public_key = ?"))
+	if update.AuditReputationBeta._set {
+		__values = append(__values, update.AuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
 	}
 
-	if update.DebounceLimit._set {
-		__values = append(__values, update.DebounceLimit.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("debounce_limit = ?"))
+	if update.UnknownAuditReputationAlpha._set {
+		__values = append(__values, update.UnknownAuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_alpha = ?"))
 	}
 
-	if update.Features._set {
-		__values = append(__values, update.Features.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
+	if update.UnknownAuditReputationBeta._set {
+		__values = append(__values, update.UnknownAuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_beta = ?"))
 	}
 
 	__now := obj.db.Hooks.Now().UTC()
@@ -24280,7 +27717,7 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_ExitF
 	__values = append(__values, __now)
 	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
 
-	__args = append(__args, node_id.value())
+	__args = append(__args, reputation_id.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -24288,18 +27725,22 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_ExitF
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	reputation = &Reputation{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
-		return obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
-	return nil
+	return reputation, nil
 }
 
-func (obj *pgxImpl) UpdateNoReturn_NodeApiVersion_By_Id_And_ApiVersion_Less(ctx context.Context,
-	node_api_version_id NodeApiVersion_Id_Field,
-	node_api_version_api_version_less NodeApiVersion_ApiVersion_Field,
-	update NodeApiVersion_Update_Fields) (
-	err error) {
+func (obj *pgxImpl) Update_Reputation_By_Id_And_AuditHistory(ctx context.Context,
+	reputation_id Reputation_Id_Field,
+	reputation_audit_history Reputation_AuditHistory_Field,
+	update Reputation_Update_Fields) (
+	reputation *Reputation, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
 		panic("using DB when inside of a transaction")
@@ -24307,15 +27748,80 @@ func (obj *pgxImpl) UpdateNoReturn_NodeApiVersion_By_Id_And_ApiVersion_Less(ctx
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE node_api_versions SET "), __sets, __sqlbundle_Literal(" WHERE node_api_versions.id = ? AND node_api_versions.api_version < ?")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE reputations SET "), __sets, __sqlbundle_Literal(" WHERE reputations.id = ? AND reputations.audit_history = ? RETURNING reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
 	var __args []any
 
-	if update.ApiVersion._set {
-		__values = append(__values, update.ApiVersion.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("api_version = ?"))
+	if update.AuditSuccessCount._set {
+		__values = append(__values, update.AuditSuccessCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
+	}
+
+	if update.TotalAuditCount._set {
+		__values = append(__values, update.TotalAuditCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_audit_count = ?"))
+	}
+
+	if update.VettedAt._set {
+		__values = append(__values, update.VettedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("vetted_at = ?"))
+	}
+
+	if update.Disqualified._set {
+		__values = append(__values, update.Disqualified.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
+	}
+
+	if update.DisqualificationReason._set {
+		__values = append(__values, update.DisqualificationReason.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualification_reason = ?"))
+	}
+
+	if update.UnknownAuditSuspended._set {
+		__values = append(__values, update.UnknownAuditSuspended.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_suspended = ?"))
+	}
+
+	if update.OfflineSuspended._set {
+		__values = append(__values, update.OfflineSuspended.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("offline_suspended = ?"))
+	}
+
+	if update.UnderReview._set {
+		__values = append(__values, update.UnderReview.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
+	}
+
+	if update.OnlineScore._set {
+		__values = append(__values, update.OnlineScore.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("online_score = ?"))
+	}
+
+	if update.AuditHistory._set {
+		__values = append(__values, update.AuditHistory.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_history = ?"))
+	}
+
+	if update.AuditReputationAlpha._set {
+		__values = append(__values, update.AuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	}
+
+	if update.AuditReputationBeta._set {
+		__values = append(__values, update.AuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	}
+
+	if update.UnknownAuditReputationAlpha._set {
+		__values = append(__values, update.UnknownAuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_alpha = ?"))
+	}
+
+	if update.UnknownAuditReputationBeta._set {
+		__values = append(__values, update.UnknownAuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_beta = ?"))
 	}
 
 	__now := obj.db.Hooks.Now().UTC()
@@ -24323,7 +27829,7 @@ func (obj *pgxImpl) UpdateNoReturn_NodeApiVersion_By_Id_And_ApiVersion_Less(ctx
 	__values = append(__values, __now)
 	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
 
-	__args = append(__args, node_api_version_id.value(), node_api_version_api_version_less.value())
+	__args = append(__args, reputation_id.value(), reputation_audit_history.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -24331,17 +27837,21 @@ func (obj *pgxImpl) UpdateNoReturn_NodeApiVersion_By_Id_And_ApiVersion_Less(ctx
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	reputation = &Reputation{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
-		return obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
-	return nil
+	return reputation, nil
 }
 
-func (obj *pgxImpl) Update_Reputation_By_Id(ctx context.Context,
+func (obj *pgxImpl) UpdateNoReturn_Reputation_By_Id(ctx context.Context,
 	reputation_id Reputation_Id_Field,
 	update Reputation_Update_Fields) (
-	reputation *Reputation, err error) {
+	err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
 		panic("using DB when inside of a transaction")
@@ -24349,7 +27859,7 @@ func (obj *pgxImpl) Update_Reputation_By_Id(ctx context.Context,
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE reputations SET "), __sets, __sqlbundle_Literal(" WHERE reputations.id = ? RETURNING reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE reputations SET "), __sets, __sqlbundle_Literal(" WHERE reputations.id = ?")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
@@ -24438,22 +27948,17 @@ func (obj *pgxImpl) Update_Reputation_By_Id(ctx context.Context,
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	reputation = &Reputation{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return obj.makeErr(err)
 	}
-	return reputation, nil
+	return nil
 }
 
-func (obj *pgxImpl) Update_Reputation_By_Id_And_AuditHistory(ctx context.Context,
-	reputation_id Reputation_Id_Field,
-	reputation_audit_history Reputation_AuditHistory_Field,
-	update Reputation_Update_Fields) (
-	reputation *Reputation, err error) {
+func (obj *pgxImpl) UpdateNoReturn_OauthClient_By_Id(ctx context.Context,
+	oauth_client_id OauthClient_Id_Field,
+	update OauthClient_Update_Fields) (
+	err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
 		panic("using DB when inside of a transaction")
@@ -24461,88 +27966,37 @@ func (obj *pgxImpl) Update_Reputation_By_Id_And_AuditHistory(ctx context.Context
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE reputations SET "), __sets, __sqlbundle_Literal(" WHERE reputations.id = ? AND reputations.audit_history = ? RETURNING reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE oauth_clients SET "), __sets, __sqlbundle_Literal(" WHERE oauth_clients.id = ?")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
 	var __args []any
 
-	if update.AuditSuccessCount._set {
-		__values = append(__values, update.AuditSuccessCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
-	}
-
-	if update.TotalAuditCount._set {
-		__values = append(__values, update.TotalAuditCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_audit_count = ?"))
-	}
-
-	if update.VettedAt._set {
-		__values = append(__values, update.VettedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("vetted_at = ?"))
-	}
-
-	if update.Disqualified._set {
-		__values = append(__values, update.Disqualified.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
-	}
-
-	if update.DisqualificationReason._set {
-		__values = append(__values, update.DisqualificationReason.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualification_reason = ?"))
-	}
-
-	if update.UnknownAuditSuspended._set {
-		__values = append(__values, update.UnknownAuditSuspended.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_suspended = ?"))
-	}
-
-	if update.OfflineSuspended._set {
-		__values = append(__values, update.OfflineSuspended.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("offline_suspended = ?"))
-	}
-
-	if update.UnderReview._set {
-		__values = append(__values, update.UnderReview.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
-	}
-
-	if update.OnlineScore._set {
-		__values = append(__values, update.OnlineScore.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("online_score = ?"))
-	}
-
-	if update.AuditHistory._set {
-		__values = append(__values, update.AuditHistory.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_history = ?"))
+	if update.EncryptedSecret._set {
+		__values = append(__values, update.EncryptedSecret.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("encrypted_secret = ?"))
 	}
 
-	if update.AuditReputationAlpha._set {
-		__values = append(__values, update.AuditReputationAlpha.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	if update.RedirectUrl._set {
+		__values = append(__values, update.RedirectUrl.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("redirect_url = ?"))
 	}
 
-	if update.AuditReputationBeta._set {
-		__values = append(__values, update.AuditReputationBeta.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	if update.AppName._set {
+		__values = append(__values, update.AppName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("app_name = ?"))
 	}
 
-	if update.UnknownAuditReputationAlpha._set {
-		__values = append(__values, update.UnknownAuditReputationAlpha.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_alpha = ?"))
+	if update.AppLogoUrl._set {
+		__values = append(__values, update.AppLogoUrl.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("app_logo_url = ?"))
 	}
 
-	if update.UnknownAuditReputationBeta._set {
-		__values = append(__values, update.UnknownAuditReputationBeta.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_beta = ?"))
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
 	}
 
-	__now := obj.db.Hooks.Now().UTC()
-
-	__values = append(__values, __now)
-	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
-
-	__args = append(__args, reputation_id.value(), reputation_audit_history.value())
+	__args = append(__args, oauth_client_id.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -24550,20 +28004,16 @@ func (obj *pgxImpl) Update_Reputation_By_Id_And_AuditHistory(ctx context.Context
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	reputation = &Reputation{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return obj.makeErr(err)
 	}
-	return reputation, nil
+	return nil
 }
 
-func (obj *pgxImpl) UpdateNoReturn_Reputation_By_Id(ctx context.Context,
-	reputation_id Reputation_Id_Field,
-	update Reputation_Update_Fields) (
+func (obj *pgxImpl) UpdateNoReturn_OauthCode_By_Code_And_ClaimedAt_Is_Null(ctx context.Context,
+	oauth_code_code OauthCode_Code_Field,
+	update OauthCode_Update_Fields) (
 	err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
@@ -24572,88 +28022,22 @@ func (obj *pgxImpl) UpdateNoReturn_Reputation_By_Id(ctx context.Context,
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE reputations SET "), __sets, __sqlbundle_Literal(" WHERE reputations.id = ?")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE oauth_codes SET "), __sets, __sqlbundle_Literal(" WHERE oauth_codes.code = ? AND oauth_codes.claimed_at is NULL")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
 	var __args []any
 
-	if update.AuditSuccessCount._set {
-		__values = append(__values, update.AuditSuccessCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
-	}
-
-	if update.TotalAuditCount._set {
-		__values = append(__values, update.TotalAuditCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_audit_count = ?"))
-	}
-
-	if update.VettedAt._set {
-		__values = append(__values, update.VettedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("vetted_at = ?"))
-	}
-
-	if update.Disqualified._set {
-		__values = append(__values, update.Disqualified.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
-	}
-
-	if update.DisqualificationReason._set {
-		__values = append(__values, update.DisqualificationReason.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualification_reason = ?"))
-	}
-
-	if update.UnknownAuditSuspended._set {
-		__values = append(__values, update.UnknownAuditSuspended.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_suspended = ?"))
-	}
-
-	if update.OfflineSuspended._set {
-		__values = append(__values, update.OfflineSuspended.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("offline_suspended = ?"))
-	}
-
-	if update.UnderReview._set {
-		__values = append(__values, update.UnderReview.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
-	}
-
-	if update.OnlineScore._set {
-		__values = append(__values, update.OnlineScore.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("online_score = ?"))
-	}
-
-	if update.AuditHistory._set {
-		__values = append(__values, update.AuditHistory.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_history = ?"))
-	}
-
-	if update.AuditReputationAlpha._set {
-		__values = append(__values, update.AuditReputationAlpha.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
-	}
-
-	if update.AuditReputationBeta._set {
-		__values = append(__values, update.AuditReputationBeta.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
-	}
-
-	if update.UnknownAuditReputationAlpha._set {
-		__values = append(__values, update.UnknownAuditReputationAlpha.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_alpha = ?"))
+	if update.ClaimedAt._set {
+		__values = append(__values, update.ClaimedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("claimed_at = ?"))
 	}
 
-	if update.UnknownAuditReputationBeta._set {
-		__values = append(__values, update.UnknownAuditReputationBeta.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_beta = ?"))
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
 	}
 
-	__now := obj.db.Hooks.Now().UTC()
-
-	__values = append(__values, __now)
-	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
-
-	__args = append(__args, reputation_id.value())
+	__args = append(__args, oauth_code_code.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -24668,9 +28052,10 @@ func (obj *pgxImpl) UpdateNoReturn_Reputation_By_Id(ctx context.Context,
 	return nil
 }
 
-func (obj *pgxImpl) UpdateNoReturn_OauthClient_By_Id(ctx context.Context,
-	oauth_client_id OauthClient_Id_Field,
-	update OauthClient_Update_Fields) (
+func (obj *pgxImpl) UpdateNoReturn_OauthToken_By_Token_And_Kind(ctx context.Context,
+	oauth_token_token OauthToken_Token_Field,
+	oauth_token_kind OauthToken_Kind_Field,
+	update OauthToken_Update_Fields) (
 	err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
@@ -24679,37 +28064,22 @@ func (obj *pgxImpl) UpdateNoReturn_OauthClient_By_Id(ctx context.Context,
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE oauth_clients SET "), __sets, __sqlbundle_Literal(" WHERE oauth_clients.id = ?")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE oauth_tokens SET "), __sets, __sqlbundle_Literal(" WHERE oauth_tokens.token = ? AND oauth_tokens.kind = ?")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
 	var __args []any
 
-	if update.EncryptedSecret._set {
-		__values = append(__values, update.EncryptedSecret.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("encrypted_secret = ?"))
-	}
-
-	if update.RedirectUrl._set {
-		__values = append(__values, update.RedirectUrl.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("redirect_url = ?"))
-	}
-
-	if update.AppName._set {
-		__values = append(__values, update.AppName.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("app_name = ?"))
-	}
-
-	if update.AppLogoUrl._set {
-		__values = append(__values, update.AppLogoUrl.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("app_logo_url = ?"))
+	if update.ExpiresAt._set {
+		__values = append(__values, update.ExpiresAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("expires_at = ?"))
 	}
 
 	if len(__sets_sql.SQLs) == 0 {
 		return emptyUpdate()
 	}
 
-	__args = append(__args, oauth_client_id.value())
+	__args = append(__args, oauth_token_token.value(), oauth_token_kind.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -24724,10 +28094,10 @@ func (obj *pgxImpl) UpdateNoReturn_OauthClient_By_Id(ctx context.Context,
 	return nil
 }
 
-func (obj *pgxImpl) UpdateNoReturn_OauthCode_By_Code_And_ClaimedAt_Is_Null(ctx context.Context,
-	oauth_code_code OauthCode_Code_Field,
-	update OauthCode_Update_Fields) (
-	err error) {
+func (obj *pgxImpl) Update_Organization_By_Id(ctx context.Context,
+	organization_id Organization_Id_Field,
+	update Organization_Update_Fields) (
+	organization *Organization, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
 		panic("using DB when inside of a transaction")
@@ -24735,22 +28105,42 @@ func (obj *pgxImpl) UpdateNoReturn_OauthCode_By_Code_And_ClaimedAt_Is_Null(ctx c
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE oauth_codes SET "), __sets, __sqlbundle_Literal(" WHERE oauth_codes.code = ? AND oauth_codes.claimed_at is NULL")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE organizations SET "), __sets, __sqlbundle_Literal(" WHERE organizations.id = ? RETURNING organizations.id, organizations.name, organizations.owner_id, organizations.project_limit, organizations.storage_limit, organizations.bandwidth_limit, organizations.segment_limit, organizations.created_at")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
 	var __args []any
 
-	if update.ClaimedAt._set {
-		__values = append(__values, update.ClaimedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("claimed_at = ?"))
+	if update.Name._set {
+		__values = append(__values, update.Name.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
+	}
+
+	if update.ProjectLimit._set {
+		__values = append(__values, update.ProjectLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("project_limit = ?"))
+	}
+
+	if update.StorageLimit._set {
+		__values = append(__values, update.StorageLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("storage_limit = ?"))
+	}
+
+	if update.BandwidthLimit._set {
+		__values = append(__values, update.BandwidthLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bandwidth_limit = ?"))
+	}
+
+	if update.SegmentLimit._set {
+		__values = append(__values, update.SegmentLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segment_limit = ?"))
 	}
 
 	if len(__sets_sql.SQLs) == 0 {
-		return emptyUpdate()
+		return nil, emptyUpdate()
 	}
 
-	__args = append(__args, oauth_code_code.value())
+	__args = append(__args, organization_id.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -24758,18 +28148,22 @@ func (obj *pgxImpl) UpdateNoReturn_OauthCode_By_Code_And_ClaimedAt_Is_Null(ctx c
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	organization = &Organization{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&organization.Id, &organization.Name, &organization.OwnerId, &organization.ProjectLimit, &organization.StorageLimit, &organization.BandwidthLimit, &organization.SegmentLimit, &organization.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
-		return obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
-	return nil
+	return organization, nil
 }
 
-func (obj *pgxImpl) UpdateNoReturn_OauthToken_By_Token_And_Kind(ctx context.Context,
-	oauth_token_token OauthToken_Token_Field,
-	oauth_token_kind OauthToken_Kind_Field,
-	update OauthToken_Update_Fields) (
-	err error) {
+func (obj *pgxImpl) Update_OrganizationMember_By_OrganizationId_And_MemberId(ctx context.Context,
+	organization_member_organization_id OrganizationMember_OrganizationId_Field,
+	organization_member_member_id OrganizationMember_MemberId_Field,
+	update OrganizationMember_Update_Fields) (
+	organization_member *OrganizationMember, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
 		panic("using DB when inside of a transaction")
@@ -24777,22 +28171,22 @@ func (obj *pgxImpl) UpdateNoReturn_OauthToken_By_Token_And_Kind(ctx context.Cont
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE oauth_tokens SET "), __sets, __sqlbundle_Literal(" WHERE oauth_tokens.token = ? AND oauth_tokens.kind = ?")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE organization_members SET "), __sets, __sqlbundle_Literal(" WHERE organization_members.organization_id = ? AND organization_members.member_id = ? RETURNING organization_members.organization_id, organization_members.member_id, organization_members.role, organization_members.created_at")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
 	var __args []any
 
-	if update.ExpiresAt._set {
-		__values = append(__values, update.ExpiresAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("expires_at = ?"))
+	if update.Role._set {
+		__values = append(__values, update.Role.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("role = ?"))
 	}
 
 	if len(__sets_sql.SQLs) == 0 {
-		return emptyUpdate()
+		return nil, emptyUpdate()
 	}
 
-	__args = append(__args, oauth_token_token.value(), oauth_token_kind.value())
+	__args = append(__args, organization_member_organization_id.value(), organization_member_member_id.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -24800,11 +28194,15 @@ func (obj *pgxImpl) UpdateNoReturn_OauthToken_By_Token_And_Kind(ctx context.Cont
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	organization_member = &OrganizationMember{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&organization_member.OrganizationId, &organization_member.MemberId, &organization_member.Role, &organization_member.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
-		return obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
-	return nil
+	return organization_member, nil
 }
 
 func (obj *pgxImpl) Update_Project_By_Id(ctx context.Context,
@@ -25135,6 +28533,56 @@ func (obj *pgxImpl) UpdateNoReturn_ApiKey_By_Id(ctx context.Context,
 	return nil
 }
 
+func (obj *pgxImpl) Update_ProjectRole_By_Id(ctx context.Context,
+	project_role_id ProjectRole_Id_Field,
+	update ProjectRole_Update_Fields) (
+	project_role *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_roles SET "), __sets, __sqlbundle_Literal(" WHERE project_roles.id = ? RETURNING project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.created_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.Name._set {
+		__values = append(__values, update.Name.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
+	}
+
+	if update.Permissions._set {
+		__values = append(__values, update.Permissions.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("permissions = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_role_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_role = &ProjectRole{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_role, nil
+}
+
 func (obj *pgxImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 	bucket_metainfo_name BucketMetainfo_Name_Field,
@@ -25628,6 +29076,62 @@ func (obj *pgxImpl) Update_BucketMigration_By_Id(ctx context.Context,
 	return bucket_migration, nil
 }
 
+func (obj *pgxImpl) Update_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field,
+	update BucketLifecycleConfig_Update_Fields) (
+	bucket_lifecycle_config *BucketLifecycleConfig, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_lifecycle_configs SET "), __sets, __sqlbundle_Literal(" WHERE bucket_lifecycle_configs.project_id = ? AND bucket_lifecycle_configs.bucket_name = ? RETURNING bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name, bucket_lifecycle_configs.rules, bucket_lifecycle_configs.deletion_cursor, bucket_lifecycle_configs.created_at, bucket_lifecycle_configs.updated_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.Rules._set {
+		__values = append(__values, update.Rules.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("rules = ?"))
+	}
+
+	if update.DeletionCursor._set {
+		__values = append(__values, update.DeletionCursor.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("deletion_cursor = ?"))
+	}
+
+	if update.UpdatedAt._set {
+		__values = append(__values, update.UpdatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, bucket_lifecycle_config_project_id.value(), bucket_lifecycle_config_bucket_name.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_lifecycle_config = &BucketLifecycleConfig{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_lifecycle_config.ProjectId, &bucket_lifecycle_config.BucketName, &bucket_lifecycle_config.Rules, &bucket_lifecycle_config.DeletionCursor, &bucket_lifecycle_config.CreatedAt, &bucket_lifecycle_config.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_lifecycle_config, nil
+}
+
 func (obj *pgxImpl) Update_User_By_Id(ctx context.Context,
 	user_id User_Id_Field,
 	update User_Update_Fields) (
@@ -26084,6 +29588,177 @@ func (obj *pgxImpl) Update_UserSettings_By_UserId(ctx context.Context,
 	return user_settings, nil
 }
 
+func (obj *pgxImpl) Update_ScimGroup_By_Id(ctx context.Context,
+	scim_group_id ScimGroup_Id_Field,
+	update ScimGroup_Update_Fields) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE scim_groups SET "), __sets, __sqlbundle_Literal(" WHERE scim_groups.id = ? RETURNING scim_groups.id, scim_groups.provider, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.DisplayName._set {
+		__values = append(__values, update.DisplayName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("display_name = ?"))
+	}
+
+	if update.ExternalId._set {
+		__values = append(__values, update.ExternalId.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("external_id = ?"))
+	}
+
+	if update.ProjectId._set {
+		__values = append(__values, update.ProjectId.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("project_id = ?"))
+	}
+
+	if update.Role._set {
+		__values = append(__values, update.Role.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("role = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, scim_group_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group = &ScimGroup{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.Provider, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return scim_group, nil
+}
+
+func (obj *pgxImpl) Update_WebauthnCredential_By_Id(ctx context.Context,
+	webauthn_credential_id WebauthnCredential_Id_Field,
+	update WebauthnCredential_Update_Fields) (
+	webauthn_credential *WebauthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE webauthn_credentials SET "), __sets, __sqlbundle_Literal(" WHERE webauthn_credentials.id = ? RETURNING webauthn_credentials.id, webauthn_credentials.user_id, webauthn_credentials.credential_id, webauthn_credentials.public_key, webauthn_credentials.sign_count, webauthn_credentials.transports, webauthn_credentials.name, webauthn_credentials.created_at, webauthn_credentials.last_used_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.SignCount._set {
+		__values = append(__values, update.SignCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("sign_count = ?"))
+	}
+
+	if update.Name._set {
+		__values = append(__values, update.Name.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
+	}
+
+	if update.LastUsedAt._set {
+		__values = append(__values, update.LastUsedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_used_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, webauthn_credential_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	webauthn_credential = &WebauthnCredential{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&webauthn_credential.Id, &webauthn_credential.UserId, &webauthn_credential.CredentialId, &webauthn_credential.PublicKey, &webauthn_credential.SignCount, &webauthn_credential.Transports, &webauthn_credential.Name, &webauthn_credential.CreatedAt, &webauthn_credential.LastUsedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return webauthn_credential, nil
+}
+
+func (obj *pgxImpl) Update_WebauthnCredential_By_Id_And_UserId(ctx context.Context,
+	webauthn_credential_id WebauthnCredential_Id_Field,
+	webauthn_credential_user_id WebauthnCredential_UserId_Field,
+	update WebauthnCredential_Update_Fields) (
+	webauthn_credential *WebauthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE webauthn_credentials SET "), __sets, __sqlbundle_Literal(" WHERE webauthn_credentials.id = ? AND webauthn_credentials.user_id = ? RETURNING webauthn_credentials.id, webauthn_credentials.user_id, webauthn_credentials.credential_id, webauthn_credentials.public_key, webauthn_credentials.sign_count, webauthn_credentials.transports, webauthn_credentials.name, webauthn_credentials.created_at, webauthn_credentials.last_used_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.SignCount._set {
+		__values = append(__values, update.SignCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("sign_count = ?"))
+	}
+
+	if update.Name._set {
+		__values = append(__values, update.Name.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
+	}
+
+	if update.LastUsedAt._set {
+		__values = append(__values, update.LastUsedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_used_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, webauthn_credential_id.value(), webauthn_credential_user_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	webauthn_credential = &WebauthnCredential{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&webauthn_credential.Id, &webauthn_credential.UserId, &webauthn_credential.CredentialId, &webauthn_credential.PublicKey, &webauthn_credential.SignCount, &webauthn_credential.Transports, &webauthn_credential.Name, &webauthn_credential.CreatedAt, &webauthn_credential.LastUsedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return webauthn_credential, nil
+}
+
 func (obj *pgxImpl) Delete_StoragenodeStorageTally_By_IntervalEndTime_Less(ctx context.Context,
 	storagenode_storage_tally_interval_end_time_less StoragenodeStorageTally_IntervalEndTime_Field) (
 	count int64, err error) {
@@ -26206,6 +29881,36 @@ func (obj *pgxImpl) Delete_StorjscanPayment_By_Status(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) Delete_AdminApprovalRequest_By_Id(ctx context.Context,
+	admin_approval_request_id AdminApprovalRequest_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM admin_approval_requests WHERE admin_approval_requests.id = ?")
+
+	var __values []any
+	__values = append(__values, admin_approval_request_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *pgxImpl) Delete_Domain_By_ProjectId_And_Subdomain(ctx context.Context,
 	domain_project_id Domain_ProjectId_Field,
 	domain_subdomain Domain_Subdomain_Field) (
@@ -26357,6 +30062,67 @@ func (obj *pgxImpl) Delete_OauthClient_By_Id(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) Delete_Organization_By_Id(ctx context.Context,
+	organization_id Organization_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM organizations WHERE organizations.id = ?")
+
+	var __values []any
+	__values = append(__values, organization_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxImpl) Delete_OrganizationMember_By_OrganizationId_And_MemberId(ctx context.Context,
+	organization_member_organization_id OrganizationMember_OrganizationId_Field,
+	organization_member_member_id OrganizationMember_MemberId_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM organization_members WHERE organization_members.organization_id = ? AND organization_members.member_id = ?")
+
+	var __values []any
+	__values = append(__values, organization_member_organization_id.value(), organization_member_member_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *pgxImpl) Delete_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field) (
 	deleted bool, err error) {
@@ -26546,6 +30312,67 @@ func (obj *pgxImpl) Delete_ApiKey_By_ProjectId_And_CreatedBy(ctx context.Context
 
 }
 
+func (obj *pgxImpl) Delete_ProjectRole_By_Id(ctx context.Context,
+	project_role_id ProjectRole_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_roles WHERE project_roles.id = ?")
+
+	var __values []any
+	__values = append(__values, project_role_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxImpl) Delete_ProjectMemberRole_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_role_member_id ProjectMemberRole_MemberId_Field,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_member_roles WHERE project_member_roles.member_id = ? AND project_member_roles.project_id = ?")
+
+	var __values []any
+	__values = append(__values, project_member_role_member_id.value(), project_member_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *pgxImpl) Delete_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 	bucket_metainfo_name BucketMetainfo_Name_Field) (
@@ -26638,6 +30465,68 @@ func (obj *pgxImpl) Delete_BucketMigration_By_Id(ctx context.Context,
 
 }
 
+func (obj *pgxImpl) Delete_BucketLimit_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_limit_project_id BucketLimit_ProjectId_Field,
+	bucket_limit_bucket_name BucketLimit_BucketName_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_limits WHERE bucket_limits.project_id = ? AND bucket_limits.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_limit_project_id.value(), bucket_limit_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxImpl) Delete_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_lifecycle_configs WHERE bucket_lifecycle_configs.project_id = ? AND bucket_lifecycle_configs.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_lifecycle_config_project_id.value(), bucket_lifecycle_config_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *pgxImpl) Delete_RepairQueue_By_UpdatedAt_Less(ctx context.Context,
 	repair_queue_updated_at_less RepairQueue_UpdatedAt_Field) (
 	count int64, err error) {
@@ -26910,6 +30799,158 @@ func (obj *pgxImpl) Delete_AccountFreezeEvent_By_UserId_And_Event(ctx context.Co
 
 }
 
+func (obj *pgxImpl) Delete_ScimGroup_By_Id(ctx context.Context,
+	scim_group_id ScimGroup_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM scim_groups WHERE scim_groups.id = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxImpl) Delete_ScimGroupMember_By_GroupId_And_UserId(ctx context.Context,
+	scim_group_member_group_id ScimGroupMember_GroupId_Field,
+	scim_group_member_user_id ScimGroupMember_UserId_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM scim_group_members WHERE scim_group_members.group_id = ? AND scim_group_members.user_id = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_member_group_id.value(), scim_group_member_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxImpl) Delete_WebauthnCredential_By_Id_And_UserId(ctx context.Context,
+	webauthn_credential_id WebauthnCredential_Id_Field,
+	webauthn_credential_user_id WebauthnCredential_UserId_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM webauthn_credentials WHERE webauthn_credentials.id = ? AND webauthn_credentials.user_id = ?")
+
+	var __values []any
+	__values = append(__values, webauthn_credential_id.value(), webauthn_credential_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxImpl) Delete_WebauthnCredential_By_UserId(ctx context.Context,
+	webauthn_credential_user_id WebauthnCredential_UserId_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM webauthn_credentials WHERE webauthn_credentials.user_id = ?")
+
+	var __values []any
+	__values = append(__values, webauthn_credential_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *pgxImpl) Delete_WebauthnUsedChallenge_By_ExpiresAt_Less(ctx context.Context,
+	webauthn_used_challenge_expires_at_less WebauthnUsedChallenge_ExpiresAt_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM webauthn_used_challenges WHERE webauthn_used_challenges.expires_at < ?")
+
+	var __values []any
+	__values = append(__values, webauthn_used_challenge_expires_at_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
 func (impl pgxImpl) isConstraintError(err error) (constraint string, ok bool) {
 	if e, ok := err.(*pgconn.PgError); ok {
 		if e.Code[:2] == "23" {
@@ -26926,11 +30967,31 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 	}
 	var __res sql.Result
 	var __count int64
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_member_roles;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
 	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM api_key_tails;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM webauthn_credentials;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -26941,6 +31002,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM scim_group_members;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -26951,6 +31022,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_roles;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -26971,6 +31052,26 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM organization_projects;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM organization_members;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -27011,6 +31112,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM webauthn_used_challenges;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -27171,6 +31282,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM scim_groups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -27281,6 +31402,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM organizations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -27391,6 +31522,26 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_limits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_lifecycle_configs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -27431,6 +31582,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM admin_approval_requests;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -28003,6 +32164,53 @@ func (obj *pgxcockroachImpl) Create_ChangeHistory(ctx context.Context,
 
 }
 
+func (obj *pgxcockroachImpl) Create_AdminApprovalRequest(ctx context.Context,
+	admin_approval_request_id AdminApprovalRequest_Id_Field,
+	admin_approval_request_operation AdminApprovalRequest_Operation_Field,
+	admin_approval_request_item_type AdminApprovalRequest_ItemType_Field,
+	admin_approval_request_user_id AdminApprovalRequest_UserId_Field,
+	admin_approval_request_requested_by AdminApprovalRequest_RequestedBy_Field,
+	admin_approval_request_reason AdminApprovalRequest_Reason_Field,
+	admin_approval_request_details AdminApprovalRequest_Details_Field,
+	admin_approval_request_payload AdminApprovalRequest_Payload_Field,
+	admin_approval_request_expires_at AdminApprovalRequest_ExpiresAt_Field,
+	optional AdminApprovalRequest_Create_Fields) (
+	admin_approval_request *AdminApprovalRequest, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := admin_approval_request_id.value()
+	__operation_val := admin_approval_request_operation.value()
+	__item_type_val := admin_approval_request_item_type.value()
+	__user_id_val := admin_approval_request_user_id.value()
+	__project_id_val := optional.ProjectId.value()
+	__requested_by_val := admin_approval_request_requested_by.value()
+	__reason_val := admin_approval_request_reason.value()
+	__details_val := admin_approval_request_details.value()
+	__payload_val := admin_approval_request_payload.value()
+	__created_at_val := __now
+	__expires_at_val := admin_approval_request_expires_at.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO admin_approval_requests ( id, operation, item_type, user_id, project_id, requested_by, reason, details, payload, created_at, expires_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING admin_approval_requests.id, admin_approval_requests.operation, admin_approval_requests.item_type, admin_approval_requests.user_id, admin_approval_requests.project_id, admin_approval_requests.requested_by, admin_approval_requests.reason, admin_approval_requests.details, admin_approval_requests.payload, admin_approval_requests.created_at, admin_approval_requests.expires_at")
+
+	var __values []any
+	__values = append(__values, __id_val, __operation_val, __item_type_val, __user_id_val, __project_id_val, __requested_by_val, __reason_val, __details_val, __payload_val, __created_at_val, __expires_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	admin_approval_request = &AdminApprovalRequest{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&admin_approval_request.Id, &admin_approval_request.Operation, &admin_approval_request.ItemType, &admin_approval_request.UserId, &admin_approval_request.ProjectId, &admin_approval_request.RequestedBy, &admin_approval_request.Reason, &admin_approval_request.Details, &admin_approval_request.Payload, &admin_approval_request.CreatedAt, &admin_approval_request.ExpiresAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return admin_approval_request, nil
+
+}
+
 func (obj *pgxcockroachImpl) Create_Domain(ctx context.Context,
 	domain_subdomain Domain_Subdomain_Field,
 	domain_project_id Domain_ProjectId_Field,
@@ -28583,6 +32791,108 @@ func (obj *pgxcockroachImpl) CreateNoReturn_OauthToken(ctx context.Context,
 
 }
 
+func (obj *pgxcockroachImpl) Create_Organization(ctx context.Context,
+	organization_id Organization_Id_Field,
+	organization_name Organization_Name_Field,
+	organization_owner_id Organization_OwnerId_Field,
+	optional Organization_Create_Fields) (
+	organization *Organization, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := organization_id.value()
+	__name_val := organization_name.value()
+	__owner_id_val := organization_owner_id.value()
+	__project_limit_val := optional.ProjectLimit.value()
+	__storage_limit_val := optional.StorageLimit.value()
+	__bandwidth_limit_val := optional.BandwidthLimit.value()
+	__segment_limit_val := optional.SegmentLimit.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO organizations ( id, name, owner_id, project_limit, storage_limit, bandwidth_limit, segment_limit, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING organizations.id, organizations.name, organizations.owner_id, organizations.project_limit, organizations.storage_limit, organizations.bandwidth_limit, organizations.segment_limit, organizations.created_at")
+
+	var __values []any
+	__values = append(__values, __id_val, __name_val, __owner_id_val, __project_limit_val, __storage_limit_val, __bandwidth_limit_val, __segment_limit_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	organization = &Organization{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&organization.Id, &organization.Name, &organization.OwnerId, &organization.ProjectLimit, &organization.StorageLimit, &organization.BandwidthLimit, &organization.SegmentLimit, &organization.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return organization, nil
+
+}
+
+func (obj *pgxcockroachImpl) Create_OrganizationMember(ctx context.Context,
+	organization_member_organization_id OrganizationMember_OrganizationId_Field,
+	organization_member_member_id OrganizationMember_MemberId_Field,
+	organization_member_role OrganizationMember_Role_Field) (
+	organization_member *OrganizationMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__organization_id_val := organization_member_organization_id.value()
+	__member_id_val := organization_member_member_id.value()
+	__role_val := organization_member_role.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO organization_members ( organization_id, member_id, role, created_at ) VALUES ( ?, ?, ?, ? ) RETURNING organization_members.organization_id, organization_members.member_id, organization_members.role, organization_members.created_at")
+
+	var __values []any
+	__values = append(__values, __organization_id_val, __member_id_val, __role_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	organization_member = &OrganizationMember{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&organization_member.OrganizationId, &organization_member.MemberId, &organization_member.Role, &organization_member.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return organization_member, nil
+
+}
+
+func (obj *pgxcockroachImpl) Create_OrganizationProject(ctx context.Context,
+	organization_project_project_id OrganizationProject_ProjectId_Field,
+	organization_project_organization_id OrganizationProject_OrganizationId_Field) (
+	organization_project *OrganizationProject, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := organization_project_project_id.value()
+	__organization_id_val := organization_project_organization_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO organization_projects ( project_id, organization_id, created_at ) VALUES ( ?, ?, ? ) RETURNING organization_projects.project_id, organization_projects.organization_id, organization_projects.created_at")
+
+	var __values []any
+	__values = append(__values, __project_id_val, __organization_id_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	organization_project = &OrganizationProject{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&organization_project.ProjectId, &organization_project.OrganizationId, &organization_project.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return organization_project, nil
+
+}
+
 func (obj *pgxcockroachImpl) Create_Project(ctx context.Context,
 	project_id Project_Id_Field,
 	project_name Project_Name_Field,
@@ -28930,6 +33240,71 @@ func (obj *pgxcockroachImpl) Replace_ApiKeyTail(ctx context.Context,
 
 }
 
+func (obj *pgxcockroachImpl) Create_ProjectRole(ctx context.Context,
+	project_role_id ProjectRole_Id_Field,
+	project_role_project_id ProjectRole_ProjectId_Field,
+	project_role_name ProjectRole_Name_Field,
+	project_role_permissions ProjectRole_Permissions_Field) (
+	project_role *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := project_role_id.value()
+	__project_id_val := project_role_project_id.value()
+	__name_val := project_role_name.value()
+	__permissions_val := project_role_permissions.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_roles ( id, project_id, name, permissions, created_at ) VALUES ( ?, ?, ?, ?, ? ) RETURNING project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.created_at")
+
+	var __values []any
+	__values = append(__values, __id_val, __project_id_val, __name_val, __permissions_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_role = &ProjectRole{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_role, nil
+
+}
+
+func (obj *pgxcockroachImpl) Replace_ProjectMemberRole(ctx context.Context,
+	project_member_role_member_id ProjectMemberRole_MemberId_Field,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field,
+	project_member_role_role_id ProjectMemberRole_RoleId_Field) (
+	project_member_role *ProjectMemberRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+	__member_id_val := project_member_role_member_id.value()
+	__project_id_val := project_member_role_project_id.value()
+	__role_id_val := project_member_role_role_id.value()
+
+	var __embed_stmt = __sqlbundle_Literal("UPSERT INTO project_member_roles ( member_id, project_id, role_id ) VALUES ( ?, ?, ? ) RETURNING project_member_roles.member_id, project_member_roles.project_id, project_member_roles.role_id")
+
+	var __values []any
+	__values = append(__values, __member_id_val, __project_id_val, __role_id_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_member_role = &ProjectMemberRole{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_member_role.MemberId, &project_member_role.ProjectId, &project_member_role.RoleId)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_member_role, nil
+
+}
+
 func (obj *pgxcockroachImpl) Create_BucketMetainfo(ctx context.Context,
 	bucket_metainfo_id BucketMetainfo_Id_Field,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
@@ -29118,6 +33493,77 @@ func (obj *pgxcockroachImpl) Create_BucketMigration(ctx context.Context,
 
 }
 
+func (obj *pgxcockroachImpl) Replace_BucketLimit(ctx context.Context,
+	bucket_limit_project_id BucketLimit_ProjectId_Field,
+	bucket_limit_bucket_name BucketLimit_BucketName_Field,
+	optional BucketLimit_Create_Fields) (
+	bucket_limit *BucketLimit, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_limit_project_id.value()
+	__bucket_name_val := bucket_limit_bucket_name.value()
+	__storage_limit_val := optional.StorageLimit.value()
+	__bandwidth_limit_val := optional.BandwidthLimit.value()
+	__segment_limit_val := optional.SegmentLimit.value()
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("UPSERT INTO bucket_limits ( project_id, bucket_name, storage_limit, bandwidth_limit, segment_limit, updated_at ) VALUES ( ?, ?, ?, ?, ?, ? ) RETURNING bucket_limits.project_id, bucket_limits.bucket_name, bucket_limits.storage_limit, bucket_limits.bandwidth_limit, bucket_limits.segment_limit, bucket_limits.updated_at")
+
+	var __values []any
+	__values = append(__values, __project_id_val, __bucket_name_val, __storage_limit_val, __bandwidth_limit_val, __segment_limit_val, __updated_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_limit = &BucketLimit{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_limit.ProjectId, &bucket_limit.BucketName, &bucket_limit.StorageLimit, &bucket_limit.BandwidthLimit, &bucket_limit.SegmentLimit, &bucket_limit.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_limit, nil
+
+}
+
+func (obj *pgxcockroachImpl) Create_BucketLifecycleConfig(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field,
+	bucket_lifecycle_config_rules BucketLifecycleConfig_Rules_Field,
+	optional BucketLifecycleConfig_Create_Fields) (
+	bucket_lifecycle_config *BucketLifecycleConfig, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_lifecycle_config_project_id.value()
+	__bucket_name_val := bucket_lifecycle_config_bucket_name.value()
+	__rules_val := bucket_lifecycle_config_rules.value()
+	__deletion_cursor_val := optional.DeletionCursor.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_lifecycle_configs ( project_id, bucket_name, rules, deletion_cursor, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ? ) RETURNING bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name, bucket_lifecycle_configs.rules, bucket_lifecycle_configs.deletion_cursor, bucket_lifecycle_configs.created_at, bucket_lifecycle_configs.updated_at")
+
+	var __values []any
+	__values = append(__values, __project_id_val, __bucket_name_val, __rules_val, __deletion_cursor_val, __created_at_val, __updated_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_lifecycle_config = &BucketLifecycleConfig{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_lifecycle_config.ProjectId, &bucket_lifecycle_config.BucketName, &bucket_lifecycle_config.Rules, &bucket_lifecycle_config.DeletionCursor, &bucket_lifecycle_config.CreatedAt, &bucket_lifecycle_config.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_lifecycle_config, nil
+
+}
+
 func (obj *pgxcockroachImpl) CreateNoReturn_RestApiKey(ctx context.Context,
 	rest_api_key_id RestApiKey_Id_Field,
 	rest_api_key_user_id RestApiKey_UserId_Field,
@@ -29527,6 +33973,143 @@ func (obj *pgxcockroachImpl) CreateNoReturn_UserSettings(ctx context.Context,
 
 }
 
+func (obj *pgxcockroachImpl) Create_ScimGroup(ctx context.Context,
+	scim_group_id ScimGroup_Id_Field,
+	scim_group_provider ScimGroup_Provider_Field,
+	scim_group_display_name ScimGroup_DisplayName_Field,
+	scim_group_role ScimGroup_Role_Field,
+	optional ScimGroup_Create_Fields) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := scim_group_id.value()
+	__provider_val := scim_group_provider.value()
+	__display_name_val := scim_group_display_name.value()
+	__external_id_val := optional.ExternalId.value()
+	__project_id_val := optional.ProjectId.value()
+	__role_val := scim_group_role.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO scim_groups ( id, provider, display_name, external_id, project_id, role, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? ) RETURNING scim_groups.id, scim_groups.provider, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at")
+
+	var __values []any
+	__values = append(__values, __id_val, __provider_val, __display_name_val, __external_id_val, __project_id_val, __role_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group = &ScimGroup{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.Provider, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return scim_group, nil
+
+}
+
+func (obj *pgxcockroachImpl) Replace_ScimGroupMember(ctx context.Context,
+	scim_group_member_group_id ScimGroupMember_GroupId_Field,
+	scim_group_member_user_id ScimGroupMember_UserId_Field) (
+	scim_group_member *ScimGroupMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+	__group_id_val := scim_group_member_group_id.value()
+	__user_id_val := scim_group_member_user_id.value()
+
+	var __embed_stmt = __sqlbundle_Literal("UPSERT INTO scim_group_members ( group_id, user_id ) VALUES ( ?, ? ) RETURNING scim_group_members.group_id, scim_group_members.user_id")
+
+	var __values []any
+	__values = append(__values, __group_id_val, __user_id_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group_member = &ScimGroupMember{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&scim_group_member.GroupId, &scim_group_member.UserId)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return scim_group_member, nil
+
+}
+
+func (obj *pgxcockroachImpl) Create_WebauthnCredential(ctx context.Context,
+	webauthn_credential_id WebauthnCredential_Id_Field,
+	webauthn_credential_user_id WebauthnCredential_UserId_Field,
+	webauthn_credential_credential_id WebauthnCredential_CredentialId_Field,
+	webauthn_credential_public_key WebauthnCredential_PublicKey_Field,
+	webauthn_credential_sign_count WebauthnCredential_SignCount_Field,
+	webauthn_credential_transports WebauthnCredential_Transports_Field,
+	webauthn_credential_name WebauthnCredential_Name_Field,
+	optional WebauthnCredential_Create_Fields) (
+	webauthn_credential *WebauthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := webauthn_credential_id.value()
+	__user_id_val := webauthn_credential_user_id.value()
+	__credential_id_val := webauthn_credential_credential_id.value()
+	__public_key_val := webauthn_credential_public_key.value()
+	__sign_count_val := webauthn_credential_sign_count.value()
+	__transports_val := webauthn_credential_transports.value()
+	__name_val := webauthn_credential_name.value()
+	__created_at_val := __now
+	__last_used_at_val := optional.LastUsedAt.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO webauthn_credentials ( id, user_id, credential_id, public_key, sign_count, transports, name, created_at, last_used_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING webauthn_credentials.id, webauthn_credentials.user_id, webauthn_credentials.credential_id, webauthn_credentials.public_key, webauthn_credentials.sign_count, webauthn_credentials.transports, webauthn_credentials.name, webauthn_credentials.created_at, webauthn_credentials.last_used_at")
+
+	var __values []any
+	__values = append(__values, __id_val, __user_id_val, __credential_id_val, __public_key_val, __sign_count_val, __transports_val, __name_val, __created_at_val, __last_used_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	webauthn_credential = &WebauthnCredential{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&webauthn_credential.Id, &webauthn_credential.UserId, &webauthn_credential.CredentialId, &webauthn_credential.PublicKey, &webauthn_credential.SignCount, &webauthn_credential.Transports, &webauthn_credential.Name, &webauthn_credential.CreatedAt, &webauthn_credential.LastUsedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return webauthn_credential, nil
+
+}
+
+func (obj *pgxcockroachImpl) Create_WebauthnUsedChallenge(ctx context.Context,
+	webauthn_used_challenge_challenge WebauthnUsedChallenge_Challenge_Field,
+	webauthn_used_challenge_expires_at WebauthnUsedChallenge_ExpiresAt_Field) (
+	webauthn_used_challenge *WebauthnUsedChallenge, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+	__challenge_val := webauthn_used_challenge_challenge.value()
+	__expires_at_val := webauthn_used_challenge_expires_at.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO webauthn_used_challenges ( challenge, expires_at ) VALUES ( ?, ? ) RETURNING webauthn_used_challenges.challenge, webauthn_used_challenges.expires_at")
+
+	var __values []any
+	__values = append(__values, __challenge_val, __expires_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	webauthn_used_challenge = &WebauthnUsedChallenge{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&webauthn_used_challenge.Challenge, &webauthn_used_challenge.ExpiresAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return webauthn_used_challenge, nil
+
+}
+
 func (obj *pgxcockroachImpl) Find_AccountingTimestamps_Value_By_Name(ctx context.Context,
 	accounting_timestamps_name AccountingTimestamps_Name_Field) (
 	row *Value_Row, err error) {
@@ -31079,25 +35662,75 @@ func (obj *pgxcockroachImpl) All_ChangeHistory_By_ProjectId_And_ItemType_Not_Ord
 
 }
 
-func (obj *pgxcockroachImpl) All_ChangeHistory_By_ProjectId_And_ItemType_OrderBy_Desc_Timestamp(ctx context.Context,
-	change_history_project_id ChangeHistory_ProjectId_Field,
-	change_history_item_type ChangeHistory_ItemType_Field) (
+func (obj *pgxcockroachImpl) All_ChangeHistory_By_ProjectId_And_ItemType_OrderBy_Desc_Timestamp(ctx context.Context,
+	change_history_project_id ChangeHistory_ProjectId_Field,
+	change_history_item_type ChangeHistory_ItemType_Field) (
+	rows []*ChangeHistory, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.project_id", Equal: true, Right: "?", Null: true}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" AND change_histories.item_type = ? ORDER BY change_histories.timestamp DESC")}}
+
+	var __values []any
+	if !change_history_project_id.isnull() {
+		__cond_0.Null = false
+		__values = append(__values, change_history_project_id.value())
+	}
+	__values = append(__values, change_history_item_type.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ChangeHistory, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				change_history := &ChangeHistory{}
+				err = __rows.Scan(&change_history.Id, &change_history.AdminEmail, &change_history.UserId, &change_history.ProjectId, &change_history.BucketName, &change_history.ItemType, &change_history.Operation, &change_history.Reason, &change_history.Changes, &change_history.Timestamp)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, change_history)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxcockroachImpl) All_ChangeHistory_By_BucketName_OrderBy_Desc_Timestamp(ctx context.Context,
+	change_history_bucket_name ChangeHistory_BucketName_Field) (
 	rows []*ChangeHistory, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
 		panic("using DB when inside of a transaction")
 	}
 
-	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.project_id", Equal: true, Right: "?", Null: true}
+	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.bucket_name", Equal: true, Right: "?", Null: true}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" AND change_histories.item_type = ? ORDER BY change_histories.timestamp DESC")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" ORDER BY change_histories.timestamp DESC")}}
 
 	var __values []any
-	if !change_history_project_id.isnull() {
+	if !change_history_bucket_name.isnull() {
 		__cond_0.Null = false
-		__values = append(__values, change_history_project_id.value())
+		__values = append(__values, change_history_bucket_name.value())
 	}
-	__values = append(__values, change_history_item_type.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -31131,29 +35764,47 @@ func (obj *pgxcockroachImpl) All_ChangeHistory_By_ProjectId_And_ItemType_OrderBy
 
 }
 
-func (obj *pgxcockroachImpl) All_ChangeHistory_By_BucketName_OrderBy_Desc_Timestamp(ctx context.Context,
-	change_history_bucket_name ChangeHistory_BucketName_Field) (
-	rows []*ChangeHistory, err error) {
+func (obj *pgxcockroachImpl) Get_AdminApprovalRequest_By_Id(ctx context.Context,
+	admin_approval_request_id AdminApprovalRequest_Id_Field) (
+	admin_approval_request *AdminApprovalRequest, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
 		panic("using DB when inside of a transaction")
 	}
 
-	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.bucket_name", Equal: true, Right: "?", Null: true}
-
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" ORDER BY change_histories.timestamp DESC")}}
+	var __embed_stmt = __sqlbundle_Literal("SELECT admin_approval_requests.id, admin_approval_requests.operation, admin_approval_requests.item_type, admin_approval_requests.user_id, admin_approval_requests.project_id, admin_approval_requests.requested_by, admin_approval_requests.reason, admin_approval_requests.details, admin_approval_requests.payload, admin_approval_requests.created_at, admin_approval_requests.expires_at FROM admin_approval_requests WHERE admin_approval_requests.id = ?")
 
 	var __values []any
-	if !change_history_bucket_name.isnull() {
-		__cond_0.Null = false
-		__values = append(__values, change_history_bucket_name.value())
+	__values = append(__values, admin_approval_request_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	admin_approval_request = &AdminApprovalRequest{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&admin_approval_request.Id, &admin_approval_request.Operation, &admin_approval_request.ItemType, &admin_approval_request.UserId, &admin_approval_request.ProjectId, &admin_approval_request.RequestedBy, &admin_approval_request.Reason, &admin_approval_request.Details, &admin_approval_request.Payload, &admin_approval_request.CreatedAt, &admin_approval_request.ExpiresAt)
+	if err != nil {
+		return (*AdminApprovalRequest)(nil), obj.makeErr(err)
+	}
+	return admin_approval_request, nil
+
+}
+
+func (obj *pgxcockroachImpl) All_AdminApprovalRequest_OrderBy_Asc_CreatedAt(ctx context.Context) (
+	rows []*AdminApprovalRequest, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
 	}
 
+	var __embed_stmt = __sqlbundle_Literal("SELECT admin_approval_requests.id, admin_approval_requests.operation, admin_approval_requests.item_type, admin_approval_requests.user_id, admin_approval_requests.project_id, admin_approval_requests.requested_by, admin_approval_requests.reason, admin_approval_requests.details, admin_approval_requests.payload, admin_approval_requests.created_at, admin_approval_requests.expires_at FROM admin_approval_requests ORDER BY admin_approval_requests.created_at")
+
+	var __values []any
+
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	for {
-		rows, err = func() (rows []*ChangeHistory, err error) {
+		rows, err = func() (rows []*AdminApprovalRequest, err error) {
 			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 			if err != nil {
 				return nil, err
@@ -31161,12 +35812,12 @@ func (obj *pgxcockroachImpl) All_ChangeHistory_By_BucketName_OrderBy_Desc_Timest
 			defer closeRows(__rows, &err)
 
 			for __rows.Next() {
-				change_history := &ChangeHistory{}
-				err = __rows.Scan(&change_history.Id, &change_history.AdminEmail, &change_history.UserId, &change_history.ProjectId, &change_history.BucketName, &change_history.ItemType, &change_history.Operation, &change_history.Reason, &change_history.Changes, &change_history.Timestamp)
+				admin_approval_request := &AdminApprovalRequest{}
+				err = __rows.Scan(&admin_approval_request.Id, &admin_approval_request.Operation, &admin_approval_request.ItemType, &admin_approval_request.UserId, &admin_approval_request.ProjectId, &admin_approval_request.RequestedBy, &admin_approval_request.Reason, &admin_approval_request.Details, &admin_approval_request.Payload, &admin_approval_request.CreatedAt, &admin_approval_request.ExpiresAt)
 				if err != nil {
 					return nil, err
 				}
-				rows = append(rows, change_history)
+				rows = append(rows, admin_approval_request)
 			}
 			return rows, nil
 		}()
@@ -31335,7 +35986,7 @@ func (obj *pgxcockroachImpl) Get_Node_By_Id(ctx context.Context,
 		panic("using DB when inside of a transaction")
 	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.asn_org FROM nodes WHERE nodes.id = ?")
 
 	var __values []any
 	__values = append(__values, node_id.value())
@@ -31344,7 +35995,7 @@ func (obj *pgxcockroachImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.Asn, &node.AsnOrg)
 	if err != nil {
 		return (*Node)(nil), obj.makeErr(err)
 	}
@@ -31403,9 +36054,9 @@ func (obj *pgxcockroachImpl) Paged_Node(ctx context.Context,
 		panic("using DB when inside of a transaction")
 	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.asn_org, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.asn_org, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
 
 	var __values []any
 
@@ -31432,7 +36083,7 @@ func (obj *pgxcockroachImpl) Paged_Node(ctx context.Context,
 
 			for __rows.Next() {
 				node := &Node{}
-				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &__continuation._value_id)
+				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.Asn, &node.AsnOrg, &__continuation._value_id)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -31462,9 +36113,9 @@ func (obj *pgxcockroachImpl) Paged_Node_By_Email(ctx context.Context,
 		panic("using DB when inside of a transaction")
 	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes WHERE nodes.email = ? AND (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.asn_org, nodes.id FROM nodes WHERE nodes.email = ? AND (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes WHERE nodes.email = ? ORDER BY nodes.id LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.asn_org, nodes.id FROM nodes WHERE nodes.email = ? ORDER BY nodes.id LIMIT ?")
 
 	var __values []any
 	__values = append(__values, node_email.value())
@@ -31492,7 +36143,7 @@ func (obj *pgxcockroachImpl) Paged_Node_By_Email(ctx context.Context,
 
 			for __rows.Next() {
 				node := &Node{}
-				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &__continuation._value_id)
+				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.Asn, &node.AsnOrg, &__continuation._value_id)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -32054,6 +36705,127 @@ func (obj *pgxcockroachImpl) Get_OauthToken_By_Kind_And_Token(ctx context.Contex
 
 }
 
+func (obj *pgxcockroachImpl) Get_Organization_By_Id(ctx context.Context,
+	organization_id Organization_Id_Field) (
+	organization *Organization, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT organizations.id, organizations.name, organizations.owner_id, organizations.project_limit, organizations.storage_limit, organizations.bandwidth_limit, organizations.segment_limit, organizations.created_at FROM organizations WHERE organizations.id = ?")
+
+	var __values []any
+	__values = append(__values, organization_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	organization = &Organization{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&organization.Id, &organization.Name, &organization.OwnerId, &organization.ProjectLimit, &organization.StorageLimit, &organization.BandwidthLimit, &organization.SegmentLimit, &organization.CreatedAt)
+	if err != nil {
+		return (*Organization)(nil), obj.makeErr(err)
+	}
+	return organization, nil
+
+}
+
+func (obj *pgxcockroachImpl) Get_OrganizationMember_By_OrganizationId_And_MemberId(ctx context.Context,
+	organization_member_organization_id OrganizationMember_OrganizationId_Field,
+	organization_member_member_id OrganizationMember_MemberId_Field) (
+	organization_member *OrganizationMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT organization_members.organization_id, organization_members.member_id, organization_members.role, organization_members.created_at FROM organization_members WHERE organization_members.organization_id = ? AND organization_members.member_id = ?")
+
+	var __values []any
+	__values = append(__values, organization_member_organization_id.value(), organization_member_member_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	organization_member = &OrganizationMember{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&organization_member.OrganizationId, &organization_member.MemberId, &organization_member.Role, &organization_member.CreatedAt)
+	if err != nil {
+		return (*OrganizationMember)(nil), obj.makeErr(err)
+	}
+	return organization_member, nil
+
+}
+
+func (obj *pgxcockroachImpl) Get_OrganizationProject_By_ProjectId(ctx context.Context,
+	organization_project_project_id OrganizationProject_ProjectId_Field) (
+	organization_project *OrganizationProject, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT organization_projects.project_id, organization_projects.organization_id, organization_projects.created_at FROM organization_projects WHERE organization_projects.project_id = ?")
+
+	var __values []any
+	__values = append(__values, organization_project_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	organization_project = &OrganizationProject{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&organization_project.ProjectId, &organization_project.OrganizationId, &organization_project.CreatedAt)
+	if err != nil {
+		return (*OrganizationProject)(nil), obj.makeErr(err)
+	}
+	return organization_project, nil
+
+}
+
+func (obj *pgxcockroachImpl) All_OrganizationProject_By_OrganizationId_OrderBy_Asc_CreatedAt_Asc_ProjectId(ctx context.Context,
+	organization_project_organization_id OrganizationProject_OrganizationId_Field) (
+	rows []*OrganizationProject, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT organization_projects.project_id, organization_projects.organization_id, organization_projects.created_at FROM organization_projects WHERE organization_projects.organization_id = ? ORDER BY organization_projects.created_at, organization_projects.project_id")
+
+	var __values []any
+	__values = append(__values, organization_project_organization_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*OrganizationProject, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				organization_project := &OrganizationProject{}
+				err = __rows.Scan(&organization_project.ProjectId, &organization_project.OrganizationId, &organization_project.CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, organization_project)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
 func (obj *pgxcockroachImpl) Get_Project_PassphraseEnc_Project_PassphraseEncKeyId_By_Id(ctx context.Context,
 	project_id Project_Id_Field) (
 	row *PassphraseEnc_PassphraseEncKeyId_Row, err error) {
@@ -33255,6 +38027,147 @@ func (obj *pgxcockroachImpl) Get_ApiKeyTail_By_Tail(ctx context.Context,
 
 }
 
+func (obj *pgxcockroachImpl) Get_ProjectRole_By_Id(ctx context.Context,
+	project_role_id ProjectRole_Id_Field) (
+	project_role *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.created_at FROM project_roles WHERE project_roles.id = ?")
+
+	var __values []any
+	__values = append(__values, project_role_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_role = &ProjectRole{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.CreatedAt)
+	if err != nil {
+		return (*ProjectRole)(nil), obj.makeErr(err)
+	}
+	return project_role, nil
+
+}
+
+func (obj *pgxcockroachImpl) All_ProjectRole_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
+	project_role_project_id ProjectRole_ProjectId_Field) (
+	rows []*ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.created_at FROM project_roles WHERE project_roles.project_id = ? ORDER BY project_roles.name")
+
+	var __values []any
+	__values = append(__values, project_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ProjectRole, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				project_role := &ProjectRole{}
+				err = __rows.Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, project_role)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxcockroachImpl) Get_ProjectMemberRole_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_role_member_id ProjectMemberRole_MemberId_Field,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+	project_member_role *ProjectMemberRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_member_roles.member_id, project_member_roles.project_id, project_member_roles.role_id FROM project_member_roles WHERE project_member_roles.member_id = ? AND project_member_roles.project_id = ?")
+
+	var __values []any
+	__values = append(__values, project_member_role_member_id.value(), project_member_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_member_role = &ProjectMemberRole{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_member_role.MemberId, &project_member_role.ProjectId, &project_member_role.RoleId)
+	if err != nil {
+		return (*ProjectMemberRole)(nil), obj.makeErr(err)
+	}
+	return project_member_role, nil
+
+}
+
+func (obj *pgxcockroachImpl) All_ProjectMemberRole_By_ProjectId_OrderBy_Asc_MemberId(ctx context.Context,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+	rows []*ProjectMemberRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_member_roles.member_id, project_member_roles.project_id, project_member_roles.role_id FROM project_member_roles WHERE project_member_roles.project_id = ? ORDER BY project_member_roles.member_id")
+
+	var __values []any
+	__values = append(__values, project_member_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ProjectMemberRole, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				project_member_role := &ProjectMemberRole{}
+				err = __rows.Scan(&project_member_role.MemberId, &project_member_role.ProjectId, &project_member_role.RoleId)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, project_member_role)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
 func (obj *pgxcockroachImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 	bucket_metainfo_name BucketMetainfo_Name_Field) (
@@ -33865,6 +38778,58 @@ func (obj *pgxcockroachImpl) Limited_BucketMigration_By_State_OrderBy_Asc_Create
 
 }
 
+func (obj *pgxcockroachImpl) Get_BucketLimit_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_limit_project_id BucketLimit_ProjectId_Field,
+	bucket_limit_bucket_name BucketLimit_BucketName_Field) (
+	bucket_limit *BucketLimit, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_limits.project_id, bucket_limits.bucket_name, bucket_limits.storage_limit, bucket_limits.bandwidth_limit, bucket_limits.segment_limit, bucket_limits.updated_at FROM bucket_limits WHERE bucket_limits.project_id = ? AND bucket_limits.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_limit_project_id.value(), bucket_limit_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_limit = &BucketLimit{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_limit.ProjectId, &bucket_limit.BucketName, &bucket_limit.StorageLimit, &bucket_limit.BandwidthLimit, &bucket_limit.SegmentLimit, &bucket_limit.UpdatedAt)
+	if err != nil {
+		return (*BucketLimit)(nil), obj.makeErr(err)
+	}
+	return bucket_limit, nil
+
+}
+
+func (obj *pgxcockroachImpl) Get_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field) (
+	bucket_lifecycle_config *BucketLifecycleConfig, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name, bucket_lifecycle_configs.rules, bucket_lifecycle_configs.deletion_cursor, bucket_lifecycle_configs.created_at, bucket_lifecycle_configs.updated_at FROM bucket_lifecycle_configs WHERE bucket_lifecycle_configs.project_id = ? AND bucket_lifecycle_configs.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_lifecycle_config_project_id.value(), bucket_lifecycle_config_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_lifecycle_config = &BucketLifecycleConfig{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_lifecycle_config.ProjectId, &bucket_lifecycle_config.BucketName, &bucket_lifecycle_config.Rules, &bucket_lifecycle_config.DeletionCursor, &bucket_lifecycle_config.CreatedAt, &bucket_lifecycle_config.UpdatedAt)
+	if err != nil {
+		return (*BucketLifecycleConfig)(nil), obj.makeErr(err)
+	}
+	return bucket_lifecycle_config, nil
+
+}
+
 func (obj *pgxcockroachImpl) Get_RestApiKey_By_Id(ctx context.Context,
 	rest_api_key_id RestApiKey_Id_Field) (
 	rest_api_key *RestApiKey, err error) {
@@ -34835,6 +39800,293 @@ func (obj *pgxcockroachImpl) Get_UserSettings_By_UserId(ctx context.Context,
 
 }
 
+func (obj *pgxcockroachImpl) Get_ScimGroup_By_Id(ctx context.Context,
+	scim_group_id ScimGroup_Id_Field) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_groups.id, scim_groups.provider, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at FROM scim_groups WHERE scim_groups.id = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group = &ScimGroup{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.Provider, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt)
+	if err != nil {
+		return (*ScimGroup)(nil), obj.makeErr(err)
+	}
+	return scim_group, nil
+
+}
+
+func (obj *pgxcockroachImpl) Get_ScimGroup_By_Provider_And_DisplayName(ctx context.Context,
+	scim_group_provider ScimGroup_Provider_Field,
+	scim_group_display_name ScimGroup_DisplayName_Field) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_groups.id, scim_groups.provider, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at FROM scim_groups WHERE scim_groups.provider = ? AND scim_groups.display_name = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_provider.value(), scim_group_display_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group = &ScimGroup{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.Provider, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt)
+	if err != nil {
+		return (*ScimGroup)(nil), obj.makeErr(err)
+	}
+	return scim_group, nil
+
+}
+
+func (obj *pgxcockroachImpl) First_ScimGroup_By_Provider_And_ExternalId(ctx context.Context,
+	scim_group_provider ScimGroup_Provider_Field,
+	scim_group_external_id ScimGroup_ExternalId_Field) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_groups.id, scim_groups.provider, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at FROM scim_groups WHERE scim_groups.provider = ? AND scim_groups.external_id = ? LIMIT 1 OFFSET 0")
+
+	var __values []any
+	__values = append(__values, scim_group_provider.value(), scim_group_external_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		scim_group, err = func() (scim_group *ScimGroup, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			if !__rows.Next() {
+				return nil, nil
+			}
+
+			scim_group = &ScimGroup{}
+			err = __rows.Scan(&scim_group.Id, &scim_group.Provider, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt)
+			if err != nil {
+				return nil, err
+			}
+
+			return scim_group, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return scim_group, nil
+	}
+
+}
+
+func (obj *pgxcockroachImpl) Count_ScimGroup_By_Provider(ctx context.Context,
+	scim_group_provider ScimGroup_Provider_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT COUNT(*) FROM scim_groups WHERE scim_groups.provider = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_provider.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&count)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *pgxcockroachImpl) Limited_ScimGroup_By_Provider_OrderBy_Asc_DisplayName(ctx context.Context,
+	scim_group_provider ScimGroup_Provider_Field,
+	limit int, offset int64) (
+	rows []*ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_groups.id, scim_groups.provider, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at FROM scim_groups WHERE scim_groups.provider = ? ORDER BY scim_groups.display_name LIMIT ? OFFSET ?")
+
+	var __values []any
+	__values = append(__values, scim_group_provider.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ScimGroup, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				scim_group := &ScimGroup{}
+				err = __rows.Scan(&scim_group.Id, &scim_group.Provider, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, scim_group)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxcockroachImpl) All_ScimGroupMember_By_GroupId_OrderBy_Asc_UserId(ctx context.Context,
+	scim_group_member_group_id ScimGroupMember_GroupId_Field) (
+	rows []*ScimGroupMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_group_members.group_id, scim_group_members.user_id FROM scim_group_members WHERE scim_group_members.group_id = ? ORDER BY scim_group_members.user_id")
+
+	var __values []any
+	__values = append(__values, scim_group_member_group_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ScimGroupMember, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				scim_group_member := &ScimGroupMember{}
+				err = __rows.Scan(&scim_group_member.GroupId, &scim_group_member.UserId)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, scim_group_member)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *pgxcockroachImpl) Get_WebauthnCredential_By_CredentialId(ctx context.Context,
+	webauthn_credential_credential_id WebauthnCredential_CredentialId_Field) (
+	webauthn_credential *WebauthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT webauthn_credentials.id, webauthn_credentials.user_id, webauthn_credentials.credential_id, webauthn_credentials.public_key, webauthn_credentials.sign_count, webauthn_credentials.transports, webauthn_credentials.name, webauthn_credentials.created_at, webauthn_credentials.last_used_at FROM webauthn_credentials WHERE webauthn_credentials.credential_id = ?")
+
+	var __values []any
+	__values = append(__values, webauthn_credential_credential_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	webauthn_credential = &WebauthnCredential{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&webauthn_credential.Id, &webauthn_credential.UserId, &webauthn_credential.CredentialId, &webauthn_credential.PublicKey, &webauthn_credential.SignCount, &webauthn_credential.Transports, &webauthn_credential.Name, &webauthn_credential.CreatedAt, &webauthn_credential.LastUsedAt)
+	if err != nil {
+		return (*WebauthnCredential)(nil), obj.makeErr(err)
+	}
+	return webauthn_credential, nil
+
+}
+
+func (obj *pgxcockroachImpl) All_WebauthnCredential_By_UserId_OrderBy_Asc_CreatedAt_Asc_Id(ctx context.Context,
+	webauthn_credential_user_id WebauthnCredential_UserId_Field) (
+	rows []*WebauthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT webauthn_credentials.id, webauthn_credentials.user_id, webauthn_credentials.credential_id, webauthn_credentials.public_key, webauthn_credentials.sign_count, webauthn_credentials.transports, webauthn_credentials.name, webauthn_credentials.created_at, webauthn_credentials.last_used_at FROM webauthn_credentials WHERE webauthn_credentials.user_id = ? ORDER BY webauthn_credentials.created_at, webauthn_credentials.id")
+
+	var __values []any
+	__values = append(__values, webauthn_credential_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*WebauthnCredential, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				webauthn_credential := &WebauthnCredential{}
+				err = __rows.Scan(&webauthn_credential.Id, &webauthn_credential.UserId, &webauthn_credential.CredentialId, &webauthn_credential.PublicKey, &webauthn_credential.SignCount, &webauthn_credential.Transports, &webauthn_credential.Name, &webauthn_credential.CreatedAt, &webauthn_credential.LastUsedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, webauthn_credential)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
 func (obj *pgxcockroachImpl) UpdateNoReturn_AccountingTimestamps_By_Name(ctx context.Context,
 	accounting_timestamps_name AccountingTimestamps_Name_Field,
 	update AccountingTimestamps_Update_Fields) (
@@ -35178,7 +40430,238 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.asn_org")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.Address._set {
+		__values = append(__values, update.Address.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("address = ?"))
+	}
+
+	if update.LastNet._set {
+		__values = append(__values, update.LastNet.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_net = ?"))
+	}
+
+	if update.LastIpPort._set {
+		__values = append(__values, update.LastIpPort.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_ip_port = ?"))
+	}
+
+	if update.CountryCode._set {
+		__values = append(__values, update.CountryCode.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
+	}
+
+	if update.Protocol._set {
+		__values = append(__values, update.Protocol.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
+	}
+
+	if update.Email._set {
+		__values = append(__values, update.Email.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("email = ?"))
+	}
+
+	if update.Wallet._set {
+		__values = append(__values, update.Wallet.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("wallet = ?"))
+	}
+
+	if update.WalletFeatures._set {
+		__values = append(__values, update.WalletFeatures.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("wallet_features = ?"))
+	}
+
+	if update.FreeDisk._set {
+		__values = append(__values, update.FreeDisk.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_disk = ?"))
+	}
+
+	if update.PieceCount._set {
+		__values = append(__values, update.PieceCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("piece_count = ?"))
+	}
+
+	if update.Major._set {
+		__values = append(__values, update.Major.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("major = ?"))
+	}
+
+	if update.Minor._set {
+		__values = append(__values, update.Minor.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("minor = ?"))
+	}
+
+	if update.Patch._set {
+		__values = append(__values, update.Patch.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("patch = ?"))
+	}
+
+	if update.CommitHash._set {
+		__values = append(__values, update.CommitHash.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("commit_hash = ?"))
+	}
+
+	if update.ReleaseTimestamp._set {
+		__values = append(__values, update.ReleaseTimestamp.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("release_timestamp = ?"))
+	}
+
+	if update.Release._set {
+		__values = append(__values, update.Release.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("release = ?"))
+	}
+
+	if update.Latency90._set {
+		__values = append(__values, update.Latency90.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("latency_90 = ?"))
+	}
+
+	if update.VettedAt._set {
+		__values = append(__values, update.VettedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("vetted_at = ?"))
+	}
+
+	if update.LastContactSuccess._set {
+		__values = append(__values, update.LastContactSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_success = ?"))
+	}
+
+	if update.LastContactFailure._set {
+		__values = append(__values, update.LastContactFailure.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_failure = ?"))
+	}
+
+	if update.Disqualified._set {
+		__values = append(__values, update.Disqualified.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
+	}
+
+	if update.DisqualificationReason._set {
+		__values = append(__values, update.DisqualificationReason.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualification_reason = ?"))
+	}
+
+	if update.UnknownAuditSuspended._set {
+		__values = append(__values, update.UnknownAuditSuspended.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_suspended = ?"))
+	}
+
+	if update.OfflineSuspended._set {
+		__values = append(__values, update.OfflineSuspended.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("offline_suspended = ?"))
+	}
+
+	if update.UnderReview._set {
+		__values = append(__values, update.UnderReview.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
+	}
+
+	if update.ExitInitiatedAt._set {
+		__values = append(__values, update.ExitInitiatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_initiated_at = ?"))
+	}
+
+	if update.ExitLoopCompletedAt._set {
+		__values = append(__values, update.ExitLoopCompletedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_loop_completed_at = ?"))
+	}
+
+	if update.ExitFinishedAt._set {
+		__values = append(__values, update.ExitFinishedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_finished_at = ?"))
+	}
+
+	if update.ExitSuccess._set {
+		__values = append(__values, update.ExitSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
+	}
+
+	if update.Contained._set {
+		__values = append(__values, update.Contained.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("contained = ?"))
+	}
+
+	if update.LastOfflineEmail._set {
+		__values = append(__values, update.LastOfflineEmail.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_offline_email = ?"))
+	}
+
+	if update.LastSoftwareUpdateEmail._set {
+		__values = append(__values, update.LastSoftwareUpdateEmail.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_software_update_email = ?"))
+	}
+
+	if update.NoiseProto._set {
+		__values = append(__values, update.NoiseProto.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("noise_proto = ?"))
+	}
+
+	if update.NoisePublicKey._set {
+		__values = append(__values, update.NoisePublicKey.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("noise_public_key = ?"))
+	}
+
+	if update.DebounceLimit._set {
+		__values = append(__values, update.DebounceLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("debounce_limit = ?"))
+	}
+
+	if update.Features._set {
+		__values = append(__values, update.Features.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
+	}
+
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
+	if update.AsnOrg._set {
+		__values = append(__values, update.AsnOrg.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn_org = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node = &Node{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.Asn, &node.AsnOrg)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node, nil
+}
+
+func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
+	node_id Node_Id_Field,
+	update Node_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ?")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
@@ -35364,6 +40847,16 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
+	if update.AsnOrg._set {
+		__values = append(__values, update.AsnOrg.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn_org = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -35377,18 +40870,14 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return obj.makeErr(err)
 	}
-	return node, nil
+	return nil
 }
 
-func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
+func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_ExitFinishedAt_Is_Null(ctx context.Context,
 	node_id Node_Id_Field,
 	update Node_Update_Fields) (
 	err error) {
@@ -35399,7 +40888,7 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ?")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? AND nodes.disqualified is NULL AND nodes.exit_finished_at is NULL")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
@@ -35585,6 +41074,16 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
+	if update.AsnOrg._set {
+		__values = append(__values, update.AsnOrg.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn_org = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -35605,9 +41104,10 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 	return nil
 }
 
-func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_ExitFinishedAt_Is_Null(ctx context.Context,
-	node_id Node_Id_Field,
-	update Node_Update_Fields) (
+func (obj *pgxcockroachImpl) UpdateNoReturn_NodeApiVersion_By_Id_And_ApiVersion_Less(ctx context.Context,
+	node_api_version_id NodeApiVersion_Id_Field,
+	node_api_version_api_version_less NodeApiVersion_ApiVersion_Field,
+	update NodeApiVersion_Update_Fields) (
 	err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
@@ -35616,95 +41116,62 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? AND nodes.disqualified is NULL AND nodes.exit_finished_at is NULL")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE node_api_versions SET "), __sets, __sqlbundle_Literal(" WHERE node_api_versions.id = ? AND node_api_versions.api_version < ?")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
 	var __args []any
 
-	if update.Address._set {
-		__values = append(__values, update.Address.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("address = ?"))
-	}
-
-	if update.LastNet._set {
-		__values = append(__values, update.LastNet.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_net = ?"))
-	}
-
-	if update.LastIpPort._set {
-		__values = append(__values, update.LastIpPort.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_ip_port = ?"))
-	}
-
-	if update.CountryCode._set {
-		__values = append(__values, update.CountryCode.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
-	}
-
-	if update.Protocol._set {
-		__values = append(__values, update.Protocol.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
+	if update.ApiVersion._set {
+		__values = append(__values, update.ApiVersion.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("api_version = ?"))
 	}
 
-	if update.Email._set {
-		__values = append(__values, update.Email.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("email = ?"))
-	}
+	__now := obj.db.Hooks.Now().UTC()
 
-	if update.Wallet._set {
-		__values = append(__values, update.Wallet.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("wallet = ?"))
-	}
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
 
-	if update.WalletFeatures._set {
-		__values = append(__values, update.WalletFeatures.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("wallet_features = ?"))
-	}
+	__args = append(__args, node_api_version_id.value(), node_api_version_api_version_less.value())
 
-	if update.FreeDisk._set {
-		__values = append(__values, update.FreeDisk.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_disk = ?"))
-	}
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
 
-	if update.PieceCount._set {
-		__values = append(__values, update.PieceCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("piece_count = ?"))
-	}
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
 
-	if update.Major._set {
-		__values = append(__values, update.Major.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("major = ?"))
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
 	}
+	return nil
+}
 
-	if update.Minor._set {
-		__values = append(__values, update.Minor.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("minor = ?"))
+func (obj *pgxcockroachImpl) Update_Reputation_By_Id(ctx context.Context,
+	reputation_id Reputation_Id_Field,
+	update Reputation_Update_Fields) (
+	reputation *Reputation, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
 	}
 
-	if update.Patch._set {
-		__values = append(__values, update.Patch.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("patch = ?"))
-	}
+	var __sets = &__sqlbundle_Hole{}
 
-	if update.CommitHash._set {
-		__values = append(__values, update.CommitHash.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("commit_hash = ?"))
-	}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE reputations SET "), __sets, __sqlbundle_Literal(" WHERE reputations.id = ? RETURNING reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta")}}
 
-	if update.ReleaseTimestamp._set {
-		__values = append(__values, update.ReleaseTimestamp.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("release_timestamp = ?"))
-	}
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
 
-	if update.Release._set {
-		__values = append(__values, update.Release.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("release = ?"))
+	if update.AuditSuccessCount._set {
+		__values = append(__values, update.AuditSuccessCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
 	}
 
-	if update.Latency90._set {
-		__values = append(__values, update.Latency90.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("latency_90 = ?"))
+	if update.TotalAuditCount._set {
+		__values = append(__values, update.TotalAuditCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_audit_count = ?"))
 	}
 
 	if update.VettedAt._set {
@@ -35712,16 +41179,6 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("vetted_at = ?"))
 	}
 
-	if update.LastContactSuccess._set {
-		__values = append(__values, update.LastContactSuccess.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_success = ?"))
-	}
-
-	if update.LastContactFailure._set {
-		__values = append(__values, update.LastContactFailure.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_failure = ?"))
-	}
-
 	if update.Disqualified._set {
 		__values = append(__values, update.Disqualified.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
@@ -35747,59 +41204,34 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
 	}
 
-	if update.ExitInitiatedAt._set {
-		__values = append(__values, update.ExitInitiatedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_initiated_at = ?"))
-	}
-
-	if update.ExitLoopCompletedAt._set {
-		__values = append(__values, update.ExitLoopCompletedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_loop_completed_at = ?"))
-	}
-
-	if update.ExitFinishedAt._set {
-		__values = append(__values, update.ExitFinishedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_finished_at = ?"))
-	}
-
-	if update.ExitSuccess._set {
-		__values = append(__values, update.ExitSuccess.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
-	}
-
-	if update.Contained._set {
-		__values = append(__values, update.Contained.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("contained = ?"))
-	}
-
-	if update.LastOfflineEmail._set {
-		__values = append(__values, update.LastOfflineEmail.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_offline_email = ?"))
+	if update.OnlineScore._set {
+		__values = append(__values, update.OnlineScore.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("online_score = ?"))
 	}
 
-	if update.LastSoftwareUpdateEmail._set {
-		__values = append(__values, update.LastSoftwareUpdateEmail.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_software_update_email = ?"))
+	if update.AuditHistory._set {
+		__values = append(__values, update.AuditHistory.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_history = ?"))
 	}
 
-	if update.NoiseProto._set {
-		__values = append(__values, update.NoiseProto.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("noise_proto = ?"))
+	if update.AuditReputationAlpha._set {
+		__values = append(__values, update.AuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
 	}
 
-	if update.NoisePublicKey._set {
-		__values = append(__values, update.NoisePublicKey.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("noise_public_key = ?"))
+	if update.AuditReputationBeta._set {
+		__values = append(__values, update.AuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
 	}
 
-	if update.DebounceLimit._set {
-		__values = append(__values, update.DebounceLimit.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("debounce_limit = ?"))
+	if update.UnknownAuditReputationAlpha._set {
+		__values = append(__values, update.UnknownAuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_alpha = ?"))
 	}
 
-	if update.Features._set {
-		__values = append(__values, update.Features.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
+	if update.UnknownAuditReputationBeta._set {
+		__values = append(__values, update.UnknownAuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_beta = ?"))
 	}
 
 	__now := obj.db.Hooks.Now().UTC()
@@ -35807,7 +41239,7 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_
 	__values = append(__values, __now)
 	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
 
-	__args = append(__args, node_id.value())
+	__args = append(__args, reputation_id.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -35815,18 +41247,22 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	reputation = &Reputation{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
-		return obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
-	return nil
+	return reputation, nil
 }
 
-func (obj *pgxcockroachImpl) UpdateNoReturn_NodeApiVersion_By_Id_And_ApiVersion_Less(ctx context.Context,
-	node_api_version_id NodeApiVersion_Id_Field,
-	node_api_version_api_version_less NodeApiVersion_ApiVersion_Field,
-	update NodeApiVersion_Update_Fields) (
-	err error) {
+func (obj *pgxcockroachImpl) Update_Reputation_By_Id_And_AuditHistory(ctx context.Context,
+	reputation_id Reputation_Id_Field,
+	reputation_audit_history Reputation_AuditHistory_Field,
+	update Reputation_Update_Fields) (
+	reputation *Reputation, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
 		panic("using DB when inside of a transaction")
@@ -35834,15 +41270,80 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_NodeApiVersion_By_Id_And_ApiVersion_
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE node_api_versions SET "), __sets, __sqlbundle_Literal(" WHERE node_api_versions.id = ? AND node_api_versions.api_version < ?")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE reputations SET "), __sets, __sqlbundle_Literal(" WHERE reputations.id = ? AND reputations.audit_history = ? RETURNING reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
 	var __args []any
 
-	if update.ApiVersion._set {
-		__values = append(__values, update.ApiVersion.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("api_version = ?"))
+	if update.AuditSuccessCount._set {
+		__values = append(__values, update.AuditSuccessCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
+	}
+
+	if update.TotalAuditCount._set {
+		__values = append(__values, update.TotalAuditCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_audit_count = ?"))
+	}
+
+	if update.VettedAt._set {
+		__values = append(__values, update.VettedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("vetted_at = ?"))
+	}
+
+	if update.Disqualified._set {
+		__values = append(__values, update.Disqualified.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
+	}
+
+	if update.DisqualificationReason._set {
+		__values = append(__values, update.DisqualificationReason.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualification_reason = ?"))
+	}
+
+	if update.UnknownAuditSuspended._set {
+		__values = append(__values, update.UnknownAuditSuspended.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_suspended = ?"))
+	}
+
+	if update.OfflineSuspended._set {
+		__values = append(__values, update.OfflineSuspended.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("offline_suspended = ?"))
+	}
+
+	if update.UnderReview._set {
+		__values = append(__values, update.UnderReview.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
+	}
+
+	if update.OnlineScore._set {
+		__values = append(__values, update.OnlineScore.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("online_score = ?"))
+	}
+
+	if update.AuditHistory._set {
+		__values = append(__values, update.AuditHistory.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_history = ?"))
+	}
+
+	if update.AuditReputationAlpha._set {
+		__values = append(__values, update.AuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	}
+
+	if update.AuditReputationBeta._set {
+		__values = append(__values, update.AuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	}
+
+	if update.UnknownAuditReputationAlpha._set {
+		__values = append(__values, update.UnknownAuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_alpha = ?"))
+	}
+
+	if update.UnknownAuditReputationBeta._set {
+		__values = append(__values, update.UnknownAuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_beta = ?"))
 	}
 
 	__now := obj.db.Hooks.Now().UTC()
@@ -35850,7 +41351,7 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_NodeApiVersion_By_Id_And_ApiVersion_
 	__values = append(__values, __now)
 	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
 
-	__args = append(__args, node_api_version_id.value(), node_api_version_api_version_less.value())
+	__args = append(__args, reputation_id.value(), reputation_audit_history.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -35858,17 +41359,21 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_NodeApiVersion_By_Id_And_ApiVersion_
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	reputation = &Reputation{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
-		return obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
-	return nil
+	return reputation, nil
 }
 
-func (obj *pgxcockroachImpl) Update_Reputation_By_Id(ctx context.Context,
+func (obj *pgxcockroachImpl) UpdateNoReturn_Reputation_By_Id(ctx context.Context,
 	reputation_id Reputation_Id_Field,
 	update Reputation_Update_Fields) (
-	reputation *Reputation, err error) {
+	err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
 		panic("using DB when inside of a transaction")
@@ -35876,7 +41381,7 @@ func (obj *pgxcockroachImpl) Update_Reputation_By_Id(ctx context.Context,
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE reputations SET "), __sets, __sqlbundle_Literal(" WHERE reputations.id = ? RETURNING reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE reputations SET "), __sets, __sqlbundle_Literal(" WHERE reputations.id = ?")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
@@ -35965,22 +41470,17 @@ func (obj *pgxcockroachImpl) Update_Reputation_By_Id(ctx context.Context,
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	reputation = &Reputation{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return obj.makeErr(err)
 	}
-	return reputation, nil
+	return nil
 }
 
-func (obj *pgxcockroachImpl) Update_Reputation_By_Id_And_AuditHistory(ctx context.Context,
-	reputation_id Reputation_Id_Field,
-	reputation_audit_history Reputation_AuditHistory_Field,
-	update Reputation_Update_Fields) (
-	reputation *Reputation, err error) {
+func (obj *pgxcockroachImpl) UpdateNoReturn_OauthClient_By_Id(ctx context.Context,
+	oauth_client_id OauthClient_Id_Field,
+	update OauthClient_Update_Fields) (
+	err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
 		panic("using DB when inside of a transaction")
@@ -35988,88 +41488,37 @@ func (obj *pgxcockroachImpl) Update_Reputation_By_Id_And_AuditHistory(ctx contex
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE reputations SET "), __sets, __sqlbundle_Literal(" WHERE reputations.id = ? AND reputations.audit_history = ? RETURNING reputations.id, reputations.audit_success_count, reputations.total_audit_count, reputations.vetted_at, reputations.created_at, reputations.updated_at, reputations.disqualified, reputations.disqualification_reason, reputations.unknown_audit_suspended, reputations.offline_suspended, reputations.under_review, reputations.online_score, reputations.audit_history, reputations.audit_reputation_alpha, reputations.audit_reputation_beta, reputations.unknown_audit_reputation_alpha, reputations.unknown_audit_reputation_beta")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE oauth_clients SET "), __sets, __sqlbundle_Literal(" WHERE oauth_clients.id = ?")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
 	var __args []any
 
-	if update.AuditSuccessCount._set {
-		__values = append(__values, update.AuditSuccessCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
-	}
-
-	if update.TotalAuditCount._set {
-		__values = append(__values, update.TotalAuditCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_audit_count = ?"))
-	}
-
-	if update.VettedAt._set {
-		__values = append(__values, update.VettedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("vetted_at = ?"))
-	}
-
-	if update.Disqualified._set {
-		__values = append(__values, update.Disqualified.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
-	}
-
-	if update.DisqualificationReason._set {
-		__values = append(__values, update.DisqualificationReason.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualification_reason = ?"))
-	}
-
-	if update.UnknownAuditSuspended._set {
-		__values = append(__values, update.UnknownAuditSuspended.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_suspended = ?"))
-	}
-
-	if update.OfflineSuspended._set {
-		__values = append(__values, update.OfflineSuspended.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("offline_suspended = ?"))
-	}
-
-	if update.UnderReview._set {
-		__values = append(__values, update.UnderReview.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
-	}
-
-	if update.OnlineScore._set {
-		__values = append(__values, update.OnlineScore.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("online_score = ?"))
-	}
-
-	if update.AuditHistory._set {
-		__values = append(__values, update.AuditHistory.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_history = ?"))
+	if update.EncryptedSecret._set {
+		__values = append(__values, update.EncryptedSecret.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("encrypted_secret = ?"))
 	}
 
-	if update.AuditReputationAlpha._set {
-		__values = append(__values, update.AuditReputationAlpha.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	if update.RedirectUrl._set {
+		__values = append(__values, update.RedirectUrl.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("redirect_url = ?"))
 	}
 
-	if update.AuditReputationBeta._set {
-		__values = append(__values, update.AuditReputationBeta.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	if update.AppName._set {
+		__values = append(__values, update.AppName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("app_name = ?"))
 	}
 
-	if update.UnknownAuditReputationAlpha._set {
-		__values = append(__values, update.UnknownAuditReputationAlpha.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_alpha = ?"))
+	if update.AppLogoUrl._set {
+		__values = append(__values, update.AppLogoUrl.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("app_logo_url = ?"))
 	}
 
-	if update.UnknownAuditReputationBeta._set {
-		__values = append(__values, update.UnknownAuditReputationBeta.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_beta = ?"))
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
 	}
 
-	__now := obj.db.Hooks.Now().UTC()
-
-	__values = append(__values, __now)
-	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
-
-	__args = append(__args, reputation_id.value(), reputation_audit_history.value())
+	__args = append(__args, oauth_client_id.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -36077,20 +41526,16 @@ func (obj *pgxcockroachImpl) Update_Reputation_By_Id_And_AuditHistory(ctx contex
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	reputation = &Reputation{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&reputation.Id, &reputation.AuditSuccessCount, &reputation.TotalAuditCount, &reputation.VettedAt, &reputation.CreatedAt, &reputation.UpdatedAt, &reputation.Disqualified, &reputation.DisqualificationReason, &reputation.UnknownAuditSuspended, &reputation.OfflineSuspended, &reputation.UnderReview, &reputation.OnlineScore, &reputation.AuditHistory, &reputation.AuditReputationAlpha, &reputation.AuditReputationBeta, &reputation.UnknownAuditReputationAlpha, &reputation.UnknownAuditReputationBeta)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return obj.makeErr(err)
 	}
-	return reputation, nil
+	return nil
 }
 
-func (obj *pgxcockroachImpl) UpdateNoReturn_Reputation_By_Id(ctx context.Context,
-	reputation_id Reputation_Id_Field,
-	update Reputation_Update_Fields) (
+func (obj *pgxcockroachImpl) UpdateNoReturn_OauthCode_By_Code_And_ClaimedAt_Is_Null(ctx context.Context,
+	oauth_code_code OauthCode_Code_Field,
+	update OauthCode_Update_Fields) (
 	err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
@@ -36099,88 +41544,22 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Reputation_By_Id(ctx context.Context
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE reputations SET "), __sets, __sqlbundle_Literal(" WHERE reputations.id = ?")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE oauth_codes SET "), __sets, __sqlbundle_Literal(" WHERE oauth_codes.code = ? AND oauth_codes.claimed_at is NULL")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
 	var __args []any
 
-	if update.AuditSuccessCount._set {
-		__values = append(__values, update.AuditSuccessCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
-	}
-
-	if update.TotalAuditCount._set {
-		__values = append(__values, update.TotalAuditCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("total_audit_count = ?"))
-	}
-
-	if update.VettedAt._set {
-		__values = append(__values, update.VettedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("vetted_at = ?"))
-	}
-
-	if update.Disqualified._set {
-		__values = append(__values, update.Disqualified.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
-	}
-
-	if update.DisqualificationReason._set {
-		__values = append(__values, update.DisqualificationReason.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualification_reason = ?"))
-	}
-
-	if update.UnknownAuditSuspended._set {
-		__values = append(__values, update.UnknownAuditSuspended.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_suspended = ?"))
-	}
-
-	if update.OfflineSuspended._set {
-		__values = append(__values, update.OfflineSuspended.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("offline_suspended = ?"))
-	}
-
-	if update.UnderReview._set {
-		__values = append(__values, update.UnderReview.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
-	}
-
-	if update.OnlineScore._set {
-		__values = append(__values, update.OnlineScore.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("online_score = ?"))
-	}
-
-	if update.AuditHistory._set {
-		__values = append(__values, update.AuditHistory.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_history = ?"))
-	}
-
-	if update.AuditReputationAlpha._set {
-		__values = append(__values, update.AuditReputationAlpha.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
-	}
-
-	if update.AuditReputationBeta._set {
-		__values = append(__values, update.AuditReputationBeta.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
-	}
-
-	if update.UnknownAuditReputationAlpha._set {
-		__values = append(__values, update.UnknownAuditReputationAlpha.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_alpha = ?"))
+	if update.ClaimedAt._set {
+		__values = append(__values, update.ClaimedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("claimed_at = ?"))
 	}
 
-	if update.UnknownAuditReputationBeta._set {
-		__values = append(__values, update.UnknownAuditReputationBeta.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_reputation_beta = ?"))
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
 	}
 
-	__now := obj.db.Hooks.Now().UTC()
-
-	__values = append(__values, __now)
-	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
-
-	__args = append(__args, reputation_id.value())
+	__args = append(__args, oauth_code_code.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -36195,9 +41574,10 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Reputation_By_Id(ctx context.Context
 	return nil
 }
 
-func (obj *pgxcockroachImpl) UpdateNoReturn_OauthClient_By_Id(ctx context.Context,
-	oauth_client_id OauthClient_Id_Field,
-	update OauthClient_Update_Fields) (
+func (obj *pgxcockroachImpl) UpdateNoReturn_OauthToken_By_Token_And_Kind(ctx context.Context,
+	oauth_token_token OauthToken_Token_Field,
+	oauth_token_kind OauthToken_Kind_Field,
+	update OauthToken_Update_Fields) (
 	err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
@@ -36206,37 +41586,22 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_OauthClient_By_Id(ctx context.Contex
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE oauth_clients SET "), __sets, __sqlbundle_Literal(" WHERE oauth_clients.id = ?")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE oauth_tokens SET "), __sets, __sqlbundle_Literal(" WHERE oauth_tokens.token = ? AND oauth_tokens.kind = ?")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
 	var __args []any
 
-	if update.EncryptedSecret._set {
-		__values = append(__values, update.EncryptedSecret.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("encrypted_secret = ?"))
-	}
-
-	if update.RedirectUrl._set {
-		__values = append(__values, update.RedirectUrl.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("redirect_url = ?"))
-	}
-
-	if update.AppName._set {
-		__values = append(__values, update.AppName.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("app_name = ?"))
-	}
-
-	if update.AppLogoUrl._set {
-		__values = append(__values, update.AppLogoUrl.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("app_logo_url = ?"))
+	if update.ExpiresAt._set {
+		__values = append(__values, update.ExpiresAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("expires_at = ?"))
 	}
 
 	if len(__sets_sql.SQLs) == 0 {
 		return emptyUpdate()
 	}
 
-	__args = append(__args, oauth_client_id.value())
+	__args = append(__args, oauth_token_token.value(), oauth_token_kind.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -36251,10 +41616,10 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_OauthClient_By_Id(ctx context.Contex
 	return nil
 }
 
-func (obj *pgxcockroachImpl) UpdateNoReturn_OauthCode_By_Code_And_ClaimedAt_Is_Null(ctx context.Context,
-	oauth_code_code OauthCode_Code_Field,
-	update OauthCode_Update_Fields) (
-	err error) {
+func (obj *pgxcockroachImpl) Update_Organization_By_Id(ctx context.Context,
+	organization_id Organization_Id_Field,
+	update Organization_Update_Fields) (
+	organization *Organization, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
 		panic("using DB when inside of a transaction")
@@ -36262,22 +41627,42 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_OauthCode_By_Code_And_ClaimedAt_Is_N
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE oauth_codes SET "), __sets, __sqlbundle_Literal(" WHERE oauth_codes.code = ? AND oauth_codes.claimed_at is NULL")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE organizations SET "), __sets, __sqlbundle_Literal(" WHERE organizations.id = ? RETURNING organizations.id, organizations.name, organizations.owner_id, organizations.project_limit, organizations.storage_limit, organizations.bandwidth_limit, organizations.segment_limit, organizations.created_at")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
 	var __args []any
 
-	if update.ClaimedAt._set {
-		__values = append(__values, update.ClaimedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("claimed_at = ?"))
+	if update.Name._set {
+		__values = append(__values, update.Name.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
+	}
+
+	if update.ProjectLimit._set {
+		__values = append(__values, update.ProjectLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("project_limit = ?"))
+	}
+
+	if update.StorageLimit._set {
+		__values = append(__values, update.StorageLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("storage_limit = ?"))
+	}
+
+	if update.BandwidthLimit._set {
+		__values = append(__values, update.BandwidthLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bandwidth_limit = ?"))
+	}
+
+	if update.SegmentLimit._set {
+		__values = append(__values, update.SegmentLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segment_limit = ?"))
 	}
 
 	if len(__sets_sql.SQLs) == 0 {
-		return emptyUpdate()
+		return nil, emptyUpdate()
 	}
 
-	__args = append(__args, oauth_code_code.value())
+	__args = append(__args, organization_id.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -36285,18 +41670,22 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_OauthCode_By_Code_And_ClaimedAt_Is_N
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	organization = &Organization{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&organization.Id, &organization.Name, &organization.OwnerId, &organization.ProjectLimit, &organization.StorageLimit, &organization.BandwidthLimit, &organization.SegmentLimit, &organization.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
-		return obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
-	return nil
+	return organization, nil
 }
 
-func (obj *pgxcockroachImpl) UpdateNoReturn_OauthToken_By_Token_And_Kind(ctx context.Context,
-	oauth_token_token OauthToken_Token_Field,
-	oauth_token_kind OauthToken_Kind_Field,
-	update OauthToken_Update_Fields) (
-	err error) {
+func (obj *pgxcockroachImpl) Update_OrganizationMember_By_OrganizationId_And_MemberId(ctx context.Context,
+	organization_member_organization_id OrganizationMember_OrganizationId_Field,
+	organization_member_member_id OrganizationMember_MemberId_Field,
+	update OrganizationMember_Update_Fields) (
+	organization_member *OrganizationMember, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
 		panic("using DB when inside of a transaction")
@@ -36304,22 +41693,22 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_OauthToken_By_Token_And_Kind(ctx con
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE oauth_tokens SET "), __sets, __sqlbundle_Literal(" WHERE oauth_tokens.token = ? AND oauth_tokens.kind = ?")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE organization_members SET "), __sets, __sqlbundle_Literal(" WHERE organization_members.organization_id = ? AND organization_members.member_id = ? RETURNING organization_members.organization_id, organization_members.member_id, organization_members.role, organization_members.created_at")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
 	var __args []any
 
-	if update.ExpiresAt._set {
-		__values = append(__values, update.ExpiresAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("expires_at = ?"))
+	if update.Role._set {
+		__values = append(__values, update.Role.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("role = ?"))
 	}
 
 	if len(__sets_sql.SQLs) == 0 {
-		return emptyUpdate()
+		return nil, emptyUpdate()
 	}
 
-	__args = append(__args, oauth_token_token.value(), oauth_token_kind.value())
+	__args = append(__args, organization_member_organization_id.value(), organization_member_member_id.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -36327,11 +41716,15 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_OauthToken_By_Token_And_Kind(ctx con
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	organization_member = &OrganizationMember{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&organization_member.OrganizationId, &organization_member.MemberId, &organization_member.Role, &organization_member.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
 	if err != nil {
-		return obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
-	return nil
+	return organization_member, nil
 }
 
 func (obj *pgxcockroachImpl) Update_Project_By_Id(ctx context.Context,
@@ -36662,6 +42055,56 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_ApiKey_By_Id(ctx context.Context,
 	return nil
 }
 
+func (obj *pgxcockroachImpl) Update_ProjectRole_By_Id(ctx context.Context,
+	project_role_id ProjectRole_Id_Field,
+	update ProjectRole_Update_Fields) (
+	project_role *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_roles SET "), __sets, __sqlbundle_Literal(" WHERE project_roles.id = ? RETURNING project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.created_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.Name._set {
+		__values = append(__values, update.Name.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
+	}
+
+	if update.Permissions._set {
+		__values = append(__values, update.Permissions.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("permissions = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_role_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_role = &ProjectRole{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_role, nil
+}
+
 func (obj *pgxcockroachImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 	bucket_metainfo_name BucketMetainfo_Name_Field,
@@ -37155,6 +42598,62 @@ func (obj *pgxcockroachImpl) Update_BucketMigration_By_Id(ctx context.Context,
 	return bucket_migration, nil
 }
 
+func (obj *pgxcockroachImpl) Update_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field,
+	update BucketLifecycleConfig_Update_Fields) (
+	bucket_lifecycle_config *BucketLifecycleConfig, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_lifecycle_configs SET "), __sets, __sqlbundle_Literal(" WHERE bucket_lifecycle_configs.project_id = ? AND bucket_lifecycle_configs.bucket_name = ? RETURNING bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name, bucket_lifecycle_configs.rules, bucket_lifecycle_configs.deletion_cursor, bucket_lifecycle_configs.created_at, bucket_lifecycle_configs.updated_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.Rules._set {
+		__values = append(__values, update.Rules.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("rules = ?"))
+	}
+
+	if update.DeletionCursor._set {
+		__values = append(__values, update.DeletionCursor.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("deletion_cursor = ?"))
+	}
+
+	if update.UpdatedAt._set {
+		__values = append(__values, update.UpdatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, bucket_lifecycle_config_project_id.value(), bucket_lifecycle_config_bucket_name.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_lifecycle_config = &BucketLifecycleConfig{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_lifecycle_config.ProjectId, &bucket_lifecycle_config.BucketName, &bucket_lifecycle_config.Rules, &bucket_lifecycle_config.DeletionCursor, &bucket_lifecycle_config.CreatedAt, &bucket_lifecycle_config.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_lifecycle_config, nil
+}
+
 func (obj *pgxcockroachImpl) Update_User_By_Id(ctx context.Context,
 	user_id User_Id_Field,
 	update User_Update_Fields) (
@@ -37611,6 +43110,177 @@ func (obj *pgxcockroachImpl) Update_UserSettings_By_UserId(ctx context.Context,
 	return user_settings, nil
 }
 
+func (obj *pgxcockroachImpl) Update_ScimGroup_By_Id(ctx context.Context,
+	scim_group_id ScimGroup_Id_Field,
+	update ScimGroup_Update_Fields) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE scim_groups SET "), __sets, __sqlbundle_Literal(" WHERE scim_groups.id = ? RETURNING scim_groups.id, scim_groups.provider, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.DisplayName._set {
+		__values = append(__values, update.DisplayName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("display_name = ?"))
+	}
+
+	if update.ExternalId._set {
+		__values = append(__values, update.ExternalId.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("external_id = ?"))
+	}
+
+	if update.ProjectId._set {
+		__values = append(__values, update.ProjectId.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("project_id = ?"))
+	}
+
+	if update.Role._set {
+		__values = append(__values, update.Role.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("role = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, scim_group_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group = &ScimGroup{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.Provider, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return scim_group, nil
+}
+
+func (obj *pgxcockroachImpl) Update_WebauthnCredential_By_Id(ctx context.Context,
+	webauthn_credential_id WebauthnCredential_Id_Field,
+	update WebauthnCredential_Update_Fields) (
+	webauthn_credential *WebauthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE webauthn_credentials SET "), __sets, __sqlbundle_Literal(" WHERE webauthn_credentials.id = ? RETURNING webauthn_credentials.id, webauthn_credentials.user_id, webauthn_credentials.credential_id, webauthn_credentials.public_key, webauthn_credentials.sign_count, webauthn_credentials.transports, webauthn_credentials.name, webauthn_credentials.created_at, webauthn_credentials.last_used_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.SignCount._set {
+		__values = append(__values, update.SignCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("sign_count = ?"))
+	}
+
+	if update.Name._set {
+		__values = append(__values, update.Name.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
+	}
+
+	if update.LastUsedAt._set {
+		__values = append(__values, update.LastUsedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_used_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, webauthn_credential_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	webauthn_credential = &WebauthnCredential{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&webauthn_credential.Id, &webauthn_credential.UserId, &webauthn_credential.CredentialId, &webauthn_credential.PublicKey, &webauthn_credential.SignCount, &webauthn_credential.Transports, &webauthn_credential.Name, &webauthn_credential.CreatedAt, &webauthn_credential.LastUsedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return webauthn_credential, nil
+}
+
+func (obj *pgxcockroachImpl) Update_WebauthnCredential_By_Id_And_UserId(ctx context.Context,
+	webauthn_credential_id WebauthnCredential_Id_Field,
+	webauthn_credential_user_id WebauthnCredential_UserId_Field,
+	update WebauthnCredential_Update_Fields) (
+	webauthn_credential *WebauthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE webauthn_credentials SET "), __sets, __sqlbundle_Literal(" WHERE webauthn_credentials.id = ? AND webauthn_credentials.user_id = ? RETURNING webauthn_credentials.id, webauthn_credentials.user_id, webauthn_credentials.credential_id, webauthn_credentials.public_key, webauthn_credentials.sign_count, webauthn_credentials.transports, webauthn_credentials.name, webauthn_credentials.created_at, webauthn_credentials.last_used_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.SignCount._set {
+		__values = append(__values, update.SignCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("sign_count = ?"))
+	}
+
+	if update.Name._set {
+		__values = append(__values, update.Name.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
+	}
+
+	if update.LastUsedAt._set {
+		__values = append(__values, update.LastUsedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_used_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, webauthn_credential_id.value(), webauthn_credential_user_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	webauthn_credential = &WebauthnCredential{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&webauthn_credential.Id, &webauthn_credential.UserId, &webauthn_credential.CredentialId, &webauthn_credential.PublicKey, &webauthn_credential.SignCount, &webauthn_credential.Transports, &webauthn_credential.Name, &webauthn_credential.CreatedAt, &webauthn_credential.LastUsedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return webauthn_credential, nil
+}
+
 func (obj *pgxcockroachImpl) Delete_StoragenodeStorageTally_By_IntervalEndTime_Less(ctx context.Context,
 	storagenode_storage_tally_interval_end_time_less StoragenodeStorageTally_IntervalEndTime_Field) (
 	count int64, err error) {
@@ -37733,6 +43403,36 @@ func (obj *pgxcockroachImpl) Delete_StorjscanPayment_By_Status(ctx context.Conte
 
 }
 
+func (obj *pgxcockroachImpl) Delete_AdminApprovalRequest_By_Id(ctx context.Context,
+	admin_approval_request_id AdminApprovalRequest_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM admin_approval_requests WHERE admin_approval_requests.id = ?")
+
+	var __values []any
+	__values = append(__values, admin_approval_request_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *pgxcockroachImpl) Delete_Domain_By_ProjectId_And_Subdomain(ctx context.Context,
 	domain_project_id Domain_ProjectId_Field,
 	domain_subdomain Domain_Subdomain_Field) (
@@ -37884,6 +43584,67 @@ func (obj *pgxcockroachImpl) Delete_OauthClient_By_Id(ctx context.Context,
 
 }
 
+func (obj *pgxcockroachImpl) Delete_Organization_By_Id(ctx context.Context,
+	organization_id Organization_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM organizations WHERE organizations.id = ?")
+
+	var __values []any
+	__values = append(__values, organization_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxcockroachImpl) Delete_OrganizationMember_By_OrganizationId_And_MemberId(ctx context.Context,
+	organization_member_organization_id OrganizationMember_OrganizationId_Field,
+	organization_member_member_id OrganizationMember_MemberId_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM organization_members WHERE organization_members.organization_id = ? AND organization_members.member_id = ?")
+
+	var __values []any
+	__values = append(__values, organization_member_organization_id.value(), organization_member_member_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *pgxcockroachImpl) Delete_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field) (
 	deleted bool, err error) {
@@ -38073,6 +43834,67 @@ func (obj *pgxcockroachImpl) Delete_ApiKey_By_ProjectId_And_CreatedBy(ctx contex
 
 }
 
+func (obj *pgxcockroachImpl) Delete_ProjectRole_By_Id(ctx context.Context,
+	project_role_id ProjectRole_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_roles WHERE project_roles.id = ?")
+
+	var __values []any
+	__values = append(__values, project_role_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxcockroachImpl) Delete_ProjectMemberRole_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_role_member_id ProjectMemberRole_MemberId_Field,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_member_roles WHERE project_member_roles.member_id = ? AND project_member_roles.project_id = ?")
+
+	var __values []any
+	__values = append(__values, project_member_role_member_id.value(), project_member_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *pgxcockroachImpl) Delete_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 	bucket_metainfo_name BucketMetainfo_Name_Field) (
@@ -38165,6 +43987,68 @@ func (obj *pgxcockroachImpl) Delete_BucketMigration_By_Id(ctx context.Context,
 
 }
 
+func (obj *pgxcockroachImpl) Delete_BucketLimit_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_limit_project_id BucketLimit_ProjectId_Field,
+	bucket_limit_bucket_name BucketLimit_BucketName_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_limits WHERE bucket_limits.project_id = ? AND bucket_limits.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_limit_project_id.value(), bucket_limit_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxcockroachImpl) Delete_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_lifecycle_configs WHERE bucket_lifecycle_configs.project_id = ? AND bucket_lifecycle_configs.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_lifecycle_config_project_id.value(), bucket_lifecycle_config_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *pgxcockroachImpl) Delete_RepairQueue_By_UpdatedAt_Less(ctx context.Context,
 	repair_queue_updated_at_less RepairQueue_UpdatedAt_Field) (
 	count int64, err error) {
@@ -38437,6 +44321,158 @@ func (obj *pgxcockroachImpl) Delete_AccountFreezeEvent_By_UserId_And_Event(ctx c
 
 }
 
+func (obj *pgxcockroachImpl) Delete_ScimGroup_By_Id(ctx context.Context,
+	scim_group_id ScimGroup_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM scim_groups WHERE scim_groups.id = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxcockroachImpl) Delete_ScimGroupMember_By_GroupId_And_UserId(ctx context.Context,
+	scim_group_member_group_id ScimGroupMember_GroupId_Field,
+	scim_group_member_user_id ScimGroupMember_UserId_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM scim_group_members WHERE scim_group_members.group_id = ? AND scim_group_members.user_id = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_member_group_id.value(), scim_group_member_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxcockroachImpl) Delete_WebauthnCredential_By_Id_And_UserId(ctx context.Context,
+	webauthn_credential_id WebauthnCredential_Id_Field,
+	webauthn_credential_user_id WebauthnCredential_UserId_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM webauthn_credentials WHERE webauthn_credentials.id = ? AND webauthn_credentials.user_id = ?")
+
+	var __values []any
+	__values = append(__values, webauthn_credential_id.value(), webauthn_credential_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxcockroachImpl) Delete_WebauthnCredential_By_UserId(ctx context.Context,
+	webauthn_credential_user_id WebauthnCredential_UserId_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM webauthn_credentials WHERE webauthn_credentials.user_id = ?")
+
+	var __values []any
+	__values = append(__values, webauthn_credential_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *pgxcockroachImpl) Delete_WebauthnUsedChallenge_By_ExpiresAt_Less(ctx context.Context,
+	webauthn_used_challenge_expires_at_less WebauthnUsedChallenge_ExpiresAt_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM webauthn_used_challenges WHERE webauthn_used_challenges.expires_at < ?")
+
+	var __values []any
+	__values = append(__values, webauthn_used_challenge_expires_at_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
 func (impl pgxcockroachImpl) isConstraintError(err error) (constraint string, ok bool) {
 	if e, ok := err.(*pgconn.PgError); ok {
 		if e.Code[:2] == "23" {
@@ -38453,11 +44489,31 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 	}
 	var __res sql.Result
 	var __count int64
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_member_roles;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
 	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM api_key_tails;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM webauthn_credentials;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -38468,6 +44524,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM scim_group_members;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -38478,6 +44544,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_roles;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -38498,6 +44574,26 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM organization_projects;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM organization_members;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -38538,6 +44634,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM webauthn_used_challenges;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -38698,6 +44804,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM scim_groups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -38808,6 +44924,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM organizations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -38918,6 +45044,26 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_limits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_lifecycle_configs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -38958,6 +45104,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM admin_approval_requests;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -39597,6 +45753,59 @@ func (obj *spannerImpl) Create_ChangeHistory(ctx context.Context,
 
 }
 
+func (obj *spannerImpl) Create_AdminApprovalRequest(ctx context.Context,
+	admin_approval_request_id AdminApprovalRequest_Id_Field,
+	admin_approval_request_operation AdminApprovalRequest_Operation_Field,
+	admin_approval_request_item_type AdminApprovalRequest_ItemType_Field,
+	admin_approval_request_user_id AdminApprovalRequest_UserId_Field,
+	admin_approval_request_requested_by AdminApprovalRequest_RequestedBy_Field,
+	admin_approval_request_reason AdminApprovalRequest_Reason_Field,
+	admin_approval_request_details AdminApprovalRequest_Details_Field,
+	admin_approval_request_payload AdminApprovalRequest_Payload_Field,
+	admin_approval_request_expires_at AdminApprovalRequest_ExpiresAt_Field,
+	optional AdminApprovalRequest_Create_Fields) (
+	admin_approval_request *AdminApprovalRequest, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := admin_approval_request_id.value()
+	__operation_val := admin_approval_request_operation.value()
+	__item_type_val := admin_approval_request_item_type.value()
+	__user_id_val := admin_approval_request_user_id.value()
+	__project_id_val := optional.ProjectId.value()
+	__requested_by_val := admin_approval_request_requested_by.value()
+	__reason_val := admin_approval_request_reason.value()
+	__details_val := spannerConvertJSON(admin_approval_request_details.value())
+	__payload_val := spannerConvertJSON(admin_approval_request_payload.value())
+	__created_at_val := __now
+	__expires_at_val := admin_approval_request_expires_at.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO admin_approval_requests ( id, operation, item_type, user_id, project_id, requested_by, reason, details, payload, created_at, expires_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) THEN RETURN admin_approval_requests.id, admin_approval_requests.operation, admin_approval_requests.item_type, admin_approval_requests.user_id, admin_approval_requests.project_id, admin_approval_requests.requested_by, admin_approval_requests.reason, admin_approval_requests.details, admin_approval_requests.payload, admin_approval_requests.created_at, admin_approval_requests.expires_at")
+
+	var __values []any
+	__values = append(__values, __id_val, __operation_val, __item_type_val, __user_id_val, __project_id_val, __requested_by_val, __reason_val, __details_val, __payload_val, __created_at_val, __expires_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	admin_approval_request = &AdminApprovalRequest{}
+	if !obj.txn {
+		err = obj.withTx(ctx, func(tx tagsql.Tx) error {
+			return tx.QueryRowContext(ctx, __stmt, __values...).Scan(&admin_approval_request.Id, &admin_approval_request.Operation, &admin_approval_request.ItemType, &admin_approval_request.UserId, &admin_approval_request.ProjectId, &admin_approval_request.RequestedBy, &admin_approval_request.Reason, spannerConvertJSON(&admin_approval_request.Details), spannerConvertJSON(&admin_approval_request.Payload), &admin_approval_request.CreatedAt, &admin_approval_request.ExpiresAt)
+		})
+	} else {
+		err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&admin_approval_request.Id, &admin_approval_request.Operation, &admin_approval_request.ItemType, &admin_approval_request.UserId, &admin_approval_request.ProjectId, &admin_approval_request.RequestedBy, &admin_approval_request.Reason, spannerConvertJSON(&admin_approval_request.Details), spannerConvertJSON(&admin_approval_request.Payload), &admin_approval_request.CreatedAt, &admin_approval_request.ExpiresAt)
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return admin_approval_request, nil
+
+}
+
 func (obj *spannerImpl) Create_Domain(ctx context.Context,
 	domain_subdomain Domain_Subdomain_Field,
 	domain_project_id Domain_ProjectId_Field,
@@ -40231,6 +46440,126 @@ func (obj *spannerImpl) CreateNoReturn_OauthToken(ctx context.Context,
 
 }
 
+func (obj *spannerImpl) Create_Organization(ctx context.Context,
+	organization_id Organization_Id_Field,
+	organization_name Organization_Name_Field,
+	organization_owner_id Organization_OwnerId_Field,
+	optional Organization_Create_Fields) (
+	organization *Organization, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := organization_id.value()
+	__name_val := organization_name.value()
+	__owner_id_val := organization_owner_id.value()
+	__project_limit_val := optional.ProjectLimit.value()
+	__storage_limit_val := optional.StorageLimit.value()
+	__bandwidth_limit_val := optional.BandwidthLimit.value()
+	__segment_limit_val := optional.SegmentLimit.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO organizations ( id, name, owner_id, project_limit, storage_limit, bandwidth_limit, segment_limit, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? ) THEN RETURN organizations.id, organizations.name, organizations.owner_id, organizations.project_limit, organizations.storage_limit, organizations.bandwidth_limit, organizations.segment_limit, organizations.created_at")
+
+	var __values []any
+	__values = append(__values, __id_val, __name_val, __owner_id_val, __project_limit_val, __storage_limit_val, __bandwidth_limit_val, __segment_limit_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	organization = &Organization{}
+	if !obj.txn {
+		err = obj.withTx(ctx, func(tx tagsql.Tx) error {
+			return tx.QueryRowContext(ctx, __stmt, __values...).Scan(&organization.Id, &organization.Name, &organization.OwnerId, &organization.ProjectLimit, &organization.StorageLimit, &organization.BandwidthLimit, &organization.SegmentLimit, &organization.CreatedAt)
+		})
+	} else {
+		err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&organization.Id, &organization.Name, &organization.OwnerId, &organization.ProjectLimit, &organization.StorageLimit, &organization.BandwidthLimit, &organization.SegmentLimit, &organization.CreatedAt)
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return organization, nil
+
+}
+
+func (obj *spannerImpl) Create_OrganizationMember(ctx context.Context,
+	organization_member_organization_id OrganizationMember_OrganizationId_Field,
+	organization_member_member_id OrganizationMember_MemberId_Field,
+	organization_member_role OrganizationMember_Role_Field) (
+	organization_member *OrganizationMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__organization_id_val := organization_member_organization_id.value()
+	__member_id_val := organization_member_member_id.value()
+	__role_val := organization_member_role.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO organization_members ( organization_id, member_id, role, created_at ) VALUES ( ?, ?, ?, ? ) THEN RETURN organization_members.organization_id, organization_members.member_id, organization_members.role, organization_members.created_at")
+
+	var __values []any
+	__values = append(__values, __organization_id_val, __member_id_val, __role_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	organization_member = &OrganizationMember{}
+	if !obj.txn {
+		err = obj.withTx(ctx, func(tx tagsql.Tx) error {
+			return tx.QueryRowContext(ctx, __stmt, __values...).Scan(&organization_member.OrganizationId, &organization_member.MemberId, &organization_member.Role, &organization_member.CreatedAt)
+		})
+	} else {
+		err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&organization_member.OrganizationId, &organization_member.MemberId, &organization_member.Role, &organization_member.CreatedAt)
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return organization_member, nil
+
+}
+
+func (obj *spannerImpl) Create_OrganizationProject(ctx context.Context,
+	organization_project_project_id OrganizationProject_ProjectId_Field,
+	organization_project_organization_id OrganizationProject_OrganizationId_Field) (
+	organization_project *OrganizationProject, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := organization_project_project_id.value()
+	__organization_id_val := organization_project_organization_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO organization_projects ( project_id, organization_id, created_at ) VALUES ( ?, ?, ? ) THEN RETURN organization_projects.project_id, organization_projects.organization_id, organization_projects.created_at")
+
+	var __values []any
+	__values = append(__values, __project_id_val, __organization_id_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	organization_project = &OrganizationProject{}
+	if !obj.txn {
+		err = obj.withTx(ctx, func(tx tagsql.Tx) error {
+			return tx.QueryRowContext(ctx, __stmt, __values...).Scan(&organization_project.ProjectId, &organization_project.OrganizationId, &organization_project.CreatedAt)
+		})
+	} else {
+		err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&organization_project.ProjectId, &organization_project.OrganizationId, &organization_project.CreatedAt)
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return organization_project, nil
+
+}
+
 func (obj *spannerImpl) Create_Project(ctx context.Context,
 	project_id Project_Id_Field,
 	project_name Project_Name_Field,
@@ -40645,6 +46974,83 @@ func (obj *spannerImpl) Replace_ApiKeyTail(ctx context.Context,
 
 }
 
+func (obj *spannerImpl) Create_ProjectRole(ctx context.Context,
+	project_role_id ProjectRole_Id_Field,
+	project_role_project_id ProjectRole_ProjectId_Field,
+	project_role_name ProjectRole_Name_Field,
+	project_role_permissions ProjectRole_Permissions_Field) (
+	project_role *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := project_role_id.value()
+	__project_id_val := project_role_project_id.value()
+	__name_val := project_role_name.value()
+	__permissions_val := project_role_permissions.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_roles ( id, project_id, name, permissions, created_at ) VALUES ( ?, ?, ?, ?, ? ) THEN RETURN project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.created_at")
+
+	var __values []any
+	__values = append(__values, __id_val, __project_id_val, __name_val, __permissions_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_role = &ProjectRole{}
+	if !obj.txn {
+		err = obj.withTx(ctx, func(tx tagsql.Tx) error {
+			return tx.QueryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.CreatedAt)
+		})
+	} else {
+		err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.CreatedAt)
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_role, nil
+
+}
+
+func (obj *spannerImpl) Replace_ProjectMemberRole(ctx context.Context,
+	project_member_role_member_id ProjectMemberRole_MemberId_Field,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field,
+	project_member_role_role_id ProjectMemberRole_RoleId_Field) (
+	project_member_role *ProjectMemberRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+	__member_id_val := project_member_role_member_id.value()
+	__project_id_val := project_member_role_project_id.value()
+	__role_id_val := project_member_role_role_id.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT OR UPDATE INTO project_member_roles ( member_id, project_id, role_id ) VALUES ( ?, ?, ? ) THEN RETURN project_member_roles.member_id, project_member_roles.project_id, project_member_roles.role_id")
+
+	var __values []any
+	__values = append(__values, __member_id_val, __project_id_val, __role_id_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_member_role = &ProjectMemberRole{}
+	if !obj.txn {
+		err = obj.withTx(ctx, func(tx tagsql.Tx) error {
+			return tx.QueryRowContext(ctx, __stmt, __values...).Scan(&project_member_role.MemberId, &project_member_role.ProjectId, &project_member_role.RoleId)
+		})
+	} else {
+		err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&project_member_role.MemberId, &project_member_role.ProjectId, &project_member_role.RoleId)
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_member_role, nil
+
+}
+
 func (obj *spannerImpl) Create_BucketMetainfo(ctx context.Context,
 	bucket_metainfo_id BucketMetainfo_Id_Field,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
@@ -40862,6 +47268,89 @@ func (obj *spannerImpl) Create_BucketMigration(ctx context.Context,
 
 }
 
+func (obj *spannerImpl) Replace_BucketLimit(ctx context.Context,
+	bucket_limit_project_id BucketLimit_ProjectId_Field,
+	bucket_limit_bucket_name BucketLimit_BucketName_Field,
+	optional BucketLimit_Create_Fields) (
+	bucket_limit *BucketLimit, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_limit_project_id.value()
+	__bucket_name_val := bucket_limit_bucket_name.value()
+	__storage_limit_val := optional.StorageLimit.value()
+	__bandwidth_limit_val := optional.BandwidthLimit.value()
+	__segment_limit_val := optional.SegmentLimit.value()
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT OR UPDATE INTO bucket_limits ( project_id, bucket_name, storage_limit, bandwidth_limit, segment_limit, updated_at ) VALUES ( ?, ?, ?, ?, ?, ? ) THEN RETURN bucket_limits.project_id, bucket_limits.bucket_name, bucket_limits.storage_limit, bucket_limits.bandwidth_limit, bucket_limits.segment_limit, bucket_limits.updated_at")
+
+	var __values []any
+	__values = append(__values, __project_id_val, __bucket_name_val, __storage_limit_val, __bandwidth_limit_val, __segment_limit_val, __updated_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_limit = &BucketLimit{}
+	if !obj.txn {
+		err = obj.withTx(ctx, func(tx tagsql.Tx) error {
+			return tx.QueryRowContext(ctx, __stmt, __values...).Scan(&bucket_limit.ProjectId, &bucket_limit.BucketName, &bucket_limit.StorageLimit, &bucket_limit.BandwidthLimit, &bucket_limit.SegmentLimit, &bucket_limit.UpdatedAt)
+		})
+	} else {
+		err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&bucket_limit.ProjectId, &bucket_limit.BucketName, &bucket_limit.StorageLimit, &bucket_limit.BandwidthLimit, &bucket_limit.SegmentLimit, &bucket_limit.UpdatedAt)
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_limit, nil
+
+}
+
+func (obj *spannerImpl) Create_BucketLifecycleConfig(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field,
+	bucket_lifecycle_config_rules BucketLifecycleConfig_Rules_Field,
+	optional BucketLifecycleConfig_Create_Fields) (
+	bucket_lifecycle_config *BucketLifecycleConfig, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_lifecycle_config_project_id.value()
+	__bucket_name_val := bucket_lifecycle_config_bucket_name.value()
+	__rules_val := bucket_lifecycle_config_rules.value()
+	__deletion_cursor_val := optional.DeletionCursor.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_lifecycle_configs ( project_id, bucket_name, rules, deletion_cursor, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ? ) THEN RETURN bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name, bucket_lifecycle_configs.rules, bucket_lifecycle_configs.deletion_cursor, bucket_lifecycle_configs.created_at, bucket_lifecycle_configs.updated_at")
+
+	var __values []any
+	__values = append(__values, __project_id_val, __bucket_name_val, __rules_val, __deletion_cursor_val, __created_at_val, __updated_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_lifecycle_config = &BucketLifecycleConfig{}
+	if !obj.txn {
+		err = obj.withTx(ctx, func(tx tagsql.Tx) error {
+			return tx.QueryRowContext(ctx, __stmt, __values...).Scan(&bucket_lifecycle_config.ProjectId, &bucket_lifecycle_config.BucketName, &bucket_lifecycle_config.Rules, &bucket_lifecycle_config.DeletionCursor, &bucket_lifecycle_config.CreatedAt, &bucket_lifecycle_config.UpdatedAt)
+		})
+	} else {
+		err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&bucket_lifecycle_config.ProjectId, &bucket_lifecycle_config.BucketName, &bucket_lifecycle_config.Rules, &bucket_lifecycle_config.DeletionCursor, &bucket_lifecycle_config.CreatedAt, &bucket_lifecycle_config.UpdatedAt)
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_lifecycle_config, nil
+
+}
+
 func (obj *spannerImpl) CreateNoReturn_RestApiKey(ctx context.Context,
 	rest_api_key_id RestApiKey_Id_Field,
 	rest_api_key_user_id RestApiKey_UserId_Field,
@@ -41355,6 +47844,167 @@ func (obj *spannerImpl) CreateNoReturn_UserSettings(ctx context.Context,
 
 }
 
+func (obj *spannerImpl) Create_ScimGroup(ctx context.Context,
+	scim_group_id ScimGroup_Id_Field,
+	scim_group_provider ScimGroup_Provider_Field,
+	scim_group_display_name ScimGroup_DisplayName_Field,
+	scim_group_role ScimGroup_Role_Field,
+	optional ScimGroup_Create_Fields) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := scim_group_id.value()
+	__provider_val := scim_group_provider.value()
+	__display_name_val := scim_group_display_name.value()
+	__external_id_val := optional.ExternalId.value()
+	__project_id_val := optional.ProjectId.value()
+	__role_val := scim_group_role.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO scim_groups ( id, provider, display_name, external_id, project_id, role, created_at ) VALUES ( ?, ?, ?, ?, ?, ?, ? ) THEN RETURN scim_groups.id, scim_groups.provider, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at")
+
+	var __values []any
+	__values = append(__values, __id_val, __provider_val, __display_name_val, __external_id_val, __project_id_val, __role_val, __created_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group = &ScimGroup{}
+	if !obj.txn {
+		err = obj.withTx(ctx, func(tx tagsql.Tx) error {
+			return tx.QueryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.Provider, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt)
+		})
+	} else {
+		err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.Provider, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt)
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return scim_group, nil
+
+}
+
+func (obj *spannerImpl) Replace_ScimGroupMember(ctx context.Context,
+	scim_group_member_group_id ScimGroupMember_GroupId_Field,
+	scim_group_member_user_id ScimGroupMember_UserId_Field) (
+	scim_group_member *ScimGroupMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+	__group_id_val := scim_group_member_group_id.value()
+	__user_id_val := scim_group_member_user_id.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT OR UPDATE INTO scim_group_members ( group_id, user_id ) VALUES ( ?, ? ) THEN RETURN scim_group_members.group_id, scim_group_members.user_id")
+
+	var __values []any
+	__values = append(__values, __group_id_val, __user_id_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group_member = &ScimGroupMember{}
+	if !obj.txn {
+		err = obj.withTx(ctx, func(tx tagsql.Tx) error {
+			return tx.QueryRowContext(ctx, __stmt, __values...).Scan(&scim_group_member.GroupId, &scim_group_member.UserId)
+		})
+	} else {
+		err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&scim_group_member.GroupId, &scim_group_member.UserId)
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return scim_group_member, nil
+
+}
+
+func (obj *spannerImpl) Create_WebauthnCredential(ctx context.Context,
+	webauthn_credential_id WebauthnCredential_Id_Field,
+	webauthn_credential_user_id WebauthnCredential_UserId_Field,
+	webauthn_credential_credential_id WebauthnCredential_CredentialId_Field,
+	webauthn_credential_public_key WebauthnCredential_PublicKey_Field,
+	webauthn_credential_sign_count WebauthnCredential_SignCount_Field,
+	webauthn_credential_transports WebauthnCredential_Transports_Field,
+	webauthn_credential_name WebauthnCredential_Name_Field,
+	optional WebauthnCredential_Create_Fields) (
+	webauthn_credential *WebauthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := webauthn_credential_id.value()
+	__user_id_val := webauthn_credential_user_id.value()
+	__credential_id_val := webauthn_credential_credential_id.value()
+	__public_key_val := webauthn_credential_public_key.value()
+	__sign_count_val := webauthn_credential_sign_count.value()
+	__transports_val := webauthn_credential_transports.value()
+	__name_val := webauthn_credential_name.value()
+	__created_at_val := __now
+	__last_used_at_val := optional.LastUsedAt.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO webauthn_credentials ( id, user_id, credential_id, public_key, sign_count, transports, name, created_at, last_used_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? ) THEN RETURN webauthn_credentials.id, webauthn_credentials.user_id, webauthn_credentials.credential_id, webauthn_credentials.public_key, webauthn_credentials.sign_count, webauthn_credentials.transports, webauthn_credentials.name, webauthn_credentials.created_at, webauthn_credentials.last_used_at")
+
+	var __values []any
+	__values = append(__values, __id_val, __user_id_val, __credential_id_val, __public_key_val, __sign_count_val, __transports_val, __name_val, __created_at_val, __last_used_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	webauthn_credential = &WebauthnCredential{}
+	if !obj.txn {
+		err = obj.withTx(ctx, func(tx tagsql.Tx) error {
+			return tx.QueryRowContext(ctx, __stmt, __values...).Scan(&webauthn_credential.Id, &webauthn_credential.UserId, &webauthn_credential.CredentialId, &webauthn_credential.PublicKey, &webauthn_credential.SignCount, &webauthn_credential.Transports, &webauthn_credential.Name, &webauthn_credential.CreatedAt, &webauthn_credential.LastUsedAt)
+		})
+	} else {
+		err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&webauthn_credential.Id, &webauthn_credential.UserId, &webauthn_credential.CredentialId, &webauthn_credential.PublicKey, &webauthn_credential.SignCount, &webauthn_credential.Transports, &webauthn_credential.Name, &webauthn_credential.CreatedAt, &webauthn_credential.LastUsedAt)
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return webauthn_credential, nil
+
+}
+
+func (obj *spannerImpl) Create_WebauthnUsedChallenge(ctx context.Context,
+	webauthn_used_challenge_challenge WebauthnUsedChallenge_Challenge_Field,
+	webauthn_used_challenge_expires_at WebauthnUsedChallenge_ExpiresAt_Field) (
+	webauthn_used_challenge *WebauthnUsedChallenge, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+	__challenge_val := webauthn_used_challenge_challenge.value()
+	__expires_at_val := webauthn_used_challenge_expires_at.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO webauthn_used_challenges ( challenge, expires_at ) VALUES ( ?, ? ) THEN RETURN webauthn_used_challenges.challenge, webauthn_used_challenges.expires_at")
+
+	var __values []any
+	__values = append(__values, __challenge_val, __expires_at_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	webauthn_used_challenge = &WebauthnUsedChallenge{}
+	if !obj.txn {
+		err = obj.withTx(ctx, func(tx tagsql.Tx) error {
+			return tx.QueryRowContext(ctx, __stmt, __values...).Scan(&webauthn_used_challenge.Challenge, &webauthn_used_challenge.ExpiresAt)
+		})
+	} else {
+		err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&webauthn_used_challenge.Challenge, &webauthn_used_challenge.ExpiresAt)
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return webauthn_used_challenge, nil
+
+}
+
 func (obj *spannerImpl) Find_AccountingTimestamps_Value_By_Name(ctx context.Context,
 	accounting_timestamps_name AccountingTimestamps_Name_Field) (
 	row *Value_Row, err error) {
@@ -42925,25 +49575,75 @@ func (obj *spannerImpl) All_ChangeHistory_By_ProjectId_And_ItemType_Not_OrderBy_
 
 }
 
-func (obj *spannerImpl) All_ChangeHistory_By_ProjectId_And_ItemType_OrderBy_Desc_Timestamp(ctx context.Context,
-	change_history_project_id ChangeHistory_ProjectId_Field,
-	change_history_item_type ChangeHistory_ItemType_Field) (
+func (obj *spannerImpl) All_ChangeHistory_By_ProjectId_And_ItemType_OrderBy_Desc_Timestamp(ctx context.Context,
+	change_history_project_id ChangeHistory_ProjectId_Field,
+	change_history_item_type ChangeHistory_ItemType_Field) (
+	rows []*ChangeHistory, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.project_id", Equal: true, Right: "?", Null: true}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" AND change_histories.item_type = ? ORDER BY change_histories.timestamp DESC")}}
+
+	var __values []any
+	if !change_history_project_id.isnull() {
+		__cond_0.Null = false
+		__values = append(__values, change_history_project_id.value())
+	}
+	__values = append(__values, change_history_item_type.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ChangeHistory, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				change_history := &ChangeHistory{}
+				err = __rows.Scan(&change_history.Id, &change_history.AdminEmail, &change_history.UserId, &change_history.ProjectId, &change_history.BucketName, &change_history.ItemType, &change_history.Operation, &change_history.Reason, spannerConvertJSON(&change_history.Changes), &change_history.Timestamp)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, change_history)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *spannerImpl) All_ChangeHistory_By_BucketName_OrderBy_Desc_Timestamp(ctx context.Context,
+	change_history_bucket_name ChangeHistory_BucketName_Field) (
 	rows []*ChangeHistory, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
 		panic("using DB when inside of a transaction")
 	}
 
-	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.project_id", Equal: true, Right: "?", Null: true}
+	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.bucket_name", Equal: true, Right: "?", Null: true}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" AND change_histories.item_type = ? ORDER BY change_histories.timestamp DESC")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" ORDER BY change_histories.timestamp DESC")}}
 
 	var __values []any
-	if !change_history_project_id.isnull() {
+	if !change_history_bucket_name.isnull() {
 		__cond_0.Null = false
-		__values = append(__values, change_history_project_id.value())
+		__values = append(__values, change_history_bucket_name.value())
 	}
-	__values = append(__values, change_history_item_type.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -42977,29 +49677,47 @@ func (obj *spannerImpl) All_ChangeHistory_By_ProjectId_And_ItemType_OrderBy_Desc
 
 }
 
-func (obj *spannerImpl) All_ChangeHistory_By_BucketName_OrderBy_Desc_Timestamp(ctx context.Context,
-	change_history_bucket_name ChangeHistory_BucketName_Field) (
-	rows []*ChangeHistory, err error) {
+func (obj *spannerImpl) Get_AdminApprovalRequest_By_Id(ctx context.Context,
+	admin_approval_request_id AdminApprovalRequest_Id_Field) (
+	admin_approval_request *AdminApprovalRequest, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if !obj.txn && txutil.IsInsideTx(ctx) {
 		panic("using DB when inside of a transaction")
 	}
 
-	var __cond_0 = &__sqlbundle_Condition{Left: "change_histories.bucket_name", Equal: true, Right: "?", Null: true}
-
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("SELECT change_histories.id, change_histories.admin_email, change_histories.user_id, change_histories.project_id, change_histories.bucket_name, change_histories.item_type, change_histories.operation, change_histories.reason, change_histories.changes, change_histories.timestamp FROM change_histories WHERE "), __cond_0, __sqlbundle_Literal(" ORDER BY change_histories.timestamp DESC")}}
+	var __embed_stmt = __sqlbundle_Literal("SELECT admin_approval_requests.id, admin_approval_requests.operation, admin_approval_requests.item_type, admin_approval_requests.user_id, admin_approval_requests.project_id, admin_approval_requests.requested_by, admin_approval_requests.reason, admin_approval_requests.details, admin_approval_requests.payload, admin_approval_requests.created_at, admin_approval_requests.expires_at FROM admin_approval_requests WHERE admin_approval_requests.id = ?")
 
 	var __values []any
-	if !change_history_bucket_name.isnull() {
-		__cond_0.Null = false
-		__values = append(__values, change_history_bucket_name.value())
+	__values = append(__values, admin_approval_request_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	admin_approval_request = &AdminApprovalRequest{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&admin_approval_request.Id, &admin_approval_request.Operation, &admin_approval_request.ItemType, &admin_approval_request.UserId, &admin_approval_request.ProjectId, &admin_approval_request.RequestedBy, &admin_approval_request.Reason, spannerConvertJSON(&admin_approval_request.Details), spannerConvertJSON(&admin_approval_request.Payload), &admin_approval_request.CreatedAt, &admin_approval_request.ExpiresAt)
+	if err != nil {
+		return (*AdminApprovalRequest)(nil), obj.makeErr(err)
+	}
+	return admin_approval_request, nil
+
+}
+
+func (obj *spannerImpl) All_AdminApprovalRequest_OrderBy_Asc_CreatedAt(ctx context.Context) (
+	rows []*AdminApprovalRequest, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
 	}
 
+	var __embed_stmt = __sqlbundle_Literal("SELECT admin_approval_requests.id, admin_approval_requests.operation, admin_approval_requests.item_type, admin_approval_requests.user_id, admin_approval_requests.project_id, admin_approval_requests.requested_by, admin_approval_requests.reason, admin_approval_requests.details, admin_approval_requests.payload, admin_approval_requests.created_at, admin_approval_requests.expires_at FROM admin_approval_requests ORDER BY admin_approval_requests.created_at")
+
+	var __values []any
+
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	for {
-		rows, err = func() (rows []*ChangeHistory, err error) {
+		rows, err = func() (rows []*AdminApprovalRequest, err error) {
 			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 			if err != nil {
 				return nil, err
@@ -43007,12 +49725,12 @@ func (obj *spannerImpl) All_ChangeHistory_By_BucketName_OrderBy_Desc_Timestamp(c
 			defer closeRows(__rows, &err)
 
 			for __rows.Next() {
-				change_history := &ChangeHistory{}
-				err = __rows.Scan(&change_history.Id, &change_history.AdminEmail, &change_history.UserId, &change_history.ProjectId, &change_history.BucketName, &change_history.ItemType, &change_history.Operation, &change_history.Reason, spannerConvertJSON(&change_history.Changes), &change_history.Timestamp)
+				admin_approval_request := &AdminApprovalRequest{}
+				err = __rows.Scan(&admin_approval_request.Id, &admin_approval_request.Operation, &admin_approval_request.ItemType, &admin_approval_request.UserId, &admin_approval_request.ProjectId, &admin_approval_request.RequestedBy, &admin_approval_request.Reason, spannerConvertJSON(&admin_approval_request.Details), spannerConvertJSON(&admin_approval_request.Payload), &admin_approval_request.CreatedAt, &admin_approval_request.ExpiresAt)
 				if err != nil {
 					return nil, err
 				}
-				rows = append(rows, change_history)
+				rows = append(rows, admin_approval_request)
 			}
 			return rows, nil
 		}()
@@ -43181,7 +49899,7 @@ func (obj *spannerImpl) Get_Node_By_Id(ctx context.Context,
 		panic("using DB when inside of a transaction")
 	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.asn_org FROM nodes WHERE nodes.id = ?")
 
 	var __values []any
 	__values = append(__values, node_id.value())
@@ -43190,7 +49908,7 @@ func (obj *spannerImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.Asn, &node.AsnOrg)
 	if err != nil {
 		return (*Node)(nil), obj.makeErr(err)
 	}
@@ -43249,9 +49967,9 @@ func (obj *spannerImpl) Paged_Node(ctx context.Context,
 		panic("using DB when inside of a transaction")
 	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes WHERE nodes.id > ? ORDER BY nodes.id LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.asn_org, nodes.id FROM nodes WHERE nodes.id > ? ORDER BY nodes.id LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.asn_org, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
 
 	var __values []any
 
@@ -43281,7 +49999,7 @@ func (obj *spannerImpl) Paged_Node(ctx context.Context,
 
 			for __rows.Next() {
 				node := &Node{}
-				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &__continuation._value_id)
+				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.Asn, &node.AsnOrg, &__continuation._value_id)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -43311,9 +50029,9 @@ func (obj *spannerImpl) Paged_Node_By_Email(ctx context.Context,
 		panic("using DB when inside of a transaction")
 	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes WHERE nodes.email = ? AND nodes.id > ? ORDER BY nodes.id LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.asn_org, nodes.id FROM nodes WHERE nodes.email = ? AND nodes.id > ? ORDER BY nodes.id LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes WHERE nodes.email = ? ORDER BY nodes.id LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.asn_org, nodes.id FROM nodes WHERE nodes.email = ? ORDER BY nodes.id LIMIT ?")
 
 	var __values []any
 	__values = append(__values, node_email.value())
@@ -43344,7 +50062,7 @@ func (obj *spannerImpl) Paged_Node_By_Email(ctx context.Context,
 
 			for __rows.Next() {
 				node := &Node{}
-				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &__continuation._value_id)
+				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.Asn, &node.AsnOrg, &__continuation._value_id)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -43906,6 +50624,127 @@ func (obj *spannerImpl) Get_OauthToken_By_Kind_And_Token(ctx context.Context,
 
 }
 
+func (obj *spannerImpl) Get_Organization_By_Id(ctx context.Context,
+	organization_id Organization_Id_Field) (
+	organization *Organization, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT organizations.id, organizations.name, organizations.owner_id, organizations.project_limit, organizations.storage_limit, organizations.bandwidth_limit, organizations.segment_limit, organizations.created_at FROM organizations WHERE organizations.id = ?")
+
+	var __values []any
+	__values = append(__values, organization_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	organization = &Organization{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&organization.Id, &organization.Name, &organization.OwnerId, &organization.ProjectLimit, &organization.StorageLimit, &organization.BandwidthLimit, &organization.SegmentLimit, &organization.CreatedAt)
+	if err != nil {
+		return (*Organization)(nil), obj.makeErr(err)
+	}
+	return organization, nil
+
+}
+
+func (obj *spannerImpl) Get_OrganizationMember_By_OrganizationId_And_MemberId(ctx context.Context,
+	organization_member_organization_id OrganizationMember_OrganizationId_Field,
+	organization_member_member_id OrganizationMember_MemberId_Field) (
+	organization_member *OrganizationMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT organization_members.organization_id, organization_members.member_id, organization_members.role, organization_members.created_at FROM organization_members WHERE organization_members.organization_id = ? AND organization_members.member_id = ?")
+
+	var __values []any
+	__values = append(__values, organization_member_organization_id.value(), organization_member_member_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	organization_member = &OrganizationMember{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&organization_member.OrganizationId, &organization_member.MemberId, &organization_member.Role, &organization_member.CreatedAt)
+	if err != nil {
+		return (*OrganizationMember)(nil), obj.makeErr(err)
+	}
+	return organization_member, nil
+
+}
+
+func (obj *spannerImpl) Get_OrganizationProject_By_ProjectId(ctx context.Context,
+	organization_project_project_id OrganizationProject_ProjectId_Field) (
+	organization_project *OrganizationProject, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT organization_projects.project_id, organization_projects.organization_id, organization_projects.created_at FROM organization_projects WHERE organization_projects.project_id = ?")
+
+	var __values []any
+	__values = append(__values, organization_project_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	organization_project = &OrganizationProject{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&organization_project.ProjectId, &organization_project.OrganizationId, &organization_project.CreatedAt)
+	if err != nil {
+		return (*OrganizationProject)(nil), obj.makeErr(err)
+	}
+	return organization_project, nil
+
+}
+
+func (obj *spannerImpl) All_OrganizationProject_By_OrganizationId_OrderBy_Asc_CreatedAt_Asc_ProjectId(ctx context.Context,
+	organization_project_organization_id OrganizationProject_OrganizationId_Field) (
+	rows []*OrganizationProject, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT organization_projects.project_id, organization_projects.organization_id, organization_projects.created_at FROM organization_projects WHERE organization_projects.organization_id = ? ORDER BY organization_projects.created_at, organization_projects.project_id")
+
+	var __values []any
+	__values = append(__values, organization_project_organization_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*OrganizationProject, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				organization_project := &OrganizationProject{}
+				err = __rows.Scan(&organization_project.ProjectId, &organization_project.OrganizationId, &organization_project.CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, organization_project)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
 func (obj *spannerImpl) Get_Project_PassphraseEnc_Project_PassphraseEncKeyId_By_Id(ctx context.Context,
 	project_id Project_Id_Field) (
 	row *PassphraseEnc_PassphraseEncKeyId_Row, err error) {
@@ -45107,6 +51946,147 @@ func (obj *spannerImpl) Get_ApiKeyTail_By_Tail(ctx context.Context,
 
 }
 
+func (obj *spannerImpl) Get_ProjectRole_By_Id(ctx context.Context,
+	project_role_id ProjectRole_Id_Field) (
+	project_role *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.created_at FROM project_roles WHERE project_roles.id = ?")
+
+	var __values []any
+	__values = append(__values, project_role_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_role = &ProjectRole{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.CreatedAt)
+	if err != nil {
+		return (*ProjectRole)(nil), obj.makeErr(err)
+	}
+	return project_role, nil
+
+}
+
+func (obj *spannerImpl) All_ProjectRole_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
+	project_role_project_id ProjectRole_ProjectId_Field) (
+	rows []*ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.created_at FROM project_roles WHERE project_roles.project_id = ? ORDER BY project_roles.name")
+
+	var __values []any
+	__values = append(__values, project_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ProjectRole, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				project_role := &ProjectRole{}
+				err = __rows.Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, project_role)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *spannerImpl) Get_ProjectMemberRole_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_role_member_id ProjectMemberRole_MemberId_Field,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+	project_member_role *ProjectMemberRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_member_roles.member_id, project_member_roles.project_id, project_member_roles.role_id FROM project_member_roles WHERE project_member_roles.member_id = ? AND project_member_roles.project_id = ?")
+
+	var __values []any
+	__values = append(__values, project_member_role_member_id.value(), project_member_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_member_role = &ProjectMemberRole{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&project_member_role.MemberId, &project_member_role.ProjectId, &project_member_role.RoleId)
+	if err != nil {
+		return (*ProjectMemberRole)(nil), obj.makeErr(err)
+	}
+	return project_member_role, nil
+
+}
+
+func (obj *spannerImpl) All_ProjectMemberRole_By_ProjectId_OrderBy_Asc_MemberId(ctx context.Context,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+	rows []*ProjectMemberRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_member_roles.member_id, project_member_roles.project_id, project_member_roles.role_id FROM project_member_roles WHERE project_member_roles.project_id = ? ORDER BY project_member_roles.member_id")
+
+	var __values []any
+	__values = append(__values, project_member_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ProjectMemberRole, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				project_member_role := &ProjectMemberRole{}
+				err = __rows.Scan(&project_member_role.MemberId, &project_member_role.ProjectId, &project_member_role.RoleId)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, project_member_role)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
 func (obj *spannerImpl) Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 	bucket_metainfo_name BucketMetainfo_Name_Field) (
@@ -45720,6 +52700,58 @@ func (obj *spannerImpl) Limited_BucketMigration_By_State_OrderBy_Asc_CreatedAt(c
 
 }
 
+func (obj *spannerImpl) Get_BucketLimit_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_limit_project_id BucketLimit_ProjectId_Field,
+	bucket_limit_bucket_name BucketLimit_BucketName_Field) (
+	bucket_limit *BucketLimit, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_limits.project_id, bucket_limits.bucket_name, bucket_limits.storage_limit, bucket_limits.bandwidth_limit, bucket_limits.segment_limit, bucket_limits.updated_at FROM bucket_limits WHERE bucket_limits.project_id = ? AND bucket_limits.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_limit_project_id.value(), bucket_limit_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_limit = &BucketLimit{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_limit.ProjectId, &bucket_limit.BucketName, &bucket_limit.StorageLimit, &bucket_limit.BandwidthLimit, &bucket_limit.SegmentLimit, &bucket_limit.UpdatedAt)
+	if err != nil {
+		return (*BucketLimit)(nil), obj.makeErr(err)
+	}
+	return bucket_limit, nil
+
+}
+
+func (obj *spannerImpl) Get_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field) (
+	bucket_lifecycle_config *BucketLifecycleConfig, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name, bucket_lifecycle_configs.rules, bucket_lifecycle_configs.deletion_cursor, bucket_lifecycle_configs.created_at, bucket_lifecycle_configs.updated_at FROM bucket_lifecycle_configs WHERE bucket_lifecycle_configs.project_id = ? AND bucket_lifecycle_configs.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_lifecycle_config_project_id.value(), bucket_lifecycle_config_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_lifecycle_config = &BucketLifecycleConfig{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&bucket_lifecycle_config.ProjectId, &bucket_lifecycle_config.BucketName, &bucket_lifecycle_config.Rules, &bucket_lifecycle_config.DeletionCursor, &bucket_lifecycle_config.CreatedAt, &bucket_lifecycle_config.UpdatedAt)
+	if err != nil {
+		return (*BucketLifecycleConfig)(nil), obj.makeErr(err)
+	}
+	return bucket_lifecycle_config, nil
+
+}
+
 func (obj *spannerImpl) Get_RestApiKey_By_Id(ctx context.Context,
 	rest_api_key_id RestApiKey_Id_Field) (
 	rest_api_key *RestApiKey, err error) {
@@ -46693,6 +53725,293 @@ func (obj *spannerImpl) Get_UserSettings_By_UserId(ctx context.Context,
 
 }
 
+func (obj *spannerImpl) Get_ScimGroup_By_Id(ctx context.Context,
+	scim_group_id ScimGroup_Id_Field) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_groups.id, scim_groups.provider, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at FROM scim_groups WHERE scim_groups.id = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group = &ScimGroup{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.Provider, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt)
+	if err != nil {
+		return (*ScimGroup)(nil), obj.makeErr(err)
+	}
+	return scim_group, nil
+
+}
+
+func (obj *spannerImpl) Get_ScimGroup_By_Provider_And_DisplayName(ctx context.Context,
+	scim_group_provider ScimGroup_Provider_Field,
+	scim_group_display_name ScimGroup_DisplayName_Field) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_groups.id, scim_groups.provider, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at FROM scim_groups WHERE scim_groups.provider = ? AND scim_groups.display_name = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_provider.value(), scim_group_display_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group = &ScimGroup{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.Provider, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt)
+	if err != nil {
+		return (*ScimGroup)(nil), obj.makeErr(err)
+	}
+	return scim_group, nil
+
+}
+
+func (obj *spannerImpl) First_ScimGroup_By_Provider_And_ExternalId(ctx context.Context,
+	scim_group_provider ScimGroup_Provider_Field,
+	scim_group_external_id ScimGroup_ExternalId_Field) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_groups.id, scim_groups.provider, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at FROM scim_groups WHERE scim_groups.provider = ? AND scim_groups.external_id = ? LIMIT 1 OFFSET 0")
+
+	var __values []any
+	__values = append(__values, scim_group_provider.value(), scim_group_external_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		scim_group, err = func() (scim_group *ScimGroup, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			if !__rows.Next() {
+				return nil, nil
+			}
+
+			scim_group = &ScimGroup{}
+			err = __rows.Scan(&scim_group.Id, &scim_group.Provider, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt)
+			if err != nil {
+				return nil, err
+			}
+
+			return scim_group, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return scim_group, nil
+	}
+
+}
+
+func (obj *spannerImpl) Count_ScimGroup_By_Provider(ctx context.Context,
+	scim_group_provider ScimGroup_Provider_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT COUNT(*) FROM scim_groups WHERE scim_groups.provider = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_provider.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&count)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *spannerImpl) Limited_ScimGroup_By_Provider_OrderBy_Asc_DisplayName(ctx context.Context,
+	scim_group_provider ScimGroup_Provider_Field,
+	limit int, offset int64) (
+	rows []*ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_groups.id, scim_groups.provider, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at FROM scim_groups WHERE scim_groups.provider = ? ORDER BY scim_groups.display_name LIMIT ? OFFSET ?")
+
+	var __values []any
+	__values = append(__values, scim_group_provider.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ScimGroup, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				scim_group := &ScimGroup{}
+				err = __rows.Scan(&scim_group.Id, &scim_group.Provider, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, scim_group)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *spannerImpl) All_ScimGroupMember_By_GroupId_OrderBy_Asc_UserId(ctx context.Context,
+	scim_group_member_group_id ScimGroupMember_GroupId_Field) (
+	rows []*ScimGroupMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT scim_group_members.group_id, scim_group_members.user_id FROM scim_group_members WHERE scim_group_members.group_id = ? ORDER BY scim_group_members.user_id")
+
+	var __values []any
+	__values = append(__values, scim_group_member_group_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*ScimGroupMember, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				scim_group_member := &ScimGroupMember{}
+				err = __rows.Scan(&scim_group_member.GroupId, &scim_group_member.UserId)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, scim_group_member)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
+func (obj *spannerImpl) Get_WebauthnCredential_By_CredentialId(ctx context.Context,
+	webauthn_credential_credential_id WebauthnCredential_CredentialId_Field) (
+	webauthn_credential *WebauthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT webauthn_credentials.id, webauthn_credentials.user_id, webauthn_credentials.credential_id, webauthn_credentials.public_key, webauthn_credentials.sign_count, webauthn_credentials.transports, webauthn_credentials.name, webauthn_credentials.created_at, webauthn_credentials.last_used_at FROM webauthn_credentials WHERE webauthn_credentials.credential_id = ?")
+
+	var __values []any
+	__values = append(__values, webauthn_credential_credential_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	webauthn_credential = &WebauthnCredential{}
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&webauthn_credential.Id, &webauthn_credential.UserId, &webauthn_credential.CredentialId, &webauthn_credential.PublicKey, &webauthn_credential.SignCount, &webauthn_credential.Transports, &webauthn_credential.Name, &webauthn_credential.CreatedAt, &webauthn_credential.LastUsedAt)
+	if err != nil {
+		return (*WebauthnCredential)(nil), obj.makeErr(err)
+	}
+	return webauthn_credential, nil
+
+}
+
+func (obj *spannerImpl) All_WebauthnCredential_By_UserId_OrderBy_Asc_CreatedAt_Asc_Id(ctx context.Context,
+	webauthn_credential_user_id WebauthnCredential_UserId_Field) (
+	rows []*WebauthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT webauthn_credentials.id, webauthn_credentials.user_id, webauthn_credentials.credential_id, webauthn_credentials.public_key, webauthn_credentials.sign_count, webauthn_credentials.transports, webauthn_credentials.name, webauthn_credentials.created_at, webauthn_credentials.last_used_at FROM webauthn_credentials WHERE webauthn_credentials.user_id = ? ORDER BY webauthn_credentials.created_at, webauthn_credentials.id")
+
+	var __values []any
+	__values = append(__values, webauthn_credential_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	for {
+		rows, err = func() (rows []*WebauthnCredential, err error) {
+			__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+			if err != nil {
+				return nil, err
+			}
+			defer closeRows(__rows, &err)
+
+			for __rows.Next() {
+				webauthn_credential := &WebauthnCredential{}
+				err = __rows.Scan(&webauthn_credential.Id, &webauthn_credential.UserId, &webauthn_credential.CredentialId, &webauthn_credential.PublicKey, &webauthn_credential.SignCount, &webauthn_credential.Transports, &webauthn_credential.Name, &webauthn_credential.CreatedAt, &webauthn_credential.LastUsedAt)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, webauthn_credential)
+			}
+			return rows, nil
+		}()
+		if err != nil {
+			if obj.shouldRetry(err) {
+				continue
+			}
+			return nil, obj.makeErr(err)
+		}
+		return rows, nil
+	}
+
+}
+
 func (obj *spannerImpl) UpdateNoReturn_AccountingTimestamps_By_Name(ctx context.Context,
 	accounting_timestamps_name AccountingTimestamps_Name_Field,
 	update AccountingTimestamps_Update_Fields) (
@@ -47031,7 +54350,7 @@ func (obj *spannerImpl) Update_Node_By_Id(ctx context.Context,
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? THEN RETURN nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? THEN RETURN nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.asn, nodes.asn_org")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
@@ -47182,6 +54501,16 @@ func (obj *spannerImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
+	if update.AsnOrg._set {
+		__values = append(__values, update.AsnOrg.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn_org = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -47196,7 +54525,7 @@ func (obj *spannerImpl) Update_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features)
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.Asn, &node.AsnOrg)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
 	}
@@ -47368,186 +54697,206 @@ func (obj *spannerImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
-	__now := obj.db.Hooks.Now().UTC()
-
-	__values = append(__values, __now)
-	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
-
-	__args = append(__args, node_id.value())
-
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __values...)
-
-	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
-	if err != nil {
-		return obj.makeErr(err)
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
+	if update.AsnOrg._set {
+		__values = append(__values, update.AsnOrg.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn_org = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
+func (obj *spannerImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_ExitFinishedAt_Is_Null(ctx context.Context,
+	node_id Node_Id_Field,
+	update Node_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? AND nodes.disqualified is NULL AND nodes.exit_finished_at is NULL")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.Address._set {
+		__values = append(__values, update.Address.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("address = ?"))
+	}
+	if update.LastNet._set {
+		__values = append(__values, update.LastNet.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_net = ?"))
+	}
+	if update.LastIpPort._set {
+		__values = append(__values, update.LastIpPort.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_ip_port = ?"))
+	}
+	if update.CountryCode._set {
+		__values = append(__values, update.CountryCode.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
+	}
+	if update.Protocol._set {
+		__values = append(__values, update.Protocol.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
+	}
+	if update.Email._set {
+		__values = append(__values, update.Email.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("email = ?"))
+	}
+	if update.Wallet._set {
+		__values = append(__values, update.Wallet.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("wallet = ?"))
+	}
+	if update.WalletFeatures._set {
+		__values = append(__values, update.WalletFeatures.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("wallet_features = ?"))
+	}
+	if update.FreeDisk._set {
+		__values = append(__values, update.FreeDisk.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_disk = ?"))
+	}
+	if update.PieceCount._set {
+		__values = append(__values, update.PieceCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("piece_count = ?"))
+	}
+	if update.Major._set {
+		__values = append(__values, update.Major.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("major = ?"))
+	}
+	if update.Minor._set {
+		__values = append(__values, update.Minor.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("minor = ?"))
+	}
+	if update.Patch._set {
+		__values = append(__values, update.Patch.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("patch = ?"))
+	}
+	if update.CommitHash._set {
+		__values = append(__values, update.CommitHash.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("commit_hash = ?"))
+	}
+	if update.ReleaseTimestamp._set {
+		__values = append(__values, update.ReleaseTimestamp.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("release_timestamp = ?"))
+	}
+	if update.Release._set {
+		__values = append(__values, update.Release.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("release = ?"))
+	}
+	if update.Latency90._set {
+		__values = append(__values, update.Latency90.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("latency_90 = ?"))
+	}
+	if update.VettedAt._set {
+		__values = append(__values, update.VettedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("vetted_at = ?"))
+	}
+	if update.LastContactSuccess._set {
+		__values = append(__values, update.LastContactSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_success = ?"))
+	}
+	if update.LastContactFailure._set {
+		__values = append(__values, update.LastContactFailure.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_failure = ?"))
+	}
+	if update.Disqualified._set {
+		__values = append(__values, update.Disqualified.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
+	}
+	if update.DisqualificationReason._set {
+		__values = append(__values, update.DisqualificationReason.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualification_reason = ?"))
+	}
+	if update.UnknownAuditSuspended._set {
+		__values = append(__values, update.UnknownAuditSuspended.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_suspended = ?"))
+	}
+	if update.OfflineSuspended._set {
+		__values = append(__values, update.OfflineSuspended.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("offline_suspended = ?"))
+	}
+	if update.UnderReview._set {
+		__values = append(__values, update.UnderReview.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
+	}
+	if update.ExitInitiatedAt._set {
+		__values = append(__values, update.ExitInitiatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_initiated_at = ?"))
+	}
+	if update.ExitLoopCompletedAt._set {
+		__values = append(__values, update.ExitLoopCompletedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_loop_completed_at = ?"))
+	}
+	if update.ExitFinishedAt._set {
+		__values = append(__values, update.ExitFinishedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_finished_at = ?"))
+	}
+	if update.ExitSuccess._set {
+		__values = append(__values, update.ExitSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
+	}
+	if update.Contained._set {
+		__values = append(__values, update.Contained.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("contained = ?"))
+	}
+	if update.LastOfflineEmail._set {
+		__values = append(__values, update.LastOfflineEmail.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_offline_email = ?"))
+	}
+	if update.LastSoftwareUpdateEmail._set {
+		__values = append(__values, update.LastSoftwareUpdateEmail.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_software_update_email = ?"))
+	}
+	if update.NoiseProto._set {
+		__values = append(__values, update.NoiseProto.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("noise_proto = ?"))
+	}
+	if update.NoisePublicKey._set {
+		__values = append(__values, update.NoisePublicKey.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("noise_public_key = ?"))
+	}
+	if update.DebounceLimit._set {
+		__values = append(__values, update.DebounceLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("debounce_limit = ?"))
+	}
+	if update.Features._set {
+		__values = append(__values, update.Features.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
+	}
+
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
 	}
-	return nil
-}
 
-func (obj *spannerImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_ExitFinishedAt_Is_Null(ctx context.Context,
-	node_id Node_Id_Field,
-	update Node_Update_Fields) (
-	err error) {
-	defer mon.Task()(&ctx)(&err)
-	if !obj.txn && txutil.IsInsideTx(ctx) {
-		panic("using DB when inside of a transaction")
-	}
-
-	var __sets = &__sqlbundle_Hole{}
-
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? AND nodes.disqualified is NULL AND nodes.exit_finished_at is NULL")}}
-
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
-	var __values []any
-	var __args []any
-
-	if update.Address._set {
-		__values = append(__values, update.Address.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("address = ?"))
-	}
-	if update.LastNet._set {
-		__values = append(__values, update.LastNet.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_net = ?"))
-	}
-	if update.LastIpPort._set {
-		__values = append(__values, update.LastIpPort.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_ip_port = ?"))
-	}
-	if update.CountryCode._set {
-		__values = append(__values, update.CountryCode.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
-	}
-	if update.Protocol._set {
-		__values = append(__values, update.Protocol.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
-	}
-	if update.Email._set {
-		__values = append(__values, update.Email.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("email = ?"))
-	}
-	if update.Wallet._set {
-		__values = append(__values, update.Wallet.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("wallet = ?"))
-	}
-	if update.WalletFeatures._set {
-		__values = append(__values, update.WalletFeatures.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("wallet_features = ?"))
-	}
-	if update.FreeDisk._set {
-		__values = append(__values, update.FreeDisk.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_disk = ?"))
-	}
-	if update.PieceCount._set {
-		__values = append(__values, update.PieceCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("piece_count = ?"))
-	}
-	if update.Major._set {
-		__values = append(__values, update.Major.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("major = ?"))
-	}
-	if update.Minor._set {
-		__values = append(__values, update.Minor.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("minor = ?"))
-	}
-	if update.Patch._set {
-		__values = append(__values, update.Patch.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("patch = ?"))
-	}
-	if update.CommitHash._set {
-		__values = append(__values, update.CommitHash.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("commit_hash = ?"))
-	}
-	if update.ReleaseTimestamp._set {
-		__values = append(__values, update.ReleaseTimestamp.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("release_timestamp = ?"))
-	}
-	if update.Release._set {
-		__values = append(__values, update.Release.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("release = ?"))
-	}
-	if update.Latency90._set {
-		__values = append(__values, update.Latency90.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("latency_90 = ?"))
-	}
-	if update.VettedAt._set {
-		__values = append(__values, update.VettedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("vetted_at = ?"))
-	}
-	if update.LastContactSuccess._set {
-		__values = append(__values, update.LastContactSuccess.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_success = ?"))
-	}
-	if update.LastContactFailure._set {
-		__values = append(__values, update.LastContactFailure.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_failure = ?"))
-	}
-	if update.Disqualified._set {
-		__values = append(__values, update.Disqualified.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified = ?"))
-	}
-	if update.DisqualificationReason._set {
-		__values = append(__values, update.DisqualificationReason.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualification_reason = ?"))
-	}
-	if update.UnknownAuditSuspended._set {
-		__values = append(__values, update.UnknownAuditSuspended.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("unknown_audit_suspended = ?"))
-	}
-	if update.OfflineSuspended._set {
-		__values = append(__values, update.OfflineSuspended.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("offline_suspended = ?"))
-	}
-	if update.UnderReview._set {
-		__values = append(__values, update.UnderReview.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("under_review = ?"))
-	}
-	if update.ExitInitiatedAt._set {
-		__values = append(__values, update.ExitInitiatedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_initiated_at = ?"))
-	}
-	if update.ExitLoopCompletedAt._set {
-		__values = append(__values, update.ExitLoopCompletedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_loop_completed_at = ?"))
-	}
-	if update.ExitFinishedAt._set {
-		__values = append(__values, update.ExitFinishedAt.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_finished_at = ?"))
-	}
-	if update.ExitSuccess._set {
-		__values = append(__values, update.ExitSuccess.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
-	}
-	if update.Contained._set {
-		__values = append(__values, update.Contained.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("contained = ?"))
-	}
-	if update.LastOfflineEmail._set {
-		__values = append(__values, update.LastOfflineEmail.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_offline_email = ?"))
-	}
-	if update.LastSoftwareUpdateEmail._set {
-		__values = append(__values, update.LastSoftwareUpdateEmail.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_software_update_email = ?"))
-	}
-	if update.NoiseProto._set {
-		__values = append(__values, update.NoiseProto.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("noise_proto = ?"))
-	}
-	if update.NoisePublicKey._set {
-		__values = append(__values, update.NoisePublicKey.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("noise_public_key = ?"))
-	}
-	if update.DebounceLimit._set {
-		__values = append(__values, update.DebounceLimit.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("debounce_limit = ?"))
-	}
-	if update.Features._set {
-		__values = append(__values, update.Features.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
+	if update.AsnOrg._set {
+		__values = append(__values, update.AsnOrg.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn_org = ?"))
 	}
 
 	__now := obj.db.Hooks.Now().UTC()
@@ -48040,6 +55389,113 @@ func (obj *spannerImpl) UpdateNoReturn_OauthToken_By_Token_And_Kind(ctx context.
 	return nil
 }
 
+func (obj *spannerImpl) Update_Organization_By_Id(ctx context.Context,
+	organization_id Organization_Id_Field,
+	update Organization_Update_Fields) (
+	organization *Organization, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE organizations SET "), __sets, __sqlbundle_Literal(" WHERE organizations.id = ? THEN RETURN organizations.id, organizations.name, organizations.owner_id, organizations.project_limit, organizations.storage_limit, organizations.bandwidth_limit, organizations.segment_limit, organizations.created_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.Name._set {
+		__values = append(__values, update.Name.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
+	}
+	if update.ProjectLimit._set {
+		__values = append(__values, update.ProjectLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("project_limit = ?"))
+	}
+	if update.StorageLimit._set {
+		__values = append(__values, update.StorageLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("storage_limit = ?"))
+	}
+	if update.BandwidthLimit._set {
+		__values = append(__values, update.BandwidthLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("bandwidth_limit = ?"))
+	}
+	if update.SegmentLimit._set {
+		__values = append(__values, update.SegmentLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("segment_limit = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, organization_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	organization = &Organization{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&organization.Id, &organization.Name, &organization.OwnerId, &organization.ProjectLimit, &organization.StorageLimit, &organization.BandwidthLimit, &organization.SegmentLimit, &organization.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return organization, nil
+}
+
+func (obj *spannerImpl) Update_OrganizationMember_By_OrganizationId_And_MemberId(ctx context.Context,
+	organization_member_organization_id OrganizationMember_OrganizationId_Field,
+	organization_member_member_id OrganizationMember_MemberId_Field,
+	update OrganizationMember_Update_Fields) (
+	organization_member *OrganizationMember, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE organization_members SET "), __sets, __sqlbundle_Literal(" WHERE organization_members.organization_id = ? AND organization_members.member_id = ? THEN RETURN organization_members.organization_id, organization_members.member_id, organization_members.role, organization_members.created_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.Role._set {
+		__values = append(__values, update.Role.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("role = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, organization_member_organization_id.value(), organization_member_member_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	organization_member = &OrganizationMember{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&organization_member.OrganizationId, &organization_member.MemberId, &organization_member.Role, &organization_member.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return organization_member, nil
+}
+
 func (obj *spannerImpl) Update_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field,
 	update Project_Update_Fields) (
@@ -48338,6 +55794,55 @@ func (obj *spannerImpl) UpdateNoReturn_ApiKey_By_Id(ctx context.Context,
 	return nil
 }
 
+func (obj *spannerImpl) Update_ProjectRole_By_Id(ctx context.Context,
+	project_role_id ProjectRole_Id_Field,
+	update ProjectRole_Update_Fields) (
+	project_role *ProjectRole, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_roles SET "), __sets, __sqlbundle_Literal(" WHERE project_roles.id = ? THEN RETURN project_roles.id, project_roles.project_id, project_roles.name, project_roles.permissions, project_roles.created_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.Name._set {
+		__values = append(__values, update.Name.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
+	}
+	if update.Permissions._set {
+		__values = append(__values, update.Permissions.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("permissions = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_role_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_role = &ProjectRole{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&project_role.Id, &project_role.ProjectId, &project_role.Name, &project_role.Permissions, &project_role.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_role, nil
+}
+
 func (obj *spannerImpl) Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 	bucket_metainfo_name BucketMetainfo_Name_Field,
@@ -48779,6 +56284,60 @@ func (obj *spannerImpl) Update_BucketMigration_By_Id(ctx context.Context,
 	return bucket_migration, nil
 }
 
+func (obj *spannerImpl) Update_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field,
+	update BucketLifecycleConfig_Update_Fields) (
+	bucket_lifecycle_config *BucketLifecycleConfig, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE bucket_lifecycle_configs SET "), __sets, __sqlbundle_Literal(" WHERE bucket_lifecycle_configs.project_id = ? AND bucket_lifecycle_configs.bucket_name = ? THEN RETURN bucket_lifecycle_configs.project_id, bucket_lifecycle_configs.bucket_name, bucket_lifecycle_configs.rules, bucket_lifecycle_configs.deletion_cursor, bucket_lifecycle_configs.created_at, bucket_lifecycle_configs.updated_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.Rules._set {
+		__values = append(__values, update.Rules.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("rules = ?"))
+	}
+	if update.DeletionCursor._set {
+		__values = append(__values, update.DeletionCursor.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("deletion_cursor = ?"))
+	}
+	if update.UpdatedAt._set {
+		__values = append(__values, update.UpdatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, bucket_lifecycle_config_project_id.value(), bucket_lifecycle_config_bucket_name.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bucket_lifecycle_config = &BucketLifecycleConfig{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&bucket_lifecycle_config.ProjectId, &bucket_lifecycle_config.BucketName, &bucket_lifecycle_config.Rules, &bucket_lifecycle_config.DeletionCursor, &bucket_lifecycle_config.CreatedAt, &bucket_lifecycle_config.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_lifecycle_config, nil
+}
+
 func (obj *spannerImpl) Update_User_By_Id(ctx context.Context,
 	user_id User_Id_Field,
 	update User_Update_Fields) (
@@ -49189,6 +56748,170 @@ func (obj *spannerImpl) Update_UserSettings_By_UserId(ctx context.Context,
 	return user_settings, nil
 }
 
+func (obj *spannerImpl) Update_ScimGroup_By_Id(ctx context.Context,
+	scim_group_id ScimGroup_Id_Field,
+	update ScimGroup_Update_Fields) (
+	scim_group *ScimGroup, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE scim_groups SET "), __sets, __sqlbundle_Literal(" WHERE scim_groups.id = ? THEN RETURN scim_groups.id, scim_groups.provider, scim_groups.display_name, scim_groups.external_id, scim_groups.project_id, scim_groups.role, scim_groups.created_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.DisplayName._set {
+		__values = append(__values, update.DisplayName.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("display_name = ?"))
+	}
+	if update.ExternalId._set {
+		__values = append(__values, update.ExternalId.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("external_id = ?"))
+	}
+	if update.ProjectId._set {
+		__values = append(__values, update.ProjectId.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("project_id = ?"))
+	}
+	if update.Role._set {
+		__values = append(__values, update.Role.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("role = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, scim_group_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	scim_group = &ScimGroup{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&scim_group.Id, &scim_group.Provider, &scim_group.DisplayName, &scim_group.ExternalId, &scim_group.ProjectId, &scim_group.Role, &scim_group.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return scim_group, nil
+}
+
+func (obj *spannerImpl) Update_WebauthnCredential_By_Id(ctx context.Context,
+	webauthn_credential_id WebauthnCredential_Id_Field,
+	update WebauthnCredential_Update_Fields) (
+	webauthn_credential *WebauthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE webauthn_credentials SET "), __sets, __sqlbundle_Literal(" WHERE webauthn_credentials.id = ? THEN RETURN webauthn_credentials.id, webauthn_credentials.user_id, webauthn_credentials.credential_id, webauthn_credentials.public_key, webauthn_credentials.sign_count, webauthn_credentials.transports, webauthn_credentials.name, webauthn_credentials.created_at, webauthn_credentials.last_used_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.SignCount._set {
+		__values = append(__values, update.SignCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("sign_count = ?"))
+	}
+	if update.Name._set {
+		__values = append(__values, update.Name.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
+	}
+	if update.LastUsedAt._set {
+		__values = append(__values, update.LastUsedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_used_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, webauthn_credential_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	webauthn_credential = &WebauthnCredential{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&webauthn_credential.Id, &webauthn_credential.UserId, &webauthn_credential.CredentialId, &webauthn_credential.PublicKey, &webauthn_credential.SignCount, &webauthn_credential.Transports, &webauthn_credential.Name, &webauthn_credential.CreatedAt, &webauthn_credential.LastUsedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return webauthn_credential, nil
+}
+
+func (obj *spannerImpl) Update_WebauthnCredential_By_Id_And_UserId(ctx context.Context,
+	webauthn_credential_id WebauthnCredential_Id_Field,
+	webauthn_credential_user_id WebauthnCredential_UserId_Field,
+	update WebauthnCredential_Update_Fields) (
+	webauthn_credential *WebauthnCredential, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE webauthn_credentials SET "), __sets, __sqlbundle_Literal(" WHERE webauthn_credentials.id = ? AND webauthn_credentials.user_id = ? THEN RETURN webauthn_credentials.id, webauthn_credentials.user_id, webauthn_credentials.credential_id, webauthn_credentials.public_key, webauthn_credentials.sign_count, webauthn_credentials.transports, webauthn_credentials.name, webauthn_credentials.created_at, webauthn_credentials.last_used_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []any
+	var __args []any
+
+	if update.SignCount._set {
+		__values = append(__values, update.SignCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("sign_count = ?"))
+	}
+	if update.Name._set {
+		__values = append(__values, update.Name.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
+	}
+	if update.LastUsedAt._set {
+		__values = append(__values, update.LastUsedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_used_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, webauthn_credential_id.value(), webauthn_credential_user_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	webauthn_credential = &WebauthnCredential{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&webauthn_credential.Id, &webauthn_credential.UserId, &webauthn_credential.CredentialId, &webauthn_credential.PublicKey, &webauthn_credential.SignCount, &webauthn_credential.Transports, &webauthn_credential.Name, &webauthn_credential.CreatedAt, &webauthn_credential.LastUsedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return webauthn_credential, nil
+}
+
 func (obj *spannerImpl) Delete_StoragenodeStorageTally_By_IntervalEndTime_Less(ctx context.Context,
 	storagenode_storage_tally_interval_end_time_less StoragenodeStorageTally_IntervalEndTime_Field) (
 	count int64, err error) {
@@ -49311,6 +57034,36 @@ func (obj *spannerImpl) Delete_StorjscanPayment_By_Status(ctx context.Context,
 
 }
 
+func (obj *spannerImpl) Delete_AdminApprovalRequest_By_Id(ctx context.Context,
+	admin_approval_request_id AdminApprovalRequest_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM admin_approval_requests WHERE admin_approval_requests.id = ?")
+
+	var __values []any
+	__values = append(__values, admin_approval_request_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *spannerImpl) Delete_Domain_By_ProjectId_And_Subdomain(ctx context.Context,
 	domain_project_id Domain_ProjectId_Field,
 	domain_subdomain Domain_Subdomain_Field) (
@@ -49462,6 +57215,67 @@ func (obj *spannerImpl) Delete_OauthClient_By_Id(ctx context.Context,
 
 }
 
+func (obj *spannerImpl) Delete_Organization_By_Id(ctx context.Context,
+	organization_id Organization_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM organizations WHERE organizations.id = ?")
+
+	var __values []any
+	__values = append(__values, organization_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *spannerImpl) Delete_OrganizationMember_By_OrganizationId_And_MemberId(ctx context.Context,
+	organization_member_organization_id OrganizationMember_OrganizationId_Field,
+	organization_member_member_id OrganizationMember_MemberId_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM organization_members WHERE organization_members.organization_id = ? AND organization_members.member_id = ?")
+
+	var __values []any
+	__values = append(__values, organization_member_organization_id.value(), organization_member_member_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *spannerImpl) Delete_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field) (
 	deleted bool, err error) {
@@ -49651,6 +57465,67 @@ func (obj *spannerImpl) Delete_ApiKey_By_ProjectId_And_CreatedBy(ctx context.Con
 
 }
 
+func (obj *spannerImpl) Delete_ProjectRole_By_Id(ctx context.Context,
+	project_role_id ProjectRole_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_roles WHERE project_roles.id = ?")
+
+	var __values []any
+	__values = append(__values, project_role_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *spannerImpl) Delete_ProjectMemberRole_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_role_member_id ProjectMemberRole_MemberId_Field,
+	project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_member_roles WHERE project_member_roles.member_id = ? AND project_member_roles.project_id = ?")
+
+	var __values []any
+	__values = append(__values, project_member_role_member_id.value(), project_member_role_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *spannerImpl) Delete_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 	bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 	bucket_metainfo_name BucketMetainfo_Name_Field) (
@@ -49743,6 +57618,68 @@ func (obj *spannerImpl) Delete_BucketMigration_By_Id(ctx context.Context,
 
 }
 
+func (obj *spannerImpl) Delete_BucketLimit_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_limit_project_id BucketLimit_ProjectId_Field,
+	bucket_limit_bucket_name BucketLimit_BucketName_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_limits WHERE bucket_limits.project_id = ? AND bucket_limits.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_limit_project_id.value(), bucket_limit_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *spannerImpl) Delete_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx context.Context,
+	bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+	bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM bucket_lifecycle_configs WHERE bucket_lifecycle_configs.project_id = ? AND bucket_lifecycle_configs.bucket_name = ?")
+
+	var __values []any
+	__values = append(__values, bucket_lifecycle_config_project_id.value(), bucket_lifecycle_config_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (obj *spannerImpl) Delete_RepairQueue_By_UpdatedAt_Less(ctx context.Context,
 	repair_queue_updated_at_less RepairQueue_UpdatedAt_Field) (
 	count int64, err error) {
@@ -50015,6 +57952,158 @@ func (obj *spannerImpl) Delete_AccountFreezeEvent_By_UserId_And_Event(ctx contex
 
 }
 
+func (obj *spannerImpl) Delete_ScimGroup_By_Id(ctx context.Context,
+	scim_group_id ScimGroup_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM scim_groups WHERE scim_groups.id = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *spannerImpl) Delete_ScimGroupMember_By_GroupId_And_UserId(ctx context.Context,
+	scim_group_member_group_id ScimGroupMember_GroupId_Field,
+	scim_group_member_user_id ScimGroupMember_UserId_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM scim_group_members WHERE scim_group_members.group_id = ? AND scim_group_members.user_id = ?")
+
+	var __values []any
+	__values = append(__values, scim_group_member_group_id.value(), scim_group_member_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *spannerImpl) Delete_WebauthnCredential_By_Id_And_UserId(ctx context.Context,
+	webauthn_credential_id WebauthnCredential_Id_Field,
+	webauthn_credential_user_id WebauthnCredential_UserId_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM webauthn_credentials WHERE webauthn_credentials.id = ? AND webauthn_credentials.user_id = ?")
+
+	var __values []any
+	__values = append(__values, webauthn_credential_id.value(), webauthn_credential_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *spannerImpl) Delete_WebauthnCredential_By_UserId(ctx context.Context,
+	webauthn_credential_user_id WebauthnCredential_UserId_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM webauthn_credentials WHERE webauthn_credentials.user_id = ?")
+
+	var __values []any
+	__values = append(__values, webauthn_credential_user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *spannerImpl) Delete_WebauthnUsedChallenge_By_ExpiresAt_Less(ctx context.Context,
+	webauthn_used_challenge_expires_at_less WebauthnUsedChallenge_ExpiresAt_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !obj.txn && txutil.IsInsideTx(ctx) {
+		panic("using DB when inside of a transaction")
+	}
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM webauthn_used_challenges WHERE webauthn_used_challenges.expires_at < ?")
+
+	var __values []any
+	__values = append(__values, webauthn_used_challenge_expires_at_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
 func (impl spannerImpl) isConstraintError(err error) (constraint string, ok bool) {
 	errcode := spanner.ErrCode(err)
 	return "", errcode == codes.AlreadyExists || errcode == codes.OutOfRange || errcode == codes.FailedPrecondition
@@ -50027,11 +58116,31 @@ func (obj *spannerImpl) deleteAll(ctx context.Context) (count int64, err error)
 	}
 	var __res sql.Result
 	var __count int64
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_member_roles;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
 	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM api_key_tails;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM webauthn_credentials;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -50042,6 +58151,16 @@ func (obj *spannerImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM scim_group_members;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -50052,6 +58171,16 @@ func (obj *spannerImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_roles;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -50072,6 +58201,26 @@ func (obj *spannerImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM organization_projects;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM organization_members;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -50112,6 +58261,16 @@ func (obj *spannerImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM webauthn_used_challenges;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -50272,6 +58431,16 @@ func (obj *spannerImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM scim_groups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -50382,6 +58551,16 @@ func (obj *spannerImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM organizations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -50492,6 +58671,26 @@ func (obj *spannerImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_limits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_lifecycle_configs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -50532,6 +58731,16 @@ func (obj *spannerImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM admin_approval_requests;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -50577,6 +58786,9 @@ type Methods interface {
 		account_freeze_event_user_id AccountFreezeEvent_UserId_Field) (
 		rows []*AccountFreezeEvent, err error)
 
+	All_AdminApprovalRequest_OrderBy_Asc_CreatedAt(ctx context.Context) (
+		rows []*AdminApprovalRequest, err error)
+
 	All_BillingTransaction_By_UserId_And_Source_OrderBy_Desc_TxTimestamp(ctx context.Context,
 		billing_transaction_user_id BillingTransaction_UserId_Field,
 		billing_transaction_source BillingTransaction_Source_Field) (
@@ -50645,6 +58857,10 @@ type Methods interface {
 	All_Node_Id_Node_PieceCount_By_Disqualified_Is_Null_And_ExitInitiatedAt_Is_Null_And_ExitFinishedAt_Is_Null(ctx context.Context) (
 		rows []*Id_PieceCount_Row, err error)
 
+	All_OrganizationProject_By_OrganizationId_OrderBy_Asc_CreatedAt_Asc_ProjectId(ctx context.Context,
+		organization_project_organization_id OrganizationProject_OrganizationId_Field) (
+		rows []*OrganizationProject, err error)
+
 	All_Project(ctx context.Context) (
 		rows []*Project, err error)
 
@@ -50667,10 +58883,18 @@ type Methods interface {
 		user_tenant_id User_TenantId_Field) (
 		rows []*ProjectInvitation, err error)
 
+	All_ProjectMemberRole_By_ProjectId_OrderBy_Asc_MemberId(ctx context.Context,
+		project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+		rows []*ProjectMemberRole, err error)
+
 	All_ProjectMember_By_MemberId(ctx context.Context,
 		project_member_member_id ProjectMember_MemberId_Field) (
 		rows []*ProjectMember, err error)
 
+	All_ProjectRole_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
+		project_role_project_id ProjectRole_ProjectId_Field) (
+		rows []*ProjectRole, err error)
+
 	All_Project_By_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
 		project_created_at_less Project_CreatedAt_Field) (
 		rows []*Project, err error)
@@ -50692,6 +58916,10 @@ type Methods interface {
 		rest_api_key_user_id RestApiKey_UserId_Field) (
 		rows []*RestApiKey, err error)
 
+	All_ScimGroupMember_By_GroupId_OrderBy_Asc_UserId(ctx context.Context,
+		scim_group_member_group_id ScimGroupMember_GroupId_Field) (
+		rows []*ScimGroupMember, err error)
+
 	All_StoragenodeBandwidthRollup_By_StoragenodeId_And_IntervalStart(ctx context.Context,
 		storagenode_bandwidth_rollup_storagenode_id StoragenodeBandwidthRollup_StoragenodeId_Field,
 		storagenode_bandwidth_rollup_interval_start StoragenodeBandwidthRollup_IntervalStart_Field) (
@@ -50739,6 +58967,10 @@ type Methods interface {
 		webapp_session_user_id WebappSession_UserId_Field) (
 		rows []*WebappSession, err error)
 
+	All_WebauthnCredential_By_UserId_OrderBy_Asc_CreatedAt_Asc_Id(ctx context.Context,
+		webauthn_credential_user_id WebauthnCredential_UserId_Field) (
+		rows []*WebauthnCredential, err error)
+
 	Count_BucketMetainfo_Name_By_ProjectId(ctx context.Context,
 		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field) (
 		count int64, err error)
@@ -50747,6 +58979,10 @@ type Methods interface {
 		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field) (
 		count int64, err error)
 
+	Count_ScimGroup_By_Provider(ctx context.Context,
+		scim_group_provider ScimGroup_Provider_Field) (
+		count int64, err error)
+
 	Count_User_By_Status(ctx context.Context,
 		user_status User_Status_Field) (
 		count int64, err error)
@@ -50838,6 +59074,19 @@ type Methods interface {
 		optional UserSettings_Create_Fields) (
 		err error)
 
+	Create_AdminApprovalRequest(ctx context.Context,
+		admin_approval_request_id AdminApprovalRequest_Id_Field,
+		admin_approval_request_operation AdminApprovalRequest_Operation_Field,
+		admin_approval_request_item_type AdminApprovalRequest_ItemType_Field,
+		admin_approval_request_user_id AdminApprovalRequest_UserId_Field,
+		admin_approval_request_requested_by AdminApprovalRequest_RequestedBy_Field,
+		admin_approval_request_reason AdminApprovalRequest_Reason_Field,
+		admin_approval_request_details AdminApprovalRequest_Details_Field,
+		admin_approval_request_payload AdminApprovalRequest_Payload_Field,
+		admin_approval_request_expires_at AdminApprovalRequest_ExpiresAt_Field,
+		optional AdminApprovalRequest_Create_Fields) (
+		admin_approval_request *AdminApprovalRequest, err error)
+
 	Create_ApiKey(ctx context.Context,
 		api_key_id ApiKey_Id_Field,
 		api_key_project_id ApiKey_ProjectId_Field,
@@ -50859,6 +59108,13 @@ type Methods interface {
 		billing_transaction_tx_timestamp BillingTransaction_TxTimestamp_Field) (
 		billing_transaction *BillingTransaction, err error)
 
+	Create_BucketLifecycleConfig(ctx context.Context,
+		bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+		bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field,
+		bucket_lifecycle_config_rules BucketLifecycleConfig_Rules_Field,
+		optional BucketLifecycleConfig_Create_Fields) (
+		bucket_lifecycle_config *BucketLifecycleConfig, err error)
+
 	Create_BucketMetainfo(ctx context.Context,
 		bucket_metainfo_id BucketMetainfo_Id_Field,
 		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
@@ -50925,6 +59181,24 @@ type Methods interface {
 		optional NodeEvent_Create_Fields) (
 		node_event *NodeEvent, err error)
 
+	Create_Organization(ctx context.Context,
+		organization_id Organization_Id_Field,
+		organization_name Organization_Name_Field,
+		organization_owner_id Organization_OwnerId_Field,
+		optional Organization_Create_Fields) (
+		organization *Organization, err error)
+
+	Create_OrganizationMember(ctx context.Context,
+		organization_member_organization_id OrganizationMember_OrganizationId_Field,
+		organization_member_member_id OrganizationMember_MemberId_Field,
+		organization_member_role OrganizationMember_Role_Field) (
+		organization_member *OrganizationMember, err error)
+
+	Create_OrganizationProject(ctx context.Context,
+		organization_project_project_id OrganizationProject_ProjectId_Field,
+		organization_project_organization_id OrganizationProject_OrganizationId_Field) (
+		organization_project *OrganizationProject, err error)
+
 	Create_Project(ctx context.Context,
 		project_id Project_Id_Field,
 		project_name Project_Name_Field,
@@ -50946,6 +59220,13 @@ type Methods interface {
 		optional ProjectMember_Create_Fields) (
 		project_member *ProjectMember, err error)
 
+	Create_ProjectRole(ctx context.Context,
+		project_role_id ProjectRole_Id_Field,
+		project_role_project_id ProjectRole_ProjectId_Field,
+		project_role_name ProjectRole_Name_Field,
+		project_role_permissions ProjectRole_Permissions_Field) (
+		project_role *ProjectRole, err error)
+
 	Create_RegistrationToken(ctx context.Context,
 		registration_token_secret RegistrationToken_Secret_Field,
 		registration_token_project_limit RegistrationToken_ProjectLimit_Field,
@@ -50971,6 +59252,14 @@ type Methods interface {
 		optional ReverificationAudits_Create_Fields) (
 		reverification_audits *ReverificationAudits, err error)
 
+	Create_ScimGroup(ctx context.Context,
+		scim_group_id ScimGroup_Id_Field,
+		scim_group_provider ScimGroup_Provider_Field,
+		scim_group_display_name ScimGroup_DisplayName_Field,
+		scim_group_role ScimGroup_Role_Field,
+		optional ScimGroup_Create_Fields) (
+		scim_group *ScimGroup, err error)
+
 	Create_StoragenodeBandwidthRollup(ctx context.Context,
 		storagenode_bandwidth_rollup_storagenode_id StoragenodeBandwidthRollup_StoragenodeId_Field,
 		storagenode_bandwidth_rollup_interval_start StoragenodeBandwidthRollup_IntervalStart_Field,
@@ -51025,6 +59314,22 @@ type Methods interface {
 		webapp_session_expires_at WebappSession_ExpiresAt_Field) (
 		webapp_session *WebappSession, err error)
 
+	Create_WebauthnCredential(ctx context.Context,
+		webauthn_credential_id WebauthnCredential_Id_Field,
+		webauthn_credential_user_id WebauthnCredential_UserId_Field,
+		webauthn_credential_credential_id WebauthnCredential_CredentialId_Field,
+		webauthn_credential_public_key WebauthnCredential_PublicKey_Field,
+		webauthn_credential_sign_count WebauthnCredential_SignCount_Field,
+		webauthn_credential_transports WebauthnCredential_Transports_Field,
+		webauthn_credential_name WebauthnCredential_Name_Field,
+		optional WebauthnCredential_Create_Fields) (
+		webauthn_credential *WebauthnCredential, err error)
+
+	Create_WebauthnUsedChallenge(ctx context.Context,
+		webauthn_used_challenge_challenge WebauthnUsedChallenge_Challenge_Field,
+		webauthn_used_challenge_expires_at WebauthnUsedChallenge_ExpiresAt_Field) (
+		webauthn_used_challenge *WebauthnUsedChallenge, err error)
+
 	Delete_AccountFreezeEvent_By_UserId(ctx context.Context,
 		account_freeze_event_user_id AccountFreezeEvent_UserId_Field) (
 		count int64, err error)
@@ -51034,6 +59339,10 @@ type Methods interface {
 		account_freeze_event_event AccountFreezeEvent_Event_Field) (
 		deleted bool, err error)
 
+	Delete_AdminApprovalRequest_By_Id(ctx context.Context,
+		admin_approval_request_id AdminApprovalRequest_Id_Field) (
+		deleted bool, err error)
+
 	Delete_ApiKey_By_Id(ctx context.Context,
 		api_key_id ApiKey_Id_Field) (
 		deleted bool, err error)
@@ -51047,6 +59356,16 @@ type Methods interface {
 		api_key_created_by ApiKey_CreatedBy_Field) (
 		count int64, err error)
 
+	Delete_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+		bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field) (
+		deleted bool, err error)
+
+	Delete_BucketLimit_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_limit_project_id BucketLimit_ProjectId_Field,
+		bucket_limit_bucket_name BucketLimit_BucketName_Field) (
+		deleted bool, err error)
+
 	Delete_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 		bucket_metainfo_name BucketMetainfo_Name_Field) (
@@ -51081,16 +59400,34 @@ type Methods interface {
 		oauth_client_id OauthClient_Id_Field) (
 		deleted bool, err error)
 
+	Delete_OrganizationMember_By_OrganizationId_And_MemberId(ctx context.Context,
+		organization_member_organization_id OrganizationMember_OrganizationId_Field,
+		organization_member_member_id OrganizationMember_MemberId_Field) (
+		deleted bool, err error)
+
+	Delete_Organization_By_Id(ctx context.Context,
+		organization_id Organization_Id_Field) (
+		deleted bool, err error)
+
 	Delete_ProjectInvitation_By_ProjectId_And_Email(ctx context.Context,
 		project_invitation_project_id ProjectInvitation_ProjectId_Field,
 		project_invitation_email ProjectInvitation_Email_Field) (
 		deleted bool, err error)
 
+	Delete_ProjectMemberRole_By_MemberId_And_ProjectId(ctx context.Context,
+		project_member_role_member_id ProjectMemberRole_MemberId_Field,
+		project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+		deleted bool, err error)
+
 	Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
 		project_member_member_id ProjectMember_MemberId_Field,
 		project_member_project_id ProjectMember_ProjectId_Field) (
 		deleted bool, err error)
 
+	Delete_ProjectRole_By_Id(ctx context.Context,
+		project_role_id ProjectRole_Id_Field) (
+		deleted bool, err error)
+
 	Delete_Project_By_Id(ctx context.Context,
 		project_id Project_Id_Field) (
 		deleted bool, err error)
@@ -51113,6 +59450,15 @@ type Methods interface {
 		reverification_audits_position ReverificationAudits_Position_Field) (
 		deleted bool, err error)
 
+	Delete_ScimGroupMember_By_GroupId_And_UserId(ctx context.Context,
+		scim_group_member_group_id ScimGroupMember_GroupId_Field,
+		scim_group_member_user_id ScimGroupMember_UserId_Field) (
+		deleted bool, err error)
+
+	Delete_ScimGroup_By_Id(ctx context.Context,
+		scim_group_id ScimGroup_Id_Field) (
+		deleted bool, err error)
+
 	Delete_StoragenodeStorageTally_By_IntervalEndTime_Less(ctx context.Context,
 		storagenode_storage_tally_interval_end_time_less StoragenodeStorageTally_IntervalEndTime_Field) (
 		count int64, err error)
@@ -51143,6 +59489,19 @@ type Methods interface {
 		webapp_session_id_not WebappSession_Id_Field) (
 		count int64, err error)
 
+	Delete_WebauthnCredential_By_Id_And_UserId(ctx context.Context,
+		webauthn_credential_id WebauthnCredential_Id_Field,
+		webauthn_credential_user_id WebauthnCredential_UserId_Field) (
+		deleted bool, err error)
+
+	Delete_WebauthnCredential_By_UserId(ctx context.Context,
+		webauthn_credential_user_id WebauthnCredential_UserId_Field) (
+		count int64, err error)
+
+	Delete_WebauthnUsedChallenge_By_ExpiresAt_Less(ctx context.Context,
+		webauthn_used_challenge_expires_at_less WebauthnUsedChallenge_ExpiresAt_Field) (
+		count int64, err error)
+
 	Find_AccountingTimestamps_Value_By_Name(ctx context.Context,
 		accounting_timestamps_name AccountingTimestamps_Name_Field) (
 		row *Value_Row, err error)
@@ -51161,6 +59520,11 @@ type Methods interface {
 		reverification_audits_node_id ReverificationAudits_NodeId_Field) (
 		reverification_audits *ReverificationAudits, err error)
 
+	First_ScimGroup_By_Provider_And_ExternalId(ctx context.Context,
+		scim_group_provider ScimGroup_Provider_Field,
+		scim_group_external_id ScimGroup_ExternalId_Field) (
+		scim_group *ScimGroup, err error)
+
 	First_StoragenodeStorageTally_IntervalEndTime_OrderBy_Asc_IntervalEndTime(ctx context.Context) (
 		row *IntervalEndTime_Row, err error)
 
@@ -51174,6 +59538,10 @@ type Methods interface {
 		account_freeze_event_event AccountFreezeEvent_Event_Field) (
 		account_freeze_event *AccountFreezeEvent, err error)
 
+	Get_AdminApprovalRequest_By_Id(ctx context.Context,
+		admin_approval_request_id AdminApprovalRequest_Id_Field) (
+		admin_approval_request *AdminApprovalRequest, err error)
+
 	Get_ApiKeyTail_By_Tail(ctx context.Context,
 		api_key_tail_tail ApiKeyTail_Tail_Field) (
 		api_key_tail *ApiKeyTail, err error)
@@ -51208,6 +59576,16 @@ type Methods interface {
 		bucket_metainfo_name BucketMetainfo_Name_Field) (
 		row *Id_CreatedBy_UserAgent_CreatedAt_Placement_Versioning_ObjectLockEnabled_DefaultRetentionMode_DefaultRetentionDays_DefaultRetentionYears_Row, err error)
 
+	Get_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+		bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field) (
+		bucket_lifecycle_config *BucketLifecycleConfig, err error)
+
+	Get_BucketLimit_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_limit_project_id BucketLimit_ProjectId_Field,
+		bucket_limit_bucket_name BucketLimit_BucketName_Field) (
+		bucket_limit *BucketLimit, err error)
+
 	Get_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 		bucket_metainfo_name BucketMetainfo_Name_Field) (
@@ -51282,6 +59660,19 @@ type Methods interface {
 		oauth_token_token OauthToken_Token_Field) (
 		oauth_token *OauthToken, err error)
 
+	Get_OrganizationMember_By_OrganizationId_And_MemberId(ctx context.Context,
+		organization_member_organization_id OrganizationMember_OrganizationId_Field,
+		organization_member_member_id OrganizationMember_MemberId_Field) (
+		organization_member *OrganizationMember, err error)
+
+	Get_OrganizationProject_By_ProjectId(ctx context.Context,
+		organization_project_project_id OrganizationProject_ProjectId_Field) (
+		organization_project *OrganizationProject, err error)
+
+	Get_Organization_By_Id(ctx context.Context,
+		organization_id Organization_Id_Field) (
+		organization *Organization, err error)
+
 	Get_PeerIdentity_By_NodeId(ctx context.Context,
 		peer_identity_node_id PeerIdentity_NodeId_Field) (
 		peer_identity *PeerIdentity, err error)
@@ -51299,11 +59690,20 @@ type Methods interface {
 		project_limit_event_id ProjectLimitEvent_Id_Field) (
 		project_limit_event *ProjectLimitEvent, err error)
 
+	Get_ProjectMemberRole_By_MemberId_And_ProjectId(ctx context.Context,
+		project_member_role_member_id ProjectMemberRole_MemberId_Field,
+		project_member_role_project_id ProjectMemberRole_ProjectId_Field) (
+		project_member_role *ProjectMemberRole, err error)
+
 	Get_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
 		project_member_member_id ProjectMember_MemberId_Field,
 		project_member_project_id ProjectMember_ProjectId_Field) (
 		project_member *ProjectMember, err error)
 
+	Get_ProjectRole_By_Id(ctx context.Context,
+		project_role_id ProjectRole_Id_Field) (
+		project_role *ProjectRole, err error)
+
 	Get_Project_BandwidthLimit_By_Id(ctx context.Context,
 		project_id Project_Id_Field) (
 		row *BandwidthLimit_Row, err error)
@@ -51393,6 +59793,15 @@ type Methods interface {
 		rest_api_key_token RestApiKey_Token_Field) (
 		rest_api_key *RestApiKey, err error)
 
+	Get_ScimGroup_By_Id(ctx context.Context,
+		scim_group_id ScimGroup_Id_Field) (
+		scim_group *ScimGroup, err error)
+
+	Get_ScimGroup_By_Provider_And_DisplayName(ctx context.Context,
+		scim_group_provider ScimGroup_Provider_Field,
+		scim_group_display_name ScimGroup_DisplayName_Field) (
+		scim_group *ScimGroup, err error)
+
 	Get_StoragenodePaystub_By_NodeId_And_Period(ctx context.Context,
 		storagenode_paystub_node_id StoragenodePaystub_NodeId_Field,
 		storagenode_paystub_period StoragenodePaystub_Period_Field) (
@@ -51483,6 +59892,10 @@ type Methods interface {
 		webapp_session_id WebappSession_Id_Field) (
 		webapp_session *WebappSession, err error)
 
+	Get_WebauthnCredential_By_CredentialId(ctx context.Context,
+		webauthn_credential_credential_id WebauthnCredential_CredentialId_Field) (
+		webauthn_credential *WebauthnCredential, err error)
+
 	Has_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 		bucket_metainfo_name BucketMetainfo_Name_Field) (
@@ -51521,6 +59934,11 @@ type Methods interface {
 		limit int, offset int64) (
 		rows []*Id_PublicId_OwnerId_Row, err error)
 
+	Limited_ScimGroup_By_Provider_OrderBy_Asc_DisplayName(ctx context.Context,
+		scim_group_provider ScimGroup_Provider_Field,
+		limit int, offset int64) (
+		rows []*ScimGroup, err error)
+
 	Limited_StoragenodePayment_By_NodeId_And_Period_OrderBy_Desc_Id(ctx context.Context,
 		storagenode_payment_node_id StoragenodePayment_NodeId_Field,
 		storagenode_payment_period StoragenodePayment_Period_Field,
@@ -51645,6 +60063,12 @@ type Methods interface {
 		optional ApiKeyTail_Create_Fields) (
 		api_key_tail *ApiKeyTail, err error)
 
+	Replace_BucketLimit(ctx context.Context,
+		bucket_limit_project_id BucketLimit_ProjectId_Field,
+		bucket_limit_bucket_name BucketLimit_BucketName_Field,
+		optional BucketLimit_Create_Fields) (
+		bucket_limit *BucketLimit, err error)
+
 	Replace_Entitlement(ctx context.Context,
 		entitlement_scope Entitlement_Scope_Field,
 		entitlement_updated_at Entitlement_UpdatedAt_Field,
@@ -51657,6 +60081,17 @@ type Methods interface {
 		optional ProjectInvitation_Create_Fields) (
 		project_invitation *ProjectInvitation, err error)
 
+	Replace_ProjectMemberRole(ctx context.Context,
+		project_member_role_member_id ProjectMemberRole_MemberId_Field,
+		project_member_role_project_id ProjectMemberRole_ProjectId_Field,
+		project_member_role_role_id ProjectMemberRole_RoleId_Field) (
+		project_member_role *ProjectMemberRole, err error)
+
+	Replace_ScimGroupMember(ctx context.Context,
+		scim_group_member_group_id ScimGroupMember_GroupId_Field,
+		scim_group_member_user_id ScimGroupMember_UserId_Field) (
+		scim_group_member *ScimGroupMember, err error)
+
 	UpdateNoReturn_AccountingTimestamps_By_Name(ctx context.Context,
 		accounting_timestamps_name AccountingTimestamps_Name_Field,
 		update AccountingTimestamps_Update_Fields) (
@@ -51727,6 +60162,12 @@ type Methods interface {
 		update BillingBalance_Update_Fields) (
 		billing_balance *BillingBalance, err error)
 
+	Update_BucketLifecycleConfig_By_ProjectId_And_BucketName(ctx context.Context,
+		bucket_lifecycle_config_project_id BucketLifecycleConfig_ProjectId_Field,
+		bucket_lifecycle_config_bucket_name BucketLifecycleConfig_BucketName_Field,
+		update BucketLifecycleConfig_Update_Fields) (
+		bucket_lifecycle_config *BucketLifecycleConfig, err error)
+
 	Update_BucketMetainfo_By_ProjectId_And_Name(ctx context.Context,
 		bucket_metainfo_project_id BucketMetainfo_ProjectId_Field,
 		bucket_metainfo_name BucketMetainfo_Name_Field,
@@ -51762,6 +60203,17 @@ type Methods interface {
 		update Node_Update_Fields) (
 		node *Node, err error)
 
+	Update_OrganizationMember_By_OrganizationId_And_MemberId(ctx context.Context,
+		organization_member_organization_id OrganizationMember_OrganizationId_Field,
+		organization_member_member_id OrganizationMember_MemberId_Field,
+		update OrganizationMember_Update_Fields) (
+		organization_member *OrganizationMember, err error)
+
+	Update_Organization_By_Id(ctx context.Context,
+		organization_id Organization_Id_Field,
+		update Organization_Update_Fields) (
+		organization *Organization, err error)
+
 	Update_ProjectInvitation_By_ProjectId_And_Email(ctx context.Context,
 		project_invitation_project_id ProjectInvitation_ProjectId_Field,
 		project_invitation_email ProjectInvitation_Email_Field,
@@ -51774,6 +60226,11 @@ type Methods interface {
 		update ProjectMember_Update_Fields) (
 		project_member *ProjectMember, err error)
 
+	Update_ProjectRole_By_Id(ctx context.Context,
+		project_role_id ProjectRole_Id_Field,
+		update ProjectRole_Update_Fields) (
+		project_role *ProjectRole, err error)
+
 	Update_Project_By_Id(ctx context.Context,
 		project_id Project_Id_Field,
 		update Project_Update_Fields) (
@@ -51795,6 +60252,11 @@ type Methods interface {
 		update Reputation_Update_Fields) (
 		reputation *Reputation, err error)
 
+	Update_ScimGroup_By_Id(ctx context.Context,
+		scim_group_id ScimGroup_Id_Field,
+		update ScimGroup_Update_Fields) (
+		scim_group *ScimGroup, err error)
+
 	Update_StripeCustomer_By_UserId(ctx context.Context,
 		stripe_customer_user_id StripeCustomer_UserId_Field,
 		update StripeCustomer_Update_Fields) (
@@ -51825,6 +60287,17 @@ type Methods interface {
 		webapp_session_id WebappSession_Id_Field,
 		update WebappSession_Update_Fields) (
 		webapp_session *WebappSession, err error)
+
+	Update_WebauthnCredential_By_Id(ctx context.Context,
+		webauthn_credential_id WebauthnCredential_Id_Field,
+		update WebauthnCredential_Update_Fields) (
+		webauthn_credential *WebauthnCredential, err error)
+
+	Update_WebauthnCredential_By_Id_And_UserId(ctx context.Context,
+		webauthn_credential_id WebauthnCredential_Id_Field,
+		webauthn_credential_user_id WebauthnCredential_UserId_Field,
+		update WebauthnCredential_Update_Fields) (
+		webauthn_credential *WebauthnCredential, err error)
 }
 
 type DialectMethods interface {
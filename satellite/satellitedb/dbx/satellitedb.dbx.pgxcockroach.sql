@@ -26,6 +26,20 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 ) ;
+CREATE TABLE admin_approval_requests (
+	id bytea NOT NULL,
+	operation text NOT NULL,
+	item_type text NOT NULL,
+	user_id bytea NOT NULL,
+	project_id bytea,
+	requested_by text NOT NULL,
+	reason text NOT NULL,
+	details jsonb NOT NULL,
+	payload jsonb NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+) ;
 CREATE TABLE billing_balances (
 	user_id bytea NOT NULL,
 	balance bigint NOT NULL,
@@ -70,6 +84,24 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 ) ;
+CREATE TABLE bucket_lifecycle_configs (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	rules bytea NOT NULL,
+	deletion_cursor bytea,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+) ;
+CREATE TABLE bucket_limits (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	storage_limit bigint,
+	bandwidth_limit bigint,
+	segment_limit bigint,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+) ;
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -158,6 +190,8 @@ CREATE TABLE nodes (
 	noise_public_key bytea,
 	debounce_limit integer NOT NULL DEFAULT 0,
 	features integer NOT NULL DEFAULT 0,
+	asn bigint,
+	asn_org text,
 	PRIMARY KEY ( id )
 ) ;
 CREATE TABLE node_api_versions (
@@ -218,6 +252,17 @@ CREATE TABLE oauth_tokens (
 	expires_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( token )
 ) ;
+CREATE TABLE organizations (
+	id bytea NOT NULL,
+	name text NOT NULL,
+	owner_id bytea NOT NULL,
+	project_limit integer,
+	storage_limit bigint,
+	bandwidth_limit bigint,
+	segment_limit bigint,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+) ;
 CREATE TABLE peer_identities (
 	node_id bytea NOT NULL,
 	leaf_serial_number bytea NOT NULL,
@@ -356,6 +401,17 @@ CREATE TABLE revocations (
 	api_key_id bytea NOT NULL,
 	PRIMARY KEY ( revoked )
 ) ;
+CREATE TABLE scim_groups (
+	id bytea NOT NULL,
+	provider text NOT NULL,
+	display_name text NOT NULL,
+	external_id text,
+	project_id bytea,
+	role text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( provider, display_name )
+) ;
 CREATE TABLE segment_pending_audits (
 	node_id bytea NOT NULL,
 	stream_id bytea NOT NULL,
@@ -557,6 +613,11 @@ CREATE TABLE webapp_sessions (
 	expires_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 ) ;
+CREATE TABLE webauthn_used_challenges (
+	challenge bytea NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( challenge )
+) ;
 CREATE TABLE api_keys (
 	id bytea NOT NULL,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
@@ -621,6 +682,19 @@ CREATE TABLE domains (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( project_id, subdomain )
 ) ;
+CREATE TABLE organization_members (
+	organization_id bytea NOT NULL REFERENCES organizations( id ) ON DELETE CASCADE,
+	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	role integer NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( organization_id, member_id )
+) ;
+CREATE TABLE organization_projects (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	organization_id bytea NOT NULL REFERENCES organizations( id ),
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+) ;
 CREATE TABLE project_invitations (
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
 	email text NOT NULL,
@@ -635,6 +709,15 @@ CREATE TABLE project_members (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( member_id, project_id )
 ) ;
+CREATE TABLE project_roles (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	name text NOT NULL,
+	permissions bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( project_id, name )
+) ;
 CREATE TABLE rest_api_keys (
 	id bytea NOT NULL,
 	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
@@ -645,12 +728,30 @@ CREATE TABLE rest_api_keys (
 	PRIMARY KEY ( id ),
 	UNIQUE ( token )
 ) ;
+CREATE TABLE scim_group_members (
+	group_id bytea NOT NULL REFERENCES scim_groups( id ) ON DELETE CASCADE,
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	PRIMARY KEY ( group_id, user_id )
+) ;
 CREATE TABLE stripecoinpayments_apply_balance_intents (
 	tx_id text NOT NULL REFERENCES coinpayments_transactions( id ) ON DELETE CASCADE,
 	state integer NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( tx_id )
 ) ;
+CREATE TABLE webauthn_credentials (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	credential_id bytea NOT NULL,
+	public_key bytea NOT NULL,
+	sign_count bigint NOT NULL,
+	transports text NOT NULL,
+	name text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	last_used_at timestamp with time zone,
+	PRIMARY KEY ( id ),
+	UNIQUE ( credential_id )
+) ;
 CREATE TABLE api_key_tails (
 	root_key_id bytea REFERENCES api_keys( id ) ON DELETE CASCADE,
 	tail bytea NOT NULL,
@@ -659,6 +760,12 @@ CREATE TABLE api_key_tails (
 	last_used timestamp with time zone NOT NULL,
 	PRIMARY KEY ( tail )
 ) ;
+CREATE TABLE project_member_roles (
+	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	role_id bytea NOT NULL REFERENCES project_roles( id ) ON DELETE CASCADE,
+	PRIMARY KEY ( member_id, project_id )
+) ;
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
 CREATE INDEX billing_transactions_tx_timestamp_index ON billing_transactions ( tx_timestamp ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
@@ -677,6 +784,7 @@ CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id ) ;
 CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
 CREATE INDEX oauth_tokens_user_id_index ON oauth_tokens ( user_id ) ;
 CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id ) ;
+CREATE INDEX organizations_owner_id_index ON organizations ( owner_id ) ;
 CREATE INDEX projects_public_id_index ON projects ( public_id ) ;
 CREATE INDEX projects_owner_id_index ON projects ( owner_id ) ;
 CREATE INDEX projects_status_status_updated_at_index ON projects ( status, status_updated_at ) WHERE projects.status_updated_at is not NULL ;
@@ -702,9 +810,15 @@ CREATE INDEX users_status_status_updated_at_index ON users ( status, status_upda
 CREATE INDEX users_tenant_id_index ON users ( tenant_id ) WHERE users.tenant_id is not NULL ;
 CREATE INDEX users_normalized_email_tenant_id_status_index ON users ( normalized_email, tenant_id, status ) WHERE users.tenant_id is not NULL ;
 CREATE INDEX webapp_sessions_user_id_index ON webapp_sessions ( user_id ) ;
+CREATE INDEX webauthn_used_challenges_expires_at_index ON webauthn_used_challenges ( expires_at ) ;
 CREATE INDEX bucket_migrations_state_created_at_index ON bucket_migrations ( state, created_at ) ;
+CREATE INDEX organization_members_member_id_index ON organization_members ( member_id ) ;
+CREATE INDEX organization_projects_organization_id_index ON organization_projects ( organization_id ) ;
 CREATE INDEX project_invitations_project_id_index ON project_invitations ( project_id ) ;
 CREATE INDEX project_invitations_email_index ON project_invitations ( email ) ;
 CREATE INDEX project_members_project_id_index ON project_members ( project_id ) ;
 CREATE INDEX rest_api_keys_user_id_index ON rest_api_keys ( user_id ) ;
-CREATE INDEX rest_api_keys_name_index ON rest_api_keys ( name )
+CREATE INDEX rest_api_keys_name_index ON rest_api_keys ( name ) ;
+CREATE INDEX scim_group_members_user_id_index ON scim_group_members ( user_id ) ;
+CREATE INDEX webauthn_credentials_user_id_index ON webauthn_credentials ( user_id ) ;
+CREATE INDEX project_member_roles_role_id_index ON project_member_roles ( role_id )
@@ -23,6 +23,19 @@ CREATE TABLE accounting_timestamps (
 	name STRING(MAX) NOT NULL,
 	value TIMESTAMP NOT NULL
 ) PRIMARY KEY ( name ) ;
+CREATE TABLE admin_approval_requests (
+	id BYTES(MAX) NOT NULL,
+	operation STRING(MAX) NOT NULL,
+	item_type STRING(MAX) NOT NULL,
+	user_id BYTES(MAX) NOT NULL,
+	project_id BYTES(MAX),
+	requested_by STRING(MAX) NOT NULL,
+	reason STRING(MAX) NOT NULL,
+	details JSON NOT NULL,
+	payload JSON NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	expires_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( id ) ;
 CREATE TABLE billing_balances (
 	user_id BYTES(MAX) NOT NULL,
 	balance INT64 NOT NULL,
@@ -64,6 +77,22 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	allocated INT64 NOT NULL,
 	settled INT64 NOT NULL
 ) PRIMARY KEY ( bucket_name, project_id, interval_start, action ) ;
+CREATE TABLE bucket_lifecycle_configs (
+	project_id BYTES(MAX) NOT NULL,
+	bucket_name BYTES(MAX) NOT NULL,
+	rules BYTES(MAX) NOT NULL,
+	deletion_cursor BYTES(MAX),
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( project_id, bucket_name ) ;
+CREATE TABLE bucket_limits (
+	project_id BYTES(MAX) NOT NULL,
+	bucket_name BYTES(MAX) NOT NULL,
+	storage_limit INT64,
+	bandwidth_limit INT64,
+	segment_limit INT64,
+	updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( project_id, bucket_name ) ;
 CREATE TABLE bucket_storage_tallies (
 	bucket_name BYTES(MAX) NOT NULL,
 	project_id BYTES(MAX) NOT NULL,
@@ -147,7 +176,9 @@ CREATE TABLE nodes (
 	noise_proto INT64,
 	noise_public_key BYTES(MAX),
 	debounce_limit INT64 NOT NULL DEFAULT (0),
-	features INT64 NOT NULL DEFAULT (0)
+	features INT64 NOT NULL DEFAULT (0),
+	asn INT64,
+	asn_org STRING(MAX)
 ) PRIMARY KEY ( id ) ;
 CREATE TABLE node_api_versions (
 	id BYTES(MAX) NOT NULL,
@@ -201,6 +232,16 @@ CREATE TABLE oauth_tokens (
 	created_at TIMESTAMP NOT NULL,
 	expires_at TIMESTAMP NOT NULL
 ) PRIMARY KEY ( token ) ;
+CREATE TABLE organizations (
+	id BYTES(MAX) NOT NULL,
+	name STRING(MAX) NOT NULL,
+	owner_id BYTES(MAX) NOT NULL,
+	project_limit INT64,
+	storage_limit INT64,
+	bandwidth_limit INT64,
+	segment_limit INT64,
+	created_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( id ) ;
 CREATE TABLE peer_identities (
 	node_id BYTES(MAX) NOT NULL,
 	leaf_serial_number BYTES(MAX) NOT NULL,
@@ -328,6 +369,16 @@ CREATE TABLE revocations (
 	revoked BYTES(MAX) NOT NULL,
 	api_key_id BYTES(MAX) NOT NULL
 ) PRIMARY KEY ( revoked ) ;
+CREATE TABLE scim_groups (
+	id BYTES(MAX) NOT NULL,
+	provider STRING(MAX) NOT NULL,
+	display_name STRING(MAX) NOT NULL,
+	external_id STRING(MAX),
+	project_id BYTES(MAX),
+	role STRING(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( id ) ;
+CREATE UNIQUE INDEX index_scim_groups_provider_display_name ON scim_groups ( provider, display_name ) ;
 CREATE TABLE segment_pending_audits (
 	node_id BYTES(MAX) NOT NULL,
 	stream_id BYTES(MAX) NOT NULL,
@@ -514,6 +565,10 @@ CREATE TABLE webapp_sessions (
 	status INT64 NOT NULL,
 	expires_at TIMESTAMP NOT NULL
 ) PRIMARY KEY ( id ) ;
+CREATE TABLE webauthn_used_challenges (
+	challenge BYTES(MAX) NOT NULL,
+	expires_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( challenge ) ;
 CREATE TABLE api_keys (
 	id BYTES(MAX) NOT NULL,
 	project_id BYTES(MAX) NOT NULL,
@@ -581,6 +636,21 @@ CREATE TABLE domains (
 	CONSTRAINT domains_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id),
 	CONSTRAINT domains_created_by_fkey FOREIGN KEY (created_by) REFERENCES users (id)
 ) PRIMARY KEY ( project_id, subdomain ) ;
+CREATE TABLE organization_members (
+	organization_id BYTES(MAX) NOT NULL,
+	member_id BYTES(MAX) NOT NULL,
+	role INT64 NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	CONSTRAINT organization_members_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations (id) ON DELETE CASCADE ,
+	CONSTRAINT organization_members_member_id_fkey FOREIGN KEY (member_id) REFERENCES users (id) ON DELETE CASCADE 
+) PRIMARY KEY ( organization_id, member_id ) ;
+CREATE TABLE organization_projects (
+	project_id BYTES(MAX) NOT NULL,
+	organization_id BYTES(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	CONSTRAINT organization_projects_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE ,
+	CONSTRAINT organization_projects_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations (id)
+) PRIMARY KEY ( project_id ) ;
 CREATE TABLE project_invitations (
 	project_id BYTES(MAX) NOT NULL,
 	email STRING(MAX) NOT NULL,
@@ -597,6 +667,15 @@ CREATE TABLE project_members (
 	CONSTRAINT project_members_member_id_fkey FOREIGN KEY (member_id) REFERENCES users (id) ON DELETE CASCADE ,
 	CONSTRAINT project_members_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE 
 ) PRIMARY KEY ( member_id, project_id ) ;
+CREATE TABLE project_roles (
+	id BYTES(MAX) NOT NULL,
+	project_id BYTES(MAX) NOT NULL,
+	name STRING(MAX) NOT NULL,
+	permissions INT64 NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	CONSTRAINT project_roles_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE 
+) PRIMARY KEY ( id ) ;
+CREATE UNIQUE INDEX index_project_roles_project_id_name ON project_roles ( project_id, name ) ;
 CREATE TABLE rest_api_keys (
 	id BYTES(MAX) NOT NULL,
 	user_id BYTES(MAX) NOT NULL,
@@ -607,12 +686,31 @@ CREATE TABLE rest_api_keys (
 	CONSTRAINT rest_api_keys_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE 
 ) PRIMARY KEY ( id ) ;
 CREATE UNIQUE INDEX index_rest_api_keys_token ON rest_api_keys ( token ) ;
+CREATE TABLE scim_group_members (
+	group_id BYTES(MAX) NOT NULL,
+	user_id BYTES(MAX) NOT NULL,
+	CONSTRAINT scim_group_members_group_id_fkey FOREIGN KEY (group_id) REFERENCES scim_groups (id) ON DELETE CASCADE ,
+	CONSTRAINT scim_group_members_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE 
+) PRIMARY KEY ( group_id, user_id ) ;
 CREATE TABLE stripecoinpayments_apply_balance_intents (
 	tx_id STRING(MAX) NOT NULL,
 	state INT64 NOT NULL,
 	created_at TIMESTAMP NOT NULL,
 	CONSTRAINT stripecoinpayments_apply_balance_intents_tx_id_fkey FOREIGN KEY (tx_id) REFERENCES coinpayments_transactions (id) ON DELETE CASCADE 
 ) PRIMARY KEY ( tx_id ) ;
+CREATE TABLE webauthn_credentials (
+	id BYTES(MAX) NOT NULL,
+	user_id BYTES(MAX) NOT NULL,
+	credential_id BYTES(MAX) NOT NULL,
+	public_key BYTES(MAX) NOT NULL,
+	sign_count INT64 NOT NULL,
+	transports STRING(MAX) NOT NULL,
+	name STRING(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	last_used_at TIMESTAMP,
+	CONSTRAINT webauthn_credentials_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE 
+) PRIMARY KEY ( id ) ;
+CREATE UNIQUE INDEX index_webauthn_credentials_credential_id ON webauthn_credentials ( credential_id ) ;
 CREATE TABLE api_key_tails (
 	root_key_id BYTES(MAX),
 	tail BYTES(MAX) NOT NULL,
@@ -621,6 +719,14 @@ CREATE TABLE api_key_tails (
 	last_used TIMESTAMP NOT NULL,
 	CONSTRAINT api_key_tails_root_key_id_fkey FOREIGN KEY (root_key_id) REFERENCES api_keys (id) ON DELETE CASCADE 
 ) PRIMARY KEY ( tail ) ;
+CREATE TABLE project_member_roles (
+	member_id BYTES(MAX) NOT NULL,
+	project_id BYTES(MAX) NOT NULL,
+	role_id BYTES(MAX) NOT NULL,
+	CONSTRAINT project_member_roles_member_id_fkey FOREIGN KEY (member_id) REFERENCES users (id) ON DELETE CASCADE ,
+	CONSTRAINT project_member_roles_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE ,
+	CONSTRAINT project_member_roles_role_id_fkey FOREIGN KEY (role_id) REFERENCES project_roles (id) ON DELETE CASCADE 
+) PRIMARY KEY ( member_id, project_id ) ;
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
 CREATE INDEX billing_transactions_tx_timestamp_index ON billing_transactions ( tx_timestamp ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
@@ -639,6 +745,7 @@ CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id ) ;
 CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
 CREATE INDEX oauth_tokens_user_id_index ON oauth_tokens ( user_id ) ;
 CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id ) ;
+CREATE INDEX organizations_owner_id_index ON organizations ( owner_id ) ;
 CREATE INDEX projects_public_id_index ON projects ( public_id ) ;
 CREATE INDEX projects_owner_id_index ON projects ( owner_id ) ;
 CREATE INDEX projects_status_status_updated_at_index ON projects ( status, status_updated_at ) ;
@@ -664,9 +771,15 @@ CREATE INDEX users_status_status_updated_at_index ON users ( status, status_upda
 CREATE INDEX users_tenant_id_index ON users ( tenant_id ) ;
 CREATE INDEX users_normalized_email_tenant_id_status_index ON users ( normalized_email, tenant_id, status ) ;
 CREATE INDEX webapp_sessions_user_id_index ON webapp_sessions ( user_id ) ;
+CREATE INDEX webauthn_used_challenges_expires_at_index ON webauthn_used_challenges ( expires_at ) ;
 CREATE INDEX bucket_migrations_state_created_at_index ON bucket_migrations ( state, created_at ) ;
+CREATE INDEX organization_members_member_id_index ON organization_members ( member_id ) ;
+CREATE INDEX organization_projects_organization_id_index ON organization_projects ( organization_id ) ;
 CREATE INDEX project_invitations_project_id_index ON project_invitations ( project_id ) ;
 CREATE INDEX project_invitations_email_index ON project_invitations ( email ) ;
 CREATE INDEX project_members_project_id_index ON project_members ( project_id ) ;
 CREATE INDEX rest_api_keys_user_id_index ON rest_api_keys ( user_id ) ;
-CREATE INDEX rest_api_keys_name_index ON rest_api_keys ( name )
+CREATE INDEX rest_api_keys_name_index ON rest_api_keys ( name ) ;
+CREATE INDEX scim_group_members_user_id_index ON scim_group_members ( user_id ) ;
+CREATE INDEX webauthn_credentials_user_id_index ON webauthn_credentials ( user_id ) ;
+CREATE INDEX project_member_roles_role_id_index ON project_member_roles ( role_id )
@@ -4,6 +4,13 @@
 /*
 Package satellitedb implements database layer for satellite operations.
 
+# Supported databases
+
+The satellite database runs on Postgres, CockroachDB and Spanner. Unlike the metabase, it
+has no SQLite implementation: the dbx models, the migration and many of the raw queries rely
+on arrays and other features, which SQLite doesn't have. Single-host deployments can keep the
+metabase in a SQLite file, but they still need one of the above for the satellite database.
+
 # Notes about Spanner
 
 # UPDATE OR INSERT is not supported
@@ -1217,6 +1217,207 @@ func (db *satelliteDB) productionMigrationSpanner() *migrate.Migration {
 					`CREATE INDEX project_limit_events_project_id_created_at_index ON project_limit_events ( project_id, created_at );`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_limits table",
+				Version:     314,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_limits (
+						project_id BYTES(MAX) NOT NULL,
+						bucket_name BYTES(MAX) NOT NULL,
+						storage_limit INT64,
+						bandwidth_limit INT64,
+						segment_limit INT64,
+						updated_at TIMESTAMP NOT NULL
+					) PRIMARY KEY ( project_id, bucket_name )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_lifecycle_configs table",
+				Version:     315,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_lifecycle_configs (
+						project_id BYTES(MAX) NOT NULL,
+						bucket_name BYTES(MAX) NOT NULL,
+						rules BYTES(MAX) NOT NULL,
+						deletion_cursor BYTES(MAX),
+						created_at TIMESTAMP NOT NULL,
+						updated_at TIMESTAMP NOT NULL
+					) PRIMARY KEY ( project_id, bucket_name )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add asn columns to nodes table",
+				Version:     316,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN asn INT64`,
+					`ALTER TABLE nodes ADD COLUMN asn_org STRING(MAX)`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add project_roles and project_member_roles tables",
+				Version:     317,
+				Action: migrate.SQL{
+					`CREATE TABLE project_roles (
+						id BYTES(MAX) NOT NULL,
+						project_id BYTES(MAX) NOT NULL,
+						name STRING(MAX) NOT NULL,
+						permissions INT64 NOT NULL,
+						created_at TIMESTAMP NOT NULL,
+						CONSTRAINT project_roles_project_id_fkey
+							FOREIGN KEY (project_id)
+							REFERENCES projects (id)
+							ON DELETE CASCADE
+					) PRIMARY KEY ( id )`,
+					`CREATE UNIQUE INDEX index_project_roles_project_id_name ON project_roles ( project_id, name )`,
+					`CREATE TABLE project_member_roles (
+						member_id BYTES(MAX) NOT NULL,
+						project_id BYTES(MAX) NOT NULL,
+						role_id BYTES(MAX) NOT NULL,
+						CONSTRAINT project_member_roles_member_id_fkey
+							FOREIGN KEY (member_id)
+							REFERENCES users (id)
+							ON DELETE CASCADE,
+						CONSTRAINT project_member_roles_project_id_fkey
+							FOREIGN KEY (project_id)
+							REFERENCES projects (id)
+							ON DELETE CASCADE,
+						CONSTRAINT project_member_roles_role_id_fkey
+							FOREIGN KEY (role_id)
+							REFERENCES project_roles (id)
+							ON DELETE CASCADE
+					) PRIMARY KEY ( member_id, project_id )`,
+					`CREATE INDEX project_member_roles_role_id_index ON project_member_roles ( role_id )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add scim_groups and scim_group_members tables",
+				Version:     318,
+				Action: migrate.SQL{
+					`CREATE TABLE scim_groups (
+						id BYTES(MAX) NOT NULL,
+						provider STRING(MAX) NOT NULL,
+						display_name STRING(MAX) NOT NULL,
+						external_id STRING(MAX),
+						project_id BYTES(MAX),
+						role STRING(MAX) NOT NULL,
+						created_at TIMESTAMP NOT NULL
+					) PRIMARY KEY ( id )`,
+					`CREATE UNIQUE INDEX index_scim_groups_provider_display_name ON scim_groups ( provider, display_name )`,
+					`CREATE TABLE scim_group_members (
+						group_id BYTES(MAX) NOT NULL,
+						user_id BYTES(MAX) NOT NULL,
+						CONSTRAINT scim_group_members_group_id_fkey
+							FOREIGN KEY (group_id)
+							REFERENCES scim_groups (id)
+							ON DELETE CASCADE,
+						CONSTRAINT scim_group_members_user_id_fkey
+							FOREIGN KEY (user_id)
+							REFERENCES users (id)
+							ON DELETE CASCADE
+					) PRIMARY KEY ( group_id, user_id )`,
+					`CREATE INDEX scim_group_members_user_id_index ON scim_group_members ( user_id )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add webauthn_credentials and webauthn_used_challenges tables",
+				Version:     319,
+				Action: migrate.SQL{
+					`CREATE TABLE webauthn_credentials (
+						id BYTES(MAX) NOT NULL,
+						user_id BYTES(MAX) NOT NULL,
+						credential_id BYTES(MAX) NOT NULL,
+						public_key BYTES(MAX) NOT NULL,
+						sign_count INT64 NOT NULL,
+						transports STRING(MAX) NOT NULL,
+						name STRING(MAX) NOT NULL,
+						created_at TIMESTAMP NOT NULL,
+						last_used_at TIMESTAMP,
+						CONSTRAINT webauthn_credentials_user_id_fkey
+							FOREIGN KEY (user_id)
+							REFERENCES users (id)
+							ON DELETE CASCADE
+					) PRIMARY KEY ( id )`,
+					`CREATE UNIQUE INDEX index_webauthn_credentials_credential_id ON webauthn_credentials ( credential_id )`,
+					`CREATE INDEX webauthn_credentials_user_id_index ON webauthn_credentials ( user_id )`,
+					`CREATE TABLE webauthn_used_challenges (
+						challenge BYTES(MAX) NOT NULL,
+						expires_at TIMESTAMP NOT NULL
+					) PRIMARY KEY ( challenge )`,
+					`CREATE INDEX webauthn_used_challenges_expires_at_index ON webauthn_used_challenges ( expires_at )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add organizations, organization_members and organization_projects tables",
+				Version:     320,
+				Action: migrate.SQL{
+					`CREATE TABLE organizations (
+						id BYTES(MAX) NOT NULL,
+						name STRING(MAX) NOT NULL,
+						owner_id BYTES(MAX) NOT NULL,
+						project_limit INT64,
+						storage_limit INT64,
+						bandwidth_limit INT64,
+						segment_limit INT64,
+						created_at TIMESTAMP NOT NULL
+					) PRIMARY KEY ( id )`,
+					`CREATE INDEX organizations_owner_id_index ON organizations ( owner_id )`,
+					`CREATE TABLE organization_members (
+						organization_id BYTES(MAX) NOT NULL,
+						member_id BYTES(MAX) NOT NULL,
+						role INT64 NOT NULL,
+						created_at TIMESTAMP NOT NULL,
+						CONSTRAINT organization_members_organization_id_fkey
+							FOREIGN KEY (organization_id)
+							REFERENCES organizations (id)
+							ON DELETE CASCADE,
+						CONSTRAINT organization_members_member_id_fkey
+							FOREIGN KEY (member_id)
+							REFERENCES users (id)
+							ON DELETE CASCADE
+					) PRIMARY KEY ( organization_id, member_id )`,
+					`CREATE INDEX organization_members_member_id_index ON organization_members ( member_id )`,
+					`CREATE TABLE organization_projects (
+						project_id BYTES(MAX) NOT NULL,
+						organization_id BYTES(MAX) NOT NULL,
+						created_at TIMESTAMP NOT NULL,
+						CONSTRAINT organization_projects_project_id_fkey
+							FOREIGN KEY (project_id)
+							REFERENCES projects (id)
+							ON DELETE CASCADE,
+						CONSTRAINT organization_projects_organization_id_fkey
+							FOREIGN KEY (organization_id)
+							REFERENCES organizations (id)
+					) PRIMARY KEY ( project_id )`,
+					`CREATE INDEX organization_projects_organization_id_index ON organization_projects ( organization_id )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add admin_approval_requests table",
+				Version:     321,
+				Action: migrate.SQL{
+					`CREATE TABLE admin_approval_requests (
+						id BYTES(MAX) NOT NULL,
+						operation STRING(MAX) NOT NULL,
+						item_type STRING(MAX) NOT NULL,
+						user_id BYTES(MAX) NOT NULL,
+						project_id BYTES(MAX),
+						requested_by STRING(MAX) NOT NULL,
+						reason STRING(MAX) NOT NULL,
+						details JSON NOT NULL,
+						payload JSON NOT NULL,
+						created_at TIMESTAMP NOT NULL,
+						expires_at TIMESTAMP NOT NULL
+					) PRIMARY KEY ( id )`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
@@ -4278,6 +4479,176 @@ func (db *satelliteDB) productionMigrationPostgres() *migrate.Migration {
 					`CREATE INDEX project_limit_events_project_id_created_at_index ON project_limit_events ( project_id, created_at ) WHERE email_sent IS NULL;`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_limits table",
+				Version:     314,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_limits (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						storage_limit bigint,
+						bandwidth_limit bigint,
+						segment_limit bigint,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					)`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_lifecycle_configs table",
+				Version:     315,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_lifecycle_configs (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						rules bytea NOT NULL,
+						deletion_cursor bytea,
+						created_at timestamp with time zone NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					)`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add asn columns to nodes table",
+				Version:     316,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN asn bigint;`,
+					`ALTER TABLE nodes ADD COLUMN asn_org text;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add project_roles and project_member_roles tables",
+				Version:     317,
+				Action: migrate.SQL{
+					`CREATE TABLE project_roles (
+						id bytea NOT NULL,
+						project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+						name text NOT NULL,
+						permissions bigint NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id ),
+						UNIQUE ( project_id, name )
+					);`,
+					`CREATE TABLE project_member_roles (
+						member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+						project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+						role_id bytea NOT NULL REFERENCES project_roles( id ) ON DELETE CASCADE,
+						PRIMARY KEY ( member_id, project_id )
+					);`,
+					`CREATE INDEX project_member_roles_role_id_index ON project_member_roles ( role_id );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add scim_groups and scim_group_members tables",
+				Version:     318,
+				Action: migrate.SQL{
+					`CREATE TABLE scim_groups (
+						id bytea NOT NULL,
+						provider text NOT NULL,
+						display_name text NOT NULL,
+						external_id text,
+						project_id bytea,
+						role text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id ),
+						UNIQUE ( provider, display_name )
+					);`,
+					`CREATE TABLE scim_group_members (
+						group_id bytea NOT NULL REFERENCES scim_groups( id ) ON DELETE CASCADE,
+						user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+						PRIMARY KEY ( group_id, user_id )
+					);`,
+					`CREATE INDEX scim_group_members_user_id_index ON scim_group_members ( user_id );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add webauthn_credentials and webauthn_used_challenges tables",
+				Version:     319,
+				Action: migrate.SQL{
+					`CREATE TABLE webauthn_credentials (
+						id bytea NOT NULL,
+						user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+						credential_id bytea NOT NULL,
+						public_key bytea NOT NULL,
+						sign_count bigint NOT NULL,
+						transports text NOT NULL,
+						name text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						last_used_at timestamp with time zone,
+						PRIMARY KEY ( id ),
+						UNIQUE ( credential_id )
+					);`,
+					`CREATE INDEX webauthn_credentials_user_id_index ON webauthn_credentials ( user_id );`,
+					`CREATE TABLE webauthn_used_challenges (
+						challenge bytea NOT NULL,
+						expires_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( challenge )
+					);`,
+					`CREATE INDEX webauthn_used_challenges_expires_at_index ON webauthn_used_challenges ( expires_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add organizations, organization_members and organization_projects tables",
+				Version:     320,
+				Action: migrate.SQL{
+					`CREATE TABLE organizations (
+						id bytea NOT NULL,
+						name text NOT NULL,
+						owner_id bytea NOT NULL,
+						project_limit integer,
+						storage_limit bigint,
+						bandwidth_limit bigint,
+						segment_limit bigint,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX organizations_owner_id_index ON organizations ( owner_id );`,
+					`CREATE TABLE organization_members (
+						organization_id bytea NOT NULL REFERENCES organizations( id ) ON DELETE CASCADE,
+						member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+						role integer NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( organization_id, member_id )
+					);`,
+					`CREATE INDEX organization_members_member_id_index ON organization_members ( member_id );`,
+					`CREATE TABLE organization_projects (
+						project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+						organization_id bytea NOT NULL REFERENCES organizations( id ),
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id )
+					);`,
+					`CREATE INDEX organization_projects_organization_id_index ON organization_projects ( organization_id );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add admin_approval_requests table",
+				Version:     321,
+				Action: migrate.SQL{
+					`CREATE TABLE admin_approval_requests (
+						id bytea NOT NULL,
+						operation text NOT NULL,
+						item_type text NOT NULL,
+						user_id bytea NOT NULL,
+						project_id bytea,
+						requested_by text NOT NULL,
+						reason text NOT NULL,
+						details jsonb NOT NULL,
+						payload jsonb NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						expires_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
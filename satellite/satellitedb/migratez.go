@@ -21,7 +21,7 @@ func (db *satelliteDB) testMigrationSpanner() *migrate.Migration {
 			{
 				DB:          &db.migrationDB,
 				Description: "Testing setup",
-				Version:     313,
+				Version:     321,
 				Action: migrate.SQL{`-- AUTOGENERATED BY storj.io/dbx
 -- DO NOT EDIT
 CREATE TABLE account_freeze_events (
@@ -47,6 +47,19 @@ CREATE TABLE accounting_timestamps (
 	name STRING(MAX) NOT NULL,
 	value TIMESTAMP NOT NULL
 ) PRIMARY KEY ( name ) ;
+CREATE TABLE admin_approval_requests (
+	id BYTES(MAX) NOT NULL,
+	operation STRING(MAX) NOT NULL,
+	item_type STRING(MAX) NOT NULL,
+	user_id BYTES(MAX) NOT NULL,
+	project_id BYTES(MAX),
+	requested_by STRING(MAX) NOT NULL,
+	reason STRING(MAX) NOT NULL,
+	details JSON NOT NULL,
+	payload JSON NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	expires_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( id ) ;
 CREATE TABLE billing_balances (
 	user_id BYTES(MAX) NOT NULL,
 	balance INT64 NOT NULL,
@@ -171,7 +184,9 @@ CREATE TABLE nodes (
 	noise_proto INT64,
 	noise_public_key BYTES(MAX),
 	debounce_limit INT64 NOT NULL DEFAULT (0),
-	features INT64 NOT NULL DEFAULT (0)
+	features INT64 NOT NULL DEFAULT (0),
+	asn INT64,
+	asn_org STRING(MAX)
 ) PRIMARY KEY ( id ) ;
 CREATE TABLE node_api_versions (
 	id BYTES(MAX) NOT NULL,
@@ -610,6 +625,22 @@ CREATE TABLE bucket_eventing_configs (
 		REFERENCES bucket_metainfos (project_id, name)
 		ON DELETE CASCADE
 ) PRIMARY KEY ( project_id, bucket_name ) ;
+CREATE TABLE bucket_limits (
+	project_id BYTES(MAX) NOT NULL,
+	bucket_name BYTES(MAX) NOT NULL,
+	storage_limit INT64,
+	bandwidth_limit INT64,
+	segment_limit INT64,
+	updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( project_id, bucket_name ) ;
+CREATE TABLE bucket_lifecycle_configs (
+	project_id BYTES(MAX) NOT NULL,
+	bucket_name BYTES(MAX) NOT NULL,
+	rules BYTES(MAX) NOT NULL,
+	deletion_cursor BYTES(MAX),
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( project_id, bucket_name ) ;
 CREATE TABLE domains (
 	subdomain STRING(MAX) NOT NULL,
 	project_id BYTES(MAX) NOT NULL,
@@ -636,6 +667,88 @@ CREATE TABLE project_members (
 	CONSTRAINT project_members_member_id_fkey FOREIGN KEY (member_id) REFERENCES users (id) ON DELETE CASCADE ,
 	CONSTRAINT project_members_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
 ) PRIMARY KEY ( member_id, project_id ) ;
+CREATE TABLE project_roles (
+	id BYTES(MAX) NOT NULL,
+	project_id BYTES(MAX) NOT NULL,
+	name STRING(MAX) NOT NULL,
+	permissions INT64 NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	CONSTRAINT project_roles_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE 
+) PRIMARY KEY ( id ) ;
+CREATE UNIQUE INDEX index_project_roles_project_id_name ON project_roles ( project_id, name ) ;
+CREATE TABLE project_member_roles (
+	member_id BYTES(MAX) NOT NULL,
+	project_id BYTES(MAX) NOT NULL,
+	role_id BYTES(MAX) NOT NULL,
+	CONSTRAINT project_member_roles_member_id_fkey FOREIGN KEY (member_id) REFERENCES users (id) ON DELETE CASCADE ,
+	CONSTRAINT project_member_roles_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE ,
+	CONSTRAINT project_member_roles_role_id_fkey FOREIGN KEY (role_id) REFERENCES project_roles (id) ON DELETE CASCADE 
+) PRIMARY KEY ( member_id, project_id ) ;
+CREATE INDEX project_member_roles_role_id_index ON project_member_roles ( role_id ) ;
+CREATE TABLE scim_groups (
+	id BYTES(MAX) NOT NULL,
+	provider STRING(MAX) NOT NULL,
+	display_name STRING(MAX) NOT NULL,
+	external_id STRING(MAX),
+	project_id BYTES(MAX),
+	role STRING(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( id ) ;
+CREATE UNIQUE INDEX index_scim_groups_provider_display_name ON scim_groups ( provider, display_name ) ;
+CREATE TABLE scim_group_members (
+	group_id BYTES(MAX) NOT NULL,
+	user_id BYTES(MAX) NOT NULL,
+	CONSTRAINT scim_group_members_group_id_fkey FOREIGN KEY (group_id) REFERENCES scim_groups (id) ON DELETE CASCADE ,
+	CONSTRAINT scim_group_members_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE 
+) PRIMARY KEY ( group_id, user_id ) ;
+CREATE INDEX scim_group_members_user_id_index ON scim_group_members ( user_id ) ;
+CREATE TABLE webauthn_credentials (
+	id BYTES(MAX) NOT NULL,
+	user_id BYTES(MAX) NOT NULL,
+	credential_id BYTES(MAX) NOT NULL,
+	public_key BYTES(MAX) NOT NULL,
+	sign_count INT64 NOT NULL,
+	transports STRING(MAX) NOT NULL,
+	name STRING(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	last_used_at TIMESTAMP,
+	CONSTRAINT webauthn_credentials_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE 
+) PRIMARY KEY ( id ) ;
+CREATE UNIQUE INDEX index_webauthn_credentials_credential_id ON webauthn_credentials ( credential_id ) ;
+CREATE INDEX webauthn_credentials_user_id_index ON webauthn_credentials ( user_id ) ;
+CREATE TABLE webauthn_used_challenges (
+	challenge BYTES(MAX) NOT NULL,
+	expires_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( challenge ) ;
+CREATE INDEX webauthn_used_challenges_expires_at_index ON webauthn_used_challenges ( expires_at ) ;
+CREATE TABLE organizations (
+	id BYTES(MAX) NOT NULL,
+	name STRING(MAX) NOT NULL,
+	owner_id BYTES(MAX) NOT NULL,
+	project_limit INT64,
+	storage_limit INT64,
+	bandwidth_limit INT64,
+	segment_limit INT64,
+	created_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( id ) ;
+CREATE INDEX organizations_owner_id_index ON organizations ( owner_id ) ;
+CREATE TABLE organization_members (
+	organization_id BYTES(MAX) NOT NULL,
+	member_id BYTES(MAX) NOT NULL,
+	role INT64 NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	CONSTRAINT organization_members_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations (id) ON DELETE CASCADE ,
+	CONSTRAINT organization_members_member_id_fkey FOREIGN KEY (member_id) REFERENCES users (id) ON DELETE CASCADE 
+) PRIMARY KEY ( organization_id, member_id ) ;
+CREATE INDEX organization_members_member_id_index ON organization_members ( member_id ) ;
+CREATE TABLE organization_projects (
+	project_id BYTES(MAX) NOT NULL,
+	organization_id BYTES(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	CONSTRAINT organization_projects_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE ,
+	CONSTRAINT organization_projects_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations (id)
+) PRIMARY KEY ( project_id ) ;
+CREATE INDEX organization_projects_organization_id_index ON organization_projects ( organization_id ) ;
 CREATE TABLE rest_api_keys (
 	id BYTES(MAX) NOT NULL,
 	user_id BYTES(MAX) NOT NULL,
@@ -724,7 +837,7 @@ func (db *satelliteDB) testMigrationPostgres() *migrate.Migration {
 			{
 				DB:          &db.migrationDB,
 				Description: "Testing setup",
-				Version:     313,
+				Version:     321,
 				Action: migrate.SQL{`-- AUTOGENERATED BY storj.io/dbx
 -- DO NOT EDIT
 CREATE TABLE account_freeze_events (
@@ -753,6 +866,20 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 ) ;
+CREATE TABLE admin_approval_requests (
+	id bytea NOT NULL,
+	operation text NOT NULL,
+	item_type text NOT NULL,
+	user_id bytea NOT NULL,
+	project_id bytea,
+	requested_by text NOT NULL,
+	reason text NOT NULL,
+	details jsonb NOT NULL,
+	payload jsonb NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+) ;
 CREATE TABLE billing_balances (
 	user_id bytea NOT NULL,
 	balance bigint NOT NULL,
@@ -885,6 +1012,8 @@ CREATE TABLE nodes (
 	noise_public_key bytea,
 	debounce_limit integer NOT NULL DEFAULT 0,
 	features integer NOT NULL DEFAULT 0,
+	asn bigint,
+	asn_org text,
 	PRIMARY KEY ( id )
 ) ;
 CREATE TABLE node_api_versions (
@@ -1355,6 +1484,24 @@ CREATE TABLE bucket_eventing_configs (
 		ON DELETE CASCADE,
 	PRIMARY KEY ( project_id, bucket_name )
 ) ;
+CREATE TABLE bucket_limits (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	storage_limit bigint,
+	bandwidth_limit bigint,
+	segment_limit bigint,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+) ;
+CREATE TABLE bucket_lifecycle_configs (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	rules bytea NOT NULL,
+	deletion_cursor bytea,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+) ;
 CREATE TABLE domains (
 	subdomain text NOT NULL,
 	project_id bytea NOT NULL REFERENCES projects( id ),
@@ -1378,6 +1525,86 @@ CREATE TABLE project_members (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( member_id, project_id )
 ) ;
+CREATE TABLE project_roles (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	name text NOT NULL,
+	permissions bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( project_id, name )
+) ;
+CREATE TABLE project_member_roles (
+	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	role_id bytea NOT NULL REFERENCES project_roles( id ) ON DELETE CASCADE,
+	PRIMARY KEY ( member_id, project_id )
+) ;
+CREATE INDEX project_member_roles_role_id_index ON project_member_roles ( role_id ) ;
+CREATE TABLE scim_groups (
+	id bytea NOT NULL,
+	provider text NOT NULL,
+	display_name text NOT NULL,
+	external_id text,
+	project_id bytea,
+	role text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( provider, display_name )
+) ;
+CREATE TABLE scim_group_members (
+	group_id bytea NOT NULL REFERENCES scim_groups( id ) ON DELETE CASCADE,
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	PRIMARY KEY ( group_id, user_id )
+) ;
+CREATE INDEX scim_group_members_user_id_index ON scim_group_members ( user_id ) ;
+CREATE TABLE webauthn_credentials (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	credential_id bytea NOT NULL,
+	public_key bytea NOT NULL,
+	sign_count bigint NOT NULL,
+	transports text NOT NULL,
+	name text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	last_used_at timestamp with time zone,
+	PRIMARY KEY ( id ),
+	UNIQUE ( credential_id )
+) ;
+CREATE INDEX webauthn_credentials_user_id_index ON webauthn_credentials ( user_id ) ;
+CREATE TABLE webauthn_used_challenges (
+	challenge bytea NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( challenge )
+) ;
+CREATE INDEX webauthn_used_challenges_expires_at_index ON webauthn_used_challenges ( expires_at ) ;
+CREATE TABLE organizations (
+	id bytea NOT NULL,
+	name text NOT NULL,
+	owner_id bytea NOT NULL,
+	project_limit integer,
+	storage_limit bigint,
+	bandwidth_limit bigint,
+	segment_limit bigint,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+) ;
+CREATE INDEX organizations_owner_id_index ON organizations ( owner_id ) ;
+CREATE TABLE organization_members (
+	organization_id bytea NOT NULL REFERENCES organizations( id ) ON DELETE CASCADE,
+	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	role integer NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( organization_id, member_id )
+) ;
+CREATE INDEX organization_members_member_id_index ON organization_members ( member_id ) ;
+CREATE TABLE organization_projects (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	organization_id bytea NOT NULL REFERENCES organizations( id ),
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+) ;
+CREATE INDEX organization_projects_organization_id_index ON organization_projects ( organization_id ) ;
 CREATE TABLE rest_api_keys (
 	id bytea NOT NULL,
 	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
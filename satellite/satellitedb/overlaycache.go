@@ -75,7 +75,7 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 	switch cache.db.impl {
 	case dbutil.Cockroach, dbutil.Postgres:
 		query := `
-			SELECT id, address, email, wallet, last_net, last_ip_port, vetted_at, country_code, noise_proto, noise_public_key, debounce_limit, features, country_code, piece_count, free_disk
+			SELECT id, address, email, wallet, last_net, last_ip_port, vetted_at, country_code, noise_proto, noise_public_key, debounce_limit, features, country_code, piece_count, free_disk, asn, asn_org
 			FROM nodes
 			` + cache.db.impl.AsOfSystemInterval(selectionCfg.AsOfSystemTime.Interval()) + `
 			WHERE disqualified IS NULL
@@ -105,7 +105,7 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 		rows, err = cache.db.QueryContext(ctx, query, args...)
 	case dbutil.Spanner:
 		query := `
-			SELECT id, address, email, wallet, last_net, last_ip_port, vetted_at, country_code, noise_proto, noise_public_key, debounce_limit, features, country_code, piece_count, free_disk
+			SELECT id, address, email, wallet, last_net, last_ip_port, vetted_at, country_code, noise_proto, noise_public_key, debounce_limit, features, country_code, piece_count, free_disk, asn, asn_org
 			FROM nodes
 			WHERE disqualified IS NULL
 				AND unknown_audit_suspended IS NULL
@@ -150,14 +150,17 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 		var lastIPPort, email, wallet sql.NullString
 		var vettedAt *time.Time
 		var noise noiseScanner
+		var asn asnScanner
 		err = rows.Scan(&node.ID, &node.Address.Address, &email, &wallet, &node.LastNet, &lastIPPort, &vettedAt, &node.CountryCode, &noise.Proto,
-			&noise.PublicKey, &node.Address.DebounceLimit, &node.Address.Features, &node.CountryCode, &node.PieceCount, &node.FreeDisk)
+			&noise.PublicKey, &node.Address.DebounceLimit, &node.Address.Features, &node.CountryCode, &node.PieceCount, &node.FreeDisk,
+			&asn.ASN, &asn.Org)
 		if err != nil {
 			return nil, nil, err
 		}
 		if lastIPPort.Valid {
 			node.LastIPPort = lastIPPort.String
 		}
+		asn.apply(&node)
 		node.Address.NoiseInfo = noise.Convert()
 		node.Email = email.String
 		node.Wallet = wallet.String
@@ -206,7 +209,7 @@ func (cache *overlaycache) selectAllStorageNodesDownload(ctx context.Context, on
 	switch cache.db.impl {
 	case dbutil.Cockroach, dbutil.Postgres:
 		query := `
-			SELECT id, address, email, wallet, last_net, last_ip_port, noise_proto, noise_public_key, debounce_limit, features, country_code, piece_count, free_disk,
+			SELECT id, address, email, wallet, last_net, last_ip_port, noise_proto, noise_public_key, debounce_limit, features, country_code, piece_count, free_disk, asn, asn_org,
 					exit_initiated_at IS NOT NULL AS exiting, (unknown_audit_suspended IS NOT NULL OR offline_suspended IS NOT NULL) AS suspended, vetted_at is not null as vetted
 			FROM nodes
 			` + cache.db.impl.AsOfSystemInterval(asOfConfig.Interval()) + `
@@ -222,7 +225,7 @@ func (cache *overlaycache) selectAllStorageNodesDownload(ctx context.Context, on
 		rows, err = cache.db.QueryContext(ctx, query, args...)
 	case dbutil.Spanner:
 		query := `
-			SELECT id, address, email, wallet, last_net, last_ip_port, noise_proto, noise_public_key, debounce_limit, features, country_code, piece_count, free_disk,
+			SELECT id, address, email, wallet, last_net, last_ip_port, noise_proto, noise_public_key, debounce_limit, features, country_code, piece_count, free_disk, asn, asn_org,
 					exit_initiated_at IS NOT NULL AS exiting, (unknown_audit_suspended IS NOT NULL OR offline_suspended IS NOT NULL) AS suspended, vetted_at is not null as vetted
 			FROM nodes
 			` + cache.db.impl.AsOfSystemInterval(asOfConfig.Interval()) + `
@@ -250,15 +253,17 @@ func (cache *overlaycache) selectAllStorageNodesDownload(ctx context.Context, on
 		node.Address = &pb.NodeAddress{}
 		var lastIPPort sql.NullString
 		var noise noiseScanner
+		var asn asnScanner
 		var err = rows.Scan(&node.ID, &node.Address.Address, &node.Email, &node.Wallet, &node.LastNet, &lastIPPort, &noise.Proto,
 			&noise.PublicKey, &node.Address.DebounceLimit, &node.Address.Features, &node.CountryCode, &node.PieceCount, &node.FreeDisk,
-			&node.Exiting, &node.Suspended, &node.Vetted)
+			&asn.ASN, &asn.Org, &node.Exiting, &node.Suspended, &node.Vetted)
 		if err != nil {
 			return nil, err
 		}
 		if lastIPPort.Valid {
 			node.LastIPPort = lastIPPort.String
 		}
+		asn.apply(&node)
 		node.Address.NoiseInfo = noise.Convert()
 		// we consider all nodes in the download selection cache to be online.
 		node.Online = true
@@ -524,6 +529,7 @@ func (cache *overlaycache) GetParticipatingNodes(ctx context.Context, nodeIDs st
 			SELECT
 				id, address, email, wallet,
 				last_net, last_ip_port, country_code, piece_count, free_disk,
+				asn, asn_org,
 				last_contact_success > $2 AS online,
 				(offline_suspended IS NOT NULL OR unknown_audit_suspended IS NOT NULL) AS suspended,
 				exit_initiated_at IS NOT NULL AS exiting,
@@ -571,6 +577,7 @@ func (cache *overlaycache) GetParticipatingNodes(ctx context.Context, nodeIDs st
 					SELECT id, address, email, wallet, last_net,
 						last_ip_port, country_code,
 						piece_count, free_disk,
+						asn, asn_org,
 						last_contact_success > @online_threshold AS online,
 						(offline_suspended IS NOT NULL OR unknown_audit_suspended IS NOT NULL) AS suspended,
 						exit_initiated_at IS NOT NULL AS exiting,
@@ -599,6 +606,7 @@ func (cache *overlaycache) GetParticipatingNodes(ctx context.Context, nodeIDs st
 				var node nodeselection.SelectedNode
 				node.Address = &pb.NodeAddress{}
 				var lastIPPort, countryCode sql.NullString
+				var asn asnScanner
 
 				// TODO(spanner): currently only supports scanning into []*struct.
 				// Needs https://github.com/googleapis/google-cloud-go/issues/11090 to fix.
@@ -608,6 +616,7 @@ func (cache *overlaycache) GetParticipatingNodes(ctx context.Context, nodeIDs st
 					&node.ID, &node.Address.Address, &node.Email, &node.Wallet, &node.LastNet,
 					&lastIPPort, &countryCode,
 					&node.PieceCount, &node.FreeDisk,
+					&asn.ASN, &asn.Org,
 					&node.Online,
 					&node.Suspended,
 					&node.Exiting,
@@ -623,6 +632,7 @@ func (cache *overlaycache) GetParticipatingNodes(ctx context.Context, nodeIDs st
 				if countryCode.Valid {
 					node.CountryCode = location.ToCountryCode(countryCode.String)
 				}
+				asn.apply(&node)
 
 				if len(tags) > 0 {
 					node.Tags = make([]nodeselection.NodeTag, 0, len(tags))
@@ -708,6 +718,7 @@ func (cache *overlaycache) GetAllParticipatingNodes(ctx context.Context, onlineW
 
 	err = withRows(cache.db.QueryContext(ctx, cache.db.Rebind(`
 		SELECT id, address, email, wallet, last_net, last_ip_port, country_code, piece_count, free_disk,
+			asn, asn_org,
 			last_contact_success > ? AS online,
 			(offline_suspended IS NOT NULL OR unknown_audit_suspended IS NOT NULL) AS suspended,
 			exit_initiated_at IS NOT NULL AS exiting,
@@ -745,9 +756,11 @@ func scanSelectedNode(rows tagsql.Rows) (nodeselection.SelectedNode, error) {
 	var node nodeselection.SelectedNode
 	node.Address = &pb.NodeAddress{}
 	var lastIPPort, countryCode sql.NullString
+	var asn asnScanner
 	err := rows.Scan(
 		&node.ID,
 		&node.Address.Address, &node.Email, &node.Wallet, &node.LastNet, &lastIPPort, &countryCode, &node.PieceCount, &node.FreeDisk,
+		&asn.ASN, &asn.Org,
 		&node.Online, &node.Suspended, &node.Exiting, &node.Vetted)
 	if err != nil {
 		return nodeselection.SelectedNode{}, err
@@ -759,6 +772,7 @@ func scanSelectedNode(rows tagsql.Rows) (nodeselection.SelectedNode, error) {
 	if countryCode.Valid {
 		node.CountryCode = location.ToCountryCode(countryCode.String)
 	}
+	asn.apply(&node)
 	return node, nil
 }
 
@@ -767,11 +781,13 @@ func scanSelectedNodeWithTags(rows tagsql.Rows) (_ nodeselection.SelectedNode, e
 	node.Address = &pb.NodeAddress{}
 
 	var lastIPPort, countryCode sql.NullString
+	var asn asnScanner
 	var tagsJSON []byte
 
 	err = rows.Scan(
 		&node.ID,
 		&node.Address.Address, &node.Email, &node.Wallet, &node.LastNet, &lastIPPort, &countryCode, &node.PieceCount, &node.FreeDisk,
+		&asn.ASN, &asn.Org,
 		&node.Online, &node.Suspended, &node.Exiting, &node.Vetted, &tagsJSON)
 	if err != nil {
 		return nodeselection.SelectedNode{}, Error.Wrap(err)
@@ -783,6 +799,7 @@ func scanSelectedNodeWithTags(rows tagsql.Rows) (_ nodeselection.SelectedNode, e
 	if countryCode.Valid {
 		node.CountryCode = location.ToCountryCode(countryCode.String)
 	}
+	asn.apply(&node)
 
 	if len(tagsJSON) > 0 {
 		var tags []struct {
@@ -982,6 +999,52 @@ func (cache *overlaycache) ActiveNodesPieceCounts(ctx context.Context) (_ map[st
 	return pieceCounts, nodeIDErrs.Err()
 }
 
+// ActiveNodesWithMinimumVersion returns the IDs of nodes that are not disqualified and are not
+// exiting, which run at least the given version.
+func (cache *overlaycache) ActiveNodesWithMinimumVersion(ctx context.Context, minimum version.SemVer) (_ storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var query string
+	switch cache.db.impl {
+	case dbutil.Cockroach, dbutil.Postgres:
+		query = `
+			SELECT id FROM nodes
+			WHERE disqualified IS NULL
+				AND exit_initiated_at IS NULL
+				AND exit_finished_at IS NULL
+				AND (major > $1 OR (major = $2 AND (minor > $3 OR (minor = $4 AND patch >= $5))))
+		`
+	case dbutil.Spanner:
+		query = `
+			SELECT id FROM nodes
+			WHERE disqualified IS NULL
+				AND exit_initiated_at IS NULL
+				AND exit_finished_at IS NULL
+				AND (major > ? OR (major = ? AND (minor > ? OR (minor = ? AND patch >= ?))))
+		`
+	default:
+		return nil, Error.New("unsupported implementation")
+	}
+
+	rows, err := cache.db.QueryContext(ctx, query,
+		minimum.Major, minimum.Major, minimum.Minor, minimum.Minor, minimum.Patch)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var nodeIDs storj.NodeIDList
+	for rows.Next() {
+		var nodeID storj.NodeID
+		if err := rows.Scan(&nodeID); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+
+	return nodeIDs, Error.Wrap(rows.Err())
+}
+
 func (cache *overlaycache) UpdatePieceCounts(ctx context.Context, pieceCounts map[storj.NodeID]int64) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	if len(pieceCounts) == 0 {
@@ -1327,6 +1390,12 @@ func convertDBNode(ctx context.Context, info *dbx.Node) (_ *overlay.NodeDossier,
 	if info.CountryCode != nil {
 		node.CountryCode = location.ToCountryCode(*info.CountryCode)
 	}
+	if info.Asn != nil {
+		node.ASN = uint32(*info.Asn)
+	}
+	if info.AsnOrg != nil {
+		node.ASNOrg = *info.AsnOrg
+	}
 	if info.Contained != nil {
 		node.Contained = true
 	}
@@ -1510,6 +1579,7 @@ func (cache *overlaycache) updateCheckInDirectUpdate(ctx context.Context, node o
 		}
 		noisePublicKey = node.Address.NoiseInfo.PublicKey
 	}
+	asn, asnOrg := asnColumns(node)
 
 	var res sql.Result
 
@@ -1541,6 +1611,8 @@ func (cache *overlaycache) updateCheckInDirectUpdate(ctx context.Context, node o
 				noise_public_key=$22,
 				debounce_limit=$23,
 				features=$24,
+				asn=$25,
+				asn_org=$26,
 				last_software_update_email = CASE
 					WHEN $19::bool IS TRUE THEN $15::timestamptz
 					WHEN $20::bool IS FALSE THEN NULL
@@ -1571,6 +1643,8 @@ func (cache *overlaycache) updateCheckInDirectUpdate(ctx context.Context, node o
 			node.SoftwareUpdateEmailSent, node.VersionBelowMin,
 			// args $21 - $24
 			noiseProto, noisePublicKey, node.Address.DebounceLimit, node.Address.Features,
+			// args $25 - $26
+			asn, asnOrg,
 		)
 
 	case dbutil.Spanner:
@@ -1593,6 +1667,7 @@ func (cache *overlaycache) updateCheckInDirectUpdate(ctx context.Context, node o
 				last_ip_port=?, wallet_features=?, country_code=?,
 				noise_proto=?, noise_public_key=?,
 				debounce_limit=?, features=?,
+				asn=?, asn_org=?,
 				last_software_update_email = CASE
 					WHEN CAST(? AS bool) IS TRUE THEN CAST(? AS TIMESTAMP)
 					WHEN CAST(? AS bool) IS FALSE THEN NULL
@@ -1614,6 +1689,7 @@ func (cache *overlaycache) updateCheckInDirectUpdate(ctx context.Context, node o
 			node.LastIPPort, walletFeatures, node.CountryCode.String(),
 			noiseProto, noisePublicKey,
 			int(node.Address.DebounceLimit), node.Address.Features,
+			asn, asnOrg,
 
 			node.SoftwareUpdateEmailSent, timestamp, node.VersionBelowMin,
 			node.IsUp,
@@ -1670,6 +1746,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 		}
 		noisePublicKey = node.Address.NoiseInfo.PublicKey
 	}
+	asn, asnOrg := asnColumns(node)
+
 	switch cache.db.impl {
 	case dbutil.Postgres, dbutil.Cockroach:
 		_, err = cache.db.ExecContext(ctx, `
@@ -1682,7 +1760,7 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				major, minor, patch, commit_hash, release_timestamp, release,
 				last_ip_port, wallet_features, country_code,
 				noise_proto, noise_public_key, debounce_limit,
-				features
+				features, asn, asn_org
 			)
 			VALUES (
 				$1, $2, $3, $4,
@@ -1696,7 +1774,7 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				$9, $10, $11, $12, $13, $14,
 				$16, $17, $18,
 				$21, $22, $23,
-				$24
+				$24, $25, $26
 			)
 			ON CONFLICT (id)
 			DO UPDATE
@@ -1723,6 +1801,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				noise_public_key=$22,
 				debounce_limit=$23,
 				features=$24,
+				asn=$25,
+				asn_org=$26,
 				last_software_update_email = CASE
 					WHEN $19::bool IS TRUE THEN $15::timestamptz
 					WHEN $20::bool IS FALSE THEN NULL
@@ -1749,6 +1829,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 			node.SoftwareUpdateEmailSent, node.VersionBelowMin,
 			// args $21 - $24
 			noiseProto, noisePublicKey, node.Address.DebounceLimit, node.Address.Features,
+			// args $25 - $26
+			asn, asnOrg,
 		)
 	case dbutil.Spanner:
 
@@ -1769,6 +1851,7 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				last_ip_port=?, wallet_features=?, country_code=?,
 				noise_proto=?, noise_public_key=?,
 				debounce_limit=?, features=?,
+				asn=?, asn_org=?,
 				last_software_update_email = CASE
 					WHEN CAST(? AS bool) IS TRUE THEN CAST(? AS timestamp)
 					WHEN CAST(? AS bool) IS FALSE THEN NULL
@@ -1787,6 +1870,7 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 			node.LastIPPort, walletFeatures, node.CountryCode.String(),
 			noiseProto, noisePublicKey,
 			int(node.Address.DebounceLimit), node.Address.Features,
+			asn, asnOrg,
 			node.SoftwareUpdateEmailSent, timestamp, node.VersionBelowMin,
 			node.IsUp, node.NodeID.Bytes(),
 		)
@@ -1798,7 +1882,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				last_contact_success, last_contact_failure, major, minor,
 				patch, commit_hash, release_timestamp, release,
 				last_ip_port, wallet_features, country_code,
-				noise_proto, noise_public_key, debounce_limit, features
+				noise_proto, noise_public_key, debounce_limit, features,
+				asn, asn_org
 			)
 			VALUES (
 				?, ?, ?, ?, ?, ?, ?,
@@ -1808,7 +1893,7 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				CASE WHEN CAST(? AS bool) IS FALSE THEN CAST(? AS timestamp)
 					ELSE CAST('0001-01-01 00:00:00+00' AS timestamp)
 				END,
-				?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
+				?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?
 			);
 				`,
 				node.NodeID.Bytes(), node.Address.GetAddress(), node.LastNet,
@@ -1819,6 +1904,7 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				node.Version.Timestamp, node.Version.GetRelease(), node.LastIPPort,
 				walletFeatures, node.CountryCode.String(), noiseProto,
 				noisePublicKey, int(node.Address.DebounceLimit), node.Address.Features,
+				asn, asnOrg,
 			)
 		}
 	default:
@@ -1831,6 +1917,14 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 	return nil
 }
 
+// asnColumns returns the autonomous system of the node as nullable column values.
+func asnColumns(node overlay.NodeCheckInInfo) (asn sql.NullInt64, asnOrg sql.NullString) {
+	if node.ASN == 0 {
+		return asn, asnOrg
+	}
+	return sql.NullInt64{Int64: int64(node.ASN), Valid: true}, sql.NullString{String: node.ASNOrg, Valid: node.ASNOrg != ""}
+}
+
 // SetNodeContained updates the contained field for the node record. If
 // `contained` is true, the contained field in the record is set to the current
 // database time, if it is not already set. If `contained` is false, the
@@ -2298,6 +2392,19 @@ func (n *noiseScanner) Convert() *pb.NoiseInfo {
 	}
 }
 
+// asnScanner scans the nullable autonomous system columns of a node.
+type asnScanner struct {
+	ASN *int64
+	Org sql.NullString
+}
+
+func (a *asnScanner) apply(node *nodeselection.SelectedNode) {
+	if a.ASN != nil {
+		node.ASN = uint32(*a.ASN)
+	}
+	node.ASNOrg = a.Org.String
+}
+
 // OneTimeFixLastNets updates the last_net values for all node records to be equal to their
 // last_ip_port values.
 //
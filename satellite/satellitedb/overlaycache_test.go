@@ -876,6 +876,30 @@ func TestOverlayCache_ActiveNodesPieceCounts(t *testing.T) {
 	})
 }
 
+func TestOverlayCache_ActiveNodesWithMinimumVersion(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		overlay := db.OverlayCache()
+
+		onlineNode := addNode(ctx, t, overlay, "online           ", "127.0.0.1", time.Second, false, false, false, false, false)
+		offlineNode := addNode(ctx, t, overlay, "offline          ", "127.0.0.2", 2*time.Hour, false, false, false, false, false)
+
+		addNode(ctx, t, overlay, "disqualified     ", "127.0.0.3", 2*time.Hour, true, false, false, false, false)
+		addNode(ctx, t, overlay, "exiting          ", "127.0.0.5", 2*time.Hour, false, false, false, true, false)
+
+		// all nodes run v0.0.0
+		nodeIDs, err := overlay.ActiveNodesWithMinimumVersion(ctx, version.SemVer{})
+		require.NoError(t, err)
+		require.ElementsMatch(t, storj.NodeIDList{onlineNode.id, offlineNode.id}, nodeIDs)
+
+		minimum, err := version.NewSemVer("v0.1.0")
+		require.NoError(t, err)
+
+		nodeIDs, err = overlay.ActiveNodesWithMinimumVersion(ctx, minimum)
+		require.NoError(t, err)
+		require.Empty(t, nodeIDs)
+	})
+}
+
 func TestGetNodesByEmail(t *testing.T) {
 	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
 		cache := db.OverlayCache()
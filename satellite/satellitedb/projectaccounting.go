@@ -651,6 +651,44 @@ func (db *ProjectAccounting) TestingGetProjectSettledBandwidthTotal(ctx context.
 	return *sum, err
 }
 
+// GetBucketBandwidth returns the GET bandwidth allocated for a bucket in the specified time frame.
+func (db *ProjectAccounting) GetBucketBandwidth(ctx context.Context, bucket metabase.BucketLocation, from, to time.Time) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var egress *int64
+	// action uses int64 for compatibility with Spanner as Spanner does not support int32
+	actionGet := int64(pb.PieceAction_GET)
+	query := `SELECT SUM(allocated + inline) FROM bucket_bandwidth_rollups
+		WHERE project_id = ? AND bucket_name = ? AND action = ? AND interval_start >= ? AND interval_start < ?`
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(query), bucket.ProjectID[:], []byte(bucket.BucketName), actionGet, from.UTC(), to.UTC()).Scan(&egress)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && egress == nil) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	return *egress, nil
+}
+
+// GetLatestBucketTally returns the storage and segment usage of a bucket recorded by its latest tally.
+func (db *ProjectAccounting) GetLatestBucketTally(ctx context.Context, bucket metabase.BucketLocation) (storage, segments int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `SELECT total_bytes, total_segments_count FROM bucket_storage_tallies
+		WHERE project_id = ? AND bucket_name = ?
+		ORDER BY interval_start DESC
+		LIMIT 1`
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(query), bucket.ProjectID[:], []byte(bucket.BucketName)).Scan(&storage, &segments)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, Error.Wrap(err)
+	}
+
+	return storage, segments, nil
+}
+
 // GetProjectBandwidth returns the used bandwidth (settled or allocated) for the specified year, month and day.
 func (db *ProjectAccounting) GetProjectBandwidth(ctx context.Context, projectID uuid.UUID, year int, month time.Month, day int, asOfSystemInterval time.Duration) (_ int64, err error) {
 	defer mon.Task()(&ctx)(&err)
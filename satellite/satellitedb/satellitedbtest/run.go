@@ -92,6 +92,15 @@ func Databases[T dbtest.TB](t T) []SatelliteDatabases {
 		})
 	}
 
+	sqlite := dbtest.PickSQLiteNoSkip()
+	if sqlite != "" && !strings.EqualFold(sqlite, "omit") {
+		dbs = append(dbs, SatelliteDatabases{
+			Name:       "SQLite",
+			MasterDB:   Database{"SQLite", "", "SQLite is only supported by the metabase.", nil},
+			MetabaseDB: Database{"SQLite", sqlite, "", nil},
+		})
+	}
+
 	return dbs
 }
 
@@ -0,0 +1,446 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package taskqueue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+)
+
+const (
+	// deadSuffix is appended to the stream name for the dead-letter stream.
+	deadSuffix = ":dead"
+	// retrySuffix is appended to the stream name for the sorted set of failed jobs waiting for a retry.
+	retrySuffix = ":retry"
+	// delayedSuffix is appended to the stream name for the sorted set of jobs scheduled for later.
+	delayedSuffix = ":delayed"
+
+	// attemptsField is the number of the failed attempts of a retried or dead job.
+	attemptsField = "_attempts"
+	// errorField is the error of the last attempt of a dead job.
+	errorField = "_error"
+
+	// promoteInterval is how often the due jobs of a stream are moved into the stream.
+	promoteInterval = time.Second
+	// promoteBatch is the maximum number of due jobs moved at once.
+	promoteBatch = 100
+)
+
+// promoteScript moves the due jobs from the sorted sets to the stream atomically,
+// so a job is never lost or duplicated by concurrent consumers.
+var promoteScript = redis.NewScript(`
+local count = 0
+for i = 2, #KEYS do
+	local due = redis.call('ZRANGEBYSCORE', KEYS[i], '-inf', ARGV[1], 'LIMIT', 0, tonumber(ARGV[2]))
+	for _, member in ipairs(due) do
+		redis.call('ZREM', KEYS[i], member)
+		local entry = cjson.decode(member)
+		redis.call('XADD', KEYS[1], '*', unpack(entry.fields))
+		count = count + 1
+	end
+end
+return count
+`)
+
+// delayedEntry is the member of the sorted sets of retried and delayed jobs.
+type delayedEntry struct {
+	// Nonce keeps identical jobs distinct in the sorted set.
+	Nonce  string   `json:"nonce"`
+	Fields []string `json:"fields"`
+}
+
+// Message is a job popped with PopPending. It stays in the pending entries list of
+// the stream until it's finished with Ack or Fail.
+type Message struct {
+	StreamID string
+	ID       string
+	// Attempt is the number of the current attempt, starting with 1.
+	Attempt int
+
+	// deliveries is the delivery count of the message in the pending entries list.
+	deliveries int64
+	values     map[string]any
+}
+
+// PushDelayed adds a single item to the given stream at the given time. item must be a struct
+// or pointer to struct.
+func (c *Client) PushDelayed(ctx context.Context, streamID string, item any, at time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fields, err := marshalStruct(item)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return c.schedule(ctx, streamID+delayedSuffix, fields, at)
+}
+
+// PopPending reads the next message from the stream and unmarshals it into dest, but keeps it
+// pending until it's acknowledged with Ack or Fail. Messages which stay unacknowledged longer than
+// the visibility timeout, e.g. because the consumer crashed, are delivered again. dest must be a
+// pointer to a struct. Returns false if no message was available within the timeout.
+func (c *Client) PopPending(ctx context.Context, streamID string, dest any, timeout time.Duration) (_ Message, _ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := c.ensureGroup(ctx, streamID); err != nil {
+		return Message{}, false, err
+	}
+	if err := c.promoteDue(ctx, streamID); err != nil {
+		return Message{}, false, err
+	}
+
+	for {
+		msg, found, err := c.receive(ctx, streamID, timeout)
+		if err != nil || !found {
+			return Message{}, false, err
+		}
+
+		// the job crashed the consumers too many times.
+		if msg.Attempt > c.maxAttempts() {
+			if err := c.bury(ctx, msg, errors.New("visibility timeout exceeded")); err != nil {
+				return Message{}, false, err
+			}
+			continue
+		}
+
+		if err := unmarshalStruct(msg.values, dest); err != nil {
+			return Message{}, false, errs.Combine(Error.Wrap(err), c.bury(ctx, msg, err))
+		}
+
+		return msg, true, nil
+	}
+}
+
+// Ack acknowledges a message popped with PopPending and deletes it from the stream.
+func (c *Client) Ack(ctx context.Context, msg Message) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pipe := c.db.TxPipeline()
+	pipe.XAck(ctx, msg.StreamID, c.group, msg.ID)
+	pipe.XDel(ctx, msg.StreamID, msg.ID)
+	_, err = pipe.Exec(ctx)
+	return Error.Wrap(err)
+}
+
+// Fail acknowledges a message popped with PopPending whose processing failed. The job is
+// retried with a backoff, until it runs out of attempts and is moved to the dead-letter
+// stream "<stream>:dead". It returns true when the job was moved to the dead-letter stream.
+func (c *Client) Fail(ctx context.Context, msg Message, cause error) (dead bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if msg.Attempt >= c.maxAttempts() {
+		return true, c.bury(ctx, msg, cause)
+	}
+
+	fields := jobFields(msg.values)
+	fields[attemptsField] = strconv.Itoa(msg.Attempt)
+
+	member, err := newDelayedEntry(fields)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+
+	pipe := c.db.TxPipeline()
+	pipe.ZAdd(ctx, msg.StreamID+retrySuffix, redis.Z{
+		Score:  float64(time.Now().Add(c.retryBackoff(msg.Attempt)).UnixMilli()),
+		Member: member,
+	})
+	pipe.XAck(ctx, msg.StreamID, c.group, msg.ID)
+	pipe.XDel(ctx, msg.StreamID, msg.ID)
+	_, err = pipe.Exec(ctx)
+	return false, Error.Wrap(err)
+}
+
+// Extend claims the messages popped with PopPending again, which resets their idle time,
+// so they aren't reclaimed by other consumers while they are still being processed.
+// Messages which were acknowledged in the meantime are left alone.
+func (c *Client) Extend(ctx context.Context, msgs ...Message) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pipe := c.db.Pipeline()
+	for _, msg := range msgs {
+		// RETRYCOUNT keeps the delivery count, which would otherwise count the
+		// extension as a new attempt. JUSTID returns only the ids of the claimed
+		// messages.
+		pipe.Do(ctx, "XCLAIM", msg.StreamID, c.group, c.consumer, 0, msg.ID,
+			"RETRYCOUNT", msg.deliveries, "JUSTID")
+	}
+	_, err = pipe.Exec(ctx)
+	return Error.Wrap(err)
+}
+
+// heartbeat extends the messages of running jobs, until they are finished.
+type heartbeat struct {
+	log    *zap.Logger
+	client *Client
+
+	mu      sync.Mutex
+	pending map[string]Message
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// startHeartbeat starts extending the messages every third of the visibility timeout, so jobs
+// which run longer than the visibility timeout aren't delivered to another consumer. It returns
+// nil when the messages aren't acknowledged.
+func (c *Client) startHeartbeat(ctx context.Context, log *zap.Logger, msgs []Message) *heartbeat {
+	if c.config.VisibilityTimeout <= 0 || len(msgs) == 0 || msgs[0].ID == "" {
+		return nil
+	}
+
+	hb := &heartbeat{
+		log:     log,
+		client:  c,
+		pending: make(map[string]Message, len(msgs)),
+		done:    make(chan struct{}),
+	}
+	for _, msg := range msgs {
+		hb.pending[msg.ID] = msg
+	}
+
+	ctx, hb.cancel = context.WithCancel(ctx)
+	go hb.run(ctx, c.config.VisibilityTimeout/3)
+	return hb
+}
+
+func (hb *heartbeat) run(ctx context.Context, interval time.Duration) {
+	defer close(hb.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		hb.mu.Lock()
+		msgs := make([]Message, 0, len(hb.pending))
+		for _, msg := range hb.pending {
+			msgs = append(msgs, msg)
+		}
+		hb.mu.Unlock()
+
+		if len(msgs) == 0 {
+			continue
+		}
+		if err := hb.client.Extend(ctx, msgs...); err != nil && ctx.Err() == nil {
+			hb.log.Warn("failed to extend running jobs", zap.Int("count", len(msgs)), zap.Error(err))
+		}
+	}
+}
+
+// finish stops extending the messages.
+func (hb *heartbeat) finish(msgs ...Message) {
+	if hb == nil {
+		return
+	}
+
+	hb.mu.Lock()
+	defer hb.mu.Unlock()
+	for _, msg := range msgs {
+		delete(hb.pending, msg.ID)
+	}
+}
+
+// stop stops the heartbeat and waits for it to exit.
+func (hb *heartbeat) stop() {
+	if hb == nil {
+		return
+	}
+
+	hb.cancel()
+	<-hb.done
+}
+
+// receive reclaims a message which exceeded the visibility timeout or reads a new one.
+func (c *Client) receive(ctx context.Context, streamID string, timeout time.Duration) (_ Message, _ bool, err error) {
+	if c.config.VisibilityTimeout > 0 {
+		claimed, _, err := c.db.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+			Stream:   streamID,
+			Group:    c.group,
+			Consumer: c.consumer,
+			MinIdle:  c.config.VisibilityTimeout,
+			Start:    "0-0",
+			Count:    1,
+		}).Result()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return Message{}, false, Error.Wrap(err)
+		}
+
+		if len(claimed) > 0 {
+			pending, err := c.db.XPendingExt(ctx, &redis.XPendingExtArgs{
+				Stream: streamID,
+				Group:  c.group,
+				Start:  claimed[0].ID,
+				End:    claimed[0].ID,
+				Count:  1,
+			}).Result()
+			if err != nil {
+				return Message{}, false, Error.Wrap(err)
+			}
+
+			deliveries := int64(1)
+			if len(pending) > 0 {
+				deliveries = pending[0].RetryCount
+			}
+			return newMessage(streamID, claimed[0], deliveries), true, nil
+		}
+	}
+
+	streams, err := c.db.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    c.group,
+		Consumer: c.consumer,
+		Streams:  []string{streamID, ">"},
+		Count:    1,
+		Block:    timeout,
+	}).Result()
+	if errors.Is(err, redis.Nil) {
+		return Message{}, false, nil
+	}
+	if err != nil {
+		return Message{}, false, Error.Wrap(err)
+	}
+
+	if len(streams) == 0 || len(streams[0].Messages) == 0 {
+		return Message{}, false, nil
+	}
+
+	return newMessage(streamID, streams[0].Messages[0], 1), true, nil
+}
+
+// bury moves a message to the dead-letter stream.
+func (c *Client) bury(ctx context.Context, msg Message, cause error) error {
+	fields := jobFields(msg.values)
+	fields[attemptsField] = strconv.Itoa(msg.Attempt)
+	if cause != nil {
+		fields[errorField] = cause.Error()
+	}
+
+	pipe := c.db.TxPipeline()
+	pipe.XAdd(ctx, &redis.XAddArgs{
+		Stream: msg.StreamID + deadSuffix,
+		Values: fields,
+	})
+	pipe.XAck(ctx, msg.StreamID, c.group, msg.ID)
+	pipe.XDel(ctx, msg.StreamID, msg.ID)
+	_, err := pipe.Exec(ctx)
+	if err == nil {
+		mon.Counter("taskqueue_dead").Inc(1)
+	}
+	return Error.Wrap(err)
+}
+
+// schedule adds the job fields to the sorted set to be moved to the stream at the given time.
+func (c *Client) schedule(ctx context.Context, key string, fields map[string]any, at time.Time) error {
+	member, err := newDelayedEntry(fields)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(c.db.ZAdd(ctx, key, redis.Z{
+		Score:  float64(at.UnixMilli()),
+		Member: member,
+	}).Err())
+}
+
+// promoteDue moves the retried and delayed jobs which are due to the stream. It runs at most
+// once per promoteInterval for each stream.
+func (c *Client) promoteDue(ctx context.Context, streamID string) error {
+	now := time.Now()
+	if last, ok := c.promoted.Load(streamID); ok && now.Sub(last.(time.Time)) < promoteInterval {
+		return nil
+	}
+	c.promoted.Store(streamID, now)
+
+	err := promoteScript.Run(ctx, c.db,
+		[]string{streamID, streamID + retrySuffix, streamID + delayedSuffix},
+		now.UnixMilli(), promoteBatch,
+	).Err()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
+func (c *Client) maxAttempts() int {
+	return max(c.config.MaxAttempts, 1)
+}
+
+// retryBackoff returns the delay before retrying a job, which failed the attempt.
+func (c *Client) retryBackoff(attempt int) time.Duration {
+	backoff := c.config.RetryBackoff
+	for i := 1; i < attempt && backoff < c.config.MaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if c.config.MaxRetryBackoff > 0 {
+		backoff = min(backoff, c.config.MaxRetryBackoff)
+	}
+	return backoff
+}
+
+func newMessage(streamID string, msg redis.XMessage, deliveries int64) Message {
+	attempts, _ := msg.Values[attemptsField].(string)
+	previous, _ := strconv.Atoi(attempts)
+
+	return Message{
+		StreamID:   streamID,
+		ID:         msg.ID,
+		Attempt:    previous + int(deliveries),
+		deliveries: deliveries,
+		values:     msg.Values,
+	}
+}
+
+// jobFields returns the fields of the job without the bookkeeping fields.
+func jobFields(values map[string]any) map[string]any {
+	fields := make(map[string]any, len(values)+2)
+	for name, value := range values {
+		if name == attemptsField || name == errorField {
+			continue
+		}
+		fields[name] = value
+	}
+	return fields
+}
+
+func newDelayedEntry(fields map[string]any) (string, error) {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	entry := delayedEntry{Fields: make([]string, 0, 2*len(fields))}
+	for _, name := range names {
+		value, ok := fields[name].(string)
+		if !ok {
+			return "", Error.New("field %q: expected string value, got %T", name, fields[name])
+		}
+		entry.Fields = append(entry.Fields, name, value)
+	}
+
+	nonce, err := uuid.New()
+	if err != nil {
+		return "", err
+	}
+	entry.Nonce = nonce.String()
+
+	data, err := json.Marshal(entry)
+	return string(data), err
+}
@@ -0,0 +1,375 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package taskqueue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/private/testredis"
+)
+
+func newAckTestClient(ctx context.Context, t *testing.T, config Config) *Client {
+	redis, err := testredis.Mini(ctx)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, redis.Close()) })
+
+	config.Address = "redis://" + redis.Addr()
+	config.Group = "test-ack"
+	config.Consumer = "test-consumer"
+
+	client, err := NewClient(ctx, config)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, client.Close()) })
+
+	return client
+}
+
+func TestPopPendingAck(t *testing.T) {
+	ctx := t.Context()
+	client := newAckTestClient(ctx, t, Config{
+		VisibilityTimeout: 100 * time.Millisecond,
+		MaxAttempts:       2,
+	})
+
+	stream := "test-pop-pending"
+	require.NoError(t, client.Push(ctx, stream, testJob{NodeID: "node-1"}))
+
+	var got testJob
+	msg, ok, err := client.PopPending(ctx, stream, &got, time.Second)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "node-1", got.NodeID)
+	assert.Equal(t, 1, msg.Attempt)
+
+	// the job is pending and isn't delivered again before the visibility timeout.
+	_, ok, err = client.PopPending(ctx, stream, &got, 10*time.Millisecond)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	stats, err := client.streamStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, StreamStats{Length: 1, Pending: 1}, stats[stream])
+
+	// the consumer "crashed", so the job is reclaimed.
+	time.Sleep(150 * time.Millisecond)
+
+	got = testJob{}
+	msg, ok, err = client.PopPending(ctx, stream, &got, time.Second)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "node-1", got.NodeID)
+	assert.Equal(t, 2, msg.Attempt)
+
+	require.NoError(t, client.Ack(ctx, msg))
+
+	stats, err = client.streamStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, StreamStats{}, stats[stream])
+
+	// a job which keeps crashing the consumers ends up in the dead-letter stream.
+	require.NoError(t, client.Push(ctx, stream, testJob{NodeID: "node-2"}))
+	for range 2 {
+		_, ok, err = client.PopPending(ctx, stream, &got, time.Second)
+		require.NoError(t, err)
+		require.True(t, ok)
+		time.Sleep(150 * time.Millisecond)
+	}
+
+	_, ok, err = client.PopPending(ctx, stream, &got, 10*time.Millisecond)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	dead, err := client.db.XRange(ctx, stream+deadSuffix, "-", "+").Result()
+	require.NoError(t, err)
+	require.Len(t, dead, 1)
+	assert.Equal(t, "node-2", dead[0].Values["nodeid"])
+	assert.Equal(t, "3", dead[0].Values[attemptsField])
+}
+
+func TestFailRetryAndDead(t *testing.T) {
+	ctx := t.Context()
+	client := newAckTestClient(ctx, t, Config{
+		VisibilityTimeout: time.Minute,
+		MaxAttempts:       3,
+		RetryBackoff:      50 * time.Millisecond,
+		MaxRetryBackoff:   time.Second,
+	})
+
+	stream := "test-fail"
+	require.NoError(t, client.Push(ctx, stream, testJob{NodeID: "node-1", Retry: 7}))
+
+	for attempt := 1; attempt <= 3; attempt++ {
+		var got testJob
+		var msg Message
+		require.Eventually(t, func() bool {
+			client.promoted.Clear()
+
+			var ok bool
+			var err error
+			msg, ok, err = client.PopPending(ctx, stream, &got, 10*time.Millisecond)
+			require.NoError(t, err)
+			return ok
+		}, 5*time.Second, 10*time.Millisecond)
+
+		assert.Equal(t, "node-1", got.NodeID)
+		assert.Equal(t, 7, got.Retry)
+		assert.Equal(t, attempt, msg.Attempt)
+
+		dead, err := client.Fail(ctx, msg, errors.New("failure"))
+		require.NoError(t, err)
+		assert.Equal(t, attempt == 3, dead)
+
+		if !dead {
+			stats, err := client.streamStats(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, StreamStats{Retry: 1}, stats[stream])
+		}
+	}
+
+	stats, err := client.streamStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, StreamStats{Dead: 1}, stats[stream])
+
+	dead, err := client.db.XRange(ctx, stream+deadSuffix, "-", "+").Result()
+	require.NoError(t, err)
+	require.Len(t, dead, 1)
+	assert.Equal(t, "failure", dead[0].Values[errorField])
+	assert.Equal(t, "3", dead[0].Values[attemptsField])
+
+	var got testJob
+	require.NoError(t, unmarshalStruct(dead[0].Values, &got))
+	assert.Equal(t, "node-1", got.NodeID)
+	assert.Equal(t, 7, got.Retry)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	client := &Client{config: Config{
+		RetryBackoff:    time.Second,
+		MaxRetryBackoff: 5 * time.Second,
+	}}
+
+	assert.Equal(t, time.Second, client.retryBackoff(1))
+	assert.Equal(t, 2*time.Second, client.retryBackoff(2))
+	assert.Equal(t, 4*time.Second, client.retryBackoff(3))
+	assert.Equal(t, 5*time.Second, client.retryBackoff(4))
+	assert.Equal(t, 5*time.Second, client.retryBackoff(100))
+}
+
+func TestPushDelayed(t *testing.T) {
+	ctx := t.Context()
+	client := newAckTestClient(ctx, t, Config{})
+
+	stream := "test-delayed"
+	require.NoError(t, client.PushDelayed(ctx, stream, testJob{NodeID: "later"}, time.Now().Add(time.Hour)))
+	require.NoError(t, client.PushDelayed(ctx, stream, testJob{NodeID: "now"}, time.Now()))
+	// identical jobs are kept separately.
+	require.NoError(t, client.PushDelayed(ctx, stream, testJob{NodeID: "now"}, time.Now()))
+
+	stats, err := client.streamStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), stats[stream].Delayed)
+
+	for range 2 {
+		var got testJob
+		ok, err := client.Pop(ctx, stream, &got, 10*time.Millisecond)
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, "now", got.NodeID)
+	}
+
+	var got testJob
+	ok, err := client.Pop(ctx, stream, &got, 10*time.Millisecond)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	stats, err = client.streamStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, StreamStats{Delayed: 1}, stats[stream])
+}
+
+func TestRunnerAcknowledge(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	defer cancel()
+
+	client := newAckTestClient(ctx, t, Config{
+		VisibilityTimeout: time.Minute,
+		MaxAttempts:       2,
+		RetryBackoff:      time.Millisecond,
+	})
+
+	stream := "test-runner-ack"
+	for i := range 10 {
+		require.NoError(t, client.Push(ctx, stream, testJob{NodeID: fmt.Sprintf("node-%d", i), Retry: i}))
+	}
+
+	var mu sync.Mutex
+	attempts := map[string]int{}
+	var succeeded atomic.Int32
+
+	runner := NewRunner[testJob](
+		zaptest.NewLogger(t),
+		RunnerConfig{
+			WorkerCount: 2,
+			Interval:    10 * time.Millisecond,
+			BatchSize:   5,
+			Acknowledge: true,
+		},
+		client,
+		stream,
+		ProcessorFunc[testJob](func(ctx context.Context, job testJob) error {
+			mu.Lock()
+			attempts[job.NodeID]++
+			attempt := attempts[job.NodeID]
+			mu.Unlock()
+
+			switch {
+			case job.Retry%3 == 0:
+				// always fails
+				return errors.New("failure")
+			case job.Retry%2 == 0 && attempt == 1:
+				// fails the first attempt
+				return errors.New("failure")
+			}
+			succeeded.Add(1)
+			return nil
+		}),
+	)
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	wg.Go(func() { _ = runner.Run(ctx) })
+
+	require.Eventually(t, func() bool {
+		stats, err := client.streamStats(ctx)
+		require.NoError(t, err)
+		return succeeded.Load() == 6 && stats[stream] == StreamStats{Dead: 4}
+	}, 10*time.Second, 50*time.Millisecond)
+
+	cancel()
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 2, attempts["node-0"])
+	assert.Equal(t, 1, attempts["node-1"])
+	assert.Equal(t, 2, attempts["node-2"])
+	assert.Equal(t, 2, attempts["node-3"])
+}
+
+func TestRunnerHeartbeat(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	defer cancel()
+
+	redis, err := testredis.Mini(ctx)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, redis.Close()) }()
+
+	newClient := func(consumer string) *Client {
+		client, err := NewClient(ctx, Config{
+			Address:           "redis://" + redis.Addr(),
+			Group:             "test-heartbeat",
+			Consumer:          consumer,
+			VisibilityTimeout: 150 * time.Millisecond,
+			MaxAttempts:       3,
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, client.Close()) })
+		return client
+	}
+	client, other := newClient("runner"), newClient("other")
+
+	stream := "test-heartbeat"
+	require.NoError(t, client.Push(ctx, stream, testJob{NodeID: "node-1"}))
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var processed atomic.Int32
+
+	runner := NewRunner[testJob](
+		zaptest.NewLogger(t),
+		RunnerConfig{
+			WorkerCount: 1,
+			Interval:    10 * time.Millisecond,
+			BatchSize:   1,
+			Acknowledge: true,
+		},
+		client,
+		stream,
+		ProcessorFunc[testJob](func(ctx context.Context, job testJob) error {
+			if processed.Add(1) == 1 {
+				close(started)
+			}
+			select {
+			case <-release:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}),
+	)
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	wg.Go(func() { _ = runner.Run(ctx) })
+
+	<-started
+
+	// the job runs several visibility timeouts, but it isn't reclaimed by the other consumer.
+	deadline := time.Now().Add(600 * time.Millisecond)
+	for time.Now().Before(deadline) {
+		var got testJob
+		_, ok, err := other.PopPending(ctx, stream, &got, 10*time.Millisecond)
+		require.NoError(t, err)
+		require.False(t, ok)
+		time.Sleep(50 * time.Millisecond)
+	}
+	close(release)
+
+	require.Eventually(t, func() bool {
+		stats, err := client.streamStats(ctx)
+		require.NoError(t, err)
+		return stats[stream] == StreamStats{}
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	assert.EqualValues(t, 1, processed.Load())
+}
+
+func TestExtendKeepsAttempt(t *testing.T) {
+	ctx := t.Context()
+	client := newAckTestClient(ctx, t, Config{
+		VisibilityTimeout: 100 * time.Millisecond,
+		MaxAttempts:       5,
+	})
+
+	stream := "test-extend"
+	require.NoError(t, client.Push(ctx, stream, testJob{NodeID: "node-1"}))
+
+	var got testJob
+	msg, ok, err := client.PopPending(ctx, stream, &got, time.Second)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, 1, msg.Attempt)
+
+	for range 3 {
+		require.NoError(t, client.Extend(ctx, msg))
+	}
+
+	// once the extensions stop, the job is reclaimed as the second attempt.
+	time.Sleep(150 * time.Millisecond)
+
+	msg, ok, err = client.PopPending(ctx, stream, &got, time.Second)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, 2, msg.Attempt)
+}
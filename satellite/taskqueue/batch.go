@@ -5,7 +5,6 @@ package taskqueue
 
 import (
 	"context"
-	"time"
 
 	"go.uber.org/zap"
 
@@ -16,15 +15,16 @@ import (
 // Implementations handle the actual work for a batch of jobs at once.
 type BatchProcessor[T any] interface {
 	// ProcessBatch handles a batch of jobs.
-	ProcessBatch(ctx context.Context, jobs []T)
+	// When the runner acknowledges jobs, all the jobs of a batch which returns an error are retried.
+	ProcessBatch(ctx context.Context, jobs []T) error
 }
 
 // BatchProcessorFunc is a function adapter for BatchProcessor.
-type BatchProcessorFunc[T any] func(ctx context.Context, jobs []T)
+type BatchProcessorFunc[T any] func(ctx context.Context, jobs []T) error
 
 // ProcessBatch implements BatchProcessor.
-func (f BatchProcessorFunc[T]) ProcessBatch(ctx context.Context, jobs []T) {
-	f(ctx, jobs)
+func (f BatchProcessorFunc[T]) ProcessBatch(ctx context.Context, jobs []T) error {
+	return f(ctx, jobs)
 }
 
 // BatchRunner pops jobs from a task queue stream and processes them in batches.
@@ -82,27 +82,17 @@ func (r *BatchRunner[T]) Close() error {
 func (r *BatchRunner[T]) processJobs(ctx context.Context) (err error, empty bool) {
 	defer mon.Task()(&ctx)(&err)
 
-	var jobs []T
-	for range r.config.BatchSize {
-		var job T
-		found, err := r.client.Pop(ctx, r.streamID, &job, time.Second)
-		if err != nil {
-			r.log.Error("failed to pop job from queue", zap.Error(err))
-			break
-		}
-		if !found {
-			break
-		}
-		jobs = append(jobs, job)
-	}
-
+	jobs, msgs := popJobs[T](ctx, r.log, r.config, r.client, r.streamID)
 	if len(jobs) == 0 {
 		return nil, true
 	}
 
 	r.log.Debug("processing jobs", zap.Int("count", len(jobs)))
 
-	r.processor.ProcessBatch(ctx, jobs)
+	hb := r.client.startHeartbeat(ctx, r.log, msgs)
+	processErr := r.processor.ProcessBatch(ctx, jobs)
+	hb.stop()
+	finishJobs(ctx, r.log, r.config, r.client, msgs, processErr)
 
 	return nil, false
 }
@@ -32,6 +32,11 @@ type Config struct {
 	Address  string `help:"redis URL for task queue" default:"redis://localhost:6379"`
 	Group    string `help:"consumer group name" default:"taskqueue"`
 	Consumer string `help:"consumer name within the group" default:"worker"`
+
+	VisibilityTimeout time.Duration `help:"how long a job popped with acknowledgement may stay unacknowledged without being extended before it is reclaimed by another consumer; runners extend their running jobs" default:"10m"`
+	MaxAttempts       int           `help:"number of attempts of a job popped with acknowledgement before it is moved to the dead-letter stream" default:"5"`
+	RetryBackoff      time.Duration `help:"delay before retrying a failed job, doubled with each attempt" default:"30s"`
+	MaxRetryBackoff   time.Duration `help:"maximum delay before retrying a failed job" default:"1h"`
 }
 
 // Client is a Redis Streams-backed task queue client supporting Push/Pop/Peek.
@@ -39,8 +44,10 @@ type Client struct {
 	db       *redis.Client
 	group    string
 	consumer string
+	config   Config
 
 	initialized sync.Map // tracks which streams have consumer groups created
+	promoted    sync.Map // tracks when due jobs of a stream were promoted the last time
 }
 
 // NewClient creates a new taskqueue Client and verifies the connection.
@@ -63,6 +70,7 @@ func NewClient(ctx context.Context, cfg Config) (_ *Client, err error) {
 		db:       db,
 		group:    cfg.Group,
 		consumer: cfg.Consumer,
+		config:   cfg,
 	}, nil
 }
 
@@ -121,6 +129,9 @@ func (c *Client) Pop(ctx context.Context, streamID string, dest any, timeout tim
 	if err := c.ensureGroup(ctx, streamID); err != nil {
 		return false, err
 	}
+	if err := c.promoteDue(ctx, streamID); err != nil {
+		return false, err
+	}
 
 	streams, err := c.db.XReadGroup(ctx, &redis.XReadGroupArgs{
 		Group:    c.group,
@@ -177,11 +188,26 @@ func (c *Client) Peek(ctx context.Context, streamID string, dest any) (_ bool, e
 	return true, nil
 }
 
-// streamLengths scans all Redis keys of type "stream" and returns their lengths.
-func (c *Client) streamLengths(ctx context.Context) (_ map[string]int64, err error) {
+// StreamStats contains the statistics of a stream.
+type StreamStats struct {
+	// Length is the number of jobs in the stream, including pending jobs.
+	Length int64
+	// Pending is the number of jobs which were popped, but not acknowledged yet.
+	Pending int64
+	// Retry is the number of failed jobs waiting for a retry.
+	Retry int64
+	// Delayed is the number of jobs scheduled for later.
+	Delayed int64
+	// Dead is the number of jobs in the dead-letter stream.
+	Dead int64
+}
+
+// streamStats scans all Redis keys of type "stream" and returns their statistics.
+// Dead-letter streams are counted in the statistics of the original stream.
+func (c *Client) streamStats(ctx context.Context) (_ map[string]StreamStats, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	result := make(map[string]int64)
+	result := make(map[string]StreamStats)
 
 	var cursor uint64
 	for {
@@ -195,7 +221,31 @@ func (c *Client) streamLengths(ctx context.Context) (_ map[string]int64, err err
 			if err != nil {
 				return nil, Error.Wrap(err)
 			}
-			if t != "stream" {
+			switch t {
+			case "zset":
+				streamID, retry := strings.CutSuffix(key, retrySuffix)
+				if !retry {
+					var delayed bool
+					if streamID, delayed = strings.CutSuffix(key, delayedSuffix); !delayed {
+						continue
+					}
+				}
+
+				count, err := c.db.ZCard(ctx, key).Result()
+				if err != nil {
+					return nil, Error.Wrap(err)
+				}
+
+				stats := result[streamID]
+				if retry {
+					stats.Retry = count
+				} else {
+					stats.Delayed = count
+				}
+				result[streamID] = stats
+				continue
+			case "stream":
+			default:
 				continue
 			}
 
@@ -203,7 +253,27 @@ func (c *Client) streamLengths(ctx context.Context) (_ map[string]int64, err err
 			if err != nil {
 				return nil, Error.Wrap(err)
 			}
-			result[key] = length
+
+			if streamID, ok := strings.CutSuffix(key, deadSuffix); ok {
+				stats := result[streamID]
+				stats.Dead = length
+				result[streamID] = stats
+				continue
+			}
+
+			stats := result[key]
+			stats.Length = length
+
+			pending, err := c.db.XPending(ctx, key, c.group).Result()
+			switch {
+			case err == nil:
+				stats.Pending = pending.Count
+			case strings.Contains(err.Error(), "NOGROUP"):
+			default:
+				return nil, Error.Wrap(err)
+			}
+
+			result[key] = stats
 		}
 
 		cursor = next
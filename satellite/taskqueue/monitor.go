@@ -19,14 +19,15 @@ type MonitorConfig struct {
 	Interval time.Duration `help:"how frequently to check task queue stream lengths" releaseDefault:"5m" devDefault:"1m" testDefault:"$TESTINTERVAL"`
 }
 
-// Monitor periodically checks all Redis streams and exposes their lengths as monkit metrics.
+// Monitor periodically checks all Redis streams and exposes their lengths and the
+// pending, retry, delayed and dead job counts as monkit metrics.
 type Monitor struct {
 	log    *zap.Logger
 	client *Client
 	Loop   *sync2.Cycle
 
 	mu     sync.Mutex
-	stats  map[string]int64
+	stats  map[string]StreamStats
 	update time.Time
 }
 
@@ -52,11 +53,11 @@ func (m *Monitor) Run(ctx context.Context) (err error) {
 	})
 }
 
-// RunOnce refreshes the stream length statistics.
+// RunOnce refreshes the stream statistics.
 func (m *Monitor) RunOnce(ctx context.Context) {
-	streams, err := m.client.streamLengths(ctx)
+	streams, err := m.client.streamStats(ctx)
 	if err != nil {
-		m.log.Error("couldn't get task queue stream lengths", zap.Error(err))
+		m.log.Error("couldn't get task queue stream statistics", zap.Error(err))
 		return
 	}
 
@@ -75,9 +76,13 @@ func (m *Monitor) Stats(cb func(key monkit.SeriesKey, field string, val float64)
 		return
 	}
 
-	for stream, length := range m.stats {
+	for stream, stats := range m.stats {
 		key := monkit.NewSeriesKey("taskqueue").
 			WithTag("stream", stream)
-		cb(key, "length", float64(length))
+		cb(key, "length", float64(stats.Length))
+		cb(key, "pending", float64(stats.Pending))
+		cb(key, "retry", float64(stats.Retry))
+		cb(key, "delayed", float64(stats.Delayed))
+		cb(key, "dead", float64(stats.Dead))
 	}
 }
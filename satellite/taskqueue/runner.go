@@ -19,21 +19,23 @@ type RunnerConfig struct {
 	WorkerCount int           `help:"number of concurrent workers" default:"10"`
 	Interval    time.Duration `help:"how often to check for new jobs" default:"15s"`
 	BatchSize   int           `help:"number of jobs to pop from queue at once" default:"100"`
+	Acknowledge bool          `help:"keep popped jobs pending until they are processed successfully; failed jobs are retried and then moved to the dead-letter stream" default:"false"`
 }
 
 // Processor defines the interface for processing jobs from the task queue.
 // Implementations handle the actual work for each job.
 type Processor[T any] interface {
 	// Process handles a single job. It is called concurrently from multiple workers.
-	Process(ctx context.Context, job T)
+	// When the runner acknowledges jobs, a job which returns an error is retried.
+	Process(ctx context.Context, job T) error
 }
 
 // ProcessorFunc is a function adapter for Processor.
-type ProcessorFunc[T any] func(ctx context.Context, job T)
+type ProcessorFunc[T any] func(ctx context.Context, job T) error
 
 // Process implements Processor.
-func (f ProcessorFunc[T]) Process(ctx context.Context, job T) {
-	f(ctx, job)
+func (f ProcessorFunc[T]) Process(ctx context.Context, job T) error {
+	return f(ctx, job)
 }
 
 // Runner pops jobs from a task queue stream and processes them concurrently.
@@ -94,28 +96,18 @@ func (r *Runner[T]) Close() error {
 func (r *Runner[T]) processJobs(ctx context.Context) (err error, empty bool) {
 	defer mon.Task()(&ctx)(&err)
 
-	var jobs []T
-	for range r.config.BatchSize {
-		var job T
-		found, err := r.client.Pop(ctx, r.streamID, &job, time.Second)
-		if err != nil {
-			r.log.Error("failed to pop job from queue", zap.Error(err))
-			break
-		}
-		if !found {
-			break
-		}
-		jobs = append(jobs, job)
-	}
-
+	jobs, msgs := popJobs[T](ctx, r.log, r.config, r.client, r.streamID)
 	if len(jobs) == 0 {
 		return nil, true
 	}
 
 	r.log.Debug("processing jobs", zap.Int("count", len(jobs)))
 
+	hb := r.client.startHeartbeat(ctx, r.log, msgs)
+	defer hb.stop()
+
 	var wg sync.WaitGroup
-	for _, job := range jobs {
+	for i, job := range jobs {
 		if err := ctx.Err(); err != nil {
 			break
 		}
@@ -125,14 +117,75 @@ func (r *Runner[T]) processJobs(ctx context.Context) (err error, empty bool) {
 		}
 
 		wg.Add(1)
-		job := job
 		go func() {
 			defer wg.Done()
 			defer r.JobLimiter.Release(1)
-			r.processor.Process(ctx, job)
+			err := r.processor.Process(ctx, job)
+			hb.finish(msgs[i])
+			finishJobs(ctx, r.log, r.config, r.client, msgs[i:i+1], err)
 		}()
 	}
 
 	wg.Wait()
 	return nil, false
 }
+
+// popJobs pops up to BatchSize jobs from the stream. When the jobs are acknowledged,
+// the messages of the jobs are returned as well.
+func popJobs[T any](ctx context.Context, log *zap.Logger, config RunnerConfig, client *Client, streamID string) (jobs []T, msgs []Message) {
+	for range config.BatchSize {
+		var job T
+		var msg Message
+		var found bool
+		var err error
+		if config.Acknowledge {
+			msg, found, err = client.PopPending(ctx, streamID, &job, time.Second)
+		} else {
+			found, err = client.Pop(ctx, streamID, &job, time.Second)
+		}
+		if err != nil {
+			log.Error("failed to pop job from queue", zap.Error(err))
+			break
+		}
+		if !found {
+			break
+		}
+		jobs = append(jobs, job)
+		msgs = append(msgs, msg)
+	}
+	return jobs, msgs
+}
+
+// finishJobs acknowledges the messages of processed jobs. Jobs which failed are retried,
+// unless they ran out of attempts.
+func finishJobs(ctx context.Context, log *zap.Logger, config RunnerConfig, client *Client, msgs []Message, processErr error) {
+	if !config.Acknowledge {
+		if processErr != nil {
+			log.Warn("failed to process job", zap.Error(processErr))
+		}
+		return
+	}
+
+	// the jobs are delivered again after the visibility timeout.
+	if ctx.Err() != nil {
+		return
+	}
+
+	for _, msg := range msgs {
+		if processErr == nil {
+			if err := client.Ack(ctx, msg); err != nil {
+				log.Error("failed to acknowledge job", zap.String("id", msg.ID), zap.Error(err))
+			}
+			continue
+		}
+
+		dead, err := client.Fail(ctx, msg, processErr)
+		if err != nil {
+			log.Error("failed to retry job", zap.String("id", msg.ID), zap.Error(err))
+			continue
+		}
+		if dead {
+			log.Warn("job moved to dead-letter stream", zap.String("id", msg.ID), zap.Int("attempts", msg.Attempt), zap.Error(processErr))
+		}
+	}
+}
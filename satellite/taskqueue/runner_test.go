@@ -54,8 +54,9 @@ func TestRunner(t *testing.T) {
 		},
 		client,
 		stream,
-		ProcessorFunc[testJob](func(ctx context.Context, job testJob) {
+		ProcessorFunc[testJob](func(ctx context.Context, job testJob) error {
 			processed.Add(1)
+			return nil
 		}),
 	)
 
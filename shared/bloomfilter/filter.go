@@ -207,6 +207,16 @@ func OptimalParameters(expectedElements int64, falsePositiveRate float64, maxSiz
 	return hashCount, sizeInBytes
 }
 
+// ExpectedFalsePositiveRate returns the expected false positive rate of a
+// bloom filter with the given parameters after adding the number of elements.
+func ExpectedFalsePositiveRate(hashCount byte, sizeInBytes int, elements int64) float64 {
+	if sizeInBytes <= 0 {
+		return 1
+	}
+	k := float64(hashCount)
+	return math.Pow(1-math.Exp(-k*float64(elements)/(float64(sizeInBytes)*8)), k)
+}
+
 func getHashCountAndSize(expectedElements int64, falsePositiveRate float64) (hashCount byte, size int) {
 	// calculation based on https://en.wikipedia.org/wiki/Bloom_filter#Optimal_number_of_hash_functions
 	bitsPerElement := -1.44 * math.Log2(falsePositiveRate)
@@ -0,0 +1,46 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter
+
+import (
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+)
+
+// PieceFilter is a set of piece IDs which may return false positives, but
+// never false negatives.
+type PieceFilter interface {
+	// Contains returns true if pieceID may be in the set.
+	Contains(pieceID storj.PieceID) bool
+	// Parameters returns the number of hash functions and the size of the table.
+	Parameters() (hashCount, size int)
+	// Bytes encodes the filter into a sequence of bytes, which can be decoded using Decode.
+	Bytes() []byte
+	// Size returns the size of Bytes call.
+	Size() int64
+}
+
+var (
+	_ PieceFilter = (*Filter)(nil)
+	_ PieceFilter = (*BinaryFuse)(nil)
+)
+
+// Decode decodes a bloom filter or a binary fuse filter from a sequence of bytes.
+// The format is selected by the version in the first byte.
+//
+// Note: data will be referenced inside the filter.
+func Decode(data []byte) (PieceFilter, error) {
+	if len(data) == 0 {
+		return nil, errs.New("not enough data")
+	}
+	switch data[0] {
+	case version1:
+		return NewFromBytes(data)
+	case version2:
+		return newBinaryFuseFromBytes(data)
+	default:
+		return nil, errs.New("unsupported version %d", data[0])
+	}
+}
@@ -0,0 +1,365 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+	"slices"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+)
+
+const (
+	version2 = 2
+
+	// fuseHeaderSize is the size of version, fingerprint bits, seed, segment length and segment count.
+	fuseHeaderSize = 1 + 1 + 8 + 4 + 4
+	// fusePadding allows reading fingerprints with a single 32 bit load.
+	fusePadding = 3
+
+	maxFingerprintBits   = 16
+	maxFuseSegmentLength = 1 << 18
+	maxFuseIterations    = 100
+)
+
+// BinaryFuse is a binary fuse filter for piece IDs.
+//
+// Compared to a bloom filter of the same size it has a considerably lower
+// false positive rate, e.g. 0.4% instead of 1% at 9.4 bits per element. Unlike
+// a bloom filter it must be built from all the piece IDs at once.
+//
+// See "Binary Fuse Filters: Fast and Smaller Than Xor Filters" by Graf and Lemire.
+type BinaryFuse struct {
+	seed            uint64
+	fingerprintBits byte
+	segmentLength   uint32
+	segmentCount    uint32
+	table           []byte
+
+	segmentLengthMask  uint32
+	segmentCountLength uint32
+	fingerprintMask    uint32
+}
+
+// PieceKey returns the key of the piece ID used for building binary fuse filters.
+//
+// Piece IDs are derived using a hash function, so their prefix is uniformly distributed.
+func PieceKey(pieceID storj.PieceID) uint64 {
+	return binary.LittleEndian.Uint64(pieceID[:8])
+}
+
+// BinaryFuseFingerprintBits returns the fingerprint size of a binary fuse filter
+// for the number of elements which fits into sizeInBytes. It returns 0 when the
+// filter doesn't fit.
+func BinaryFuseFingerprintBits(elements int64, sizeInBytes int) int {
+	if elements < 0 || elements > math.MaxUint32 {
+		return 0
+	}
+	segmentLength, segmentCount := fuseParameters(uint32(elements))
+	arrayLength := int64(segmentCount+2) * int64(segmentLength)
+
+	available := int64(sizeInBytes-fuseHeaderSize-fusePadding) * 8
+	if available <= 0 {
+		return 0
+	}
+	return int(min(available/arrayLength, maxFingerprintBits))
+}
+
+// BinaryFuseFalsePositiveRate returns the false positive rate of a binary fuse
+// filter with the fingerprint size.
+func BinaryFuseFalsePositiveRate(fingerprintBits int) float64 {
+	return math.Ldexp(1, -fingerprintBits)
+}
+
+// NewBinaryFuse builds a binary fuse filter from keys returned by PieceKey.
+// The largest fingerprint size which fits into sizeInBytes is used.
+//
+// Note: keys will be sorted and deduplicated in place.
+func NewBinaryFuse(keys []uint64, sizeInBytes int) (*BinaryFuse, error) {
+	slices.Sort(keys)
+	keys = slices.Compact(keys)
+
+	fingerprintBits := BinaryFuseFingerprintBits(int64(len(keys)), sizeInBytes)
+	if fingerprintBits == 0 {
+		return nil, errs.New("%d elements do not fit into %d bytes", len(keys), sizeInBytes)
+	}
+
+	segmentLength, segmentCount := fuseParameters(uint32(len(keys)))
+	filter := newBinaryFuse(0, byte(fingerprintBits), segmentLength, segmentCount)
+	if err := filter.populate(keys); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+func newBinaryFuse(seed uint64, fingerprintBits byte, segmentLength, segmentCount uint32) *BinaryFuse {
+	filter := &BinaryFuse{
+		seed:            seed,
+		fingerprintBits: fingerprintBits,
+		segmentLength:   segmentLength,
+		segmentCount:    segmentCount,
+	}
+	filter.initialize()
+	filter.table = make([]byte, filter.tableSize())
+	return filter
+}
+
+func (filter *BinaryFuse) initialize() {
+	filter.segmentLengthMask = filter.segmentLength - 1
+	filter.segmentCountLength = filter.segmentCount * filter.segmentLength
+	filter.fingerprintMask = 1<<filter.fingerprintBits - 1
+}
+
+func (filter *BinaryFuse) arrayLength() uint32 {
+	return (filter.segmentCount + 2) * filter.segmentLength
+}
+
+func (filter *BinaryFuse) tableSize() int {
+	return int((uint64(filter.arrayLength())*uint64(filter.fingerprintBits)+7)/8) + fusePadding
+}
+
+// fuseParameters calculates the segment length and count for a 3-wise binary
+// fuse filter with the given number of elements.
+func fuseParameters(size uint32) (segmentLength, segmentCount uint32) {
+	segmentLength = 4
+	if size > 0 {
+		segmentLength = uint32(1) << int(math.Floor(math.Log(float64(size))/math.Log(3.33)+2.25))
+	}
+	segmentLength = min(segmentLength, maxFuseSegmentLength)
+
+	capacity := uint64(0)
+	if size > 1 {
+		sizeFactor := math.Max(1.125, 0.875+0.25*math.Log(1000000)/math.Log(float64(size)))
+		capacity = uint64(math.Round(float64(size) * sizeFactor))
+	}
+
+	segments := (capacity + uint64(segmentLength) - 1) / uint64(segmentLength)
+	if segments <= 2 {
+		return segmentLength, 1
+	}
+	return segmentLength, uint32(segments - 2)
+}
+
+func (filter *BinaryFuse) populate(keys []uint64) error {
+	size := uint32(len(keys))
+	capacity := filter.arrayLength()
+
+	alone := make([]uint32, capacity)
+	// the lowest 2 bits are the index of the hash which refers to the slot,
+	// the rest is the number of keys in the slot.
+	t2count := make([]uint8, capacity)
+	t2hash := make([]uint64, capacity)
+	reverseH := make([]uint8, size)
+	reverseOrder := make([]uint64, size)
+
+	var h012 [5]uint32
+	rng := uint64(1)
+	for iteration := 0; ; iteration++ {
+		if iteration >= maxFuseIterations {
+			return errs.New("unable to build binary fuse filter")
+		}
+
+		filter.seed = splitmix64(&rng)
+		clear(t2count)
+		clear(t2hash)
+
+		failed := false
+		for _, key := range keys {
+			hash := mixsplit(key, filter.seed)
+			h0, h1, h2 := filter.positions(hash)
+			t2count[h0] += 4
+			t2hash[h0] ^= hash
+			t2count[h1] += 4
+			t2count[h1] ^= 1
+			t2hash[h1] ^= hash
+			t2count[h2] += 4
+			t2count[h2] ^= 2
+			t2hash[h2] ^= hash
+			// detect overflow of the counter.
+			if t2count[h0] < 4 || t2count[h1] < 4 || t2count[h2] < 4 {
+				failed = true
+				break
+			}
+		}
+		if failed {
+			continue
+		}
+
+		// peel the slots which are referenced by a single key.
+		queueSize := 0
+		for i := uint32(0); i < capacity; i++ {
+			alone[queueSize] = i
+			if t2count[i]>>2 == 1 {
+				queueSize++
+			}
+		}
+
+		stackSize := uint32(0)
+		for queueSize > 0 {
+			queueSize--
+			index := alone[queueSize]
+			if t2count[index]>>2 != 1 {
+				continue
+			}
+
+			hash := t2hash[index]
+			found := t2count[index] & 3
+			reverseH[stackSize] = found
+			reverseOrder[stackSize] = hash
+			stackSize++
+
+			h0, h1, h2 := filter.positions(hash)
+			h012[1], h012[2], h012[3], h012[4] = h1, h2, h0, h1
+
+			for _, next := range [2]uint8{found + 1, found + 2} {
+				other := h012[next]
+				alone[queueSize] = other
+				if t2count[other]>>2 == 2 {
+					queueSize++
+				}
+				t2count[other] -= 4
+				t2count[other] ^= next % 3
+				t2hash[other] ^= hash
+			}
+		}
+
+		if stackSize == size {
+			break
+		}
+	}
+
+	for i := int(size) - 1; i >= 0; i-- {
+		hash := reverseOrder[i]
+		h0, h1, h2 := filter.positions(hash)
+		h012[0], h012[1], h012[2], h012[3], h012[4] = h0, h1, h2, h0, h1
+
+		found := reverseH[i]
+		filter.set(h012[found], filter.fingerprint(hash)^filter.get(h012[found+1])^filter.get(h012[found+2]))
+	}
+	return nil
+}
+
+// Contains returns true if pieceID may be in the set.
+func (filter *BinaryFuse) Contains(pieceID storj.PieceID) bool {
+	hash := mixsplit(PieceKey(pieceID), filter.seed)
+	h0, h1, h2 := filter.positions(hash)
+	return filter.fingerprint(hash)^filter.get(h0)^filter.get(h1)^filter.get(h2) == 0
+}
+
+// Parameters returns the number of hash functions and the size of the table.
+func (filter *BinaryFuse) Parameters() (hashCount, size int) {
+	return 3, len(filter.table)
+}
+
+// FingerprintBits returns the size of a fingerprint in bits.
+func (filter *BinaryFuse) FingerprintBits() int {
+	return int(filter.fingerprintBits)
+}
+
+// Bytes encodes the filter into a sequence of bytes that can be transferred on network.
+func (filter *BinaryFuse) Bytes() []byte {
+	bytes := make([]byte, fuseHeaderSize+len(filter.table))
+	bytes[0] = version2
+	bytes[1] = filter.fingerprintBits
+	binary.LittleEndian.PutUint64(bytes[2:], filter.seed)
+	binary.LittleEndian.PutUint32(bytes[10:], filter.segmentLength)
+	binary.LittleEndian.PutUint32(bytes[14:], filter.segmentCount)
+	copy(bytes[fuseHeaderSize:], filter.table)
+	return bytes
+}
+
+// Size returns the size of Bytes call.
+func (filter *BinaryFuse) Size() int64 {
+	return int64(fuseHeaderSize + len(filter.table))
+}
+
+// newBinaryFuseFromBytes decodes the filter from a sequence of bytes.
+//
+// Note: data will be referenced inside the table.
+func newBinaryFuseFromBytes(data []byte) (*BinaryFuse, error) {
+	if len(data) < fuseHeaderSize {
+		return nil, errs.New("not enough data")
+	}
+	if data[0] != version2 {
+		return nil, errs.New("unsupported version %d", data[0])
+	}
+
+	filter := &BinaryFuse{
+		fingerprintBits: data[1],
+		seed:            binary.LittleEndian.Uint64(data[2:]),
+		segmentLength:   binary.LittleEndian.Uint32(data[10:]),
+		segmentCount:    binary.LittleEndian.Uint32(data[14:]),
+		table:           data[fuseHeaderSize:],
+	}
+
+	switch {
+	case filter.fingerprintBits == 0 || filter.fingerprintBits > maxFingerprintBits:
+		return nil, errs.New("invalid fingerprint bits %d", filter.fingerprintBits)
+	case filter.segmentLength == 0 || filter.segmentLength > maxFuseSegmentLength || bits.OnesCount32(filter.segmentLength) != 1:
+		return nil, errs.New("invalid segment length %d", filter.segmentLength)
+	case filter.segmentCount == 0 || (uint64(filter.segmentCount)+2)*uint64(filter.segmentLength) > math.MaxUint32:
+		return nil, errs.New("invalid segment count %d", filter.segmentCount)
+	}
+
+	filter.initialize()
+	if len(filter.table) != filter.tableSize() {
+		return nil, errs.New("invalid table size: expected %d but got %d", filter.tableSize(), len(filter.table))
+	}
+	return filter, nil
+}
+
+func (filter *BinaryFuse) positions(hash uint64) (h0, h1, h2 uint32) {
+	hi, _ := bits.Mul64(hash, uint64(filter.segmentCountLength))
+	h0 = uint32(hi)
+	h1 = h0 + filter.segmentLength
+	h2 = h1 + filter.segmentLength
+	h1 ^= uint32(hash>>18) & filter.segmentLengthMask
+	h2 ^= uint32(hash) & filter.segmentLengthMask
+	return h0, h1, h2
+}
+
+func (filter *BinaryFuse) fingerprint(hash uint64) uint32 {
+	return uint32(hash^(hash>>32)) & filter.fingerprintMask
+}
+
+// get returns the fingerprint stored at the index. Fingerprints are packed
+// tightly, so a fingerprint may span up to three bytes.
+func (filter *BinaryFuse) get(index uint32) uint32 {
+	bit := uint64(index) * uint64(filter.fingerprintBits)
+	word := binary.LittleEndian.Uint32(filter.table[bit/8:])
+	return (word >> (bit % 8)) & filter.fingerprintMask
+}
+
+func (filter *BinaryFuse) set(index, value uint32) {
+	bit := uint64(index) * uint64(filter.fingerprintBits)
+	word := binary.LittleEndian.Uint32(filter.table[bit/8:])
+	word &^= filter.fingerprintMask << (bit % 8)
+	word |= (value & filter.fingerprintMask) << (bit % 8)
+	binary.LittleEndian.PutUint32(filter.table[bit/8:], word)
+}
+
+func murmur64(h uint64) uint64 {
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
+
+func mixsplit(key, seed uint64) uint64 {
+	return murmur64(key + seed)
+}
+
+func splitmix64(seed *uint64) uint64 {
+	*seed += 0x9e3779b97f4a7c15
+	z := *seed
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
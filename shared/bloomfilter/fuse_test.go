@@ -0,0 +1,150 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testrand"
+	"storj.io/storj/shared/bloomfilter"
+)
+
+func pieceKeys(pieceIDs []storj.PieceID) []uint64 {
+	keys := make([]uint64, len(pieceIDs))
+	for i, pieceID := range pieceIDs {
+		keys[i] = bloomfilter.PieceKey(pieceID)
+	}
+	return keys
+}
+
+func TestBinaryFuse_NoFalseNegative(t *testing.T) {
+	for _, count := range []int{0, 1, 2, 3, 100, 10000, 100000} {
+		pieceIDs := generateTestIDs(count)
+
+		// duplicates are allowed.
+		keys := pieceKeys(pieceIDs)
+		keys = append(keys, keys...)
+
+		filter, err := bloomfilter.NewBinaryFuse(keys, 1024+count*2)
+		require.NoError(t, err)
+		for _, pieceID := range pieceIDs {
+			require.True(t, filter.Contains(pieceID))
+		}
+	}
+}
+
+func TestBinaryFuse_Bytes(t *testing.T) {
+	for _, count := range []int{0, 100, 1000, 10000} {
+		pieceIDs := generateTestIDs(count)
+		filter, err := bloomfilter.NewBinaryFuse(pieceKeys(pieceIDs), 1024+count)
+		require.NoError(t, err)
+
+		bytes := filter.Bytes()
+		require.EqualValues(t, len(bytes), filter.Size())
+
+		decoded, err := bloomfilter.Decode(bytes)
+		require.NoError(t, err)
+		require.Equal(t, filter, decoded)
+
+		for _, pieceID := range pieceIDs {
+			require.True(t, decoded.Contains(pieceID))
+		}
+	}
+}
+
+func TestBinaryFuse_Size(t *testing.T) {
+	pieceIDs := generateTestIDs(10000)
+
+	for _, size := range []int{2000, 5000, 10000, 20000, 100000} {
+		filter, err := bloomfilter.NewBinaryFuse(pieceKeys(pieceIDs), size)
+		require.NoError(t, err)
+		require.LessOrEqual(t, filter.Size(), int64(size))
+		require.Equal(t, bloomfilter.BinaryFuseFingerprintBits(int64(len(pieceIDs)), size), filter.FingerprintBits())
+	}
+
+	_, err := bloomfilter.NewBinaryFuse(pieceKeys(pieceIDs), 1000)
+	require.Error(t, err)
+}
+
+func TestDecode(t *testing.T) {
+	bloom := bloomfilter.NewOptimal(100, 0.1)
+	decoded, err := bloomfilter.Decode(bloom.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, bloom, decoded)
+
+	fuse, err := bloomfilter.NewBinaryFuse(pieceKeys(generateTestIDs(100)), 1000)
+	require.NoError(t, err)
+	valid := fuse.Bytes()
+
+	failing := [][]byte{
+		{},
+		{0},
+		{3, 1, 2, 3},
+		{2, 8},
+		valid[:len(valid)-1],
+		append(append([]byte{}, valid...), 0),
+	}
+	// invalid fingerprint bits
+	invalid := append([]byte{}, valid...)
+	invalid[1] = 17
+	failing = append(failing, invalid)
+	// segment length which is not a power of two
+	invalid = append([]byte{}, valid...)
+	invalid[10] = 3
+	failing = append(failing, invalid)
+
+	for _, bytes := range failing {
+		_, err := bloomfilter.Decode(bytes)
+		require.Error(t, err)
+	}
+}
+
+func TestBinaryFuse_FalsePositivesAtSameSize(t *testing.T) {
+	const count = 100000
+	const validation = 100000
+
+	pieceIDs := generateTestIDs(count)
+
+	for _, p := range []float64{0.01, 0.1} {
+		bloom := bloomfilter.NewOptimal(count, p)
+		for _, pieceID := range pieceIDs {
+			bloom.Add(pieceID)
+		}
+
+		fuse, err := bloomfilter.NewBinaryFuse(pieceKeys(pieceIDs), int(bloom.Size()))
+		require.NoError(t, err)
+		require.LessOrEqual(t, fuse.Size(), bloom.Size())
+
+		expected := bloomfilter.BinaryFuseFalsePositiveRate(fuse.FingerprintBits())
+		hashCount, size := bloom.Parameters()
+		require.Less(t, expected, bloomfilter.ExpectedFalsePositiveRate(byte(hashCount), size, count))
+
+		var bloomPositives, fusePositives int
+		for range validation {
+			pieceID := testrand.PieceID()
+			if bloom.Contains(pieceID) {
+				bloomPositives++
+			}
+			if fuse.Contains(pieceID) {
+				fusePositives++
+			}
+		}
+		t.Logf("p=%.2f size=%d bloom=%.4f fuse=%.4f (%d bits)", p, bloom.Size(),
+			float64(bloomPositives)/validation, float64(fusePositives)/validation, fuse.FingerprintBits())
+
+		require.Less(t, fusePositives, bloomPositives)
+		require.InDelta(t, expected, float64(fusePositives)/validation, expected/2)
+	}
+}
+
+func BenchmarkBinaryFuseBuild(b *testing.B) {
+	keys := pieceKeys(generateTestIDs(100000))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = bloomfilter.NewBinaryFuse(keys, 200000)
+	}
+}
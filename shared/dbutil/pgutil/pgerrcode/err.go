@@ -45,3 +45,8 @@ func IsInvalidSyntax(err error) bool {
 func IsConstraintViolation(err error) bool {
 	return strings.HasPrefix(FromError(err), pgErrorClassConstraintViolation)
 }
+
+// IsUndefinedTable returns whether the query refers to a table, which doesn't exist.
+func IsUndefinedTable(err error) bool {
+	return FromError(err) == "42P01"
+}
@@ -0,0 +1,504 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package sqliteutil
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	sqlite3 "github.com/mattn/go-sqlite3"
+	"github.com/zeebo/errs"
+)
+
+// PostgresDialectDriverName is the name of the registered sqlite3 driver, which
+// accepts queries written in the Postgres dialect.
+const PostgresDialectDriverName = "sqlite3+pgdialect"
+
+// TimestampLayout is the layout used for storing timestamps. All timestamps are
+// stored in UTC with a fixed width, so they can be compared as strings.
+const TimestampLayout = "2006-01-02 15:04:05.000000-07:00"
+
+func init() {
+	sql.Register(PostgresDialectDriverName, WrapPostgresDialect(&sqlite3.SQLiteDriver{
+		ConnectHook: registerPostgresFunctions,
+	}))
+}
+
+// registerPostgresFunctions registers functions, which are commonly used in
+// Postgres queries, but which do not exist in sqlite.
+func registerPostgresFunctions(conn *sqlite3.SQLiteConn) error {
+	err := conn.RegisterFunc("now", func() string {
+		return formatTimestamp(time.Now())
+	}, false)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	err = conn.RegisterFunc("epoch", func(value string) (float64, error) {
+		t, err := time.Parse(TimestampLayout, value)
+		if err != nil {
+			return 0, errs.Wrap(err)
+		}
+		return float64(t.UnixMicro()) / 1e6, nil
+	}, true)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	return nil
+}
+
+// PostgresDialectDriver wraps a sqlite3 driver.Driver, so that it accepts
+// queries written in the Postgres dialect.
+//
+// Queries are translated in the following ways:
+//
+//   - $N placeholders are replaced with ?N,
+//   - casts to integer and bytea types are replaced with CAST, other casts are removed,
+//   - EXTRACT(EPOCH FROM x) is replaced with the registered epoch function,
+//   - substring(x FROM a FOR b) is replaced with substr(x, a, b),
+//   - GREATEST and LEAST are replaced with max and min,
+//   - row values in ORDER BY and GROUP BY are replaced with plain lists.
+//
+// Additionally, string arguments are stored as blobs and timestamps are
+// stored in UTC using TimestampLayout. Errors implement SQLState with the
+// equivalent Postgres error codes.
+//
+// Arrays and data-modifying statements inside WITH are not supported.
+type PostgresDialectDriver struct {
+	driver driver.Driver
+}
+
+// WrapPostgresDialect wraps a sqlite3 driver.Driver with Postgres dialect translation.
+func WrapPostgresDialect(driver driver.Driver) *PostgresDialectDriver {
+	return &PostgresDialectDriver{driver: driver}
+}
+
+// Unwrap returns the underlying driver.Driver.
+func (d *PostgresDialectDriver) Unwrap() driver.Driver { return d.driver }
+
+// Open returns a wrapped driver.Conn with Postgres dialect translation.
+func (d *PostgresDialectDriver) Open(name string) (driver.Conn, error) {
+	conn, err := d.driver.Open(name)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &pgDialectConn{conn: conn.(sqliteConn)}, nil
+}
+
+// sqliteConn mirrors the methods implemented by *sqlite3.SQLiteConn.
+type sqliteConn interface {
+	driver.Conn
+	driver.Pinger
+	driver.ConnBeginTx
+	driver.ConnPrepareContext
+	driver.QueryerContext
+	driver.ExecerContext
+}
+
+type pgDialectConn struct {
+	conn sqliteConn
+}
+
+func (c *pgDialectConn) Close() error { return c.conn.Close() }
+
+func (c *pgDialectConn) Ping(ctx context.Context) error { return c.conn.Ping(ctx) }
+
+func (c *pgDialectConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *pgDialectConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	tx, err := c.conn.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return &pgDialectTx{tx: tx}, nil
+}
+
+type pgDialectTx struct {
+	tx driver.Tx
+}
+
+func (t *pgDialectTx) Commit() error { return wrapError(t.tx.Commit()) }
+
+func (t *pgDialectTx) Rollback() error { return wrapError(t.tx.Rollback()) }
+
+func (c *pgDialectConn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *pgDialectConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	stmt, err := c.conn.PrepareContext(ctx, TranslatePostgres(query))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return &pgDialectStmt{stmt: stmt.(sqliteStmt)}, nil
+}
+
+func (c *pgDialectConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	rows, err := c.conn.QueryContext(ctx, TranslatePostgres(query), convertArgs(args))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return &pgDialectRows{rows: rows}, nil
+}
+
+func (c *pgDialectConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	result, err := c.conn.ExecContext(ctx, TranslatePostgres(query), convertArgs(args))
+	return result, wrapError(err)
+}
+
+// sqliteStmt mirrors the methods implemented by *sqlite3.SQLiteStmt.
+type sqliteStmt interface {
+	driver.Stmt
+	driver.StmtQueryContext
+	driver.StmtExecContext
+}
+
+type pgDialectStmt struct {
+	stmt sqliteStmt
+}
+
+func (s *pgDialectStmt) Close() error { return s.stmt.Close() }
+
+func (s *pgDialectStmt) NumInput() int { return s.stmt.NumInput() }
+
+func (s *pgDialectStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.ExecContext(context.Background(), namedValues(args))
+}
+
+func (s *pgDialectStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.QueryContext(context.Background(), namedValues(args))
+}
+
+func (s *pgDialectStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	result, err := s.stmt.ExecContext(ctx, convertArgs(args))
+	return result, wrapError(err)
+}
+
+func (s *pgDialectStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	rows, err := s.stmt.QueryContext(ctx, convertArgs(args))
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return &pgDialectRows{rows: rows}, nil
+}
+
+// pgDialectRows parses timestamps, which are returned as strings by expressions.
+type pgDialectRows struct {
+	rows driver.Rows
+}
+
+func (r *pgDialectRows) Columns() []string { return r.rows.Columns() }
+
+func (r *pgDialectRows) Close() error { return r.rows.Close() }
+
+func (r *pgDialectRows) Next(dest []driver.Value) error {
+	if err := r.rows.Next(dest); err != nil {
+		if errors.Is(err, io.EOF) {
+			return err
+		}
+		return wrapError(err)
+	}
+	for i, v := range dest {
+		s, ok := v.(string)
+		if !ok || len(s) != len(TimestampLayout) {
+			continue
+		}
+		if t, err := time.Parse(TimestampLayout, s); err == nil {
+			dest[i] = t
+		}
+	}
+	return nil
+}
+
+// pgDialectError adds the equivalent Postgres error code to sqlite errors.
+type pgDialectError struct {
+	err  sqlite3.Error
+	code string
+}
+
+func wrapError(err error) error {
+	var sqliteErr sqlite3.Error
+	if !errors.As(err, &sqliteErr) {
+		return err
+	}
+
+	var code string
+	switch {
+	case sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique,
+		sqliteErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey:
+		code = "23505" // unique_violation
+	case sqliteErr.ExtendedCode == sqlite3.ErrConstraintNotNull:
+		code = "23502" // not_null_violation
+	case sqliteErr.Code == sqlite3.ErrConstraint:
+		code = "23000" // integrity_constraint_violation
+	case sqliteErr.Code == sqlite3.ErrBusy, sqliteErr.Code == sqlite3.ErrLocked:
+		code = "40001" // serialization_failure
+	case sqliteErr.Code == sqlite3.ErrError && strings.HasPrefix(sqliteErr.Error(), "no such table"):
+		code = "42P01" // undefined_table
+	case sqliteErr.Code == sqlite3.ErrError && strings.Contains(sqliteErr.Error(), "syntax error"):
+		code = "42601" // syntax_error
+	default:
+		return err
+	}
+	return &pgDialectError{err: sqliteErr, code: code}
+}
+
+func (err *pgDialectError) Error() string { return err.err.Error() }
+
+func (err *pgDialectError) Unwrap() error { return err.err }
+
+// SQLState returns the Postgres error code.
+func (err *pgDialectError) SQLState() string { return err.code }
+
+func namedValues(args []driver.Value) []driver.NamedValue {
+	named := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		named[i] = driver.NamedValue{Ordinal: i + 1, Value: arg}
+	}
+	return named
+}
+
+func convertArgs(args []driver.NamedValue) []driver.NamedValue {
+	converted := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		converted[i] = arg
+		switch v := arg.Value.(type) {
+		case string:
+			converted[i].Value = []byte(v)
+		case time.Time:
+			converted[i].Value = formatTimestamp(v)
+		}
+	}
+	return converted
+}
+
+func formatTimestamp(t time.Time) string {
+	return t.UTC().Format(TimestampLayout)
+}
+
+// TranslatePostgres translates a query from the Postgres dialect to sqlite.
+func TranslatePostgres(query string) string {
+	type sqlParseState int
+	const (
+		sqlParseStart sqlParseState = iota
+		sqlParseInStringLiteral
+		sqlParseInQuotedIdentifier
+		sqlParseInComment
+	)
+
+	query = strings.NewReplacer(
+		"EXTRACT(EPOCH FROM ", "epoch(",
+		"extract(epoch from ", "epoch(",
+		"GREATEST(", "max(",
+		"greatest(", "max(",
+		"LEAST(", "min(",
+		"least(", "min(",
+	).Replace(query)
+	query = translateSubstring(query)
+	query = translateRowOrdering(query)
+
+	out := make([]byte, 0, len(query)+10)
+
+	state := sqlParseStart
+	for i := 0; i < len(query); i++ {
+		ch := query[i]
+		switch state {
+		case sqlParseStart:
+			switch {
+			case ch == '$' && i+1 < len(query) && isDigit(query[i+1]):
+				out = append(out, '?')
+				continue
+			case ch == ':' && i+1 < len(query) && query[i+1] == ':':
+				typ, n := parseCastType(query[i+2:])
+				i += 1 + n
+				out = appendCast(out, typ)
+				continue
+			case ch == '-' && i+1 < len(query) && query[i+1] == '-':
+				state = sqlParseInComment
+			case ch == '"':
+				state = sqlParseInQuotedIdentifier
+			case ch == '\'':
+				state = sqlParseInStringLiteral
+			}
+		case sqlParseInStringLiteral:
+			if ch == '\'' {
+				state = sqlParseStart
+			}
+		case sqlParseInQuotedIdentifier:
+			if ch == '"' {
+				state = sqlParseStart
+			}
+		case sqlParseInComment:
+			if ch == '\n' {
+				state = sqlParseStart
+			}
+		}
+		out = append(out, ch)
+	}
+
+	return string(out)
+}
+
+// translateSubstring replaces "substring(x FROM a FOR b)" with "substr(x, a, b)".
+func translateSubstring(query string) string {
+	const function = "substring("
+
+	var out strings.Builder
+	for {
+		start := strings.Index(strings.ToLower(query), function)
+		if start < 0 {
+			out.WriteString(query)
+			return out.String()
+		}
+		out.WriteString(query[:start])
+		query = query[start+len(function):]
+
+		end := closingParen(query)
+		args := query[:end]
+		query = query[end:]
+
+		lower := strings.ToLower(args)
+		if from := strings.Index(lower, " from "); from >= 0 {
+			args = args[:from] + ", " + args[from+len(" from "):]
+			lower = strings.ToLower(args)
+		}
+		if length := strings.Index(lower, " for "); length >= 0 {
+			args = args[:length] + ", " + args[length+len(" for "):]
+		}
+
+		out.WriteString("substr(")
+		out.WriteString(translateSubstring(args))
+	}
+}
+
+// translateRowOrdering replaces "ORDER BY (a, b)" and "GROUP BY (a, b)"
+// with "ORDER BY a, b" and "GROUP BY a, b", since sqlite does not support
+// row values in those clauses.
+func translateRowOrdering(query string) string {
+	const clause = "by ("
+
+	var out strings.Builder
+	for {
+		start := strings.Index(strings.ToLower(query), clause)
+		if start < 0 {
+			out.WriteString(query)
+			return out.String()
+		}
+		out.WriteString(query[:start+len(clause)-1])
+		query = query[start+len(clause):]
+
+		end := closingParen(query)
+		if end < len(query) && strings.Contains(query[:end], ",") {
+			out.WriteString(query[:end])
+			query = query[end+1:]
+		} else {
+			out.WriteString("(")
+		}
+	}
+}
+
+// closingParen returns the index of the parenthesis, which closes the
+// expression in s, or len(s) when it's not closed.
+func closingParen(s string) int {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				return i
+			}
+			depth--
+		}
+	}
+	return len(s)
+}
+
+// parseCastType parses the type name after "::" and returns the number of
+// bytes consumed.
+func parseCastType(s string) (typ string, n int) {
+	for n < len(s) && s[n] == ' ' {
+		n++
+	}
+	start := n
+	for n < len(s) && (isLetter(s[n]) || isDigit(s[n]) || s[n] == '_') {
+		n++
+	}
+	typ = strings.ToLower(s[start:n])
+	if strings.HasPrefix(s[n:], "[]") {
+		n += 2
+		typ += "[]"
+	}
+	return typ, n
+}
+
+// appendCast wraps the last operand in out with a CAST, when the type
+// affects the comparison semantics in sqlite.
+func appendCast(out []byte, typ string) []byte {
+	var target string
+	switch typ {
+	case "int2", "int4", "int8", "integer", "bigint", "smallint":
+		target = "INTEGER"
+	case "bytea":
+		target = "BLOB"
+	default:
+		return out
+	}
+
+	start := operandStart(out)
+	operand := string(out[start:])
+	out = append(out[:start], "CAST("...)
+	out = append(out, operand...)
+	out = append(out, " AS "+target+")"...)
+	return out
+}
+
+// operandStart finds the start of the last operand in out.
+func operandStart(out []byte) int {
+	i := len(out)
+	if i == 0 {
+		return 0
+	}
+
+	switch out[i-1] {
+	case ')':
+		depth := 0
+		for i--; i > 0; i-- {
+			if out[i] == ')' {
+				depth++
+			} else if out[i] == '(' {
+				depth--
+			}
+			if depth == 0 {
+				break
+			}
+		}
+		// include the function name, if there's one.
+		for i > 0 && (isLetter(out[i-1]) || isDigit(out[i-1]) || out[i-1] == '_') {
+			i--
+		}
+		return i
+	case '\'':
+		for i -= 2; i > 0 && out[i] != '\''; i-- {
+		}
+		return i
+	default:
+		for i > 0 && (isLetter(out[i-1]) || isDigit(out[i-1]) || out[i-1] == '_' || out[i-1] == '.' || out[i-1] == '?') {
+			i--
+		}
+		return i
+	}
+}
+
+func isDigit(ch byte) bool  { return '0' <= ch && ch <= '9' }
+func isLetter(ch byte) bool { return 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z' }
@@ -0,0 +1,53 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package sqliteutil_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/shared/dbutil/sqliteutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+func TestTranslatePostgres(t *testing.T) {
+	for _, test := range []struct {
+		in, out string
+	}{
+		{`SELECT $1, $2`, `SELECT ?1, ?2`},
+		{`SELECT GREATEST(a, b), least(a, b)`, `SELECT max(a, b), min(a, b)`},
+		{`SELECT substring(x FROM 1 FOR 4)`, `SELECT substr(x, 1, 4)`},
+		{`ORDER BY (a, b) DESC`, `ORDER BY a, b DESC`},
+		{`ORDER BY (a) DESC`, `ORDER BY (a) DESC`},
+	} {
+		require.Equal(t, test.out, sqliteutil.TranslatePostgres(test.in), test.in)
+	}
+}
+
+func TestPostgresDialectDriver(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db, err := tagsql.Open(ctx, sqliteutil.PostgresDialectDriverName, ":memory:", nil)
+	require.NoError(t, err)
+	defer ctx.Check(db.Close)
+
+	_, err = db.ExecContext(ctx, `CREATE TABLE example (id INTEGER PRIMARY KEY, created_at TIMESTAMP NOT NULL)`)
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	_, err = db.ExecContext(ctx, `INSERT INTO example (id, created_at) VALUES ($1, $2)`, 1, now)
+	require.NoError(t, err)
+
+	var createdAt time.Time
+	err = db.QueryRowContext(ctx, `SELECT created_at FROM example WHERE id = $1::INT8`, 1).Scan(&createdAt)
+	require.NoError(t, err)
+	require.True(t, now.Equal(createdAt), "%v != %v", now, createdAt)
+
+	_, err = db.ExecContext(ctx, `INSERT INTO example (id, created_at) VALUES ($1, now())`, 1)
+	require.Error(t, err)
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package sqliteutil
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/shared/dbutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+// DefaultOptions are the connection options used for file databases, when
+// the connection string doesn't specify any.
+const DefaultOptions = "_busy_timeout=10000&_journal=WAL&_txlock=immediate"
+
+// WithDefaultOptions adds DefaultOptions to source, when it doesn't specify any options.
+func WithDefaultOptions(source string) string {
+	if strings.Contains(source, "?") || strings.Contains(source, ":memory:") {
+		return source
+	}
+	if !strings.HasPrefix(source, "file:") {
+		source = "file:" + source
+	}
+	return source + "?" + DefaultOptions
+}
+
+// OpenUnique opens a temporary, uniquely named sqlite database file, which uses the
+// Postgres dialect driver. The connstr may specify the directory for the file,
+// e.g. "sqlite3:///tmp", otherwise the default temporary directory is used.
+// When closed, the database file will be deleted.
+func OpenUnique(ctx context.Context, connstr string, prefix string) (*dbutil.TempDatabase, error) {
+	_, dir, impl, err := dbutil.SplitConnStr(connstr)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if impl != dbutil.SQLite3 {
+		return nil, errs.New("not a sqlite connection string: %q", connstr)
+	}
+	if dir == "" {
+		dir = os.TempDir()
+	}
+
+	var suffix [8]byte
+	if _, err := rand.Read(suffix[:]); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	name := strings.ReplaceAll(prefix, "/", "_") + "-" + hex.EncodeToString(suffix[:])
+	path := filepath.Join(dir, name+".db")
+
+	source := "file:" + path + "?" + DefaultOptions
+	db, err := tagsql.Open(ctx, PostgresDialectDriverName, source, nil)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	cleanup := func(tagsql.DB) error {
+		var group errs.Group
+		for _, suffix := range []string{"", "-wal", "-shm"} {
+			if err := os.Remove(path + suffix); err != nil && !os.IsNotExist(err) {
+				group.Add(err)
+			}
+		}
+		return group.Err()
+	}
+
+	return &dbutil.TempDatabase{
+		DB:             db,
+		ConnStr:        "sqlite3://" + source,
+		Schema:         name,
+		Driver:         PostgresDialectDriverName,
+		Implementation: dbutil.SQLite3,
+		Cleanup:        cleanup,
+	}, nil
+}
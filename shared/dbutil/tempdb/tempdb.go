@@ -14,6 +14,7 @@ import (
 	"storj.io/storj/shared/dbutil/cockroachutil"
 	"storj.io/storj/shared/dbutil/pgutil"
 	"storj.io/storj/shared/dbutil/spannerutil"
+	"storj.io/storj/shared/dbutil/sqliteutil"
 )
 
 // OpenUnique opens a temporary, uniquely named database (or isolated database schema)
@@ -28,5 +29,8 @@ func OpenUnique(ctx context.Context, log *zap.Logger, connURL string, namePrefix
 	if strings.HasPrefix(connURL, "spanner://") {
 		return spannerutil.OpenUnique(ctx, log, connURL, namePrefix, spannerExtraStatements)
 	}
+	if strings.HasPrefix(connURL, "sqlite3://") || strings.HasPrefix(connURL, "sqlite://") {
+		return sqliteutil.OpenUnique(ctx, connURL, namePrefix)
+	}
 	return nil, errs.New("OpenUnique does not yet support the db type for %q", connURL)
 }
@@ -120,7 +120,14 @@ func (m ModuleGraph) componentPage(c *mud.Component) (string, error) {
 		buffer += line + "\n"
 	}
 
+	status := c.Status()
+	statusStr := status.State.String()
+	if status.Err != nil {
+		statusStr += ": " + status.Err.Error()
+	}
+
 	err = tpl.Execute(res, map[string]interface{}{
+		"Status":  statusStr,
 		"Json":    string(raw),
 		"Slug":    strings.ReplaceAll(c.ID(), "/", "_"),
 		"Metrics": metrics,
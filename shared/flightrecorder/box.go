@@ -4,9 +4,14 @@
 package flightrecorder
 
 import (
+	"net/http"
+	"runtime/trace"
 	"sort"
+	"sync"
 	"sync/atomic"
+	"time"
 
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 )
 
@@ -17,6 +22,12 @@ type Box struct {
 	buffers atomic.Pointer[[EventTypeCount]*CircularBuffer]
 	log     *zap.Logger
 	config  Config
+
+	// trace is the moving window of the execution trace, set while Run is active.
+	trace atomic.Pointer[trace.FlightRecorder]
+
+	dumpMu   sync.Mutex
+	lastDump atomic.Int64
 }
 
 // NewBox creates a new Box based on the provided configuration.
@@ -51,6 +62,67 @@ func (b *Box) Enqueue(eventType EventType, skipCallers int) {
 	cb.Enqueue(NewEvent(eventType, skipCallers+1)) // +1 to skip the Enqueue call itself.
 }
 
+// RecordDRPC records a finished DRPC call, and triggers a dump if the call
+// was slower than the latency threshold.
+func (b *Box) RecordDRPC(rpc string, duration time.Duration, err error) {
+	b.record(EventTypeDRPC, rpc, duration, err)
+	if err != nil {
+		b.record(EventTypeError, rpc, duration, err)
+	}
+	b.checkLatency(EventTypeDRPC, duration)
+}
+
+// RecordHTTP records a finished HTTP request, and triggers a dump if the request
+// was slower than the latency threshold.
+func (b *Box) RecordHTTP(method, path string, status int, duration time.Duration) {
+	name := method + " " + path
+	var err error
+	if status >= http.StatusInternalServerError {
+		err = errs.New("%d %s", status, http.StatusText(status))
+		b.record(EventTypeError, name, duration, err)
+	}
+	b.record(EventTypeHTTP, name, duration, err)
+	b.checkLatency(EventTypeHTTP, duration)
+}
+
+// RecordQuery records a database query, if it's slower than the slow query threshold.
+func (b *Box) RecordQuery(query string, duration time.Duration) {
+	if duration < b.config.SlowQueryThreshold {
+		return
+	}
+	b.record(EventTypeSlowQuery, query, duration, nil)
+}
+
+// RecordError records an error, which is not related to a DRPC call or HTTP request.
+func (b *Box) RecordError(name string, err error) {
+	b.record(EventTypeError, name, 0, err)
+}
+
+// record enqueues a typed event. Event types without buffer are ignored.
+//
+// DRPC calls and HTTP requests are recorded for every call, so their stack isn't captured to
+// keep the overhead low. Their name identifies them and the stack would only show the server.
+func (b *Box) record(eventType EventType, name string, duration time.Duration, err error) {
+	cb := b.buffers.Load()[eventType]
+	if cb == nil {
+		return
+	}
+
+	var event Event
+	switch eventType {
+	case EventTypeDRPC, EventTypeHTTP:
+		event = Event{Timestamp: uint64(time.Now().UnixNano()), Type: eventType}
+	default:
+		event = NewEvent(eventType, 2) // 2 to skip record and the Record* call.
+	}
+	event.Name = name
+	event.Duration = duration
+	if err != nil {
+		event.Err = err.Error()
+	}
+	cb.Enqueue(event)
+}
+
 // Reset replaces the active buffers with new ones and returns the old ones.
 // This ensures DumpAndReset reads from a stable snapshot while new events are recorded.
 func (b *Box) Reset() *[EventTypeCount]*CircularBuffer {
@@ -94,15 +166,34 @@ func (b *Box) newBuffers() *[EventTypeCount]*CircularBuffer {
 	buffers := &[EventTypeCount]*CircularBuffer{}
 	buffers[EventTypeDB] = NewCircularBuffer(b.config.DBStackFrameCapacity)
 
+	for eventType, capacity := range map[EventType]int{
+		EventTypeDRPC:      b.config.DRPCCapacity,
+		EventTypeHTTP:      b.config.HTTPCapacity,
+		EventTypeSlowQuery: b.config.SlowQueryCapacity,
+		EventTypeError:     b.config.ErrorCapacity,
+	} {
+		if capacity > 0 {
+			buffers[eventType] = NewCircularBuffer(capacity)
+		}
+	}
+
 	return buffers
 }
 
 // logEvents logs each event using the Box's logger.
 func (b *Box) logEvents(events []Event) {
 	for _, event := range events {
-		b.log.Debug("flight recorder event",
+		fields := []zap.Field{
 			zap.Uint64("timestamp", event.Timestamp),
 			zap.String("type", event.Type.String()),
-			zap.String("stack", event.FormattedStack()))
+			zap.String("stack", event.FormattedStack()),
+		}
+		if event.Name != "" {
+			fields = append(fields, zap.String("name", event.Name), zap.Duration("duration", event.Duration))
+		}
+		if event.Err != "" {
+			fields = append(fields, zap.String("error", event.Err))
+		}
+		b.log.Debug("flight recorder event", fields...)
 	}
 }
@@ -3,9 +3,30 @@
 
 package flightrecorder
 
+import (
+	"time"
+
+	"storj.io/common/memory"
+)
+
 // Config holds configuration for the Flight Recorder (Box), including separate buffer configurations for each event type.
 type Config struct {
 	Enabled bool `help:"enable flight recorder" default:"false"`
 
 	DBStackFrameCapacity int `help:"capacity of the circular buffer for database stack frame events." default:"1000"`
+	DRPCCapacity         int `help:"capacity of the circular buffer for DRPC call events." default:"1000"`
+	HTTPCapacity         int `help:"capacity of the circular buffer for HTTP request events." default:"1000"`
+	SlowQueryCapacity    int `help:"capacity of the circular buffer for slow database query events." default:"100"`
+	ErrorCapacity        int `help:"capacity of the circular buffer for error events." default:"100"`
+
+	SlowQueryThreshold time.Duration `help:"minimum duration of a database query to be recorded as a slow query." default:"1s"`
+	LatencyThreshold   time.Duration `help:"duration of a DRPC call or HTTP request which triggers a dump (0 disables the trigger)." default:"0"`
+
+	TraceEnabled  bool          `help:"keep a moving window of the Go execution trace, which is included in the dumps." default:"false"`
+	TraceMinAge   time.Duration `help:"minimum age of the execution trace window." default:"10s"`
+	TraceMaxBytes memory.Size   `help:"maximum size of the execution trace window." default:"16MiB"`
+
+	DumpDir         string        `help:"directory for the dump bundles (temp directory if empty)." default:""`
+	MinDumpInterval time.Duration `help:"minimum time between two automatically triggered dumps." default:"1m"`
+	MaxDumps        int           `help:"maximum number of dump bundles kept in the dump directory, the oldest ones are removed (0 keeps all)." default:"10"`
 }
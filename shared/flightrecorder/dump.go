@@ -0,0 +1,285 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package flightrecorder
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"runtime/trace"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+)
+
+// Error is the error class of the flight recorder.
+var Error = errs.Class("flightrecorder")
+
+// dumpedEvent is the JSON representation of an Event in the dump bundle.
+type dumpedEvent struct {
+	Time     time.Time     `json:"time"`
+	Type     string        `json:"type"`
+	Name     string        `json:"name,omitempty"`
+	Duration time.Duration `json:"duration,omitempty"`
+	Error    string        `json:"error,omitempty"`
+	Stack    string        `json:"stack,omitempty"`
+}
+
+// Run keeps a moving window of the execution trace (if enabled), and dumps
+// the recorded data when the process receives SIGUSR1.
+func (b *Box) Run(ctx context.Context) error {
+	if b.config.TraceEnabled {
+		recorder := trace.NewFlightRecorder(trace.FlightRecorderConfig{
+			MinAge:   b.config.TraceMinAge,
+			MaxBytes: uint64(b.config.TraceMaxBytes.Int64()),
+		})
+		if err := recorder.Start(); err != nil {
+			return Error.Wrap(err)
+		}
+		b.trace.Store(recorder)
+		defer func() {
+			b.trace.Store(nil)
+			b.dumpMu.Lock()
+			defer b.dumpMu.Unlock()
+			recorder.Stop()
+		}()
+	}
+
+	signals, stop := notifyDumpSignal()
+	defer stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-signals:
+			b.dumpAndLog("signal")
+		}
+	}
+}
+
+// DumpOnPanic writes a dump bundle when the goroutine panics, and re-panics.
+// It must be called directly with defer.
+func (b *Box) DumpOnPanic() {
+	if r := recover(); r != nil {
+		b.dumpAndLog("panic")
+		panic(r)
+	}
+}
+
+// checkLatency triggers a dump in the background, if the duration is above the
+// latency threshold, and there was no dump triggered recently.
+func (b *Box) checkLatency(eventType EventType, duration time.Duration) {
+	if b.config.LatencyThreshold <= 0 || duration < b.config.LatencyThreshold {
+		return
+	}
+
+	now := time.Now().UnixNano()
+	last := b.lastDump.Load()
+	if last != 0 && now-last < int64(b.config.MinDumpInterval) {
+		return
+	}
+	if !b.lastDump.CompareAndSwap(last, now) {
+		return
+	}
+
+	go b.dumpAndLog("latency-" + eventType.String())
+}
+
+func (b *Box) dumpAndLog(reason string) {
+	path, err := b.Dump(reason)
+	if err != nil {
+		b.log.Error("failed to write flight recorder dump", zap.String("reason", reason), zap.Error(err))
+		return
+	}
+	b.log.Info("flight recorder dump is written", zap.String("reason", reason), zap.String("path", path))
+}
+
+// dumpPrefix is the file name prefix of the dump bundles.
+const dumpPrefix = "flightrecorder-"
+
+// Dump writes a bundle with the recorded events, the execution trace window
+// (if enabled) and the goroutine profile to a zip file, and returns its path.
+// Unlike DumpAndReset, the recorded events are kept. The oldest bundles are
+// removed, when there are more than MaxDumps of them.
+func (b *Box) Dump(reason string) (path string, err error) {
+	b.dumpMu.Lock()
+	defer b.dumpMu.Unlock()
+
+	dir := b.config.DumpDir
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	path = filepath.Join(dir, fmt.Sprintf(dumpPrefix+"%s-%s.zip", time.Now().UTC().Format("20060102-150405.000"), reason))
+	if err := b.writeDump(path); err != nil {
+		return "", err
+	}
+
+	if err := b.removeOldDumps(dir); err != nil {
+		b.log.Warn("failed to remove old flight recorder dumps", zap.String("dir", dir), zap.Error(err))
+	}
+	return path, nil
+}
+
+// removeOldDumps removes the oldest dump bundles from the directory, keeping MaxDumps of them.
+func (b *Box) removeOldDumps(dir string) error {
+	if b.config.MaxDumps <= 0 {
+		return nil
+	}
+
+	dumps, err := filepath.Glob(filepath.Join(dir, dumpPrefix+"*.zip"))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if len(dumps) <= b.config.MaxDumps {
+		return nil
+	}
+
+	// the names start with the time of the dump, so they are sorted by age.
+	sort.Strings(dumps)
+
+	var group errs.Group
+	for _, dump := range dumps[:len(dumps)-b.config.MaxDumps] {
+		group.Add(os.Remove(dump))
+	}
+	return Error.Wrap(group.Err())
+}
+
+// writeDump writes the dump bundle to the path.
+func (b *Box) writeDump(path string) (err error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, Error.Wrap(file.Close()))
+		if err != nil {
+			_ = os.Remove(path)
+		}
+	}()
+
+	archive := zip.NewWriter(file)
+
+	w, err := archive.Create("events.json")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if err := json.NewEncoder(w).Encode(b.events()); err != nil {
+		return Error.Wrap(err)
+	}
+
+	w, err = archive.Create("goroutines.txt")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if err := pprof.Lookup("goroutine").WriteTo(w, 2); err != nil {
+		return Error.Wrap(err)
+	}
+
+	if recorder := b.trace.Load(); recorder != nil && recorder.Enabled() {
+		w, err = archive.Create("trace.out")
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if _, err := recorder.WriteTo(w); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	return Error.Wrap(archive.Close())
+}
+
+// events returns all the recorded events, sorted by time.
+func (b *Box) events() []dumpedEvent {
+	var events []Event
+	for _, buf := range *b.buffers.Load() {
+		if buf != nil {
+			events = buf.DumpTo(events)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Timestamp < events[j].Timestamp
+	})
+
+	result := make([]dumpedEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, dumpedEvent{
+			Time:     time.Unix(0, int64(event.Timestamp)).UTC(),
+			Type:     event.Type.String(),
+			Name:     event.Name,
+			Duration: event.Duration,
+			Error:    event.Err,
+			Stack:    event.FormattedStack(),
+		})
+	}
+	return result
+}
+
+// Description implements the debug.Extension interface.
+func (b *Box) Description() string {
+	return "Flight recorder dump"
+}
+
+// Path implements the debug.Extension interface.
+func (b *Box) Path() string {
+	return "/flightrecorder/"
+}
+
+// Handler implements the debug.Extension interface. It writes a dump bundle and
+// returns its path, or the bundle itself with the download query parameter.
+func (b *Box) Handler(w http.ResponseWriter, r *http.Request) {
+	path, err := b.Dump("http")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if r.URL.Query().Get("download") != "" {
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filepath.Base(path)))
+		http.ServeFile(w, r, path)
+		return
+	}
+	_, _ = fmt.Fprintln(w, path)
+}
+
+// HTTPMiddleware records the HTTP requests served by the handler.
+func (b *Box) HTTPMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer b.DumpOnPanic()
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		start := time.Now()
+		next.ServeHTTP(recorder, r)
+		b.RecordHTTP(r.Method, r.URL.Path, recorder.status, time.Since(start))
+	})
+}
+
+// statusRecorder captures the status code of an HTTP response.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader implements http.ResponseWriter.
+func (s *statusRecorder) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
+
+// Unwrap returns the original http.ResponseWriter.
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
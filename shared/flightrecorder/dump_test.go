@@ -0,0 +1,170 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package flightrecorder_test
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/shared/flightrecorder"
+)
+
+func TestDump(t *testing.T) {
+	box := flightrecorder.NewBox(zaptest.NewLogger(t), flightrecorder.Config{
+		DRPCCapacity:       10,
+		HTTPCapacity:       10,
+		SlowQueryCapacity:  10,
+		ErrorCapacity:      10,
+		SlowQueryThreshold: time.Second,
+		DumpDir:            t.TempDir(),
+	})
+
+	box.RecordDRPC("/piecestore/Upload", time.Millisecond, nil)
+	box.RecordDRPC("/piecestore/Download", time.Millisecond, errors.New("download failed"))
+	box.RecordHTTP("GET", "/api/v0/projects", 500, time.Millisecond)
+	box.RecordQuery("SELECT 1", time.Millisecond)
+	box.RecordQuery("SELECT pg_sleep(2)", 2*time.Second)
+
+	path, err := box.Dump("test")
+	require.NoError(t, err)
+	require.Contains(t, filepath.Base(path), "-test.zip")
+
+	events := readDumpedEvents(t, path)
+
+	count := map[string]int{}
+	for _, event := range events {
+		count[event.Type]++
+
+		// stacks are captured only for the event types, which aren't recorded on every call.
+		if event.Type == "DRPC" || event.Type == "HTTP" {
+			require.Empty(t, event.Stack, event.Name)
+		} else {
+			require.NotEmpty(t, event.Stack, event.Name)
+		}
+	}
+	require.Equal(t, map[string]int{
+		"DRPC":      2,
+		"HTTP":      1,
+		"SlowQuery": 1,
+		"Error":     2,
+	}, count)
+}
+
+func TestDumpOnLatency(t *testing.T) {
+	dir := t.TempDir()
+	box := flightrecorder.NewBox(zaptest.NewLogger(t), flightrecorder.Config{
+		DRPCCapacity:     10,
+		LatencyThreshold: time.Second,
+		MinDumpInterval:  time.Hour,
+		DumpDir:          dir,
+	})
+
+	box.RecordDRPC("/fast", time.Millisecond, nil)
+	box.RecordDRPC("/slow", 2*time.Second, nil)
+	box.RecordDRPC("/slow", 2*time.Second, nil)
+
+	require.Eventually(t, func() bool {
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		return len(entries) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// the second slow call is within the minimum dump interval.
+	time.Sleep(100 * time.Millisecond)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func TestDumpRotation(t *testing.T) {
+	dir := t.TempDir()
+	box := flightrecorder.NewBox(zaptest.NewLogger(t), flightrecorder.Config{
+		DRPCCapacity: 10,
+		DumpDir:      dir,
+		MaxDumps:     2,
+	})
+
+	// unrelated files are kept.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "other.zip"), nil, 0644))
+
+	var paths []string
+	for _, reason := range []string{"first", "second", "third"} {
+		path, err := box.Dump(reason)
+		require.NoError(t, err)
+		paths = append(paths, path)
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	require.ElementsMatch(t, []string{"other.zip", filepath.Base(paths[1]), filepath.Base(paths[2])}, names)
+}
+
+func TestHTTPMiddleware(t *testing.T) {
+	box := flightrecorder.NewBox(zaptest.NewLogger(t), flightrecorder.Config{
+		HTTPCapacity:  10,
+		ErrorCapacity: 10,
+		DumpDir:       t.TempDir(),
+	})
+
+	handler := box.HTTPMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	for _, path := range []string{"/ok", "/fail"} {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	path, err := box.Dump("test")
+	require.NoError(t, err)
+
+	var recorded []string
+	for _, event := range readDumpedEvents(t, path) {
+		recorded = append(recorded, event.Type+" "+event.Name)
+	}
+	require.ElementsMatch(t, []string{"HTTP GET /ok", "HTTP GET /fail", "Error GET /fail"}, recorded)
+}
+
+type dumpedEvent struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Error string `json:"error"`
+	Stack string `json:"stack"`
+}
+
+func readDumpedEvents(t *testing.T, path string) []dumpedEvent {
+	archive, err := zip.OpenReader(path)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, archive.Close()) }()
+
+	var names []string
+	for _, file := range archive.File {
+		names = append(names, file.Name)
+	}
+	require.Equal(t, []string{"events.json", "goroutines.txt"}, names)
+
+	eventsFile, err := archive.Open("events.json")
+	require.NoError(t, err)
+	defer func() { require.NoError(t, eventsFile.Close()) }()
+
+	var events []dumpedEvent
+	require.NoError(t, json.NewDecoder(eventsFile).Decode(&events))
+	return events
+}
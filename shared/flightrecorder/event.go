@@ -16,6 +16,14 @@ type EventType int
 const (
 	// EventTypeDB represents a database event.
 	EventTypeDB EventType = iota
+	// EventTypeDRPC represents a finished DRPC call.
+	EventTypeDRPC
+	// EventTypeHTTP represents a finished HTTP request.
+	EventTypeHTTP
+	// EventTypeSlowQuery represents a database query slower than the configured threshold.
+	EventTypeSlowQuery
+	// EventTypeError represents an error returned to a client or logged by a service.
+	EventTypeError
 
 	// EventTypeCount represents the total number of event types.
 	// Always keep this last in the const block.
@@ -27,6 +35,14 @@ func (et EventType) String() string {
 	switch et {
 	case EventTypeDB:
 		return "DB"
+	case EventTypeDRPC:
+		return "DRPC"
+	case EventTypeHTTP:
+		return "HTTP"
+	case EventTypeSlowQuery:
+		return "SlowQuery"
+	case EventTypeError:
+		return "Error"
 	default:
 		return "Unknown"
 	}
@@ -35,11 +51,16 @@ func (et EventType) String() string {
 // Event represents a single recorded event.
 // Timestamp is stored as a uint64 (Unix nanosecond timestamp) to minimize size.
 // Stack is stored as a fixed-size array of uintptr, ensuring constant memory usage.
+// Name, Duration and Err are only set for typed events (e.g. the RPC name and latency of a DRPC call).
 type Event struct {
 	Timestamp   uint64
 	Stack       [8]uintptr
 	Type        EventType
 	SkipCallers int
+
+	Name     string
+	Duration time.Duration
+	Err      string
 }
 
 // NewEvent creates a new event with the given type and stack depth.
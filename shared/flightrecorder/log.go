@@ -0,0 +1,84 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package flightrecorder
+
+import (
+	"go.uber.org/zap/zapcore"
+)
+
+// WrapLogCore returns a zapcore.Core, which records the entries logged at error level or above
+// as errors in addition to writing them to the core. This covers the error paths of chores and
+// services, which log their errors instead of returning them.
+//
+// It's meant to be used with zap.WrapCore.
+func (b *Box) WrapLogCore(core zapcore.Core) zapcore.Core {
+	return &errorCore{Core: core, box: b}
+}
+
+// errorCore records the logged errors to the box.
+type errorCore struct {
+	zapcore.Core
+	box    *Box
+	fields []zapcore.Field
+}
+
+// With implements zapcore.Core.
+func (c *errorCore) With(fields []zapcore.Field) zapcore.Core {
+	return &errorCore{
+		Core:   c.Core.With(fields),
+		box:    c.box,
+		fields: append(c.fields[:len(c.fields):len(c.fields)], fields...),
+	}
+}
+
+// Check implements zapcore.Core.
+func (c *errorCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if entry.Level >= zapcore.ErrorLevel {
+		checked = checked.AddCore(entry, errorRecorder{core: c})
+	}
+	return c.Core.Check(entry, checked)
+}
+
+// errorRecorder is added to the checked entries, so that writing them records the error
+// without writing the entry to the wrapped core twice.
+type errorRecorder struct {
+	core *errorCore
+}
+
+// Enabled implements zapcore.Core.
+func (r errorRecorder) Enabled(level zapcore.Level) bool { return level >= zapcore.ErrorLevel }
+
+// With implements zapcore.Core.
+func (r errorRecorder) With(fields []zapcore.Field) zapcore.Core { return r.core.With(fields) }
+
+// Check implements zapcore.Core.
+func (r errorRecorder) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	return checked
+}
+
+// Write implements zapcore.Core.
+func (r errorRecorder) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	name := entry.Message
+	if entry.LoggerName != "" {
+		name = entry.LoggerName + ": " + name
+	}
+
+	r.core.box.RecordError(name, loggedError(fields, r.core.fields))
+	return nil
+}
+
+// loggedError returns the first error field of the entry, or nil.
+func loggedError(lists ...[]zapcore.Field) error {
+	for _, fields := range lists {
+		for _, field := range fields {
+			if err, ok := field.Interface.(error); ok && field.Type == zapcore.ErrorType {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Sync implements zapcore.Core.
+func (r errorRecorder) Sync() error { return nil }
@@ -0,0 +1,47 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package flightrecorder_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+	"go.uber.org/zap/zaptest/observer"
+
+	"storj.io/storj/shared/flightrecorder"
+)
+
+func TestWrapLogCore(t *testing.T) {
+	box := flightrecorder.NewBox(zaptest.NewLogger(t), flightrecorder.Config{
+		ErrorCapacity: 10,
+		DumpDir:       t.TempDir(),
+	})
+
+	core, observed := observer.New(zap.InfoLevel)
+	log := zap.New(core).WithOptions(zap.WrapCore(box.WrapLogCore)).Named("chore")
+
+	log.Info("iteration finished")
+	log.Error("iteration failed", zap.Error(errors.New("database unavailable")))
+	log.With(zap.Error(errors.New("disk full"))).Warn("retrying")
+	log.With(zap.String("bucket", "b")).Error("unable to delete")
+
+	// every entry is still written to the wrapped core exactly once.
+	require.Equal(t, 4, observed.Len())
+
+	path, err := box.Dump("test")
+	require.NoError(t, err)
+
+	var recorded []string
+	for _, event := range readDumpedEvents(t, path) {
+		require.Equal(t, "Error", event.Type)
+		recorded = append(recorded, event.Name+": "+event.Error)
+	}
+	require.ElementsMatch(t, []string{
+		"chore: iteration failed: database unavailable",
+		"chore: unable to delete: ",
+	}, recorded)
+}
@@ -3,10 +3,18 @@
 
 package flightrecorder
 
+import "time"
+
 // NewTestConfig creates a new test config.
 func NewTestConfig() Config {
 	return Config{
 		Enabled:              true,
 		DBStackFrameCapacity: 1000,
+		DRPCCapacity:         1000,
+		HTTPCapacity:         1000,
+		SlowQueryCapacity:    100,
+		ErrorCapacity:        100,
+		SlowQueryThreshold:   time.Second,
+		MinDumpInterval:      time.Minute,
 	}
 }
@@ -0,0 +1,19 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+//go:build !windows
+
+package flightrecorder
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// notifyDumpSignal returns a channel, which receives SIGUSR1.
+func notifyDumpSignal() (<-chan os.Signal, func()) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGUSR1)
+	return signals, func() { signal.Stop(signals) }
+}
@@ -0,0 +1,14 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package flightrecorder
+
+import (
+	"os"
+)
+
+// notifyDumpSignal returns a channel, which never receives anything, as
+// SIGUSR1 is not available on windows.
+func notifyDumpSignal() (<-chan os.Signal, func()) {
+	return make(chan os.Signal), func() {}
+}
@@ -99,12 +99,7 @@ func (m *MudCommand) Execute(ctx context.Context) error {
 	}
 
 	defer func() {
-		shutdownTimeout := 15 * time.Second
-		if timeoutStr := os.Getenv("STORJ_SHUTDOWN_TIMEOUT"); timeoutStr != "" {
-			if timeoutSecs, parseErr := strconv.Atoi(timeoutStr); parseErr == nil && timeoutSecs > 0 {
-				shutdownTimeout = time.Duration(timeoutSecs) * time.Second
-			}
-		}
+		shutdownTimeout := secondsFromEnv("STORJ_SHUTDOWN_TIMEOUT", 15*time.Second)
 
 		closeCtx, cancel := context.WithCancel(context.Background())
 		defer cancel()
@@ -143,7 +138,12 @@ func (m *MudCommand) Execute(ctx context.Context) error {
 		}
 	}()
 
-	eg, childCtx := errgroup.WithContext(ctx)
+	// Run stages are not canceled directly by the signal: the components are drained first.
+	runCtx, stopRun := context.WithCancel(context.WithoutCancel(ctx))
+	defer stopRun()
+
+	eg, childCtx := errgroup.WithContext(runCtx)
+	drained := modular.DrainOnCancel(ctx, childCtx, m.ball, m.runSelector, secondsFromEnv("STORJ_DRAIN_TIMEOUT", modular.DefaultDrainTimeout), stopRun)
 
 	// Phase 2: Run RunEarly components AND their dependencies
 	// Note: background goroutines are dispatched but may not be fully running yet when Phase 3 starts.
@@ -164,6 +164,9 @@ func (m *MudCommand) Execute(ctx context.Context) error {
 		return errs.Wrap(err)
 	}
 
+	// readiness requires all the selected components to be running from now.
+	modular.WatchHealth(m.ball, m.runSelector)
+
 	// Phase 4: Run all remaining components (already running components are skipped by component.Run)
 	err = mud.ForEachDependency(m.ball, m.runSelector, func(component *mud.Component) error {
 		return component.Run(pprof.WithLabels(childCtx, pprof.Labels("component", component.Name())), eg)
@@ -172,7 +175,22 @@ func (m *MudCommand) Execute(ctx context.Context) error {
 		return errs.Wrap(err)
 	}
 
-	return eg.Wait()
+	err = eg.Wait()
+	stopRun()
+	if drainErr := <-drained; drainErr != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "%v\n", drainErr)
+	}
+	return err
+}
+
+// secondsFromEnv returns the duration from the environment variable (in seconds), or the default value.
+func secondsFromEnv(name string, defaultValue time.Duration) time.Duration {
+	if timeoutStr := os.Getenv(name); timeoutStr != "" {
+		if timeoutSecs, parseErr := strconv.Atoi(timeoutStr); parseErr == nil && timeoutSecs > 0 {
+			return time.Duration(timeoutSecs) * time.Second
+		}
+	}
+	return defaultValue
 }
 
 func (m *MudCommand) printComponentInformation() error {
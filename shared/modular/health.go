@@ -0,0 +1,178 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package modular
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"storj.io/storj/shared/mud"
+)
+
+// HealthProbe is an optional interface for components to report their health,
+// in addition to the lifecycle state which is tracked by mud.
+type HealthProbe interface {
+	// CheckHealth returns an error if the component is not able to serve requests.
+	CheckHealth(ctx context.Context) error
+}
+
+// ComponentHealth is the lifecycle state and health of a single component.
+type ComponentHealth struct {
+	Name    string    `json:"name"`
+	State   string    `json:"state"`
+	Since   time.Time `json:"since,omitzero"`
+	Healthy bool      `json:"healthy"`
+	Error   string    `json:"error,omitempty"`
+}
+
+// HealthReport is the result of a readiness or liveness check.
+type HealthReport struct {
+	Healthy    bool              `json:"healthy"`
+	Components []ComponentHealth `json:"components"`
+}
+
+// Health reports readiness and liveness, based on the lifecycle state of the components.
+//
+// A process is live, until one of the components is failed. A process is ready, when
+// all the watched components are initialized and running, and the components which
+// implement HealthProbe report no error.
+type Health struct {
+	ball *mud.Ball
+
+	mu       sync.Mutex
+	selector mud.ComponentSelector
+}
+
+// NewHealth creates a new Health for the components of the ball.
+func NewHealth(ball *mud.Ball) *Health {
+	return &Health{
+		ball: ball,
+	}
+}
+
+// Watch sets the selected components (and dependencies), which are required to be running for readiness.
+// The process is not ready until Watch is called.
+func (h *Health) Watch(selector mud.ComponentSelector) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.selector = selector
+}
+
+// WatchHealth calls Watch on the Health component, if it's registered and initialized.
+func WatchHealth(ball *mud.Ball, selector mud.ComponentSelector) {
+	component, found := mud.LookupByType(ball, reflect.TypeOf((*Health)(nil)))
+	if !found {
+		return
+	}
+	if health, ok := component.Instance().(*Health); ok {
+		health.Watch(selector)
+	}
+}
+
+// Ready checks if all the watched components are running and healthy.
+func (h *Health) Ready(ctx context.Context) HealthReport {
+	h.mu.Lock()
+	selector := h.selector
+	h.mu.Unlock()
+
+	var components []*mud.Component
+	if selector != nil {
+		components = mud.FindSelectedWithDependencies(h.ball, selector)
+	} else {
+		components = h.started()
+	}
+
+	report := HealthReport{Healthy: selector != nil}
+	for _, component := range components {
+		status := component.Status()
+		result := ComponentHealth{
+			Name:    component.Name(),
+			State:   status.State.String(),
+			Since:   status.Since,
+			Healthy: true,
+		}
+
+		switch {
+		case status.State == mud.StateFailed:
+			result.Healthy = false
+			result.Error = status.Err.Error()
+		case status.State == mud.StatePending, status.State == mud.StateDraining, status.State == mud.StateStopped:
+			result.Healthy = false
+		case status.State == mud.StateInitialized && component.HasRunStage():
+			result.Healthy = false
+		default:
+			if probe, ok := component.Instance().(HealthProbe); ok {
+				if err := probe.CheckHealth(ctx); err != nil {
+					result.Healthy = false
+					result.Error = err.Error()
+				}
+			}
+		}
+
+		report.Healthy = report.Healthy && result.Healthy
+		report.Components = append(report.Components, result)
+	}
+	report.sort()
+	return report
+}
+
+// Live checks if none of the started components are failed.
+func (h *Health) Live(ctx context.Context) HealthReport {
+	report := HealthReport{Healthy: true}
+	for _, component := range h.started() {
+		status := component.Status()
+		result := ComponentHealth{
+			Name:    component.Name(),
+			State:   status.State.String(),
+			Since:   status.Since,
+			Healthy: status.State != mud.StateFailed,
+		}
+		if status.Err != nil {
+			result.Error = status.Err.Error()
+		}
+
+		report.Healthy = report.Healthy && result.Healthy
+		report.Components = append(report.Components, result)
+	}
+	report.sort()
+	return report
+}
+
+// ServeReady is an HTTP handler for the readiness check.
+func (h *Health) ServeReady(w http.ResponseWriter, r *http.Request) {
+	writeHealthReport(w, h.Ready(r.Context()))
+}
+
+// ServeLive is an HTTP handler for the liveness check.
+func (h *Health) ServeLive(w http.ResponseWriter, r *http.Request) {
+	writeHealthReport(w, h.Live(r.Context()))
+}
+
+// started returns the components which are initialized (or tried to be initialized).
+func (h *Health) started() []*mud.Component {
+	return mud.Find(h.ball, func(component *mud.Component) bool {
+		return component.Status().State != mud.StatePending
+	})
+}
+
+func (report *HealthReport) sort() {
+	sort.Slice(report.Components, func(i, j int) bool {
+		return report.Components[i].Name < report.Components[j].Name
+	})
+}
+
+func writeHealthReport(w http.ResponseWriter, report HealthReport) {
+	w.Header().Set("Content-Type", "application/json")
+	if report.Healthy {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_ = json.NewEncoder(w).Encode(report)
+}
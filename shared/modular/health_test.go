@@ -0,0 +1,85 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package modular_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/shared/modular"
+	"storj.io/storj/shared/mud"
+)
+
+type probedService struct {
+	err error
+}
+
+func (p *probedService) Run(ctx context.Context) error {
+	<-ctx.Done()
+	return nil
+}
+
+func (p *probedService) CheckHealth(ctx context.Context) error {
+	return p.err
+}
+
+func TestHealth(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	service := &probedService{}
+
+	ball := mud.NewBall()
+	mud.Provide[*probedService](ball, func() *probedService { return service })
+	mud.Provide[*modular.Health](ball, modular.NewHealth)
+
+	require.NoError(t, modular.Initialize(ctx, ball, mud.Select[*modular.Health](ball)))
+	health := mud.MustLookup[*modular.Health](ball)
+
+	// not ready, until the runner starts to watch the components
+	require.False(t, health.Ready(ctx).Healthy)
+	require.True(t, health.Live(ctx).Healthy)
+
+	selector := mud.Select[*probedService](ball)
+	require.NoError(t, modular.Initialize(ctx, ball, selector))
+	modular.WatchHealth(ball, selector)
+
+	// initialized, but not yet running
+	require.False(t, health.Ready(ctx).Healthy)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var eg errgroup.Group
+	require.NoError(t, mud.MustLookupComponent[*probedService](ball).Run(runCtx, &eg))
+
+	report := health.Ready(ctx)
+	require.True(t, report.Healthy)
+	require.Len(t, report.Components, 1)
+	require.Equal(t, "running", report.Components[0].State)
+
+	service.err = errs.New("database is not available")
+
+	recorder := httptest.NewRecorder()
+	health.ServeReady(recorder, httptest.NewRequest(http.MethodGet, "/ready", nil))
+	require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+
+	var decoded modular.HealthReport
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &decoded))
+	require.False(t, decoded.Healthy)
+	require.Equal(t, "database is not available", decoded.Components[0].Error)
+
+	recorder = httptest.NewRecorder()
+	health.ServeLive(recorder, httptest.NewRequest(http.MethodGet, "/live", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	cancel()
+	require.NoError(t, eg.Wait())
+}
@@ -5,8 +5,11 @@ package modular
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"time"
 
+	"github.com/zeebo/errs"
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/storj/shared/mud"
@@ -17,16 +20,97 @@ type StopTrigger struct {
 	Cancel context.CancelFunc
 }
 
+// DefaultDrainTimeout is the drain deadline of components without DrainTimeout annotation.
+const DefaultDrainTimeout = 30 * time.Second
+
+// ErrDrainOverrun is returned when some of the components couldn't finish draining before their deadline.
+var ErrDrainOverrun = errs.Class("drain overrun")
+
+// DrainTimeout is an annotation to override the drain deadline of a component.
+type DrainTimeout struct {
+	Timeout time.Duration
+}
+
+func (d DrainTimeout) String() string {
+	return "DrainTimeout(" + d.Timeout.String() + ")"
+}
+
 // Run runs storage node until it's either closed or it errors.
+//
+// When ctx is canceled (e.g. on SIGTERM), the components are drained first, and the
+// Run stages are canceled only after the drain is finished.
 func Run(ctx context.Context, ball *mud.Ball, selector mud.ComponentSelector) (err error) {
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancel()
+
 	eg := &errgroup.Group{}
 	err = mud.ForEachDependency(ball, selector, func(component *mud.Component) error {
-		return component.Run(ctx, eg)
+		return component.Run(runCtx, eg)
 	}, mud.All)
 	if err != nil {
 		return err
 	}
-	return eg.Wait()
+
+	drained := DrainOnCancel(ctx, runCtx, ball, selector, DefaultDrainTimeout, cancel)
+	err = eg.Wait()
+	cancel()
+	return errs.Combine(err, <-drained)
+}
+
+// DrainOnCancel drains the selected components when ctx is canceled, and calls stop after that
+// to cancel runCtx (the context of the Run stages). The returned channel receives the result of
+// the drain, or nil, if runCtx is done without draining.
+func DrainOnCancel(ctx, runCtx context.Context, ball *mud.Ball, selector mud.ComponentSelector, timeout time.Duration, stop context.CancelFunc) <-chan error {
+	drained := make(chan error, 1)
+	go func() {
+		defer stop()
+		select {
+		case <-ctx.Done():
+			if runCtx.Err() != nil {
+				drained <- nil
+				return
+			}
+			drained <- Drain(context.WithoutCancel(ctx), ball, selector, timeout)
+		case <-runCtx.Done():
+			drained <- nil
+		}
+	}()
+	return drained
+}
+
+// Drain calls the Drain stage of the selected components (and dependencies) in reverse dependency order.
+// Components which depend on others (like API servers) are drained before their dependencies (like
+// write buffers). Each component gets its own deadline (DrainTimeout annotation or the default timeout),
+// components overrunning their deadline are reported with ErrDrainOverrun.
+func Drain(ctx context.Context, ball *mud.Ball, selector mud.ComponentSelector, timeout time.Duration) error {
+	var group errs.Group
+	var overran []string
+	_ = mud.ForEachDependencyReverse(ball, selector, func(component *mud.Component) error {
+		if !component.HasDrainStage() {
+			return nil
+		}
+
+		deadline := timeout
+		if tag, found := mud.GetTagOf[DrainTimeout](component); found {
+			deadline = tag.Timeout
+		}
+
+		drainCtx, cancel := context.WithTimeout(ctx, deadline)
+		defer cancel()
+
+		err := component.Drain(drainCtx)
+		if errors.Is(err, context.DeadlineExceeded) || drainCtx.Err() != nil {
+			overran = append(overran, component.Name())
+		} else if err != nil {
+			group.Add(err)
+		}
+		return nil
+	}, mud.All)
+
+	if len(overran) > 0 {
+		group.Add(ErrDrainOverrun.New("%s", strings.Join(overran, ", ")))
+	}
+	return group.Err()
 }
 
 // Close closes all the resources.
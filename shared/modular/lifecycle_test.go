@@ -0,0 +1,107 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package modular_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/shared/modular"
+	"storj.io/storj/shared/mud"
+)
+
+type drainOrder struct {
+	mu    sync.Mutex
+	names []string
+}
+
+func (d *drainOrder) add(name string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.names = append(d.names, name)
+}
+
+type drainedBuffer struct {
+	order *drainOrder
+}
+
+func (b *drainedBuffer) Drain(ctx context.Context) error {
+	b.order.add("buffer")
+	return nil
+}
+
+type drainedServer struct {
+	order  *drainOrder
+	buffer *drainedBuffer
+	stuck  bool
+}
+
+func (s *drainedServer) Run(ctx context.Context) error {
+	<-ctx.Done()
+	s.order.add("run finished")
+	return nil
+}
+
+func (s *drainedServer) Drain(ctx context.Context) error {
+	s.order.add("server")
+	if s.stuck {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return nil
+}
+
+func TestRunDrain(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	order := &drainOrder{}
+	ball := mud.NewBall()
+	mud.Supply[*drainOrder](ball, order)
+	mud.Provide[*drainedBuffer](ball, func(order *drainOrder) *drainedBuffer {
+		return &drainedBuffer{order: order}
+	})
+	mud.Provide[*drainedServer](ball, func(order *drainOrder, buffer *drainedBuffer) *drainedServer {
+		return &drainedServer{order: order, buffer: buffer}
+	})
+
+	selector := mud.Select[*drainedServer](ball)
+	require.NoError(t, modular.Initialize(ctx, ball, selector))
+
+	runCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.NoError(t, modular.Run(runCtx, ball, selector))
+
+	// dependents are drained first, and the run stages are canceled only after the drain.
+	require.Equal(t, []string{"server", "buffer", "run finished"}, order.names)
+}
+
+func TestDrainOverrun(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	order := &drainOrder{}
+	ball := mud.NewBall()
+	mud.Supply[*drainOrder](ball, order)
+	mud.Provide[*drainedBuffer](ball, func(order *drainOrder) *drainedBuffer {
+		return &drainedBuffer{order: order}
+	})
+	mud.Provide[*drainedServer](ball, func(order *drainOrder, buffer *drainedBuffer) *drainedServer {
+		return &drainedServer{order: order, buffer: buffer, stuck: true}
+	})
+	mud.Tag[*drainedServer, modular.DrainTimeout](ball, modular.DrainTimeout{Timeout: 10 * time.Millisecond})
+
+	selector := mud.Select[*drainedServer](ball)
+	require.NoError(t, modular.Initialize(ctx, ball, selector))
+
+	err := modular.Drain(ctx, ball, selector, time.Minute)
+	require.True(t, modular.ErrDrainOverrun.Has(err))
+	require.Contains(t, err.Error(), "*modular_test.drainedServer")
+
+	// other components are still drained
+	require.Equal(t, []string{"server", "buffer"}, order.names)
+}
@@ -5,11 +5,13 @@ package mud
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"reflect"
 	"slices"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -33,11 +35,16 @@ type Component struct {
 
 	run *Stage
 
+	drain *Stage
+
 	close *Stage
 
 	tags []any
 
 	definition string
+
+	statusMu sync.Mutex
+	status   Status
 }
 
 // Name returns with the human friendly name of the component.
@@ -58,6 +65,7 @@ func (c *Component) Init(ctx context.Context) error {
 	c.create.started = time.Now()
 	err := c.create.run(nil, ctx)
 	c.create.finished = time.Now()
+	c.setStatus(StateInitialized, err)
 	return err
 }
 
@@ -71,6 +79,7 @@ func (c *Component) Run(ctx context.Context, eg *errgroup.Group) error {
 	}
 
 	c.run.dispatched.Store(true)
+	c.setStatus(StateRunning, nil)
 
 	if c.run.background {
 		eg.Go(func() error {
@@ -83,6 +92,12 @@ func (c *Component) Run(ctx context.Context, eg *errgroup.Group) error {
 			c.run.started = time.Now()
 			err := c.run.run(c.instance, ctx)
 			c.run.finished = time.Now()
+			if errors.Is(err, context.Canceled) {
+				// cancellation is the normal way to stop background stages.
+				c.setStatus(StateCompleted, nil)
+			} else {
+				c.setStatus(StateCompleted, err)
+			}
 			return err
 		})
 		return nil
@@ -90,10 +105,35 @@ func (c *Component) Run(ctx context.Context, eg *errgroup.Group) error {
 		c.run.started = time.Now()
 		err := c.run.run(c.instance, ctx)
 		c.run.finished = time.Now()
+		if err != nil {
+			c.setStatus(StateFailed, err)
+		}
 		return err
 	}
 }
 
+// Drain calls the Drain stage function. Drain is called before Close, while the Run stage is
+// still active, to stop accepting new work and to finish (or flush) the in-flight work.
+func (c *Component) Drain(ctx context.Context) error {
+	if c.drain == nil || c.drain.run == nil || c.drain.dispatched.Load() || c.instance == nil {
+		return nil
+	}
+	c.drain.dispatched.Store(true)
+	c.setStatus(StateDraining, nil)
+	c.drain.started = time.Now()
+	err := c.drain.run(c.instance, ctx)
+	c.drain.finished = time.Now()
+	if err != nil {
+		c.setStatus(StateFailed, err)
+	}
+	return err
+}
+
+// HasDrainStage returns true if the component has a Drain stage.
+func (c *Component) HasDrainStage() bool {
+	return c.drain != nil && c.drain.run != nil
+}
+
 // Close calls the Close stage function.
 func (c *Component) Close(ctx context.Context) error {
 	if c.close == nil || c.close.run == nil || c.close.dispatched.Load() || c.instance == nil {
@@ -103,6 +143,7 @@ func (c *Component) Close(ctx context.Context) error {
 	c.close.started = time.Now()
 	err := c.close.run(c.instance, ctx)
 	c.close.finished = time.Now()
+	c.setStatus(StateStopped, err)
 	return err
 }
 
@@ -111,6 +152,7 @@ func (c *Component) String() string {
 	out := c.target.String()
 	out += stageStr(c.create, "i")
 	out += stageStr(c.run, "r")
+	out += stageStr(c.drain, "d")
 	out += stageStr(c.close, "c")
 	return out
 }
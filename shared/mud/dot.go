@@ -67,15 +67,25 @@ func Dot(w io.Writer, components []*Component) (err error) {
 		if strings.Contains(component.Name(), "zap.Logger") {
 			continue
 		}
-		entries := []string{"label=\"" + component.Name() + tagsStr(component) + "\""}
-		if component.instance == nil {
+		status := component.Status()
+		label := component.Name() + tagsStr(component)
+		if status.State != StatePending {
+			label += "\n[" + status.State.String() + "]"
+		}
+		entries := []string{fmt.Sprintf("label=%q", label)}
+		switch status.State {
+		case StatePending:
 			entries = append(entries, "color=darkgray", "fontcolor=darkgray")
-		} else if component.run != nil && !component.run.started.IsZero() {
-			if component.run.finished.IsZero() {
-				entries = append(entries, "fillcolor=green")
-			} else {
-				entries = append(entries, "fillcolor=blue")
-			}
+		case StateRunning:
+			entries = append(entries, "fillcolor=green")
+		case StateCompleted:
+			entries = append(entries, "fillcolor=blue")
+		case StateDraining:
+			entries = append(entries, "fillcolor=yellow")
+		case StateStopped:
+			entries = append(entries, "fillcolor=lightgray")
+		case StateFailed:
+			entries = append(entries, "fillcolor=red", fmt.Sprintf("tooltip=%q", status.Err.Error()))
 		}
 
 		for _, tag := range component.tags {
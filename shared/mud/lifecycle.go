@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
@@ -37,21 +38,41 @@ func runComponents(ctx context.Context, log *zap.Logger, components []*Component
 	return g.Wait()
 }
 
-// CloseAll calls the close callback stage on all initialized components.
+// CloseAll calls the drain and close callback stages on all initialized components.
+// All the components are drained (in reverse order) before closing the first one.
+// The drain and the close stages have their own deadline, and the components are closed
+// even when some of them failed to drain.
 func CloseAll(ball *Ball, timeout time.Duration) error {
-	ctx := context.Background()
-	ctx, cancel := context.WithTimeout(ctx, timeout)
-	defer cancel()
 	components := ball.registry
 	reverse(components)
 	log := ball.getLogger()
-	return forEachComponent(components, func(component *Component) error {
+
+	var group errs.Group
+	func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		_ = forEachComponent(components, func(component *Component) error {
+			if component.instance != nil && component.HasDrainStage() {
+				log.Info("draining", zap.String("component", component.Name()))
+				if err := component.Drain(ctx); err != nil {
+					log.Warn("draining failed", zap.String("component", component.Name()), zap.Error(err))
+					group.Add(err)
+				}
+			}
+			return nil
+		})
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	group.Add(forEachComponent(components, func(component *Component) error {
 		log.Info("closing", zap.String("component", component.Name()))
 		if component.instance != nil {
 			return component.Close(ctx)
 		}
 		return nil
-	})
+	}))
+	return group.Err()
 }
 
 // Reverse reverses the elements of the slice in place.
@@ -0,0 +1,77 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mud
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+)
+
+type drainRecorder struct {
+	events *[]string
+}
+
+type failingDrain struct {
+	drainRecorder
+}
+
+func (d *failingDrain) Drain(ctx context.Context) error {
+	*d.events = append(*d.events, "drain failing")
+	return errors.New("drain failed")
+}
+
+func (d *failingDrain) Close(ctx context.Context) error {
+	*d.events = append(*d.events, "close failing")
+	return nil
+}
+
+type slowDrain struct {
+	drainRecorder
+	failing *failingDrain
+}
+
+func (d *slowDrain) Drain(ctx context.Context) error {
+	*d.events = append(*d.events, "drain slow")
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (d *slowDrain) Close(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	*d.events = append(*d.events, "close slow")
+	return nil
+}
+
+func TestCloseAll(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var events []string
+	ball := NewBall()
+	Provide[*failingDrain](ball, func() *failingDrain {
+		return &failingDrain{drainRecorder{events: &events}}
+	})
+	Provide[*slowDrain](ball, func(failing *failingDrain) *slowDrain {
+		return &slowDrain{drainRecorder: drainRecorder{events: &events}, failing: failing}
+	})
+
+	for _, component := range FindSelectedWithDependencies(ball, Select[*slowDrain](ball)) {
+		require.NoError(t, component.Init(ctx))
+	}
+
+	err := CloseAll(ball, 100*time.Millisecond)
+	require.Error(t, err)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.ErrorContains(t, err, "drain failed")
+
+	// the components are closed with a fresh deadline, even though draining failed.
+	require.Equal(t, []string{"drain slow", "drain failing", "close slow", "close failing"}, events)
+}
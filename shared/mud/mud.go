@@ -398,6 +398,13 @@ func Provide[A any](ball *Ball, factory interface{}, options ...any) {
 		registerFunc[A](runF, component.run, "Run")
 	}
 
+	// auto-detect Drain method for Drain stage
+	drainF, found := t.MethodByName("Drain")
+	if found {
+		component.drain = &Stage{}
+		registerFunc[A](drainF, component.drain, "Drain")
+	}
+
 	// auto-detect Close method for Close stage
 	closeF, found := t.MethodByName("Close")
 	if found {
@@ -468,6 +475,10 @@ func Supply[T any](ball *Ball, t T) {
 			started:  time.Now(),
 			finished: time.Now(),
 		},
+		status: Status{
+			State: StateInitialized,
+			Since: time.Now(),
+		},
 	})
 }
 
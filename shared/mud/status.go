@@ -0,0 +1,87 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mud
+
+import (
+	"time"
+)
+
+// State is the lifecycle state of a component.
+type State int
+
+const (
+	// StatePending means that the component is not yet initialized.
+	StatePending State = iota
+	// StateInitialized means that the instance is created, but the Run stage is not yet started.
+	StateInitialized
+	// StateRunning means that the Run stage is started (and still running, in case of background stages).
+	StateRunning
+	// StateCompleted means that a background Run stage returned without error.
+	StateCompleted
+	// StateDraining means that the Drain stage is called, the component doesn't accept new work.
+	StateDraining
+	// StateStopped means that the Close stage is called.
+	StateStopped
+	// StateFailed means that one of the stages returned with an error.
+	StateFailed
+)
+
+// String implements fmt.Stringer.
+func (s State) String() string {
+	switch s {
+	case StatePending:
+		return "pending"
+	case StateInitialized:
+		return "initialized"
+	case StateRunning:
+		return "running"
+	case StateCompleted:
+		return "completed"
+	case StateDraining:
+		return "draining"
+	case StateStopped:
+		return "stopped"
+	case StateFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// Status is a snapshot of the lifecycle state of a component.
+type Status struct {
+	State State
+	// Err is the error returned by the failed stage.
+	Err error
+	// Since is the time of the last state change.
+	Since time.Time
+}
+
+// Status returns with the current lifecycle state of the component.
+func (c *Component) Status() Status {
+	c.statusMu.Lock()
+	defer c.statusMu.Unlock()
+	return c.status
+}
+
+// HasRunStage returns true if the component has a Run stage.
+func (c *Component) HasRunStage() bool {
+	return c.run != nil && c.run.run != nil
+}
+
+// setStatus updates the lifecycle state. Any error marks the component as failed.
+func (c *Component) setStatus(state State, err error) {
+	if err != nil {
+		state = StateFailed
+	}
+
+	c.statusMu.Lock()
+	defer c.statusMu.Unlock()
+
+	c.status = Status{
+		State: state,
+		Err:   err,
+		Since: time.Now(),
+	}
+}
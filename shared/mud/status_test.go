@@ -0,0 +1,80 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package mud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/errs2"
+	"storj.io/common/testcontext"
+)
+
+type blockingService struct {
+	failWith error
+}
+
+func (s *blockingService) Run(ctx context.Context) error {
+	if s.failWith != nil {
+		return s.failWith
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (s *blockingService) Close() error { return nil }
+
+func TestStatus(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	ball := NewBall()
+	Supply[DB](ball, DB{})
+	Provide[*blockingService](ball, func() *blockingService {
+		return &blockingService{}
+	})
+
+	require.Equal(t, StateInitialized, MustLookupComponent[DB](ball).Status().State)
+
+	component := MustLookupComponent[*blockingService](ball)
+	require.Equal(t, StatePending, component.Status().State)
+	require.True(t, component.HasRunStage())
+
+	require.NoError(t, component.Init(ctx))
+	require.Equal(t, StateInitialized, component.Status().State)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	var eg errgroup.Group
+	require.NoError(t, component.Run(runCtx, &eg))
+	require.Equal(t, StateRunning, component.Status().State)
+
+	cancel()
+	require.True(t, errs2.IsCanceled(eg.Wait()))
+	require.Equal(t, StateCompleted, component.Status().State)
+
+	require.NoError(t, component.Close(ctx))
+	require.Equal(t, StateStopped, component.Status().State)
+}
+
+func TestStatusFailed(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	ball := NewBall()
+	Provide[*blockingService](ball, func() *blockingService {
+		return &blockingService{failWith: context.DeadlineExceeded}
+	})
+
+	component := MustLookupComponent[*blockingService](ball)
+	require.NoError(t, component.Init(ctx))
+
+	var eg errgroup.Group
+	require.NoError(t, component.Run(ctx, &eg))
+	require.ErrorIs(t, eg.Wait(), context.DeadlineExceeded)
+
+	status := component.Status()
+	require.Equal(t, StateFailed, status.State)
+	require.ErrorIs(t, status.Err, context.DeadlineExceeded)
+}
@@ -7,12 +7,14 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/zeebo/errs"
 
 	"storj.io/common/context2"
 	"storj.io/common/leak"
 	"storj.io/common/traces"
+	"storj.io/storj/shared/flightrecorder"
 )
 
 var (
@@ -44,6 +46,7 @@ type sqlConn struct {
 	useTxContext bool
 	tracker      leak.Ref
 	monReleased  bool
+	box          *flightrecorder.Box
 }
 
 func (s *sqlConn) BeginTx(ctx context.Context, txOptions *sql.TxOptions) (Tx, error) {
@@ -63,6 +66,7 @@ func (s *sqlConn) BeginTx(ctx context.Context, txOptions *sql.TxOptions) (Tx, er
 		tx:         tx,
 		useContext: s.useContext && s.useTxContext,
 		tracker:    s.tracker.Child("sqlTx", 1),
+		box:        s.box,
 	}, nil
 }
 
@@ -75,6 +79,7 @@ func (s *sqlConn) Close() error {
 }
 
 func (s *sqlConn) ExecContext(ctx context.Context, query string, args ...interface{}) (_ sql.Result, err error) {
+	defer recordQuery(s.box, query, time.Now())
 	traces.Tag(ctx, traces.TagDB)
 	defer mon.Task()(&ctx, query, args)(&err)
 
@@ -104,13 +109,16 @@ func (s *sqlConn) PrepareContext(ctx context.Context, query string) (_ Stmt, err
 		return nil, err
 	}
 	return &sqlStmt{
+		query:      query,
 		stmt:       stmt,
 		useContext: s.useContext,
 		tracker:    s.tracker.Child("sqlStmt", 1),
+		box:        s.box,
 	}, nil
 }
 
 func (s *sqlConn) QueryContext(ctx context.Context, query string, args ...interface{}) (_ Rows, err error) {
+	defer recordQuery(s.box, query, time.Now())
 	traces.Tag(ctx, traces.TagDB)
 	defer mon.Task()(&ctx, query, args)(&err)
 
@@ -121,6 +129,7 @@ func (s *sqlConn) QueryContext(ctx context.Context, query string, args ...interf
 }
 
 func (s *sqlConn) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	defer recordQuery(s.box, query, time.Now())
 	traces.Tag(ctx, traces.TagDB)
 	defer mon.Task()(&ctx, query, args)(nil)
 
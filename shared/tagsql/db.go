@@ -162,6 +162,8 @@ func (s *sqlDB) Name() string {
 	// only used by golang benchmark
 	case strings.Contains(driverType, "stdlib.Driver"):
 		return PostgresName
+	case strings.Contains(driverType, "sqliteutil.PostgresDialectDriver"):
+		return SqliteName
 	// only used under test; treat as sqlite
 	case strings.Contains(driverType, "utccheck.Driver"):
 		return SqliteName
@@ -225,11 +227,13 @@ func (s *sqlDB) Conn(ctx context.Context) (Conn, error) {
 		useContext:   s.useContext,
 		useTxContext: s.useTxContext,
 		tracker:      s.tracker.Child("sqlConn", 1),
+		box:          s.box,
 	}, nil
 }
 
 func (s *sqlDB) ExecContext(ctx context.Context, query string, args ...interface{}) (_ sql.Result, err error) {
 	s.record()
+	defer recordQuery(s.box, query, time.Now())
 	traces.Tag(ctx, traces.TagDB)
 	defer mon.Task()(&ctx, query, args)(&err)
 
@@ -274,6 +278,7 @@ func (s *sqlDB) PrepareContext(ctx context.Context, query string) (_ Stmt, err e
 
 func (s *sqlDB) QueryContext(ctx context.Context, query string, args ...interface{}) (_ Rows, err error) {
 	s.record()
+	defer recordQuery(s.box, query, time.Now())
 	traces.Tag(ctx, traces.TagDB)
 	defer mon.Task()(&ctx, query, args)(&err)
 
@@ -285,6 +290,7 @@ func (s *sqlDB) QueryContext(ctx context.Context, query string, args ...interfac
 
 func (s *sqlDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
 	s.record()
+	defer recordQuery(s.box, query, time.Now())
 	traces.Tag(ctx, traces.TagDB)
 	defer mon.Task()(&ctx, query, args)(nil)
 
@@ -310,6 +316,14 @@ func (s *sqlDB) Stats() sql.DBStats {
 	return s.db.Stats()
 }
 
+// recordQuery records the query in the flight recorder, if it was slow.
+func recordQuery(box *flightrecorder.Box, query string, start time.Time) {
+	if box == nil {
+		return
+	}
+	box.RecordQuery(query, time.Since(start))
+}
+
 func (s *sqlDB) record() {
 	if s.box == nil {
 		return
@@ -59,7 +59,9 @@ func DetectContextSupport(db *sql.DB) (ContextSupport, error) {
 	switch {
 	case typ.PkgPath() == "github.com/mattn/go-sqlite3" && typ.Name() == "SQLiteDriver" ||
 		// wrapper for sqlite
-		typ.PkgPath() == "storj.io/storj/shared/dbutil/utccheck" && typ.Name() == "Driver":
+		typ.PkgPath() == "storj.io/storj/shared/dbutil/utccheck" && typ.Name() == "Driver" ||
+		// sqlite with postgres dialect translation
+		typ.PkgPath() == "storj.io/storj/shared/dbutil/sqliteutil" && typ.Name() == "PostgresDialectDriver":
 		return SupportBasic, nil
 	case typ.PkgPath() == "github.com/lib/pq" && typ.Name() == "Driver":
 		return SupportNone, nil
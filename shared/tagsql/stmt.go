@@ -6,6 +6,7 @@ package tagsql
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/zeebo/errs"
 
@@ -43,6 +44,7 @@ func (s *sqlStmt) Close() error {
 
 func (s *sqlStmt) ExecContext(ctx context.Context, args ...interface{}) (_ sql.Result, err error) {
 	s.record()
+	defer recordQuery(s.box, s.query, time.Now())
 	traces.Tag(ctx, traces.TagDB)
 	defer mon.Task()(&ctx, s.query, args)(&err)
 
@@ -54,6 +56,7 @@ func (s *sqlStmt) ExecContext(ctx context.Context, args ...interface{}) (_ sql.R
 
 func (s *sqlStmt) QueryContext(ctx context.Context, args ...interface{}) (_ Rows, err error) {
 	s.record()
+	defer recordQuery(s.box, s.query, time.Now())
 	traces.Tag(ctx, traces.TagDB)
 	defer mon.Task()(&ctx, s.query, args)(&err)
 
@@ -65,6 +68,7 @@ func (s *sqlStmt) QueryContext(ctx context.Context, args ...interface{}) (_ Rows
 
 func (s *sqlStmt) QueryRowContext(ctx context.Context, args ...interface{}) *sql.Row {
 	s.record()
+	defer recordQuery(s.box, s.query, time.Now())
 	traces.Tag(ctx, traces.TagDB)
 	defer mon.Task()(&ctx, s.query, args)(nil)
 
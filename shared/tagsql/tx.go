@@ -6,6 +6,7 @@ package tagsql
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/zeebo/errs"
 
@@ -46,6 +47,7 @@ type sqlTx struct {
 
 func (s *sqlTx) ExecContext(ctx context.Context, query string, args ...interface{}) (_ sql.Result, err error) {
 	s.record()
+	defer recordQuery(s.box, query, time.Now())
 	traces.Tag(ctx, traces.TagDB)
 	defer mon.Task()(&ctx, query, args)(&err)
 
@@ -82,6 +84,7 @@ func (s *sqlTx) PrepareContext(ctx context.Context, query string) (_ Stmt, err e
 
 func (s *sqlTx) QueryContext(ctx context.Context, query string, args ...interface{}) (_ Rows, err error) {
 	s.record()
+	defer recordQuery(s.box, query, time.Now())
 	traces.Tag(ctx, traces.TagDB)
 	defer mon.Task()(&ctx, query, args)(&err)
 
@@ -93,6 +96,7 @@ func (s *sqlTx) QueryContext(ctx context.Context, query string, args ...interfac
 
 func (s *sqlTx) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
 	s.record()
+	defer recordQuery(s.box, query, time.Now())
 	traces.Tag(ctx, traces.TagDB)
 	defer mon.Task()(&ctx, query, args)(nil)
 
@@ -25,6 +25,9 @@ type DB interface {
 	// Check checks if api key exists in db by secret.
 	Check(ctx context.Context, secret multinodeauth.Secret) error
 
+	// Get returns the api key by secret, without the creation time.
+	Get(ctx context.Context, secret multinodeauth.Secret) (APIKey, error)
+
 	// Revoke removes api key from db.
 	Revoke(ctx context.Context, secret multinodeauth.Secret) error
 }
@@ -33,6 +36,8 @@ type DB interface {
 type APIKey struct {
 	// APIKeys is PK of the table and keeps unique value sno api key.
 	Secret multinodeauth.Secret
+	// Scopes are the management actions allowed with the api key.
+	Scopes Scope
 
 	CreatedAt time.Time `json:"createdAt"`
 }
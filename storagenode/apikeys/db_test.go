@@ -27,6 +27,7 @@ func TestAPIKeysDB(t *testing.T) {
 		t.Run("Store", func(t *testing.T) {
 			err := apiKeys.Store(ctx, apikeys.APIKey{
 				Secret:    secret,
+				Scopes:    apikeys.ScopeGracefulExit | apikeys.ScopeFilewalker,
 				CreatedAt: time.Now().UTC(),
 			})
 			assert.NoError(t, err)
@@ -40,6 +41,16 @@ func TestAPIKeysDB(t *testing.T) {
 			assert.Error(t, err)
 		})
 
+		t.Run("Get", func(t *testing.T) {
+			apiKey, err := apiKeys.Get(ctx, secret)
+			assert.NoError(t, err)
+			assert.Equal(t, secret, apiKey.Secret)
+			assert.Equal(t, apikeys.ScopeGracefulExit|apikeys.ScopeFilewalker, apiKey.Scopes)
+
+			_, err = apiKeys.Get(ctx, secret2)
+			assert.True(t, apikeys.ErrNoAPIKey.Has(err))
+		})
+
 		t.Run("Revoke", func(t *testing.T) {
 			err = apiKeys.Revoke(ctx, secret)
 			assert.NoError(t, err)
@@ -0,0 +1,92 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package apikeys
+
+import (
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrScope represents an invalid api key scope error.
+var ErrScope = errs.Class("api key scope")
+
+// Scope is a set of management actions an api key is allowed to perform.
+// Every api key can read the node information, the zero scope doesn't allow
+// changing the node in any way.
+type Scope int64
+
+// ScopeNone allows only reading the node information.
+const ScopeNone Scope = 0
+
+const (
+	// ScopeAllocatedDiskSpace allows changing the allocated disk space.
+	ScopeAllocatedDiskSpace Scope = 1 << iota
+	// ScopeGracefulExit allows initiating and cancelling graceful exit.
+	ScopeGracefulExit
+	// ScopeForgetSatellite allows forgetting a satellite.
+	ScopeForgetSatellite
+	// ScopeFilewalker allows triggering the used-space filewalker.
+	ScopeFilewalker
+	// ScopeRotateAPIKey allows replacing the api key with a new one.
+	ScopeRotateAPIKey
+
+	// ScopeAll allows all management actions.
+	ScopeAll = ScopeAllocatedDiskSpace | ScopeGracefulExit | ScopeForgetSatellite | ScopeFilewalker | ScopeRotateAPIKey
+)
+
+// scopeNames contains the names of the scopes used in configuration.
+var scopeNames = []struct {
+	scope Scope
+	name  string
+}{
+	{ScopeAllocatedDiskSpace, "allocated-disk-space"},
+	{ScopeGracefulExit, "graceful-exit"},
+	{ScopeForgetSatellite, "forget-satellite"},
+	{ScopeFilewalker, "filewalker"},
+	{ScopeRotateAPIKey, "rotate-api-key"},
+}
+
+// Has returns true when the scope contains all actions of other.
+func (scope Scope) Has(other Scope) bool {
+	return scope&other == other
+}
+
+// String returns the comma separated names of the scope actions.
+func (scope Scope) String() string {
+	var names []string
+	for _, named := range scopeNames {
+		if scope.Has(named.scope) {
+			names = append(names, named.name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// ParseScope parses comma separated scope names, "all" allows all actions.
+func ParseScope(value string) (scope Scope, err error) {
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if name == "all" {
+			scope |= ScopeAll
+			continue
+		}
+
+		found := false
+		for _, named := range scopeNames {
+			if named.name == name {
+				scope |= named.scope
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, ErrScope.New("unknown scope %q", name)
+		}
+	}
+	return scope, nil
+}
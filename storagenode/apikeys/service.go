@@ -16,6 +16,8 @@ import (
 var (
 	// ErrService defines secret service error.
 	ErrService = errs.Class("secret service")
+	// ErrUnauthorized is returned when the api key doesn't have the required scope.
+	ErrUnauthorized = errs.Class("api key unauthorized")
 
 	mon = monkit.Package()
 )
@@ -32,8 +34,8 @@ func NewService(db DB) *Service {
 	return &Service{store: db}
 }
 
-// Issue generates new api key and stores it into db.
-func (service *Service) Issue(ctx context.Context) (apiKey APIKey, err error) {
+// Issue generates new api key with the provided scopes and stores it into db.
+func (service *Service) Issue(ctx context.Context, scopes Scope) (apiKey APIKey, err error) {
 	defer mon.Task()(&ctx)(&err)
 	secret, err := multinodeauth.NewSecret()
 	if err != nil {
@@ -41,6 +43,7 @@ func (service *Service) Issue(ctx context.Context) (apiKey APIKey, err error) {
 	}
 
 	apiKey.Secret = secret
+	apiKey.Scopes = scopes
 	apiKey.CreatedAt = time.Now().UTC()
 
 	err = service.store.Store(ctx, apiKey)
@@ -64,3 +67,39 @@ func (service *Service) Remove(ctx context.Context, secret multinodeauth.Secret)
 
 	return ErrService.Wrap(service.store.Revoke(ctx, secret))
 }
+
+// Authorize returns error if api key does not exist or doesn't have the required scope.
+func (service *Service) Authorize(ctx context.Context, secret multinodeauth.Secret, scope Scope) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	apiKey, err := service.store.Get(ctx, secret)
+	if err != nil {
+		return err
+	}
+	if !apiKey.Scopes.Has(scope) {
+		return ErrUnauthorized.New("scope %q is required", scope)
+	}
+
+	return nil
+}
+
+// Rotate issues a new api key with the same scopes and revokes the old one.
+func (service *Service) Rotate(ctx context.Context, secret multinodeauth.Secret) (_ APIKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	old, err := service.store.Get(ctx, secret)
+	if err != nil {
+		return APIKey{}, ErrService.Wrap(err)
+	}
+
+	apiKey, err := service.Issue(ctx, old.Scopes)
+	if err != nil {
+		return APIKey{}, err
+	}
+
+	if err := service.store.Revoke(ctx, old.Secret); err != nil {
+		return APIKey{}, ErrService.Wrap(errs.Combine(err, service.store.Revoke(ctx, apiKey.Secret)))
+	}
+
+	return apiKey, nil
+}
@@ -0,0 +1,331 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package delegatedrepair implements the delegated repair endpoint, which lets
+// a trusted satellite use the node to repair a segment: the node downloads the
+// pieces, reconstructs the segment and uploads the new pieces.
+package delegatedrepair
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/semaphore"
+
+	"storj.io/common/errs2"
+	"storj.io/common/identity"
+	"storj.io/common/pb"
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/storj/private/delegatedrepairpb"
+	"storj.io/storj/shared/sync/kofn"
+	"storj.io/storj/storagenode/trust"
+	"storj.io/uplink/private/eestream"
+	"storj.io/uplink/private/piecestore"
+)
+
+var (
+	// Error is the default error class for delegated repair.
+	Error = errs.Class("delegated repair")
+
+	// ErrPieceHashVerifyFailed is the error class of the downloaded pieces with invalid hash.
+	ErrPieceHashVerifyFailed = errs.Class("piece hashes don't match")
+
+	mon = monkit.Package()
+)
+
+// Config contains the configuration of delegated repair.
+type Config struct {
+	Enabled         bool          `help:"repair segments on behalf of the trusted satellites, when they ask for it" default:"false"`
+	MaxConcurrent   int           `help:"maximum number of delegated repairs running at the same time" default:"2"`
+	DialTimeout     time.Duration `help:"time limit for dialing a storage node" default:"5s"`
+	DownloadTimeout time.Duration `help:"time limit for downloading a piece from a storage node" default:"5m"`
+	UploadTimeout   time.Duration `help:"time limit for uploading the new pieces, after which the remaining uploads are canceled" default:"5m"`
+}
+
+// Endpoint implements the delegated repair endpoint.
+//
+// architecture: Endpoint
+type Endpoint struct {
+	delegatedrepairpb.DRPCDelegatedRepairUnimplementedServer
+
+	log     *zap.Logger
+	trust   trust.TrustedSatelliteSource
+	dialer  rpc.Dialer
+	config  Config
+	limiter *semaphore.Weighted
+}
+
+// NewEndpoint creates a new delegated repair endpoint.
+func NewEndpoint(log *zap.Logger, trust trust.TrustedSatelliteSource, dialer rpc.Dialer, config Config) *Endpoint {
+	return &Endpoint{
+		log:     log,
+		trust:   trust,
+		dialer:  dialer,
+		config:  config,
+		limiter: semaphore.NewWeighted(int64(config.MaxConcurrent)),
+	}
+}
+
+// Repair downloads the pieces of a segment, reconstructs it, and uploads the
+// new pieces to the nodes of the PUT order limits.
+func (endpoint *Endpoint) Repair(ctx context.Context, req *delegatedrepairpb.RepairRequest) (_ *delegatedrepairpb.RepairResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+	if err := endpoint.trust.VerifySatelliteID(ctx, peer.ID); err != nil {
+		return nil, rpcstatus.Error(rpcstatus.PermissionDenied, err.Error())
+	}
+	signee, err := endpoint.trust.GetSignee(ctx, peer.ID)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	if !endpoint.limiter.TryAcquire(1) {
+		mon.Meter("delegated_repair_rejected").Mark(1)
+		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, "too many delegated repairs")
+	}
+	defer endpoint.limiter.Release(1)
+
+	getKey, err := storj.PiecePrivateKeyFromBytes(req.PrivateKeyForGet)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+	putKey, err := storj.PiecePrivateKeyFromBytes(req.PrivateKeyForPut)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+	redundancy, err := eestream.NewRedundancyStrategyFromProto(req.Redundancy)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+	newRedundancy, err := eestream.NewRedundancyStrategyFromProto(req.NewRedundancy)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+	if len(req.GetOrders) != redundancy.TotalCount() || len(req.PutOrders) != newRedundancy.TotalCount() {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "number of order limits doesn't match the redundancy")
+	}
+
+	log := endpoint.log.With(zap.Stringer("satellite", peer.ID))
+
+	segment, failed, err := endpoint.download(ctx, log, signee, req.GetOrders, getKey, redundancy, req.SegmentSize)
+	if err != nil {
+		log.Debug("delegated repair download failed", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
+	}
+
+	uploaded, err := endpoint.upload(ctx, log, req.PutOrders, putKey, newRedundancy, segment, int(req.SuccessfulNeeded))
+	if err != nil {
+		log.Debug("delegated repair upload failed", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Unavailable, err.Error())
+	}
+
+	mon.Meter("delegated_repair_success").Mark(1)
+	return &delegatedrepairpb.RepairResponse{
+		UploadedPieces:  uploaded,
+		FailedPieceNums: failed,
+	}, nil
+}
+
+// download downloads the required number of pieces and reconstructs the
+// segment. It also returns the piece numbers which failed hash verification.
+func (endpoint *Endpoint) download(ctx context.Context, log *zap.Logger, signee signing.Signee, limits []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, redundancy eestream.RedundancyStrategy, segmentSize int64) (_ []byte, failed []int32, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pieceSize := eestream.CalcPieceSize(segmentSize, redundancy)
+
+	successes, failures := kofn.Collect(
+		ctx,
+		kofn.Config{
+			Concurrency:       redundancy.RequiredCount(),
+			RequiredSuccesses: redundancy.RequiredCount(),
+		},
+		limits,
+		func(limit *pb.AddressedOrderLimit) bool { return limit == nil },
+		func(ctx context.Context, index int, limit *pb.AddressedOrderLimit) ([]byte, error) {
+			return endpoint.downloadPiece(ctx, signee, limit, privateKey, pieceSize)
+		},
+	)
+
+	for _, result := range failures {
+		if ErrPieceHashVerifyFailed.Has(result.Error) {
+			failed = append(failed, int32(result.Index))
+		}
+		if !errors.Is(result.Error, context.Canceled) {
+			log.Debug("failed to download piece for delegated repair",
+				zap.Stringer("node_id", limits[result.Index].GetLimit().StorageNodeId),
+				zap.Error(result.Error))
+		}
+	}
+
+	if len(successes) < redundancy.RequiredCount() {
+		return nil, failed, Error.New("not enough pieces downloaded, want %d, got %d", redundancy.RequiredCount(), len(successes))
+	}
+
+	pieces := make(map[int]io.ReadCloser, len(successes))
+	for _, result := range successes {
+		pieces[result.Index] = io.NopCloser(bytes.NewReader(result.Value))
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	decoder := eestream.DecodeReaders2(ctx, cancel, pieces, redundancy, pieceSize*int64(redundancy.RequiredCount()), 0, false)
+	segment, err := io.ReadAll(io.LimitReader(decoder, segmentSize))
+	err = errs.Combine(err, decoder.Close())
+	if err != nil {
+		return nil, failed, Error.New("could not reconstruct segment: %w", err)
+	}
+	return segment, failed, nil
+}
+
+// downloadPiece downloads a piece and verifies its hash.
+func (endpoint *Endpoint) downloadPiece(ctx context.Context, signee signing.Signee, limit *pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, pieceSize int64) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dialCtx, dialCancel := context.WithTimeout(ctx, endpoint.config.DialTimeout)
+	defer dialCancel()
+
+	client, err := piecestore.Dial(dialCtx, endpoint.dialer, storj.NodeURL{
+		ID:      limit.GetLimit().StorageNodeId,
+		Address: limit.GetStorageNodeAddress().GetAddress(),
+	}, piecestore.DefaultConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, client.Close()) }()
+
+	downloadCtx, downloadCancel := context.WithTimeout(ctx, endpoint.config.DownloadTimeout)
+	defer downloadCancel()
+
+	downloader, err := client.Download(downloadCtx, limit.GetLimit(), privateKey, 0, pieceSize)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, downloader.Close()) }()
+
+	data := make([]byte, pieceSize)
+	if _, err := io.ReadFull(downloader, data); err != nil {
+		return nil, err
+	}
+
+	hash, originalLimit := downloader.GetHashAndLimit()
+	if hash == nil || originalLimit == nil {
+		return nil, Error.New("hash or original order limit was not sent from storagenode")
+	}
+	if err := signing.VerifyOrderLimitSignature(ctx, signee, originalLimit); err != nil {
+		return nil, Error.New("invalid order limit signature: %w", err)
+	}
+	if originalLimit.PieceId != hash.PieceId {
+		return nil, ErrPieceHashVerifyFailed.New("piece id changed")
+	}
+
+	hasher := pb.NewHashFromAlgorithm(hash.HashAlgorithm)
+	_, _ = hasher.Write(data)
+	if !bytes.Equal(hasher.Sum(nil), hash.Hash) {
+		return nil, ErrPieceHashVerifyFailed.New("hash from storage node doesn't match calculated hash")
+	}
+	if err := signing.VerifyUplinkPieceHashSignature(ctx, originalLimit.UplinkPublicKey, hash); err != nil {
+		return nil, ErrPieceHashVerifyFailed.New("invalid piece hash signature")
+	}
+
+	mon.Meter("delegated_repair_bytes_downloaded").Mark64(pieceSize)
+	return data, nil
+}
+
+// upload encodes the segment and uploads the pieces, until successfulNeeded
+// uploads are finished or the upload timeout is reached.
+func (endpoint *Endpoint) upload(ctx context.Context, log *zap.Logger, limits []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, redundancy eestream.RedundancyStrategy, segment []byte, successfulNeeded int) (_ []*pb.SegmentPieceUploadResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	readers, err := eestream.EncodeReader2(ctx, bytes.NewReader(segment), redundancy)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	type result struct {
+		index int
+		hash  *pb.PieceHash
+		err   error
+	}
+	results := make(chan result, len(limits))
+
+	uploadCtx, cancel := context.WithTimeout(ctx, endpoint.config.UploadTimeout)
+	defer cancel()
+
+	for i, limit := range limits {
+		go func(i int, limit *pb.AddressedOrderLimit) {
+			hash, err := endpoint.uploadPiece(uploadCtx, limit, privateKey, readers[i])
+			results <- result{index: i, hash: hash, err: err}
+		}(i, limit)
+	}
+
+	var uploaded []*pb.SegmentPieceUploadResult
+	for range limits {
+		result := <-results
+		limit := limits[result.index]
+		if limit == nil {
+			continue
+		}
+		if result.err != nil {
+			if !errs2.IsCanceled(result.err) {
+				log.Debug("failed to upload piece for delegated repair",
+					zap.Stringer("node_id", limit.GetLimit().StorageNodeId),
+					zap.Error(result.err))
+			}
+			continue
+		}
+
+		uploaded = append(uploaded, &pb.SegmentPieceUploadResult{
+			PieceNum: int32(result.index),
+			NodeId:   limit.GetLimit().StorageNodeId,
+			Hash:     result.hash,
+		})
+		if successfulNeeded > 0 && len(uploaded) >= successfulNeeded {
+			// cancel the long tail
+			cancel()
+		}
+	}
+
+	if len(uploaded) == 0 {
+		return nil, Error.New("upload to all nodes failed")
+	}
+	return uploaded, nil
+}
+
+// uploadPiece uploads a single piece.
+func (endpoint *Endpoint) uploadPiece(ctx context.Context, limit *pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, data io.ReadCloser) (_ *pb.PieceHash, err error) {
+	defer mon.Task()(&ctx)(&err)
+	defer func() { err = errs.Combine(err, data.Close()) }()
+
+	if limit == nil {
+		_, _ = io.Copy(io.Discard, data)
+		return nil, nil
+	}
+
+	dialCtx, dialCancel := context.WithTimeout(ctx, endpoint.config.DialTimeout)
+	defer dialCancel()
+
+	client, err := piecestore.Dial(dialCtx, endpoint.dialer, storj.NodeURL{
+		ID:      limit.GetLimit().StorageNodeId,
+		Address: limit.GetStorageNodeAddress().GetAddress(),
+	}, piecestore.DefaultConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, client.Close()) }()
+
+	return client.UploadReader(ctx, limit.GetLimit(), privateKey, data)
+}
@@ -144,3 +144,26 @@ func (c *Service) ExitNotPossible(ctx context.Context, satelliteID storj.NodeID)
 
 	return c.satelliteDB.CancelGracefulExit(ctx, satelliteID)
 }
+
+// CancelExit removes the pending graceful exit from the satellite, so the node
+// stops exiting it. The satellite keeps track of exits it has already
+// registered, so it should be used before the node contacted the satellite.
+func (c *Service) CancelExit(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exits, err := c.satelliteDB.ListGracefulExits(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	for _, exit := range exits {
+		if exit.SatelliteID != satelliteID {
+			continue
+		}
+		if exit.FinishedAt != nil {
+			return Error.New("graceful exit from satellite %s has already finished", satelliteID)
+		}
+		return Error.Wrap(c.satelliteDB.CancelGracefulExit(ctx, satelliteID))
+	}
+
+	return Error.New("graceful exit from satellite %s not found", satelliteID)
+}
@@ -241,6 +241,24 @@ func (service *Service) DiskSpace(ctx context.Context) (_ DiskSpace, err error)
 	return service.spaceReport.DiskSpace(ctx)
 }
 
+// SetAllocatedDiskSpace changes the allocated disk space and updates the
+// capacity reported to the satellites. The change isn't persisted, the
+// configured value is used again after restart.
+func (service *Service) SetAllocatedDiskSpace(ctx context.Context, allocated int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	setter, ok := service.spaceReport.(AllocatedSpaceSetter)
+	if !ok {
+		return Error.New("allocated disk space can't be changed for %T", service.spaceReport)
+	}
+
+	if err := setter.SetAllocatedDiskSpace(ctx, allocated); err != nil {
+		return err
+	}
+
+	return service.updateNodeInformation(ctx)
+}
+
 // isLowerThanAllocated checks if the disk space is lower than allocated.
 func isLowerThanAllocated(actual, allocated int64) bool {
 	return actual > 0 && actual < allocated
@@ -5,6 +5,7 @@ package monitor
 
 import (
 	"context"
+	"sync/atomic"
 
 	"go.uber.org/zap"
 )
@@ -47,22 +48,24 @@ type PieceStoreSpaceUsage interface {
 type SharedDisk struct {
 	store              PieceStoreSpaceUsage
 	hashStore          HashStoreBackend
-	allocatedDiskSpace int64
+	allocatedDiskSpace atomic.Int64
 	log                *zap.Logger
 	minimumDiskSpace   int64
 }
 
 var _ SpaceReport = (*SharedDisk)(nil)
+var _ AllocatedSpaceSetter = (*SharedDisk)(nil)
 
 // NewSharedDisk creates a new SharedDisk.
 func NewSharedDisk(log *zap.Logger, store PieceStoreSpaceUsage, hashStore HashStoreBackend, minimumDiskSpace, allocatedDiskSpace int64) *SharedDisk {
-	return &SharedDisk{
-		log:                log,
-		store:              store,
-		hashStore:          hashStore,
-		allocatedDiskSpace: allocatedDiskSpace,
-		minimumDiskSpace:   minimumDiskSpace,
+	disk := &SharedDisk{
+		log:              log,
+		store:            store,
+		hashStore:        hashStore,
+		minimumDiskSpace: minimumDiskSpace,
 	}
+	disk.allocatedDiskSpace.Store(allocatedDiskSpace)
+	return disk
 }
 
 // PreFlightCheck checks if the disk is ready to use.
@@ -84,35 +87,61 @@ func (s *SharedDisk) PreFlightCheck(ctx context.Context) error {
 		return Error.Wrap(err)
 	}
 
+	allocatedDiskSpace := s.allocatedDiskSpace.Load()
+
 	// check your hard drive is big enough
 	// first time setup as a piece node server
-	if totalUsed == 0 && freeDiskSpace < s.allocatedDiskSpace {
-		s.allocatedDiskSpace = freeDiskSpace
-		s.log.Warn("Disk space is less than requested. Allocated space is", zap.Int64("bytes", s.allocatedDiskSpace))
+	if totalUsed == 0 && freeDiskSpace < allocatedDiskSpace {
+		allocatedDiskSpace = freeDiskSpace
+		s.log.Warn("Disk space is less than requested. Allocated space is", zap.Int64("bytes", allocatedDiskSpace))
 	}
 
 	// on restarting the Piece node server, assuming already been working as a node
 	// used above the allocated space, user changed the allocation space setting
 	// before restarting
-	if totalUsed >= s.allocatedDiskSpace {
-		s.log.Warn("Used more space than allocated. Allocated space is", zap.Int64("bytes", s.allocatedDiskSpace))
+	if totalUsed >= allocatedDiskSpace {
+		s.log.Warn("Used more space than allocated. Allocated space is", zap.Int64("bytes", allocatedDiskSpace))
 	}
 
 	// the available disk space is less than remaining allocated space,
 	// due to change of setting before restarting
-	if freeDiskSpace < s.allocatedDiskSpace-totalUsed {
-		s.allocatedDiskSpace = freeDiskSpace + totalUsed
-		s.log.Warn("Disk space is less than requested. Allocated space is", zap.Int64("bytes", s.allocatedDiskSpace))
+	if freeDiskSpace < allocatedDiskSpace-totalUsed {
+		allocatedDiskSpace = freeDiskSpace + totalUsed
+		s.log.Warn("Disk space is less than requested. Allocated space is", zap.Int64("bytes", allocatedDiskSpace))
 	}
 
+	s.allocatedDiskSpace.Store(allocatedDiskSpace)
+
 	// Ensure the disk is at least 500GB in size, which is our current minimum required to be an operator
-	if s.allocatedDiskSpace < s.minimumDiskSpace {
+	if allocatedDiskSpace < s.minimumDiskSpace {
 		s.log.Error("Total disk space is less than required minimum", zap.Int64("bytes", s.minimumDiskSpace))
 		return Error.New("disk space requirement not met")
 	}
 	return nil
 }
 
+// SetAllocatedDiskSpace changes the allocated disk space.
+func (s *SharedDisk) SetAllocatedDiskSpace(ctx context.Context, allocated int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if allocated < s.minimumDiskSpace {
+		return Error.New("allocated disk space %d is less than required minimum %d", allocated, s.minimumDiskSpace)
+	}
+
+	if s.store != nil {
+		storageStatus, err := s.store.StorageStatus(ctx)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if isLowerThanAllocated(storageStatus.DiskTotal, allocated) {
+			return Error.New("allocated disk space %d is more than the total disk space %d", allocated, storageStatus.DiskTotal)
+		}
+	}
+
+	s.allocatedDiskSpace.Store(allocated)
+	return nil
+}
+
 // DiskSpace returns consolidated disk space state info.
 func (s *SharedDisk) DiskSpace(ctx context.Context) (_ DiskSpace, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -139,7 +168,7 @@ func (s *SharedDisk) DiskSpace(ctx context.Context) (_ DiskSpace, err error) {
 
 	overused := int64(0)
 
-	allocated := s.allocatedDiskSpace
+	allocated := s.allocatedDiskSpace.Load()
 	if isLowerThanAllocated(storageStatus.DiskTotal, allocated) {
 		allocated = storageStatus.DiskTotal
 	}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
 
 	"storj.io/common/memory"
 	"storj.io/storj/storagenode/hashstore"
@@ -62,3 +63,46 @@ func TestSpaceUsage_ReservedField(t *testing.T) {
 	assert.Equal(t, int64(dbStats.FreeRequired), hashSpaceUsage.Reserved)
 	require.Greater(t, hashSpaceUsage.Reserved, int64(0), "Reserved space should be positive when there's data")
 }
+
+type testSpaceUsage struct {
+	status StorageStatus
+}
+
+func (usage *testSpaceUsage) StorageStatus(ctx context.Context) (StorageStatus, error) {
+	return usage.status, nil
+}
+
+func (usage *testSpaceUsage) SpaceUsedForPieces(ctx context.Context) (int64, int64, error) {
+	return 0, 0, nil
+}
+
+func (usage *testSpaceUsage) SpaceUsedForTrash(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
+func (usage *testSpaceUsage) SpaceUsedForPiecesAndTrash(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
+type testHashStore struct{}
+
+func (testHashStore) SpaceUsage() SpaceUsage { return SpaceUsage{} }
+
+func TestSharedDisk_SetAllocatedDiskSpace(t *testing.T) {
+	ctx := context.Background()
+
+	store := &testSpaceUsage{status: StorageStatus{
+		DiskTotal: memory.TB.Int64(),
+		DiskFree:  memory.TB.Int64(),
+	}}
+	disk := NewSharedDisk(zaptest.NewLogger(t), store, testHashStore{}, memory.GB.Int64(), 2*memory.GB.Int64())
+
+	require.Error(t, disk.SetAllocatedDiskSpace(ctx, memory.MB.Int64()))
+	require.Error(t, disk.SetAllocatedDiskSpace(ctx, 2*memory.TB.Int64()))
+
+	require.NoError(t, disk.SetAllocatedDiskSpace(ctx, 100*memory.GB.Int64()))
+	diskSpace, err := disk.DiskSpace(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 100*memory.GB.Int64(), diskSpace.Allocated)
+	require.Equal(t, 100*memory.GB.Int64(), diskSpace.Available)
+}
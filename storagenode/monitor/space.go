@@ -15,3 +15,10 @@ type SpaceReport interface {
 	// Used by reporting only.
 	DiskSpace(ctx context.Context) (_ DiskSpace, err error)
 }
+
+// AllocatedSpaceSetter is implemented by space reports, which can change the
+// allocated disk space while the node is running.
+type AllocatedSpaceSetter interface {
+	// SetAllocatedDiskSpace changes the allocated disk space.
+	SetAllocatedDiskSpace(ctx context.Context, allocated int64) error
+}
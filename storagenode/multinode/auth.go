@@ -6,6 +6,7 @@ package multinode
 import (
 	"context"
 
+	"storj.io/common/rpc/rpcstatus"
 	"storj.io/storj/private/multinodeauth"
 	"storj.io/storj/private/multinodepb"
 	"storj.io/storj/storagenode/apikeys"
@@ -24,3 +25,21 @@ func authenticate(ctx context.Context, apiKeys *apikeys.Service, header *multino
 
 	return nil
 }
+
+// authorize checks if request header contains valid api key with the required scope.
+// The returned error is an rpc status error.
+func authorize(ctx context.Context, apiKeys *apikeys.Service, header *multinodepb.RequestHeader, scope apikeys.Scope) (multinodeauth.Secret, error) {
+	secret, err := multinodeauth.SecretFromBytes(header.GetApiKey())
+	if err != nil {
+		return multinodeauth.Secret{}, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	if err = apiKeys.Authorize(ctx, secret, scope); err != nil {
+		if apikeys.ErrUnauthorized.Has(err) {
+			return multinodeauth.Secret{}, rpcstatus.Wrap(rpcstatus.PermissionDenied, err)
+		}
+		return multinodeauth.Secret{}, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	return secret, nil
+}
@@ -0,0 +1,190 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multinode
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/private/multinodepb"
+	"storj.io/storj/storagenode/apikeys"
+	"storj.io/storj/storagenode/forgetsatellite"
+	"storj.io/storj/storagenode/gracefulexit"
+	"storj.io/storj/storagenode/internalpb"
+	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/pieces"
+)
+
+// ensures that ManagementEndpoint implements multinodepb.DRPCManagementServer.
+var _ multinodepb.DRPCManagementServer = (*ManagementEndpoint)(nil)
+
+// ManagementEndpoint implements multinode management endpoint.
+// Every action requires an api key with the corresponding scope and is logged.
+//
+// architecture: Endpoint
+type ManagementEndpoint struct {
+	multinodepb.DRPCManagementUnimplementedServer
+
+	log                 *zap.Logger
+	apiKeys             *apikeys.Service
+	monitor             *monitor.Service
+	gracefulExit        *gracefulexit.Endpoint
+	gracefulExitService *gracefulexit.Service
+	forgetSatellite     *forgetsatellite.Endpoint
+	cacheService        *pieces.CacheService
+}
+
+// NewManagementEndpoint creates new multinode management endpoint.
+// cacheService is nil, when the node doesn't use the space used cache.
+func NewManagementEndpoint(log *zap.Logger, apiKeys *apikeys.Service, monitor *monitor.Service, gracefulExit *gracefulexit.Endpoint, gracefulExitService *gracefulexit.Service, forgetSatellite *forgetsatellite.Endpoint, cacheService *pieces.CacheService) *ManagementEndpoint {
+	return &ManagementEndpoint{
+		log:                 log,
+		apiKeys:             apiKeys,
+		monitor:             monitor,
+		gracefulExit:        gracefulExit,
+		gracefulExitService: gracefulExitService,
+		forgetSatellite:     forgetSatellite,
+		cacheService:        cacheService,
+	}
+}
+
+// SetAllocatedDiskSpace changes the allocated disk space until the node is restarted.
+func (management *ManagementEndpoint) SetAllocatedDiskSpace(ctx context.Context, req *multinodepb.SetAllocatedDiskSpaceRequest) (_ *multinodepb.SetAllocatedDiskSpaceResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = management.authorize(ctx, "set allocated disk space", req.GetHeader(), apikeys.ScopeAllocatedDiskSpace); err != nil {
+		return nil, err
+	}
+
+	log := management.log.With(zap.String("action", "set allocated disk space"), zap.Int64("allocated", req.Allocated))
+	if err = management.monitor.SetAllocatedDiskSpace(ctx, req.Allocated); err != nil {
+		log.Error("management action failed", zap.Error(err))
+		return nil, rpcstatus.Wrap(rpcstatus.FailedPrecondition, err)
+	}
+	log.Info("allocated disk space changed")
+
+	diskSpace, err := management.monitor.DiskSpace(ctx)
+	if err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	return &multinodepb.SetAllocatedDiskSpaceResponse{
+		Allocated: diskSpace.Allocated,
+	}, nil
+}
+
+// InitiateGracefulExit starts graceful exit from the satellite.
+func (management *ManagementEndpoint) InitiateGracefulExit(ctx context.Context, req *multinodepb.InitiateGracefulExitRequest) (_ *multinodepb.InitiateGracefulExitResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = management.authorize(ctx, "initiate graceful exit", req.GetHeader(), apikeys.ScopeGracefulExit); err != nil {
+		return nil, err
+	}
+
+	log := management.log.With(zap.String("action", "initiate graceful exit"), zap.Stringer("satellite_id", req.SatelliteId))
+	_, err = management.gracefulExit.InitiateGracefulExit(ctx, &internalpb.InitiateGracefulExitRequest{
+		NodeId: req.SatelliteId,
+	})
+	if err != nil {
+		log.Error("management action failed", zap.Error(err))
+		return nil, err
+	}
+	log.Info("graceful exit initiated")
+
+	return &multinodepb.InitiateGracefulExitResponse{}, nil
+}
+
+// CancelGracefulExit cancels the pending graceful exit from the satellite.
+func (management *ManagementEndpoint) CancelGracefulExit(ctx context.Context, req *multinodepb.CancelGracefulExitRequest) (_ *multinodepb.CancelGracefulExitResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = management.authorize(ctx, "cancel graceful exit", req.GetHeader(), apikeys.ScopeGracefulExit); err != nil {
+		return nil, err
+	}
+
+	log := management.log.With(zap.String("action", "cancel graceful exit"), zap.Stringer("satellite_id", req.SatelliteId))
+	if err = management.gracefulExitService.CancelExit(ctx, req.SatelliteId); err != nil {
+		log.Error("management action failed", zap.Error(err))
+		return nil, rpcstatus.Wrap(rpcstatus.FailedPrecondition, err)
+	}
+	log.Info("graceful exit cancelled")
+
+	return &multinodepb.CancelGracefulExitResponse{}, nil
+}
+
+// ForgetSatellite starts removing all data of the satellite from the node.
+func (management *ManagementEndpoint) ForgetSatellite(ctx context.Context, req *multinodepb.ForgetSatelliteRequest) (_ *multinodepb.ForgetSatelliteResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = management.authorize(ctx, "forget satellite", req.GetHeader(), apikeys.ScopeForgetSatellite); err != nil {
+		return nil, err
+	}
+
+	log := management.log.With(zap.String("action", "forget satellite"), zap.Stringer("satellite_id", req.SatelliteId), zap.Bool("force_cleanup", req.ForceCleanup))
+	_, err = management.forgetSatellite.InitForgetSatellite(ctx, &internalpb.InitForgetSatelliteRequest{
+		SatelliteId:  req.SatelliteId,
+		ForceCleanup: req.ForceCleanup,
+	})
+	if err != nil {
+		log.Error("management action failed", zap.Error(err))
+		return nil, err
+	}
+	log.Info("forget satellite initiated")
+
+	return &multinodepb.ForgetSatelliteResponse{}, nil
+}
+
+// TriggerUsedSpaceFilewalker requests the used-space filewalker to recalculate the used space.
+func (management *ManagementEndpoint) TriggerUsedSpaceFilewalker(ctx context.Context, req *multinodepb.TriggerUsedSpaceFilewalkerRequest) (_ *multinodepb.TriggerUsedSpaceFilewalkerResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = management.authorize(ctx, "trigger used-space filewalker", req.GetHeader(), apikeys.ScopeFilewalker); err != nil {
+		return nil, err
+	}
+
+	log := management.log.With(zap.String("action", "trigger used-space filewalker"))
+	if management.cacheService == nil {
+		log.Error("management action failed", zap.String("reason", "used-space filewalker is disabled for dedicated disk"))
+		return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, "used-space filewalker is disabled for dedicated disk")
+	}
+
+	management.cacheService.TriggerScan()
+	log.Info("used-space filewalker triggered")
+
+	return &multinodepb.TriggerUsedSpaceFilewalkerResponse{}, nil
+}
+
+// RotateAPIKey replaces the api key used for the request with a new one with the same scopes.
+func (management *ManagementEndpoint) RotateAPIKey(ctx context.Context, req *multinodepb.RotateAPIKeyRequest) (_ *multinodepb.RotateAPIKeyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	secret, err := authorize(ctx, management.apiKeys, req.GetHeader(), apikeys.ScopeRotateAPIKey)
+	if err != nil {
+		management.log.Warn("management action denied", zap.String("action", "rotate api key"), zap.Error(err))
+		return nil, err
+	}
+
+	log := management.log.With(zap.String("action", "rotate api key"))
+	apiKey, err := management.apiKeys.Rotate(ctx, secret)
+	if err != nil {
+		log.Error("management action failed", zap.Error(err))
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+	log.Info("api key rotated")
+
+	return &multinodepb.RotateAPIKeyResponse{
+		ApiKey: apiKey.Secret[:],
+	}, nil
+}
+
+// authorize checks that the api key has the required scope and logs denied requests.
+func (management *ManagementEndpoint) authorize(ctx context.Context, action string, header *multinodepb.RequestHeader, scope apikeys.Scope) error {
+	if _, err := authorize(ctx, management.apiKeys, header, scope); err != nil {
+		management.log.Warn("management action denied", zap.String("action", action), zap.Error(err))
+		return err
+	}
+	return nil
+}
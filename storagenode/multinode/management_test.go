@@ -0,0 +1,120 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package multinode_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/errs2"
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/multinodeauth"
+	"storj.io/storj/private/multinodepb"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/apikeys"
+	"storj.io/storj/storagenode/gracefulexit"
+	"storj.io/storj/storagenode/multinode"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestManagementEndpoint(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		service := apikeys.NewService(db.APIKeys())
+		gracefulExitService := gracefulexit.NewService(log, nil, nil, db.Satellites(), rpc.Dialer{}, gracefulexit.Config{})
+
+		endpoint := multinode.NewManagementEndpoint(log, service, nil, nil, gracefulExitService, nil, nil)
+
+		readOnly, err := service.Issue(ctx, apikeys.ScopeNone)
+		require.NoError(t, err)
+		manager, err := service.Issue(ctx, apikeys.ScopeGracefulExit|apikeys.ScopeFilewalker|apikeys.ScopeRotateAPIKey)
+		require.NoError(t, err)
+
+		header := func(secret multinodeauth.Secret) *multinodepb.RequestHeader {
+			return &multinodepb.RequestHeader{ApiKey: secret[:]}
+		}
+
+		satelliteID := testrand.NodeID()
+		require.NoError(t, db.Satellites().InitiateGracefulExit(ctx, satelliteID, time.Now(), 0))
+
+		t.Run("unauthenticated", func(t *testing.T) {
+			unknown, err := multinodeauth.NewSecret()
+			require.NoError(t, err)
+
+			_, err = endpoint.CancelGracefulExit(ctx, &multinodepb.CancelGracefulExitRequest{
+				Header:      header(unknown),
+				SatelliteId: satelliteID,
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.Unauthenticated))
+		})
+
+		t.Run("missing scope", func(t *testing.T) {
+			_, err := endpoint.CancelGracefulExit(ctx, &multinodepb.CancelGracefulExitRequest{
+				Header:      header(readOnly.Secret),
+				SatelliteId: satelliteID,
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+
+			_, err = endpoint.SetAllocatedDiskSpace(ctx, &multinodepb.SetAllocatedDiskSpaceRequest{
+				Header:    header(manager.Secret),
+				Allocated: 1,
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+
+			exits, err := db.Satellites().ListGracefulExits(ctx)
+			require.NoError(t, err)
+			require.Len(t, exits, 1)
+		})
+
+		t.Run("cancel graceful exit", func(t *testing.T) {
+			_, err := endpoint.CancelGracefulExit(ctx, &multinodepb.CancelGracefulExitRequest{
+				Header:      header(manager.Secret),
+				SatelliteId: satelliteID,
+			})
+			require.NoError(t, err)
+
+			exits, err := db.Satellites().ListGracefulExits(ctx)
+			require.NoError(t, err)
+			require.Empty(t, exits)
+
+			_, err = endpoint.CancelGracefulExit(ctx, &multinodepb.CancelGracefulExitRequest{
+				Header:      header(manager.Secret),
+				SatelliteId: satelliteID,
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+		})
+
+		t.Run("filewalker without cache", func(t *testing.T) {
+			_, err := endpoint.TriggerUsedSpaceFilewalker(ctx, &multinodepb.TriggerUsedSpaceFilewalkerRequest{
+				Header: header(manager.Secret),
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+		})
+
+		t.Run("rotate api key", func(t *testing.T) {
+			resp, err := endpoint.RotateAPIKey(ctx, &multinodepb.RotateAPIKeyRequest{
+				Header: header(manager.Secret),
+			})
+			require.NoError(t, err)
+
+			rotated, err := multinodeauth.SecretFromBytes(resp.ApiKey)
+			require.NoError(t, err)
+			require.NotEqual(t, manager.Secret, rotated)
+
+			require.Error(t, service.Check(ctx, manager.Secret))
+			require.NoError(t, service.Authorize(ctx, rotated, apikeys.ScopeGracefulExit|apikeys.ScopeFilewalker|apikeys.ScopeRotateAPIKey))
+
+			_, err = endpoint.RotateAPIKey(ctx, &multinodepb.RotateAPIKeyRequest{
+				Header: header(manager.Secret),
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.Unauthenticated))
+		})
+	})
+}
@@ -93,7 +93,7 @@ func TestPayoutsEndpointSummary(t *testing.T) {
 		})
 		require.NoError(t, err)
 
-		key, err := service.Issue(ctx)
+		key, err := service.Issue(ctx, apikeys.ScopeNone)
 		require.NoError(t, err)
 
 		response, err := endpoint.SummaryPeriod(ctx, &multinodepb.SummaryPeriodRequest{
@@ -145,7 +145,7 @@ func TestPayoutsEndpointEstimations(t *testing.T) {
 		log := zaptest.NewLogger(t)
 		service := apikeys.NewService(db.APIKeys())
 
-		key, err := service.Issue(ctx)
+		key, err := service.Issue(ctx, apikeys.ScopeNone)
 		require.NoError(t, err)
 
 		// Initialize a trust pool
@@ -216,7 +216,7 @@ func TestPayoutsUndistributedEndpoint(t *testing.T) {
 		log := zaptest.NewLogger(t)
 		service := apikeys.NewService(db.APIKeys())
 
-		key, err := service.Issue(ctx)
+		key, err := service.Issue(ctx, apikeys.ScopeNone)
 		require.NoError(t, err)
 
 		// Initialize a trust pool
@@ -51,6 +51,10 @@ type Cache struct {
 	maxSleep time.Duration
 	Storage  *sync2.Cycle
 
+	// backfillSince is the start of the storage usage requested by the next
+	// CacheSpaceUsage call, when it's earlier than the current month.
+	backfillSince time.Time
+
 	UsageStat *UsageStat
 }
 
@@ -73,6 +77,13 @@ func NewCache(log *zap.Logger, config Config, db CacheStorage, service *Service,
 	return cache
 }
 
+// BackfillSpaceUsage makes the next storage usage sync request the daily
+// storage usage since the given time, e.g. when the storage usage database
+// has been rebuilt. It must be called before Run.
+func (cache *Cache) BackfillSpaceUsage(since time.Time) {
+	cache.backfillSince = since
+}
+
 // Run runs loop.
 func (cache *Cache) Run(ctx context.Context) error {
 	var group errgroup.Group
@@ -173,6 +184,15 @@ func (cache *Cache) CacheSpaceUsage(ctx context.Context) (err error) {
 	startDate, endDate := date.MonthBoundary(time.Now().UTC())
 	// start from last day of previous month
 	startDate = startDate.AddDate(0, 0, -1)
+	if !cache.backfillSince.IsZero() && cache.backfillSince.Before(startDate) {
+		startDate = cache.backfillSince
+	}
+
+	defer func() {
+		if err == nil {
+			cache.backfillSince = time.Time{}
+		}
+	}()
 
 	return cache.satelliteLoop(ctx, func(satellite storj.NodeID) error {
 		spaceUsages, err := cache.service.GetDailyStorageUsage(ctx, satellite, startDate, endDate)
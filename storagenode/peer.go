@@ -27,9 +27,11 @@ import (
 	"storj.io/common/rpc"
 	"storj.io/common/storj"
 	"storj.io/common/version"
+	"storj.io/storj/private/delegatedrepairpb"
 	"storj.io/storj/private/emptyfs"
 	"storj.io/storj/private/lifecycle"
 	"storj.io/storj/private/multinodepb"
+	"storj.io/storj/private/piecetransferpb"
 	"storj.io/storj/private/server"
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/storagenode/apikeys"
@@ -40,6 +42,7 @@ import (
 	"storj.io/storj/storagenode/console"
 	"storj.io/storj/storagenode/console/consoleserver"
 	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/delegatedrepair"
 	"storj.io/storj/storagenode/forgetsatellite"
 	"storj.io/storj/storagenode/gracefulexit"
 	"storj.io/storj/storagenode/hashstore"
@@ -60,6 +63,7 @@ import (
 	"storj.io/storj/storagenode/piecestore"
 	"storj.io/storj/storagenode/piecestore/signaturecheck"
 	"storj.io/storj/storagenode/piecestore/usedserials"
+	"storj.io/storj/storagenode/piecetransfer"
 	"storj.io/storj/storagenode/preflight"
 	"storj.io/storj/storagenode/pricing"
 	"storj.io/storj/storagenode/reputation"
@@ -152,6 +156,10 @@ type Config struct {
 	GracefulExit gracefulexit.Config
 
 	ForgetSatellite forgetsatellite.Config
+
+	DelegatedRepair delegatedrepair.Config
+
+	PieceTransfer piecetransfer.Config
 }
 
 // DatabaseConfig returns the storagenodedb.Config that should be used with this Config.
@@ -318,6 +326,14 @@ type Peer struct {
 		Cleaner  *forgetsatellite.Cleaner
 	}
 
+	DelegatedRepair struct {
+		Endpoint *delegatedrepair.Endpoint
+	}
+
+	PieceTransfer struct {
+		Endpoint *piecetransfer.Endpoint
+	}
+
 	Notifications struct {
 		Service *notifications.Service
 	}
@@ -338,10 +354,11 @@ type Peer struct {
 	}
 
 	Multinode struct {
-		Storage   *multinode.StorageEndpoint
-		Bandwidth *multinode.BandwidthEndpoint
-		Node      *multinode.NodeEndpoint
-		Payout    *multinode.PayoutEndpoint
+		Storage    *multinode.StorageEndpoint
+		Bandwidth  *multinode.BandwidthEndpoint
+		Node       *multinode.NodeEndpoint
+		Payout     *multinode.PayoutEndpoint
+		Management *multinode.ManagementEndpoint
 	}
 }
 
@@ -989,6 +1006,33 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			debug.Cycle("Graceful Exit", peer.GracefulExit.Chore.Loop))
 	}
 
+	if config.DelegatedRepair.Enabled { // setup delegated repair
+		peer.DelegatedRepair.Endpoint = delegatedrepair.NewEndpoint(
+			process.NamedLog(peer.Log, "delegatedrepair:endpoint"),
+			peer.Storage2.Trust,
+			peer.Dialer,
+			config.DelegatedRepair,
+		)
+		if err := delegatedrepairpb.DRPCRegisterDelegatedRepair(peer.Server.DRPC(), peer.DelegatedRepair.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+	}
+
+	if config.PieceTransfer.Enabled { // setup piece transfer
+		peer.PieceTransfer.Endpoint = piecetransfer.NewEndpoint(
+			process.NamedLog(peer.Log, "piecetransfer:endpoint"),
+			peer.Identity,
+			peer.Storage2.Trust,
+			peer.Dialer,
+			peer.Storage2.PieceBackend,
+			peer.Storage2.Monitor,
+			config.PieceTransfer,
+		)
+		if err := piecetransferpb.DRPCRegisterPieceTransfer(peer.Server.DRPC(), peer.PieceTransfer.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+	}
+
 	{ // setup forget-satellite
 		peer.ForgetSatellite.Endpoint = forgetsatellite.NewEndpoint(
 			process.NamedLog(peer.Log, "forgetsatellite:endpoint"),
@@ -1073,6 +1117,16 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Payout.Service,
 		)
 
+		peer.Multinode.Management = multinode.NewManagementEndpoint(
+			process.NamedLog(peer.Log, "multinode:management-endpoint"),
+			apiKeys,
+			peer.Storage2.Monitor,
+			peer.GracefulExit.Endpoint,
+			peer.GracefulExit.Service,
+			peer.ForgetSatellite.Endpoint,
+			peer.StorageOld.CacheService,
+		)
+
 		if err = multinodepb.DRPCRegisterStorage(peer.Server.DRPC(), peer.Multinode.Storage); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
@@ -1088,6 +1142,9 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		if err = multinodepb.DRPCRegisterPayouts(peer.Server.DRPC(), peer.Multinode.Payout); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		if err = multinodepb.DRPCRegisterManagement(peer.Server.DRPC(), peer.Multinode.Management); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 	}
 
 	return peer, nil
@@ -1116,6 +1173,36 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 	return group.Wait()
 }
 
+// RepopulateRebuiltDatabases schedules restoring the data of the rebuilt
+// databases. Storage usage is requested from the satellites and the space
+// used by pieces is recalculated by the startup piece scan. Reputation,
+// payouts, pricing and satellites are refreshed from the satellites by their
+// chores anyway. It must be called before Run.
+func (peer *Peer) RepopulateRebuiltDatabases(dbNames []string) {
+	for _, dbName := range dbNames {
+		log := peer.Log.With(zap.String("database", dbName))
+
+		switch dbName {
+		case storagenodedb.StorageUsageDBName:
+			peer.NodeStats.Cache.BackfillSpaceUsage(time.Now().AddDate(-1, 0, 0))
+			log.Info("storage usage of the last year will be restored from the satellites")
+		case storagenodedb.PieceSpaceUsedDBName:
+			if peer.StorageOld.CacheService == nil {
+				continue
+			}
+			peer.StorageOld.CacheService.ScanOnStartup()
+			log.Info("space used by pieces will be recalculated by the startup piece scan")
+		case storagenodedb.ReputationDBName, storagenodedb.HeldAmountDBName,
+			storagenodedb.PricingDBName, storagenodedb.SatellitesDBName:
+			log.Info("data will be restored from the satellites")
+		case storagenodedb.APIKeysDBName:
+			log.Warn("multinode API key was lost, issue a new one with the issue-apikey command")
+		default:
+			log.Warn("data of the rebuilt database can't be restored")
+		}
+	}
+}
+
 // Close closes all the resources.
 func (peer *Peer) Close() error {
 	return errs.Combine(
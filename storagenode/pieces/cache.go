@@ -39,7 +39,8 @@ type CacheService struct {
 	// This is useful for testing.
 	InitFence sync2.Fence
 
-	spaceUsedDB PieceSpaceUsedDB
+	spaceUsedDB  PieceSpaceUsedDB
+	scanRequests chan struct{}
 }
 
 // NewService creates a new cache service that updates the space usage cache on startup and syncs the cache values to
@@ -51,10 +52,18 @@ func NewService(log *zap.Logger, usageCache *BlobsUsageCache, pieces *Store, spa
 		store:              pieces,
 		pieceScanOnStartup: pieceScanOnStartup,
 		spaceUsedDB:        spaceUsedDB,
+		scanRequests:       make(chan struct{}, 1),
 		Loop:               sync2.NewCycle(interval),
 	}
 }
 
+// ScanOnStartup enables the startup piece scan regardless of the
+// configuration, e.g. when the space used database has been rebuilt. It must
+// be called before Run.
+func (service *CacheService) ScanOnStartup() {
+	service.pieceScanOnStartup = true
+}
+
 // Run recalculates the space used cache once and also runs a loop to sync the space used cache
 // to persistent storage on an interval.
 func (service *CacheService) Run(ctx context.Context) (err error) {
@@ -67,58 +76,37 @@ func (service *CacheService) Run(ctx context.Context) (err error) {
 	}
 
 	group, ctx := errgroup.WithContext(ctx)
+	triggerCtx, stopTriggers := context.WithCancel(ctx)
 	group.Go(func() error {
 		// recalculate the cache once
-		if !service.pieceScanOnStartup {
+		if service.pieceScanOnStartup {
+			if err := service.scan(ctx); err != nil {
+				return err
+			}
+			service.InitFence.Release()
+		} else {
 			service.log.Info("Startup piece scan omitted by configuration")
-			return nil
 		}
 
-		satellites, err := service.store.getAllStoringSatellites(ctx)
-		if err != nil {
-			service.log.Error("error getting all storing satellites: ", zap.Error(err))
-			return err
-		}
+		// recalculate the cache again when requested
+		for {
+			select {
+			case <-triggerCtx.Done():
+				return nil
+			case <-service.scanRequests:
+			}
 
-		totalsAtStart := service.usageCache.copyCacheTotals()
-		for _, id := range satellites {
-			piecesTotal, contentSize, err := service.store.WalkAndComputeSpaceUsedBySatellite(ctx, id, service.store.lazyFilewalkerEnabled())
-			if err != nil {
-				service.log.Error("encountered error while computing space used by satellite", zap.Error(err), zap.Stringer("satellite_id", id))
+			service.log.Info("Triggered piece scan started")
+			if err := service.scan(triggerCtx); err != nil {
+				service.log.Error("triggered piece scan failed", zap.Error(err))
 				continue
 			}
-			usage := SatelliteUsage{
-				Total:       piecesTotal,
-				ContentSize: contentSize,
-			}
-			service.usageCache.recalculateUsageForSatellite(
-				id,
-				usage,
-				totalsAtStart.spaceUsedBySatellite[id].Total,
-				totalsAtStart.spaceUsedBySatellite[id].ContentSize,
-			)
+			service.log.Info("Triggered piece scan finished")
 		}
-
-		// TODO(clement): this walks the trash and doesn't use lazyfilewalker all this while???
-		//  See if we can avoid this completely, or make it faster
-		trashTotal, err := service.usageCache.Blobs.SpaceUsedForTrash(ctx)
-		if err != nil {
-			service.log.Error("error getting current used space for trash: ", zap.Error(err))
-			return err
-		}
-		service.usageCache.recalculateTrash(trashTotal, totalsAtStart.trashTotal)
-
-		// persist the recalculated values
-		if err := service.PersistCacheTotals(ctx); err != nil {
-			service.log.Error("error persisting cache totals to the database: ", zap.Error(err))
-		}
-
-		service.InitFence.Release()
-
-		return nil
 	})
 
 	group.Go(func() error {
+		defer stopTriggers()
 		return service.Loop.Run(ctx, func(ctx context.Context) (err error) {
 			defer mon.Task()(&ctx)(&err)
 
@@ -134,6 +122,62 @@ func (service *CacheService) Run(ctx context.Context) (err error) {
 	return group.Wait()
 }
 
+// scan recalculates the space used cache by walking the pieces of all satellites and the trash.
+func (service *CacheService) scan(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satellites, err := service.store.getAllStoringSatellites(ctx)
+	if err != nil {
+		service.log.Error("error getting all storing satellites: ", zap.Error(err))
+		return err
+	}
+
+	totalsAtStart := service.usageCache.copyCacheTotals()
+	for _, id := range satellites {
+		piecesTotal, contentSize, err := service.store.WalkAndComputeSpaceUsedBySatellite(ctx, id, service.store.lazyFilewalkerEnabled())
+		if err != nil {
+			service.log.Error("encountered error while computing space used by satellite", zap.Error(err), zap.Stringer("satellite_id", id))
+			continue
+		}
+		usage := SatelliteUsage{
+			Total:       piecesTotal,
+			ContentSize: contentSize,
+		}
+		service.usageCache.recalculateUsageForSatellite(
+			id,
+			usage,
+			totalsAtStart.spaceUsedBySatellite[id].Total,
+			totalsAtStart.spaceUsedBySatellite[id].ContentSize,
+		)
+	}
+
+	// TODO(clement): this walks the trash and doesn't use lazyfilewalker all this while???
+	//  See if we can avoid this completely, or make it faster
+	trashTotal, err := service.usageCache.Blobs.SpaceUsedForTrash(ctx)
+	if err != nil {
+		service.log.Error("error getting current used space for trash: ", zap.Error(err))
+		return err
+	}
+	service.usageCache.recalculateTrash(trashTotal, totalsAtStart.trashTotal)
+
+	// persist the recalculated values
+	if err := service.PersistCacheTotals(ctx); err != nil {
+		service.log.Error("error persisting cache totals to the database: ", zap.Error(err))
+	}
+
+	return nil
+}
+
+// TriggerScan requests the space used cache to be recalculated. The scan runs
+// in the background after the startup scan, the request is ignored when a scan
+// is already pending.
+func (service *CacheService) TriggerScan() {
+	select {
+	case service.scanRequests <- struct{}{}:
+	default:
+	}
+}
+
 // PersistCacheTotals saves the current totals of the space used cache to the database
 // so that if the storagenode restarts it can retrieve the latest space used
 // values without needing to recalculate since that could take a long time.
@@ -1029,7 +1029,7 @@ func (endpoint *Endpoint) Retain(ctx context.Context, retainReq *pb.RetainReques
 }
 
 func (endpoint *Endpoint) processRetainReq(ctx context.Context, peerID storj.NodeID, retainReq *pb.RetainRequest) (res *pb.RetainResponse, err error) {
-	filter, err := bloomfilter.NewFromBytes(retainReq.GetFilter())
+	filter, err := bloomfilter.Decode(retainReq.GetFilter())
 	if err != nil {
 		return nil, rpcstatus.NamedWrap("invalid-bf", rpcstatus.InvalidArgument, err)
 	}
@@ -0,0 +1,281 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package piecetransfer implements node-to-node piece transfers: the satellite
+// asks the destination node to download a piece directly from the source node,
+// so the piece doesn't have to travel through the satellite.
+package piecetransfer
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/semaphore"
+
+	"storj.io/common/identity"
+	"storj.io/common/pb"
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/storj/private/piecetransferpb"
+	"storj.io/storj/storagenode/monitor"
+	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/trust"
+	uplinkpiecestore "storj.io/uplink/private/piecestore"
+)
+
+var (
+	// Error is the default error class for piece transfers.
+	Error = errs.Class("piece transfer")
+
+	mon = monkit.Package()
+)
+
+// Config contains the configuration of piece transfers.
+type Config struct {
+	Enabled         bool          `help:"accept piece transfer requests from the trusted satellites" default:"true"`
+	MaxConcurrent   int           `help:"maximum number of piece transfers running at the same time" default:"5"`
+	DialTimeout     time.Duration `help:"time limit for dialing the source node" default:"5s"`
+	DownloadTimeout time.Duration `help:"time limit for downloading the piece from the source node" default:"5m"`
+}
+
+// Endpoint implements the piece transfer endpoint.
+//
+// architecture: Endpoint
+type Endpoint struct {
+	piecetransferpb.DRPCPieceTransferUnimplementedServer
+
+	log     *zap.Logger
+	ident   *identity.FullIdentity
+	trust   trust.TrustedSatelliteSource
+	dialer  rpc.Dialer
+	backend piecestore.PieceBackend
+	monitor *monitor.Service
+	config  Config
+	limiter *semaphore.Weighted
+}
+
+// NewEndpoint creates a new piece transfer endpoint.
+func NewEndpoint(log *zap.Logger, ident *identity.FullIdentity, trust trust.TrustedSatelliteSource, dialer rpc.Dialer, backend piecestore.PieceBackend, monitor *monitor.Service, config Config) *Endpoint {
+	return &Endpoint{
+		log:     log,
+		ident:   ident,
+		trust:   trust,
+		dialer:  dialer,
+		backend: backend,
+		monitor: monitor,
+		config:  config,
+		limiter: semaphore.NewWeighted(int64(config.MaxConcurrent)),
+	}
+}
+
+// Transfer downloads a piece from the source node of the request, verifies it
+// against the hash signed by the original uploader, and stores it under the
+// destination order limit.
+func (endpoint *Endpoint) Transfer(ctx context.Context, req *piecetransferpb.TransferRequest) (_ *piecetransferpb.TransferResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+	if err := endpoint.trust.VerifySatelliteID(ctx, peer.ID); err != nil {
+		return nil, rpcstatus.Error(rpcstatus.PermissionDenied, err.Error())
+	}
+	signee, err := endpoint.trust.GetSignee(ctx, peer.ID)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	sourceLimit := req.SourceLimit.GetLimit()
+	destinationLimit := req.DestinationLimit
+	switch {
+	case sourceLimit == nil || req.SourceLimit.GetStorageNodeAddress() == nil:
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "missing source order limit")
+	case sourceLimit.Action != pb.PieceAction_GET_REPAIR:
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "expected get repair action for the source, got %v", sourceLimit.Action)
+	case destinationLimit == nil:
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "missing destination order limit")
+	case destinationLimit.Action != pb.PieceAction_PUT_REPAIR:
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "expected put repair action for the destination, got %v", destinationLimit.Action)
+	case destinationLimit.StorageNodeId != endpoint.ident.ID:
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "destination order limit is issued for another node")
+	case destinationLimit.SatelliteId != peer.ID:
+		return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "destination order limit is issued by another satellite")
+	case time.Now().After(destinationLimit.OrderExpiration):
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "destination order limit is expired")
+	}
+	if err := signing.VerifyOrderLimitSignature(ctx, signee, destinationLimit); err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, "invalid destination order limit signature")
+	}
+
+	sourceKey, err := storj.PiecePrivateKeyFromBytes(req.SourcePrivateKey)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+	destinationKey, err := storj.PiecePrivateKeyFromBytes(req.DestinationPrivateKey)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+
+	if !endpoint.limiter.TryAcquire(1) {
+		mon.Meter("piece_transfer_rejected").Mark(1)
+		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, "too many piece transfers")
+	}
+	defer endpoint.limiter.Release(1)
+
+	availableSpace, err := endpoint.monitor.AvailableSpace(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	if availableSpace < destinationLimit.Limit {
+		return nil, rpcstatus.Errorf(rpcstatus.Aborted, "not enough available disk space, have: %v, need: %v", availableSpace, destinationLimit.Limit)
+	}
+
+	log := endpoint.log.With(
+		zap.Stringer("satellite_id", peer.ID),
+		zap.Stringer("source_node", sourceLimit.StorageNodeId),
+		zap.Stringer("piece_id", destinationLimit.PieceId))
+
+	data, originalHash, err := endpoint.download(ctx, signee, req.SourceLimit, sourceKey)
+	if err != nil {
+		log.Debug("piece transfer download failed", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
+	}
+	if int64(len(data)) > destinationLimit.Limit {
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "piece is larger than the destination order limit: %d > %d", len(data), destinationLimit.Limit)
+	}
+
+	hash, err := endpoint.store(ctx, destinationLimit, destinationKey, originalHash, data)
+	if err != nil {
+		log.Error("piece transfer store failed", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	mon.Meter("piece_transfer_bytes").Mark64(int64(len(data)))
+	log.Debug("piece transferred")
+	return &piecetransferpb.TransferResponse{Hash: hash}, nil
+}
+
+// download downloads the piece and verifies it against the hash signed by the
+// original uploader.
+func (endpoint *Endpoint) download(ctx context.Context, signee signing.Signee, limit *pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey) (_ []byte, _ *pb.PieceHash, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dialCtx, dialCancel := context.WithTimeout(ctx, endpoint.config.DialTimeout)
+	defer dialCancel()
+
+	client, err := uplinkpiecestore.Dial(dialCtx, endpoint.dialer, storj.NodeURL{
+		ID:      limit.GetLimit().StorageNodeId,
+		Address: limit.GetStorageNodeAddress().GetAddress(),
+	}, uplinkpiecestore.DefaultConfig)
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, client.Close()) }()
+
+	downloadCtx, downloadCancel := context.WithTimeout(ctx, endpoint.config.DownloadTimeout)
+	defer downloadCancel()
+
+	downloader, err := client.Download(downloadCtx, limit.GetLimit(), privateKey, 0, limit.GetLimit().Limit)
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, downloader.Close()) }()
+
+	data, err := io.ReadAll(downloader)
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+
+	hash, originalLimit := downloader.GetHashAndLimit()
+	switch {
+	case hash == nil || originalLimit == nil:
+		return nil, nil, Error.New("hash or original order limit was not sent from the source node")
+	case hash.PieceId != originalLimit.PieceId:
+		return nil, nil, Error.New("piece id doesn't match the original order limit")
+	case hash.PieceSize != int64(len(data)):
+		return nil, nil, Error.New("piece size doesn't match the hash: %d != %d", len(data), hash.PieceSize)
+	}
+	if err := signing.VerifyOrderLimitSignature(ctx, signee, originalLimit); err != nil {
+		return nil, nil, Error.New("invalid original order limit signature: %w", err)
+	}
+	if err := signing.VerifyUplinkPieceHashSignature(ctx, originalLimit.UplinkPublicKey, hash); err != nil {
+		return nil, nil, Error.New("invalid piece hash signature: %w", err)
+	}
+
+	hasher := pb.NewHashFromAlgorithm(hash.HashAlgorithm)
+	_, _ = hasher.Write(data)
+	if !bytes.Equal(hasher.Sum(nil), hash.Hash) {
+		return nil, nil, Error.New("downloaded piece doesn't match the hash")
+	}
+
+	return data, hash, nil
+}
+
+// store writes the piece to the piece backend, as if it were uploaded with the
+// destination order limit, and returns the piece hash signed by this node.
+func (endpoint *Endpoint) store(ctx context.Context, limit *pb.OrderLimit, privateKey storj.PiecePrivateKey, originalHash *pb.PieceHash, data []byte) (_ *pb.PieceHash, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the piece is signed with the key of the destination order limit, so it
+	// can be verified on download like any other repaired piece.
+	uplinkHash, err := signing.SignUplinkPieceHash(ctx, privateKey, &pb.PieceHash{
+		PieceId:       limit.PieceId,
+		Hash:          originalHash.Hash,
+		HashAlgorithm: originalHash.HashAlgorithm,
+		PieceSize:     int64(len(data)),
+		Timestamp:     time.Now(),
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if err := signing.VerifyUplinkPieceHashSignature(ctx, limit.UplinkPublicKey, uplinkHash); err != nil {
+		return nil, Error.New("private key doesn't match the destination order limit: %w", err)
+	}
+
+	writer, err := endpoint.backend.Writer(ctx, limit.SatelliteId, limit.PieceId, originalHash.HashAlgorithm, limit.PieceExpiration)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			err = errs.Combine(err, writer.Cancel(ctx))
+		}
+	}()
+
+	if _, err := writer.Write(data); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if !bytes.Equal(writer.Hash(), originalHash.Hash) {
+		return nil, Error.New("stored piece doesn't match the hash")
+	}
+
+	err = writer.Commit(ctx, &pb.PieceHeader{
+		Hash:          uplinkHash.Hash,
+		HashAlgorithm: uplinkHash.HashAlgorithm,
+		CreationTime:  uplinkHash.Timestamp,
+		Signature:     uplinkHash.Signature,
+		OrderLimit:    *limit,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	committed = true
+
+	hash, err := signing.SignPieceHash(ctx, signing.SignerFromFullIdentity(endpoint.ident), &pb.PieceHash{
+		PieceId:       limit.PieceId,
+		Hash:          uplinkHash.Hash,
+		HashAlgorithm: uplinkHash.HashAlgorithm,
+		PieceSize:     writer.Size(),
+		Timestamp:     time.Now(),
+	})
+	return hash, Error.Wrap(err)
+}
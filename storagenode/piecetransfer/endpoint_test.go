@@ -0,0 +1,170 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecetransfer_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/piecetransferpb"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/orders"
+)
+
+func TestTransfer(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 2, StorageNodeCount: 2,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		source, dest := planet.StorageNodes[0], planet.StorageNodes[1]
+		data := testrand.Bytes(10 * memory.KiB)
+
+		// storePiece stores data on the source node as piece 0 of a new segment,
+		// with the hash of hashedData signed by the uplink.
+		storePiece := func(t *testing.T, hashedData []byte) storj.PieceID {
+			rootPieceID := testrand.PieceID()
+			signer, err := orders.NewSignerRepairPut(sat.Orders.Service, rootPieceID, time.Time{}, time.Now(), int64(len(data)), metabase.BucketLocation{})
+			require.NoError(t, err)
+			limit, err := signer.Sign(ctx, nodeOf(source), 0)
+			require.NoError(t, err)
+
+			hasher := pb.NewHashFromAlgorithm(pb.PieceHashAlgorithm_SHA256)
+			_, _ = hasher.Write(hashedData)
+			hash, err := signing.SignUplinkPieceHash(ctx, signer.PrivateKey, &pb.PieceHash{
+				PieceId:       limit.Limit.PieceId,
+				Hash:          hasher.Sum(nil),
+				HashAlgorithm: pb.PieceHashAlgorithm_SHA256,
+				PieceSize:     int64(len(data)),
+				Timestamp:     time.Now(),
+			})
+			require.NoError(t, err)
+
+			writer, err := source.Storage2.PieceBackend.Writer(ctx, sat.ID(), limit.Limit.PieceId, pb.PieceHashAlgorithm_SHA256, time.Time{})
+			require.NoError(t, err)
+			_, err = writer.Write(data)
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{
+				Hash:          hash.Hash,
+				HashAlgorithm: hash.HashAlgorithm,
+				CreationTime:  hash.Timestamp,
+				Signature:     hash.Signature,
+				OrderLimit:    *limit.Limit,
+			}))
+			return rootPieceID
+		}
+
+		// newRequest creates a request to transfer piece 0 of the segment from
+		// the source node to the destination, with a destination order limit
+		// signed by the satellite.
+		newRequest := func(t *testing.T, rootPieceID storj.PieceID, signedBy *testplanet.Satellite, destination *testplanet.StorageNode, orderCreation time.Time, destinationSize int64) *piecetransferpb.TransferRequest {
+			getSigner, err := orders.NewSignerRepairGet(sat.Orders.Service, rootPieceID, time.Now(), int64(len(data)), metabase.BucketLocation{})
+			require.NoError(t, err)
+			sourceLimit, err := getSigner.Sign(ctx, nodeOf(source), 0)
+			require.NoError(t, err)
+
+			putSigner, err := orders.NewSignerRepairPut(signedBy.Orders.Service, rootPieceID, time.Time{}, orderCreation, destinationSize, metabase.BucketLocation{})
+			require.NoError(t, err)
+			destinationLimit, err := putSigner.Sign(ctx, nodeOf(destination), 0)
+			require.NoError(t, err)
+
+			return &piecetransferpb.TransferRequest{
+				SourceLimit:           sourceLimit,
+				SourcePrivateKey:      getSigner.PrivateKey.Bytes(),
+				DestinationLimit:      destinationLimit.Limit,
+				DestinationPrivateKey: putSigner.PrivateKey.Bytes(),
+			}
+		}
+
+		transfer := func(t *testing.T, req *piecetransferpb.TransferRequest) (*piecetransferpb.TransferResponse, error) {
+			conn, err := sat.Dialer.DialNodeURL(ctx, dest.NodeURL())
+			require.NoError(t, err)
+			defer ctx.Check(conn.Close)
+
+			return piecetransferpb.NewDRPCPieceTransferClient(conn).Transfer(ctx, req)
+		}
+
+		requireNotStored := func(t *testing.T, rootPieceID storj.PieceID) {
+			_, err := dest.Storage2.PieceBackend.Reader(ctx, sat.ID(), rootPieceID.Derive(dest.ID(), 0))
+			require.Error(t, err)
+		}
+
+		t.Run("success", func(t *testing.T) {
+			rootPieceID := storePiece(t, data)
+
+			resp, err := transfer(t, newRequest(t, rootPieceID, sat, dest, time.Now(), int64(len(data))))
+			require.NoError(t, err)
+			require.Equal(t, rootPieceID.Derive(dest.ID(), 0), resp.Hash.PieceId)
+			require.Equal(t, int64(len(data)), resp.Hash.PieceSize)
+
+			reader, err := dest.Storage2.PieceBackend.Reader(ctx, sat.ID(), resp.Hash.PieceId)
+			require.NoError(t, err)
+			defer ctx.Check(reader.Close)
+			stored, err := io.ReadAll(io.LimitReader(reader, reader.Size()))
+			require.NoError(t, err)
+			require.Equal(t, data, stored)
+		})
+
+		t.Run("wrong node", func(t *testing.T) {
+			rootPieceID := storePiece(t, data)
+
+			// the destination order limit is issued for the source node
+			_, err := transfer(t, newRequest(t, rootPieceID, sat, source, time.Now(), int64(len(data))))
+			require.Equal(t, rpcstatus.InvalidArgument, rpcstatus.Code(err))
+			requireNotStored(t, rootPieceID)
+		})
+
+		t.Run("wrong satellite", func(t *testing.T) {
+			rootPieceID := storePiece(t, data)
+
+			// the destination order limit is issued by another trusted satellite
+			_, err := transfer(t, newRequest(t, rootPieceID, planet.Satellites[1], dest, time.Now(), int64(len(data))))
+			require.Equal(t, rpcstatus.PermissionDenied, rpcstatus.Code(err))
+			requireNotStored(t, rootPieceID)
+		})
+
+		t.Run("expired limit", func(t *testing.T) {
+			rootPieceID := storePiece(t, data)
+
+			orderCreation := time.Now().Add(-sat.Config.Orders.Expiration - time.Hour)
+			_, err := transfer(t, newRequest(t, rootPieceID, sat, dest, orderCreation, int64(len(data))))
+			require.Equal(t, rpcstatus.InvalidArgument, rpcstatus.Code(err))
+			requireNotStored(t, rootPieceID)
+		})
+
+		t.Run("oversized piece", func(t *testing.T) {
+			rootPieceID := storePiece(t, data)
+
+			_, err := transfer(t, newRequest(t, rootPieceID, sat, dest, time.Now(), int64(len(data))-1))
+			require.Equal(t, rpcstatus.InvalidArgument, rpcstatus.Code(err))
+			requireNotStored(t, rootPieceID)
+		})
+
+		t.Run("hash mismatch", func(t *testing.T) {
+			// the source node stores data, which doesn't match the signed hash
+			rootPieceID := storePiece(t, testrand.Bytes(memory.Size(len(data))))
+
+			_, err := transfer(t, newRequest(t, rootPieceID, sat, dest, time.Now(), int64(len(data))))
+			require.Equal(t, rpcstatus.FailedPrecondition, rpcstatus.Code(err))
+			requireNotStored(t, rootPieceID)
+		})
+	})
+}
+
+func nodeOf(node *testplanet.StorageNode) *pb.Node {
+	return &pb.Node{
+		Id:      node.ID(),
+		Address: &pb.NodeAddress{Address: node.Addr()},
+	}
+}
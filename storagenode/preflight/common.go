@@ -13,4 +13,7 @@ var mon = monkit.Package()
 type Config struct {
 	LocalTimeCheck bool `help:"whether or not preflight check for local system clock is enabled on the satellite side. When disabling this feature, your storagenode may not setup correctly." default:"true"`
 	DatabaseCheck  bool `help:"whether or not preflight check for database is enabled." default:"true"`
+
+	DatabaseRepair         bool `help:"whether or not the databases are checked on startup after a corruption error, and the corrupted ones are quarantined and rebuilt." default:"true"`
+	DatabaseIntegrityCheck bool `help:"whether or not the databases are checked for corruption on every startup, which requires database-repair and may be slow on large databases." default:"false"`
 }
@@ -0,0 +1,49 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package relocate
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// UpdateConfigFile sets the values of the keys in the YAML config file. The
+// lines of the keys are replaced, missing keys are appended, and the rest of
+// the file, including the comments, is kept intact.
+func UpdateConfigFile(path string, values map[string]string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	lines := strings.Split(string(data), "\n")
+	updated := map[string]bool{}
+	for i, line := range lines {
+		key, _, ok := strings.Cut(strings.TrimSpace(line), ":")
+		if !ok || strings.HasPrefix(key, "#") {
+			continue
+		}
+		if value, ok := values[key]; ok {
+			lines[i] = key + ": " + strconv.Quote(value)
+			updated[key] = true
+		}
+	}
+
+	if len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	for _, key := range sortedKeys(values) {
+		if !updated[key] {
+			lines = append(lines, key+": "+strconv.Quote(values[key]))
+		}
+	}
+	lines = append(lines, "")
+
+	return Error.Wrap(writeFileAtomic(path, []byte(strings.Join(lines, "\n")), info.Mode().Perm()))
+}
@@ -0,0 +1,466 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package relocate moves the data of a storage node to a new location while
+// the node keeps serving.
+//
+// The relocation copies the storage directory, and optionally the hashstore
+// and database directories, in repeated passes. Every pass only transfers
+// what has changed since the previous one, so the passes converge while the
+// node keeps writing. Once converged, the relocation is marked as synced and
+// the next start of the node runs a final pass while it's offline, verifies
+// the copy and switches the configuration to the new location.
+package relocate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+)
+
+var (
+	// Error is the default error class for relocations.
+	Error = errs.Class("relocate")
+
+	mon = monkit.Package()
+)
+
+// StateFileName is the name of the file in the storage directory, which
+// tracks the progress of the relocation.
+const StateFileName = "relocation.json"
+
+// Config keys updated when the relocation is finished.
+const (
+	ConfigKeyStoragePath = "storage.path"
+	ConfigKeyLogsPath    = "hashstore.logs-path"
+	ConfigKeyTablePath   = "hashstore.table-path"
+	ConfigKeyDatabaseDir = "storage2.database-dir"
+)
+
+// Phase is the phase of a relocation.
+type Phase string
+
+const (
+	// PhaseCopying means that the data is being copied while the node is running.
+	PhaseCopying Phase = "copying"
+	// PhaseSynced means that the copy has converged and the relocation is
+	// finished on the next start of the node.
+	PhaseSynced Phase = "synced"
+	// PhaseDone means that the node has switched to the new location.
+	PhaseDone Phase = "done"
+)
+
+// Location is a directory tree, which is copied to a new destination.
+type Location struct {
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+}
+
+// PassStats contains the statistics of a single copy pass.
+type PassStats struct {
+	FilesCopied  int64         `json:"filesCopied"`
+	BytesCopied  int64         `json:"bytesCopied"`
+	FilesDeleted int64         `json:"filesDeleted"`
+	Changed      int64         `json:"changed"` // files that changed while being copied
+	Duration     time.Duration `json:"duration"`
+}
+
+// State is the persisted progress of a relocation.
+type State struct {
+	Phase     Phase      `json:"phase"`
+	Locations []Location `json:"locations"`
+	// Config contains the config values that are set once the relocation is finished.
+	Config map[string]string `json:"config"`
+
+	Passes      int       `json:"passes"`
+	FilesCopied int64     `json:"filesCopied"`
+	BytesCopied int64     `json:"bytesCopied"`
+	LastPass    PassStats `json:"lastPass"`
+
+	StartedAt  time.Time  `json:"startedAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
+// Paths are the currently configured paths of a storage node.
+type Paths struct {
+	Storage     string // storage.path
+	LogsPath    string // hashstore.logs-path, relative to Storage when not absolute
+	TablePath   string // hashstore.table-path, relative to Storage when not absolute
+	DatabaseDir string // storage2.database-dir, Storage when empty
+}
+
+// Targets are the requested new locations. Apart from Storage, targets are
+// optional: directories inside the storage directory move along with it,
+// while directories outside of it stay where they are.
+type Targets struct {
+	Storage   string
+	Logs      string
+	Tables    string
+	Databases string
+}
+
+// Options controls the passes done while the node is running.
+type Options struct {
+	// MaxPasses is the maximum number of passes done by a single Copy call.
+	MaxPasses int
+	// ConvergeSize is the amount of copied data below which a pass is
+	// considered to have converged.
+	ConvergeSize memory.Size
+	// Progress is called after every pass, when set.
+	Progress func(State)
+}
+
+// NewState plans the relocation of paths to targets.
+func NewState(paths Paths, targets Targets, now time.Time) (_ *State, err error) {
+	if paths.Storage == "" || targets.Storage == "" {
+		return nil, Error.New("storage path and its destination are required")
+	}
+
+	type directory struct {
+		key        string
+		configured string
+		target     string
+	}
+
+	storage, err := filepath.Abs(paths.Storage)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	storageTarget, err := filepath.Abs(targets.Storage)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	state := &State{
+		Phase:     PhaseCopying,
+		Locations: []Location{{Source: storage, Destination: storageTarget}},
+		Config:    map[string]string{ConfigKeyStoragePath: storageTarget},
+		StartedAt: now,
+		UpdatedAt: now,
+	}
+
+	directories := []directory{
+		{key: ConfigKeyLogsPath, configured: paths.LogsPath, target: targets.Logs},
+		{key: ConfigKeyTablePath, configured: paths.TablePath, target: targets.Tables},
+		{key: ConfigKeyDatabaseDir, configured: paths.DatabaseDir, target: targets.Databases},
+	}
+
+	sources := make([]string, len(directories))
+	for i, dir := range directories {
+		switch {
+		case dir.key == ConfigKeyDatabaseDir && dir.configured == "":
+			if dir.target != "" {
+				return nil, Error.New("moving the databases separately requires %s to be configured", dir.key)
+			}
+			sources[i] = storage
+		case dir.key != ConfigKeyDatabaseDir && !filepath.IsAbs(dir.configured):
+			sources[i] = filepath.Join(storage, dir.configured)
+		default:
+			sources[i], err = filepath.Abs(dir.configured)
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+		}
+
+		if dir.target != "" {
+			directories[i].target, err = filepath.Abs(dir.target)
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+		}
+	}
+
+	// directories sharing the same source, e.g. logs and tables, must move together.
+	for i := range directories {
+		for j := range directories {
+			if i == j || sources[i] != sources[j] || directories[j].target == "" {
+				continue
+			}
+			if directories[i].target == "" {
+				directories[i].target = directories[j].target
+			}
+			if directories[i].target != directories[j].target {
+				return nil, Error.New("%s and %s share the directory %q and can't be moved to different destinations",
+					directories[i].key, directories[j].key, sources[i])
+			}
+		}
+	}
+
+	for i, dir := range directories {
+		source := sources[i]
+		switch {
+		case dir.target != "":
+			if !state.hasSource(source) {
+				state.Locations = append(state.Locations, Location{Source: source, Destination: dir.target})
+			} else if state.destination(source) != dir.target {
+				return nil, Error.New("%q can't be moved to both %q and %q", source, state.destination(source), dir.target)
+			}
+			state.Config[dir.key] = dir.target
+		case dir.configured != "" && filepath.IsAbs(dir.configured) && isInside(storage, source):
+			rel, err := filepath.Rel(storage, source)
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+			state.Config[dir.key] = filepath.Join(storageTarget, rel)
+		}
+	}
+
+	if err := state.validate(); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+func (state *State) hasSource(source string) bool {
+	for _, location := range state.Locations {
+		if location.Source == source {
+			return true
+		}
+	}
+	return false
+}
+
+func (state *State) destination(source string) string {
+	for _, location := range state.Locations {
+		if location.Source == source {
+			return location.Destination
+		}
+	}
+	return ""
+}
+
+// validate checks that the copied trees don't overlap.
+func (state *State) validate() error {
+	for i, a := range state.Locations {
+		if isInside(a.Source, a.Destination) || isInside(a.Destination, a.Source) {
+			return Error.New("destination %q overlaps with source %q", a.Destination, a.Source)
+		}
+		for j, b := range state.Locations {
+			if i == j {
+				continue
+			}
+			if isInside(b.Source, a.Destination) || isInside(a.Destination, b.Source) {
+				return Error.New("destination %q overlaps with source %q", a.Destination, b.Source)
+			}
+			if isInside(b.Destination, a.Destination) {
+				return Error.New("destination %q overlaps with destination %q", a.Destination, b.Destination)
+			}
+		}
+	}
+	return nil
+}
+
+// CheckDestinations checks that the destinations are empty or don't exist
+// yet, so that the relocation doesn't overwrite unrelated data.
+func (state *State) CheckDestinations() error {
+	for _, location := range state.Locations {
+		entries, err := os.ReadDir(location.Destination)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(entries) > 0 {
+			return Error.New("destination %q is not empty", location.Destination)
+		}
+	}
+	return nil
+}
+
+// StoragePath returns the storage directory, which is being relocated.
+func (state *State) StoragePath() string {
+	return state.Locations[0].Source
+}
+
+// LoadState loads the state of the relocation of the storage directory. It
+// returns nil when there is no relocation.
+func LoadState(storagePath string) (_ *State, err error) {
+	data, err := os.ReadFile(filepath.Join(storagePath, StateFileName))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var state State
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, Error.New("invalid %s: %w", StateFileName, err)
+	}
+	if len(state.Locations) == 0 {
+		return nil, Error.New("invalid %s: no locations", StateFileName)
+	}
+	return &state, nil
+}
+
+// SaveState atomically writes the state into the storage directory.
+func SaveState(state *State) error {
+	data, err := json.MarshalIndent(state, "", "\t")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(writeFileAtomic(filepath.Join(state.StoragePath(), StateFileName), data, 0644))
+}
+
+// Cancel removes the state of the relocation of the storage directory. The
+// data already copied to the destinations is left as is.
+func Cancel(storagePath string) error {
+	state, err := LoadState(storagePath)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		return Error.New("no relocation in progress")
+	}
+	if state.Phase == PhaseDone {
+		return Error.New("relocation is already done")
+	}
+	return Error.Wrap(os.Remove(filepath.Join(storagePath, StateFileName)))
+}
+
+// Copy runs passes while the node is running until the amount of copied data
+// converges, or the maximum number of passes is reached. The state is saved
+// after every pass, so an interrupted copy continues where it stopped.
+func Copy(ctx context.Context, log *zap.Logger, state *State, opts Options) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if state.Phase == PhaseDone {
+		return Error.New("relocation is already done")
+	}
+
+	state.Phase = PhaseCopying
+	if err := SaveState(state); err != nil {
+		return err
+	}
+
+	for pass := 0; opts.MaxPasses <= 0 || pass < opts.MaxPasses; pass++ {
+		stats, err := newSyncer(log, state, false).run(ctx)
+		if err != nil {
+			return err
+		}
+		state.addPass(stats, time.Now())
+
+		if stats.BytesCopied <= opts.ConvergeSize.Int64() && stats.Changed == 0 {
+			state.Phase = PhaseSynced
+		}
+		if err := SaveState(state); err != nil {
+			return err
+		}
+		if opts.Progress != nil {
+			opts.Progress(*state)
+		}
+		if state.Phase == PhaseSynced {
+			log.Info("relocation synced, restart the node to finish it",
+				zap.Int("passes", state.Passes))
+			return nil
+		}
+	}
+
+	log.Info("relocation didn't converge yet, run it again to continue",
+		zap.Int("passes", state.Passes),
+		zap.Stringer("last pass", memory.Size(state.LastPass.BytesCopied)))
+	return nil
+}
+
+// Finish finishes a synced relocation of the storage directory. It must be
+// called before the node opens its databases. It runs the final pass, verifies
+// the copy and updates configFile. The returned config values must be
+// applied to the configuration of the running process. It returns nil when
+// there is no relocation to finish.
+func Finish(ctx context.Context, log *zap.Logger, storagePath, configFile string) (_ map[string]string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	state, err := LoadState(storagePath)
+	if err != nil || state == nil {
+		return nil, err
+	}
+
+	switch state.Phase {
+	case PhaseCopying:
+		log.Info("relocation in progress, the node keeps using the current location",
+			zap.String("destination", state.Locations[0].Destination))
+		return nil, nil
+	case PhaseDone:
+		return nil, Error.New("storage was relocated from %q to %q, but the node is still configured to use the old location; update %s",
+			state.StoragePath(), state.Locations[0].Destination, configFile)
+	case PhaseSynced:
+	default:
+		return nil, Error.New("unknown relocation phase %q", state.Phase)
+	}
+
+	if _, err := os.Stat(configFile); err != nil {
+		return nil, Error.New("unable to update the configuration: %w", err)
+	}
+
+	log.Info("finishing relocation", zap.String("destination", state.Locations[0].Destination))
+
+	syncer := newSyncer(log, state, true)
+	stats, err := syncer.run(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := syncer.verify(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := UpdateConfigFile(configFile, state.Config); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	state.addPass(stats, now)
+	state.Phase = PhaseDone
+	state.FinishedAt = &now
+	if err := SaveState(state); err != nil {
+		return nil, err
+	}
+
+	log.Info("relocation finished",
+		zap.Int("passes", state.Passes),
+		zap.Stringer("copied", memory.Size(state.BytesCopied)),
+		zap.Duration("final pass", stats.Duration))
+
+	return state.Config, nil
+}
+
+func (state *State) addPass(stats PassStats, now time.Time) {
+	state.Passes++
+	state.FilesCopied += stats.FilesCopied
+	state.BytesCopied += stats.BytesCopied
+	state.LastPass = stats
+	state.UpdatedAt = now
+}
+
+// isInside returns whether path is dir or inside of it.
+func isInside(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)))
+}
+
+// sortedKeys returns the keys of the map in order.
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,216 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package relocate_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/relocate"
+)
+
+func TestNewState(t *testing.T) {
+	dir := t.TempDir()
+	old := filepath.Join(dir, "old")
+	now := time.Now()
+
+	t.Run("relative directories move along", func(t *testing.T) {
+		state, err := relocate.NewState(relocate.Paths{
+			Storage: old, LogsPath: "hashstore", TablePath: "hashstore",
+		}, relocate.Targets{Storage: filepath.Join(dir, "new")}, now)
+		require.NoError(t, err)
+		require.Equal(t, []relocate.Location{{Source: old, Destination: filepath.Join(dir, "new")}}, state.Locations)
+		require.Equal(t, map[string]string{relocate.ConfigKeyStoragePath: filepath.Join(dir, "new")}, state.Config)
+	})
+
+	t.Run("absolute directories", func(t *testing.T) {
+		state, err := relocate.NewState(relocate.Paths{
+			Storage:     old,
+			LogsPath:    filepath.Join(old, "logs"),
+			TablePath:   filepath.Join(dir, "ssd", "tables"),
+			DatabaseDir: filepath.Join(dir, "ssd", "dbs"),
+		}, relocate.Targets{
+			Storage:   filepath.Join(dir, "new"),
+			Databases: filepath.Join(dir, "nvme", "dbs"),
+		}, now)
+		require.NoError(t, err)
+		require.Equal(t, []relocate.Location{
+			{Source: old, Destination: filepath.Join(dir, "new")},
+			{Source: filepath.Join(dir, "ssd", "dbs"), Destination: filepath.Join(dir, "nvme", "dbs")},
+		}, state.Locations)
+		require.Equal(t, map[string]string{
+			relocate.ConfigKeyStoragePath: filepath.Join(dir, "new"),
+			relocate.ConfigKeyLogsPath:    filepath.Join(dir, "new", "logs"),
+			relocate.ConfigKeyDatabaseDir: filepath.Join(dir, "nvme", "dbs"),
+		}, state.Config)
+	})
+
+	t.Run("shared hashstore directory", func(t *testing.T) {
+		state, err := relocate.NewState(relocate.Paths{
+			Storage: old, LogsPath: "hashstore", TablePath: "hashstore",
+		}, relocate.Targets{Storage: filepath.Join(dir, "new"), Logs: filepath.Join(dir, "hashstore")}, now)
+		require.NoError(t, err)
+		require.Len(t, state.Locations, 2)
+		require.Equal(t, filepath.Join(dir, "hashstore"), state.Config[relocate.ConfigKeyLogsPath])
+		require.Equal(t, filepath.Join(dir, "hashstore"), state.Config[relocate.ConfigKeyTablePath])
+
+		_, err = relocate.NewState(relocate.Paths{
+			Storage: old, LogsPath: "hashstore", TablePath: "hashstore",
+		}, relocate.Targets{
+			Storage: filepath.Join(dir, "new"),
+			Logs:    filepath.Join(dir, "logs"),
+			Tables:  filepath.Join(dir, "tables"),
+		}, now)
+		require.Error(t, err)
+	})
+
+	t.Run("overlapping destinations", func(t *testing.T) {
+		for _, targets := range []relocate.Targets{
+			{Storage: old},
+			{Storage: filepath.Join(old, "new")},
+			{Storage: dir},
+			{Storage: filepath.Join(dir, "new"), Logs: filepath.Join(dir, "new", "logs")},
+		} {
+			_, err := relocate.NewState(relocate.Paths{
+				Storage: old, LogsPath: "hashstore", TablePath: "hashstore",
+			}, targets, now)
+			require.Error(t, err, "%+v", targets)
+		}
+
+		_, err := relocate.NewState(relocate.Paths{Storage: old}, relocate.Targets{
+			Storage:   filepath.Join(dir, "new"),
+			Databases: filepath.Join(dir, "dbs"),
+		}, now)
+		require.Error(t, err)
+	})
+}
+
+func TestRelocate(t *testing.T) {
+	ctx := t.Context()
+	log := zaptest.NewLogger(t)
+
+	dir := t.TempDir()
+	old, target := filepath.Join(dir, "old"), filepath.Join(dir, "new")
+
+	write := func(path string, data []byte) {
+		t.Helper()
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, os.WriteFile(path, data, 0600))
+	}
+	appendTo := func(path string, data []byte) {
+		t.Helper()
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+		require.NoError(t, err)
+		_, err = f.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+	}
+
+	logFile := filepath.Join(old, "hashstore", "s0", "01", "log-0000000000000001-00000000")
+	tableFile := filepath.Join(old, "hashstore", "s0", "meta", "hashtbl-0000000000000001")
+	dbFile := filepath.Join(old, "piecestore.db")
+	blobFile := filepath.Join(old, "blobs", "sat", "aa", "piece.sj1")
+	removedFile := filepath.Join(old, "blobs", "sat", "ab", "removed.sj1")
+
+	write(logFile, testrand.BytesInt(3*memory.MiB.Int()))
+	write(tableFile, testrand.BytesInt(2*memory.MiB.Int()+100))
+	write(dbFile, testrand.BytesInt(4096))
+	write(blobFile, testrand.BytesInt(1000))
+	write(removedFile, testrand.BytesInt(1000))
+
+	configFile := filepath.Join(dir, "config.yaml")
+	write(configFile, []byte("# storage.path: \"\"\nstorage.path: "+old+"\nserver.address: :28967\n"))
+
+	state, err := relocate.NewState(relocate.Paths{
+		Storage: old, LogsPath: "hashstore", TablePath: "hashstore",
+	}, relocate.Targets{Storage: target}, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, state.CheckDestinations())
+
+	// the first pass copies everything, the second one nothing.
+	var passes []relocate.PassStats
+	require.NoError(t, relocate.Copy(ctx, log, state, relocate.Options{
+		MaxPasses: 5,
+		Progress:  func(state relocate.State) { passes = append(passes, state.LastPass) },
+	}))
+	require.Len(t, passes, 2)
+	require.EqualValues(t, 5, passes[0].FilesCopied)
+	require.Zero(t, passes[1].BytesCopied)
+	require.Equal(t, relocate.PhaseSynced, state.Phase)
+
+	loaded, err := relocate.LoadState(old)
+	require.NoError(t, err)
+	require.Equal(t, relocate.PhaseSynced, loaded.Phase)
+	require.NoFileExists(t, filepath.Join(target, relocate.StateFileName))
+	require.Error(t, state.CheckDestinations())
+
+	// the node keeps writing while it's running.
+	appendTo(logFile, testrand.BytesInt(100))
+	require.NoError(t, os.Remove(removedFile))
+	write(filepath.Join(old, "blobs", "sat", "ac", "new.sj1"), testrand.BytesInt(1000))
+
+	// the table is modified in place without changing its size or modification time.
+	info, err := os.Stat(tableFile)
+	require.NoError(t, err)
+	f, err := os.OpenFile(tableFile, os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.WriteAt([]byte("modified"), memory.MiB.Int64()+10)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.NoError(t, os.Chtimes(tableFile, info.ModTime(), info.ModTime()))
+
+	config, err := relocate.Finish(ctx, log, old, configFile)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{relocate.ConfigKeyStoragePath: target}, config)
+
+	for _, path := range []string{logFile, tableFile, dbFile, blobFile, filepath.Join(old, "blobs", "sat", "ac", "new.sj1")} {
+		rel, err := filepath.Rel(old, path)
+		require.NoError(t, err)
+
+		expected, err := os.ReadFile(path)
+		require.NoError(t, err)
+		actual, err := os.ReadFile(filepath.Join(target, rel))
+		require.NoError(t, err)
+		require.Equal(t, expected, actual, rel)
+	}
+	require.NoDirExists(t, filepath.Join(target, "blobs", "sat", "ab", "removed.sj1"))
+
+	data, err := os.ReadFile(configFile)
+	require.NoError(t, err)
+	require.Equal(t, "# storage.path: \"\"\nstorage.path: \""+target+"\"\nserver.address: :28967\n", string(data))
+
+	// the node refuses to start from the old location.
+	_, err = relocate.Finish(ctx, log, old, configFile)
+	require.Error(t, err)
+
+	config, err = relocate.Finish(ctx, log, target, configFile)
+	require.NoError(t, err)
+	require.Nil(t, config)
+}
+
+func TestUpdateConfigFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("storage.path: /old\n# hashstore.logs-path: hashstore\n"), 0644))
+
+	require.NoError(t, relocate.UpdateConfigFile(path, map[string]string{
+		relocate.ConfigKeyStoragePath: "/new",
+		relocate.ConfigKeyTablePath:   "/ssd/tables",
+		relocate.ConfigKeyLogsPath:    "/new/logs",
+	}))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, `storage.path: "/new"
+# hashstore.logs-path: hashstore
+hashstore.logs-path: "/new/logs"
+hashstore.table-path: "/ssd/tables"
+`, string(data))
+}
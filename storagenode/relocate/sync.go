@@ -0,0 +1,495 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package relocate
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+)
+
+const (
+	// chunkSize is the size of the blocks compared and copied.
+	chunkSize = 1 * memory.MiB
+	// tailSize is the size of the end of a log file, which is compared before
+	// appending to it.
+	tailSize = 8 * memory.MiB
+	// tempSuffix is the suffix of files, which are being copied.
+	tempSuffix = ".relocate-tmp"
+)
+
+// fileKind determines how a file is copied.
+type fileKind int
+
+const (
+	// kindImmutable files are written once, e.g. piece files. They're copied
+	// when their size or modification time changes.
+	kindImmutable fileKind = iota
+	// kindAppendOnly files only grow, e.g. hashstore log files. Only the data
+	// appended since the previous pass is copied.
+	kindAppendOnly
+	// kindMutable files are modified in place, e.g. databases and hashstore
+	// tables. Only the changed blocks are copied and they are always copied
+	// again in the final pass.
+	kindMutable
+)
+
+func classify(name string) fileKind {
+	switch {
+	case strings.HasPrefix(name, "log-"):
+		return kindAppendOnly
+	case strings.HasPrefix(name, "hashtbl"), strings.HasPrefix(name, "hint-"),
+		strings.HasSuffix(name, ".db"), strings.HasSuffix(name, ".db-wal"),
+		strings.HasSuffix(name, ".db-shm"), strings.HasSuffix(name, ".db-journal"):
+		return kindMutable
+	default:
+		return kindImmutable
+	}
+}
+
+var (
+	// errChanged is returned when the source changed while it was being copied.
+	errChanged = errors.New("source changed while copying")
+	// errRewritten is returned when an append-only file has to be copied again.
+	errRewritten = errors.New("source has been rewritten")
+)
+
+// syncer runs a single pass over all locations.
+type syncer struct {
+	log       *zap.Logger
+	locations []Location
+	excluded  map[string]bool
+	// final is set for the pass done while the node is offline.
+	final bool
+
+	stats PassStats
+	buf   [2][]byte
+}
+
+func newSyncer(log *zap.Logger, state *State, final bool) *syncer {
+	excluded := map[string]bool{
+		filepath.Join(state.StoragePath(), StateFileName): true,
+	}
+	for _, location := range state.Locations {
+		excluded[location.Source] = true
+	}
+	return &syncer{
+		log:       log,
+		locations: state.Locations,
+		excluded:  excluded,
+		final:     final,
+		buf:       [2][]byte{make([]byte, chunkSize), make([]byte, chunkSize)},
+	}
+}
+
+// run copies the changes of all locations.
+func (s *syncer) run(ctx context.Context) (_ PassStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	start := time.Now()
+	for _, location := range s.locations {
+		if err := s.syncLocation(ctx, location); err != nil {
+			return s.stats, err
+		}
+	}
+	s.stats.Duration = time.Since(start)
+
+	s.log.Debug("relocation pass done",
+		zap.Bool("final", s.final),
+		zap.Int64("files copied", s.stats.FilesCopied),
+		zap.Stringer("bytes copied", memory.Size(s.stats.BytesCopied)),
+		zap.Int64("files deleted", s.stats.FilesDeleted),
+		zap.Int64("changed", s.stats.Changed))
+
+	return s.stats, nil
+}
+
+// walk calls fn for every entry in the location, skipping the nested
+// locations.
+func (s *syncer) walk(ctx context.Context, root string, fn func(rel string, d fs.DirEntry) error) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// the node deletes files and directories while we walk.
+			if errors.Is(err, fs.ErrNotExist) && path != root {
+				return nil
+			}
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if path == root {
+			return nil
+		}
+		if s.excluded[path] {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		return fn(rel, d)
+	})
+}
+
+func (s *syncer) syncLocation(ctx context.Context, location Location) error {
+	if err := os.MkdirAll(location.Destination, 0700); err != nil {
+		return Error.Wrap(err)
+	}
+
+	seen := map[string]bool{}
+	err := s.walk(ctx, location.Source, func(rel string, d fs.DirEntry) error {
+		source := filepath.Join(location.Source, rel)
+		destination := filepath.Join(location.Destination, rel)
+
+		switch {
+		case d.IsDir():
+			seen[rel] = true
+			return os.MkdirAll(destination, 0700)
+		case d.Type().IsRegular():
+			exists, err := s.syncFile(source, destination)
+			if exists {
+				seen[rel] = true
+			}
+			return err
+		default:
+			s.log.Warn("skipping unsupported file", zap.String("path", source))
+			return nil
+		}
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// remove what has been deleted from the source since the previous pass.
+	err = s.walk(ctx, location.Destination, func(rel string, d fs.DirEntry) error {
+		if seen[rel] {
+			return nil
+		}
+		if err := os.RemoveAll(filepath.Join(location.Destination, rel)); err != nil {
+			return err
+		}
+		s.stats.FilesDeleted++
+		if d.IsDir() {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	return Error.Wrap(err)
+}
+
+// syncFile copies the changes of a single file. It returns false when the
+// source doesn't exist anymore.
+func (s *syncer) syncFile(source, destination string) (exists bool, err error) {
+	sourceInfo, err := os.Stat(source)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	destinationInfo, err := os.Stat(destination)
+	if errors.Is(err, fs.ErrNotExist) {
+		destinationInfo = nil
+	} else if err != nil {
+		return true, err
+	}
+
+	kind := classify(sourceInfo.Name())
+	unchanged := destinationInfo != nil &&
+		destinationInfo.Size() == sourceInfo.Size() &&
+		destinationInfo.ModTime().Equal(sourceInfo.ModTime())
+	if unchanged && !(s.final && kind == kindMutable) {
+		return true, nil
+	}
+
+	switch {
+	case kind == kindMutable:
+		err = s.copyBlocks(source, destination, sourceInfo)
+	case kind == kindAppendOnly && destinationInfo != nil && destinationInfo.Size() < sourceInfo.Size():
+		err = s.copyAppended(source, destination, sourceInfo, destinationInfo.Size())
+		if errors.Is(err, errRewritten) {
+			err = s.copyWhole(source, destination, sourceInfo)
+		}
+	default:
+		err = s.copyWhole(source, destination, sourceInfo)
+	}
+
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return false, nil
+	case errors.Is(err, errChanged) && !s.final:
+		// the next pass will pick up the new contents.
+		s.stats.Changed++
+		return true, nil
+	case err != nil:
+		return true, Error.New("%s: %w", source, err)
+	}
+
+	s.stats.FilesCopied++
+	return true, os.Chtimes(destination, sourceInfo.ModTime(), sourceInfo.ModTime())
+}
+
+// copyWhole copies the file into a temporary file, which replaces the
+// destination once the copy is verified.
+func (s *syncer) copyWhole(source, destination string, sourceInfo fs.FileInfo) error {
+	temp := destination + tempSuffix
+	if err := s.copyToTemp(source, temp, sourceInfo); err != nil {
+		return errors.Join(err, ignoreNotExist(os.Remove(temp)))
+	}
+	return os.Rename(temp, destination)
+}
+
+func (s *syncer) copyToTemp(source, temp string, sourceInfo fs.FileInfo) (err error) {
+	src, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, src.Close()) }()
+
+	dst, err := os.OpenFile(temp, os.O_CREATE|os.O_TRUNC|os.O_RDWR, sourceInfo.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, dst.Close()) }()
+
+	n, err := io.CopyBuffer(dst, io.LimitReader(src, sourceInfo.Size()), s.buf[0])
+	s.stats.BytesCopied += n
+	if err != nil {
+		return err
+	}
+	if n != sourceInfo.Size() {
+		return errChanged
+	}
+	if err := dst.Sync(); err != nil {
+		return err
+	}
+	return s.compare(src, dst, 0, sourceInfo.Size())
+}
+
+// copyAppended copies the data appended to the source after the destination's
+// size. When the end of the destination doesn't match the source anymore, the
+// whole file is copied again.
+func (s *syncer) copyAppended(source, destination string, sourceInfo fs.FileInfo, offset int64) (err error) {
+	src, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, src.Close()) }()
+
+	dst, err := os.OpenFile(destination, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, dst.Close()) }()
+
+	tail := min(offset, tailSize.Int64())
+	if err := s.compare(src, dst, offset-tail, tail); err != nil {
+		if errors.Is(err, errChanged) {
+			// the log was truncated and rewritten since the previous pass.
+			return errRewritten
+		}
+		return err
+	}
+
+	n, err := io.CopyBuffer(
+		io.NewOffsetWriter(dst, offset),
+		io.NewSectionReader(src, offset, sourceInfo.Size()-offset),
+		s.buf[0])
+	s.stats.BytesCopied += n
+	if err == nil && offset+n != sourceInfo.Size() {
+		err = errChanged
+	}
+	if err == nil {
+		err = dst.Sync()
+	}
+	if err == nil {
+		err = s.compare(src, dst, offset, n)
+	}
+	if err != nil {
+		// drop the partially copied data, so the next pass appends it again.
+		return errors.Join(err, dst.Truncate(offset))
+	}
+	return nil
+}
+
+// copyBlocks copies the blocks of the source, which differ from the
+// destination. The destination is updated in place.
+func (s *syncer) copyBlocks(source, destination string, sourceInfo fs.FileInfo) (err error) {
+	src, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, src.Close()) }()
+
+	dst, err := os.OpenFile(destination, os.O_CREATE|os.O_RDWR, sourceInfo.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, dst.Close()) }()
+
+	size := sourceInfo.Size()
+	for offset := int64(0); offset < size; offset += chunkSize.Int64() {
+		n := min(chunkSize.Int64(), size-offset)
+		a, b := s.buf[0][:n], s.buf[1][:n]
+
+		if _, err := src.ReadAt(a, offset); err != nil {
+			if errors.Is(err, io.EOF) {
+				return errChanged
+			}
+			return err
+		}
+		read, err := dst.ReadAt(b, offset)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		if int64(read) == n && bytes.Equal(a, b) {
+			continue
+		}
+		if _, err := dst.WriteAt(a, offset); err != nil {
+			return err
+		}
+		s.stats.BytesCopied += n
+	}
+
+	if err := dst.Truncate(size); err != nil {
+		return err
+	}
+	return dst.Sync()
+}
+
+// compare checks that length bytes at offset are the same in both files.
+func (s *syncer) compare(a, b io.ReaderAt, offset, length int64) error {
+	for length > 0 {
+		n := min(chunkSize.Int64(), length)
+		x, y := s.buf[0][:n], s.buf[1][:n]
+
+		if _, err := a.ReadAt(x, offset); err != nil {
+			if errors.Is(err, io.EOF) {
+				return errChanged
+			}
+			return err
+		}
+		if _, err := b.ReadAt(y, offset); err != nil {
+			if errors.Is(err, io.EOF) {
+				return errChanged
+			}
+			return err
+		}
+		if !bytes.Equal(x, y) {
+			return errChanged
+		}
+
+		offset += n
+		length -= n
+	}
+	return nil
+}
+
+// verify checks the result of the final pass. Piece files are verified when
+// they are copied, so only their sizes are compared, the ends of the log files
+// and the mutable files are compared fully.
+func (s *syncer) verify(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, location := range s.locations {
+		err := s.walk(ctx, location.Source, func(rel string, d fs.DirEntry) error {
+			if !d.Type().IsRegular() {
+				return nil
+			}
+			err := s.verifyFile(filepath.Join(location.Source, rel), filepath.Join(location.Destination, rel))
+			if err != nil {
+				return Error.New("verifying %s failed: %w", filepath.Join(location.Source, rel), err)
+			}
+			return nil
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return nil
+}
+
+func (s *syncer) verifyFile(source, destination string) (err error) {
+	src, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, src.Close()) }()
+
+	dst, err := os.Open(destination)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, dst.Close()) }()
+
+	sourceInfo, err := src.Stat()
+	if err != nil {
+		return err
+	}
+	destinationInfo, err := dst.Stat()
+	if err != nil {
+		return err
+	}
+	if sourceInfo.Size() != destinationInfo.Size() {
+		return Error.New("size mismatch: expected %d, got %d", sourceInfo.Size(), destinationInfo.Size())
+	}
+
+	size := sourceInfo.Size()
+	switch classify(sourceInfo.Name()) {
+	case kindMutable:
+		return s.compare(src, dst, 0, size)
+	case kindAppendOnly:
+		tail := min(size, tailSize.Int64())
+		return s.compare(src, dst, size-tail, tail)
+	default:
+		return nil
+	}
+}
+
+func ignoreNotExist(err error) error {
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// writeFileAtomic writes the data into a temporary file, which then replaces
+// the file.
+func writeFileAtomic(path string, data []byte, perm fs.FileMode) (err error) {
+	temp := path + tempSuffix
+	f, err := os.OpenFile(temp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, perm)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(temp)
+		}
+	}()
+
+	if _, err := f.Write(data); err != nil {
+		return errors.Join(err, f.Close())
+	}
+	if err := f.Sync(); err != nil {
+		return errors.Join(err, f.Close())
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(temp, path)
+}
@@ -36,7 +36,7 @@ func (bfm *BloomFilterManager) Status() Status {
 }
 
 type bloomFilterState struct {
-	filter  *bloomfilter.Filter
+	filter  bloomfilter.PieceFilter
 	created time.Time
 }
 
@@ -59,7 +59,7 @@ func NewBloomFilterManager(dir string, skew time.Duration) (*BloomFilterManager,
 		if err := verifyHash(req); err != nil {
 			return err
 		}
-		filter, err := bloomfilter.NewFromBytes(req.Filter)
+		filter, err := bloomfilter.Decode(req.Filter)
 		if err != nil {
 			return err
 		}
@@ -98,7 +98,7 @@ func (bfm *BloomFilterManager) Queue(ctx context.Context, satellite storj.NodeID
 		return errs.New("new request is older than existing request: req=%v < existing=%v", req.CreationDate, existing.created)
 	}
 
-	filter, err := bloomfilter.NewFromBytes(req.Filter)
+	filter, err := bloomfilter.Decode(req.Filter)
 	if err != nil {
 		return errs.Wrap(err)
 	}
@@ -81,3 +81,40 @@ func TestBloomFilterManager(t *testing.T) {
 	assert.True(t, fn(ctx, excludedPiece, now.Add(-time.Second)))
 	assert.True(t, bfm.GetCreatedTime(sat).Equal(now))
 }
+
+func TestBloomFilterManager_BinaryFuse(t *testing.T) {
+	ctx := t.Context()
+	dir := t.TempDir()
+
+	now := time.Now()
+	sat := testrand.NodeID()
+	includedPiece := testrand.PieceID()
+	excludedPiece := testrand.PieceID()
+
+	bfm, err := NewBloomFilterManager(dir, 0)
+	assert.NoError(t, err)
+	fn := bfm.GetBloomFilter(sat)
+
+	// create a filter that includes the included piece and excludes the excluded piece
+	filter, err := bloomfilter.NewBinaryFuse([]uint64{bloomfilter.PieceKey(includedPiece)}, 100)
+	assert.NoError(t, err)
+	for filter.Contains(excludedPiece) {
+		excludedPiece = testrand.PieceID()
+	}
+
+	assert.NoError(t, bfm.Queue(ctx, sat, &pb.RetainRequest{
+		CreationDate: now,
+		Filter:       filter.Bytes(),
+	}))
+
+	assert.False(t, fn(ctx, includedPiece, now.Add(-time.Second)))
+	assert.True(t, fn(ctx, excludedPiece, now.Add(-time.Second)))
+
+	// the filter is loaded again after a restart
+	bfm, err = NewBloomFilterManager(dir, 0)
+	assert.NoError(t, err)
+	fn = bfm.GetBloomFilter(sat)
+
+	assert.False(t, fn(ctx, includedPiece, now.Add(-time.Second)))
+	assert.True(t, fn(ctx, excludedPiece, now.Add(-time.Second)))
+}
@@ -42,7 +42,7 @@ type Request struct {
 	Filename      string
 	SatelliteID   storj.NodeID
 	CreatedBefore time.Time
-	Filter        *bloomfilter.Filter
+	Filter        bloomfilter.PieceFilter
 }
 
 // GetFilename returns the filename used to store the request in the cache directory.
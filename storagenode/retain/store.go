@@ -123,7 +123,7 @@ func NewRequestStore(path string) (RequestStore, error) {
 				data = pbReq.Filter
 			}
 
-			req.Filter, err = bloomfilter.NewFromBytes(data)
+			req.Filter, err = bloomfilter.Decode(data)
 			if err != nil {
 				errsEncountered.Add(errs.New("malformed bloom filter: %w", err))
 				err := DeleteFile(store.path, file.Name())
@@ -171,7 +171,7 @@ func (store *RequestStore) Add(satelliteID storj.NodeID, pbReq *pb.RetainRequest
 		}
 	}
 
-	filter, err := bloomfilter.NewFromBytes(pbReq.GetFilter())
+	filter, err := bloomfilter.Decode(pbReq.GetFilter())
 	if err != nil {
 		return false, err
 	}
@@ -34,12 +34,14 @@ func (db *apiKeysDB) Store(ctx context.Context, apiKey apikeys.APIKey) (err erro
 
 	query := `INSERT INTO secret (
 			token,
-			created_at
-		) VALUES(?,?)`
+			created_at,
+			scopes
+		) VALUES(?,?,?)`
 
 	_, err = db.ExecContext(ctx, query,
 		apiKey.Secret[:],
 		apiKey.CreatedAt,
+		int64(apiKey.Scopes),
 	)
 
 	return ErrAPIKeysDB.Wrap(err)
@@ -71,6 +73,34 @@ func (db *apiKeysDB) Check(ctx context.Context, secret multinodeauth.Secret) (er
 	return nil
 }
 
+// Get returns the api key by secret, without the creation time.
+func (db *apiKeysDB) Get(ctx context.Context, secret multinodeauth.Secret) (_ apikeys.APIKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var token []uint8
+	var scopes int64
+	apiKey := apikeys.APIKey{}
+
+	err = db.QueryRowContext(ctx,
+		`SELECT token, scopes FROM secret WHERE token = ?`,
+		secret[:],
+	).Scan(&token, &scopes)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apikeys.APIKey{}, apikeys.ErrNoAPIKey.Wrap(err)
+		}
+		return apikeys.APIKey{}, ErrAPIKeysDB.Wrap(err)
+	}
+
+	apiKey.Secret, err = multinodeauth.SecretFromBytes(token)
+	if err != nil {
+		return apikeys.APIKey{}, ErrAPIKeysDB.Wrap(err)
+	}
+	apiKey.Scopes = apikeys.Scope(scopes)
+
+	return apiKey, nil
+}
+
 // Revoke removes api key from db.
 func (db *apiKeysDB) Revoke(ctx context.Context, secret multinodeauth.Secret) (err error) {
 	defer mon.Task()(&ctx)(&err)
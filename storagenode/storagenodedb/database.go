@@ -2273,6 +2273,14 @@ func (db *DB) Migration(ctx context.Context) *migrate.Migration {
 					`ALTER TABLE used_space_per_prefix ADD COLUMN piece_counts INTEGER NOT NULL DEFAULT 0`,
 				},
 			},
+			{
+				DB:          &db.apiKeysDB.DB,
+				Description: "Add scopes column to secret table",
+				Version:     63,
+				Action: migrate.SQL{
+					`ALTER TABLE secret ADD COLUMN scopes INTEGER NOT NULL DEFAULT 0`,
+				},
+			},
 		},
 	}
 }
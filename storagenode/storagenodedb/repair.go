@@ -0,0 +1,259 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/shared/dbutil/sqliteutil"
+)
+
+// QuarantineDir is the directory inside the database directory, where
+// corrupted databases are moved to.
+const QuarantineDir = "corrupted"
+
+// corruptionMarker is the file inside the database directory, which records
+// that a corruption error was detected, so the databases are checked on the
+// next start.
+const corruptionMarker = "corruption-detected"
+
+// ErrRepair represents an error during checking or rebuilding corrupted databases.
+var ErrRepair = errs.Class("database repair")
+
+// CheckIntegrity runs an integrity check on all the opened databases and
+// returns the names of the corrupted ones. The full check also verifies the
+// indexes against the tables, which is slow on large databases.
+func (db *DB) CheckIntegrity(ctx context.Context, full bool) (corrupted []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, dbName := range db.databaseNames() {
+		sqlDB := db.rawDatabaseFromName(dbName)
+		if sqlDB == nil {
+			continue
+		}
+
+		problems, err := db.checkIntegrity(ctx, dbName, full)
+		if err != nil {
+			return nil, err
+		}
+		if len(problems) > 0 {
+			db.log.Warn("database is corrupted",
+				zap.String("database", dbName),
+				zap.Strings("problems", problems))
+			corrupted = append(corrupted, dbName)
+		}
+	}
+
+	return corrupted, nil
+}
+
+// checkIntegrity returns the problems found by integrity_check or quick_check on the database.
+func (db *DB) checkIntegrity(ctx context.Context, dbName string, full bool) (problems []string, err error) {
+	pragma := "PRAGMA quick_check"
+	if full {
+		pragma = "PRAGMA integrity_check"
+	}
+
+	rows, err := db.rawDatabaseFromName(dbName).QueryContext(ctx, pragma)
+	if err != nil {
+		if sqliteutil.IsCorruptionError(err) {
+			return []string{err.Error()}, nil
+		}
+		return nil, ErrRepair.New("%s integrity check failed: %w", dbName, err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var result string
+		if err := rows.Scan(&result); err != nil {
+			return nil, ErrRepair.New("%s integrity check failed: %w", dbName, err)
+		}
+		if result != "ok" {
+			problems = append(problems, result)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		if sqliteutil.IsCorruptionError(err) {
+			return append(problems, err.Error()), nil
+		}
+		return nil, ErrRepair.New("%s integrity check failed: %w", dbName, err)
+	}
+
+	return problems, nil
+}
+
+// ReportCorruption records a corruption error, so the databases are checked
+// by the next RepairCorrupted call. Other errors are ignored.
+func (db *DB) ReportCorruption(err error) {
+	if err == nil || !sqliteutil.IsCorruptionError(err) {
+		return
+	}
+
+	db.log.Error("database corruption detected, the databases will be checked on the next start", zap.Error(err))
+	if err := os.WriteFile(filepath.Join(db.dbDirectory, corruptionMarker), nil, 0644); err != nil {
+		db.log.Warn("failed to record database corruption", zap.Error(err))
+	}
+}
+
+// RepairCorrupted checks the integrity of all the databases with a quick
+// check and rebuilds the corrupted ones, when a corruption error was reported
+// since the last check or when force is set. It returns the names of the
+// rebuilt databases.
+func (db *DB) RepairCorrupted(ctx context.Context, force bool) (rebuilt []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	marker := filepath.Join(db.dbDirectory, corruptionMarker)
+	if !force {
+		if _, err := os.Stat(marker); errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		} else if err != nil {
+			return nil, ErrRepair.Wrap(err)
+		}
+	}
+
+	corrupted, err := db.CheckIntegrity(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(corrupted) > 0 {
+		if err := db.Rebuild(ctx, corrupted...); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := os.Remove(marker); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrRepair.Wrap(err)
+	}
+	return corrupted, nil
+}
+
+// Rebuild moves the databases into the quarantine directory and replaces
+// them with empty databases. The empty databases are created by the
+// migrations, so that they match the schema in schema.go and have the latest
+// version, and are checked with the preflight check before being used.
+func (db *DB) Rebuild(ctx context.Context, dbNames ...string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	templateDir, err := os.MkdirTemp(db.dbDirectory, ".rebuild-")
+	if err != nil {
+		return ErrRepair.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrRepair.Wrap(os.RemoveAll(templateDir))) }()
+
+	if err := db.createTemplate(ctx, templateDir); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, dbName := range dbNames {
+		if _, ok := db.SQLDBs[dbName]; !ok {
+			return ErrRepair.New("unknown database %q", dbName)
+		}
+
+		if err := db.closeDatabase(dbName); err != nil {
+			db.log.Warn("failed to close corrupted database", zap.String("database", dbName), zap.Error(err))
+		}
+
+		quarantined, err := db.quarantine(dbName, now)
+		if err != nil {
+			return err
+		}
+
+		template := filepath.Join(templateDir, db.filenameFromDBName(dbName))
+		if err := copyFile(template, db.filepathFromDBName(dbName)); err != nil {
+			return ErrRepair.New("%s failed to create database: %w", dbName, err)
+		}
+
+		if err := db.openDatabaseWithStat(ctx, dbName, false); err != nil {
+			return ErrRepair.Wrap(err)
+		}
+		if err := db.preflight(ctx, dbName, db.SQLDBs[dbName]); err != nil {
+			return ErrRepair.Wrap(err)
+		}
+
+		db.log.Warn("corrupted database rebuilt",
+			zap.String("database", dbName),
+			zap.String("quarantined", quarantined))
+	}
+
+	return nil
+}
+
+// createTemplate creates a fresh set of databases in dir.
+func (db *DB) createTemplate(ctx context.Context, dir string) (err error) {
+	template, err := OpenNew(ctx, zap.NewNop(), Config{
+		Storage: dir,
+		Info:    filepath.Join(dir, "piecestore.db"),
+		Info2:   filepath.Join(dir, "info.db"),
+		Driver:  db.config.Driver,
+		Pieces:  dir,
+	})
+	if err != nil {
+		return ErrRepair.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, ErrRepair.Wrap(template.Close())) }()
+
+	return ErrRepair.Wrap(template.MigrateToLatest(ctx))
+}
+
+// quarantine moves the database with its journal files into the quarantine
+// directory and returns the new location of the database.
+func (db *DB) quarantine(dbName string, now time.Time) (string, error) {
+	dir := filepath.Join(db.dbDirectory, QuarantineDir)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", ErrRepair.Wrap(err)
+	}
+
+	path := db.filepathFromDBName(dbName)
+	quarantined := filepath.Join(dir, dbName+"-"+now.UTC().Format("20060102T150405Z")+".db")
+
+	for _, suffix := range []string{"", "-wal", "-shm", "-journal"} {
+		err := os.Rename(path+suffix, quarantined+suffix)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return "", ErrRepair.New("%s failed to quarantine %q: %w", dbName, path+suffix, err)
+		}
+	}
+
+	return quarantined, nil
+}
+
+// databaseNames returns the names of all databases in order.
+func (db *DB) databaseNames() []string {
+	names := make([]string, 0, len(db.SQLDBs))
+	for dbName := range db.SQLDBs {
+		names = append(names, dbName)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func copyFile(source, destination string) (err error) {
+	src, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, src.Close()) }()
+
+	dst, err := os.OpenFile(destination, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, dst.Close()) }()
+
+	if _, err := io.Copy(dst, src); err != nil {
+		return err
+	}
+	return dst.Sync()
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sqlite3 "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/storagenodedb"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestRepairCorrupted(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		sndb := db.(*storagenodedb.DB)
+
+		rebuilt, err := sndb.RepairCorrupted(ctx, true)
+		require.NoError(t, err)
+		require.Empty(t, rebuilt)
+
+		satelliteID := testrand.NodeID()
+		require.NoError(t, db.Bandwidth().Add(ctx, satelliteID, pb.PieceAction_GET, 100, time.Now()))
+
+		// overwrite the beginning of the database, including its header.
+		path := filepath.Join(sndb.DBDirectory(), storagenodedb.BandwidthDBName+".db")
+		f, err := os.OpenFile(path, os.O_WRONLY, 0)
+		require.NoError(t, err)
+		_, err = f.WriteAt(bytes.Repeat([]byte{0xff}, 8192), 0)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		corrupted, err := sndb.CheckIntegrity(ctx, true)
+		require.NoError(t, err)
+		require.Equal(t, []string{storagenodedb.BandwidthDBName}, corrupted)
+
+		// the databases aren't checked until a corruption error is reported.
+		rebuilt, err = sndb.RepairCorrupted(ctx, false)
+		require.NoError(t, err)
+		require.Empty(t, rebuilt)
+
+		sndb.ReportCorruption(sqlite3.Error{Code: sqlite3.ErrCorrupt})
+
+		rebuilt, err = sndb.RepairCorrupted(ctx, false)
+		require.NoError(t, err)
+		require.Equal(t, []string{storagenodedb.BandwidthDBName}, rebuilt)
+
+		quarantined, err := filepath.Glob(filepath.Join(sndb.DBDirectory(), storagenodedb.QuarantineDir, storagenodedb.BandwidthDBName+"-*.db"))
+		require.NoError(t, err)
+		require.Len(t, quarantined, 1)
+
+		// the rebuilt database is empty, but usable and up to date.
+		require.NoError(t, sndb.CheckVersion(ctx))
+		require.NoError(t, db.Preflight(ctx))
+
+		summary, err := db.Bandwidth().SatelliteSummary(ctx, satelliteID, time.Time{}, time.Now())
+		require.NoError(t, err)
+		require.Zero(t, summary.Total())
+
+		require.NoError(t, db.Bandwidth().Add(ctx, satelliteID, pb.PieceAction_GET, 100, time.Now()))
+
+		corrupted, err = sndb.CheckIntegrity(ctx, true)
+		require.NoError(t, err)
+		require.Empty(t, corrupted)
+
+		rebuilt, err = sndb.RepairCorrupted(ctx, true)
+		require.NoError(t, err)
+		require.Empty(t, rebuilt)
+	})
+}
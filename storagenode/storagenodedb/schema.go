@@ -728,6 +728,11 @@ func Schema() map[string]*dbschema.Schema {
 							Type:       "timestamp with time zone",
 							IsNullable: false,
 						},
+						{
+							Name:       "scopes",
+							Type:       "INTEGER",
+							IsNullable: false,
+						},
 						{
 							Name:       "token",
 							Type:       "bytea",
@@ -0,0 +1,36 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testdata
+
+import "storj.io/storj/storagenode/storagenodedb"
+
+var v63 = MultiDBState{
+	Version: 63,
+	DBStates: DBStates{
+		storagenodedb.UsedSerialsDBName:     v62.DBStates[storagenodedb.UsedSerialsDBName],
+		storagenodedb.StorageUsageDBName:    v62.DBStates[storagenodedb.StorageUsageDBName],
+		storagenodedb.PieceSpaceUsedDBName:  v62.DBStates[storagenodedb.PieceSpaceUsedDBName],
+		storagenodedb.PieceInfoDBName:       v62.DBStates[storagenodedb.PieceInfoDBName],
+		storagenodedb.PieceExpirationDBName: v62.DBStates[storagenodedb.PieceExpirationDBName],
+		storagenodedb.OrdersDBName:          v62.DBStates[storagenodedb.OrdersDBName],
+		storagenodedb.BandwidthDBName:       v62.DBStates[storagenodedb.BandwidthDBName],
+		storagenodedb.SatellitesDBName:      v62.DBStates[storagenodedb.SatellitesDBName],
+		storagenodedb.DeprecatedInfoDBName:  v62.DBStates[storagenodedb.DeprecatedInfoDBName],
+		storagenodedb.NotificationsDBName:   v62.DBStates[storagenodedb.NotificationsDBName],
+		storagenodedb.HeldAmountDBName:      v62.DBStates[storagenodedb.HeldAmountDBName],
+		storagenodedb.PricingDBName:         v62.DBStates[storagenodedb.PricingDBName],
+		storagenodedb.APIKeysDBName: &DBState{
+			SQL: `
+				-- table to hold storagenode secret token
+				CREATE TABLE secret (
+					token bytea NOT NULL,
+					created_at timestamp with time zone NOT NULL,
+					scopes INTEGER NOT NULL DEFAULT 0,
+					PRIMARY KEY ( token )
+				);`,
+		},
+		storagenodedb.GCFilewalkerProgressDBName: v62.DBStates[storagenodedb.GCFilewalkerProgressDBName],
+		storagenodedb.UsedSpacePerPrefixDBName:   v62.DBStates[storagenodedb.UsedSpacePerPrefixDBName],
+	},
+}